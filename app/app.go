@@ -768,6 +768,7 @@ func NewStrideApp(
 		app.IcacallbacksKeeper,
 		&app.RatelimitKeeper,
 		app.ICAOracleKeeper,
+		&app.AuctionKeeper,
 	)
 	app.StakeibcKeeper = *stakeibcKeeper.SetHooks(
 		stakeibcmoduletypes.NewMultiStakeIBCHooks(app.ClaimKeeper.Hooks()),
@@ -783,6 +784,7 @@ func NewStrideApp(
 		app.StakeibcKeeper,
 		app.ClaimKeeper,
 		app.TransferKeeper,
+		app.ContractKeeper,
 	)
 	autopilotModule := autopilot.NewAppModule(appCodec, app.AutopilotKeeper)
 
@@ -808,6 +810,7 @@ func NewStrideApp(
 		app.BankKeeper,
 		app.ICAOracleKeeper,
 		&app.RatelimitKeeper,
+		app.StakeibcKeeper,
 		app.TransferKeeper,
 	)
 	stakeDymModule := stakedym.NewAppModule(appCodec, app.StakedymKeeper)
@@ -905,6 +908,19 @@ func NewStrideApp(
 		return nil
 	}
 
+	// Register autopilot actions
+	if err := app.AutopilotKeeper.SetAutopilotActions(
+		app.StakeibcKeeper.AutopilotActions(),
+		app.StakedymKeeper.AutopilotActions(),
+	); err != nil {
+		return nil
+	}
+
+	// Register autopilot to be notified when asynchronous LSM liquid stakes complete
+	if err := app.StakeibcKeeper.SetLSMLiquidStakeCallbacks(autopilottypes.ModuleName, app.AutopilotKeeper); err != nil {
+		return nil
+	}
+
 	// create IBC middleware stacks by combining middleware with base application
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec,
@@ -12,6 +12,10 @@ const UpgradeName = "v33"
 // post-upgrade
 const AdminMultisigAddress = "stride1fduug6m38gyuqt3wcgc2kcgr9nnte0n7ssn27e"
 
+// LegacyRebalanceSource is recorded as the source validator of the in-flight redelegations
+// seeded from the legacy rebalance cadence, since the actual source is not known
+const LegacyRebalanceSource = "legacy-rebalance"
+
 // ExpectedValidatorCount is enforced by the upgrade handler. Panics if
 // consumerKeeper.GetAllCCValidator returns a different count.
 const ExpectedValidatorCount = 8
@@ -86,6 +86,10 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 
+		// 6. Track the redelegations from the last rebalance under the legacy cadence
+		ctx.Logger().Info("v33: seeding in-flight redelegations from the legacy rebalance cadence...")
+		SeedLegacyRedelegations(ctx, stakeibcKeeper)
+
 		ctx.Logger().Info(fmt.Sprintf("Upgrade %s complete.", UpgradeName))
 		return versionMap, nil
 	}
@@ -251,3 +255,48 @@ func UpdateValidatorWeights(ctx sdk.Context, sk stakeibckeeper.Keeper) error {
 
 	return nil
 }
+
+// Prior to this upgrade, each host zone was only rebalanced on day epochs that were a multiple of
+// (UnbondingPeriod + 1), and the redelegations from that rebalance were not tracked. Rebalances
+// can now be submitted every day, so to avoid conflicting with any redelegations that are still
+// in flight on the host, every validator is locked as a redelegation destination until the
+// point at which the legacy cadence would have next allowed a rebalance
+//
+// The source and amount of the legacy redelegations are not known, so each entry is recorded
+// with LegacyRebalanceSource as the source validator and a zero amount. The entries are
+// pruned as normal once they mature
+func SeedLegacyRedelegations(ctx sdk.Context, sk stakeibckeeper.Keeper) {
+	dayEpoch, found := sk.GetEpochTracker(ctx, epochstypes.DAY_EPOCH)
+	if !found {
+		ctx.Logger().Info("v33: day epoch tracker not found, skipping legacy redelegation seeding")
+		return
+	}
+
+	blockTime := utils.IntToUint(ctx.BlockTime().UnixNano())
+	for _, hostZone := range sk.GetAllHostZone(ctx) {
+		// The last rebalance under the legacy cadence happened during this day epoch, and its
+		// redelegations finished by the end of the unbonding period following the epoch
+		epochsSinceRebalance := dayEpoch.EpochNumber % (hostZone.UnbondingPeriod + 1)
+		if epochsSinceRebalance*dayEpoch.Duration > dayEpoch.NextEpochStartTime {
+			continue
+		}
+		rebalanceEpochEnd := dayEpoch.NextEpochStartTime - epochsSinceRebalance*dayEpoch.Duration
+		completionTime := rebalanceEpochEnd + hostZone.UnbondingPeriod*dayEpoch.Duration
+		if completionTime <= blockTime {
+			continue
+		}
+
+		for _, validator := range hostZone.Validators {
+			sk.SetInFlightRedelegation(ctx, stakeibctypes.InFlightRedelegation{
+				ChainId:      hostZone.ChainId,
+				SrcValidator: LegacyRebalanceSource,
+				DstValidator: validator.Address,
+				Entries: []stakeibctypes.InFlightRedelegationEntry{
+					{Amount: sdkmath.ZeroInt(), CompletionTime: completionTime},
+				},
+			})
+		}
+		ctx.Logger().Info(fmt.Sprintf("  Locked %d validators on %s until %d",
+			len(hostZone.Validators), hostZone.ChainId, completionTime))
+	}
+}
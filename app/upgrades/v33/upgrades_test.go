@@ -589,3 +589,52 @@ func (s *UpgradeTestSuite) TestReconcileOsmosisDelegations_SkipsMissingValidator
 	s.Require().Len(records, 1)
 	s.Require().Equal(trackedTotal, records[0].Amount, "credit equals the reductions for the present validators")
 }
+
+func (s *UpgradeTestSuite) TestSeedLegacyRedelegations() {
+	dayDuration := uint64(24 * time.Hour)
+	blockTime := uint64(s.Ctx.BlockTime().UnixNano())
+
+	// Day 11 with a 4 day unbonding period - the last legacy rebalance was on day 10,
+	// so its redelegations finish 4 days after the end of day 10 (i.e. 4 days from now)
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier:    epochstypes.DAY_EPOCH,
+		EpochNumber:        11,
+		NextEpochStartTime: blockTime + dayDuration,
+		Duration:           dayDuration,
+	})
+	expectedCompletionTime := blockTime + 4*dayDuration
+
+	// A host zone whose legacy rebalance is still in flight
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:         "chain-1",
+		UnbondingPeriod: 4,
+		Validators:      []*stakeibctypes.Validator{{Address: "val1"}, {Address: "val2"}},
+	})
+	// A host zone that is due for a legacy rebalance today - since the rebalance may have
+	// already run, the validators should be locked for the full unbonding period after today
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:         "chain-2",
+		UnbondingPeriod: 10,
+		Validators:      []*stakeibctypes.Validator{{Address: "val3"}},
+	})
+
+	v33.SeedLegacyRedelegations(s.Ctx, s.App.StakeibcKeeper)
+
+	// Each validator on the first host should be locked until the legacy rebalance completes
+	redelegations := s.App.StakeibcKeeper.GetInFlightRedelegationsForHostZone(s.Ctx, "chain-1")
+	s.Require().Len(redelegations, 2, "number of seeded redelegations")
+	for _, redelegation := range redelegations {
+		s.Require().Equal(v33.LegacyRebalanceSource, redelegation.SrcValidator, "source validator")
+		s.Require().Len(redelegation.Entries, 1, "number of entries for %s", redelegation.DstValidator)
+		s.Require().Equal(expectedCompletionTime, redelegation.Entries[0].CompletionTime, "completion time")
+	}
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, "chain-1")
+	s.Require().True(found, "host zone should have been found")
+	constraints := s.App.StakeibcKeeper.GetRedelegationConstraints(s.Ctx, hostZone)
+	s.Require().Equal(map[string]bool{"val1": true, "val2": true}, constraints.LockedValidators, "locked validators")
+
+	redelegations = s.App.StakeibcKeeper.GetInFlightRedelegationsForHostZone(s.Ctx, "chain-2")
+	s.Require().Len(redelegations, 1, "number of seeded redelegations on the second host")
+	s.Require().Equal(blockTime+11*dayDuration, redelegations[0].Entries[0].CompletionTime, "second host completion time")
+}
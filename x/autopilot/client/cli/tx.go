@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdRetryAutopilotAction(),
+	)
+
+	return cmd
+}
+
+// Retries an autopilot action that failed with a retry failure policy
+func CmdRetryAutopilotAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-autopilot-action [failure-id]",
+		Short: "Retries a failed autopilot action",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replays an autopilot action that failed after the transfer landed, using the tokens held by the receiver.
+Must be signed by the receiver from the original autopilot memo.
+
+Example:
+  $ %[1]s tx %[2]s retry-autopilot-action 1
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			failureId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetryAutopilotAction(
+				clientCtx.GetFromAddress().String(),
+				failureId,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,13 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	// Remove retry failures that are past their expiration
+	k.PruneExpiredAutopilotFailures(ctx)
+
+	// Remove daily action usage from previous days
+	k.PruneStaleDailyActionUsage(ctx)
+}
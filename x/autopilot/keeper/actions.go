@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"fmt"
+
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+// Builds the registry key for an action from the module's route and the action name
+func getActionKey(module, name string) string {
+	return fmt.Sprintf("%s/%s", module, name)
+}
+
+// Registers the autopilot actions exposed by other modules (e.g. stakedym liquid staking)
+// Each action can only be registered once
+func (k Keeper) SetAutopilotActions(moduleActions ...types.ModuleActions) error {
+	for _, actions := range moduleActions {
+		for _, action := range actions {
+			if action.Module == "" || action.Name == "" {
+				return fmt.Errorf("autopilot action module and name must be specified")
+			}
+			if action.Module == types.ReceiverKey {
+				return fmt.Errorf("autopilot action module cannot be %s", types.ReceiverKey)
+			}
+			if action.Handler == nil {
+				return fmt.Errorf("handler for autopilot action %s %s must be specified", action.Module, action.Name)
+			}
+
+			actionKey := getActionKey(action.Module, action.Name)
+			if _, found := k.actions[actionKey]; found {
+				return fmt.Errorf("autopilot action %s %s already registered", action.Module, action.Name)
+			}
+			k.actions[actionKey] = action
+		}
+	}
+	return nil
+}
+
+// Returns the autopilot action registered by a module under the given name
+func (k Keeper) GetAutopilotAction(module, name string) (action types.AutopilotAction, found bool) {
+	action, found = k.actions[getActionKey(module, name)]
+	return action, found
+}
+
+// Looks up the registered action for a packet route and decodes the module-specific metadata
+// This is called before the transfer is processed so that invalid memos are rejected upfront
+func (k Keeper) ParseRegisteredAction(
+	routingInfo types.RegisteredActionPacketMetadata,
+) (action types.AutopilotAction, metadata types.ActionMetadata, err error) {
+	action, found := k.GetAutopilotAction(routingInfo.Module, routingInfo.Action)
+	if !found {
+		return action, nil, errorsmod.Wrapf(types.ErrUnsupportedAutopilotAction,
+			"action %s is not supported for module %s", routingInfo.Action, routingInfo.Module)
+	}
+
+	if action.ParseMetadata == nil {
+		return action, nil, nil
+	}
+	metadata, err = action.ParseMetadata(routingInfo.RawMetadata)
+	if err != nil {
+		return action, nil, errorsmod.Wrapf(err, "invalid metadata for %s %s", routingInfo.Module, routingInfo.Action)
+	}
+
+	return action, metadata, nil
+}
+
+// Returns the denom of the inbound transfer's tokens on stride
+// If the tokens are returning to stride (i.e. they're native to stride or came from
+// stride on the previous hop), the first hop is removed from the path
+// Otherwise, the destination port and channel are prepended to the path to build the IBC denom
+func GetReceivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ExtractDenomFromPath(denom).HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
+		voucherPrefix := utils.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ExtractDenomFromPath(denom[len(voucherPrefix):]).IBCDenom()
+	}
+	return utils.GetIBCDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+}
+
+// Builds the input for an autopilot action from the inbound transfer
+// The amount is not validated here (it could be negative), as that's left to the action's handler
+func BuildActionInput(
+	packet channeltypes.Packet,
+	transferMetadata transfertypes.FungibleTokenPacketData,
+	strideAddress string,
+) (input types.AutopilotActionInput, err error) {
+	amount, ok := sdkmath.NewIntFromString(transferMetadata.Amount)
+	if !ok {
+		return input, fmt.Errorf("not a parsable amount field")
+	}
+
+	return types.AutopilotActionInput{
+		Packet:           packet,
+		TransferMetadata: transferMetadata,
+		Receiver:         transferMetadata.Receiver,
+		StrideAddress:    strideAddress,
+		Token:            sdk.Coin{Denom: GetReceivedDenom(packet, transferMetadata.Denom), Amount: amount},
+	}, nil
+}
+
+// Invokes the handler of a registered action
+// Returns the tokens produced by the action, if any
+func (k Keeper) RunAutopilotAction(
+	ctx sdk.Context,
+	module string,
+	name string,
+	input types.AutopilotActionInput,
+	metadata types.ActionMetadata,
+) (sdk.Coin, error) {
+	action, found := k.GetAutopilotAction(module, name)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrUnsupportedAutopilotAction,
+			"action %s is not registered for module %s", name, module)
+	}
+	return action.Handler(ctx, input, metadata)
+}
+
+// Attempts to run an action that was registered by another module, after the inbound transfer has completed
+func (k Keeper) TryRegisteredAction(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	transferMetadata transfertypes.FungibleTokenPacketData,
+	routingInfo types.RegisteredActionPacketMetadata,
+) error {
+	_, metadata, err := k.ParseRegisteredAction(routingInfo)
+	if err != nil {
+		return err
+	}
+
+	input, err := BuildActionInput(packet, transferMetadata, routingInfo.StrideAddress)
+	if err != nil {
+		return err
+	}
+
+	output, err := k.RunAutopilotAction(ctx, routingInfo.Module, routingInfo.Action, input, metadata)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to run autopilot action %s %s", routingInfo.Module, routingInfo.Action)
+	}
+
+	// If there's a next action, run it with the tokens produced by the action
+	if routingInfo.Next != nil {
+		return k.RunNextAction(ctx, output, input.Receiver, routingInfo.StrideAddress, *routingInfo.Next)
+	}
+	return nil
+}
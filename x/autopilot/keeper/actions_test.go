@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/keeper"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+const (
+	MockModule = "mockmodule"
+	MockAction = "MockAction"
+)
+
+// Metadata schema for the mock action
+type MockActionMetadata struct {
+	Memo string `json:"memo"`
+}
+
+func (m MockActionMetadata) Validate() error {
+	if m.Memo == "" {
+		return errors.New("memo cannot be empty")
+	}
+	return nil
+}
+
+// Registers a mock action that records the input and metadata it was called with
+func (s *KeeperTestSuite) RegisterMockAction(
+	calledInput *types.AutopilotActionInput,
+	calledMetadata *types.ActionMetadata,
+) {
+	mockAction := types.AutopilotAction{
+		Module: MockModule,
+		Name:   MockAction,
+		ParseMetadata: func(bz []byte) (types.ActionMetadata, error) {
+			var metadata MockActionMetadata
+			if err := json.Unmarshal(bz, &metadata); err != nil {
+				return nil, err
+			}
+			return metadata, metadata.Validate()
+		},
+		Handler: func(ctx sdk.Context, input types.AutopilotActionInput, metadata types.ActionMetadata) (sdk.Coin, error) {
+			*calledInput = input
+			*calledMetadata = metadata
+			return input.Token, nil
+		},
+	}
+	err := s.App.AutopilotKeeper.SetAutopilotActions(types.ModuleActions{mockAction})
+	s.Require().NoError(err, "no error expected when registering mock action")
+}
+
+func (s *KeeperTestSuite) TestSetAutopilotActions() {
+	handler := func(ctx sdk.Context, input types.AutopilotActionInput, metadata types.ActionMetadata) (sdk.Coin, error) {
+		return sdk.Coin{}, nil
+	}
+
+	// Register a new action
+	action := types.AutopilotAction{Module: MockModule, Name: MockAction, Handler: handler}
+	err := s.App.AutopilotKeeper.SetAutopilotActions(types.ModuleActions{action})
+	s.Require().NoError(err, "no error expected when registering action")
+
+	_, found := s.App.AutopilotKeeper.GetAutopilotAction(MockModule, MockAction)
+	s.Require().True(found, "action should have been registered")
+
+	// The actions registered in app.go should be present as well
+	_, found = s.App.AutopilotKeeper.GetAutopilotAction("stakeibc", types.LiquidStake)
+	s.Require().True(found, "stakeibc liquid stake should be registered")
+	_, found = s.App.AutopilotKeeper.GetAutopilotAction("stakeibc", types.LSMLiquidStake)
+	s.Require().True(found, "stakeibc lsm liquid stake should be registered")
+	_, found = s.App.AutopilotKeeper.GetAutopilotAction("stakedym", types.LiquidStake)
+	s.Require().True(found, "stakedym liquid stake should be registered")
+
+	// Attempting to register the same action again should fail
+	err = s.App.AutopilotKeeper.SetAutopilotActions(types.ModuleActions{action})
+	s.Require().ErrorContains(err, "already registered")
+
+	// Actions without a handler, name or module should fail
+	err = s.App.AutopilotKeeper.SetAutopilotActions(types.ModuleActions{{Module: MockModule, Name: "NoHandler"}})
+	s.Require().ErrorContains(err, "must be specified")
+
+	err = s.App.AutopilotKeeper.SetAutopilotActions(types.ModuleActions{{Module: MockModule, Handler: handler}})
+	s.Require().ErrorContains(err, "module and name must be specified")
+
+	err = s.App.AutopilotKeeper.SetAutopilotActions(types.ModuleActions{{Module: "receiver", Name: MockAction, Handler: handler}})
+	s.Require().ErrorContains(err, "autopilot action module cannot be receiver")
+}
+
+func (s *KeeperTestSuite) TestParseRegisteredAction() {
+	var calledInput types.AutopilotActionInput
+	var calledMetadata types.ActionMetadata
+	s.RegisterMockAction(&calledInput, &calledMetadata)
+
+	validAddress := s.TestAccs[0].String()
+
+	testCases := []struct {
+		name             string
+		routingInfo      types.RegisteredActionPacketMetadata
+		expectedMetadata types.ActionMetadata
+		expectedError    string
+	}{
+		{
+			name: "successful parse",
+			routingInfo: types.RegisteredActionPacketMetadata{
+				Module:        MockModule,
+				Action:        MockAction,
+				StrideAddress: validAddress,
+				RawMetadata:   []byte(`{ "action": "MockAction", "memo": "hello" }`),
+			},
+			expectedMetadata: MockActionMetadata{Memo: "hello"},
+		},
+		{
+			name: "action with no metadata parser",
+			routingInfo: types.RegisteredActionPacketMetadata{
+				Module:        "stakedym",
+				Action:        types.LiquidStake,
+				StrideAddress: validAddress,
+				RawMetadata:   []byte(`{ "action": "LiquidStake" }`),
+			},
+			expectedMetadata: nil,
+		},
+		{
+			name: "unregistered module",
+			routingInfo: types.RegisteredActionPacketMetadata{
+				Module:        "other_module",
+				Action:        MockAction,
+				StrideAddress: validAddress,
+				RawMetadata:   []byte(`{ "action": "MockAction" }`),
+			},
+			expectedError: "action MockAction is not supported for module other_module",
+		},
+		{
+			name: "unregistered action",
+			routingInfo: types.RegisteredActionPacketMetadata{
+				Module:        MockModule,
+				Action:        "OtherAction",
+				StrideAddress: validAddress,
+				RawMetadata:   []byte(`{ "action": "OtherAction" }`),
+			},
+			expectedError: "action OtherAction is not supported for module mockmodule",
+		},
+		{
+			name: "invalid module metadata",
+			routingInfo: types.RegisteredActionPacketMetadata{
+				Module:        MockModule,
+				Action:        MockAction,
+				StrideAddress: validAddress,
+				RawMetadata:   []byte(`{ "action": "MockAction" }`),
+			},
+			expectedError: "memo cannot be empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, metadata, err := s.App.AutopilotKeeper.ParseRegisteredAction(tc.routingInfo)
+			if tc.expectedError == "" {
+				s.Require().NoError(err, "no error expected")
+				s.Require().Equal(tc.expectedMetadata, metadata, "metadata")
+			} else {
+				s.Require().ErrorContains(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetReceivedDenom() {
+	packet := channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      SourceChannelOnHost,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+	}
+
+	// A token native to the sender zone should be converted to an ibc denom
+	expectedAtomDenom := utils.GetIBCDenom(transfertypes.PortID, "channel-0", Atom)
+	s.Require().Equal(expectedAtomDenom, keeper.GetReceivedDenom(packet, Atom), "atom denom")
+
+	// A token native to stride should have the first hop removed
+	strdPath := utils.GetPrefixedDenom(transfertypes.PortID, SourceChannelOnHost, Strd)
+	s.Require().Equal(Strd, keeper.GetReceivedDenom(packet, strdPath), "strd denom")
+
+	// A token that came from a third zone through stride should be converted back to its ibc denom on stride
+	osmoPathOnStride := utils.GetPrefixedDenom(transfertypes.PortID, "channel-5", Osmo)
+	osmoPath := utils.GetPrefixedDenom(transfertypes.PortID, SourceChannelOnHost, osmoPathOnStride)
+	expectedOsmoDenom := utils.GetIBCDenom(transfertypes.PortID, "channel-5", Osmo)
+	s.Require().Equal(expectedOsmoDenom, keeper.GetReceivedDenom(packet, osmoPath), "osmo denom")
+}
+
+func (s *KeeperTestSuite) TestTryRegisteredAction() {
+	var calledInput types.AutopilotActionInput
+	var calledMetadata types.ActionMetadata
+	s.RegisterMockAction(&calledInput, &calledMetadata)
+
+	receiver := s.TestAccs[0].String()
+	amount := sdkmath.NewInt(1000)
+
+	packet := channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      SourceChannelOnHost,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+	}
+	transferMetadata := transfertypes.FungibleTokenPacketData{
+		Denom:    Atom,
+		Amount:   amount.String(),
+		Receiver: receiver,
+	}
+	routingInfo := types.RegisteredActionPacketMetadata{
+		Module:        MockModule,
+		Action:        MockAction,
+		StrideAddress: receiver,
+		RawMetadata:   []byte(`{ "action": "MockAction", "memo": "hello" }`),
+	}
+
+	// Run the action and confirm the handler was called with the expected input
+	err := s.App.AutopilotKeeper.TryRegisteredAction(s.Ctx, packet, transferMetadata, routingInfo)
+	s.Require().NoError(err, "no error expected when running action")
+
+	expectedToken := sdk.NewCoin(utils.GetIBCDenom(transfertypes.PortID, "channel-0", Atom), amount)
+	s.Require().Equal(expectedToken, calledInput.Token, "input token")
+	s.Require().Equal(receiver, calledInput.Receiver, "input receiver")
+	s.Require().Equal(receiver, calledInput.StrideAddress, "input stride address")
+	s.Require().Equal(MockActionMetadata{Memo: "hello"}, calledMetadata, "action metadata")
+
+	// Invalid amount
+	invalidTransferMetadata := transferMetadata
+	invalidTransferMetadata.Amount = ""
+	err = s.App.AutopilotKeeper.TryRegisteredAction(s.Ctx, packet, invalidTransferMetadata, routingInfo)
+	s.Require().ErrorContains(err, "not a parsable amount field")
+
+	// Unregistered action
+	invalidRoutingInfo := routingInfo
+	invalidRoutingInfo.Action = "OtherAction"
+	err = s.App.AutopilotKeeper.TryRegisteredAction(s.Ctx, packet, transferMetadata, invalidRoutingInfo)
+	s.Require().ErrorContains(err, fmt.Sprintf("action OtherAction is not supported for module %s", MockModule))
+}
@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+const (
+	// Time after which a retry failure can no longer be retried and is pruned
+	RetryableFailureExpiration = 30 * 24 * time.Hour
+	// Max number of expired failures that are removed in a single block
+	MaxExpiredFailuresPrunedPerBlock = 100
+)
+
+// Stores an autopilot failure, key'd by the receiver and failure ID
+// If the failure expires, the receiver is also indexed by the expiration time so it can be pruned
+func (k Keeper) SetAutopilotFailure(ctx sdk.Context, failure types.AutopilotFailure) {
+	receiver := sdk.MustAccAddressFromBech32(failure.Receiver)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutopilotFailurePrefix)
+	key := types.GetAutopilotFailureKey(receiver, failure.Id)
+	value := k.Cdc.MustMarshal(&failure)
+	store.Set(key, value)
+
+	if failure.ExpirationTime != 0 {
+		expirationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FailureExpirationPrefix)
+		expirationStore.Set(types.GetFailureExpirationKey(failure.ExpirationTime, failure.Id), receiver)
+	}
+}
+
+// Reads an autopilot failure from the store
+func (k Keeper) GetAutopilotFailure(ctx sdk.Context, receiver sdk.AccAddress, id uint64) (failure types.AutopilotFailure, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutopilotFailurePrefix)
+	valueBz := store.Get(types.GetAutopilotFailureKey(receiver, id))
+	if len(valueBz) == 0 {
+		return failure, false
+	}
+	k.Cdc.MustUnmarshal(valueBz, &failure)
+	return failure, true
+}
+
+// Removes an autopilot failure and its expiration from the store
+func (k Keeper) RemoveAutopilotFailure(ctx sdk.Context, receiver sdk.AccAddress, id uint64) {
+	failure, found := k.GetAutopilotFailure(ctx, receiver, id)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutopilotFailurePrefix)
+	store.Delete(types.GetAutopilotFailureKey(receiver, id))
+
+	if failure.ExpirationTime != 0 {
+		expirationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FailureExpirationPrefix)
+		expirationStore.Delete(types.GetFailureExpirationKey(failure.ExpirationTime, id))
+	}
+}
+
+// Removes the retry failures that have expired, oldest first
+// At most MaxExpiredFailuresPrunedPerBlock failures are removed per block, and the remaining
+// expired failures are removed in the following blocks
+// The tokens were already sent to the receiver when the failure was recorded, so only the
+// ability to retry is lost
+func (k Keeper) PruneExpiredAutopilotFailures(ctx sdk.Context) {
+	currentTime := ctx.BlockTime().Unix()
+
+	expirationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FailureExpirationPrefix)
+	iterator := expirationStore.Iterator(nil, types.GetFailureExpirationKey(currentTime+1, 0))
+
+	type expiredFailure struct {
+		receiver sdk.AccAddress
+		id       uint64
+	}
+	expiredFailures := []expiredFailure{}
+	for ; iterator.Valid() && len(expiredFailures) < MaxExpiredFailuresPrunedPerBlock; iterator.Next() {
+		id := binary.BigEndian.Uint64(iterator.Key()[8:])
+		expiredFailures = append(expiredFailures, expiredFailure{receiver: iterator.Value(), id: id})
+	}
+	iterator.Close()
+
+	for _, expired := range expiredFailures {
+		k.RemoveAutopilotFailure(ctx, expired.receiver, expired.id)
+	}
+}
+
+// Returns all autopilot failures for a given receiver
+func (k Keeper) GetAutopilotFailuresByReceiver(ctx sdk.Context, receiver sdk.AccAddress) (failures []types.AutopilotFailure) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutopilotFailurePrefix)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetAutopilotFailureReceiverPrefix(receiver))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		failure := types.AutopilotFailure{}
+		k.Cdc.MustUnmarshal(iterator.Value(), &failure)
+		failures = append(failures, failure)
+	}
+
+	return failures
+}
+
+// Returns all autopilot failures across receivers
+func (k Keeper) GetAllAutopilotFailures(ctx sdk.Context) (failures []types.AutopilotFailure) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutopilotFailurePrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		failure := types.AutopilotFailure{}
+		k.Cdc.MustUnmarshal(iterator.Value(), &failure)
+		failures = append(failures, failure)
+	}
+
+	return failures
+}
+
+// Returns the ID that will be assigned to the next autopilot failure
+func (k Keeper) GetNextAutopilotFailureId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	idBz := store.Get(types.NextAutopilotFailureIdKey)
+	if len(idBz) == 0 {
+		return 1
+	}
+	return binary.BigEndian.Uint64(idBz)
+}
+
+// Stores the ID that will be assigned to the next autopilot failure
+func (k Keeper) SetNextAutopilotFailureId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, id)
+	store.Set(types.NextAutopilotFailureIdKey, idBz)
+}
+
+// Increments and returns the next autopilot failure ID
+func (k Keeper) IncrementAutopilotFailureId(ctx sdk.Context) uint64 {
+	id := k.GetNextAutopilotFailureId(ctx)
+	k.SetNextAutopilotFailureId(ctx, id+1)
+	return id
+}
+
+// Handles an autopilot action that failed after the inbound transfer landed, for a packet with
+// a keep or retry failure policy
+// If the transfer receiver was the hashed address, the tokens are moved to the receiver from
+// the memo so that they're held by an account the user controls
+// A failure record is only stored with the retry policy (and expires after the retry window),
+// since there's nothing left to do with a keep policy once the receiver has the tokens
+func (k Keeper) RecordAutopilotFailure(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	transferMetadata transfertypes.FungibleTokenPacketData,
+	autopilotMetadata types.AutopilotMetadata,
+	actionErr error,
+) error {
+	if transferMetadata.Receiver != autopilotMetadata.Receiver {
+		input, err := BuildActionInput(packet, transferMetadata, autopilotMetadata.Receiver)
+		if err != nil {
+			return err
+		}
+		hashedReceiver, err := sdk.AccAddressFromBech32(transferMetadata.Receiver)
+		if err != nil {
+			return err
+		}
+		receiver, err := sdk.AccAddressFromBech32(autopilotMetadata.Receiver)
+		if err != nil {
+			return err
+		}
+		if k.bankKeeper.BlockedAddr(receiver) {
+			return errorsmod.Wrapf(types.ErrBlockedFallbackAddress, "receiver %s is blocked", autopilotMetadata.Receiver)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, hashedReceiver, receiver, sdk.NewCoins(input.Token)); err != nil {
+			return errorsmod.Wrapf(err, "unable to return tokens to receiver")
+		}
+	}
+
+	if autopilotMetadata.FailurePolicy != types.FailurePolicy_RETRY {
+		k.Logger(ctx).Info(fmt.Sprintf("Autopilot action failed for %s with policy %s, tokens were kept: %s",
+			autopilotMetadata.Receiver, autopilotMetadata.FailurePolicy.String(), actionErr.Error()))
+		return nil
+	}
+
+	failure := types.AutopilotFailure{
+		Id:                 k.IncrementAutopilotFailureId(ctx),
+		Receiver:           autopilotMetadata.Receiver,
+		Sender:             transferMetadata.Sender,
+		SourcePort:         packet.SourcePort,
+		SourceChannel:      packet.SourceChannel,
+		DestinationPort:    packet.DestinationPort,
+		DestinationChannel: packet.DestinationChannel,
+		Sequence:           packet.Sequence,
+		Denom:              transferMetadata.Denom,
+		Amount:             transferMetadata.Amount,
+		Memo:               transferMetadata.Memo,
+		FailurePolicy:      autopilotMetadata.FailurePolicy,
+		Error:              actionErr.Error(),
+		BlockHeight:        ctx.BlockHeight(),
+		ExpirationTime:     ctx.BlockTime().Add(RetryableFailureExpiration).Unix(),
+	}
+	k.SetAutopilotFailure(ctx, failure)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Recorded autopilot failure %d for %s with policy %s: %s",
+		failure.Id, failure.Receiver, failure.FailurePolicy.String(), failure.Error))
+
+	return nil
+}
+
+// Replays a failed autopilot action using the tokens that were kept by the receiver
+// The receiver from the memo is used as the transfer receiver, so any forwarding step is sent
+// from the receiver's account (which also acts as the fallback address)
+// The failure is removed if the action succeeds
+func (k Keeper) RetryAutopilotAction(ctx sdk.Context, receiver sdk.AccAddress, failureId uint64) error {
+	failure, found := k.GetAutopilotFailure(ctx, receiver, failureId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAutopilotFailureNotFound, "failure %d not found for %s", failureId, receiver.String())
+	}
+	if failure.FailurePolicy != types.FailurePolicy_RETRY {
+		return errorsmod.Wrapf(types.ErrFailureNotRetryable, "failure %d has policy %s", failureId, failure.FailurePolicy.String())
+	}
+	if failure.ExpirationTime != 0 && ctx.BlockTime().Unix() >= failure.ExpirationTime {
+		return errorsmod.Wrapf(types.ErrFailureNotRetryable, "failure %d has expired", failureId)
+	}
+
+	// If the tokens are still held by another account, the retry returns them to the receiver
+	if failure.Holder != "" {
+		if err := k.returnHeldTokens(ctx, failure); err != nil {
+			return errorsmod.Wrapf(err, "failed to retry autopilot action %d", failureId)
+		}
+		k.RemoveAutopilotFailure(ctx, receiver, failureId)
+		return nil
+	}
+
+	autopilotMetadata, err := types.ParseAutopilotMetadata(failure.Memo)
+	if err != nil {
+		return err
+	}
+	if autopilotMetadata == nil {
+		return errorsmod.Wrapf(types.ErrInvalidPacketMetadata, "failure %d does not have autopilot metadata", failureId)
+	}
+
+	packet := channeltypes.Packet{
+		Sequence:           failure.Sequence,
+		SourcePort:         failure.SourcePort,
+		SourceChannel:      failure.SourceChannel,
+		DestinationPort:    failure.DestinationPort,
+		DestinationChannel: failure.DestinationChannel,
+	}
+	transferMetadata := transfertypes.FungibleTokenPacketData{
+		Denom:    failure.Denom,
+		Amount:   failure.Amount,
+		Sender:   failure.Sender,
+		Receiver: failure.Receiver,
+		Memo:     failure.Memo,
+	}
+
+	err = utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.RouteAutopilotAction(ctx, packet, transferMetadata, *autopilotMetadata)
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to retry autopilot action %d", failureId)
+	}
+
+	k.RemoveAutopilotFailure(ctx, receiver, failureId)
+
+	return nil
+}
+
+// Returns the tokens from a failure that are held by an account other than the receiver
+func (k Keeper) returnHeldTokens(ctx sdk.Context, failure types.AutopilotFailure) error {
+	holder, err := sdk.AccAddressFromBech32(failure.Holder)
+	if err != nil {
+		return err
+	}
+	amount, ok := sdkmath.NewIntFromString(failure.Amount)
+	if !ok {
+		return fmt.Errorf("invalid amount %s", failure.Amount)
+	}
+	return k.SendFromHolder(ctx, holder, failure.Receiver, sdk.NewCoin(failure.Denom, amount))
+}
@@ -0,0 +1,347 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/x/autopilot"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/keeper"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+	recordsmodule "github.com/Stride-Labs/stride/v33/x/records"
+)
+
+// Helper function to create the autopilot JSON payload for a liquid stake with a failure policy
+func getLiquidStakeWithFailurePolicyMetadata(receiver, ibcReceiver, transferChannelId, failurePolicy string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"stakeibc": { "action": "LiquidStake", "ibc_receiver": "%[2]s", "transfer_channel": "%[3]s" },
+				"on_failure": "%[4]s"
+			}
+		}`, receiver, ibcReceiver, transferChannelId, failurePolicy)
+}
+
+// Sends an inbound autopilot liquid stake packet through the middleware stack and returns the ack
+func (s *KeeperTestSuite) receiveAutopilotLiquidStakePacket(memo string, receiver string, amount sdkmath.Int) (channeltypes.Packet, bool) {
+	transferMetadata := transfertypes.FungibleTokenPacketData{
+		Sender:   HostAddress,
+		Receiver: receiver,
+		Denom:    ReceivePacketDenomTraces[Atom],
+		Amount:   amount.String(),
+		Memo:     memo,
+	}
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      SourceChannelOnHost,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: ibctesting.FirstChannelID,
+		Data:               transfertypes.ModuleCdc.MustMarshalJSON(&transferMetadata),
+	}
+
+	transferIBCModule := transfer.NewIBCModule(s.App.TransferKeeper)
+	recordsStack := recordsmodule.NewIBCModule(s.App.RecordsKeeper, transferIBCModule)
+	routerIBCModule := autopilot.NewIBCModule(s.App.AutopilotKeeper, recordsStack)
+	ack := routerIBCModule.OnRecvPacket(s.Ctx, transfertypes.V1, packet, s.TestAccs[2])
+
+	return packet, ack.Success()
+}
+
+func (s *KeeperTestSuite) TestAutopilotFailureStore() {
+	receiverA := s.TestAccs[0]
+	receiverB := s.TestAccs[1]
+
+	// Store failures across two receivers
+	failures := []types.AutopilotFailure{
+		{Id: 1, Receiver: receiverA.String()},
+		{Id: 2, Receiver: receiverB.String()},
+		{Id: 3, Receiver: receiverA.String()},
+	}
+	for _, failure := range failures {
+		s.App.AutopilotKeeper.SetAutopilotFailure(s.Ctx, failure)
+	}
+
+	// Check lookups by ID
+	failure, found := s.App.AutopilotKeeper.GetAutopilotFailure(s.Ctx, receiverA, 3)
+	s.Require().True(found, "failure 3 should have been found")
+	s.Require().Equal(failures[2], failure, "failure 3")
+
+	_, found = s.App.AutopilotKeeper.GetAutopilotFailure(s.Ctx, receiverB, 3)
+	s.Require().False(found, "failure 3 should not have been found under a different receiver")
+
+	// Check lookups by receiver
+	s.Require().Equal([]types.AutopilotFailure{failures[0], failures[2]},
+		s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, receiverA), "receiver A failures")
+	s.Require().Equal([]types.AutopilotFailure{failures[1]},
+		s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, receiverB), "receiver B failures")
+
+	// Remove a failure and confirm it's no longer returned
+	s.App.AutopilotKeeper.RemoveAutopilotFailure(s.Ctx, receiverA, 1)
+	s.Require().Equal([]types.AutopilotFailure{failures[2]},
+		s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, receiverA), "receiver A failures after removal")
+
+	// Check the ID increments
+	s.Require().Equal(uint64(1), s.App.AutopilotKeeper.IncrementAutopilotFailureId(s.Ctx), "first id")
+	s.Require().Equal(uint64(2), s.App.AutopilotKeeper.IncrementAutopilotFailureId(s.Ctx), "second id")
+}
+
+func (s *KeeperTestSuite) TestOnRecvPacket_FailurePolicy() {
+	liquidStaker := s.TestAccs[0]
+	depositAddress := s.TestAccs[1]
+	stakeAmount := sdkmath.NewInt(1000000)
+
+	testCases := []struct {
+		name             string
+		failurePolicy    string
+		expectedSuccess  bool
+		expectedRecorded bool
+	}{
+		{
+			name:            "refund",
+			failurePolicy:   types.FailurePolicyRefund,
+			expectedSuccess: false,
+		},
+		{
+			name:            "keep",
+			failurePolicy:   types.FailurePolicyKeep,
+			expectedSuccess: true,
+		},
+		{
+			name:             "retry",
+			failurePolicy:    types.FailurePolicyRetry,
+			expectedSuccess:  true,
+			expectedRecorded: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			nativeTokenIBCDenom := s.SetupAutopilotLiquidStake(true, ibctesting.FirstChannelID, depositAddress, liquidStaker)
+
+			// Liquid stake and forward along a channel that doesn't exist so the action fails
+			// The transfer receiver will be the hashed address since there's a forwarding step
+			memo := getLiquidStakeWithFailurePolicyMetadata(liquidStaker.String(), HostAddress, "channel-999", tc.failurePolicy)
+			packet, success := s.receiveAutopilotLiquidStakePacket(memo, liquidStaker.String(), stakeAmount)
+			s.Require().Equal(tc.expectedSuccess, success, "ack success")
+
+			failures := s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, liquidStaker)
+			if !tc.expectedSuccess {
+				s.Require().Empty(failures, "no failures should be recorded when refunded")
+				return
+			}
+
+			// Confirm the native tokens were moved from the hashed address to the receiver from the memo
+			// and that the liquid stake was reverted
+			stakerBalance := s.App.BankKeeper.GetBalance(s.Ctx, liquidStaker, nativeTokenIBCDenom)
+			s.Require().Equal(stakeAmount.Int64(), stakerBalance.Amount.Int64(), "liquid staker native balance")
+
+			stTokenSupply := s.App.BankKeeper.GetSupply(s.Ctx, "st"+HostDenom)
+			s.Require().Zero(stTokenSupply.Amount.Int64(), "stToken supply")
+
+			// With a keep policy, the receiver has the tokens so no failure should be recorded
+			if !tc.expectedRecorded {
+				s.Require().Empty(failures, "no failures should be recorded when kept")
+				return
+			}
+
+			// Confirm the failure was recorded
+			s.Require().Len(failures, 1, "number of failures")
+			failure := failures[0]
+			s.Require().Equal(uint64(1), failure.Id, "failure id")
+			s.Require().Equal(liquidStaker.String(), failure.Receiver, "failure receiver")
+			s.Require().Equal(HostAddress, failure.Sender, "failure sender")
+			s.Require().Equal(packet.DestinationChannel, failure.DestinationChannel, "failure destination channel")
+			s.Require().Equal(stakeAmount.String(), failure.Amount, "failure amount")
+			s.Require().Equal(memo, failure.Memo, "failure memo")
+			s.Require().Equal(types.FailurePolicy_RETRY, failure.FailurePolicy, "failure policy")
+			s.Require().Contains(failure.Error, "failed to submit transfer", "failure error")
+
+			expectedExpiration := s.Ctx.BlockTime().Add(keeper.RetryableFailureExpiration).Unix()
+			s.Require().Equal(expectedExpiration, failure.ExpirationTime, "failure expiration time")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRetryAutopilotAction() {
+	liquidStaker := s.TestAccs[0]
+	depositAddress := s.TestAccs[1]
+	stakeAmount := sdkmath.NewInt(1000000)
+
+	// Send a liquid stake while autopilot stakeibc routing is disabled, with a retry policy
+	nativeTokenIBCDenom := s.SetupAutopilotLiquidStake(false, ibctesting.FirstChannelID, depositAddress, liquidStaker)
+
+	memo := getLiquidStakeWithFailurePolicyMetadata(liquidStaker.String(), "", "", types.FailurePolicyRetry)
+	_, success := s.receiveAutopilotLiquidStakePacket(memo, liquidStaker.String(), stakeAmount)
+	s.Require().True(success, "ack should be successful with a retry policy")
+
+	failures := s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, liquidStaker)
+	s.Require().Len(failures, 1, "number of failures")
+	failureId := failures[0].Id
+
+	// Attempt to retry while routing is still disabled, it should fail and the failure should remain
+	msgServer := keeper.NewMsgServerImpl(s.App.AutopilotKeeper)
+	_, err := msgServer.RetryAutopilotAction(s.Ctx, types.NewMsgRetryAutopilotAction(liquidStaker.String(), failureId))
+	s.Require().ErrorContains(err, "autopilot packet forwarding is disabled")
+
+	_, found := s.App.AutopilotKeeper.GetAutopilotFailure(s.Ctx, liquidStaker, failureId)
+	s.Require().True(found, "failure should not have been removed")
+
+	// Attempt to retry from a different address, it should fail since the failure is not found
+	_, err = msgServer.RetryAutopilotAction(s.Ctx, types.NewMsgRetryAutopilotAction(s.TestAccs[2].String(), failureId))
+	s.Require().ErrorContains(err, "autopilot failure not found")
+
+	// Enable routing and retry, the liquid stake should succeed
+	params := s.App.AutopilotKeeper.GetParams(s.Ctx)
+	params.StakeibcActive = true
+	s.App.AutopilotKeeper.SetParams(s.Ctx, params)
+
+	_, err = msgServer.RetryAutopilotAction(s.Ctx, types.NewMsgRetryAutopilotAction(liquidStaker.String(), failureId))
+	s.Require().NoError(err, "no error expected when retrying")
+
+	s.CheckLiquidStakeSucceeded(stakeAmount, liquidStaker, depositAddress, nativeTokenIBCDenom, "")
+
+	// Confirm the failure was removed, and can't be retried again
+	_, found = s.App.AutopilotKeeper.GetAutopilotFailure(s.Ctx, liquidStaker, failureId)
+	s.Require().False(found, "failure should have been removed")
+
+	_, err = msgServer.RetryAutopilotAction(s.Ctx, types.NewMsgRetryAutopilotAction(liquidStaker.String(), failureId))
+	s.Require().ErrorContains(err, "autopilot failure not found")
+}
+
+func (s *KeeperTestSuite) TestRetryAutopilotAction_NotRetryable() {
+	receiver := s.TestAccs[0]
+	s.App.AutopilotKeeper.SetAutopilotFailure(s.Ctx, types.AutopilotFailure{
+		Id:            1,
+		Receiver:      receiver.String(),
+		FailurePolicy: types.FailurePolicy_KEEP,
+	})
+
+	err := s.App.AutopilotKeeper.RetryAutopilotAction(s.Ctx, receiver, 1)
+	s.Require().ErrorContains(err, "autopilot failure is not retryable")
+}
+
+func (s *KeeperTestSuite) TestRetryAutopilotAction_Expired() {
+	receiver := s.TestAccs[0]
+	s.App.AutopilotKeeper.SetAutopilotFailure(s.Ctx, types.AutopilotFailure{
+		Id:             1,
+		Receiver:       receiver.String(),
+		FailurePolicy:  types.FailurePolicy_RETRY,
+		ExpirationTime: s.Ctx.BlockTime().Unix(),
+	})
+
+	err := s.App.AutopilotKeeper.RetryAutopilotAction(s.Ctx, receiver, 1)
+	s.Require().ErrorContains(err, "failure 1 has expired")
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredAutopilotFailures() {
+	receiverA := s.TestAccs[0]
+	receiverB := s.TestAccs[1]
+	currentTime := s.Ctx.BlockTime().Unix()
+
+	// Store failures that have expired, that expire in the future, and that never expire
+	failures := []types.AutopilotFailure{
+		{Id: 1, Receiver: receiverA.String(), ExpirationTime: currentTime - 10},
+		{Id: 2, Receiver: receiverB.String(), ExpirationTime: currentTime},
+		{Id: 3, Receiver: receiverA.String(), ExpirationTime: currentTime + 10},
+		{Id: 4, Receiver: receiverB.String()},
+	}
+	for _, failure := range failures {
+		s.App.AutopilotKeeper.SetAutopilotFailure(s.Ctx, failure)
+	}
+
+	s.App.AutopilotKeeper.BeginBlocker(s.Ctx)
+
+	// Confirm only the expired failures were removed
+	s.Require().Equal([]types.AutopilotFailure{failures[2]},
+		s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, receiverA), "receiver A failures")
+	s.Require().Equal([]types.AutopilotFailure{failures[3]},
+		s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, receiverB), "receiver B failures")
+
+	// Move past the last expiration, the remaining failure with an expiration should be removed
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+	s.App.AutopilotKeeper.BeginBlocker(s.Ctx)
+
+	s.Require().Empty(s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, receiverA), "receiver A failures")
+	s.Require().Equal([]types.AutopilotFailure{failures[3]},
+		s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, receiverB), "receiver B failures")
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredAutopilotFailures_MaxPerBlock() {
+	receiver := s.TestAccs[0]
+	expiredTime := s.Ctx.BlockTime().Unix() - 1
+
+	numFailures := uint64(keeper.MaxExpiredFailuresPrunedPerBlock + 5)
+	for id := uint64(1); id <= numFailures; id++ {
+		s.App.AutopilotKeeper.SetAutopilotFailure(s.Ctx, types.AutopilotFailure{
+			Id:             id,
+			Receiver:       receiver.String(),
+			ExpirationTime: expiredTime,
+		})
+	}
+
+	// Only the max number of failures should be removed in the first block
+	s.App.AutopilotKeeper.BeginBlocker(s.Ctx)
+	s.Require().Len(s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, receiver), 5, "failures after first block")
+
+	// The remaining failures should be removed in the next block
+	s.App.AutopilotKeeper.BeginBlocker(s.Ctx)
+	s.Require().Empty(s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, receiver), "failures after second block")
+}
+
+func (s *KeeperTestSuite) TestRetryAutopilotAction_HeldTokens() {
+	receiver := s.TestAccs[0]
+	holder := s.TestAccs[1]
+	heldTokens := sdk.NewCoin(LSMTokenIbcDenom, sdkmath.NewInt(1000))
+	s.FundAccount(holder, heldTokens)
+
+	// Store a failure from an LSM liquid stake where the tokens are still held by the hashed receiver
+	s.App.AutopilotKeeper.SetAutopilotFailure(s.Ctx, types.AutopilotFailure{
+		Id:            1,
+		Receiver:      receiver.String(),
+		Holder:        holder.String(),
+		Denom:         heldTokens.Denom,
+		Amount:        heldTokens.Amount.String(),
+		FailurePolicy: types.FailurePolicy_RETRY,
+	})
+
+	msgServer := keeper.NewMsgServerImpl(s.App.AutopilotKeeper)
+	_, err := msgServer.RetryAutopilotAction(s.Ctx, types.NewMsgRetryAutopilotAction(receiver.String(), 1))
+	s.Require().NoError(err, "no error expected when retrying")
+
+	// Confirm the tokens were returned to the receiver and the failure was removed
+	receiverBalance := s.App.BankKeeper.GetBalance(s.Ctx, receiver, heldTokens.Denom)
+	s.Require().Equal(heldTokens.Amount.Int64(), receiverBalance.Amount.Int64(), "receiver balance")
+
+	holderBalance := s.App.BankKeeper.GetBalance(s.Ctx, holder, heldTokens.Denom)
+	s.Require().Zero(holderBalance.Amount.Int64(), "holder balance")
+
+	_, found := s.App.AutopilotKeeper.GetAutopilotFailure(s.Ctx, receiver, 1)
+	s.Require().False(found, "failure should have been removed")
+}
+
+func (s *KeeperTestSuite) TestQueryAutopilotFailures() {
+	receiver := s.TestAccs[0]
+	failure := types.AutopilotFailure{Id: 1, Receiver: receiver.String(), Denom: Atom, Amount: "1000"}
+	s.App.AutopilotKeeper.SetAutopilotFailure(s.Ctx, failure)
+
+	resp, err := s.App.AutopilotKeeper.AutopilotFailures(s.Ctx, &types.QueryAutopilotFailuresRequest{Address: receiver.String()})
+	s.Require().NoError(err, "no error expected when querying failures")
+	s.Require().Equal([]types.AutopilotFailure{failure}, resp.Failures, "failures")
+
+	_, err = s.App.AutopilotKeeper.AutopilotFailures(s.Ctx, &types.QueryAutopilotFailuresRequest{Address: "invalid"})
+	s.Require().ErrorContains(err, "invalid address")
+
+	_, err = s.App.AutopilotKeeper.AutopilotFailures(s.Ctx, nil)
+	s.Require().ErrorContains(err, "invalid request")
+}
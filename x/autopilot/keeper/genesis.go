@@ -10,11 +10,24 @@ import (
 // state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
+
+	for _, failure := range genState.Failures {
+		k.SetAutopilotFailure(ctx, failure)
+	}
+	if genState.NextFailureId != 0 {
+		k.SetNextAutopilotFailureId(ctx, genState.NextFailureId)
+	}
+	for _, pendingLiquidStake := range genState.PendingLsmLiquidStakes {
+		k.SetPendingLSMLiquidStake(ctx, pendingLiquidStake)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.Failures = k.GetAllAutopilotFailures(ctx)
+	genesis.NextFailureId = k.GetNextAutopilotFailureId(ctx)
+	genesis.PendingLsmLiquidStakes = k.GetAllPendingLSMLiquidStakes(ctx)
 	return genesis
 }
@@ -1,15 +1,38 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
 )
 
 func (s *KeeperTestSuite) TestGenesis() {
+	expirationTime := s.Ctx.BlockTime().Add(time.Hour).Unix()
 	expectedGenesisState := types.GenesisState{
 		Params: types.Params{
 			StakeibcActive: true,
 			ClaimActive:    true,
 		},
+		Failures: []types.AutopilotFailure{
+			{Id: 1, Receiver: s.TestAccs[0].String(), Denom: "denom", Amount: "100", FailurePolicy: types.FailurePolicy_RETRY, ExpirationTime: expirationTime},
+			{Id: 3, Receiver: s.TestAccs[0].String(), Denom: "denom", Amount: "200", FailurePolicy: types.FailurePolicy_RETRY, ExpirationTime: expirationTime},
+			{Id: 2, Receiver: s.TestAccs[1].String(), Denom: "denom", Amount: "300", FailurePolicy: types.FailurePolicy_RETRY, ExpirationTime: expirationTime},
+		},
+		NextFailureId: 4,
+		PendingLsmLiquidStakes: []types.PendingLSMLiquidStake{
+			{
+				LsmTokenIbcDenom: "ibc/lsm1",
+				Receiver:         s.TestAccs[2].String(),
+				FallbackAddress:  s.TestAccs[0].String(),
+				IbcReceiver:      "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf",
+				TransferChannel:  "channel-0",
+			},
+			{
+				LsmTokenIbcDenom: "ibc/lsm2",
+				Receiver:         s.TestAccs[2].String(),
+				FallbackAddress:  s.TestAccs[1].String(),
+			},
+		},
 	}
 
 	s.App.AutopilotKeeper.InitGenesis(s.Ctx, expectedGenesisState)
@@ -17,4 +40,15 @@ func (s *KeeperTestSuite) TestGenesis() {
 	actualGenesisState := s.App.AutopilotKeeper.ExportGenesis(s.Ctx)
 	s.Require().NotNil(actualGenesisState)
 	s.Require().Equal(expectedGenesisState.Params, actualGenesisState.Params)
+	s.Require().ElementsMatch(expectedGenesisState.Failures, actualGenesisState.Failures, "failures")
+	s.Require().Equal(expectedGenesisState.NextFailureId, actualGenesisState.NextFailureId, "next failure id")
+	s.Require().ElementsMatch(expectedGenesisState.PendingLsmLiquidStakes, actualGenesisState.PendingLsmLiquidStakes, "pending lsm liquid stakes")
+
+	// The next failure should pick up from the imported ID
+	s.Require().Equal(uint64(4), s.App.AutopilotKeeper.IncrementAutopilotFailureId(s.Ctx), "next failure id after import")
+
+	// The expiration index should be rebuilt, so the failures are pruned once they expire
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(2 * time.Hour))
+	s.App.AutopilotKeeper.PruneExpiredAutopilotFailures(s.Ctx)
+	s.Require().Empty(s.App.AutopilotKeeper.GetAllAutopilotFailures(s.Ctx), "failures after expiration")
 }
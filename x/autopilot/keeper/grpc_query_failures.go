@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+// Queries the failed autopilot actions for a given receiver address
+func (k Keeper) AutopilotFailures(c context.Context, req *types.QueryAutopilotFailuresRequest) (*types.QueryAutopilotFailuresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receiver, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	failures := k.GetAutopilotFailuresByReceiver(ctx, receiver)
+	return &types.QueryAutopilotFailuresResponse{Failures: failures}, nil
+}
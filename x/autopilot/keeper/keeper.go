@@ -24,6 +24,8 @@ type (
 		stakeibcKeeper stakeibckeeper.Keeper
 		claimKeeper    claimkeeper.Keeper
 		transferKeeper types.IbcTransferKeeper
+		contractKeeper types.ContractKeeper
+		actions        map[string]types.AutopilotAction
 	}
 )
 
@@ -35,6 +37,7 @@ func NewKeeper(
 	stakeibcKeeper stakeibckeeper.Keeper,
 	claimKeeper claimkeeper.Keeper,
 	transferKeeper types.IbcTransferKeeper,
+	contractKeeper types.ContractKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -49,6 +52,8 @@ func NewKeeper(
 		stakeibcKeeper: stakeibcKeeper,
 		claimKeeper:    claimKeeper,
 		transferKeeper: transferKeeper,
+		contractKeeper: contractKeeper,
+		actions:        make(map[string]types.AutopilotAction),
 	}
 }
 
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+const (
+	SecondsPerDay = 24 * 60 * 60
+	// Max number of daily usage entries from previous days that are removed in a single block
+	MaxStaleDailyUsagePrunedPerBlock = 100
+)
+
+// Stores the amount a sender has sent through an action limit on a channel, on the usage's day
+func (k Keeper) SetDailyActionUsage(ctx sdk.Context, limitKey, channelId, sender string, usage types.DailyActionUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DailyActionUsagePrefix)
+	key := types.GetDailyActionUsageKey(usage.Day, limitKey, channelId, sender)
+	value := k.Cdc.MustMarshal(&usage)
+	store.Set(key, value)
+}
+
+// Reads the amount a sender has sent through an action limit on a channel on the current day
+// If there was no usage recorded today, returns a zero amount
+func (k Keeper) GetDailyActionUsage(ctx sdk.Context, limitKey, channelId, sender string) types.DailyActionUsage {
+	currentDay := ctx.BlockTime().Unix() / SecondsPerDay
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DailyActionUsagePrefix)
+	valueBz := store.Get(types.GetDailyActionUsageKey(currentDay, limitKey, channelId, sender))
+
+	usage := types.DailyActionUsage{}
+	if len(valueBz) != 0 {
+		k.Cdc.MustUnmarshal(valueBz, &usage)
+	}
+	if usage.Amount.IsNil() {
+		return types.DailyActionUsage{Day: currentDay, Amount: sdkmath.ZeroInt()}
+	}
+	return usage
+}
+
+// Removes the daily usage entries from previous days, oldest first
+// At most MaxStaleDailyUsagePrunedPerBlock entries are removed per block, and the remaining
+// entries are removed in the following blocks
+func (k Keeper) PruneStaleDailyActionUsage(ctx sdk.Context) {
+	currentDay := ctx.BlockTime().Unix() / SecondsPerDay
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DailyActionUsagePrefix)
+	iterator := store.Iterator(nil, types.GetDailyActionUsageDayPrefix(currentDay))
+
+	staleKeys := [][]byte{}
+	for ; iterator.Valid() && len(staleKeys) < MaxStaleDailyUsagePrunedPerBlock; iterator.Next() {
+		staleKeys = append(staleKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+}
+
+// Enforces the action limits and redemption host zone allowlist from the params on an inbound
+// autopilot packet, and records the amount towards the sender's daily cap
+// The daily cap is tracked by the channel the packet was received on and the sender on the
+// counterparty chain, since the receiver in the memo can be freely changed
+// This is called before the transfer is processed so the packet can be rejected with an error ack
+func (k Keeper) CheckAutopilotLimits(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	transferMetadata transfertypes.FungibleTokenPacketData,
+	autopilotMetadata types.AutopilotMetadata,
+) error {
+	var module, action string
+	switch routingInfo := autopilotMetadata.RoutingInfo.(type) {
+	case types.StakeibcPacketMetadata:
+		module, action = types.StakeibcRouteKey, routingInfo.Action
+	case types.RegisteredActionPacketMetadata:
+		module, action = routingInfo.Module, routingInfo.Action
+	default:
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+
+	if module == types.StakeibcRouteKey && action == types.RedeemStake {
+		if err := k.checkRedemptionHostZoneAllowed(ctx, params, packet, transferMetadata); err != nil {
+			return err
+		}
+	}
+
+	denom := GetReceivedDenom(packet, transferMetadata.Denom)
+	limit, found := params.GetActionLimit(module, action, denom)
+	if !found {
+		return nil
+	}
+
+	if len(limit.AllowedChannels) > 0 && !slices.Contains(limit.AllowedChannels, packet.DestinationChannel) {
+		return errorsmod.Wrapf(types.ErrChannelNotAllowed, "%s %s cannot be received on %s", module, action, packet.DestinationChannel)
+	}
+
+	amount, ok := sdkmath.NewIntFromString(transferMetadata.Amount)
+	if !ok {
+		return fmt.Errorf("not a parsable amount field")
+	}
+	if amount.LT(limit.MinAmount) {
+		return errorsmod.Wrapf(types.ErrAmountBelowMinimum, "amount %v is below the minimum of %v", amount, limit.MinAmount)
+	}
+	if limit.MaxAmount.IsPositive() && amount.GT(limit.MaxAmount) {
+		return errorsmod.Wrapf(types.ErrAmountAboveMaximum, "amount %v is above the maximum of %v", amount, limit.MaxAmount)
+	}
+
+	if !limit.DailyAddressCap.IsPositive() {
+		return nil
+	}
+	usage := k.GetDailyActionUsage(ctx, limit.GetKey(), packet.DestinationChannel, transferMetadata.Sender)
+	usage.Amount = usage.Amount.Add(amount)
+	if usage.Amount.GT(limit.DailyAddressCap) {
+		return errorsmod.Wrapf(types.ErrDailyCapExceeded, "%s on %s has sent %v of the daily cap of %v",
+			transferMetadata.Sender, packet.DestinationChannel, usage.Amount.Sub(amount), limit.DailyAddressCap)
+	}
+	k.SetDailyActionUsage(ctx, limit.GetKey(), packet.DestinationChannel, transferMetadata.Sender, usage)
+
+	return nil
+}
+
+// Confirms the host zone of the stTokens in an autopilot redemption accepts autopilot redemptions
+func (k Keeper) checkRedemptionHostZoneAllowed(
+	ctx sdk.Context,
+	params types.Params,
+	packet channeltypes.Packet,
+	transferMetadata transfertypes.FungibleTokenPacketData,
+) error {
+	if len(params.RedemptionHostZones) == 0 {
+		return nil
+	}
+
+	// Native stTokens have the channel on the host zone as the first hop
+	voucherPrefix := utils.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	stAssetDenom := strings.TrimPrefix(transferMetadata.Denom, voucherPrefix)
+	if !k.stakeibcKeeper.CheckIsStToken(ctx, stAssetDenom) {
+		return errorsmod.Wrapf(types.ErrRedemptionNotAllowed, "%s is not a liquid staking token", transferMetadata.Denom)
+	}
+
+	hostZone, err := k.stakeibcKeeper.GetHostZoneFromHostDenom(ctx, utils.HostZoneDenomFromStAssetDenom(stAssetDenom))
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrRedemptionNotAllowed, "no host zone found for %s", transferMetadata.Denom)
+	}
+	if !params.IsRedemptionHostZoneAllowed(hostZone.ChainId) {
+		return errorsmod.Wrapf(types.ErrRedemptionNotAllowed, "%s", hostZone.ChainId)
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/keeper"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+// Helper function to build an inbound atom packet and liquid stake metadata for the limit checks
+func (s *KeeperTestSuite) getLiquidStakeLimitPacket(channelId string, amount sdkmath.Int) (
+	channeltypes.Packet,
+	transfertypes.FungibleTokenPacketData,
+	types.AutopilotMetadata,
+) {
+	packet := channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      SourceChannelOnHost,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: channelId,
+	}
+	transferMetadata := transfertypes.FungibleTokenPacketData{
+		Denom:    Atom,
+		Amount:   amount.String(),
+		Sender:   HostAddress,
+		Receiver: s.TestAccs[0].String(),
+	}
+	autopilotMetadata := types.AutopilotMetadata{
+		Receiver:    s.TestAccs[0].String(),
+		RoutingInfo: types.StakeibcPacketMetadata{Action: types.LiquidStake},
+	}
+	return packet, transferMetadata, autopilotMetadata
+}
+
+// Helper function to store a single action limit in the params
+func (s *KeeperTestSuite) setActionLimit(limit types.ActionLimit) {
+	params := s.App.AutopilotKeeper.GetParams(s.Ctx)
+	params.ActionLimits = []types.ActionLimit{limit}
+	s.App.AutopilotKeeper.SetParams(s.Ctx, params)
+}
+
+func (s *KeeperTestSuite) TestDailyActionUsage() {
+	channelId := ibctesting.FirstChannelID
+	limitKey := "stakeibc/LiquidStake/"
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(10*keeper.SecondsPerDay+100, 0))
+
+	// With no usage stored, the amount should be zero for the current day
+	usage := s.App.AutopilotKeeper.GetDailyActionUsage(s.Ctx, limitKey, channelId, HostAddress)
+	s.Require().Equal(int64(10), usage.Day, "day with no usage")
+	s.Require().Zero(usage.Amount.Int64(), "amount with no usage")
+
+	// Store usage and confirm it's returned on the same day
+	usage.Amount = sdkmath.NewInt(500)
+	s.App.AutopilotKeeper.SetDailyActionUsage(s.Ctx, limitKey, channelId, HostAddress, usage)
+
+	actual := s.App.AutopilotKeeper.GetDailyActionUsage(s.Ctx, limitKey, channelId, HostAddress)
+	s.Require().Equal(int64(500), actual.Amount.Int64(), "amount on the same day")
+
+	actual = s.App.AutopilotKeeper.GetDailyActionUsage(s.Ctx, limitKey, channelId, "other-sender")
+	s.Require().Zero(actual.Amount.Int64(), "amount for a different sender")
+
+	actual = s.App.AutopilotKeeper.GetDailyActionUsage(s.Ctx, limitKey, "channel-5", HostAddress)
+	s.Require().Zero(actual.Amount.Int64(), "amount for a different channel")
+
+	// On the next day, the usage should reset
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(11*keeper.SecondsPerDay, 0))
+	actual = s.App.AutopilotKeeper.GetDailyActionUsage(s.Ctx, limitKey, channelId, HostAddress)
+	s.Require().Equal(int64(11), actual.Day, "day after reset")
+	s.Require().Zero(actual.Amount.Int64(), "amount after reset")
+}
+
+func (s *KeeperTestSuite) TestPruneStaleDailyActionUsage() {
+	channelId := ibctesting.FirstChannelID
+	limitKey := "stakeibc/LiquidStake/"
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(10*keeper.SecondsPerDay+100, 0))
+
+	// Store usage for the previous two days and the current day
+	numStaleSenders := keeper.MaxStaleDailyUsagePrunedPerBlock + 5
+	for i := 0; i < numStaleSenders; i++ {
+		sender := fmt.Sprintf("sender-%d", i)
+		for _, day := range []int64{8, 9} {
+			usage := types.DailyActionUsage{Day: day, Amount: sdkmath.NewInt(100)}
+			s.App.AutopilotKeeper.SetDailyActionUsage(s.Ctx, limitKey, channelId, sender, usage)
+		}
+	}
+	currentUsage := types.DailyActionUsage{Day: 10, Amount: sdkmath.NewInt(500)}
+	s.App.AutopilotKeeper.SetDailyActionUsage(s.Ctx, limitKey, channelId, HostAddress, currentUsage)
+
+	// Helper to count the stored usage entries
+	countUsage := func() (count int) {
+		store := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)), types.DailyActionUsagePrefix)
+		iterator := store.Iterator(nil, nil)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			count++
+		}
+		return count
+	}
+	s.Require().Equal(2*numStaleSenders+1, countUsage(), "usage entries before pruning")
+
+	// Each block should only remove up to the max number of stale entries
+	s.App.AutopilotKeeper.BeginBlocker(s.Ctx)
+	s.Require().Equal(2*numStaleSenders+1-keeper.MaxStaleDailyUsagePrunedPerBlock, countUsage(), "usage entries after one block")
+
+	for i := 0; i < 3; i++ {
+		s.App.AutopilotKeeper.BeginBlocker(s.Ctx)
+	}
+	s.Require().Equal(1, countUsage(), "usage entries after all stale entries are pruned")
+
+	// Confirm the current day's usage was not removed
+	actual := s.App.AutopilotKeeper.GetDailyActionUsage(s.Ctx, limitKey, channelId, HostAddress)
+	s.Require().Equal(int64(500), actual.Amount.Int64(), "current day usage")
+}
+
+func (s *KeeperTestSuite) TestCheckAutopilotLimits() {
+	allowedChannel := ibctesting.FirstChannelID
+	atomIbcDenom := utils.GetIBCDenom(transfertypes.PortID, allowedChannel, Atom)
+
+	limit := types.ActionLimit{
+		Module:          types.StakeibcRouteKey,
+		Action:          types.LiquidStake,
+		Denom:           atomIbcDenom,
+		AllowedChannels: []string{allowedChannel},
+		MinAmount:       sdkmath.NewInt(10),
+		MaxAmount:       sdkmath.NewInt(1000),
+		DailyAddressCap: sdkmath.NewInt(1500),
+	}
+
+	testCases := []struct {
+		name          string
+		channelId     string
+		amount        sdkmath.Int
+		expectedError string
+	}{
+		{
+			name:      "within limits",
+			channelId: allowedChannel,
+			amount:    sdkmath.NewInt(500),
+		},
+		{
+			name:          "channel is not allowed",
+			channelId:     "channel-5",
+			amount:        sdkmath.NewInt(500),
+			expectedError: "channel is not allowed",
+		},
+		{
+			name:          "below minimum",
+			channelId:     allowedChannel,
+			amount:        sdkmath.NewInt(9),
+			expectedError: "amount is below the autopilot action minimum",
+		},
+		{
+			name:          "above maximum",
+			channelId:     allowedChannel,
+			amount:        sdkmath.NewInt(1001),
+			expectedError: "amount is above the autopilot action maximum",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			s.setActionLimit(limit)
+
+			packet, transferMetadata, autopilotMetadata := s.getLiquidStakeLimitPacket(tc.channelId, tc.amount)
+			err := s.App.AutopilotKeeper.CheckAutopilotLimits(s.Ctx, packet, transferMetadata, autopilotMetadata)
+			if tc.expectedError == "" {
+				s.Require().NoError(err, "no error expected")
+			} else {
+				s.Require().ErrorContains(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCheckAutopilotLimits_DailyCap() {
+	channelId := ibctesting.FirstChannelID
+
+	// Apply the limit to all denoms
+	limit := types.ActionLimit{
+		Module:          types.StakeibcRouteKey,
+		Action:          types.LiquidStake,
+		MinAmount:       sdkmath.ZeroInt(),
+		MaxAmount:       sdkmath.ZeroInt(),
+		DailyAddressCap: sdkmath.NewInt(1000),
+	}
+	s.setActionLimit(limit)
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(10*keeper.SecondsPerDay, 0))
+
+	// Send up to the cap over two packets
+	for _, amount := range []int64{600, 400} {
+		packet, transferMetadata, autopilotMetadata := s.getLiquidStakeLimitPacket(channelId, sdkmath.NewInt(amount))
+		err := s.App.AutopilotKeeper.CheckAutopilotLimits(s.Ctx, packet, transferMetadata, autopilotMetadata)
+		s.Require().NoError(err, "no error expected when sending %d", amount)
+	}
+
+	usage := s.App.AutopilotKeeper.GetDailyActionUsage(s.Ctx, limit.GetKey(), channelId, HostAddress)
+	s.Require().Equal(int64(1000), usage.Amount.Int64(), "usage after hitting the cap")
+
+	// Any further amount should exceed the cap, and the usage should not change
+	packet, transferMetadata, autopilotMetadata := s.getLiquidStakeLimitPacket(channelId, sdkmath.NewInt(1))
+	err := s.App.AutopilotKeeper.CheckAutopilotLimits(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().ErrorContains(err, "autopilot action daily cap exceeded")
+
+	usage = s.App.AutopilotKeeper.GetDailyActionUsage(s.Ctx, limit.GetKey(), channelId, HostAddress)
+	s.Require().Equal(int64(1000), usage.Amount.Int64(), "usage after the rejected packet")
+
+	// Changing the receiver in the memo should not reset the cap
+	autopilotMetadata.Receiver = s.TestAccs[1].String()
+	err = s.App.AutopilotKeeper.CheckAutopilotLimits(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().ErrorContains(err, "autopilot action daily cap exceeded", "different receiver")
+
+	// A different sender has its own cap
+	transferMetadata.Sender = "other-sender"
+	err = s.App.AutopilotKeeper.CheckAutopilotLimits(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().NoError(err, "no error expected for a different sender")
+	transferMetadata.Sender = HostAddress
+
+	// On the next day, the cap should reset
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(11*keeper.SecondsPerDay, 0))
+	err = s.App.AutopilotKeeper.CheckAutopilotLimits(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().NoError(err, "no error expected on the next day")
+}
+
+func (s *KeeperTestSuite) TestCheckAutopilotLimits_RedemptionHostZones() {
+	redeemer := s.TestAccs[0]
+	redeemAmount := sdkmath.NewInt(1000)
+	s.SetupAutopilotRedeemStake(true, redeemAmount, s.TestAccs[1], redeemer)
+
+	hubToStrideChannel := "channel-1"
+	packet := channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      hubToStrideChannel,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: ibctesting.FirstChannelID,
+	}
+	transferMetadata := transfertypes.FungibleTokenPacketData{
+		Denom:    utils.GetPrefixedDenom(transfertypes.PortID, hubToStrideChannel, "st"+HostDenom),
+		Amount:   redeemAmount.String(),
+		Sender:   HostAddress,
+		Receiver: redeemer.String(),
+	}
+	autopilotMetadata := types.AutopilotMetadata{
+		Receiver:    redeemer.String(),
+		RoutingInfo: types.StakeibcPacketMetadata{Action: types.RedeemStake, IbcReceiver: HostAddress},
+	}
+
+	// With no allowlist, all host zones are allowed
+	err := s.App.AutopilotKeeper.CheckAutopilotLimits(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().NoError(err, "no error expected without an allowlist")
+
+	// With the host zone in the allowlist, the redemption should be allowed
+	params := s.App.AutopilotKeeper.GetParams(s.Ctx)
+	params.RedemptionHostZones = []string{HostChainId}
+	s.App.AutopilotKeeper.SetParams(s.Ctx, params)
+
+	err = s.App.AutopilotKeeper.CheckAutopilotLimits(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().NoError(err, "no error expected when the host zone is allowed")
+
+	// Remove the host zone from the allowlist, the redemption should be rejected
+	params.RedemptionHostZones = []string{"chain-1"}
+	s.App.AutopilotKeeper.SetParams(s.Ctx, params)
+
+	err = s.App.AutopilotKeeper.CheckAutopilotLimits(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().ErrorContains(err, "autopilot redemptions are not enabled for host zone")
+
+	// Redeeming a token that isn't an stToken should also be rejected
+	transferMetadata.Denom = utils.GetPrefixedDenom(transfertypes.PortID, hubToStrideChannel, "uosmo")
+	err = s.App.AutopilotKeeper.CheckAutopilotLimits(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().ErrorContains(err, "is not a liquid staking token")
+}
+
+func (s *KeeperTestSuite) TestOnRecvPacket_ActionLimitExceeded() {
+	liquidStaker := s.TestAccs[0]
+	depositAddress := s.TestAccs[1]
+	stakeAmount := sdkmath.NewInt(1000000)
+
+	s.SetupAutopilotLiquidStake(true, ibctesting.FirstChannelID, depositAddress, liquidStaker)
+
+	// Cap liquid stakes below the packet amount
+	s.setActionLimit(types.ActionLimit{
+		Module:          types.StakeibcRouteKey,
+		Action:          types.LiquidStake,
+		MinAmount:       sdkmath.ZeroInt(),
+		MaxAmount:       stakeAmount.SubRaw(1),
+		DailyAddressCap: sdkmath.ZeroInt(),
+	})
+
+	// The packet should be rejected before the transfer, even with a keep failure policy
+	memo := getLiquidStakeWithFailurePolicyMetadata(liquidStaker.String(), "", "", types.FailurePolicyKeep)
+	_, success := s.receiveAutopilotLiquidStakePacket(memo, liquidStaker.String(), stakeAmount)
+	s.Require().False(success, "ack should have failed")
+
+	s.Require().Empty(s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, liquidStaker), "no failures should be recorded")
+
+	stTokenSupply := s.App.BankKeeper.GetSupply(s.Ctx, "st"+HostDenom)
+	s.Require().Zero(stTokenSupply.Amount.Int64(), "stToken supply")
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"errors"
 	"fmt"
 	"time"
 
@@ -9,14 +8,11 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
 
 	errorsmod "cosmossdk.io/errors"
-	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v33/utils"
 	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
-	stakeibckeeper "github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
-	stakeibctypes "github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 )
 
 const (
@@ -42,20 +38,17 @@ func (k Keeper) TryLiquidStaking(
 		return errorsmod.Wrapf(types.ErrPacketForwardingInactive, "autopilot stakeibc routing is inactive")
 	}
 
-	// Verify the amount is valid
-	amount, ok := sdkmath.NewIntFromString(transferMetadata.Amount)
-	if !ok {
-		return errors.New("not a parsable amount field")
-	}
-
 	// In this case, we can't process a liquid staking transaction, because we're dealing with native tokens (e.g. STRD, stATOM)
 	if transfertypes.ExtractDenomFromPath(transferMetadata.Denom).HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
 		return fmt.Errorf("native token is not supported for liquid staking (%s)", transferMetadata.Denom)
 	}
 
 	// Note: the denom in the packet is the base denom e.g. uatom - not ibc/xxx
-	// We need to use the port and channel to build the IBC denom
-	ibcDenom := utils.GetIBCDenom(packet.GetDestPort(), packet.GetDestChannel(), transferMetadata.Denom)
+	// The input token is denominated in the IBC denom built from the port and channel
+	input, err := BuildActionInput(packet, transferMetadata, autopilotMetadata.StrideAddress)
+	if err != nil {
+		return err
+	}
 
 	hostZone, err := k.stakeibcKeeper.GetHostZoneFromHostDenom(ctx, transferMetadata.Denom)
 	if err != nil {
@@ -64,47 +57,37 @@ func (k Keeper) TryLiquidStaking(
 
 	// Verify the IBC denom of the packet matches the host zone, to confirm the packet
 	// was sent over a trusted channel
-	if hostZone.IbcDenom != ibcDenom {
-		return fmt.Errorf("ibc denom %s is not equal to host zone ibc denom %s", ibcDenom, hostZone.IbcDenom)
+	if hostZone.IbcDenom != input.Token.Denom {
+		return fmt.Errorf("ibc denom %s is not equal to host zone ibc denom %s", input.Token.Denom, hostZone.IbcDenom)
 	}
 
-	return k.RunLiquidStake(ctx, amount, transferMetadata, autopilotMetadata)
+	return k.RunLiquidStake(ctx, input, autopilotMetadata)
 }
 
-// Submits a LiquidStake message from the transfer receiver
-// If a forwarding recipient is specified, the stTokens are ibc transferred
+// Liquid stakes from the transfer receiver using the LiquidStake action registered by stakeibc
+// If a forwarding recipient or next action is specified, it's run with the stTokens
 func (k Keeper) RunLiquidStake(
 	ctx sdk.Context,
-	amount sdkmath.Int,
-	transferMetadata transfertypes.FungibleTokenPacketData,
+	input types.AutopilotActionInput,
 	autopilotMetadata types.StakeibcPacketMetadata,
 ) error {
-	msg := &stakeibctypes.MsgLiquidStake{
-		Creator:   transferMetadata.Receiver,
-		Amount:    amount,
-		HostDenom: transferMetadata.Denom,
-	}
-
-	if err := msg.ValidateBasic(); err != nil {
-		return err
-	}
-
-	msgServer := stakeibckeeper.NewMsgServerImpl(k.stakeibcKeeper)
-	msgResponse, err := msgServer.LiquidStake(
-		ctx,
-		msg,
-	)
+	stToken, err := k.RunAutopilotAction(ctx, types.StakeibcRouteKey, types.LiquidStake, input, autopilotMetadata)
 	if err != nil {
 		return errorsmod.Wrapf(err, "failed to liquid stake")
 	}
 
+	// If there's a next action, it's run with the stTokens instead of the ibc receiver forwarding
+	if autopilotMetadata.Next != nil {
+		return k.RunNextAction(ctx, stToken, input.Receiver, autopilotMetadata.StrideAddress, *autopilotMetadata.Next)
+	}
+
 	// If the IBCReceiver is empty, there is no forwarding step
 	if autopilotMetadata.IbcReceiver == "" {
 		return nil
 	}
 
 	// Otherwise, if there is forwarding info, submit the IBC transfer
-	return k.IBCTransferStToken(ctx, msgResponse.StToken, transferMetadata, autopilotMetadata)
+	return k.IBCTransferStToken(ctx, stToken, input.TransferMetadata, autopilotMetadata)
 }
 
 // Submits an IBC transfer of the stToken to a non-stride zone (either back to the host zone or to a different zone)
@@ -115,7 +98,9 @@ func (k Keeper) IBCTransferStToken(
 	transferMetadata transfertypes.FungibleTokenPacketData,
 	autopilotMetadata types.StakeibcPacketMetadata,
 ) error {
-	hostZone, err := k.stakeibcKeeper.GetHostZoneFromHostDenom(ctx, transferMetadata.Denom)
+	// The host zone is identified from the stToken, since the inbound tokens may be LSM tokens
+	hostDenom := utils.HostZoneDenomFromStAssetDenom(stToken.Denom)
+	hostZone, err := k.stakeibcKeeper.GetHostZoneFromHostDenom(ctx, hostDenom)
 	if err != nil {
 		return err
 	}
@@ -126,33 +111,21 @@ func (k Keeper) IBCTransferStToken(
 		channelId = hostZone.TransferChannelId
 	}
 
-	// Use a long timeout for the transfer
-	timeoutTimestamp := utils.IntToUint(ctx.BlockTime().UnixNano() + LiquidStakeForwardTransferTimeout.Nanoseconds())
-
 	// Submit the transfer from the hashed address
-	transferMsg := &transfertypes.MsgTransfer{
-		SourcePort:       transfertypes.PortID,
-		SourceChannel:    channelId,
-		Token:            stToken,
-		Sender:           transferMetadata.Receiver,
-		Receiver:         autopilotMetadata.IbcReceiver,
-		TimeoutTimestamp: timeoutTimestamp,
-		Memo:             "autopilot-liquid-stake-and-forward",
-	}
-
-	transferResponse, err := k.transferKeeper.Transfer(ctx, transferMsg)
-	if err != nil {
-		return errorsmod.Wrapf(err, "failed to submit transfer during autopilot liquid stake and forward")
-	}
-
-	// Store the original receiver as the fallback address in case the transfer fails
 	// autopilotMetadata.StrideAddress is never the hashed address, because the autopilotMetadata struct
 	// is parsed upstream of hashing the receiver
 	// So StrideAddress is used as the fallback (which is always the original receiver)
-	if k.bankKeeper.BlockedAddr(sdk.MustAccAddressFromBech32(autopilotMetadata.StrideAddress)) {
-		return errorsmod.Wrapf(types.ErrBlockedFallbackAddress, "fallback address %s is blocked", autopilotMetadata.StrideAddress)
+	err = k.SubmitForwardTransfer(
+		ctx,
+		stToken,
+		transferMetadata.Receiver,
+		autopilotMetadata.IbcReceiver,
+		channelId,
+		"autopilot-liquid-stake-and-forward",
+		autopilotMetadata.StrideAddress,
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to submit transfer during autopilot liquid stake and forward")
 	}
-	k.SetTransferFallbackAddress(ctx, channelId, transferResponse.Sequence, autopilotMetadata.StrideAddress)
-
-	return err
+	return nil
 }
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"fmt"
+
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
+)
+
+// Stores a pending LSM liquid stake and forward
+func (k Keeper) SetPendingLSMLiquidStake(ctx sdk.Context, pendingLiquidStake types.PendingLSMLiquidStake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingLSMLiquidStakePrefix)
+	key := []byte(pendingLiquidStake.LsmTokenIbcDenom)
+	value := k.Cdc.MustMarshal(&pendingLiquidStake)
+	store.Set(key, value)
+}
+
+// Reads a pending LSM liquid stake and forward from the store, key'd by the LSM token's IBC denom
+func (k Keeper) GetPendingLSMLiquidStake(ctx sdk.Context, lsmTokenIbcDenom string) (pendingLiquidStake types.PendingLSMLiquidStake, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingLSMLiquidStakePrefix)
+	valueBz := store.Get([]byte(lsmTokenIbcDenom))
+	if len(valueBz) == 0 {
+		return pendingLiquidStake, false
+	}
+	k.Cdc.MustUnmarshal(valueBz, &pendingLiquidStake)
+	return pendingLiquidStake, true
+}
+
+// Removes a pending LSM liquid stake and forward from the store
+func (k Keeper) RemovePendingLSMLiquidStake(ctx sdk.Context, lsmTokenIbcDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingLSMLiquidStakePrefix)
+	store.Delete([]byte(lsmTokenIbcDenom))
+}
+
+// Returns all pending LSM liquid stakes
+func (k Keeper) GetAllPendingLSMLiquidStakes(ctx sdk.Context) (pendingLiquidStakes []types.PendingLSMLiquidStake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingLSMLiquidStakePrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pendingLiquidStake := types.PendingLSMLiquidStake{}
+		k.Cdc.MustUnmarshal(iterator.Value(), &pendingLiquidStake)
+		pendingLiquidStakes = append(pendingLiquidStakes, pendingLiquidStake)
+	}
+
+	return pendingLiquidStakes
+}
+
+// Attempts to do an autopilot LSM liquid stake (and optional forward)
+// If the liquid stake requires a validator slash query, the stTokens are minted (and forwarded)
+// asynchronously once the query callback finishes the liquid stake
+func (k Keeper) TryLSMLiquidStaking(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	transferMetadata transfertypes.FungibleTokenPacketData,
+	autopilotMetadata types.StakeibcPacketMetadata,
+) error {
+	params := k.GetParams(ctx)
+	if !params.StakeibcActive {
+		return errorsmod.Wrapf(types.ErrPacketForwardingInactive, "autopilot stakeibc routing is inactive")
+	}
+
+	// LSM tokens must originate from the host zone, so tokens that are returning to stride are not supported
+	if transfertypes.ExtractDenomFromPath(transferMetadata.Denom).HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return fmt.Errorf("native token is not supported for lsm liquid staking (%s)", transferMetadata.Denom)
+	}
+
+	// If there's a forwarding step, the tokens are held by the hashed receiver until the liquid stake
+	// finishes, so confirm they can be returned to the fallback address before the liquid stake starts
+	if autopilotMetadata.IbcReceiver != "" {
+		if err := k.ValidateFallbackAddress(autopilotMetadata.StrideAddress); err != nil {
+			return err
+		}
+	}
+
+	// The LSM token's denom trace is validated against the host zone's transfer channel by stakeibc
+	input, err := BuildActionInput(packet, transferMetadata, autopilotMetadata.StrideAddress)
+	if err != nil {
+		return err
+	}
+
+	stToken, err := k.RunAutopilotAction(ctx, types.StakeibcRouteKey, types.LSMLiquidStake, input, autopilotMetadata)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to lsm liquid stake")
+	}
+
+	// If the IBCReceiver is empty, there is no forwarding step
+	// In the async case, the stTokens will be minted directly to the receiver
+	if autopilotMetadata.IbcReceiver == "" {
+		return nil
+	}
+
+	// If the liquid stake is waiting on a slash query, store the forwarding info so the stTokens can
+	// be forwarded once it finishes
+	if stToken.IsNil() || stToken.IsZero() {
+		k.SetPendingLSMLiquidStake(ctx, types.PendingLSMLiquidStake{
+			LsmTokenIbcDenom: input.Token.Denom,
+			Receiver:         input.Receiver,
+			FallbackAddress:  autopilotMetadata.StrideAddress,
+			IbcReceiver:      autopilotMetadata.IbcReceiver,
+			TransferChannel:  autopilotMetadata.TransferChannel,
+		})
+		return nil
+	}
+
+	return k.IBCTransferStToken(ctx, stToken, transferMetadata, autopilotMetadata)
+}
+
+// Callback from stakeibc after an asynchronous LSM liquid stake finishes
+// If the liquid stake was started by autopilot with a forwarding step, the stTokens are forwarded
+// If the forward fails, the stTokens are sent to the fallback address instead so they're not
+// left on the hashed receiver
+func (k Keeper) AfterLSMLiquidStakeFinished(ctx sdk.Context, deposit recordstypes.LSMTokenDeposit) error {
+	pendingLiquidStake, found := k.GetPendingLSMLiquidStake(ctx, deposit.IbcDenom)
+	if !found {
+		return nil
+	}
+	k.RemovePendingLSMLiquidStake(ctx, deposit.IbcDenom)
+
+	transferMetadata := transfertypes.FungibleTokenPacketData{
+		Receiver: pendingLiquidStake.Receiver,
+	}
+	autopilotMetadata := types.StakeibcPacketMetadata{
+		Action:          types.LSMLiquidStake,
+		StrideAddress:   pendingLiquidStake.FallbackAddress,
+		IbcReceiver:     pendingLiquidStake.IbcReceiver,
+		TransferChannel: pendingLiquidStake.TransferChannel,
+	}
+	err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.IBCTransferStToken(ctx, deposit.StToken, transferMetadata, autopilotMetadata)
+	})
+	if err == nil {
+		return nil
+	}
+
+	k.Logger(ctx).Error(fmt.Sprintf("Failed to forward stTokens from autopilot lsm liquid stake %s, sending to fallback address %s: %s",
+		deposit.DepositId, pendingLiquidStake.FallbackAddress, err.Error()))
+
+	k.returnPendingLSMTokens(ctx, pendingLiquidStake, deposit.StToken.Denom)
+	return nil
+}
+
+// Callback from stakeibc after an asynchronous LSM liquid stake fails
+// If the liquid stake was started by autopilot with a forwarding step, the LSM tokens are
+// held by the hashed receiver, so they're returned to the fallback address
+func (k Keeper) AfterLSMLiquidStakeFailed(ctx sdk.Context, deposit recordstypes.LSMTokenDeposit) error {
+	pendingLiquidStake, found := k.GetPendingLSMLiquidStake(ctx, deposit.IbcDenom)
+	if !found {
+		return nil
+	}
+	k.RemovePendingLSMLiquidStake(ctx, deposit.IbcDenom)
+
+	k.returnPendingLSMTokens(ctx, pendingLiquidStake, deposit.IbcDenom)
+	return nil
+}
+
+// Returns the full balance of a denom from the hashed receiver of a pending LSM liquid stake
+// to the fallback address
+// If the tokens can't be returned, an autopilot failure is recorded with the hashed receiver as
+// the holder, so that the fallback address can recover them with MsgRetryAutopilotAction
+// Errors are not returned since the callback's state changes (including the removal of the
+// pending liquid stake) would be discarded
+func (k Keeper) returnPendingLSMTokens(ctx sdk.Context, pendingLiquidStake types.PendingLSMLiquidStake, denom string) {
+	hashedReceiver, err := sdk.AccAddressFromBech32(pendingLiquidStake.Receiver)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Invalid hashed receiver %s for pending lsm liquid stake %s",
+			pendingLiquidStake.Receiver, pendingLiquidStake.LsmTokenIbcDenom))
+		return
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, hashedReceiver, denom)
+	if balance.IsZero() {
+		return
+	}
+
+	err = utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.SendFromHolder(ctx, hashedReceiver, pendingLiquidStake.FallbackAddress, balance)
+	})
+	if err == nil {
+		return
+	}
+
+	k.Logger(ctx).Error(fmt.Sprintf("Failed to return %v from autopilot lsm liquid stake to fallback address %s: %s",
+		balance, pendingLiquidStake.FallbackAddress, err.Error()))
+
+	// The failure is key'd by the fallback address, so it must be a valid address to be recorded
+	if _, err := sdk.AccAddressFromBech32(pendingLiquidStake.FallbackAddress); err != nil {
+		return
+	}
+	failure := types.AutopilotFailure{
+		Id:            k.IncrementAutopilotFailureId(ctx),
+		Receiver:      pendingLiquidStake.FallbackAddress,
+		Holder:        pendingLiquidStake.Receiver,
+		Denom:         balance.Denom,
+		Amount:        balance.Amount.String(),
+		FailurePolicy: types.FailurePolicy_RETRY,
+		Error:         err.Error(),
+		BlockHeight:   ctx.BlockHeight(),
+	}
+	k.SetAutopilotFailure(ctx, failure)
+}
+
+// Sends tokens from an account holding them on behalf of the receiver (e.g. the hashed receiver
+// of an autopilot transfer) to the receiver
+func (k Keeper) SendFromHolder(ctx sdk.Context, holder sdk.AccAddress, receiver string, token sdk.Coin) error {
+	if err := k.ValidateFallbackAddress(receiver); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoins(ctx, holder, sdk.MustAccAddressFromBech32(receiver), sdk.NewCoins(token))
+}
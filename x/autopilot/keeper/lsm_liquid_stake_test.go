@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
+)
+
+var LSMTokenIbcDenom = utils.GetIBCDenom(transfertypes.PortID, ibctesting.FirstChannelID, "cosmosvaloperXXX/1")
+
+type AutopilotLSMCallbackTestCase struct {
+	hashedReceiver  sdk.AccAddress
+	fallbackAddress sdk.AccAddress
+	deposit         recordstypes.LSMTokenDeposit
+	pending         types.PendingLSMLiquidStake
+}
+
+// Mocks out a host zone and transfer channel, and stores a pending autopilot LSM liquid stake
+// for a deposit that's waiting on a validator slash query
+func (s *KeeperTestSuite) SetupAutopilotLSMCallback() AutopilotLSMCallbackTestCase {
+	hashedReceiver := s.TestAccs[0]
+	fallbackAddress := s.TestAccs[1]
+	depositAddress := s.TestAccs[2]
+
+	s.SetupAutopilotLiquidStake(true, ibctesting.FirstChannelID, depositAddress, fallbackAddress)
+
+	deposit := recordstypes.LSMTokenDeposit{
+		DepositId: "deposit-1",
+		ChainId:   HostChainId,
+		IbcDenom:  LSMTokenIbcDenom,
+		StToken:   sdk.NewCoin("st"+HostDenom, sdkmath.NewInt(1000)),
+	}
+
+	pending := types.PendingLSMLiquidStake{
+		LsmTokenIbcDenom: LSMTokenIbcDenom,
+		Receiver:         hashedReceiver.String(),
+		FallbackAddress:  fallbackAddress.String(),
+		IbcReceiver:      HostAddress,
+	}
+	s.App.AutopilotKeeper.SetPendingLSMLiquidStake(s.Ctx, pending)
+
+	return AutopilotLSMCallbackTestCase{
+		hashedReceiver:  hashedReceiver,
+		fallbackAddress: fallbackAddress,
+		deposit:         deposit,
+		pending:         pending,
+	}
+}
+
+func (s *KeeperTestSuite) TestPendingLSMLiquidStake() {
+	pending := types.PendingLSMLiquidStake{
+		LsmTokenIbcDenom: LSMTokenIbcDenom,
+		Receiver:         s.TestAccs[0].String(),
+		FallbackAddress:  s.TestAccs[1].String(),
+		IbcReceiver:      HostAddress,
+		TransferChannel:  "channel-5",
+	}
+	s.App.AutopilotKeeper.SetPendingLSMLiquidStake(s.Ctx, pending)
+
+	actual, found := s.App.AutopilotKeeper.GetPendingLSMLiquidStake(s.Ctx, LSMTokenIbcDenom)
+	s.Require().True(found, "pending liquid stake should have been found")
+	s.Require().Equal(pending, actual, "pending liquid stake")
+
+	_, found = s.App.AutopilotKeeper.GetPendingLSMLiquidStake(s.Ctx, "other-denom")
+	s.Require().False(found, "pending liquid stake should not have been found for a different denom")
+
+	s.App.AutopilotKeeper.RemovePendingLSMLiquidStake(s.Ctx, LSMTokenIbcDenom)
+	_, found = s.App.AutopilotKeeper.GetPendingLSMLiquidStake(s.Ctx, LSMTokenIbcDenom)
+	s.Require().False(found, "pending liquid stake should have been removed")
+}
+
+func (s *KeeperTestSuite) TestAfterLSMLiquidStakeFinished_Forward() {
+	tc := s.SetupAutopilotLSMCallback()
+	s.FundAccount(tc.hashedReceiver, tc.deposit.StToken)
+
+	err := s.App.AutopilotKeeper.AfterLSMLiquidStakeFinished(s.Ctx, tc.deposit)
+	s.Require().NoError(err, "no error expected when forwarding stTokens")
+
+	// Confirm the stTokens were sent to the escrow account
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, ibctesting.FirstChannelID)
+	escrowBalance := s.App.BankKeeper.GetBalance(s.Ctx, escrowAddress, tc.deposit.StToken.Denom)
+	s.Require().Equal(tc.deposit.StToken.Amount.Int64(), escrowBalance.Amount.Int64(), "escrow balance")
+
+	// Confirm the fallback address was stored for the outbound transfer
+	address, found := s.App.AutopilotKeeper.GetTransferFallbackAddress(s.Ctx, ibctesting.FirstChannelID, 1)
+	s.Require().True(found, "fallback address should have been stored")
+	s.Require().Equal(tc.fallbackAddress.String(), address, "fallback address")
+
+	// Confirm the pending liquid stake was removed
+	_, found = s.App.AutopilotKeeper.GetPendingLSMLiquidStake(s.Ctx, LSMTokenIbcDenom)
+	s.Require().False(found, "pending liquid stake should have been removed")
+}
+
+func (s *KeeperTestSuite) TestAfterLSMLiquidStakeFinished_ForwardFailed() {
+	tc := s.SetupAutopilotLSMCallback()
+	s.FundAccount(tc.hashedReceiver, tc.deposit.StToken)
+
+	// Update the pending liquid stake to use a channel that doesn't exist so the transfer fails
+	tc.pending.TransferChannel = "channel-100"
+	s.App.AutopilotKeeper.SetPendingLSMLiquidStake(s.Ctx, tc.pending)
+
+	err := s.App.AutopilotKeeper.AfterLSMLiquidStakeFinished(s.Ctx, tc.deposit)
+	s.Require().NoError(err, "no error expected when falling back")
+
+	// Confirm the stTokens were sent to the fallback address
+	fallbackBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.fallbackAddress, tc.deposit.StToken.Denom)
+	s.Require().Equal(tc.deposit.StToken.Amount.Int64(), fallbackBalance.Amount.Int64(), "fallback balance")
+
+	receiverBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.hashedReceiver, tc.deposit.StToken.Denom)
+	s.Require().Zero(receiverBalance.Amount.Int64(), "hashed receiver balance")
+
+	_, found := s.App.AutopilotKeeper.GetPendingLSMLiquidStake(s.Ctx, LSMTokenIbcDenom)
+	s.Require().False(found, "pending liquid stake should have been removed")
+}
+
+func (s *KeeperTestSuite) TestAfterLSMLiquidStakeFinished_NotAutopilot() {
+	tc := s.SetupAutopilotLSMCallback()
+	s.FundAccount(tc.hashedReceiver, tc.deposit.StToken)
+
+	// Callback for a deposit with a different denom should be a no-op
+	otherDeposit := tc.deposit
+	otherDeposit.IbcDenom = "ibc/other"
+	err := s.App.AutopilotKeeper.AfterLSMLiquidStakeFinished(s.Ctx, otherDeposit)
+	s.Require().NoError(err, "no error expected when there's no pending liquid stake")
+
+	receiverBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.hashedReceiver, tc.deposit.StToken.Denom)
+	s.Require().Equal(tc.deposit.StToken.Amount.Int64(), receiverBalance.Amount.Int64(), "hashed receiver balance")
+
+	_, found := s.App.AutopilotKeeper.GetPendingLSMLiquidStake(s.Ctx, LSMTokenIbcDenom)
+	s.Require().True(found, "pending liquid stake should not have been removed")
+}
+
+func (s *KeeperTestSuite) TestAfterLSMLiquidStakeFailed() {
+	tc := s.SetupAutopilotLSMCallback()
+
+	// The LSM tokens are still held by the hashed receiver after a failed liquid stake
+	lsmTokens := sdk.NewCoin(LSMTokenIbcDenom, sdkmath.NewInt(1000))
+	s.FundAccount(tc.hashedReceiver, lsmTokens)
+
+	err := s.App.AutopilotKeeper.AfterLSMLiquidStakeFailed(s.Ctx, tc.deposit)
+	s.Require().NoError(err, "no error expected when returning LSM tokens")
+
+	// Confirm the LSM tokens were sent to the fallback address
+	fallbackBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.fallbackAddress, LSMTokenIbcDenom)
+	s.Require().Equal(lsmTokens.Amount.Int64(), fallbackBalance.Amount.Int64(), "fallback balance")
+
+	receiverBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.hashedReceiver, LSMTokenIbcDenom)
+	s.Require().Zero(receiverBalance.Amount.Int64(), "hashed receiver balance")
+
+	_, found := s.App.AutopilotKeeper.GetPendingLSMLiquidStake(s.Ctx, LSMTokenIbcDenom)
+	s.Require().False(found, "pending liquid stake should have been removed")
+}
+
+func (s *KeeperTestSuite) TestAfterLSMLiquidStakeFinished_FallbackFailed() {
+	tc := s.SetupAutopilotLSMCallback()
+	s.FundAccount(tc.hashedReceiver, tc.deposit.StToken)
+
+	// Update the pending liquid stake to use a channel that doesn't exist and a blocked fallback
+	// address, so that both the transfer and the return to the fallback address fail
+	blockedAddress := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	tc.pending.TransferChannel = "channel-100"
+	tc.pending.FallbackAddress = blockedAddress.String()
+	s.App.AutopilotKeeper.SetPendingLSMLiquidStake(s.Ctx, tc.pending)
+
+	err := s.App.AutopilotKeeper.AfterLSMLiquidStakeFinished(s.Ctx, tc.deposit)
+	s.Require().NoError(err, "no error expected when the fallback fails")
+
+	// Confirm the stTokens are still held by the hashed receiver
+	receiverBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.hashedReceiver, tc.deposit.StToken.Denom)
+	s.Require().Equal(tc.deposit.StToken.Amount.Int64(), receiverBalance.Amount.Int64(), "hashed receiver balance")
+
+	// Confirm a failure was recorded with the hashed receiver as the holder
+	failures := s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, blockedAddress)
+	s.Require().Len(failures, 1, "number of failures")
+	s.Require().Equal(tc.hashedReceiver.String(), failures[0].Holder, "failure holder")
+	s.Require().Equal(tc.deposit.StToken.Denom, failures[0].Denom, "failure denom")
+	s.Require().Equal(tc.deposit.StToken.Amount.String(), failures[0].Amount, "failure amount")
+	s.Require().Equal(types.FailurePolicy_RETRY, failures[0].FailurePolicy, "failure policy")
+	s.Require().Contains(failures[0].Error, "fallback address", "failure error")
+
+	// Confirm the pending liquid stake was still removed
+	_, found := s.App.AutopilotKeeper.GetPendingLSMLiquidStake(s.Ctx, LSMTokenIbcDenom)
+	s.Require().False(found, "pending liquid stake should have been removed")
+}
+
+func (s *KeeperTestSuite) TestAfterLSMLiquidStakeFailed_FallbackFailed() {
+	tc := s.SetupAutopilotLSMCallback()
+
+	lsmTokens := sdk.NewCoin(LSMTokenIbcDenom, sdkmath.NewInt(1000))
+	s.FundAccount(tc.hashedReceiver, lsmTokens)
+
+	// Update the pending liquid stake to use a blocked fallback address
+	blockedAddress := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	tc.pending.FallbackAddress = blockedAddress.String()
+	s.App.AutopilotKeeper.SetPendingLSMLiquidStake(s.Ctx, tc.pending)
+
+	err := s.App.AutopilotKeeper.AfterLSMLiquidStakeFailed(s.Ctx, tc.deposit)
+	s.Require().NoError(err, "no error expected when the fallback fails")
+
+	// Confirm the LSM tokens are still held by the hashed receiver and a failure was recorded
+	receiverBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.hashedReceiver, LSMTokenIbcDenom)
+	s.Require().Equal(lsmTokens.Amount.Int64(), receiverBalance.Amount.Int64(), "hashed receiver balance")
+
+	failures := s.App.AutopilotKeeper.GetAutopilotFailuresByReceiver(s.Ctx, blockedAddress)
+	s.Require().Len(failures, 1, "number of failures")
+	s.Require().Equal(tc.hashedReceiver.String(), failures[0].Holder, "failure holder")
+	s.Require().Equal(LSMTokenIbcDenom, failures[0].Denom, "failure denom")
+	s.Require().Equal(lsmTokens.Amount.String(), failures[0].Amount, "failure amount")
+
+	_, found := s.App.AutopilotKeeper.GetPendingLSMLiquidStake(s.Ctx, LSMTokenIbcDenom)
+	s.Require().False(found, "pending liquid stake should have been removed")
+}
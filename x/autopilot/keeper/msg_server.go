@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Replays an autopilot action that failed after the transfer landed
+// The failure is looked up under the signer, so only the original receiver can retry
+func (k msgServer) RetryAutopilotAction(goCtx context.Context, msg *types.MsgRetryAutopilotAction) (*types.MsgRetryAutopilotActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RetryAutopilotAction(ctx, receiver, msg.FailureId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryAutopilotActionResponse{}, nil
+}
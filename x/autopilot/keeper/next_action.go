@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+// Runs the next action from the autopilot memo with the tokens produced by the primary action
+// The tokens are held by the transfer receiver, which is always the hashed address when there's
+// a next action, and the original receiver from the memo is used as the fallback address
+func (k Keeper) RunNextAction(
+	ctx sdk.Context,
+	token sdk.Coin,
+	sender string,
+	fallbackAddress string,
+	next types.NextActionMetadata,
+) error {
+	if token.Amount.IsNil() || !token.Amount.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalidNextAction, "no tokens were produced for the next action")
+	}
+
+	// Confirm the tokens can be returned to the fallback address before they leave the receiver,
+	// for both a forward transfer and a contract execution
+	if err := k.ValidateFallbackAddress(fallbackAddress); err != nil {
+		return err
+	}
+
+	switch {
+	case next.Forward != nil:
+		err := k.SubmitForwardTransfer(
+			ctx,
+			token,
+			sender,
+			next.Forward.Receiver,
+			next.Forward.Channel,
+			string(next.Forward.Memo),
+			fallbackAddress,
+		)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to submit transfer during autopilot next action")
+		}
+		return nil
+
+	case next.Wasm != nil:
+		contractAddress, err := sdk.AccAddressFromBech32(next.Wasm.Contract)
+		if err != nil {
+			return err
+		}
+		senderAddress, err := sdk.AccAddressFromBech32(sender)
+		if err != nil {
+			return err
+		}
+
+		if _, err := k.contractKeeper.Execute(ctx, contractAddress, senderAddress, next.Wasm.Msg, sdk.NewCoins(token)); err != nil {
+			return errorsmod.Wrapf(err, "failed to execute contract %s during autopilot next action", next.Wasm.Contract)
+		}
+		return nil
+
+	default:
+		return errorsmod.Wrap(types.ErrInvalidNextAction, "one of forward or wasm must be specified")
+	}
+}
+
+// Submits an outbound transfer of tokens held by the autopilot receiver, and stores the fallback
+// address so the tokens are returned to the original receiver if the transfer fails or times out
+// The sender is the hashed receiver of the original autopilot inbound transfer
+func (k Keeper) SubmitForwardTransfer(
+	ctx sdk.Context,
+	token sdk.Coin,
+	sender string,
+	receiver string,
+	channelId string,
+	memo string,
+	fallbackAddress string,
+) error {
+	// Confirm the fallback address can receive the tokens before they're escrowed
+	if err := k.ValidateFallbackAddress(fallbackAddress); err != nil {
+		return err
+	}
+
+	// Use a long timeout for the transfer
+	timeoutTimestamp := utils.IntToUint(ctx.BlockTime().UnixNano() + LiquidStakeForwardTransferTimeout.Nanoseconds())
+
+	transferMsg := &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    channelId,
+		Token:            token,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+
+	transferResponse, err := k.transferKeeper.Transfer(ctx, transferMsg)
+	if err != nil {
+		return err
+	}
+
+	// Store the original receiver as the fallback address in case the transfer fails
+	k.SetTransferFallbackAddress(ctx, channelId, transferResponse.Sequence, fallbackAddress)
+
+	return nil
+}
+
+// Confirms the fallback address is valid and is not blocked from receiving tokens, since
+// the tokens from a failed next action would otherwise be stuck
+func (k Keeper) ValidateFallbackAddress(fallbackAddress string) error {
+	address, err := sdk.AccAddressFromBech32(fallbackAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid fallback address %s", fallbackAddress)
+	}
+	if k.bankKeeper.BlockedAddr(address) {
+		return errorsmod.Wrapf(types.ErrBlockedFallbackAddress, "fallback address %s is blocked", fallbackAddress)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+func (s *KeeperTestSuite) TestRunNextAction_Forward() {
+	s.CreateTransferChannel("chain-0")
+
+	sender := s.TestAccs[0]
+	fallbackAddress := s.TestAccs[1].String()
+	token := sdk.NewCoin("st"+HostDenom, sdkmath.NewInt(1000))
+	s.FundAccount(sender, token)
+
+	next := types.NextActionMetadata{
+		Forward: &types.ForwardNextMetadata{
+			Receiver: HostAddress,
+			Channel:  ibctesting.FirstChannelID,
+			Memo:     []byte(`{"forward":{"receiver":"osmo1xxx","port":"transfer","channel":"channel-1"}}`),
+		},
+	}
+	err := s.App.AutopilotKeeper.RunNextAction(s.Ctx, token, sender.String(), fallbackAddress, next)
+	s.Require().NoError(err, "no error expected when forwarding")
+
+	// Confirm the tokens were sent to the escrow account
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, ibctesting.FirstChannelID)
+	escrowBalance := s.App.BankKeeper.GetBalance(s.Ctx, escrowAddress, token.Denom)
+	s.Require().Equal(token.Amount.Int64(), escrowBalance.Amount.Int64(), "escrow balance")
+
+	// Confirm the fallback address was stored for the outbound transfer
+	address, found := s.App.AutopilotKeeper.GetTransferFallbackAddress(s.Ctx, ibctesting.FirstChannelID, 1)
+	s.Require().True(found, "fallback address should have been stored")
+	s.Require().Equal(fallbackAddress, address, "fallback address")
+}
+
+func (s *KeeperTestSuite) TestRunNextAction_Failure() {
+	s.CreateTransferChannel("chain-0")
+
+	sender := s.TestAccs[0]
+	fallbackAddress := s.TestAccs[1].String()
+	token := sdk.NewCoin("st"+HostDenom, sdkmath.NewInt(1000))
+	s.FundAccount(sender, token)
+
+	validForward := types.NextActionMetadata{
+		Forward: &types.ForwardNextMetadata{Receiver: HostAddress, Channel: ibctesting.FirstChannelID},
+	}
+
+	// No tokens produced by the primary action
+	emptyToken := sdk.NewCoin(token.Denom, sdkmath.ZeroInt())
+	err := s.App.AutopilotKeeper.RunNextAction(s.Ctx, emptyToken, sender.String(), fallbackAddress, validForward)
+	s.Require().ErrorContains(err, "no tokens were produced for the next action")
+
+	// Transfer along a channel that does not exist
+	invalidForward := types.NextActionMetadata{
+		Forward: &types.ForwardNextMetadata{Receiver: HostAddress, Channel: "channel-100"},
+	}
+	err = s.App.AutopilotKeeper.RunNextAction(s.Ctx, token, sender.String(), fallbackAddress, invalidForward)
+	s.Require().ErrorContains(err, "failed to submit transfer during autopilot next action")
+
+	// Execute a contract that does not exist
+	wasmNext := types.NextActionMetadata{
+		Wasm: &types.WasmNextMetadata{Contract: s.TestAccs[2].String(), Msg: []byte(`{"deposit":{}}`)},
+	}
+	err = s.App.AutopilotKeeper.RunNextAction(s.Ctx, token, sender.String(), fallbackAddress, wasmNext)
+	s.Require().ErrorContains(err, "failed to execute contract")
+
+	// No action specified
+	err = s.App.AutopilotKeeper.RunNextAction(s.Ctx, token, sender.String(), fallbackAddress, types.NextActionMetadata{})
+	s.Require().ErrorContains(err, "one of forward or wasm must be specified")
+}
+
+func (s *KeeperTestSuite) TestRunNextAction_BlockedFallbackAddress() {
+	s.CreateTransferChannel("chain-0")
+
+	sender := s.TestAccs[0]
+	blockedAddress := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	token := sdk.NewCoin("st"+HostDenom, sdkmath.NewInt(1000))
+	s.FundAccount(sender, token)
+
+	forwardNext := types.NextActionMetadata{
+		Forward: &types.ForwardNextMetadata{Receiver: HostAddress, Channel: ibctesting.FirstChannelID},
+	}
+	wasmNext := types.NextActionMetadata{
+		Wasm: &types.WasmNextMetadata{Contract: s.TestAccs[2].String(), Msg: []byte(`{"deposit":{}}`)},
+	}
+
+	// Both next actions should be rejected before the tokens leave the sender
+	for _, next := range []types.NextActionMetadata{forwardNext, wasmNext} {
+		err := s.App.AutopilotKeeper.RunNextAction(s.Ctx, token, sender.String(), blockedAddress, next)
+		s.Require().ErrorIs(err, types.ErrBlockedFallbackAddress)
+	}
+
+	// Confirm no tokens were escrowed and no transfer was sent
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, ibctesting.FirstChannelID)
+	escrowBalance := s.App.BankKeeper.GetBalance(s.Ctx, escrowAddress, token.Denom)
+	s.Require().Zero(escrowBalance.Amount.Int64(), "escrow balance")
+
+	senderBalance := s.App.BankKeeper.GetBalance(s.Ctx, sender, token.Denom)
+	s.Require().Equal(token.Amount.Int64(), senderBalance.Amount.Int64(), "sender balance")
+
+	_, found := s.App.AutopilotKeeper.GetTransferFallbackAddress(s.Ctx, ibctesting.FirstChannelID, 1)
+	s.Require().False(found, "no fallback address should have been stored")
+
+	// Submitting the forward transfer directly should also be rejected before the escrow
+	err := s.App.AutopilotKeeper.SubmitForwardTransfer(s.Ctx, token, sender.String(), HostAddress,
+		ibctesting.FirstChannelID, "", blockedAddress)
+	s.Require().ErrorIs(err, types.ErrBlockedFallbackAddress)
+
+	escrowBalance = s.App.BankKeeper.GetBalance(s.Ctx, escrowAddress, token.Denom)
+	s.Require().Zero(escrowBalance.Amount.Int64(), "escrow balance after direct transfer")
+}
@@ -7,8 +7,9 @@ import (
 )
 
 // GetParams get all parameters as types.Params
+// Params that have not been set yet (e.g. the action limits before they're configured) are left empty
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramstore.GetParamSet(ctx, &params)
+	k.paramstore.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
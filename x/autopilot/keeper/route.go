@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+// Routes an autopilot packet to the corresponding module after the inbound transfer has landed
+// This is called from OnRecvPacket, and again when a failed action is retried
+func (k Keeper) RouteAutopilotAction(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	transferMetadata transfertypes.FungibleTokenPacketData,
+	autopilotMetadata types.AutopilotMetadata,
+) error {
+	autopilotParams := k.GetParams(ctx)
+	sender := transferMetadata.Sender
+
+	switch routingInfo := autopilotMetadata.RoutingInfo.(type) {
+	case types.StakeibcPacketMetadata:
+		// If stakeibc routing is inactive (but the packet had routing info in the memo) return an error
+		if !autopilotParams.StakeibcActive {
+			k.Logger(ctx).Error(fmt.Sprintf("Packet from %s had stakeibc routing info but autopilot stakeibc routing is disabled", sender))
+			return types.ErrPacketForwardingInactive
+		}
+		k.Logger(ctx).Info(fmt.Sprintf("Forwaring packet from %s to stakeibc", sender))
+
+		switch routingInfo.Action {
+		case types.LiquidStake:
+			if err := k.TryLiquidStaking(ctx, packet, transferMetadata, routingInfo); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Error liquid staking packet from autopilot for %s: %s", sender, err.Error()))
+				return err
+			}
+		case types.LSMLiquidStake:
+			if err := k.TryLSMLiquidStaking(ctx, packet, transferMetadata, routingInfo); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Error lsm liquid staking packet from autopilot for %s: %s", sender, err.Error()))
+				return err
+			}
+		case types.RedeemStake:
+			if err := k.TryRedeemStake(ctx, packet, transferMetadata, routingInfo); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Error redeem staking packet from autopilot for %s: %s", sender, err.Error()))
+				return err
+			}
+		}
+
+		return nil
+
+	case types.ClaimPacketMetadata:
+		// If claim routing is inactive (but the packet had routing info in the memo) return an error
+		if !autopilotParams.ClaimActive {
+			k.Logger(ctx).Error(fmt.Sprintf("Packet from %s had claim routing info but autopilot claim routing is disabled", sender))
+			return types.ErrPacketForwardingInactive
+		}
+		k.Logger(ctx).Info(fmt.Sprintf("Forwaring packet from %s to claim", sender))
+
+		if err := k.TryUpdateAirdropClaim(ctx, packet, transferMetadata); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error updating airdrop claim from autopilot for %s: %s", sender, err.Error()))
+			return err
+		}
+
+		return nil
+
+	case types.RegisteredActionPacketMetadata:
+		k.Logger(ctx).Info(fmt.Sprintf("Forwaring packet from %s to %s %s", sender, routingInfo.Module, routingInfo.Action))
+
+		if err := k.TryRegisteredAction(ctx, packet, transferMetadata, routingInfo); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error running autopilot action %s %s for %s: %s",
+				routingInfo.Module, routingInfo.Action, sender, err.Error()))
+			return err
+		}
+
+		return nil
+
+	default:
+		return errorsmod.Wrapf(types.ErrUnsupportedAutopilotRoute, "%T", routingInfo)
+	}
+}
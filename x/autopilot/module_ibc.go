@@ -13,6 +13,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/Stride-Labs/stride/v33/utils"
 	"github.com/Stride-Labs/stride/v33/x/autopilot/keeper"
 	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
 )
@@ -186,13 +187,25 @@ func (im IBCModule) OnRecvPacket(
 			tokenPacketData.Receiver, autopilotMetadata.Receiver))
 	}
 
-	// For autopilot liquid stake and forward, we'll override the receiver with a hashed address
-	// The hashed address will also be the sender of the outbound transfer
-	// This is to prevent impersonation at downstream zones
-	// We can identify the forwarding step by whether there's a non-empty IBC receiver field
-	if routingInfo, ok := autopilotMetadata.RoutingInfo.(types.StakeibcPacketMetadata); ok &&
-		routingInfo.Action == types.LiquidStake && routingInfo.IbcReceiver != "" {
+	// For actions registered by other modules, confirm the action exists and that the
+	// module-specific metadata is valid before the transfer is processed
+	if routingInfo, ok := autopilotMetadata.RoutingInfo.(types.RegisteredActionPacketMetadata); ok {
+		if _, _, err := im.keeper.ParseRegisteredAction(routingInfo); err != nil {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(err, types.ErrInvalidPacketMetadata.Error()))
+		}
+	}
+
+	// Enforce the action limits and allowlists from the params before the transfer is processed
+	if err := im.keeper.CheckAutopilotLimits(ctx, packet, tokenPacketData, *autopilotMetadata); err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("Autopilot packet from %s rejected by limits: %s", tokenPacketData.Sender, err.Error()))
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
 
+	// For autopilot liquid stake and forward (or any action with a next step), we'll override the
+	// receiver with a hashed address
+	// The hashed address will also be the sender of the outbound transfer or contract call
+	// This is to prevent impersonation at downstream zones and contracts
+	if autopilotMetadata.HasForwardingStep() {
 		var err error
 		hashedReceiver, err := types.GenerateHashedAddress(packet.DestinationChannel, tokenPacketData.Sender)
 		if err != nil {
@@ -216,54 +229,26 @@ func (im IBCModule) OnRecvPacket(
 		return ack
 	}
 
-	autopilotParams := im.keeper.GetParams(ctx)
-	sender := tokenPacketData.Sender
-
-	// If the transfer was successful, then route to the corresponding module, if applicable
-	switch routingInfo := autopilotMetadata.RoutingInfo.(type) {
-	case types.StakeibcPacketMetadata:
-		// If stakeibc routing is inactive (but the packet had routing info in the memo) return an ack error
-		if !autopilotParams.StakeibcActive {
-			im.keeper.Logger(ctx).Error(fmt.Sprintf("Packet from %s had stakeibc routing info but autopilot stakeibc routing is disabled", sender))
-			return channeltypes.NewErrorAcknowledgement(types.ErrPacketForwardingInactive)
-		}
-		im.keeper.Logger(ctx).Info(fmt.Sprintf("Forwaring packet from %s to stakeibc", sender))
-
-		switch routingInfo.Action {
-		case types.LiquidStake:
-			// Try to liquid stake - return an ack error if it fails, otherwise return the ack generated from the earlier packet propogation
-			if err := im.keeper.TryLiquidStaking(ctx, packet, tokenPacketData, routingInfo); err != nil {
-				im.keeper.Logger(ctx).Error(fmt.Sprintf("Error liquid staking packet from autopilot for %s: %s", sender, err.Error()))
-				return channeltypes.NewErrorAcknowledgement(err)
-			}
-		case types.RedeemStake:
-			// Try to redeem stake - return an ack error if it fails, otherwise return the ack generated from the earlier packet propogation
-			if err := im.keeper.TryRedeemStake(ctx, packet, tokenPacketData, routingInfo); err != nil {
-				im.keeper.Logger(ctx).Error(fmt.Sprintf("Error redeem staking packet from autopilot for %s: %s", sender, err.Error()))
-				return channeltypes.NewErrorAcknowledgement(err)
-			}
-		}
-
-		return ack
-
-	case types.ClaimPacketMetadata:
-		// If claim routing is inactive (but the packet had routing info in the memo) return an ack error
-		if !autopilotParams.ClaimActive {
-			im.keeper.Logger(ctx).Error(fmt.Sprintf("Packet from %s had claim routing info but autopilot claim routing is disabled", sender))
-			return channeltypes.NewErrorAcknowledgement(types.ErrPacketForwardingInactive)
-		}
-		im.keeper.Logger(ctx).Info(fmt.Sprintf("Forwaring packet from %s to claim", sender))
-
-		if err := im.keeper.TryUpdateAirdropClaim(ctx, packet, tokenPacketData); err != nil {
-			im.keeper.Logger(ctx).Error(fmt.Sprintf("Error updating airdrop claim from autopilot for %s: %s", sender, err.Error()))
-			return channeltypes.NewErrorAcknowledgement(err)
-		}
-
+	// If the transfer was successful, then route to the corresponding module
+	// Any partial state changes from a failed action are discarded
+	err = utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return im.keeper.RouteAutopilotAction(ctx, packet, tokenPacketData, *autopilotMetadata)
+	})
+	if err == nil {
 		return ack
+	}
 
-	default:
-		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrUnsupportedAutopilotRoute, "%T", routingInfo))
+	// If the action failed, return an ack error so the transfer is refunded, unless the memo
+	// specified that the tokens should be kept on stride
+	if autopilotMetadata.FailurePolicy == types.FailurePolicy_REFUND {
+		return channeltypes.NewErrorAcknowledgement(err)
 	}
+	if recordErr := im.keeper.RecordAutopilotFailure(ctx, packet, tokenPacketData, *autopilotMetadata, err); recordErr != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("Unable to record autopilot failure for %s: %s", tokenPacketData.Sender, recordErr.Error()))
+		return channeltypes.NewErrorAcknowledgement(recordErr)
+	}
+
+	return ack
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
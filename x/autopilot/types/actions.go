@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ActionMetadata defines the module-specific fields of an autopilot memo for a registered action
+// Each module defines its own schema, which is decoded by the action's ParseMetadata function
+type ActionMetadata interface {
+	Validate() error
+}
+
+// AutopilotActionInput contains the details of the inbound transfer that an action is
+// executed with, after the tokens have landed in the receiver's account
+type AutopilotActionInput struct {
+	// The inbound transfer packet and its packet data
+	// The packet data's receiver will be the hashed address if the action forwards its output
+	Packet           channeltypes.Packet
+	TransferMetadata transfertypes.FungibleTokenPacketData
+	// The address on stride that received the tokens
+	Receiver string
+	// The original (non-hashed) receiver from the autopilot memo
+	StrideAddress string
+	// The tokens received from the transfer, denominated in the token's denom on stride
+	Token sdk.Coin
+}
+
+// Handler invoked when an autopilot packet is routed to an action
+// Returns the tokens produced by the action (e.g. the minted stTokens), if there are any
+type AutopilotActionHandler func(ctx sdk.Context, input AutopilotActionInput, metadata ActionMetadata) (sdk.Coin, error)
+
+// Decodes and validates the module-specific fields of an autopilot memo into the action's metadata schema
+type AutopilotActionMetadataParser func(bz []byte) (ActionMetadata, error)
+
+// AutopilotAction defines a named action that a module exposes through autopilot
+// The module name is the key that's used in the autopilot memo (e.g. "stakedym")
+// ParseMetadata is optional - if it's not provided, the action does not take any
+// module-specific fields and the handler is invoked with nil metadata
+type AutopilotAction struct {
+	Module        string
+	Name          string
+	ParseMetadata AutopilotActionMetadataParser
+	Handler       AutopilotActionHandler
+}
+
+type ModuleActions []AutopilotAction
+
+// Packet metadata for a route to an action that was registered by another module
+// The module-specific fields are kept as raw JSON, and are decoded and validated
+// against the registered action before the packet is processed
+type RegisteredActionPacketMetadata struct {
+	Module        string
+	Action        string
+	StrideAddress string
+	RawMetadata   json.RawMessage
+	Next          *NextActionMetadata
+}
+
+// Validate the registered action route includes the stride address, module and action name
+// The module-specific fields are validated by the registered action
+func (m RegisteredActionPacketMetadata) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.StrideAddress); err != nil {
+		return err
+	}
+	if m.Module == "" {
+		return errorsmod.Wrap(ErrUnsupportedAutopilotRoute, "module cannot be empty")
+	}
+	if m.Action == "" {
+		return errorsmod.Wrapf(ErrUnsupportedAutopilotAction, "action must be specified for module %s", m.Module)
+	}
+	if m.Next != nil {
+		return m.Next.Validate()
+	}
+	return nil
+}
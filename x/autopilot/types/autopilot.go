@@ -14,9 +14,11 @@ import (
 // packet will occur in the respective module
 type RawPacketMetadata struct {
 	Autopilot *struct {
-		Receiver string                  `json:"receiver"`
-		Stakeibc *StakeibcPacketMetadata `json:"stakeibc,omitempty"`
-		Claim    *ClaimPacketMetadata    `json:"claim,omitempty"`
+		Receiver  string                  `json:"receiver"`
+		Stakeibc  *StakeibcPacketMetadata `json:"stakeibc,omitempty"`
+		Claim     *ClaimPacketMetadata    `json:"claim,omitempty"`
+		Next      *NextActionMetadata     `json:"next,omitempty"`
+		OnFailure string                  `json:"on_failure,omitempty"`
 	} `json:"autopilot"`
 	Forward *interface{} `json:"forward"`
 	Wasm    *interface{} `json:"wasm"`
@@ -25,8 +27,9 @@ type RawPacketMetadata struct {
 // AutopilotActionMetadata stores the metadata that's specific to the autopilot action
 // e.g. Fields required for LiquidStake
 type AutopilotMetadata struct {
-	Receiver    string
-	RoutingInfo ModuleRoutingInfo
+	Receiver      string
+	RoutingInfo   ModuleRoutingInfo
+	FailurePolicy FailurePolicy
 }
 
 // ModuleRoutingInfo defines the interface required for each autopilot action
@@ -34,6 +37,21 @@ type ModuleRoutingInfo interface {
 	Validate() error
 }
 
+// Returns true if the tokens are sent onward from the receiver after the autopilot action
+// (either through a liquid stake and forward, or a next action)
+// In this case, the transfer receiver is replaced with a hashed address
+func (m AutopilotMetadata) HasForwardingStep() bool {
+	switch routingInfo := m.RoutingInfo.(type) {
+	case StakeibcPacketMetadata:
+		isLiquidStake := routingInfo.Action == LiquidStake || routingInfo.Action == LSMLiquidStake
+		return isLiquidStake && (routingInfo.IbcReceiver != "" || routingInfo.Next != nil)
+	case RegisteredActionPacketMetadata:
+		return routingInfo.Next != nil
+	default:
+		return false
+	}
+}
+
 // GenerateHashedSender generates a new  address for a packet, by hashing
 // the channel and original sender.
 // This makes the address deterministic and can used to identify the sender
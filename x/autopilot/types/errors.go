@@ -6,14 +6,24 @@ import (
 
 // x/autopilot module sentinel errors
 var (
-	ErrInvalidPacketMetadata     = errorsmod.Register(ModuleName, 1501, "invalid packet metadata")
-	ErrUnsupportedStakeibcAction = errorsmod.Register(ModuleName, 1502, "unsupported stakeibc action")
-	ErrInvalidClaimAirdropId     = errorsmod.Register(ModuleName, 1503, "invalid claim airdrop ID (cannot be empty)")
-	ErrInvalidModuleRoutes       = errorsmod.Register(ModuleName, 1504, "invalid number of module routes, only 1 module is allowed at a time")
-	ErrUnsupportedAutopilotRoute = errorsmod.Register(ModuleName, 1505, "unsupported autpilot route")
-	ErrInvalidReceiverAddress    = errorsmod.Register(ModuleName, 1506, "receiver address must be specified when using autopilot")
-	ErrPacketForwardingInactive  = errorsmod.Register(ModuleName, 1507, "autopilot packet forwarding is disabled")
-	ErrInvalidMemoLength         = errorsmod.Register(ModuleName, 1508, "the memo field exceeded the max allowable size")
-	ErrInvalidReceiverLength     = errorsmod.Register(ModuleName, 1509, "the receiver field exceeded the max allowable size")
-	ErrBlockedFallbackAddress    = errorsmod.Register(ModuleName, 1510, "autopilot metadata fallback address is blocked")
+	ErrInvalidPacketMetadata      = errorsmod.Register(ModuleName, 1501, "invalid packet metadata")
+	ErrUnsupportedStakeibcAction  = errorsmod.Register(ModuleName, 1502, "unsupported stakeibc action")
+	ErrInvalidClaimAirdropId      = errorsmod.Register(ModuleName, 1503, "invalid claim airdrop ID (cannot be empty)")
+	ErrInvalidModuleRoutes        = errorsmod.Register(ModuleName, 1504, "invalid number of module routes, only 1 module is allowed at a time")
+	ErrUnsupportedAutopilotRoute  = errorsmod.Register(ModuleName, 1505, "unsupported autpilot route")
+	ErrInvalidReceiverAddress     = errorsmod.Register(ModuleName, 1506, "receiver address must be specified when using autopilot")
+	ErrPacketForwardingInactive   = errorsmod.Register(ModuleName, 1507, "autopilot packet forwarding is disabled")
+	ErrInvalidMemoLength          = errorsmod.Register(ModuleName, 1508, "the memo field exceeded the max allowable size")
+	ErrInvalidReceiverLength      = errorsmod.Register(ModuleName, 1509, "the receiver field exceeded the max allowable size")
+	ErrBlockedFallbackAddress     = errorsmod.Register(ModuleName, 1510, "autopilot metadata fallback address is blocked")
+	ErrUnsupportedAutopilotAction = errorsmod.Register(ModuleName, 1511, "unsupported autopilot action")
+	ErrInvalidNextAction          = errorsmod.Register(ModuleName, 1512, "invalid autopilot next action")
+	ErrInvalidFailurePolicy       = errorsmod.Register(ModuleName, 1513, "invalid autopilot failure policy")
+	ErrAutopilotFailureNotFound   = errorsmod.Register(ModuleName, 1514, "autopilot failure not found")
+	ErrFailureNotRetryable        = errorsmod.Register(ModuleName, 1515, "autopilot failure is not retryable")
+	ErrChannelNotAllowed          = errorsmod.Register(ModuleName, 1516, "channel is not allowed for autopilot action")
+	ErrAmountBelowMinimum         = errorsmod.Register(ModuleName, 1517, "amount is below the autopilot action minimum")
+	ErrAmountAboveMaximum         = errorsmod.Register(ModuleName, 1518, "amount is above the autopilot action maximum")
+	ErrDailyCapExceeded           = errorsmod.Register(ModuleName, 1519, "autopilot action daily cap exceeded")
+	ErrRedemptionNotAllowed       = errorsmod.Register(ModuleName, 1520, "autopilot redemptions are not enabled for host zone")
 )
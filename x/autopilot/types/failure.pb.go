@@ -0,0 +1,1073 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/autopilot/failure.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Determines what happens to the transferred tokens if the autopilot action
+// fails after the transfer lands on stride
+type FailurePolicy int32
+
+const (
+	// The transfer is failed and the tokens are refunded to the sender on the
+	// source chain
+	FailurePolicy_REFUND FailurePolicy = 0
+	// The tokens are kept by the receiver on stride
+	FailurePolicy_KEEP FailurePolicy = 1
+	// The tokens are kept by the receiver on stride, and the action can be
+	// replayed later with MsgRetryAutopilotAction
+	FailurePolicy_RETRY FailurePolicy = 2
+)
+
+var FailurePolicy_name = map[int32]string{
+	0: "REFUND",
+	1: "KEEP",
+	2: "RETRY",
+}
+
+var FailurePolicy_value = map[string]int32{
+	"REFUND": 0,
+	"KEEP":   1,
+	"RETRY":  2,
+}
+
+func (x FailurePolicy) String() string {
+	return proto.EnumName(FailurePolicy_name, int32(x))
+}
+
+func (FailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6095347ea4ded846, []int{0}
+}
+
+// Record of an autopilot action that failed after the inbound transfer
+// succeeded with a retry failure policy
+// The packet details are stored so that the action can be replayed
+// Failures from an asynchronous LSM liquid stake have a holder instead of the
+// packet details, and the retry returns the tokens from the holder
+type AutopilotFailure struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Stride address from the autopilot memo that's holding the tokens (or that
+	// the tokens should be returned to, if there's a holder)
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Sender of the inbound transfer on the source chain
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Inbound packet details
+	SourcePort         string `protobuf:"bytes,4,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	SourceChannel      string `protobuf:"bytes,5,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	DestinationPort    string `protobuf:"bytes,6,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	DestinationChannel string `protobuf:"bytes,7,opt,name=destination_channel,json=destinationChannel,proto3" json:"destination_channel,omitempty"`
+	Sequence           uint64 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Denom and amount from the transfer packet data
+	Denom  string `protobuf:"bytes,9,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount string `protobuf:"bytes,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Original autopilot memo
+	Memo          string        `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+	FailurePolicy FailurePolicy `protobuf:"varint,12,opt,name=failure_policy,json=failurePolicy,proto3,enum=stride.autopilot.FailurePolicy" json:"failure_policy,omitempty"`
+	// Error from the failed action
+	Error       string `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	BlockHeight int64  `protobuf:"varint,14,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Address holding the tokens if they could not be returned to the receiver
+	// (the hashed receiver of a pending LSM liquid stake)
+	// The denom and amount are the tokens on stride in this case
+	Holder string `protobuf:"bytes,15,opt,name=holder,proto3" json:"holder,omitempty"`
+	// Unix time (in seconds) after which a retry failure can no longer be retried
+	// and is pruned (zero if the failure does not expire)
+	ExpirationTime int64 `protobuf:"varint,16,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+}
+
+func (m *AutopilotFailure) Reset()         { *m = AutopilotFailure{} }
+func (m *AutopilotFailure) String() string { return proto.CompactTextString(m) }
+func (*AutopilotFailure) ProtoMessage()    {}
+func (*AutopilotFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6095347ea4ded846, []int{0}
+}
+func (m *AutopilotFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutopilotFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutopilotFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutopilotFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutopilotFailure.Merge(m, src)
+}
+func (m *AutopilotFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutopilotFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutopilotFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutopilotFailure proto.InternalMessageInfo
+
+func (m *AutopilotFailure) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AutopilotFailure) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetDestinationPort() string {
+	if m != nil {
+		return m.DestinationPort
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetDestinationChannel() string {
+	if m != nil {
+		return m.DestinationChannel
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AutopilotFailure) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetFailurePolicy() FailurePolicy {
+	if m != nil {
+		return m.FailurePolicy
+	}
+	return FailurePolicy_REFUND
+}
+
+func (m *AutopilotFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *AutopilotFailure) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *AutopilotFailure) GetExpirationTime() int64 {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("stride.autopilot.FailurePolicy", FailurePolicy_name, FailurePolicy_value)
+	proto.RegisterType((*AutopilotFailure)(nil), "stride.autopilot.AutopilotFailure")
+}
+
+func init() { proto.RegisterFile("stride/autopilot/failure.proto", fileDescriptor_6095347ea4ded846) }
+
+var fileDescriptor_6095347ea4ded846 = []byte{
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0xa4, 0x4e, 0x48, 0x6e, 0x1a, 0xc7, 0x1a, 0x10, 0x1a, 0x75, 0xe1, 0x06, 0x24, 0x44,
+	0x40, 0xc2, 0x46, 0xe4, 0x0b, 0x78, 0x24, 0x42, 0xe2, 0xa1, 0xc8, 0x94, 0x05, 0x6c, 0x22, 0xc7,
+	0xbe, 0xad, 0x47, 0xd8, 0x1e, 0x33, 0x1e, 0x57, 0xed, 0x5f, 0xb0, 0xe6, 0x8b, 0x58, 0x76, 0xc9,
+	0x12, 0x25, 0x3f, 0x82, 0x3c, 0xe3, 0x04, 0xd3, 0xdd, 0x9c, 0xc7, 0x5c, 0x9d, 0xfb, 0x00, 0xb7,
+	0x54, 0x92, 0xc7, 0xe8, 0x87, 0x95, 0x12, 0x05, 0x4f, 0x85, 0xf2, 0xcf, 0x43, 0x9e, 0x56, 0x12,
+	0xbd, 0x42, 0x0a, 0x25, 0xa8, 0x63, 0x74, 0xef, 0xa0, 0x3f, 0xfc, 0x69, 0x81, 0xf3, 0x72, 0x8f,
+	0x96, 0xc6, 0x4c, 0x6d, 0xe8, 0xf2, 0x98, 0x91, 0x29, 0x99, 0x59, 0x41, 0x97, 0xc7, 0xf4, 0x04,
+	0x06, 0x12, 0x23, 0xe4, 0x97, 0x28, 0x59, 0x77, 0x4a, 0x66, 0xc3, 0xe0, 0x80, 0xe9, 0x7d, 0xe8,
+	0x97, 0x98, 0xc7, 0x28, 0xd9, 0x91, 0x56, 0x1a, 0x44, 0x4f, 0x61, 0x54, 0x8a, 0x4a, 0x46, 0xb8,
+	0x2e, 0x84, 0x54, 0xcc, 0xd2, 0x22, 0x18, 0x6a, 0x25, 0xa4, 0xa2, 0x8f, 0xc0, 0x6e, 0x0c, 0x51,
+	0x12, 0xe6, 0x39, 0xa6, 0xac, 0xa7, 0x3d, 0x63, 0xc3, 0xbe, 0x36, 0x24, 0x7d, 0x02, 0x4e, 0x8c,
+	0xa5, 0xe2, 0x79, 0xa8, 0xb8, 0xc8, 0x4d, 0xb1, 0xbe, 0x36, 0x4e, 0x5a, 0xbc, 0xae, 0xe8, 0xc3,
+	0xdd, 0xb6, 0x75, 0x5f, 0xf6, 0x8e, 0x76, 0xd3, 0x96, 0xb4, 0xaf, 0x7d, 0x02, 0x83, 0x12, 0xbf,
+	0x57, 0x98, 0x47, 0xc8, 0x06, 0xba, 0xdb, 0x03, 0xa6, 0xf7, 0xa0, 0x17, 0x63, 0x2e, 0x32, 0x36,
+	0xd4, 0xdf, 0x0d, 0xa8, 0xbb, 0x0d, 0x33, 0x51, 0xe5, 0x8a, 0x81, 0xe9, 0xd6, 0x20, 0x4a, 0xc1,
+	0xca, 0x30, 0x13, 0x6c, 0xa4, 0x59, 0xfd, 0xa6, 0x4b, 0xb0, 0x9b, 0xe9, 0xaf, 0x0b, 0x91, 0xf2,
+	0xe8, 0x9a, 0x1d, 0x4f, 0xc9, 0xcc, 0x7e, 0x71, 0xea, 0xdd, 0xde, 0x82, 0xd7, 0x0c, 0x7e, 0xa5,
+	0x6d, 0xc1, 0xf8, 0xbc, 0x0d, 0xeb, 0x24, 0x28, 0xa5, 0x90, 0x6c, 0x6c, 0x92, 0x68, 0x40, 0x1f,
+	0xc0, 0xf1, 0x26, 0x15, 0xd1, 0xb7, 0x75, 0x82, 0xfc, 0x22, 0x51, 0xcc, 0x9e, 0x92, 0xd9, 0x51,
+	0x30, 0xd2, 0xdc, 0x5b, 0x4d, 0xd5, 0x61, 0x13, 0x91, 0xd6, 0xab, 0x99, 0x98, 0xb0, 0x06, 0xd1,
+	0xc7, 0x30, 0xc1, 0xab, 0x82, 0x4b, 0x33, 0x26, 0xc5, 0x33, 0x64, 0x8e, 0xfe, 0x6d, 0xff, 0xa3,
+	0xcf, 0x78, 0x86, 0x4f, 0x9f, 0xc3, 0xf8, 0xbf, 0x64, 0x14, 0xa0, 0x1f, 0x2c, 0x96, 0x9f, 0x3f,
+	0xbe, 0x71, 0x3a, 0x74, 0x00, 0xd6, 0xbb, 0xc5, 0x62, 0xe5, 0x10, 0x3a, 0x84, 0x5e, 0xb0, 0x38,
+	0x0b, 0xbe, 0x38, 0xdd, 0x57, 0x1f, 0x7e, 0x6d, 0x5d, 0x72, 0xb3, 0x75, 0xc9, 0x9f, 0xad, 0x4b,
+	0x7e, 0xec, 0xdc, 0xce, 0xcd, 0xce, 0xed, 0xfc, 0xde, 0xb9, 0x9d, 0xaf, 0xf3, 0x0b, 0xae, 0x92,
+	0x6a, 0xe3, 0x45, 0x22, 0xf3, 0x3f, 0xe9, 0xfe, 0x9f, 0xbd, 0x0f, 0x37, 0xa5, 0xdf, 0x5c, 0xec,
+	0xe5, 0x7c, 0xee, 0x5f, 0xb5, 0xee, 0x56, 0x5d, 0x17, 0x58, 0x6e, 0xfa, 0xfa, 0x6c, 0xe7, 0x7f,
+	0x07, 0x00, 0x37, 0x4a, 0x94, 0x6b, 0xd8, 0x02, 0x00, 0x00,
+}
+
+func (m *AutopilotFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutopilotFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutopilotFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.ExpirationTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.FailurePolicy != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.FailurePolicy))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DestinationChannel) > 0 {
+		i -= len(m.DestinationChannel)
+		copy(dAtA[i:], m.DestinationChannel)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.DestinationChannel)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DestinationPort) > 0 {
+		i -= len(m.DestinationPort)
+		copy(dAtA[i:], m.DestinationPort)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.DestinationPort)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFailure(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFailure(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AutopilotFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFailure(uint64(m.Id))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	l = len(m.DestinationPort)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	l = len(m.DestinationChannel)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovFailure(uint64(m.Sequence))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	if m.FailurePolicy != 0 {
+		n += 1 + sovFailure(uint64(m.FailurePolicy))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovFailure(uint64(m.BlockHeight))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	if m.ExpirationTime != 0 {
+		n += 2 + sovFailure(uint64(m.ExpirationTime))
+	}
+	return n
+}
+
+func sovFailure(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFailure(x uint64) (n int) {
+	return sovFailure(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AutopilotFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFailure
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutopilotFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutopilotFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
+			}
+			m.FailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailurePolicy |= FailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			m.ExpirationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFailure(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFailure(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFailure
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFailure
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFailure
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFailure
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFailure        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFailure          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFailure = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // TODO: fix this file
 
 // DefaultIndex is the default capability global index
@@ -8,12 +14,46 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:                 DefaultParams(),
+		Failures:               []AutopilotFailure{},
+		NextFailureId:          1,
+		PendingLsmLiquidStakes: []PendingLSMLiquidStake{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Check for duplicated failure IDs, and that each ID was assigned before the next ID
+	failureIdMap := make(map[uint64]bool)
+	for _, failure := range gs.Failures {
+		if _, ok := failureIdMap[failure.Id]; ok {
+			return fmt.Errorf("duplicated id for autopilot failure: %d", failure.Id)
+		}
+		if failure.Id >= gs.NextFailureId {
+			return fmt.Errorf("autopilot failure id %d should be lower than the next failure id %d", failure.Id, gs.NextFailureId)
+		}
+		if _, err := sdk.AccAddressFromBech32(failure.Receiver); err != nil {
+			return fmt.Errorf("invalid receiver for autopilot failure %d: %s", failure.Id, err.Error())
+		}
+		failureIdMap[failure.Id] = true
+	}
+
+	// Check for duplicated pending LSM liquid stakes, which are key'd by the LSM token's IBC denom
+	pendingLiquidStakeMap := make(map[string]bool)
+	for _, pendingLiquidStake := range gs.PendingLsmLiquidStakes {
+		if pendingLiquidStake.LsmTokenIbcDenom == "" {
+			return fmt.Errorf("pending lsm liquid stake denom cannot be empty")
+		}
+		if _, ok := pendingLiquidStakeMap[pendingLiquidStake.LsmTokenIbcDenom]; ok {
+			return fmt.Errorf("duplicated pending lsm liquid stake for denom %s", pendingLiquidStake.LsmTokenIbcDenom)
+		}
+		pendingLiquidStakeMap[pendingLiquidStake.LsmTokenIbcDenom] = true
+	}
+
+	return nil
 }
@@ -27,6 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	// Autopilot failures that have not yet been retried or pruned
+	Failures []AutopilotFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures"`
+	// ID that will be assigned to the next autopilot failure
+	NextFailureId uint64 `protobuf:"varint,3,opt,name=next_failure_id,json=nextFailureId,proto3" json:"next_failure_id,omitempty"`
+	// LSM liquid stakes that are waiting on a validator slash query
+	PendingLsmLiquidStakes []PendingLSMLiquidStake `protobuf:"bytes,4,rep,name=pending_lsm_liquid_stakes,json=pendingLsmLiquidStakes,proto3" json:"pending_lsm_liquid_stakes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +75,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetFailures() []AutopilotFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextFailureId() uint64 {
+	if m != nil {
+		return m.NextFailureId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingLsmLiquidStakes() []PendingLSMLiquidStake {
+	if m != nil {
+		return m.PendingLsmLiquidStakes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.autopilot.GenesisState")
 }
@@ -76,21 +103,29 @@ func init() {
 func init() { proto.RegisterFile("stride/autopilot/genesis.proto", fileDescriptor_a7e087b21fd12e65) }
 
 var fileDescriptor_a7e087b21fd12e65 = []byte{
-	// 212 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0x4f, 0x2c, 0x2d, 0xc9, 0x2f, 0xc8, 0xcc, 0xc9, 0x2f, 0xd1, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94, 0x2c,
-	0x86, 0x39, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x63, 0x94, 0xc2, 0xb9, 0x78, 0xdc, 0x21, 0xe6,
-	0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xb9, 0x73, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35,
-	0xb8, 0x8d, 0x24, 0xf4, 0xd0, 0xed, 0xd1, 0x0b, 0x00, 0xcb, 0x3b, 0x89, 0x9e, 0xb8, 0x27, 0xcf,
-	0xf0, 0xe9, 0x9e, 0x3c, 0x6f, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x44, 0x97, 0x52, 0x10, 0x54,
-	0xbb, 0x93, 0xef, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa7, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x07, 0x83, 0x0d, 0xd7, 0xf5, 0x49, 0x4c,
-	0x2a, 0xd6, 0x87, 0x3a, 0xb4, 0xcc, 0xd8, 0x58, 0xbf, 0x02, 0xc9, 0xb9, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0x60, 0xe7, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xd2, 0x58, 0x76,
-	0x17, 0x01, 0x00, 0x00,
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x93, 0xb6, 0x94, 0x9f, 0xf4, 0x2f, 0x4a, 0x50, 0x89, 0x01, 0xa7, 0x25, 0x0b, 0xed,
+	0xc6, 0x04, 0x9a, 0x9d, 0x3b, 0x8b, 0x58, 0x84, 0x14, 0xa4, 0xd9, 0xb9, 0x09, 0x53, 0x33, 0xa6,
+	0x83, 0x49, 0x26, 0x66, 0x26, 0xd2, 0xbe, 0x85, 0x6f, 0xe2, 0x6b, 0x74, 0xd9, 0xa5, 0xab, 0x22,
+	0xcd, 0x1b, 0xf8, 0x04, 0x92, 0x99, 0xb1, 0x14, 0xe3, 0xee, 0x72, 0xbf, 0x7b, 0xce, 0x3d, 0x33,
+	0x57, 0x03, 0x94, 0xe5, 0x38, 0x44, 0x0e, 0x2c, 0x18, 0xc9, 0x70, 0x4c, 0x98, 0x13, 0xa1, 0x14,
+	0x51, 0x4c, 0xed, 0x2c, 0x27, 0x8c, 0xe8, 0x87, 0x82, 0xdb, 0x3b, 0x6e, 0x1e, 0x45, 0x24, 0x22,
+	0x1c, 0x3a, 0x55, 0x25, 0xe6, 0xcc, 0xb3, 0x9a, 0x4f, 0x06, 0x73, 0x98, 0x48, 0x1b, 0xb3, 0xbe,
+	0xe6, 0x09, 0xe2, 0xb8, 0xc8, 0x91, 0xe4, 0x66, 0x8d, 0xc7, 0x34, 0x11, 0xcc, 0x7a, 0x6f, 0x68,
+	0xff, 0xc7, 0x22, 0x94, 0xcf, 0x20, 0x43, 0xfa, 0x58, 0x6b, 0x0b, 0x73, 0x43, 0xed, 0xab, 0x83,
+	0xce, 0xd0, 0xb0, 0x7f, 0x87, 0xb4, 0xef, 0x39, 0x1f, 0x1d, 0xaf, 0x36, 0x3d, 0xe5, 0x6b, 0xd3,
+	0xeb, 0x2e, 0x61, 0x12, 0x5f, 0x59, 0x42, 0x65, 0x4d, 0xa5, 0x5c, 0xbf, 0xd1, 0xfe, 0xc9, 0x18,
+	0xd4, 0x68, 0xf4, 0x9b, 0x83, 0xce, 0xd0, 0xaa, 0x5b, 0x5d, 0xff, 0x54, 0xb7, 0x62, 0x74, 0xd4,
+	0xaa, 0x4c, 0xa7, 0x3b, 0xa5, 0x7e, 0xae, 0x1d, 0xa4, 0x68, 0xc1, 0x02, 0xd9, 0x08, 0x70, 0x68,
+	0x34, 0xfb, 0xea, 0xa0, 0x35, 0xed, 0x56, 0x6d, 0xa9, 0xba, 0x0b, 0xf5, 0xb9, 0x76, 0x9a, 0xa1,
+	0x34, 0xc4, 0x69, 0x14, 0xc4, 0x34, 0x09, 0x62, 0xfc, 0x52, 0xe0, 0x30, 0xa0, 0x0c, 0x3e, 0x23,
+	0x6a, 0xb4, 0xf8, 0xfa, 0x8b, 0x3f, 0x5e, 0x22, 0x24, 0x9e, 0x3f, 0xf1, 0xb8, 0xc0, 0xaf, 0xe6,
+	0x65, 0x86, 0x13, 0xe9, 0xe7, 0xd1, 0x64, 0x0f, 0xd2, 0xd1, 0x64, 0xb5, 0x05, 0xea, 0x7a, 0x0b,
+	0xd4, 0xcf, 0x2d, 0x50, 0xdf, 0x4a, 0xa0, 0xac, 0x4b, 0xa0, 0x7c, 0x94, 0x40, 0x79, 0x70, 0x23,
+	0xcc, 0xe6, 0xc5, 0xcc, 0x7e, 0x24, 0x89, 0xe3, 0xf3, 0x55, 0x97, 0x1e, 0x9c, 0x51, 0x47, 0x7e,
+	0xff, 0xab, 0xeb, 0x3a, 0x8b, 0xbd, 0x23, 0xb0, 0x65, 0x86, 0xe8, 0xac, 0xcd, 0xef, 0xe0, 0x7e,
+	0x0f, 0x00, 0xea, 0x2d, 0xf3, 0x7c, 0x2c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +148,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingLsmLiquidStakes) > 0 {
+		for iNdEx := len(m.PendingLsmLiquidStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingLsmLiquidStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextFailureId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextFailureId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -145,6 +213,21 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextFailureId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextFailureId))
+	}
+	if len(m.PendingLsmLiquidStakes) > 0 {
+		for _, e := range m.PendingLsmLiquidStakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -216,6 +299,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, AutopilotFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFailureId", wireType)
+			}
+			m.NextFailureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFailureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingLsmLiquidStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingLsmLiquidStakes = append(m.PendingLsmLiquidStakes, PendingLSMLiquidStake{})
+			if err := m.PendingLsmLiquidStakes[len(m.PendingLsmLiquidStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,10 +5,14 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
 	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	apptesting.SetupConfig()
+	validAddress := apptesting.CreateRandomAccounts(1)[0].String()
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -19,6 +23,85 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "valid failures",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Failures: []types.AutopilotFailure{
+					{Id: 1, Receiver: validAddress},
+					{Id: 2, Receiver: validAddress},
+				},
+				NextFailureId: 3,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated failure id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Failures: []types.AutopilotFailure{
+					{Id: 1, Receiver: validAddress},
+					{Id: 1, Receiver: validAddress},
+				},
+				NextFailureId: 3,
+			},
+			valid: false,
+		},
+		{
+			desc: "failure id not lower than next id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Failures: []types.AutopilotFailure{
+					{Id: 3, Receiver: validAddress},
+				},
+				NextFailureId: 3,
+			},
+			valid: false,
+		},
+		{
+			desc: "valid pending lsm liquid stakes",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				NextFailureId: 1,
+				PendingLsmLiquidStakes: []types.PendingLSMLiquidStake{
+					{LsmTokenIbcDenom: "ibc/denom1"},
+					{LsmTokenIbcDenom: "ibc/denom2"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated pending lsm liquid stake",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				NextFailureId: 1,
+				PendingLsmLiquidStakes: []types.PendingLSMLiquidStake{
+					{LsmTokenIbcDenom: "ibc/denom1"},
+					{LsmTokenIbcDenom: "ibc/denom1"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty pending lsm liquid stake denom",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				NextFailureId:          1,
+				PendingLsmLiquidStakes: []types.PendingLSMLiquidStake{{}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid failure receiver",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Failures: []types.AutopilotFailure{
+					{Id: 1, Receiver: "invalid"},
+				},
+				NextFailureId: 2,
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -1,6 +1,13 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+)
 
 const (
 	// ModuleName defines the module name
@@ -18,6 +25,11 @@ const (
 
 var (
 	TransferFallbackAddressPrefix = []byte("fallback")
+	PendingLSMLiquidStakePrefix   = []byte("pending-lsm")
+	AutopilotFailurePrefix        = []byte("failures")
+	NextAutopilotFailureIdKey     = []byte("next-failure-id")
+	DailyActionUsagePrefix        = []byte("daily-usage")
+	FailureExpirationPrefix       = []byte("failure-expirations")
 
 	FallbackAddressChannelPrefixLength int = 16
 )
@@ -33,3 +45,46 @@ func GetTransferFallbackAddressKey(channelId string, sequenceNumber uint64) []by
 
 	return append(channelIdBz, sequenceNumberBz...)
 }
+
+// Builds the prefix for all autopilot failures belonging to a receiver
+// The address is length prefixed so that the failure ID can be appended
+func GetAutopilotFailureReceiverPrefix(receiver sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(receiver)
+}
+
+// Builds the store key for an autopilot failure, key'd by receiver and failure ID
+func GetAutopilotFailureKey(receiver sdk.AccAddress, id uint64) []byte {
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, id)
+
+	return append(GetAutopilotFailureReceiverPrefix(receiver), idBz...)
+}
+
+// Builds the store key for the expiration of an autopilot failure, key'd by expiration time
+// and failure ID so that expired failures can be iterated in order
+func GetFailureExpirationKey(expirationTime int64, id uint64) []byte {
+	expirationBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(expirationBz, utils.IntToUint(expirationTime))
+
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, id)
+
+	return append(expirationBz, idBz...)
+}
+
+// Builds the prefix for all daily action usage on a given day
+// The day is the first component of the key so that usage from previous days can be pruned
+func GetDailyActionUsageDayPrefix(day int64) []byte {
+	dayBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(dayBz, utils.IntToUint(day))
+	return dayBz
+}
+
+// Builds the store key for the daily usage of an action limit by a packet sender, key'd by the
+// day, limit, channel the packet was received on, and sender on the counterparty chain
+func GetDailyActionUsageKey(day int64, limitKey, channelId, sender string) []byte {
+	key := GetDailyActionUsageDayPrefix(day)
+	key = append(key, address.MustLengthPrefix([]byte(limitKey))...)
+	key = append(key, address.MustLengthPrefix([]byte(channelId))...)
+	return append(key, []byte(sender)...)
+}
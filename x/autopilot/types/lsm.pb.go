@@ -0,0 +1,534 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/autopilot/lsm.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// An autopilot LSM liquid stake and forward that's waiting on a validator slash
+// query, key'd by the IBC denom of the LSM tokens
+// Once the liquid stake finishes, the stTokens are forwarded to the ibc receiver.
+// If it fails, the LSM tokens are returned to the fallback address
+type PendingLSMLiquidStake struct {
+	// IBC denom of the LSM tokens on stride
+	LsmTokenIbcDenom string `protobuf:"bytes,1,opt,name=lsm_token_ibc_denom,json=lsmTokenIbcDenom,proto3" json:"lsm_token_ibc_denom,omitempty"`
+	// Hashed receiver of the inbound transfer that's holding the tokens
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Original receiver from the autopilot memo
+	FallbackAddress string `protobuf:"bytes,3,opt,name=fallback_address,json=fallbackAddress,proto3" json:"fallback_address,omitempty"`
+	// Recipient and channel for the stToken transfer
+	IbcReceiver     string `protobuf:"bytes,4,opt,name=ibc_receiver,json=ibcReceiver,proto3" json:"ibc_receiver,omitempty"`
+	TransferChannel string `protobuf:"bytes,5,opt,name=transfer_channel,json=transferChannel,proto3" json:"transfer_channel,omitempty"`
+}
+
+func (m *PendingLSMLiquidStake) Reset()         { *m = PendingLSMLiquidStake{} }
+func (m *PendingLSMLiquidStake) String() string { return proto.CompactTextString(m) }
+func (*PendingLSMLiquidStake) ProtoMessage()    {}
+func (*PendingLSMLiquidStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67495493447793fa, []int{0}
+}
+func (m *PendingLSMLiquidStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingLSMLiquidStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingLSMLiquidStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingLSMLiquidStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingLSMLiquidStake.Merge(m, src)
+}
+func (m *PendingLSMLiquidStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingLSMLiquidStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingLSMLiquidStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingLSMLiquidStake proto.InternalMessageInfo
+
+func (m *PendingLSMLiquidStake) GetLsmTokenIbcDenom() string {
+	if m != nil {
+		return m.LsmTokenIbcDenom
+	}
+	return ""
+}
+
+func (m *PendingLSMLiquidStake) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *PendingLSMLiquidStake) GetFallbackAddress() string {
+	if m != nil {
+		return m.FallbackAddress
+	}
+	return ""
+}
+
+func (m *PendingLSMLiquidStake) GetIbcReceiver() string {
+	if m != nil {
+		return m.IbcReceiver
+	}
+	return ""
+}
+
+func (m *PendingLSMLiquidStake) GetTransferChannel() string {
+	if m != nil {
+		return m.TransferChannel
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PendingLSMLiquidStake)(nil), "stride.autopilot.PendingLSMLiquidStake")
+}
+
+func init() { proto.RegisterFile("stride/autopilot/lsm.proto", fileDescriptor_67495493447793fa) }
+
+var fileDescriptor_67495493447793fa = []byte{
+	// 281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x86, 0x3b, 0xdf, 0xa7, 0xa2, 0xa3, 0x60, 0x89, 0x08, 0xa1, 0x8b, 0x41, 0x5d, 0xe9, 0xa2,
+	0xcd, 0x22, 0x57, 0xe0, 0xcf, 0x46, 0x68, 0x41, 0x1a, 0x57, 0x6e, 0xc2, 0xfc, 0x9c, 0xb6, 0x43,
+	0x26, 0x33, 0x71, 0x66, 0x52, 0xf4, 0x2e, 0xbc, 0x2c, 0x97, 0x5d, 0x0a, 0x6e, 0x24, 0xb9, 0x11,
+	0x49, 0x62, 0x43, 0x97, 0xe7, 0x79, 0x5f, 0xde, 0x03, 0x0f, 0x1e, 0x39, 0x6f, 0xa5, 0x80, 0x88,
+	0x96, 0xde, 0x14, 0x52, 0x19, 0x1f, 0x29, 0x97, 0x4f, 0x0a, 0x6b, 0xbc, 0x09, 0x86, 0x5d, 0x36,
+	0xe9, 0xb3, 0xab, 0x6f, 0x84, 0xcf, 0x9f, 0x40, 0x0b, 0xa9, 0x97, 0xd3, 0x64, 0x36, 0x95, 0xaf,
+	0xa5, 0x14, 0x89, 0xa7, 0x19, 0x04, 0x63, 0x7c, 0xa6, 0x5c, 0x9e, 0x7a, 0x93, 0x81, 0x4e, 0x25,
+	0xe3, 0xa9, 0x00, 0x6d, 0xf2, 0x10, 0x5d, 0xa0, 0xeb, 0xa3, 0xf9, 0x50, 0xb9, 0xfc, 0xb9, 0x49,
+	0x1e, 0x19, 0x7f, 0x68, 0x78, 0x30, 0xc2, 0x87, 0x16, 0x38, 0xc8, 0x35, 0xd8, 0xf0, 0x5f, 0xdb,
+	0xe9, 0xef, 0xe0, 0x06, 0x0f, 0x17, 0x54, 0x29, 0x46, 0x79, 0x96, 0x52, 0x21, 0x2c, 0x38, 0x17,
+	0xfe, 0x6f, 0x3b, 0xa7, 0x5b, 0x7e, 0xdb, 0xe1, 0xe0, 0x12, 0x9f, 0x34, 0xbf, 0xfa, 0xa9, 0xbd,
+	0xb6, 0x76, 0x2c, 0x19, 0x9f, 0xef, 0xac, 0x79, 0x4b, 0xb5, 0x5b, 0x80, 0x4d, 0xf9, 0x8a, 0x6a,
+	0x0d, 0x2a, 0xdc, 0xef, 0xd6, 0xb6, 0xfc, 0xbe, 0xc3, 0x77, 0xb3, 0xcf, 0x8a, 0xa0, 0x4d, 0x45,
+	0xd0, 0x4f, 0x45, 0xd0, 0x47, 0x4d, 0x06, 0x9b, 0x9a, 0x0c, 0xbe, 0x6a, 0x32, 0x78, 0x89, 0x97,
+	0xd2, 0xaf, 0x4a, 0x36, 0xe1, 0x26, 0x8f, 0x92, 0x56, 0xca, 0x78, 0x4a, 0x99, 0x8b, 0xfe, 0xe4,
+	0xad, 0xe3, 0x38, 0x7a, 0xdb, 0x51, 0xe8, 0xdf, 0x0b, 0x70, 0xec, 0xa0, 0xb5, 0x18, 0xff, 0x0e,
+	0x00, 0xab, 0xef, 0x82, 0x05, 0x63, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingLSMLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingLSMLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingLSMLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferChannel) > 0 {
+		i -= len(m.TransferChannel)
+		copy(dAtA[i:], m.TransferChannel)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.TransferChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IbcReceiver) > 0 {
+		i -= len(m.IbcReceiver)
+		copy(dAtA[i:], m.IbcReceiver)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.IbcReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FallbackAddress) > 0 {
+		i -= len(m.FallbackAddress)
+		copy(dAtA[i:], m.FallbackAddress)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.FallbackAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LsmTokenIbcDenom) > 0 {
+		i -= len(m.LsmTokenIbcDenom)
+		copy(dAtA[i:], m.LsmTokenIbcDenom)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.LsmTokenIbcDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLsm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLsm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingLSMLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LsmTokenIbcDenom)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.FallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.IbcReceiver)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.TransferChannel)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	return n
+}
+
+func sovLsm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLsm(x uint64) (n int) {
+	return sovLsm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingLSMLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLsm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingLSMLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingLSMLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmTokenIbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LsmTokenIbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLsm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLsm(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLsm
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLsm
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLsm
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLsm
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLsm        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLsm          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLsm = fmt.Errorf("proto: unexpected end of group")
+)
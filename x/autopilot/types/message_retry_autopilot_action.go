@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRetryAutopilotAction = "retry_autopilot_action"
+
+var _ sdk.Msg = &MsgRetryAutopilotAction{}
+
+func NewMsgRetryAutopilotAction(creator string, failureId uint64) *MsgRetryAutopilotAction {
+	return &MsgRetryAutopilotAction{
+		Creator:   creator,
+		FailureId: failureId,
+	}
+}
+
+func (msg MsgRetryAutopilotAction) Type() string {
+	return TypeMsgRetryAutopilotAction
+}
+
+func (msg MsgRetryAutopilotAction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRetryAutopilotAction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRetryAutopilotAction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
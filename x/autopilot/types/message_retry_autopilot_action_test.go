@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+func TestMsgRetryAutopilotAction(t *testing.T) {
+	apptesting.SetupConfig()
+	validAddr, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name string
+		msg  types.MsgRetryAutopilotAction
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgRetryAutopilotAction{
+				Creator:   validAddr,
+				FailureId: 1,
+			},
+		},
+		{
+			name: "invalid creator",
+			msg: types.MsgRetryAutopilotAction{
+				Creator:   invalidAddr,
+				FailureId: 1,
+			},
+			err: "invalid creator address",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), validAddr)
+
+				require.Equal(t, test.msg.Type(), "retry_autopilot_action", "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
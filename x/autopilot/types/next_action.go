@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/json"
+
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Follow-up action that's run with the tokens produced by the primary autopilot action
+// (e.g. liquid stake, then forward the stTokens to another chain)
+// Only one of forward or wasm can be specified
+type NextActionMetadata struct {
+	Forward *ForwardNextMetadata `json:"forward,omitempty"`
+	Wasm    *WasmNextMetadata    `json:"wasm,omitempty"`
+}
+
+// Transfers the tokens to another chain
+// The memo is passed through to the outbound transfer, which allows the tokens to be routed
+// to a third chain via PFM (e.g. { "forward": { "receiver": "...", "port": "transfer", "channel": "..." } })
+type ForwardNextMetadata struct {
+	Receiver string          `json:"receiver"`
+	Channel  string          `json:"channel"`
+	Memo     json.RawMessage `json:"memo,omitempty"`
+}
+
+// Executes a CosmWasm contract on stride with the tokens attached as funds
+type WasmNextMetadata struct {
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+// Validates that exactly one follow-up action was specified and that it's well formed
+func (m NextActionMetadata) Validate() error {
+	switch {
+	case m.Forward != nil && m.Wasm != nil:
+		return errorsmod.Wrap(ErrInvalidNextAction, "only one of forward and wasm can be specified")
+	case m.Forward != nil:
+		return m.Forward.Validate()
+	case m.Wasm != nil:
+		return m.Wasm.Validate()
+	default:
+		return errorsmod.Wrap(ErrInvalidNextAction, "one of forward or wasm must be specified")
+	}
+}
+
+// Validates the forward receiver, channel and memo
+func (m ForwardNextMetadata) Validate() error {
+	if m.Receiver == "" {
+		return errorsmod.Wrap(ErrInvalidNextAction, "forward receiver cannot be empty")
+	}
+	if !channeltypes.IsValidChannelID(m.Channel) {
+		return errorsmod.Wrapf(ErrInvalidNextAction, "invalid forward channel %s", m.Channel)
+	}
+	if len(m.Memo) > 0 && !isJSONObject(m.Memo) {
+		return errorsmod.Wrap(ErrInvalidNextAction, "forward memo must be a JSON object")
+	}
+	return nil
+}
+
+// Validates the contract address and that the execute msg is a JSON object
+func (m WasmNextMetadata) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+		return errorsmod.Wrapf(ErrInvalidNextAction, "invalid contract address %s: %s", m.Contract, err.Error())
+	}
+	if !isJSONObject(m.Msg) {
+		return errorsmod.Wrap(ErrInvalidNextAction, "wasm msg must be a JSON object")
+	}
+	return nil
+}
+
+// Checks whether the raw JSON is an object (as opposed to a string, array, etc.)
+func isJSONObject(bz json.RawMessage) bool {
+	var object map[string]json.RawMessage
+	return json.Unmarshal(bz, &object) == nil && object != nil
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+func TestValidateNextActionMetadata(t *testing.T) {
+	validAddress, _ := apptesting.GenerateTestAddrs()
+
+	validForward := &types.ForwardNextMetadata{
+		Receiver: "osmo1xxx",
+		Channel:  "channel-5",
+	}
+	validWasm := &types.WasmNextMetadata{
+		Contract: validAddress,
+		Msg:      []byte(`{ "deposit": {} }`),
+	}
+
+	testCases := []struct {
+		name        string
+		metadata    types.NextActionMetadata
+		expectedErr string
+	}{
+		{
+			name:     "valid forward",
+			metadata: types.NextActionMetadata{Forward: validForward},
+		},
+		{
+			name: "valid forward with pfm memo",
+			metadata: types.NextActionMetadata{Forward: &types.ForwardNextMetadata{
+				Receiver: "osmo1xxx",
+				Channel:  "channel-5",
+				Memo:     []byte(`{ "forward": { "receiver": "juno1xxx", "port": "transfer", "channel": "channel-1" } }`),
+			}},
+		},
+		{
+			name:     "valid wasm",
+			metadata: types.NextActionMetadata{Wasm: validWasm},
+		},
+		{
+			name:        "no action",
+			metadata:    types.NextActionMetadata{},
+			expectedErr: "one of forward or wasm must be specified",
+		},
+		{
+			name:        "both forward and wasm",
+			metadata:    types.NextActionMetadata{Forward: validForward, Wasm: validWasm},
+			expectedErr: "only one of forward and wasm can be specified",
+		},
+		{
+			name:        "forward missing receiver",
+			metadata:    types.NextActionMetadata{Forward: &types.ForwardNextMetadata{Channel: "channel-5"}},
+			expectedErr: "forward receiver cannot be empty",
+		},
+		{
+			name:        "forward invalid channel",
+			metadata:    types.NextActionMetadata{Forward: &types.ForwardNextMetadata{Receiver: "osmo1xxx", Channel: "5"}},
+			expectedErr: "invalid forward channel",
+		},
+		{
+			name: "forward memo not an object",
+			metadata: types.NextActionMetadata{Forward: &types.ForwardNextMetadata{
+				Receiver: "osmo1xxx",
+				Channel:  "channel-5",
+				Memo:     []byte(`"memo"`),
+			}},
+			expectedErr: "forward memo must be a JSON object",
+		},
+		{
+			name:        "wasm invalid contract",
+			metadata:    types.NextActionMetadata{Wasm: &types.WasmNextMetadata{Contract: "invalid", Msg: []byte(`{}`)}},
+			expectedErr: "invalid contract address",
+		},
+		{
+			name:        "wasm missing msg",
+			metadata:    types.NextActionMetadata{Wasm: &types.WasmNextMetadata{Contract: validAddress}},
+			expectedErr: "wasm msg must be a JSON object",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
 	"gopkg.in/yaml.v2"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -16,8 +18,10 @@ const (
 
 // KeyActive is the store key for Params
 var (
-	KeyStakeibcActive = []byte("StakeibcActive")
-	KeyClaimActive    = []byte("ClaimActive")
+	KeyStakeibcActive      = []byte("StakeibcActive")
+	KeyClaimActive         = []byte("ClaimActive")
+	KeyActionLimits        = []byte("ActionLimits")
+	KeyRedemptionHostZones = []byte("RedemptionHostZones")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -45,6 +49,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyStakeibcActive, &p.StakeibcActive, validateBool),
 		paramtypes.NewParamSetPair(KeyClaimActive, &p.ClaimActive, validateBool),
+		paramtypes.NewParamSetPair(KeyActionLimits, &p.ActionLimits, validateActionLimits),
+		paramtypes.NewParamSetPair(KeyRedemptionHostZones, &p.RedemptionHostZones, validateRedemptionHostZones),
 	}
 }
 
@@ -56,10 +62,73 @@ func (p Params) Validate() error {
 	if err := validateBool(p.ClaimActive); err != nil {
 		return err
 	}
+	if err := validateActionLimits(p.ActionLimits); err != nil {
+		return err
+	}
+	if err := validateRedemptionHostZones(p.RedemptionHostZones); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// Returns the limit for an action and denom, preferring the limit scoped to the denom
+// over the limit that applies to all denoms
+func (p Params) GetActionLimit(module, action, denom string) (limit ActionLimit, found bool) {
+	for _, actionLimit := range p.ActionLimits {
+		if actionLimit.Module != module || actionLimit.Action != action {
+			continue
+		}
+		if actionLimit.Denom == denom {
+			return actionLimit, true
+		}
+		if actionLimit.Denom == "" {
+			limit, found = actionLimit, true
+		}
+	}
+	return limit, found
+}
+
+// Checks whether autopilot redemptions are accepted for a host zone
+func (p Params) IsRedemptionHostZoneAllowed(chainId string) bool {
+	if len(p.RedemptionHostZones) == 0 {
+		return true
+	}
+	for _, allowedChainId := range p.RedemptionHostZones {
+		if allowedChainId == chainId {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the key used to identify the action limit when tracking daily usage
+func (l ActionLimit) GetKey() string {
+	return fmt.Sprintf("%s/%s/%s", l.Module, l.Action, l.Denom)
+}
+
+// Validates the action and amounts of a limit, and that each allowed channel is a valid channel ID
+func (l ActionLimit) Validate() error {
+	if l.Module == "" || l.Action == "" {
+		return errors.New("action limit module and action must be specified")
+	}
+	for _, channelId := range l.AllowedChannels {
+		if !channeltypes.IsValidChannelID(channelId) {
+			return fmt.Errorf("invalid allowed channel %s for %s", channelId, l.GetKey())
+		}
+	}
+	if l.MinAmount.IsNil() || l.MaxAmount.IsNil() || l.DailyAddressCap.IsNil() {
+		return fmt.Errorf("min amount, max amount and daily address cap must be specified for %s", l.GetKey())
+	}
+	if l.MinAmount.IsNegative() || l.MaxAmount.IsNegative() || l.DailyAddressCap.IsNegative() {
+		return fmt.Errorf("min amount, max amount and daily address cap cannot be negative for %s", l.GetKey())
+	}
+	if l.MaxAmount.IsPositive() && l.MinAmount.GT(l.MaxAmount) {
+		return fmt.Errorf("min amount cannot be greater than max amount for %s", l.GetKey())
+	}
+	return nil
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -74,3 +143,43 @@ func validateBool(i interface{}) error {
 
 	return nil
 }
+
+func validateActionLimits(i interface{}) error {
+	actionLimits, ok := i.([]ActionLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	limitKeys := map[string]bool{}
+	for _, actionLimit := range actionLimits {
+		if err := actionLimit.Validate(); err != nil {
+			return err
+		}
+		if limitKeys[actionLimit.GetKey()] {
+			return fmt.Errorf("duplicate action limit for %s", actionLimit.GetKey())
+		}
+		limitKeys[actionLimit.GetKey()] = true
+	}
+
+	return nil
+}
+
+func validateRedemptionHostZones(i interface{}) error {
+	chainIds, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := map[string]bool{}
+	for _, chainId := range chainIds {
+		if chainId == "" {
+			return errors.New("redemption host zone chain ID cannot be empty")
+		}
+		if seen[chainId] {
+			return fmt.Errorf("duplicate redemption host zone %s", chainId)
+		}
+		seen[chainId] = true
+	}
+
+	return nil
+}
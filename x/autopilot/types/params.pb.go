@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,11 +25,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
-// next id: 1
+// next id: 5
 type Params struct {
 	// optionally, turn off each module
 	StakeibcActive bool `protobuf:"varint,1,opt,name=stakeibc_active,json=stakeibcActive,proto3" json:"stakeibc_active,omitempty"`
 	ClaimActive    bool `protobuf:"varint,2,opt,name=claim_active,json=claimActive,proto3" json:"claim_active,omitempty"`
+	// Limits for each autopilot action, optionally scoped to a denom
+	ActionLimits []ActionLimit `protobuf:"bytes,3,rep,name=action_limits,json=actionLimits,proto3" json:"action_limits"`
+	// Chain IDs of the host zones that accept autopilot redemptions
+	// If empty, redemptions are accepted for all host zones
+	RedemptionHostZones []string `protobuf:"bytes,4,rep,name=redemption_host_zones,json=redemptionHostZones,proto3" json:"redemption_host_zones,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -77,27 +83,143 @@ func (m *Params) GetClaimActive() bool {
 	return false
 }
 
+func (m *Params) GetActionLimits() []ActionLimit {
+	if m != nil {
+		return m.ActionLimits
+	}
+	return nil
+}
+
+func (m *Params) GetRedemptionHostZones() []string {
+	if m != nil {
+		return m.RedemptionHostZones
+	}
+	return nil
+}
+
+// Restricts the inbound transfers that can trigger an autopilot action
+// The limit with a matching denom takes precedence over the limit without
+// a denom for the same action
+type ActionLimit struct {
+	// Key of the route in the autopilot memo (e.g. stakeibc or stakedym)
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// Action name (e.g. LiquidStake)
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// IBC denom of the inbound tokens on stride
+	// If empty, the limit applies to all denoms for the action
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Channels on stride that the transfer can be received on
+	// If empty, all channels are allowed
+	AllowedChannels []string `protobuf:"bytes,4,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	// Minimum and maximum amount per packet (a zero max means no max)
+	MinAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount"`
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+	// Maximum amount each sender can send through the action per day, tracked
+	// by the sender on the counterparty chain and the channel on stride
+	// (a zero cap means no cap)
+	// Usage is not included in genesis, so it resets if the chain is restarted
+	// from an exported genesis
+	DailyAddressCap cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=daily_address_cap,json=dailyAddressCap,proto3,customtype=cosmossdk.io/math.Int" json:"daily_address_cap"`
+}
+
+func (m *ActionLimit) Reset()         { *m = ActionLimit{} }
+func (m *ActionLimit) String() string { return proto.CompactTextString(m) }
+func (*ActionLimit) ProtoMessage()    {}
+func (*ActionLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0b993e9f5195319, []int{1}
+}
+func (m *ActionLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionLimit.Merge(m, src)
+}
+func (m *ActionLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionLimit proto.InternalMessageInfo
+
+func (m *ActionLimit) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ActionLimit) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ActionLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ActionLimit) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "stride.autopilot.Params")
+	proto.RegisterType((*ActionLimit)(nil), "stride.autopilot.ActionLimit")
 }
 
 func init() { proto.RegisterFile("stride/autopilot/params.proto", fileDescriptor_b0b993e9f5195319) }
 
 var fileDescriptor_b0b993e9f5195319 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0x4f, 0x2c, 0x2d, 0xc9, 0x2f, 0xc8, 0xcc, 0xc9, 0x2f, 0xd1, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x48, 0xeb, 0xc1, 0xa5,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x52, 0x14, 0x17,
-	0x5b, 0x00, 0x58, 0x9f, 0x90, 0x3a, 0x17, 0x7f, 0x71, 0x49, 0x62, 0x76, 0x6a, 0x66, 0x52, 0x72,
-	0x7c, 0x62, 0x72, 0x49, 0x66, 0x59, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x47, 0x10, 0x1f, 0x4c,
-	0xd8, 0x11, 0x2c, 0x2a, 0xa4, 0xc8, 0xc5, 0x93, 0x9c, 0x93, 0x98, 0x99, 0x0b, 0x53, 0xc5, 0x04,
-	0x56, 0xc5, 0x0d, 0x16, 0x83, 0x28, 0xb1, 0x62, 0x99, 0xb1, 0x40, 0x9e, 0xc1, 0xc9, 0xf7, 0xc4,
-	0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x8c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93,
-	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x83, 0xc1, 0x0e, 0xd5, 0xf5, 0x49, 0x4c, 0x2a, 0xd6, 0x87, 0xfa,
-	0xa9, 0xcc, 0xd8, 0x58, 0xbf, 0x02, 0xc9, 0x67, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x17, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc9, 0xb8, 0xeb, 0xf3, 0xfa, 0x00, 0x00, 0x00,
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xed, 0x26, 0x0d, 0xe4, 0x52, 0x48, 0x39, 0x5a, 0x64, 0x21, 0xc5, 0x09, 0x5d, 0x08,
+	0x03, 0xb6, 0xd4, 0x6c, 0x88, 0x25, 0xe9, 0xd2, 0x4a, 0x45, 0x42, 0x66, 0xeb, 0x62, 0xbd, 0xf8,
+	0x4e, 0xc9, 0xa9, 0x77, 0xf7, 0x2c, 0xdf, 0xa5, 0xa4, 0x7c, 0x0a, 0x46, 0x46, 0xbe, 0x0c, 0x52,
+	0xc7, 0x8e, 0x15, 0x43, 0x85, 0x92, 0x2f, 0x82, 0x7c, 0x76, 0x68, 0xc5, 0x94, 0xcd, 0xef, 0xf7,
+	0xff, 0xff, 0x9f, 0xdf, 0xd3, 0x3d, 0xd2, 0x33, 0xb6, 0x10, 0x8c, 0xc7, 0xb0, 0xb0, 0x98, 0x0b,
+	0x89, 0x36, 0xce, 0xa1, 0x00, 0x65, 0xa2, 0xbc, 0x40, 0x8b, 0x74, 0xbf, 0x92, 0xa3, 0x7f, 0xf2,
+	0xeb, 0x83, 0x19, 0xce, 0xd0, 0x89, 0x71, 0xf9, 0x55, 0xf9, 0x8e, 0xee, 0x7c, 0xd2, 0xfa, 0xec,
+	0x82, 0xf4, 0x2d, 0xe9, 0x1a, 0x0b, 0x97, 0x5c, 0x4c, 0xb3, 0x14, 0x32, 0x2b, 0xae, 0x78, 0xe0,
+	0x0f, 0xfc, 0xe1, 0xd3, 0xe4, 0xf9, 0x06, 0x8f, 0x1d, 0xa5, 0x6f, 0xc8, 0x5e, 0x26, 0x41, 0xa8,
+	0x8d, 0x6b, 0xc7, 0xb9, 0x3a, 0x8e, 0xd5, 0x96, 0x53, 0xf2, 0xac, 0x14, 0x51, 0xa7, 0x52, 0x28,
+	0x61, 0x4d, 0xd0, 0x18, 0x34, 0x86, 0x9d, 0xe3, 0x5e, 0xf4, 0xff, 0x58, 0xd1, 0xd8, 0xd9, 0xce,
+	0x4b, 0xd7, 0xa4, 0x79, 0x73, 0xdf, 0xf7, 0x92, 0x3d, 0x78, 0x40, 0x86, 0x1e, 0x93, 0xc3, 0x82,
+	0x33, 0xae, 0x72, 0xd7, 0x6d, 0x8e, 0xc6, 0xa6, 0xdf, 0x50, 0x73, 0x13, 0x34, 0x07, 0x8d, 0x61,
+	0x3b, 0x79, 0xf9, 0x20, 0x9e, 0xa2, 0xb1, 0x17, 0xa5, 0xf4, 0xa1, 0xf9, 0xe3, 0x67, 0xdf, 0x3b,
+	0xfa, 0xb5, 0x43, 0x3a, 0x8f, 0xba, 0xd3, 0x57, 0xa4, 0xa5, 0x90, 0x2d, 0x64, 0xb5, 0x56, 0x3b,
+	0xa9, 0xab, 0x92, 0x57, 0x7f, 0x74, 0x8b, 0xb4, 0x93, 0xba, 0xa2, 0x07, 0x64, 0x97, 0x71, 0x8d,
+	0x2a, 0x68, 0x38, 0x5c, 0x15, 0xf4, 0x1d, 0xd9, 0x07, 0x29, 0xf1, 0x2b, 0x67, 0x69, 0x36, 0x07,
+	0xad, 0xb9, 0xdc, 0x8c, 0xd2, 0xad, 0xf9, 0x49, 0x8d, 0xe9, 0x47, 0x42, 0x94, 0xd0, 0x29, 0x28,
+	0x5c, 0x68, 0x1b, 0xec, 0x96, 0x5d, 0x26, 0xbd, 0x72, 0xc5, 0xdf, 0xf7, 0xfd, 0xc3, 0x0c, 0x8d,
+	0x42, 0x63, 0xd8, 0x65, 0x24, 0x30, 0x56, 0x60, 0xe7, 0xd1, 0x99, 0xb6, 0x49, 0x5b, 0x09, 0x3d,
+	0x76, 0x7e, 0x97, 0x86, 0xe5, 0x26, 0xdd, 0xda, 0x2e, 0x0d, 0xcb, 0x3a, 0x7d, 0x46, 0x5e, 0x30,
+	0x10, 0xf2, 0x3a, 0x05, 0xc6, 0x0a, 0x6e, 0x4c, 0x9a, 0x41, 0x1e, 0x3c, 0xd9, 0xa6, 0x49, 0xd7,
+	0xe5, 0xc6, 0x55, 0xec, 0x04, 0xf2, 0xc9, 0xa7, 0x9b, 0x55, 0xe8, 0xdf, 0xae, 0x42, 0xff, 0xcf,
+	0x2a, 0xf4, 0xbf, 0xaf, 0x43, 0xef, 0x76, 0x1d, 0x7a, 0x77, 0xeb, 0xd0, 0xbb, 0x18, 0xcd, 0x84,
+	0x9d, 0x2f, 0xa6, 0x51, 0x86, 0x2a, 0xfe, 0xe2, 0x1e, 0xf6, 0xfd, 0x39, 0x4c, 0x4d, 0x5c, 0x9f,
+	0xe6, 0xd5, 0x68, 0x14, 0x2f, 0x1f, 0x1d, 0xa8, 0xbd, 0xce, 0xb9, 0x99, 0xb6, 0xdc, 0xe1, 0x8d,
+	0xfe, 0x0e, 0x00, 0x57, 0xef, 0x26, 0x2c, 0xc1, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -120,6 +242,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RedemptionHostZones) > 0 {
+		for iNdEx := len(m.RedemptionHostZones) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RedemptionHostZones[iNdEx])
+			copy(dAtA[i:], m.RedemptionHostZones[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.RedemptionHostZones[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ActionLimits) > 0 {
+		for iNdEx := len(m.ActionLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActionLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ClaimActive {
 		i--
 		if m.ClaimActive {
@@ -143,6 +288,89 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ActionLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DailyAddressCap.Size()
+		i -= size
+		if _, err := m.DailyAddressCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -166,6 +394,51 @@ func (m *Params) Size() (n int) {
 	if m.ClaimActive {
 		n += 2
 	}
+	if len(m.ActionLimits) > 0 {
+		for _, e := range m.ActionLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RedemptionHostZones) > 0 {
+		for _, s := range m.RedemptionHostZones {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActionLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DailyAddressCap.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -244,6 +517,352 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ClaimActive = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionLimits = append(m.ActionLimits, ActionLimit{})
+			if err := m.ActionLimits[len(m.ActionLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionHostZones", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionHostZones = append(m.RedemptionHostZones, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyAddressCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyAddressCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
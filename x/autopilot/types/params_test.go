@@ -0,0 +1,155 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v33/x/autopilot/types"
+)
+
+func validActionLimit() types.ActionLimit {
+	return types.ActionLimit{
+		Module:          "stakeibc",
+		Action:          "LiquidStake",
+		Denom:           "ibc/denom",
+		AllowedChannels: []string{"channel-0"},
+		MinAmount:       sdkmath.NewInt(10),
+		MaxAmount:       sdkmath.NewInt(100),
+		DailyAddressCap: sdkmath.NewInt(1000),
+	}
+}
+
+func TestParams_Validate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		modify        func(p *types.Params)
+		expectedError string
+	}{
+		{
+			name:   "default params",
+			modify: func(p *types.Params) {},
+		},
+		{
+			name: "valid limits and host zones",
+			modify: func(p *types.Params) {
+				p.ActionLimits = []types.ActionLimit{validActionLimit()}
+				p.RedemptionHostZones = []string{"cosmoshub-4", "osmosis-1"}
+			},
+		},
+		{
+			name: "no max amount",
+			modify: func(p *types.Params) {
+				limit := validActionLimit()
+				limit.MaxAmount = sdkmath.ZeroInt()
+				p.ActionLimits = []types.ActionLimit{limit}
+			},
+		},
+		{
+			name: "missing module",
+			modify: func(p *types.Params) {
+				limit := validActionLimit()
+				limit.Module = ""
+				p.ActionLimits = []types.ActionLimit{limit}
+			},
+			expectedError: "module and action must be specified",
+		},
+		{
+			name: "invalid channel",
+			modify: func(p *types.Params) {
+				limit := validActionLimit()
+				limit.AllowedChannels = []string{"channel"}
+				p.ActionLimits = []types.ActionLimit{limit}
+			},
+			expectedError: "invalid allowed channel",
+		},
+		{
+			name: "negative min amount",
+			modify: func(p *types.Params) {
+				limit := validActionLimit()
+				limit.MinAmount = sdkmath.NewInt(-1)
+				p.ActionLimits = []types.ActionLimit{limit}
+			},
+			expectedError: "cannot be negative",
+		},
+		{
+			name: "min above max",
+			modify: func(p *types.Params) {
+				limit := validActionLimit()
+				limit.MinAmount = sdkmath.NewInt(101)
+				p.ActionLimits = []types.ActionLimit{limit}
+			},
+			expectedError: "min amount cannot be greater than max amount",
+		},
+		{
+			name: "duplicate limit",
+			modify: func(p *types.Params) {
+				p.ActionLimits = []types.ActionLimit{validActionLimit(), validActionLimit()}
+			},
+			expectedError: "duplicate action limit",
+		},
+		{
+			name: "empty host zone",
+			modify: func(p *types.Params) {
+				p.RedemptionHostZones = []string{""}
+			},
+			expectedError: "chain ID cannot be empty",
+		},
+		{
+			name: "duplicate host zone",
+			modify: func(p *types.Params) {
+				p.RedemptionHostZones = []string{"cosmoshub-4", "cosmoshub-4"}
+			},
+			expectedError: "duplicate redemption host zone",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+
+			err := params.Validate()
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestParams_GetActionLimit(t *testing.T) {
+	denomLimit := validActionLimit()
+	wildcardLimit := validActionLimit()
+	wildcardLimit.Denom = ""
+	wildcardLimit.MinAmount = sdkmath.ZeroInt()
+
+	params := types.DefaultParams()
+	params.ActionLimits = []types.ActionLimit{wildcardLimit, denomLimit}
+
+	// The denom specific limit should take precedence over the wildcard
+	limit, found := params.GetActionLimit("stakeibc", "LiquidStake", "ibc/denom")
+	require.True(t, found, "denom limit should have been found")
+	require.Equal(t, denomLimit, limit, "denom limit")
+
+	// Other denoms should fall back to the wildcard
+	limit, found = params.GetActionLimit("stakeibc", "LiquidStake", "ibc/other")
+	require.True(t, found, "wildcard limit should have been found")
+	require.Equal(t, wildcardLimit, limit, "wildcard limit")
+
+	// Other actions should not have a limit
+	_, found = params.GetActionLimit("stakeibc", "RedeemStake", "ibc/denom")
+	require.False(t, found, "no limit should have been found for a different action")
+}
+
+func TestParams_IsRedemptionHostZoneAllowed(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.IsRedemptionHostZoneAllowed("cosmoshub-4"), "all zones allowed with an empty list")
+
+	params.RedemptionHostZones = []string{"osmosis-1"}
+	require.True(t, params.IsRedemptionHostZoneAllowed("osmosis-1"), "zone in the list")
+	require.False(t, params.IsRedemptionHostZoneAllowed("cosmoshub-4"), "zone not in the list")
+}
@@ -9,8 +9,26 @@ import (
 )
 
 const (
-	LiquidStake = "LiquidStake"
-	RedeemStake = "RedeemStake"
+	LiquidStake    = "LiquidStake"
+	RedeemStake    = "RedeemStake"
+	LSMLiquidStake = "LSMLiquidStake"
+)
+
+// Keys in the autopilot memo that are parsed directly by autopilot
+// All other keys are treated as routes to registered actions
+const (
+	ReceiverKey      = "receiver"
+	StakeibcRouteKey = "stakeibc"
+	ClaimRouteKey    = "claim"
+	NextKey          = "next"
+	OnFailureKey     = "on_failure"
+)
+
+// Failure policies that can be specified in the memo under "on_failure"
+const (
+	FailurePolicyRefund = "refund"
+	FailurePolicyKeep   = "keep"
+	FailurePolicyRetry  = "retry"
 )
 
 // Packet metadata info specific to Stakeibc (e.g. 1-click liquid staking)
@@ -18,8 +36,9 @@ type StakeibcPacketMetadata struct {
 	Action string `json:"action"`
 	// TODO [cleanup]: Rename to FallbackAddress
 	StrideAddress   string
-	IbcReceiver     string `json:"ibc_receiver,omitempty"`
-	TransferChannel string `json:"transfer_channel,omitempty"`
+	IbcReceiver     string              `json:"ibc_receiver,omitempty"`
+	TransferChannel string              `json:"transfer_channel,omitempty"`
+	Next            *NextActionMetadata `json:"-"`
 }
 
 // Packet metadata info specific to Claim (e.g. airdrops for non-118 coins)
@@ -38,10 +57,22 @@ func (m StakeibcPacketMetadata) Validate() error {
 	switch m.Action {
 	case LiquidStake:
 	case RedeemStake:
+	case LSMLiquidStake:
 	default:
 		return errorsmod.Wrapf(ErrUnsupportedStakeibcAction, "action %s is not supported", m.Action)
 	}
 
+	// A next action can only follow a liquid stake, and replaces the ibc receiver forwarding
+	if m.Next != nil {
+		if m.Action != LiquidStake {
+			return errorsmod.Wrapf(ErrInvalidNextAction, "next action is not supported for %s", m.Action)
+		}
+		if m.IbcReceiver != "" {
+			return errorsmod.Wrap(ErrInvalidNextAction, "ibc receiver cannot be used with a next action")
+		}
+		return m.Next.Validate()
+	}
+
 	return nil
 }
 
@@ -104,6 +135,7 @@ func ParseAutopilotMetadata(metadata string) (*AutopilotMetadata, error) {
 	if raw.Autopilot.Stakeibc != nil {
 		// override the stride address with the receiver address
 		raw.Autopilot.Stakeibc.StrideAddress = raw.Autopilot.Receiver
+		raw.Autopilot.Stakeibc.Next = raw.Autopilot.Next
 		moduleCount++
 		routingInfo = *raw.Autopilot.Stakeibc
 	}
@@ -112,6 +144,36 @@ func ParseAutopilotMetadata(metadata string) (*AutopilotMetadata, error) {
 		raw.Autopilot.Claim.StrideAddress = raw.Autopilot.Receiver
 		moduleCount++
 		routingInfo = *raw.Autopilot.Claim
+		if raw.Autopilot.Next != nil {
+			return nil, errorsmod.Wrap(ErrInvalidPacketMetadata, "next action is not supported for claim")
+		}
+	}
+	// Any other module keys are routes to actions registered by other modules (e.g. stakedym)
+	// The module-specific fields are decoded and validated against the registry when the packet is processed
+	var rawRoutes struct {
+		Autopilot map[string]json.RawMessage `json:"autopilot"`
+	}
+	if err := json.Unmarshal([]byte(metadata), &rawRoutes); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidPacketMetadata, err.Error())
+	}
+	for module, rawMetadata := range rawRoutes.Autopilot {
+		if module == ReceiverKey || module == StakeibcRouteKey || module == ClaimRouteKey || module == NextKey || module == OnFailureKey {
+			continue
+		}
+		var rawAction struct {
+			Action string `json:"action"`
+		}
+		if err := json.Unmarshal(rawMetadata, &rawAction); err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidPacketMetadata, err.Error())
+		}
+		moduleCount++
+		routingInfo = RegisteredActionPacketMetadata{
+			Module:        module,
+			Action:        rawAction.Action,
+			StrideAddress: raw.Autopilot.Receiver,
+			RawMetadata:   rawMetadata,
+			Next:          raw.Autopilot.Next,
+		}
 	}
 	if moduleCount != 1 {
 		return nil, errorsmod.Wrap(ErrInvalidPacketMetadata, ErrInvalidModuleRoutes.Error())
@@ -122,8 +184,34 @@ func ParseAutopilotMetadata(metadata string) (*AutopilotMetadata, error) {
 		return nil, errorsmod.Wrap(err, ErrInvalidPacketMetadata.Error())
 	}
 
+	// Parse the failure policy, which defaults to refunding the transfer
+	failurePolicy, err := ParseFailurePolicy(raw.Autopilot.OnFailure)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, ErrInvalidPacketMetadata.Error())
+	}
+	if _, isClaim := routingInfo.(ClaimPacketMetadata); isClaim && failurePolicy != FailurePolicy_REFUND {
+		return nil, errorsmod.Wrap(ErrInvalidPacketMetadata, "failure policy is not supported for claim")
+	}
+
 	return &AutopilotMetadata{
-		Receiver:    raw.Autopilot.Receiver,
-		RoutingInfo: routingInfo,
+		Receiver:      raw.Autopilot.Receiver,
+		RoutingInfo:   routingInfo,
+		FailurePolicy: failurePolicy,
 	}, nil
 }
+
+// Converts the failure policy from the memo into its enum
+// If no policy is specified, the transfer is refunded
+func ParseFailurePolicy(policy string) (FailurePolicy, error) {
+	switch policy {
+	case "", FailurePolicyRefund:
+		return FailurePolicy_REFUND, nil
+	case FailurePolicyKeep:
+		return FailurePolicy_KEEP, nil
+	case FailurePolicyRetry:
+		return FailurePolicy_RETRY, nil
+	default:
+		return FailurePolicy_REFUND, errorsmod.Wrapf(ErrInvalidFailurePolicy,
+			"on_failure must be one of %s, %s, or %s, but was %s", FailurePolicyRefund, FailurePolicyKeep, FailurePolicyRetry, policy)
+	}
+}
@@ -65,6 +65,38 @@ func getClaimAndStakeibcMemo(address, action string) string {
 		}`, address, action)
 }
 
+func getRegisteredActionMemo(address, module, action string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"%[2]s": { "action": "%[3]s" } 
+			}
+		}`, address, module, action)
+}
+
+func getRegisteredActionAndStakeibcMemo(address, module, action string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"stakeibc": { "action": "%[3]s" },
+				"%[2]s": { "action": "%[3]s" } 
+			}
+		}`, address, module, action)
+}
+
+func getStakeibcMemoWithNext(address, action, next string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"stakeibc": { "action": "%[2]s" },
+				"next": %[3]s
+			}
+		}`, address, action, next)
+}
+
 // Helper function to check the routingInfo with a switch statement
 // This isn't the most efficient way to check the type  (require.TypeOf could be used instead)
 // but it better aligns with how the routing info is checked in module_ibc
@@ -74,6 +106,8 @@ func checkModuleRoutingInfoType(routingInfo types.ModuleRoutingInfo, expectedTyp
 		return expectedType == "stakeibc"
 	case types.ClaimPacketMetadata:
 		return expectedType == "claim"
+	case types.RegisteredActionPacketMetadata:
+		return expectedType == "registered"
 	default:
 		return false
 	}
@@ -88,15 +122,36 @@ func TestParsePacketMetadata(t *testing.T) {
 		Action:        validStakeibcAction,
 	}
 
+	validParsedLSMStakeibcPacketMetadata := types.StakeibcPacketMetadata{
+		StrideAddress: validAddress,
+		Action:        "LSMLiquidStake",
+	}
+
 	validParsedClaimPacketMetadata := types.ClaimPacketMetadata{
 		StrideAddress: validAddress,
 	}
 
+	validNextForward := `{ "forward": { "receiver": "osmo1xxx", "channel": "channel-5" } }`
+	validParsedStakeibcWithNextMetadata := types.StakeibcPacketMetadata{
+		StrideAddress: validAddress,
+		Action:        validStakeibcAction,
+		Next: &types.NextActionMetadata{
+			Forward: &types.ForwardNextMetadata{Receiver: "osmo1xxx", Channel: "channel-5"},
+		},
+	}
+
+	validParsedRegisteredActionMetadata := types.RegisteredActionPacketMetadata{
+		Module:        "stakedym",
+		Action:        validStakeibcAction,
+		StrideAddress: validAddress,
+	}
+
 	testCases := []struct {
 		name                string
 		metadata            string
 		parsedStakeibc      *types.StakeibcPacketMetadata
 		parsedClaim         *types.ClaimPacketMetadata
+		parsedRegistered    *types.RegisteredActionPacketMetadata
 		expectedNilMetadata bool
 		expectedErr         string
 	}{
@@ -120,6 +175,21 @@ func TestParsePacketMetadata(t *testing.T) {
 			metadata:    getClaimMemoWithStrideAddress(validAddress, "different_address"),
 			parsedClaim: &validParsedClaimPacketMetadata,
 		},
+		{
+			name:           "valid lsm liquid stake memo",
+			metadata:       getStakeibcMemo(validAddress, "LSMLiquidStake"),
+			parsedStakeibc: &validParsedLSMStakeibcPacketMetadata,
+		},
+		{
+			name:           "valid stakeibc memo with next action",
+			metadata:       getStakeibcMemoWithNext(validAddress, validStakeibcAction, validNextForward),
+			parsedStakeibc: &validParsedStakeibcWithNextMetadata,
+		},
+		{
+			name:             "valid registered action memo",
+			metadata:         getRegisteredActionMemo(validAddress, "stakedym", validStakeibcAction),
+			parsedRegistered: &validParsedRegisteredActionMetadata,
+		},
 		{
 			name:                "normal IBC transfer",
 			metadata:            validAddress, // normal address - not autopilot JSON
@@ -195,6 +265,42 @@ func TestParsePacketMetadata(t *testing.T) {
 			metadata:    getClaimAndStakeibcMemo(validAddress, validStakeibcAction),
 			expectedErr: "invalid number of module routes",
 		},
+		{
+			name:        "both registered action and stakeibc memo set",
+			metadata:    getRegisteredActionAndStakeibcMemo(validAddress, "stakedym", validStakeibcAction),
+			expectedErr: "invalid number of module routes",
+		},
+		{
+			name:        "next action with redeem stake",
+			metadata:    getStakeibcMemoWithNext(validAddress, "RedeemStake", validNextForward),
+			expectedErr: "next action is not supported for RedeemStake",
+		},
+		{
+			name:        "next action with lsm liquid stake",
+			metadata:    getStakeibcMemoWithNext(validAddress, "LSMLiquidStake", validNextForward),
+			expectedErr: "next action is not supported for LSMLiquidStake",
+		},
+		{
+			name:        "invalid next action",
+			metadata:    getStakeibcMemoWithNext(validAddress, validStakeibcAction, `{ "forward": { "receiver": "osmo1xxx" } }`),
+			expectedErr: "invalid forward channel",
+		},
+		{
+			name: "next action with claim",
+			metadata: fmt.Sprintf(`{ "autopilot": { "receiver": "%s", "claim": {}, "next": %s } }`,
+				validAddress, validNextForward),
+			expectedErr: "next action is not supported for claim",
+		},
+		{
+			name:        "missing registered action",
+			metadata:    getRegisteredActionMemo(validAddress, "stakedym", ""),
+			expectedErr: "unsupported autopilot action",
+		},
+		{
+			name:        "invalid registered action metadata",
+			metadata:    fmt.Sprintf(`{ "autopilot": { "receiver": "%s", "stakedym": "LiquidStake" } }`, validAddress),
+			expectedErr: "cannot unmarshal string",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -216,6 +322,14 @@ func TestParsePacketMetadata(t *testing.T) {
 						routingInfo, ok := parsedData.RoutingInfo.(types.ClaimPacketMetadata)
 						require.True(t, ok, "routing info should be claim")
 						require.Equal(t, *tc.parsedClaim, routingInfo, "parsed claim value")
+					} else if tc.parsedRegistered != nil {
+						checkModuleRoutingInfoType(parsedData.RoutingInfo, "registered")
+						routingInfo, ok := parsedData.RoutingInfo.(types.RegisteredActionPacketMetadata)
+						require.True(t, ok, "routing info should be a registered action")
+						require.Equal(t, tc.parsedRegistered.Module, routingInfo.Module, "parsed module")
+						require.Equal(t, tc.parsedRegistered.Action, routingInfo.Action, "parsed action")
+						require.Equal(t, tc.parsedRegistered.StrideAddress, routingInfo.StrideAddress, "parsed stride address")
+						require.NotEmpty(t, routingInfo.RawMetadata, "raw metadata")
 					}
 				}
 			} else {
@@ -226,6 +340,77 @@ func TestParsePacketMetadata(t *testing.T) {
 	}
 }
 
+func TestParseAutopilotMetadata_FailurePolicy(t *testing.T) {
+	validAddress, _ := apptesting.GenerateTestAddrs()
+
+	getMemoWithFailurePolicy := func(route, policy string) string {
+		return fmt.Sprintf(`{ "autopilot": { "receiver": "%s", %s, "on_failure": "%s" } }`, validAddress, route, policy)
+	}
+	stakeibcRoute := `"stakeibc": { "action": "LiquidStake" }`
+	registeredRoute := `"stakedym": { "action": "LiquidStake" }`
+	claimRoute := `"claim": {}`
+
+	testCases := []struct {
+		name           string
+		metadata       string
+		expectedPolicy types.FailurePolicy
+		expectedErr    string
+	}{
+		{
+			name:           "no failure policy",
+			metadata:       getStakeibcMemo(validAddress, "LiquidStake"),
+			expectedPolicy: types.FailurePolicy_REFUND,
+		},
+		{
+			name:           "refund",
+			metadata:       getMemoWithFailurePolicy(stakeibcRoute, "refund"),
+			expectedPolicy: types.FailurePolicy_REFUND,
+		},
+		{
+			name:           "keep",
+			metadata:       getMemoWithFailurePolicy(stakeibcRoute, "keep"),
+			expectedPolicy: types.FailurePolicy_KEEP,
+		},
+		{
+			name:           "retry",
+			metadata:       getMemoWithFailurePolicy(stakeibcRoute, "retry"),
+			expectedPolicy: types.FailurePolicy_RETRY,
+		},
+		{
+			name:           "retry with registered action",
+			metadata:       getMemoWithFailurePolicy(registeredRoute, "retry"),
+			expectedPolicy: types.FailurePolicy_RETRY,
+		},
+		{
+			name:           "refund with claim",
+			metadata:       getMemoWithFailurePolicy(claimRoute, "refund"),
+			expectedPolicy: types.FailurePolicy_REFUND,
+		},
+		{
+			name:        "keep with claim",
+			metadata:    getMemoWithFailurePolicy(claimRoute, "keep"),
+			expectedErr: "failure policy is not supported for claim",
+		},
+		{
+			name:        "invalid failure policy",
+			metadata:    getMemoWithFailurePolicy(stakeibcRoute, "burn"),
+			expectedErr: "on_failure must be one of refund, keep, or retry",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsedData, actualErr := types.ParseAutopilotMetadata(tc.metadata)
+			if tc.expectedErr == "" {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.expectedPolicy, parsedData.FailurePolicy, "failure policy")
+			} else {
+				require.ErrorContains(t, actualErr, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestValidateStakeibcPacketMetadata(t *testing.T) {
 	validAddress, _ := apptesting.GenerateTestAddrs()
 	validAction := "LiquidStake"
@@ -112,33 +112,134 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryAutopilotFailuresRequest is request type for the
+// Query/AutopilotFailures RPC method.
+type QueryAutopilotFailuresRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAutopilotFailuresRequest) Reset()         { *m = QueryAutopilotFailuresRequest{} }
+func (m *QueryAutopilotFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutopilotFailuresRequest) ProtoMessage()    {}
+func (*QueryAutopilotFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dd160550c308365, []int{2}
+}
+func (m *QueryAutopilotFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutopilotFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutopilotFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutopilotFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutopilotFailuresRequest.Merge(m, src)
+}
+func (m *QueryAutopilotFailuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutopilotFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutopilotFailuresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutopilotFailuresRequest proto.InternalMessageInfo
+
+func (m *QueryAutopilotFailuresRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAutopilotFailuresResponse is response type for the
+// Query/AutopilotFailures RPC method.
+type QueryAutopilotFailuresResponse struct {
+	Failures []AutopilotFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures"`
+}
+
+func (m *QueryAutopilotFailuresResponse) Reset()         { *m = QueryAutopilotFailuresResponse{} }
+func (m *QueryAutopilotFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutopilotFailuresResponse) ProtoMessage()    {}
+func (*QueryAutopilotFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dd160550c308365, []int{3}
+}
+func (m *QueryAutopilotFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutopilotFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutopilotFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutopilotFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutopilotFailuresResponse.Merge(m, src)
+}
+func (m *QueryAutopilotFailuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutopilotFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutopilotFailuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutopilotFailuresResponse proto.InternalMessageInfo
+
+func (m *QueryAutopilotFailuresResponse) GetFailures() []AutopilotFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "stride.autopilot.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "stride.autopilot.QueryParamsResponse")
+	proto.RegisterType((*QueryAutopilotFailuresRequest)(nil), "stride.autopilot.QueryAutopilotFailuresRequest")
+	proto.RegisterType((*QueryAutopilotFailuresResponse)(nil), "stride.autopilot.QueryAutopilotFailuresResponse")
 }
 
 func init() { proto.RegisterFile("stride/autopilot/query.proto", fileDescriptor_1dd160550c308365) }
 
 var fileDescriptor_1dd160550c308365 = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0x4f, 0x2c, 0x2d, 0xc9, 0x2f, 0xc8, 0xcc, 0xc9, 0x2f, 0xd1, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xea, 0xc1, 0x65, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94, 0x4c, 0x7a, 0x7e,
-	0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62, 0x49,
-	0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x16, 0xc3, 0x8e, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xb4,
-	0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xce, 0x00, 0xb0, 0x60, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71,
-	0x89, 0x92, 0x2f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x19, 0x17,
-	0x1b, 0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba, 0x13, 0xf5, 0x20,
-	0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x36, 0xea, 0x65, 0xe4, 0x62, 0x05,
-	0x9b, 0x27, 0xd4, 0xcc, 0xc8, 0xc5, 0x06, 0x51, 0x22, 0xa4, 0x82, 0xa9, 0x19, 0xd3, 0x25, 0x52,
-	0xaa, 0x04, 0x54, 0x41, 0x5c, 0xa6, 0xa4, 0xd3, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x35, 0x21, 0x15,
-	0xfd, 0x60, 0xb0, 0x72, 0x5d, 0x9f, 0xc4, 0xa4, 0x62, 0x7d, 0x1c, 0x5e, 0x77, 0xf2, 0x3d, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xe3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x6c, 0x26, 0x95, 0x19, 0x1b, 0xeb, 0x57, 0x20, 0x99, 0x57, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x4a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x70,
-	0xa9, 0x98, 0xcf, 0x01, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4e, 0xe2, 0x40,
+	0x18, 0xc7, 0x5b, 0x76, 0x97, 0xdd, 0x1d, 0x2e, 0xbb, 0x23, 0x07, 0xd2, 0xc0, 0x68, 0x1a, 0x34,
+	0x1c, 0xb4, 0x43, 0xda, 0xc4, 0xe8, 0x51, 0x62, 0x3c, 0x49, 0xa2, 0x78, 0xf3, 0x36, 0xc8, 0x50,
+	0x9b, 0x40, 0xa7, 0x74, 0xa6, 0x46, 0x62, 0xbc, 0xe8, 0x0b, 0x98, 0xf8, 0x22, 0x3e, 0x82, 0x47,
+	0x8e, 0x24, 0x5e, 0x3c, 0x19, 0x03, 0x3e, 0x88, 0xa1, 0x33, 0x25, 0x4a, 0xad, 0x7a, 0x6b, 0xe7,
+	0xff, 0xfd, 0xff, 0xdf, 0xef, 0xfb, 0x66, 0x40, 0x99, 0x8b, 0xd0, 0xeb, 0x50, 0x4c, 0x22, 0xc1,
+	0x02, 0xaf, 0xc7, 0x04, 0x1e, 0x44, 0x34, 0x1c, 0x5a, 0x41, 0xc8, 0x04, 0x83, 0xff, 0xa4, 0x6a,
+	0xcd, 0x55, 0xa3, 0xe8, 0x32, 0x97, 0xc5, 0x22, 0x9e, 0x7d, 0xc9, 0x3a, 0xa3, 0xec, 0x32, 0xe6,
+	0xf6, 0x28, 0x26, 0x81, 0x87, 0x89, 0xef, 0x33, 0x41, 0x84, 0xc7, 0x7c, 0xae, 0x54, 0x94, 0xea,
+	0xd1, 0x25, 0x5e, 0x2f, 0x0a, 0xa9, 0xd2, 0x2b, 0x29, 0x3d, 0x20, 0x21, 0xe9, 0x2b, 0xbb, 0x59,
+	0x04, 0xf0, 0x70, 0xc6, 0x74, 0x10, 0x1f, 0xb6, 0xe8, 0x20, 0xa2, 0x5c, 0x98, 0x4d, 0xb0, 0xf4,
+	0xee, 0x94, 0x07, 0xcc, 0xe7, 0x14, 0x6e, 0x82, 0xbc, 0x34, 0x97, 0xf4, 0x15, 0xbd, 0x56, 0xb0,
+	0x4b, 0xd6, 0xe2, 0x08, 0x96, 0x74, 0x34, 0x7e, 0x8e, 0x9e, 0x96, 0xb5, 0x96, 0xaa, 0x36, 0xb7,
+	0x41, 0x25, 0x8e, 0xdb, 0x49, 0xca, 0xf6, 0x24, 0x62, 0xd2, 0x0f, 0x96, 0xc0, 0x6f, 0xd2, 0xe9,
+	0x84, 0x94, 0xcb, 0xe4, 0xbf, 0xad, 0xe4, 0xd7, 0xec, 0x02, 0x94, 0x65, 0x55, 0x50, 0xbb, 0xe0,
+	0x8f, 0x9a, 0x78, 0x66, 0xfe, 0x51, 0x2b, 0xd8, 0x66, 0x1a, 0x6b, 0xd1, 0xae, 0x00, 0xe7, 0x4e,
+	0xfb, 0x3e, 0x07, 0x7e, 0xc5, 0x8d, 0xe0, 0xb5, 0x0e, 0xf2, 0x72, 0x0a, 0x58, 0x4d, 0x07, 0xa5,
+	0x97, 0x65, 0xac, 0x7e, 0x51, 0x25, 0x39, 0xcd, 0xf5, 0xab, 0x87, 0x97, 0xdb, 0xdc, 0x1a, 0xac,
+	0xe2, 0xa3, 0xb8, 0x7c, 0x63, 0x9f, 0xb4, 0x39, 0xce, 0xb8, 0x1d, 0x78, 0xa7, 0x83, 0xff, 0xa9,
+	0x99, 0x21, 0xce, 0x68, 0x95, 0xb5, 0x58, 0xa3, 0xfe, 0x7d, 0x83, 0xc2, 0xdc, 0x8a, 0x31, 0x6d,
+	0x58, 0xff, 0x1c, 0x33, 0x59, 0x1c, 0xbe, 0x50, 0x37, 0x75, 0xd9, 0x68, 0x8e, 0x26, 0x48, 0x1f,
+	0x4f, 0x90, 0xfe, 0x3c, 0x41, 0xfa, 0xcd, 0x14, 0x69, 0xe3, 0x29, 0xd2, 0x1e, 0xa7, 0x48, 0x3b,
+	0x76, 0x5c, 0x4f, 0x9c, 0x46, 0x6d, 0xeb, 0x84, 0xf5, 0x3f, 0x4a, 0x3d, 0x73, 0x1c, 0x7c, 0xfe,
+	0x26, 0x5b, 0x0c, 0x03, 0xca, 0xdb, 0xf9, 0xf8, 0x81, 0x3a, 0xaf, 0x03, 0x00, 0x57, 0x20, 0x3d,
+	0x2a, 0x45, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +256,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries the failed autopilot actions for a given receiver address
+	AutopilotFailures(ctx context.Context, in *QueryAutopilotFailuresRequest, opts ...grpc.CallOption) (*QueryAutopilotFailuresResponse, error)
 }
 
 type queryClient struct {
@@ -174,10 +277,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) AutopilotFailures(ctx context.Context, in *QueryAutopilotFailuresRequest, opts ...grpc.CallOption) (*QueryAutopilotFailuresResponse, error) {
+	out := new(QueryAutopilotFailuresResponse)
+	err := c.cc.Invoke(ctx, "/stride.autopilot.Query/AutopilotFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries the failed autopilot actions for a given receiver address
+	AutopilotFailures(context.Context, *QueryAutopilotFailuresRequest) (*QueryAutopilotFailuresResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -187,6 +301,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) AutopilotFailures(ctx context.Context, req *QueryAutopilotFailuresRequest) (*QueryAutopilotFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutopilotFailures not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -210,6 +327,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutopilotFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutopilotFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutopilotFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.autopilot.Query/AutopilotFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutopilotFailures(ctx, req.(*QueryAutopilotFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.autopilot.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -218,6 +353,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "AutopilotFailures",
+			Handler:    _Query_AutopilotFailures_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/autopilot/query.proto",
@@ -279,6 +418,73 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAutopilotFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutopilotFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutopilotFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutopilotFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutopilotFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutopilotFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -310,6 +516,34 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutopilotFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutopilotFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -449,6 +683,172 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutopilotFailuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutopilotFailuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutopilotFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutopilotFailuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutopilotFailuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutopilotFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, AutopilotFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/autopilot/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Retries a failed autopilot action
+// Must be signed by the receiver from the original autopilot memo
+type MsgRetryAutopilotAction struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	FailureId uint64 `protobuf:"varint,2,opt,name=failure_id,json=failureId,proto3" json:"failure_id,omitempty"`
+}
+
+func (m *MsgRetryAutopilotAction) Reset()         { *m = MsgRetryAutopilotAction{} }
+func (m *MsgRetryAutopilotAction) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryAutopilotAction) ProtoMessage()    {}
+func (*MsgRetryAutopilotAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_408ffe6cf26dd8be, []int{0}
+}
+func (m *MsgRetryAutopilotAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryAutopilotAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryAutopilotAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryAutopilotAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryAutopilotAction.Merge(m, src)
+}
+func (m *MsgRetryAutopilotAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryAutopilotAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryAutopilotAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryAutopilotAction proto.InternalMessageInfo
+
+func (m *MsgRetryAutopilotAction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRetryAutopilotAction) GetFailureId() uint64 {
+	if m != nil {
+		return m.FailureId
+	}
+	return 0
+}
+
+type MsgRetryAutopilotActionResponse struct {
+}
+
+func (m *MsgRetryAutopilotActionResponse) Reset()         { *m = MsgRetryAutopilotActionResponse{} }
+func (m *MsgRetryAutopilotActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryAutopilotActionResponse) ProtoMessage()    {}
+func (*MsgRetryAutopilotActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_408ffe6cf26dd8be, []int{1}
+}
+func (m *MsgRetryAutopilotActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryAutopilotActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryAutopilotActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryAutopilotActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryAutopilotActionResponse.Merge(m, src)
+}
+func (m *MsgRetryAutopilotActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryAutopilotActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryAutopilotActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryAutopilotActionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRetryAutopilotAction)(nil), "stride.autopilot.MsgRetryAutopilotAction")
+	proto.RegisterType((*MsgRetryAutopilotActionResponse)(nil), "stride.autopilot.MsgRetryAutopilotActionResponse")
+}
+
+func init() { proto.RegisterFile("stride/autopilot/tx.proto", fileDescriptor_408ffe6cf26dd8be) }
+
+var fileDescriptor_408ffe6cf26dd8be = []byte{
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3d, 0x4f, 0xc2, 0x40,
+	0x1c, 0xc6, 0x39, 0x5f, 0xc3, 0x4d, 0xda, 0x90, 0x00, 0x4d, 0x3c, 0x81, 0x09, 0x49, 0xe8, 0x05,
+	0xba, 0xb9, 0xc1, 0x66, 0x22, 0x4b, 0xd9, 0x5c, 0x48, 0x69, 0xcf, 0x7a, 0x09, 0xed, 0x35, 0xf7,
+	0x3f, 0x08, 0x6c, 0x86, 0xd1, 0xc9, 0x8f, 0xe0, 0x47, 0x60, 0xf0, 0x43, 0x38, 0x12, 0x27, 0x47,
+	0x43, 0x87, 0x7e, 0x0d, 0x63, 0x5f, 0xd4, 0x18, 0x9b, 0xb8, 0xdc, 0xe5, 0xb9, 0xdf, 0x93, 0xe7,
+	0xee, 0x7f, 0x0f, 0xae, 0x83, 0x92, 0xdc, 0x65, 0xd4, 0x9e, 0x2b, 0x11, 0xf2, 0x99, 0x50, 0x54,
+	0x2d, 0x8d, 0x50, 0x0a, 0x25, 0xb4, 0x93, 0x14, 0x19, 0x5f, 0x48, 0x3f, 0xb5, 0x7d, 0x1e, 0x08,
+	0x9a, 0xac, 0xa9, 0x49, 0xaf, 0x3b, 0x02, 0x7c, 0x01, 0x93, 0x44, 0xd1, 0x54, 0x64, 0xa8, 0x9a,
+	0x2a, 0xea, 0x83, 0x47, 0x17, 0xbd, 0xcf, 0x2d, 0x05, 0xad, 0x27, 0x84, 0xab, 0x23, 0xf0, 0x2c,
+	0xa6, 0xe4, 0x6a, 0x90, 0x87, 0x0f, 0x1c, 0xc5, 0x45, 0xa0, 0xf5, 0xf1, 0xb1, 0x23, 0x99, 0xad,
+	0x84, 0xac, 0xa1, 0x06, 0x6a, 0x97, 0x87, 0xb5, 0xd7, 0xe7, 0x6e, 0x25, 0xcb, 0x1d, 0xb8, 0xae,
+	0x64, 0x00, 0x63, 0x25, 0x79, 0xe0, 0x59, 0xb9, 0x51, 0x3b, 0xc3, 0xf8, 0xd6, 0xe6, 0xb3, 0xb9,
+	0x64, 0x13, 0xee, 0xd6, 0xf6, 0x1a, 0xa8, 0x7d, 0x60, 0x95, 0xb3, 0x93, 0x2b, 0xf7, 0xb2, 0xbf,
+	0x8e, 0x37, 0x9d, 0xdc, 0xfc, 0x10, 0x6f, 0x3a, 0xcd, 0xef, 0x61, 0x0b, 0x9e, 0xd1, 0x6a, 0xe2,
+	0xf3, 0x02, 0x64, 0x31, 0x08, 0x45, 0x00, 0xac, 0xbf, 0x46, 0x78, 0x7f, 0x04, 0x9e, 0xa6, 0x70,
+	0xe5, 0xcf, 0x49, 0x2e, 0x8c, 0xdf, 0xff, 0x67, 0x14, 0x44, 0xea, 0xbd, 0x7f, 0x5b, 0xf3, 0xdb,
+	0xf5, 0xc3, 0xfb, 0x78, 0xd3, 0x41, 0xc3, 0xd1, 0xcb, 0x8e, 0xa0, 0xed, 0x8e, 0xa0, 0xf7, 0x1d,
+	0x41, 0x8f, 0x11, 0x29, 0x6d, 0x23, 0x52, 0x7a, 0x8b, 0x48, 0xe9, 0xc6, 0xf4, 0xb8, 0xba, 0x9b,
+	0x4f, 0x0d, 0x47, 0xf8, 0x74, 0x9c, 0xa4, 0x77, 0xaf, 0xed, 0x29, 0xd0, 0xac, 0xef, 0x85, 0x69,
+	0xd2, 0xe5, 0xcf, 0xd6, 0x57, 0x21, 0x83, 0xe9, 0x51, 0x52, 0x90, 0xf9, 0x31, 0x00, 0xee, 0x7f,
+	0x49, 0xb4, 0x16, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Replays an autopilot action that failed after the transfer landed, using
+	// the tokens that were kept by the receiver
+	RetryAutopilotAction(ctx context.Context, in *MsgRetryAutopilotAction, opts ...grpc.CallOption) (*MsgRetryAutopilotActionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RetryAutopilotAction(ctx context.Context, in *MsgRetryAutopilotAction, opts ...grpc.CallOption) (*MsgRetryAutopilotActionResponse, error) {
+	out := new(MsgRetryAutopilotActionResponse)
+	err := c.cc.Invoke(ctx, "/stride.autopilot.Msg/RetryAutopilotAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Replays an autopilot action that failed after the transfer landed, using
+	// the tokens that were kept by the receiver
+	RetryAutopilotAction(context.Context, *MsgRetryAutopilotAction) (*MsgRetryAutopilotActionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RetryAutopilotAction(ctx context.Context, req *MsgRetryAutopilotAction) (*MsgRetryAutopilotActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryAutopilotAction not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RetryAutopilotAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryAutopilotAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryAutopilotAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.autopilot.Msg/RetryAutopilotAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryAutopilotAction(ctx, req.(*MsgRetryAutopilotAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stride.autopilot.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RetryAutopilotAction",
+			Handler:    _Msg_RetryAutopilotAction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stride/autopilot/tx.proto",
+}
+
+func (m *MsgRetryAutopilotAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryAutopilotAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryAutopilotAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailureId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FailureId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryAutopilotActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryAutopilotActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryAutopilotActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRetryAutopilotAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FailureId != 0 {
+		n += 1 + sovTx(uint64(m.FailureId))
+	}
+	return n
+}
+
+func (m *MsgRetryAutopilotActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRetryAutopilotAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryAutopilotAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryAutopilotAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureId", wireType)
+			}
+			m.FailureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryAutopilotActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryAutopilotActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryAutopilotActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,7 +8,6 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
-	ibctmtypes "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -36,80 +35,10 @@ var _ types.MsgServer = msgServer{}
 func (k msgServer) AddOracle(goCtx context.Context, msg *types.MsgAddOracle) (*types.MsgAddOracleResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Grab the connection and confirm it exists
-	controllerConnectionId := msg.ConnectionId
-	connectionEnd, found := k.ConnectionKeeper.GetConnection(ctx, controllerConnectionId)
-	if !found {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "connection (%s) not found", controllerConnectionId)
-	}
-
-	// Get chain id from the connection
-	clientState, found := k.ClientKeeper.GetClientState(ctx, connectionEnd.ClientId)
-	if !found {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "client (%s) not found", connectionEnd.ClientId)
-	}
-	client, ok := clientState.(*ibctmtypes.ClientState)
-	if !ok {
-		return nil, types.ErrClientStateNotTendermint
-	}
-	chainId := client.ChainId
-
-	// Confirm oracle was not already created
-	_, found = k.GetOracle(ctx, chainId)
-	if found {
-		return nil, types.ErrOracleAlreadyExists
-	}
-
-	// Create the oracle struct, marked as inactive
-	oracle := types.Oracle{
-		ChainId:      chainId,
-		ConnectionId: controllerConnectionId,
-		Active:       false,
-	}
-	k.SetOracle(ctx, oracle)
-
-	// Get the expected port ID for the ICA channel
-	owner := types.FormatICAAccountOwner(chainId, types.ICAAccountType_Oracle)
-	portID, err := icatypes.NewControllerPortID(owner)
-	if err != nil {
+	if err := k.Keeper.AddOracle(ctx, msg.ConnectionId); err != nil {
 		return nil, err
 	}
 
-	// Check if an ICA account has already been created for this oracle
-	// (in the event that an oracle was removed and then added back)
-	// If so, there's no need to register a new ICA
-	channelID, channelFound := k.ICAControllerKeeper.GetOpenActiveChannel(ctx, controllerConnectionId, portID)
-	icaAddress, icaFound := k.ICAControllerKeeper.GetInterchainAccountAddress(ctx, controllerConnectionId, portID)
-
-	if channelFound && icaFound {
-		oracle.IcaAddress = icaAddress
-		oracle.ChannelId = channelID
-		oracle.PortId = portID
-
-		k.SetOracle(ctx, oracle)
-
-		return &types.MsgAddOracleResponse{}, nil
-	}
-
-	// Get the corresponding connection on the host
-	hostConnectionId := connectionEnd.Counterparty.ConnectionId
-	if hostConnectionId == "" {
-		return nil, types.ErrHostConnectionNotFound
-	}
-
-	// Register the oracle interchain account
-	appVersion := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
-		Version:                icatypes.Version,
-		ControllerConnectionId: controllerConnectionId,
-		HostConnectionId:       hostConnectionId,
-		Encoding:               icatypes.EncodingProtobuf,
-		TxType:                 icatypes.TxTypeSDKMultiMsg,
-	}))
-
-	if err := k.ICAControllerKeeper.RegisterInterchainAccount(ctx, controllerConnectionId, owner, appVersion, channeltypes.ORDERED); err != nil {
-		return nil, errorsmod.Wrapf(err, "unable to register oracle interchain account")
-	}
-
 	return &types.MsgAddOracleResponse{}, nil
 }
 
@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
 
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/Stride-Labs/stride/v33/x/icaoracle/types"
 )
@@ -105,3 +108,82 @@ func (k Keeper) IsOracleICAChannelOpen(ctx sdk.Context, oracle types.Oracle) boo
 	}
 	return channel.State == channeltypes.OPEN
 }
+
+// Adds a new oracle as a destination for metric updates
+// Registers a new ICA account along this connection
+func (k Keeper) AddOracle(ctx sdk.Context, controllerConnectionId string) error {
+	// Grab the connection and confirm it exists
+	connectionEnd, found := k.ConnectionKeeper.GetConnection(ctx, controllerConnectionId)
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "connection (%s) not found", controllerConnectionId)
+	}
+
+	// Get chain id from the connection
+	clientState, found := k.ClientKeeper.GetClientState(ctx, connectionEnd.ClientId)
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "client (%s) not found", connectionEnd.ClientId)
+	}
+	client, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return types.ErrClientStateNotTendermint
+	}
+	chainId := client.ChainId
+
+	// Confirm oracle was not already created
+	_, found = k.GetOracle(ctx, chainId)
+	if found {
+		return types.ErrOracleAlreadyExists
+	}
+
+	// Create the oracle struct, marked as inactive
+	oracle := types.Oracle{
+		ChainId:      chainId,
+		ConnectionId: controllerConnectionId,
+		Active:       false,
+	}
+	k.SetOracle(ctx, oracle)
+
+	// Get the expected port ID for the ICA channel
+	owner := types.FormatICAAccountOwner(chainId, types.ICAAccountType_Oracle)
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	// Check if an ICA account has already been created for this oracle
+	// (in the event that an oracle was removed and then added back)
+	// If so, there's no need to register a new ICA
+	channelID, channelFound := k.ICAControllerKeeper.GetOpenActiveChannel(ctx, controllerConnectionId, portID)
+	icaAddress, icaFound := k.ICAControllerKeeper.GetInterchainAccountAddress(ctx, controllerConnectionId, portID)
+
+	if channelFound && icaFound {
+		oracle.IcaAddress = icaAddress
+		oracle.ChannelId = channelID
+		oracle.PortId = portID
+
+		k.SetOracle(ctx, oracle)
+
+		return nil
+	}
+
+	// Get the corresponding connection on the host
+	hostConnectionId := connectionEnd.Counterparty.ConnectionId
+	if hostConnectionId == "" {
+		return types.ErrHostConnectionNotFound
+	}
+
+	// Register the oracle interchain account
+	appVersion := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: controllerConnectionId,
+		HostConnectionId:       hostConnectionId,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	if err := k.ICAControllerKeeper.RegisterInterchainAccount(ctx, controllerConnectionId, owner, appVersion, channeltypes.ORDERED); err != nil {
+		return errorsmod.Wrapf(err, "unable to register oracle interchain account")
+	}
+
+	return nil
+}
@@ -31,6 +31,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListDepositRecordByHost())
 	cmd.AddCommand(CmdLSMDeposit())
 	cmd.AddCommand(CmdLSMDeposits())
+	cmd.AddCommand(CmdInstantRedemptionBufferRecords())
 
 	return cmd
 }
@@ -104,3 +104,30 @@ func CmdListDepositRecordByHost() *cobra.Command {
 
 	return cmd
 }
+
+func CmdInstantRedemptionBufferRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instant-redemption-buffer-records [host]",
+		Short: "list the instant redemption buffer records for a given host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hostZoneId := args[0]
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InstantRedemptionBufferRecords(context.Background(), &types.QueryInstantRedemptionBufferRecordsRequest{
+				HostZoneId: hostZoneId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
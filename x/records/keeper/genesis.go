@@ -34,6 +34,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, elem := range genState.LsmTokenDepositList {
 		k.SetLSMTokenDeposit(ctx, elem)
 	}
+
+	// Set all instant redemption buffer records
+	for _, elem := range genState.InstantRedemptionBufferRecordList {
+		k.SetInstantRedemptionBufferRecord(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -46,6 +51,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis.UserRedemptionRecordList = k.GetAllUserRedemptionRecord(ctx)
 	genesis.EpochUnbondingRecordList = k.GetAllEpochUnbondingRecord(ctx)
 	genesis.LsmTokenDepositList = k.GetAllLSMTokenDeposit(ctx)
+	genesis.InstantRedemptionBufferRecordList = k.GetAllInstantRedemptionBufferRecords(ctx)
 
 	return genesis
 }
@@ -63,6 +63,16 @@ func (s *KeeperTestSuite) TestGenesis() {
 				StToken:   sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.OneInt()),
 			},
 		},
+		InstantRedemptionBufferRecordList: []types.InstantRedemptionBufferRecord{
+			{
+				HostZoneId:     "chain-1",
+				EpochNumber:    1,
+				FundedAmount:   sdkmath.OneInt(),
+				RedeemedAmount: sdkmath.ZeroInt(),
+				FeeAmount:      sdkmath.ZeroInt(),
+				RefilledAmount: sdkmath.ZeroInt(),
+			},
+		},
 	}
 	s.App.RecordsKeeper.InitGenesis(s.Ctx, genesisState)
 	got := s.App.RecordsKeeper.ExportGenesis(s.Ctx)
@@ -73,4 +83,5 @@ func (s *KeeperTestSuite) TestGenesis() {
 	s.Require().ElementsMatch(genesisState.DepositRecordList, got.DepositRecordList)
 	s.Require().Equal(genesisState.DepositRecordCount, got.DepositRecordCount)
 	s.Require().ElementsMatch(genesisState.LsmTokenDepositList, got.LsmTokenDepositList)
+	s.Require().ElementsMatch(genesisState.InstantRedemptionBufferRecordList, got.InstantRedemptionBufferRecordList)
 }
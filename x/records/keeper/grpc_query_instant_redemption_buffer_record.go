@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/x/records/types"
+)
+
+func (k Keeper) InstantRedemptionBufferRecords(
+	c context.Context,
+	req *types.QueryInstantRedemptionBufferRecordsRequest,
+) (*types.QueryInstantRedemptionBufferRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records := k.GetInstantRedemptionBufferRecordsForHostZone(ctx, req.HostZoneId)
+
+	return &types.QueryInstantRedemptionBufferRecordsResponse{InstantRedemptionBufferRecords: records}, nil
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v33/x/records/types"
+)
+
+func (s *KeeperTestSuite) TestInstantRedemptionBufferRecord() {
+	// Store records across two host zones, where one chain ID is a prefix of the other
+	records := []types.InstantRedemptionBufferRecord{}
+	for _, chainId := range []string{"chain-1", "chain-10"} {
+		for _, epochNumber := range []uint64{2, 1} {
+			record := types.InstantRedemptionBufferRecord{
+				HostZoneId:     chainId,
+				EpochNumber:    epochNumber,
+				FundedAmount:   sdkmath.NewInt(int64(epochNumber)),
+				RedeemedAmount: sdkmath.ZeroInt(),
+				FeeAmount:      sdkmath.ZeroInt(),
+				RefilledAmount: sdkmath.ZeroInt(),
+			}
+			s.App.RecordsKeeper.SetInstantRedemptionBufferRecord(s.Ctx, record)
+			records = append(records, record)
+		}
+	}
+
+	// Check a lookup by host zone and epoch
+	record, found := s.App.RecordsKeeper.GetInstantRedemptionBufferRecord(s.Ctx, "chain-1", 2)
+	s.Require().True(found, "record should have been found")
+	s.Require().Equal(records[0], record, "record")
+
+	// A missing record should be returned with zero amounts
+	record, found = s.App.RecordsKeeper.GetInstantRedemptionBufferRecord(s.Ctx, "chain-1", 3)
+	s.Require().False(found, "record should not have been found")
+	s.Require().Zero(record.FundedAmount.Int64(), "missing record funded amount")
+	s.Require().Equal(uint64(3), record.EpochNumber, "missing record epoch")
+
+	// Records for a host zone should be ordered by epoch and exclude other host zones
+	hostZoneRecords := s.App.RecordsKeeper.GetInstantRedemptionBufferRecordsForHostZone(s.Ctx, "chain-1")
+	s.Require().Equal([]types.InstantRedemptionBufferRecord{records[1], records[0]}, hostZoneRecords, "chain-1 records")
+
+	s.Require().Len(s.App.RecordsKeeper.GetAllInstantRedemptionBufferRecords(s.Ctx), 4, "all records")
+
+	// Check the query
+	resp, err := s.App.RecordsKeeper.InstantRedemptionBufferRecords(s.Ctx, &types.QueryInstantRedemptionBufferRecordsRequest{
+		HostZoneId: "chain-10",
+	})
+	s.Require().NoError(err, "no error expected when querying records")
+	s.Require().Equal([]types.InstantRedemptionBufferRecord{records[3], records[2]}, resp.InstantRedemptionBufferRecords, "queried records")
+}
@@ -12,14 +12,15 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:                    DefaultParams(),
-		PortId:                    PortID,
-		UserRedemptionRecordList:  []UserRedemptionRecord{},
-		UserRedemptionRecordCount: 0,
-		EpochUnbondingRecordList:  []EpochUnbondingRecord{},
-		DepositRecordList:         []DepositRecord{},
-		DepositRecordCount:        0,
-		LsmTokenDepositList:       []LSMTokenDeposit{},
+		Params:                            DefaultParams(),
+		PortId:                            PortID,
+		UserRedemptionRecordList:          []UserRedemptionRecord{},
+		UserRedemptionRecordCount:         0,
+		EpochUnbondingRecordList:          []EpochUnbondingRecord{},
+		DepositRecordList:                 []DepositRecord{},
+		DepositRecordCount:                0,
+		LsmTokenDepositList:               []LSMTokenDeposit{},
+		InstantRedemptionBufferRecordList: []InstantRedemptionBufferRecord{},
 	}
 }
 
@@ -75,5 +76,15 @@ func (gs GenesisState) Validate() error {
 		lsmTokenDepositChainDenomMap[chainDenomId] = true
 	}
 
+	// Check for duplicate instant redemption buffer records
+	bufferRecordMap := make(map[string]bool)
+	for _, elem := range gs.InstantRedemptionBufferRecordList {
+		bufferRecordId := fmt.Sprintf("%s.%d", elem.HostZoneId, elem.EpochNumber)
+		if _, ok := bufferRecordMap[bufferRecordId]; ok {
+			return fmt.Errorf("duplicated instant redemption buffer record for %s", bufferRecordId)
+		}
+		bufferRecordMap[bufferRecordId] = true
+	}
+
 	return gs.Params.Validate()
 }
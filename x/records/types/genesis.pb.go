@@ -25,14 +25,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the records module's genesis state.
 type GenesisState struct {
-	Params                    Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId                    string                 `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	UserRedemptionRecordList  []UserRedemptionRecord `protobuf:"bytes,3,rep,name=user_redemption_record_list,json=userRedemptionRecordList,proto3" json:"user_redemption_record_list"`
-	UserRedemptionRecordCount uint64                 `protobuf:"varint,4,opt,name=user_redemption_record_count,json=userRedemptionRecordCount,proto3" json:"user_redemption_record_count,omitempty"`
-	EpochUnbondingRecordList  []EpochUnbondingRecord `protobuf:"bytes,5,rep,name=epoch_unbonding_record_list,json=epochUnbondingRecordList,proto3" json:"epoch_unbonding_record_list"`
-	DepositRecordList         []DepositRecord        `protobuf:"bytes,7,rep,name=deposit_record_list,json=depositRecordList,proto3" json:"deposit_record_list"`
-	DepositRecordCount        uint64                 `protobuf:"varint,8,opt,name=deposit_record_count,json=depositRecordCount,proto3" json:"deposit_record_count,omitempty"`
-	LsmTokenDepositList       []LSMTokenDeposit      `protobuf:"bytes,9,rep,name=lsm_token_deposit_list,json=lsmTokenDepositList,proto3" json:"lsm_token_deposit_list"`
+	Params                            Params                          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId                            string                          `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	UserRedemptionRecordList          []UserRedemptionRecord          `protobuf:"bytes,3,rep,name=user_redemption_record_list,json=userRedemptionRecordList,proto3" json:"user_redemption_record_list"`
+	UserRedemptionRecordCount         uint64                          `protobuf:"varint,4,opt,name=user_redemption_record_count,json=userRedemptionRecordCount,proto3" json:"user_redemption_record_count,omitempty"`
+	EpochUnbondingRecordList          []EpochUnbondingRecord          `protobuf:"bytes,5,rep,name=epoch_unbonding_record_list,json=epochUnbondingRecordList,proto3" json:"epoch_unbonding_record_list"`
+	DepositRecordList                 []DepositRecord                 `protobuf:"bytes,7,rep,name=deposit_record_list,json=depositRecordList,proto3" json:"deposit_record_list"`
+	DepositRecordCount                uint64                          `protobuf:"varint,8,opt,name=deposit_record_count,json=depositRecordCount,proto3" json:"deposit_record_count,omitempty"`
+	LsmTokenDepositList               []LSMTokenDeposit               `protobuf:"bytes,9,rep,name=lsm_token_deposit_list,json=lsmTokenDepositList,proto3" json:"lsm_token_deposit_list"`
+	InstantRedemptionBufferRecordList []InstantRedemptionBufferRecord `protobuf:"bytes,10,rep,name=instant_redemption_buffer_record_list,json=instantRedemptionBufferRecordList,proto3" json:"instant_redemption_buffer_record_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -124,6 +125,13 @@ func (m *GenesisState) GetLsmTokenDepositList() []LSMTokenDeposit {
 	return nil
 }
 
+func (m *GenesisState) GetInstantRedemptionBufferRecordList() []InstantRedemptionBufferRecord {
+	if m != nil {
+		return m.InstantRedemptionBufferRecordList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.records.GenesisState")
 }
@@ -131,34 +139,36 @@ func init() {
 func init() { proto.RegisterFile("stride/records/genesis.proto", fileDescriptor_98cfd0253c8b6797) }
 
 var fileDescriptor_98cfd0253c8b6797 = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0xae, 0x93, 0x40,
-	0x18, 0x85, 0xc1, 0x8b, 0xbd, 0xde, 0xb9, 0xc6, 0x44, 0xda, 0x54, 0x6c, 0x2b, 0x25, 0xc6, 0x05,
-	0x1b, 0x41, 0x5b, 0xf7, 0x26, 0x55, 0x63, 0x8c, 0x35, 0x31, 0x60, 0x37, 0xdd, 0x10, 0x60, 0x26,
-	0x74, 0x62, 0x61, 0xc8, 0xcc, 0x60, 0xf4, 0x2d, 0x7c, 0xac, 0x2e, 0xbb, 0xd3, 0x95, 0x31, 0xed,
-	0x8b, 0x18, 0x66, 0xa6, 0x4d, 0x21, 0xb8, 0x6a, 0x99, 0xf3, 0x9f, 0x73, 0xbe, 0x3f, 0xf9, 0xc1,
-	0x84, 0x71, 0x8a, 0x21, 0xf2, 0x29, 0x4a, 0x09, 0x85, 0xcc, 0xcf, 0x50, 0x81, 0x18, 0x66, 0x5e,
-	0x49, 0x09, 0x27, 0xe6, 0x03, 0xa9, 0x7a, 0x4a, 0x1d, 0x0d, 0x32, 0x92, 0x11, 0x21, 0xf9, 0xf5,
-	0x3f, 0x39, 0x35, 0x1a, 0xb7, 0x32, 0xca, 0x98, 0xc6, 0xb9, 0x8a, 0x18, 0xb5, 0x0b, 0xd4, 0xaf,
-	0x54, 0x9f, 0xfe, 0x32, 0xc0, 0xfd, 0xf7, 0xb2, 0x32, 0xe4, 0x31, 0x47, 0xe6, 0x2b, 0xd0, 0x93,
-	0x76, 0x4b, 0x77, 0x74, 0xf7, 0x76, 0x36, 0xf4, 0x9a, 0x08, 0xde, 0x67, 0xa1, 0x2e, 0x8c, 0xdd,
-	0x9f, 0xa9, 0x16, 0xa8, 0x59, 0xf3, 0x11, 0xb8, 0x2e, 0x09, 0xe5, 0x11, 0x86, 0xd6, 0x1d, 0x47,
-	0x77, 0x6f, 0x82, 0x5e, 0xfd, 0xf9, 0x01, 0x9a, 0x18, 0x8c, 0x2b, 0x86, 0x68, 0x44, 0x11, 0x44,
-	0x79, 0xc9, 0x31, 0x29, 0x22, 0x19, 0x14, 0x6d, 0x31, 0xe3, 0xd6, 0x95, 0x73, 0xe5, 0xde, 0xce,
-	0x9e, 0xb5, 0x3b, 0x56, 0x0c, 0xd1, 0xe0, 0xec, 0x08, 0xc4, 0xab, 0x6a, 0xb4, 0xaa, 0x0e, 0x6d,
-	0x89, 0x19, 0x37, 0x5f, 0x83, 0xc9, 0x7f, 0xaa, 0x52, 0x52, 0x15, 0xdc, 0x32, 0x1c, 0xdd, 0x35,
-	0x82, 0xc7, 0x5d, 0xfe, 0x37, 0xf5, 0x40, 0xcd, 0x8a, 0x4a, 0x92, 0x6e, 0xa2, 0xaa, 0x48, 0x48,
-	0x01, 0x71, 0x91, 0x35, 0x58, 0xef, 0x76, 0xb3, 0xbe, 0xab, 0x2d, 0xab, 0x93, 0xa3, 0xc9, 0x8a,
-	0x3a, 0x34, 0xc1, 0x1a, 0x82, 0x3e, 0x44, 0x25, 0x61, 0x98, 0x37, 0x2a, 0xae, 0x45, 0xc5, 0x93,
-	0x76, 0xc5, 0x5b, 0x39, 0xda, 0xc8, 0x7e, 0x08, 0x2f, 0x1f, 0x45, 0xe8, 0x0b, 0x30, 0x68, 0x85,
-	0xca, 0xc5, 0xef, 0x89, 0xc5, 0xcd, 0x86, 0x41, 0x6e, 0xbc, 0x06, 0xc3, 0x2d, 0xcb, 0x23, 0x4e,
-	0xbe, 0xa2, 0x22, 0x3a, 0x79, 0x05, 0xc9, 0x8d, 0x20, 0x99, 0xb6, 0x49, 0x96, 0xe1, 0xa7, 0x2f,
-	0xf5, 0xb0, 0x22, 0x52, 0x2c, 0xfd, 0x2d, 0xcb, 0x2f, 0x9f, 0x6b, 0x9a, 0xc5, 0xc7, 0xdd, 0xc1,
-	0xd6, 0xf7, 0x07, 0x5b, 0xff, 0x7b, 0xb0, 0xf5, 0x9f, 0x47, 0x5b, 0xdb, 0x1f, 0x6d, 0xed, 0xf7,
-	0xd1, 0xd6, 0xd6, 0x2f, 0x33, 0xcc, 0x37, 0x55, 0xe2, 0xa5, 0x24, 0xf7, 0x43, 0x91, 0xff, 0x7c,
-	0x19, 0x27, 0xcc, 0x57, 0x87, 0xfa, 0x6d, 0x3e, 0xf7, 0xbf, 0x9f, 0xcf, 0x95, 0xff, 0x28, 0x11,
-	0x4b, 0x7a, 0xe2, 0x5a, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x55, 0x5c, 0xbf, 0x2e,
-	0x03, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x63, 0xd6, 0x75, 0xcc, 0x43, 0x48, 0x64, 0xd3, 0x08, 0xdd, 0xc8, 0x02, 0x02, 0x29,
+	0x97, 0x25, 0xb0, 0x72, 0x47, 0x2a, 0x20, 0x34, 0x51, 0x24, 0x94, 0xb0, 0xcb, 0x2e, 0x51, 0x12,
+	0x7b, 0x99, 0x45, 0x63, 0x47, 0xb6, 0x83, 0xe0, 0x03, 0x70, 0xe7, 0x63, 0xed, 0xb8, 0x23, 0x27,
+	0x40, 0xed, 0x17, 0x41, 0xb1, 0xbd, 0xb6, 0x89, 0xc2, 0x4e, 0x49, 0xfc, 0x7f, 0xff, 0xf7, 0xff,
+	0xbd, 0x58, 0x0f, 0x1e, 0x0a, 0xc9, 0x09, 0xc2, 0x21, 0xc7, 0x39, 0xe3, 0x48, 0x84, 0x05, 0xa6,
+	0x58, 0x10, 0x11, 0x54, 0x9c, 0x49, 0x66, 0xdf, 0xd7, 0x6a, 0x60, 0xd4, 0xd1, 0x5e, 0xc1, 0x0a,
+	0xa6, 0xa4, 0xb0, 0x79, 0xd3, 0x55, 0xa3, 0x83, 0x4e, 0x8f, 0x2a, 0xe5, 0x69, 0x69, 0x5a, 0x8c,
+	0xba, 0x01, 0xe6, 0xa9, 0xd5, 0xa7, 0x7f, 0x36, 0xe1, 0xbd, 0xf7, 0x3a, 0x32, 0x96, 0xa9, 0xc4,
+	0xf6, 0x2b, 0x38, 0xd4, 0x76, 0x07, 0x78, 0xc0, 0xdf, 0x39, 0xd9, 0x0f, 0xda, 0x08, 0xc1, 0x27,
+	0xa5, 0x4e, 0x06, 0x57, 0xbf, 0x8f, 0xac, 0xc8, 0xd4, 0xda, 0x0f, 0xe1, 0x56, 0xc5, 0xb8, 0x4c,
+	0x08, 0x72, 0xee, 0x78, 0xc0, 0xdf, 0x8e, 0x86, 0xcd, 0xe7, 0x29, 0xb2, 0x09, 0x3c, 0xa8, 0x05,
+	0xe6, 0x09, 0xc7, 0x08, 0x97, 0x95, 0x24, 0x8c, 0x26, 0xba, 0x51, 0x32, 0x23, 0x42, 0x3a, 0x1b,
+	0xde, 0x86, 0xbf, 0x73, 0xf2, 0xac, 0x9b, 0x71, 0x26, 0x30, 0x8f, 0x96, 0x8e, 0x48, 0x9d, 0x9a,
+	0x44, 0xa7, 0xee, 0xd1, 0xa6, 0x44, 0x48, 0xfb, 0x35, 0x3c, 0xfc, 0x4f, 0x54, 0xce, 0x6a, 0x2a,
+	0x9d, 0x81, 0x07, 0xfc, 0x41, 0xf4, 0xa8, 0xcf, 0xff, 0xa6, 0x29, 0x68, 0x58, 0x71, 0xc5, 0xf2,
+	0xcb, 0xa4, 0xa6, 0x19, 0xa3, 0x88, 0xd0, 0xa2, 0xc5, 0xba, 0xd9, 0xcf, 0xfa, 0xae, 0xb1, 0x9c,
+	0xdd, 0x38, 0xda, 0xac, 0xb8, 0x47, 0x53, 0xac, 0x31, 0xdc, 0x45, 0xb8, 0x62, 0x82, 0xc8, 0x56,
+	0xc4, 0x96, 0x8a, 0x78, 0xdc, 0x8d, 0x78, 0xab, 0x4b, 0x5b, 0xbd, 0x1f, 0xa0, 0xf5, 0x43, 0xd5,
+	0xf4, 0x05, 0xdc, 0xeb, 0x34, 0xd5, 0x83, 0xdf, 0x55, 0x83, 0xdb, 0x2d, 0x83, 0x9e, 0xf8, 0x1c,
+	0xee, 0xcf, 0x44, 0x99, 0x48, 0xf6, 0x05, 0xd3, 0xe4, 0xc6, 0xab, 0x48, 0xb6, 0x15, 0xc9, 0x51,
+	0x97, 0x64, 0x1a, 0x7f, 0xfc, 0xdc, 0x14, 0x1b, 0x22, 0xc3, 0xb2, 0x3b, 0x13, 0xe5, 0xfa, 0xb1,
+	0xa2, 0xf9, 0x01, 0xe0, 0x73, 0x42, 0x85, 0x4c, 0xa9, 0x5c, 0xbf, 0x92, 0xac, 0xbe, 0xb8, 0x50,
+	0x97, 0xb4, 0x9a, 0x1a, 0xaa, 0xac, 0xe3, 0x6e, 0xd6, 0xa9, 0x36, 0xaf, 0xee, 0x6a, 0xa2, 0xac,
+	0xad, 0xbf, 0xf0, 0x84, 0xdc, 0x56, 0xd4, 0x70, 0x4c, 0x3e, 0x5c, 0xcd, 0x5d, 0x70, 0x3d, 0x77,
+	0xc1, 0xdf, 0xb9, 0x0b, 0x7e, 0x2e, 0x5c, 0xeb, 0x7a, 0xe1, 0x5a, 0xbf, 0x16, 0xae, 0x75, 0xfe,
+	0xb2, 0x20, 0xf2, 0xb2, 0xce, 0x82, 0x9c, 0x95, 0x61, 0xac, 0xb2, 0x8f, 0xa7, 0x69, 0x26, 0x42,
+	0xb3, 0x30, 0x5f, 0xc7, 0xe3, 0xf0, 0xdb, 0x72, 0x6d, 0xe4, 0xf7, 0x0a, 0x8b, 0x6c, 0xa8, 0xb6,
+	0x66, 0xfc, 0x6f, 0x00, 0xf9, 0x77, 0x92, 0x39, 0xb6, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -181,6 +191,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InstantRedemptionBufferRecordList) > 0 {
+		for iNdEx := len(m.InstantRedemptionBufferRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InstantRedemptionBufferRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.LsmTokenDepositList) > 0 {
 		for iNdEx := len(m.LsmTokenDepositList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -320,6 +344,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.InstantRedemptionBufferRecordList) > 0 {
+		for _, e := range m.InstantRedemptionBufferRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -597,6 +627,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionBufferRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstantRedemptionBufferRecordList = append(m.InstantRedemptionBufferRecordList, InstantRedemptionBufferRecord{})
+			if err := m.InstantRedemptionBufferRecordList[len(m.InstantRedemptionBufferRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
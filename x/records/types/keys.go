@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "records"
@@ -47,3 +49,17 @@ const (
 	DepositRecordCountKey        = "DepositRecord-count-"
 	LSMTokenDepositKey           = "LSMTokenDeposit"
 )
+
+const InstantRedemptionBufferRecordKey = "InstantRedemptionBufferRecord"
+
+// Builds the instant redemption buffer record prefix for a host zone as chainId + "/"
+func GetInstantRedemptionBufferRecordHostPrefix(chainId string) []byte {
+	return append([]byte(chainId), []byte("/")...)
+}
+
+// Builds the instant redemption buffer record key as chainId + "/" + epochNumber
+func GetInstantRedemptionBufferRecordKey(chainId string, epochNumber uint64) []byte {
+	epochBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBz, epochNumber)
+	return append(GetInstantRedemptionBufferRecordHostPrefix(chainId), epochBz...)
+}
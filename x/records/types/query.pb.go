@@ -1090,6 +1090,102 @@ func (m *QueryLSMDepositsResponse) GetDeposits() []LSMTokenDeposit {
 	return nil
 }
 
+type QueryInstantRedemptionBufferRecordsRequest struct {
+	HostZoneId string `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+}
+
+func (m *QueryInstantRedemptionBufferRecordsRequest) Reset() {
+	*m = QueryInstantRedemptionBufferRecordsRequest{}
+}
+func (m *QueryInstantRedemptionBufferRecordsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInstantRedemptionBufferRecordsRequest) ProtoMessage() {}
+func (*QueryInstantRedemptionBufferRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e7cc311be81f7b, []int{22}
+}
+func (m *QueryInstantRedemptionBufferRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInstantRedemptionBufferRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInstantRedemptionBufferRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInstantRedemptionBufferRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInstantRedemptionBufferRecordsRequest.Merge(m, src)
+}
+func (m *QueryInstantRedemptionBufferRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInstantRedemptionBufferRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInstantRedemptionBufferRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInstantRedemptionBufferRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryInstantRedemptionBufferRecordsRequest) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+type QueryInstantRedemptionBufferRecordsResponse struct {
+	InstantRedemptionBufferRecords []InstantRedemptionBufferRecord `protobuf:"bytes,1,rep,name=instant_redemption_buffer_records,json=instantRedemptionBufferRecords,proto3" json:"instant_redemption_buffer_records"`
+}
+
+func (m *QueryInstantRedemptionBufferRecordsResponse) Reset() {
+	*m = QueryInstantRedemptionBufferRecordsResponse{}
+}
+func (m *QueryInstantRedemptionBufferRecordsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInstantRedemptionBufferRecordsResponse) ProtoMessage() {}
+func (*QueryInstantRedemptionBufferRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e7cc311be81f7b, []int{23}
+}
+func (m *QueryInstantRedemptionBufferRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInstantRedemptionBufferRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInstantRedemptionBufferRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInstantRedemptionBufferRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInstantRedemptionBufferRecordsResponse.Merge(m, src)
+}
+func (m *QueryInstantRedemptionBufferRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInstantRedemptionBufferRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInstantRedemptionBufferRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInstantRedemptionBufferRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryInstantRedemptionBufferRecordsResponse) GetInstantRedemptionBufferRecords() []InstantRedemptionBufferRecord {
+	if m != nil {
+		return m.InstantRedemptionBufferRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "stride.records.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "stride.records.QueryParamsResponse")
@@ -1113,87 +1209,94 @@ func init() {
 	proto.RegisterType((*QueryLSMDepositResponse)(nil), "stride.records.QueryLSMDepositResponse")
 	proto.RegisterType((*QueryLSMDepositsRequest)(nil), "stride.records.QueryLSMDepositsRequest")
 	proto.RegisterType((*QueryLSMDepositsResponse)(nil), "stride.records.QueryLSMDepositsResponse")
+	proto.RegisterType((*QueryInstantRedemptionBufferRecordsRequest)(nil), "stride.records.QueryInstantRedemptionBufferRecordsRequest")
+	proto.RegisterType((*QueryInstantRedemptionBufferRecordsResponse)(nil), "stride.records.QueryInstantRedemptionBufferRecordsResponse")
 }
 
 func init() { proto.RegisterFile("stride/records/query.proto", fileDescriptor_25e7cc311be81f7b) }
 
 var fileDescriptor_25e7cc311be81f7b = []byte{
-	// 1191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xe6, 0x5f, 0x9b, 0x97, 0x36, 0x84, 0xa9, 0x95, 0x06, 0x37, 0x75, 0xd2, 0x4d, 0xd5,
-	0x3f, 0x49, 0xea, 0x21, 0x7f, 0x50, 0x45, 0x2b, 0x54, 0xb9, 0x81, 0x34, 0xa1, 0x69, 0x55, 0x1c,
-	0x7a, 0x89, 0x04, 0xdb, 0xb5, 0x77, 0xe2, 0xac, 0x62, 0xef, 0xb8, 0x3b, 0xeb, 0x08, 0x63, 0x7c,
-	0xe1, 0xc4, 0x11, 0xa9, 0xdf, 0x00, 0xc4, 0x37, 0xe0, 0x03, 0x20, 0x4e, 0x39, 0x70, 0x68, 0xc5,
-	0xa5, 0x27, 0x84, 0x12, 0x3e, 0x02, 0x67, 0x84, 0x3c, 0x33, 0xeb, 0x78, 0xed, 0xd9, 0xf5, 0x3a,
-	0x32, 0x07, 0x4e, 0xf1, 0xce, 0xfb, 0xf7, 0xfb, 0xcd, 0x7b, 0xf3, 0xde, 0x4c, 0x20, 0xc9, 0x3c,
-	0xd7, 0xb6, 0x08, 0x76, 0x49, 0x9e, 0xba, 0x16, 0xc3, 0x2f, 0x2b, 0xc4, 0xad, 0xa6, 0xcb, 0x2e,
-	0xf5, 0x28, 0x9a, 0x10, 0xb2, 0xb4, 0x94, 0x25, 0x17, 0xf2, 0x94, 0x95, 0x28, 0xc3, 0x39, 0x93,
-	0x11, 0xa1, 0x88, 0x0f, 0x97, 0x73, 0xc4, 0x33, 0x97, 0x71, 0xd9, 0x2c, 0xd8, 0x8e, 0xe9, 0xd9,
-	0xd4, 0x11, 0xb6, 0xc9, 0x44, 0x81, 0x16, 0x28, 0xff, 0x89, 0x1b, 0xbf, 0xe4, 0xea, 0x4c, 0x81,
-	0xd2, 0x42, 0x91, 0x60, 0xb3, 0x6c, 0x63, 0xd3, 0x71, 0xa8, 0xc7, 0x4d, 0x98, 0x94, 0x5e, 0x69,
-	0xc3, 0x52, 0x36, 0x5d, 0xb3, 0xe4, 0x0b, 0x67, 0xda, 0x84, 0xf2, 0xaf, 0x90, 0xea, 0x09, 0x40,
-	0x9f, 0x35, 0x00, 0x3d, 0xe3, 0x26, 0x59, 0xf2, 0xb2, 0x42, 0x98, 0xa7, 0x3f, 0x86, 0x4b, 0x81,
-	0x55, 0x56, 0xa6, 0x0e, 0x23, 0x68, 0x0d, 0x46, 0x85, 0xeb, 0x69, 0x6d, 0x4e, 0xbb, 0x35, 0xbe,
-	0x32, 0x95, 0x0e, 0x12, 0x4d, 0x0b, 0xfd, 0x87, 0xc3, 0x47, 0x7f, 0xcc, 0x0e, 0x64, 0xa5, 0xae,
-	0x9e, 0x86, 0x19, 0xee, 0xec, 0x11, 0xf1, 0x3e, 0x26, 0x65, 0xca, 0x6c, 0x2f, 0xcb, 0xd5, 0x65,
-	0x30, 0x34, 0x01, 0x83, 0xb6, 0xc5, 0x3d, 0x0e, 0x67, 0x07, 0x6d, 0x4b, 0x3f, 0x80, 0xab, 0x21,
-	0xfa, 0x12, 0xc6, 0xa7, 0x30, 0x61, 0x09, 0x81, 0x21, 0x02, 0x4b, 0x38, 0x57, 0xdb, 0xe1, 0x04,
-	0xcc, 0x25, 0xaa, 0x8b, 0x56, 0xeb, 0xa2, 0xbe, 0x27, 0xc1, 0x65, 0x8a, 0x45, 0x25, 0xb8, 0x0d,
-	0x80, 0xd3, 0x14, 0xc9, 0x38, 0x37, 0xd2, 0x22, 0x9f, 0xe9, 0x46, 0x3e, 0xd3, 0x22, 0xf1, 0x32,
-	0x9f, 0xe9, 0x67, 0x66, 0x81, 0x48, 0xdb, 0x6c, 0x8b, 0xa5, 0xfe, 0xb3, 0x26, 0x59, 0x75, 0x06,
-	0x8a, 0x60, 0x35, 0x74, 0x36, 0x56, 0xe8, 0x51, 0x00, 0xf5, 0x20, 0x47, 0x7d, 0xb3, 0x2b, 0x6a,
-	0x01, 0x24, 0x00, 0x7b, 0x1d, 0x66, 0x39, 0xea, 0x60, 0xcc, 0xea, 0x26, 0x65, 0x9e, 0xbf, 0x43,
-	0x73, 0x70, 0x61, 0x9f, 0x32, 0xcf, 0xf8, 0x9a, 0x3a, 0xc4, 0x90, 0x89, 0x1c, 0xcb, 0x42, 0x63,
-	0x6d, 0x97, 0x3a, 0x64, 0xcb, 0xd2, 0x1d, 0x98, 0x0b, 0x77, 0xd2, 0x7f, 0xf6, 0xfa, 0x07, 0x30,
-	0xef, 0x17, 0xd0, 0x73, 0x46, 0xdc, 0x2c, 0xb1, 0x48, 0xa9, 0xdc, 0xa0, 0x13, 0x56, 0x77, 0x63,
-	0xbc, 0xee, 0xbe, 0xd3, 0xe0, 0x7a, 0xb4, 0x9d, 0xc4, 0xfa, 0x02, 0xa6, 0x2a, 0x8c, 0xb8, 0x86,
-	0xdb, 0x54, 0x08, 0xd6, 0xe1, 0xf5, 0x76, 0xcc, 0x2a, 0x6f, 0x12, 0x7a, 0xa2, 0xa2, 0x90, 0xe9,
-	0x25, 0xc9, 0x20, 0x53, 0x2c, 0x46, 0x31, 0xe8, 0x57, 0x71, 0xbe, 0xf1, 0x99, 0x87, 0xc6, 0x8b,
-	0xc1, 0x7c, 0xa8, 0x1f, 0xcc, 0xfb, 0x57, 0xb9, 0x6f, 0x34, 0x58, 0x88, 0xe2, 0xb4, 0x41, 0x5d,
-	0xb1, 0x2c, 0xb6, 0xf2, 0x3d, 0x38, 0x9f, 0xdf, 0x37, 0x6d, 0xe7, 0xb4, 0x82, 0xcf, 0xf1, 0xef,
-	0x2d, 0x0b, 0x4d, 0xc2, 0x90, 0x65, 0x56, 0x39, 0x96, 0xe1, 0x6c, 0xe3, 0x27, 0x9a, 0x86, 0x73,
-	0xa6, 0x65, 0xb9, 0x84, 0xb1, 0xe9, 0x21, 0xa1, 0x2b, 0x3f, 0x51, 0x02, 0x46, 0x8a, 0x76, 0xc9,
-	0xf6, 0xa6, 0x87, 0xb9, 0xb6, 0xf8, 0x68, 0xcb, 0xd3, 0xc8, 0x99, 0xf3, 0xf4, 0x56, 0x83, 0xc5,
-	0x58, 0x9c, 0xfe, 0x7f, 0xe9, 0xda, 0x3c, 0x3d, 0xb3, 0x9f, 0x94, 0x69, 0x7e, 0xff, 0xb9, 0x93,
-	0xa3, 0x8e, 0x65, 0x3b, 0x85, 0x60, 0xc5, 0x5f, 0x83, 0x0b, 0xa4, 0x21, 0x36, 0x9c, 0x4a, 0x29,
-	0x47, 0x5c, 0x39, 0x35, 0xc6, 0xf9, 0xda, 0x53, 0xbe, 0x14, 0x38, 0xc6, 0x6a, 0x57, 0xa7, 0xbb,
-	0x23, 0x7c, 0x55, 0x7c, 0x85, 0x2e, 0xc7, 0x58, 0xe5, 0xcd, 0xdf, 0x1d, 0xa2, 0x90, 0xb5, 0x1e,
-	0xe3, 0x28, 0x52, 0xff, 0xc5, 0x31, 0x3e, 0x33, 0xf3, 0xa1, 0x7e, 0x30, 0xef, 0x5f, 0x5d, 0x6c,
-	0xc1, 0x14, 0xa7, 0xb4, 0xbd, 0xf3, 0xa4, 0xd9, 0xf9, 0xbb, 0x9e, 0xd8, 0x04, 0x8c, 0x58, 0xc4,
-	0xa1, 0x25, 0x1e, 0x78, 0x2c, 0x2b, 0x3e, 0xf4, 0x5d, 0xb8, 0xdc, 0xe1, 0x4a, 0x6e, 0xc8, 0x03,
-	0x38, 0x27, 0x47, 0x88, 0xdc, 0xfe, 0xd9, 0xf6, 0x1d, 0xd8, 0xde, 0x79, 0xf2, 0x39, 0x3d, 0x20,
-	0x8e, 0xb4, 0x94, 0xe4, 0x7d, 0x2b, 0xbd, 0xda, 0xe1, 0x9b, 0xc5, 0xc0, 0xb9, 0x08, 0xef, 0x1e,
-	0x9a, 0x45, 0xdb, 0x32, 0x3d, 0xea, 0x1a, 0x7e, 0x47, 0x11, 0x98, 0x27, 0x9b, 0x82, 0x8c, 0x6c,
-	0x2d, 0x53, 0x30, 0xca, 0x3c, 0xd3, 0xab, 0xf8, 0x3d, 0x47, 0x7e, 0xe9, 0x5f, 0xc0, 0x74, 0x67,
-	0x68, 0xc9, 0x2b, 0x03, 0xe7, 0x25, 0x42, 0x26, 0x53, 0x1b, 0x93, 0x58, 0xd3, 0x6c, 0xe5, 0xef,
-	0x77, 0x60, 0x84, 0xfb, 0x47, 0xdf, 0xc0, 0xa8, 0xb8, 0xdf, 0x21, 0xbd, 0xdd, 0x49, 0xe7, 0x15,
-	0x32, 0x39, 0x1f, 0xa9, 0x23, 0xf0, 0xe9, 0xb7, 0xbf, 0xfd, 0xfd, 0xaf, 0x57, 0x83, 0xf3, 0xe8,
-	0x1a, 0xde, 0xe1, 0xca, 0xdb, 0x66, 0x8e, 0x61, 0xe5, 0x65, 0x16, 0xfd, 0xaa, 0x41, 0x42, 0xd5,
-	0x9e, 0xd0, 0xaa, 0x32, 0x50, 0xf4, 0xec, 0x4f, 0xae, 0xf5, 0x66, 0x24, 0xe1, 0x3e, 0xe0, 0x70,
-	0x3f, 0x44, 0x77, 0x25, 0xdc, 0x3b, 0x2a, 0xbc, 0xea, 0x8e, 0x8b, 0x6b, 0xb6, 0x55, 0x47, 0xbf,
-	0x68, 0x70, 0x59, 0x15, 0x21, 0x53, 0x2c, 0x86, 0xf0, 0x88, 0xbe, 0x01, 0x84, 0xf0, 0xe8, 0x32,
-	0xc6, 0xf5, 0x7b, 0x9c, 0xc7, 0x1a, 0x5a, 0xe9, 0x9d, 0x07, 0xfa, 0x47, 0x83, 0x2b, 0x11, 0xb3,
-	0x07, 0xdd, 0xeb, 0x05, 0x51, 0x70, 0x08, 0x27, 0xef, 0x9f, 0xc9, 0x56, 0x92, 0xda, 0xe3, 0xa4,
-	0x5e, 0xa0, 0x2f, 0x7b, 0x27, 0x65, 0xec, 0x51, 0xd7, 0x68, 0x88, 0x70, 0xcd, 0x3f, 0xaa, 0x75,
-	0x5c, 0xb3, 0xcc, 0x6a, 0x1d, 0xd7, 0xe4, 0xb1, 0xac, 0xe3, 0x1a, 0x9f, 0xe5, 0x75, 0xf4, 0x9b,
-	0x06, 0x09, 0x55, 0x3f, 0x0c, 0x2f, 0xc4, 0x88, 0xde, 0x1f, 0x5e, 0x88, 0x51, 0x0d, 0x5c, 0xdf,
-	0xe2, 0x5c, 0xd7, 0x51, 0x26, 0x8a, 0xab, 0xba, 0xc5, 0xe3, 0x5a, 0xeb, 0x00, 0x15, 0x25, 0xa9,
-	0x8a, 0x15, 0x59, 0x92, 0xbd, 0x33, 0xea, 0x32, 0x92, 0xe2, 0x95, 0xa4, 0x9a, 0x11, 0xfa, 0x49,
-	0x83, 0x8b, 0x81, 0x67, 0x01, 0x5a, 0x0a, 0xdb, 0x55, 0xd5, 0x1b, 0x2f, 0x79, 0x27, 0xa6, 0xb6,
-	0x84, 0x7a, 0x97, 0x43, 0x5d, 0x46, 0x38, 0x0a, 0x6a, 0xf0, 0x31, 0x23, 0x4e, 0xff, 0x8f, 0x1a,
-	0x4c, 0x06, 0x5c, 0x36, 0xf6, 0x78, 0x29, 0x6c, 0xbb, 0x7a, 0x80, 0x1a, 0xf6, 0xa6, 0xd4, 0x57,
-	0x38, 0xd4, 0x25, 0xb4, 0x10, 0x1f, 0x2a, 0x3a, 0xd2, 0xe0, 0x92, 0xe2, 0xa5, 0x86, 0xb0, 0x32,
-	0x74, 0xf8, 0xc3, 0x30, 0xf9, 0x7e, 0x7c, 0x03, 0x09, 0xf7, 0x29, 0x87, 0xbb, 0x89, 0x36, 0xe2,
-	0xc3, 0x35, 0x72, 0x55, 0xa3, 0xf9, 0xfc, 0xc4, 0xb5, 0xd6, 0x97, 0x68, 0x1d, 0xfd, 0xa0, 0x01,
-	0x9c, 0x8e, 0x45, 0x74, 0x43, 0x09, 0xa8, 0xe3, 0x66, 0x91, 0xbc, 0xd9, 0x55, 0x4f, 0xe2, 0x5d,
-	0xe7, 0x78, 0x3f, 0x42, 0xf7, 0x55, 0x78, 0x99, 0x67, 0x1e, 0x10, 0x3b, 0x97, 0xc7, 0x45, 0x56,
-	0x32, 0x24, 0xe8, 0x60, 0x7f, 0x69, 0xdc, 0x4a, 0xea, 0xe8, 0x95, 0x06, 0xe3, 0x2d, 0xb3, 0x1b,
-	0x75, 0x8b, 0xde, 0x9c, 0xb0, 0xb7, 0xba, 0x2b, 0x4a, 0x9c, 0xcb, 0x1c, 0xe7, 0x22, 0xba, 0x1d,
-	0x17, 0x27, 0x7b, 0xf8, 0xf8, 0xe8, 0x38, 0xa5, 0xbd, 0x3e, 0x4e, 0x69, 0x7f, 0x1e, 0xa7, 0xb4,
-	0xef, 0x4f, 0x52, 0x03, 0xaf, 0x4f, 0x52, 0x03, 0x6f, 0x4f, 0x52, 0x03, 0xbb, 0xcb, 0x05, 0xdb,
-	0xdb, 0xaf, 0xe4, 0xd2, 0x79, 0x5a, 0x52, 0xb9, 0x3b, 0x5c, 0x5d, 0xc5, 0x5f, 0x35, 0x93, 0xe5,
-	0x55, 0xcb, 0x84, 0xe5, 0x46, 0xf9, 0xff, 0x9a, 0x56, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x44,
-	0x93, 0x29, 0x44, 0x34, 0x13, 0x00, 0x00,
+	// 1277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x4f, 0x1b, 0x47,
+	0x14, 0x67, 0xf9, 0x4a, 0x78, 0x24, 0x88, 0x4c, 0x2c, 0x42, 0x37, 0x60, 0x60, 0x89, 0xf2, 0xc1,
+	0x87, 0xb7, 0x7c, 0x54, 0x51, 0x83, 0xaa, 0xc8, 0xd0, 0x12, 0x68, 0x08, 0x4a, 0x4d, 0x72, 0x41,
+	0x6a, 0x9d, 0xb5, 0x77, 0x30, 0x2b, 0xec, 0x1d, 0x67, 0x67, 0x8d, 0xea, 0xba, 0xee, 0xa1, 0xa7,
+	0x1e, 0x2b, 0xe5, 0x3f, 0x68, 0xd5, 0x63, 0x6f, 0xfd, 0x03, 0xaa, 0x9e, 0x38, 0xf4, 0x90, 0xa8,
+	0x97, 0x9c, 0xaa, 0x0a, 0xaa, 0x5e, 0xfb, 0x1f, 0x54, 0x95, 0x67, 0x66, 0x6d, 0xaf, 0x99, 0x5d,
+	0xaf, 0x91, 0x7b, 0xe8, 0x09, 0xef, 0xbc, 0xaf, 0xdf, 0x6f, 0xe6, 0xcd, 0x7b, 0x6f, 0x00, 0x95,
+	0xba, 0x8e, 0x65, 0x62, 0xdd, 0xc1, 0x59, 0xe2, 0x98, 0x54, 0x7f, 0x59, 0xc2, 0x4e, 0x39, 0x51,
+	0x74, 0x88, 0x4b, 0xd0, 0x08, 0x97, 0x25, 0x84, 0x4c, 0x9d, 0xcb, 0x12, 0x5a, 0x20, 0x54, 0xcf,
+	0x18, 0x14, 0x73, 0x45, 0xfd, 0x78, 0x29, 0x83, 0x5d, 0x63, 0x49, 0x2f, 0x1a, 0x39, 0xcb, 0x36,
+	0x5c, 0x8b, 0xd8, 0xdc, 0x56, 0x8d, 0xe5, 0x48, 0x8e, 0xb0, 0x9f, 0x7a, 0xed, 0x97, 0x58, 0x9d,
+	0xc8, 0x11, 0x92, 0xcb, 0x63, 0xdd, 0x28, 0x5a, 0xba, 0x61, 0xdb, 0xc4, 0x65, 0x26, 0x54, 0x48,
+	0x6f, 0xb6, 0x60, 0x29, 0x1a, 0x8e, 0x51, 0xf0, 0x84, 0x13, 0x2d, 0x42, 0xf1, 0x97, 0x4b, 0xb5,
+	0x18, 0xa0, 0x4f, 0x6a, 0x80, 0x9e, 0x32, 0x93, 0x14, 0x7e, 0x59, 0xc2, 0xd4, 0xd5, 0x1e, 0xc3,
+	0x75, 0xdf, 0x2a, 0x2d, 0x12, 0x9b, 0x62, 0xb4, 0x0a, 0x83, 0xdc, 0xf5, 0xb8, 0x32, 0xad, 0xdc,
+	0x1d, 0x5e, 0x1e, 0x4b, 0xf8, 0x89, 0x26, 0xb8, 0xfe, 0x7a, 0xff, 0xc9, 0xef, 0x53, 0x3d, 0x29,
+	0xa1, 0xab, 0x25, 0x60, 0x82, 0x39, 0x7b, 0x84, 0xdd, 0x0f, 0x71, 0x91, 0x50, 0xcb, 0x4d, 0x31,
+	0x75, 0x11, 0x0c, 0x8d, 0x40, 0xaf, 0x65, 0x32, 0x8f, 0xfd, 0xa9, 0x5e, 0xcb, 0xd4, 0x8e, 0x60,
+	0x32, 0x40, 0x5f, 0xc0, 0xf8, 0x18, 0x46, 0x4c, 0x2e, 0x48, 0xf3, 0xc0, 0x02, 0xce, 0x64, 0x2b,
+	0x1c, 0x9f, 0xb9, 0x40, 0x75, 0xd5, 0x6c, 0x5e, 0xd4, 0x0e, 0x04, 0xb8, 0x64, 0x3e, 0x2f, 0x05,
+	0xb7, 0x09, 0xd0, 0x38, 0x22, 0x11, 0xe7, 0x76, 0x82, 0x9f, 0x67, 0xa2, 0x76, 0x9e, 0x09, 0x7e,
+	0xf0, 0xe2, 0x3c, 0x13, 0x4f, 0x8d, 0x1c, 0x16, 0xb6, 0xa9, 0x26, 0x4b, 0xed, 0x27, 0x05, 0x26,
+	0x03, 0x02, 0x85, 0xb0, 0xea, 0xbb, 0x18, 0x2b, 0xf4, 0xc8, 0x87, 0xba, 0x97, 0xa1, 0xbe, 0xd3,
+	0x16, 0x35, 0x07, 0xe2, 0x83, 0xbd, 0x01, 0x53, 0x0c, 0xb5, 0x3f, 0x66, 0x79, 0x8b, 0x50, 0xd7,
+	0xdb, 0xa1, 0x69, 0xb8, 0x72, 0x48, 0xa8, 0x9b, 0xfe, 0x82, 0xd8, 0x38, 0x2d, 0x0e, 0x72, 0x28,
+	0x05, 0xb5, 0xb5, 0x7d, 0x62, 0xe3, 0x6d, 0x53, 0xb3, 0x61, 0x3a, 0xd8, 0x49, 0xf7, 0xd9, 0x6b,
+	0xef, 0xc1, 0xac, 0x97, 0x40, 0xcf, 0x29, 0x76, 0x52, 0xd8, 0xc4, 0x85, 0x62, 0x8d, 0x4e, 0x50,
+	0xde, 0x0d, 0xb1, 0xbc, 0xfb, 0x46, 0x81, 0x5b, 0xe1, 0x76, 0x02, 0xeb, 0x0b, 0x18, 0x2b, 0x51,
+	0xec, 0xa4, 0x9d, 0xba, 0x82, 0x3f, 0x0f, 0x6f, 0xb5, 0x62, 0x96, 0x79, 0x13, 0xd0, 0x63, 0x25,
+	0x89, 0x4c, 0x2b, 0x08, 0x06, 0xc9, 0x7c, 0x3e, 0x8c, 0x41, 0xb7, 0x92, 0xf3, 0x8d, 0xc7, 0x3c,
+	0x30, 0x5e, 0x04, 0xe6, 0x7d, 0xdd, 0x60, 0xde, 0xbd, 0xcc, 0x7d, 0xa3, 0xc0, 0x5c, 0x18, 0xa7,
+	0x4d, 0xe2, 0xf0, 0x65, 0xbe, 0x95, 0xef, 0xc0, 0xe5, 0xec, 0xa1, 0x61, 0xd9, 0x8d, 0x0c, 0xbe,
+	0xc4, 0xbe, 0xb7, 0x4d, 0x34, 0x0a, 0x7d, 0xa6, 0x51, 0x66, 0x58, 0xfa, 0x53, 0xb5, 0x9f, 0x68,
+	0x1c, 0x2e, 0x19, 0xa6, 0xe9, 0x60, 0x4a, 0xc7, 0xfb, 0xb8, 0xae, 0xf8, 0x44, 0x31, 0x18, 0xc8,
+	0x5b, 0x05, 0xcb, 0x1d, 0xef, 0x67, 0xda, 0xfc, 0xa3, 0xe5, 0x9c, 0x06, 0x2e, 0x7c, 0x4e, 0x6f,
+	0x15, 0x98, 0x8f, 0xc4, 0xe9, 0xff, 0x77, 0x5c, 0x5b, 0x8d, 0x3b, 0xfb, 0x51, 0x91, 0x64, 0x0f,
+	0x9f, 0xdb, 0x19, 0x62, 0x9b, 0x96, 0x9d, 0xf3, 0x67, 0xfc, 0x0c, 0x5c, 0xc1, 0x35, 0x71, 0xda,
+	0x2e, 0x15, 0x32, 0xd8, 0x11, 0x5d, 0x63, 0x98, 0xad, 0xed, 0xb2, 0x25, 0xdf, 0x35, 0x96, 0xbb,
+	0x6a, 0xec, 0x0e, 0xf7, 0x55, 0xf2, 0x14, 0xda, 0x5c, 0x63, 0x99, 0x37, 0x6f, 0x77, 0xb0, 0x44,
+	0xd6, 0x7c, 0x8d, 0xc3, 0x48, 0xfd, 0x17, 0xd7, 0xf8, 0xc2, 0xcc, 0xfb, 0xba, 0xc1, 0xbc, 0x7b,
+	0x79, 0xb1, 0x0d, 0x63, 0x8c, 0xd2, 0xce, 0xde, 0x93, 0x7a, 0xe5, 0x6f, 0x7b, 0x63, 0x63, 0x30,
+	0x60, 0x62, 0x9b, 0x14, 0x58, 0xe0, 0xa1, 0x14, 0xff, 0xd0, 0xf6, 0xe1, 0xc6, 0x39, 0x57, 0x62,
+	0x43, 0x1e, 0xc2, 0x25, 0xd1, 0x42, 0xc4, 0xf6, 0x4f, 0xb5, 0xee, 0xc0, 0xce, 0xde, 0x93, 0x67,
+	0xe4, 0x08, 0xdb, 0xc2, 0x52, 0x90, 0xf7, 0xac, 0xb4, 0xf2, 0x39, 0xdf, 0x34, 0x02, 0xce, 0x79,
+	0xb8, 0x76, 0x6c, 0xe4, 0x2d, 0xd3, 0x70, 0x89, 0x93, 0xf6, 0x2a, 0x0a, 0xc7, 0x3c, 0x5a, 0x17,
+	0x24, 0xf9, 0x3a, 0x1a, 0x83, 0x41, 0xea, 0x1a, 0x6e, 0xc9, 0xab, 0x39, 0xe2, 0x4b, 0xfb, 0x14,
+	0xc6, 0xcf, 0x87, 0x16, 0xbc, 0x92, 0x70, 0x59, 0x20, 0xa4, 0xe2, 0x68, 0x23, 0x12, 0xab, 0x9b,
+	0x69, 0xbb, 0xa2, 0x8c, 0x6e, 0xdb, 0xd4, 0x35, 0x6c, 0xb7, 0x51, 0x01, 0xd6, 0x4b, 0x07, 0x07,
+	0xd8, 0xe1, 0xe7, 0x4d, 0xa3, 0x0f, 0x03, 0x3f, 0x7a, 0x35, 0xac, 0x9d, 0x43, 0x41, 0xe1, 0x2b,
+	0x98, 0xb1, 0xb8, 0x66, 0x73, 0x19, 0xcb, 0x30, 0x5d, 0x91, 0xb5, 0x1e, 0xb7, 0xc5, 0x56, 0x6e,
+	0xa1, 0x21, 0x04, 0xd3, 0xb8, 0x15, 0x8a, 0x63, 0xf9, 0xaf, 0x6b, 0x30, 0xc0, 0xf0, 0xa2, 0x2f,
+	0x61, 0x90, 0xcf, 0xb7, 0x48, 0x6b, 0x0d, 0x74, 0x7e, 0x84, 0x56, 0x67, 0x43, 0x75, 0x38, 0x39,
+	0xed, 0xde, 0xd7, 0xbf, 0xfd, 0xf9, 0xaa, 0x77, 0x16, 0xcd, 0xe8, 0x7b, 0x4c, 0x79, 0xc7, 0xc8,
+	0x50, 0x5d, 0x3a, 0xcc, 0xa3, 0x5f, 0x14, 0x88, 0xc9, 0xca, 0x33, 0x5a, 0x91, 0x06, 0x0a, 0x9f,
+	0x7d, 0xd4, 0xd5, 0xce, 0x8c, 0x04, 0xdc, 0x87, 0x0c, 0xee, 0xfb, 0xe8, 0xbe, 0x80, 0xbb, 0x28,
+	0xc3, 0x2b, 0xef, 0x38, 0x7a, 0xc5, 0x32, 0xab, 0xe8, 0x67, 0x05, 0x6e, 0xc8, 0x22, 0x24, 0xf3,
+	0xf9, 0x00, 0x1e, 0xe1, 0x13, 0x90, 0xba, 0xda, 0x99, 0x91, 0xe0, 0xf1, 0x80, 0xf1, 0x58, 0x45,
+	0xcb, 0x9d, 0xf3, 0x40, 0xff, 0x28, 0x70, 0x33, 0xa4, 0xf7, 0xa2, 0x07, 0x9d, 0x20, 0xf2, 0x0f,
+	0x21, 0xea, 0xda, 0x85, 0x6c, 0x05, 0xa9, 0x03, 0x46, 0xea, 0x05, 0xfa, 0xac, 0x73, 0x52, 0xe9,
+	0x03, 0xe2, 0xa4, 0x6b, 0x22, 0xbd, 0xe2, 0x95, 0xaa, 0xaa, 0x5e, 0x31, 0x8d, 0x72, 0x55, 0xaf,
+	0x88, 0xb2, 0x54, 0xd5, 0x2b, 0x6c, 0x96, 0xa9, 0xa2, 0x5f, 0x15, 0x88, 0xc9, 0xfa, 0x41, 0x70,
+	0x22, 0x86, 0xf4, 0x3e, 0x75, 0xb5, 0x33, 0x23, 0xc1, 0x75, 0x9b, 0x71, 0xdd, 0x40, 0xc9, 0x30,
+	0xae, 0xf2, 0x16, 0xa7, 0x57, 0x9a, 0x07, 0x08, 0x9e, 0x92, 0xb2, 0x58, 0xa1, 0x29, 0xd9, 0x39,
+	0xa3, 0x36, 0x2d, 0x39, 0x5a, 0x4a, 0xca, 0x19, 0xa1, 0x1f, 0x14, 0xb8, 0xea, 0x7b, 0x16, 0xa1,
+	0x85, 0xa0, 0x5d, 0x95, 0xbd, 0x71, 0xd5, 0xc5, 0x88, 0xda, 0x02, 0xea, 0x7d, 0x06, 0x75, 0x09,
+	0xe9, 0x61, 0x50, 0xfd, 0x8f, 0x39, 0x7e, 0xfb, 0xbf, 0x57, 0x60, 0xd4, 0xe7, 0xb2, 0xb6, 0xc7,
+	0x0b, 0x41, 0xdb, 0xd5, 0x01, 0xd4, 0xa0, 0x37, 0xb5, 0xb6, 0xcc, 0xa0, 0x2e, 0xa0, 0xb9, 0xe8,
+	0x50, 0xd1, 0x89, 0x02, 0xd7, 0x25, 0x2f, 0x55, 0xa4, 0x4b, 0x43, 0x07, 0x3f, 0x8c, 0xd5, 0x77,
+	0xa3, 0x1b, 0x08, 0xb8, 0xbb, 0x0c, 0xee, 0x16, 0xda, 0x8c, 0x0e, 0x37, 0x9d, 0x29, 0xa7, 0xeb,
+	0x1d, 0x57, 0xaf, 0x34, 0x37, 0xdf, 0x2a, 0xfa, 0x4e, 0x01, 0x68, 0x8c, 0x05, 0xe8, 0xb6, 0x14,
+	0xd0, 0xb9, 0xc9, 0x4a, 0xbd, 0xd3, 0x56, 0x4f, 0xe0, 0xdd, 0x60, 0x78, 0x3f, 0x40, 0x6b, 0x32,
+	0xbc, 0xd4, 0x35, 0x8e, 0xb0, 0x95, 0xc9, 0xea, 0x79, 0x5a, 0x48, 0x0b, 0xd0, 0xfe, 0xfa, 0x52,
+	0x9b, 0xca, 0xaa, 0xe8, 0x95, 0x02, 0xc3, 0x0d, 0xdf, 0x14, 0xb5, 0x8b, 0x5e, 0xef, 0xb0, 0x77,
+	0xdb, 0x2b, 0x0a, 0x9c, 0x4b, 0x0c, 0xe7, 0x3c, 0xba, 0x17, 0x15, 0x27, 0x45, 0x7f, 0x2b, 0x10,
+	0x0f, 0x9f, 0x50, 0x02, 0x2a, 0x7d, 0xa4, 0x39, 0x49, 0x5d, 0xbb, 0x90, 0xad, 0xa0, 0xf3, 0x8c,
+	0xd1, 0xd9, 0x45, 0x3b, 0x61, 0x69, 0xd2, 0x76, 0x68, 0x6a, 0x49, 0x96, 0xf5, 0xc7, 0x27, 0xa7,
+	0x71, 0xe5, 0xf5, 0x69, 0x5c, 0xf9, 0xe3, 0x34, 0xae, 0x7c, 0x7b, 0x16, 0xef, 0x79, 0x7d, 0x16,
+	0xef, 0x79, 0x7b, 0x16, 0xef, 0xd9, 0x5f, 0xca, 0x59, 0xee, 0x61, 0x29, 0x93, 0xc8, 0x92, 0x82,
+	0x2c, 0xe2, 0xf1, 0xca, 0x8a, 0xfe, 0x79, 0x3d, 0xae, 0x5b, 0x2e, 0x62, 0x9a, 0x19, 0x64, 0xff,
+	0x5d, 0x5c, 0xf9, 0x77, 0x00, 0x25, 0xf7, 0x3c, 0xf9, 0x26, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1232,6 +1335,8 @@ type QueryClient interface {
 	//   intended use:
 	//   ...stakeibc/lsm_deposits?chain_id=X&validator_address=Y&status=Z
 	LSMDeposits(ctx context.Context, in *QueryLSMDepositsRequest, opts ...grpc.CallOption) (*QueryLSMDepositsResponse, error)
+	// Queries the instant redemption buffer records for a given host zone
+	InstantRedemptionBufferRecords(ctx context.Context, in *QueryInstantRedemptionBufferRecordsRequest, opts ...grpc.CallOption) (*QueryInstantRedemptionBufferRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -1341,6 +1446,15 @@ func (c *queryClient) LSMDeposits(ctx context.Context, in *QueryLSMDepositsReque
 	return out, nil
 }
 
+func (c *queryClient) InstantRedemptionBufferRecords(ctx context.Context, in *QueryInstantRedemptionBufferRecordsRequest, opts ...grpc.CallOption) (*QueryInstantRedemptionBufferRecordsResponse, error) {
+	out := new(QueryInstantRedemptionBufferRecordsResponse)
+	err := c.cc.Invoke(ctx, "/stride.records.Query/InstantRedemptionBufferRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1367,6 +1481,8 @@ type QueryServer interface {
 	//   intended use:
 	//   ...stakeibc/lsm_deposits?chain_id=X&validator_address=Y&status=Z
 	LSMDeposits(context.Context, *QueryLSMDepositsRequest) (*QueryLSMDepositsResponse, error)
+	// Queries the instant redemption buffer records for a given host zone
+	InstantRedemptionBufferRecords(context.Context, *QueryInstantRedemptionBufferRecordsRequest) (*QueryInstantRedemptionBufferRecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1406,6 +1522,9 @@ func (*UnimplementedQueryServer) LSMDeposit(ctx context.Context, req *QueryLSMDe
 func (*UnimplementedQueryServer) LSMDeposits(ctx context.Context, req *QueryLSMDepositsRequest) (*QueryLSMDepositsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LSMDeposits not implemented")
 }
+func (*UnimplementedQueryServer) InstantRedemptionBufferRecords(ctx context.Context, req *QueryInstantRedemptionBufferRecordsRequest) (*QueryInstantRedemptionBufferRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantRedemptionBufferRecords not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1609,6 +1728,24 @@ func _Query_LSMDeposits_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InstantRedemptionBufferRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInstantRedemptionBufferRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InstantRedemptionBufferRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.records.Query/InstantRedemptionBufferRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InstantRedemptionBufferRecords(ctx, req.(*QueryInstantRedemptionBufferRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.records.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1657,6 +1794,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LSMDeposits",
 			Handler:    _Query_LSMDeposits_Handler,
 		},
+		{
+			MethodName: "InstantRedemptionBufferRecords",
+			Handler:    _Query_InstantRedemptionBufferRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/records/query.proto",
@@ -2481,6 +2622,73 @@ func (m *QueryLSMDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInstantRedemptionBufferRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstantRedemptionBufferRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstantRedemptionBufferRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstantRedemptionBufferRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstantRedemptionBufferRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstantRedemptionBufferRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InstantRedemptionBufferRecords) > 0 {
+		for iNdEx := len(m.InstantRedemptionBufferRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InstantRedemptionBufferRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2816,6 +3024,34 @@ func (m *QueryLSMDepositsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInstantRedemptionBufferRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInstantRedemptionBufferRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InstantRedemptionBufferRecords) > 0 {
+		for _, e := range m.InstantRedemptionBufferRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4943,6 +5179,172 @@ func (m *QueryLSMDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInstantRedemptionBufferRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstantRedemptionBufferRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstantRedemptionBufferRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstantRedemptionBufferRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstantRedemptionBufferRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstantRedemptionBufferRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionBufferRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstantRedemptionBufferRecords = append(m.InstantRedemptionBufferRecords, InstantRedemptionBufferRecord{})
+			if err := m.InstantRedemptionBufferRecords[len(m.InstantRedemptionBufferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
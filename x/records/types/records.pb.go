@@ -92,7 +92,9 @@ const (
 	HostZoneUnbonding_UNBONDING_QUEUE HostZoneUnbonding_Status = 0
 	// unbonding ICA has been submitted
 	HostZoneUnbonding_UNBONDING_IN_PROGRESS HostZoneUnbonding_Status = 3
-	// unbonding ICA failed for at least one batch and need to be retried
+	// unbonding ICA failed for at least one batch and need to be retried, or
+	// the record was only partially unbonded due to the host zone's unbond cap
+	// and the remainder is queued for the next unbonding epoch
 	HostZoneUnbonding_UNBONDING_RETRY_QUEUE HostZoneUnbonding_Status = 5
 	// unbonding completed on delegate account
 	HostZoneUnbonding_EXIT_TRANSFER_QUEUE HostZoneUnbonding_Status = 1
@@ -177,6 +179,15 @@ type UserRedemptionRecord struct {
 	EpochNumber       uint64                `protobuf:"varint,7,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 	ClaimIsPending    bool                  `protobuf:"varint,8,opt,name=claim_is_pending,json=claimIsPending,proto3" json:"claim_is_pending,omitempty"`
 	StTokenAmount     cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=st_token_amount,json=stTokenAmount,proto3,customtype=cosmossdk.io/math.Int" json:"st_token_amount"`
+	// Stride address that is permitted to transfer or cancel the record
+	// Only the owner can redeem into the record after it's created
+	// Empty for records created before ownership was tracked (and for instant
+	// redemption records), which can't be transferred or cancelled
+	Owner string `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty"`
+	// ID of the x/nft token that represents the record, if it's been tokenized
+	// While set, the record is owned by the holder of the NFT instead of the
+	// owner above
+	NftId string `protobuf:"bytes,11,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
 
 func (m *UserRedemptionRecord) Reset()         { *m = UserRedemptionRecord{} }
@@ -254,6 +265,20 @@ func (m *UserRedemptionRecord) GetClaimIsPending() bool {
 	return false
 }
 
+func (m *UserRedemptionRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *UserRedemptionRecord) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
 type DepositRecord struct {
 	Id                      uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Amount                  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
@@ -589,6 +614,69 @@ func (m *LSMTokenDeposit) GetStatus() LSMTokenDeposit_Status {
 	return LSMTokenDeposit_DEPOSIT_PENDING
 }
 
+// Tracks the movements in and out of a host zone's instant redemption buffer
+// during a day epoch, so the buffer balance can be reconciled against the
+// deposit and user redemption records from the same epoch
+type InstantRedemptionBufferRecord struct {
+	HostZoneId  string `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// Native tokens diverted from the epoch's deposit record into the buffer
+	FundedAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=funded_amount,json=fundedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"funded_amount"`
+	// Native tokens paid out from the buffer to instant redeemers
+	RedeemedAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=redeemed_amount,json=redeemedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"redeemed_amount"`
+	// Fees retained by the buffer from instant redemptions
+	FeeAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=fee_amount,json=feeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"fee_amount"`
+	// Native tokens credited back to the buffer from completed unbondings
+	RefilledAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=refilled_amount,json=refilledAmount,proto3,customtype=cosmossdk.io/math.Int" json:"refilled_amount"`
+}
+
+func (m *InstantRedemptionBufferRecord) Reset()         { *m = InstantRedemptionBufferRecord{} }
+func (m *InstantRedemptionBufferRecord) String() string { return proto.CompactTextString(m) }
+func (*InstantRedemptionBufferRecord) ProtoMessage()    {}
+func (*InstantRedemptionBufferRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_295ee594cc85d8ca, []int{5}
+}
+func (m *InstantRedemptionBufferRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstantRedemptionBufferRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstantRedemptionBufferRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InstantRedemptionBufferRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstantRedemptionBufferRecord.Merge(m, src)
+}
+func (m *InstantRedemptionBufferRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstantRedemptionBufferRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstantRedemptionBufferRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstantRedemptionBufferRecord proto.InternalMessageInfo
+
+func (m *InstantRedemptionBufferRecord) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *InstantRedemptionBufferRecord) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("stride.records.DepositRecord_Status", DepositRecord_Status_name, DepositRecord_Status_value)
 	proto.RegisterEnum("stride.records.DepositRecord_Source", DepositRecord_Source_name, DepositRecord_Source_value)
@@ -599,82 +687,90 @@ func init() {
 	proto.RegisterType((*HostZoneUnbonding)(nil), "stride.records.HostZoneUnbonding")
 	proto.RegisterType((*EpochUnbondingRecord)(nil), "stride.records.EpochUnbondingRecord")
 	proto.RegisterType((*LSMTokenDeposit)(nil), "stride.records.LSMTokenDeposit")
+	proto.RegisterType((*InstantRedemptionBufferRecord)(nil), "stride.records.InstantRedemptionBufferRecord")
 }
 
 func init() { proto.RegisterFile("stride/records/records.proto", fileDescriptor_295ee594cc85d8ca) }
 
 var fileDescriptor_295ee594cc85d8ca = []byte{
-	// 1117 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0xe2, 0x46,
-	0x14, 0x8f, 0xc1, 0x21, 0xf0, 0xb2, 0x21, 0x64, 0x42, 0x1a, 0x42, 0x37, 0x2c, 0x8b, 0xba, 0x15,
-	0x52, 0x55, 0xd3, 0x24, 0x6a, 0x0f, 0x6d, 0xd5, 0x16, 0x82, 0x37, 0xf1, 0x2e, 0x21, 0xa9, 0x31,
-	0xda, 0x36, 0x97, 0x91, 0xc1, 0x23, 0xb0, 0x12, 0x3c, 0xc8, 0x33, 0x8e, 0xd2, 0x7e, 0x8a, 0x4a,
-	0xed, 0xa9, 0xdf, 0xa3, 0xc7, 0xde, 0xf7, 0xb8, 0xb7, 0x56, 0x3d, 0xac, 0xaa, 0xe4, 0x8b, 0xac,
-	0x3c, 0x36, 0x06, 0x9c, 0xcd, 0x26, 0xbb, 0x27, 0xf0, 0xef, 0xfd, 0x99, 0x99, 0x37, 0xbf, 0xdf,
-	0x7b, 0x03, 0x0f, 0x19, 0x77, 0x6d, 0x8b, 0xd4, 0x5c, 0xd2, 0xa7, 0xae, 0xc5, 0x26, 0xbf, 0xca,
-	0xd8, 0xa5, 0x9c, 0xa2, 0x6c, 0x60, 0x55, 0x42, 0xb4, 0x58, 0xea, 0x53, 0x36, 0xa2, 0xac, 0xd6,
-	0x33, 0x19, 0xa9, 0x5d, 0xec, 0xf4, 0x08, 0x37, 0x77, 0x6a, 0x7d, 0x6a, 0x3b, 0x81, 0x7f, 0x31,
-	0x3f, 0xa0, 0x03, 0x2a, 0xfe, 0xd6, 0xfc, 0x7f, 0x01, 0x5a, 0xb9, 0x4e, 0x40, 0xbe, 0xcb, 0x88,
-	0xab, 0x13, 0x8b, 0x8c, 0xc6, 0xdc, 0xa6, 0x8e, 0x2e, 0xf2, 0xa1, 0x2c, 0x24, 0x6c, 0xab, 0x20,
-	0x95, 0xa5, 0x6a, 0x46, 0x4f, 0xd8, 0x16, 0x2a, 0x42, 0xda, 0x25, 0x7d, 0x62, 0x5f, 0x10, 0xb7,
-	0x90, 0x14, 0x68, 0xf4, 0x8d, 0x8e, 0x60, 0xdd, 0x31, 0xb9, 0x7d, 0x41, 0x30, 0xa7, 0x67, 0xc4,
-	0xc1, 0xe6, 0x88, 0x7a, 0x0e, 0x2f, 0xc8, 0xbe, 0x5b, 0x63, 0xfb, 0xe5, 0xeb, 0x47, 0x0b, 0xff,
-	0xbd, 0x7e, 0xb4, 0x11, 0xec, 0x8f, 0x59, 0x67, 0x8a, 0x4d, 0x6b, 0x23, 0x93, 0x0f, 0x15, 0xcd,
-	0xe1, 0xfa, 0x5a, 0x10, 0x69, 0xf8, 0x81, 0x75, 0x11, 0x87, 0xf2, 0xb0, 0x68, 0x11, 0x87, 0x8e,
-	0x0a, 0x8b, 0x62, 0x9d, 0xe0, 0x03, 0x95, 0xe1, 0xc1, 0x90, 0x32, 0x8e, 0x7f, 0xa5, 0x0e, 0xc1,
-	0xb6, 0x55, 0x48, 0x09, 0x23, 0xf8, 0xd8, 0x29, 0x75, 0x88, 0x66, 0xa1, 0xc7, 0xf0, 0x80, 0x8c,
-	0x69, 0x7f, 0x88, 0x1d, 0x6f, 0xd4, 0x23, 0x6e, 0x61, 0xa9, 0x2c, 0x55, 0x65, 0x7d, 0x59, 0x60,
-	0x6d, 0x01, 0xa1, 0x2a, 0xe4, 0xfa, 0xe7, 0xa6, 0x3d, 0xc2, 0x36, 0xc3, 0x63, 0xe2, 0x58, 0xb6,
-	0x33, 0x28, 0xa4, 0xcb, 0x52, 0x35, 0xad, 0x67, 0x05, 0xae, 0xb1, 0x93, 0x00, 0x45, 0x2a, 0xac,
-	0x32, 0x3e, 0x7f, 0x9e, 0xcc, 0x7d, 0xce, 0xb3, 0xc2, 0xf8, 0xcc, 0x59, 0x9e, 0xc9, 0xe9, 0x44,
-	0x2e, 0x59, 0xf9, 0x5d, 0x86, 0x95, 0x26, 0x19, 0x53, 0x66, 0xf3, 0x1b, 0xe5, 0x95, 0x45, 0x79,
-	0xbf, 0x84, 0x54, 0xb8, 0x4a, 0xe2, 0x3e, 0xab, 0x84, 0xce, 0xd3, 0x52, 0x25, 0xdf, 0x55, 0x2a,
-	0xf9, 0x46, 0xa9, 0xbe, 0x85, 0x14, 0xe3, 0x26, 0xf7, 0x98, 0x28, 0x63, 0x76, 0xf7, 0x13, 0x65,
-	0x9e, 0x4d, 0xca, 0xdc, 0x6e, 0x95, 0x8e, 0xf0, 0xd5, 0xc3, 0x18, 0xf4, 0x05, 0xe4, 0xad, 0xc0,
-	0x8e, 0xdf, 0x52, 0x70, 0x14, 0xda, 0xd4, 0x99, 0xba, 0xfb, 0xeb, 0x51, 0xcf, 0xed, 0x13, 0x51,
-	0xed, 0xbb, 0xd7, 0x13, 0xbe, 0x7a, 0x18, 0x83, 0xbe, 0x81, 0xa2, 0x45, 0xce, 0xc9, 0xc0, 0xf4,
-	0xf9, 0x89, 0xf9, 0x25, 0xc3, 0xb6, 0x83, 0xc7, 0x2e, 0x1d, 0xb8, 0x84, 0x31, 0x71, 0x2d, 0xb2,
-	0xbe, 0x39, 0xf5, 0x30, 0x2e, 0x99, 0xe6, 0x9c, 0x84, 0xe6, 0xca, 0x10, 0x52, 0xc1, 0xf6, 0x11,
-	0x82, 0xac, 0xa1, 0xd7, 0xdb, 0x9d, 0xa7, 0xaa, 0x8e, 0x7f, 0xec, 0xaa, 0x5d, 0x35, 0xb7, 0x80,
-	0x0a, 0x90, 0x8f, 0x30, 0xad, 0x8d, 0x4f, 0xf4, 0xe3, 0x03, 0x5d, 0xed, 0x74, 0x72, 0x09, 0x94,
-	0x87, 0x5c, 0x53, 0x6d, 0xa9, 0x07, 0x75, 0x43, 0x3b, 0x6e, 0x87, 0xfe, 0x12, 0x2a, 0xc2, 0x47,
-	0x33, 0xe8, 0x6c, 0x44, 0xb2, 0x52, 0x85, 0x54, 0xb0, 0x71, 0x04, 0x90, 0xea, 0x18, 0xba, 0xd6,
-	0xf4, 0x57, 0x40, 0x90, 0x7d, 0xa1, 0x19, 0x87, 0x4d, 0xbd, 0xfe, 0xa2, 0xde, 0xc2, 0xda, 0x7e,
-	0x3d, 0x27, 0x3d, 0x93, 0xd3, 0x8b, 0xb9, 0x54, 0xe5, 0x9f, 0x14, 0xac, 0x1d, 0x86, 0x77, 0xd2,
-	0x75, 0x7a, 0xf4, 0x56, 0xe2, 0x49, 0xef, 0x4f, 0xbc, 0xdb, 0x34, 0x99, 0xf8, 0x40, 0x4d, 0x1e,
-	0xc2, 0xda, 0x64, 0x57, 0x0c, 0x73, 0x8a, 0x7b, 0x9e, 0xeb, 0x88, 0xbb, 0xbc, 0x33, 0x59, 0x36,
-	0xdc, 0x17, 0x33, 0x68, 0xc3, 0x73, 0x1d, 0x64, 0xc0, 0xe6, 0xec, 0xc6, 0x44, 0x36, 0x4f, 0x9c,
-	0xfe, 0x7e, 0x02, 0xcb, 0xcf, 0x6c, 0x8e, 0x19, 0x34, 0x28, 0x1c, 0xea, 0xc2, 0xa6, 0x10, 0xb0,
-	0xd9, 0x3b, 0x27, 0x78, 0x2e, 0x7f, 0x01, 0xee, 0x93, 0x75, 0x23, 0x8a, 0x6e, 0xcf, 0xa4, 0x47,
-	0xdf, 0xc3, 0x43, 0xcf, 0x79, 0x07, 0xf7, 0x96, 0x05, 0xf7, 0xb6, 0x66, 0x7d, 0xe6, 0xd8, 0xf7,
-	0xc1, 0x02, 0x7d, 0x02, 0x59, 0x6f, 0x42, 0x09, 0xcc, 0xed, 0x11, 0x11, 0xcd, 0x50, 0xd6, 0x57,
-	0x22, 0xd4, 0xb0, 0x47, 0x04, 0xfd, 0x10, 0xd3, 0x71, 0x35, 0xae, 0xab, 0x1b, 0xfc, 0x8a, 0x6b,
-	0xf9, 0x2b, 0xd8, 0xf4, 0x18, 0x71, 0xb1, 0x1b, 0x0d, 0x00, 0x1c, 0xc6, 0x16, 0x96, 0xca, 0xc9,
-	0x6a, 0x46, 0xdf, 0xf0, 0xde, 0x32, 0x1e, 0x58, 0xe5, 0x4f, 0x29, 0xd2, 0xd5, 0x3a, 0xac, 0x76,
-	0xdb, 0x8d, 0xe3, 0x76, 0x53, 0x6b, 0x1f, 0x44, 0xc2, 0xda, 0x82, 0x8d, 0x29, 0x38, 0xa7, 0x93,
-	0x79, 0x93, 0xae, 0x1a, 0xfa, 0xcf, 0x61, 0xd4, 0x22, 0xda, 0x84, 0x75, 0xf5, 0x27, 0xcd, 0xc0,
-	0x31, 0x9d, 0x4a, 0x68, 0x1b, 0xb6, 0xe6, 0x0d, 0xb3, 0x29, 0x65, 0xb4, 0x02, 0x99, 0xfd, 0x56,
-	0x5d, 0x3b, 0xaa, 0x37, 0x5a, 0x6a, 0x2e, 0x51, 0xf9, 0x43, 0x82, 0xbc, 0x68, 0x3f, 0xd1, 0xb1,
-	0xc3, 0xb6, 0x1b, 0x1f, 0x11, 0xd2, 0xcd, 0x11, 0xd1, 0x81, 0xfc, 0xf4, 0x6e, 0xa2, 0x6a, 0xb3,
-	0x42, 0xb2, 0x9c, 0xac, 0x2e, 0xef, 0x3e, 0xbe, 0xb3, 0xc0, 0x3a, 0x1a, 0xc6, 0x21, 0x16, 0x8e,
-	0x81, 0xbf, 0x65, 0x58, 0x6d, 0x75, 0x8e, 0x04, 0xb7, 0xc2, 0x86, 0x87, 0xb6, 0x01, 0x26, 0xbd,
-	0x34, 0x9a, 0xb7, 0x99, 0x10, 0xd1, 0x2c, 0xb4, 0x05, 0xe9, 0xfe, 0xd0, 0xb4, 0x1d, 0xdf, 0x28,
-	0xb4, 0xab, 0x2f, 0x89, 0x6f, 0xcd, 0xba, 0x85, 0x5a, 0x1f, 0x43, 0xc6, 0xee, 0xf5, 0x71, 0x60,
-	0x09, 0x78, 0x95, 0xb6, 0x7b, 0xfd, 0xa6, 0x30, 0x3e, 0x81, 0x2c, 0xe3, 0xe6, 0x19, 0x71, 0xb1,
-	0x69, 0x59, 0x82, 0xc0, 0xc1, 0x88, 0x5d, 0x09, 0xd0, 0x7a, 0x00, 0xa2, 0xcf, 0x60, 0xed, 0xc2,
-	0x3c, 0xb7, 0x2d, 0x93, 0xd3, 0xa9, 0x67, 0x30, 0x6f, 0x73, 0x91, 0x61, 0xe2, 0x3c, 0x9d, 0x5c,
-	0x4b, 0xef, 0x33, 0xb9, 0xbe, 0x86, 0xf4, 0xa4, 0xa1, 0x88, 0x3e, 0xb2, 0xbc, 0xbb, 0xa5, 0x04,
-	0x11, 0x8a, 0xff, 0x82, 0x51, 0xc2, 0x17, 0x8c, 0xb2, 0x4f, 0x6d, 0xa7, 0x21, 0xfb, 0x39, 0xf5,
-	0xa5, 0xb0, 0x93, 0xa0, 0xef, 0x22, 0xd6, 0x67, 0x04, 0xeb, 0x3f, 0x8d, 0x5f, 0x4a, 0xac, 0xc8,
-	0x31, 0xce, 0x57, 0xfe, 0x9a, 0xe3, 0x6e, 0x53, 0x3d, 0x39, 0xee, 0x68, 0x06, 0x3e, 0x51, 0x05,
-	0x23, 0x83, 0x96, 0x7d, 0x83, 0x80, 0xb7, 0x0f, 0x8a, 0x75, 0x58, 0x8d, 0x2c, 0x4f, 0xeb, 0x5a,
-	0x4b, 0x6d, 0xe6, 0x92, 0xbe, 0x7b, 0x53, 0x35, 0x8e, 0x9f, 0xab, 0x6d, 0xed, 0x74, 0x76, 0x82,
-	0xc8, 0xa8, 0x04, 0xc5, 0x98, 0x65, 0x36, 0xdd, 0xa2, 0xaf, 0x8e, 0x98, 0x3d, 0x4c, 0x9a, 0x6a,
-	0x3c, 0x7f, 0x79, 0x55, 0x92, 0x5e, 0x5d, 0x95, 0xa4, 0xff, 0xaf, 0x4a, 0xd2, 0x6f, 0xd7, 0xa5,
-	0x85, 0x57, 0xd7, 0xa5, 0x85, 0x7f, 0xaf, 0x4b, 0x0b, 0xa7, 0x3b, 0x03, 0x9b, 0x0f, 0xbd, 0x9e,
-	0xd2, 0xa7, 0xa3, 0x5a, 0x47, 0xd4, 0xe2, 0xf3, 0x96, 0xd9, 0x63, 0xb5, 0xf0, 0x05, 0x79, 0xb1,
-	0xb7, 0x57, 0xbb, 0x8c, 0xde, 0x91, 0xfc, 0x97, 0x31, 0x61, 0xbd, 0x94, 0x78, 0x00, 0xee, 0xbd,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0x03, 0x3a, 0x12, 0xb3, 0x66, 0x0a, 0x00, 0x00,
+	// 1232 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x73, 0xdb, 0x44,
+	0x14, 0x8e, 0x6c, 0x45, 0xb1, 0x5f, 0x1a, 0xc7, 0xd9, 0x38, 0x44, 0x09, 0x8d, 0x9b, 0x7a, 0x28,
+	0xe3, 0x19, 0x06, 0x9b, 0xb6, 0x03, 0x07, 0xe8, 0x00, 0x76, 0xad, 0xb6, 0x6a, 0x5d, 0x27, 0xc8,
+	0xca, 0x14, 0x7a, 0xd1, 0xc8, 0xd6, 0x3a, 0xd6, 0x34, 0xde, 0xf5, 0x68, 0x57, 0xa1, 0xf0, 0x2b,
+	0x3a, 0x03, 0x27, 0xfe, 0x07, 0x47, 0xee, 0x3d, 0xf6, 0x06, 0xc3, 0xa1, 0xc3, 0xb4, 0xff, 0x81,
+	0x33, 0xa3, 0x5d, 0x59, 0xb6, 0xe5, 0xa6, 0x35, 0x3d, 0xd9, 0xfa, 0xde, 0xbe, 0xb7, 0x6f, 0xf7,
+	0x7d, 0xdf, 0x7b, 0x0b, 0x97, 0x19, 0x0f, 0x7c, 0x0f, 0xd7, 0x03, 0xdc, 0xa7, 0x81, 0xc7, 0x26,
+	0xbf, 0xb5, 0x71, 0x40, 0x39, 0x45, 0x05, 0x69, 0xad, 0xc5, 0xe8, 0x7e, 0xb9, 0x4f, 0xd9, 0x88,
+	0xb2, 0x7a, 0xcf, 0x65, 0xb8, 0x7e, 0x7e, 0xbd, 0x87, 0xb9, 0x7b, 0xbd, 0xde, 0xa7, 0x3e, 0x91,
+	0xeb, 0xf7, 0x4b, 0xa7, 0xf4, 0x94, 0x8a, 0xbf, 0xf5, 0xe8, 0x9f, 0x44, 0x2b, 0xcf, 0xb2, 0x50,
+	0x3a, 0x61, 0x38, 0xb0, 0xb0, 0x87, 0x47, 0x63, 0xee, 0x53, 0x62, 0x89, 0x78, 0xa8, 0x00, 0x19,
+	0xdf, 0xd3, 0x95, 0x43, 0xa5, 0x9a, 0xb7, 0x32, 0xbe, 0x87, 0xf6, 0x21, 0x17, 0xe0, 0x3e, 0xf6,
+	0xcf, 0x71, 0xa0, 0x67, 0x05, 0x9a, 0x7c, 0xa3, 0x87, 0xb0, 0x4d, 0x5c, 0xee, 0x9f, 0x63, 0x87,
+	0xd3, 0x27, 0x98, 0x38, 0xee, 0x88, 0x86, 0x84, 0xeb, 0x6a, 0xb4, 0xac, 0x79, 0xf0, 0xfc, 0xe5,
+	0x95, 0x95, 0xbf, 0x5f, 0x5e, 0xd9, 0x91, 0xf9, 0x31, 0xef, 0x49, 0xcd, 0xa7, 0xf5, 0x91, 0xcb,
+	0x87, 0x35, 0x93, 0x70, 0x6b, 0x4b, 0x7a, 0xda, 0x91, 0x63, 0x43, 0xf8, 0xa1, 0x12, 0xac, 0x7a,
+	0x98, 0xd0, 0x91, 0xbe, 0x2a, 0xf6, 0x91, 0x1f, 0xe8, 0x10, 0x2e, 0x0d, 0x29, 0xe3, 0xce, 0xcf,
+	0x94, 0x60, 0xc7, 0xf7, 0x74, 0x4d, 0x18, 0x21, 0xc2, 0x1e, 0x53, 0x82, 0x4d, 0x0f, 0x5d, 0x85,
+	0x4b, 0x78, 0x4c, 0xfb, 0x43, 0x87, 0x84, 0xa3, 0x1e, 0x0e, 0xf4, 0xb5, 0x43, 0xa5, 0xaa, 0x5a,
+	0xeb, 0x02, 0xeb, 0x08, 0x08, 0x55, 0xa1, 0xd8, 0x3f, 0x73, 0xfd, 0x91, 0xe3, 0x33, 0x67, 0x8c,
+	0x89, 0xe7, 0x93, 0x53, 0x3d, 0x77, 0xa8, 0x54, 0x73, 0x56, 0x41, 0xe0, 0x26, 0x3b, 0x96, 0x28,
+	0x32, 0x60, 0x93, 0xf1, 0xf9, 0xf3, 0xe4, 0x97, 0x39, 0xcf, 0x06, 0xe3, 0xa9, 0xb3, 0xd0, 0x1f,
+	0x09, 0x0e, 0x74, 0x90, 0x67, 0x11, 0x1f, 0x68, 0x07, 0x34, 0x32, 0xe0, 0xd1, 0x29, 0xd6, 0x25,
+	0x4c, 0x06, 0xdc, 0xf4, 0xee, 0xab, 0xb9, 0x4c, 0x31, 0x5b, 0xf9, 0x45, 0x85, 0x8d, 0x16, 0x1e,
+	0x53, 0xe6, 0xf3, 0x85, 0x5a, 0xa8, 0xa2, 0x16, 0x9f, 0x83, 0x16, 0xa7, 0x94, 0x59, 0x26, 0x25,
+	0xcd, 0x4d, 0x72, 0x91, 0xf7, 0x9a, 0x7d, 0xdb, 0xbd, 0xaa, 0x0b, 0xf7, 0x7a, 0x0b, 0x34, 0xc6,
+	0x5d, 0x1e, 0x32, 0x71, 0xe7, 0x85, 0x1b, 0x1f, 0xd5, 0xe6, 0xa9, 0x57, 0x9b, 0xcb, 0xb6, 0xd6,
+	0x15, 0x6b, 0xad, 0xd8, 0x07, 0x7d, 0x06, 0x25, 0x4f, 0xda, 0x9d, 0x37, 0x54, 0x07, 0xc5, 0x36,
+	0x63, 0xa6, 0x48, 0xd1, 0x7e, 0x34, 0x0c, 0xfa, 0x58, 0xcf, 0x2d, 0xb5, 0x9f, 0x58, 0x6b, 0xc5,
+	0x3e, 0xe8, 0x2b, 0xd8, 0xf7, 0xf0, 0x19, 0x3e, 0x75, 0x23, 0x32, 0x3b, 0xfc, 0x29, 0x73, 0x7c,
+	0xe2, 0x8c, 0x03, 0x7a, 0x1a, 0x60, 0xc6, 0x44, 0x0d, 0x55, 0x6b, 0x77, 0xba, 0xc2, 0x7e, 0xca,
+	0x4c, 0x72, 0x1c, 0x9b, 0x2b, 0x43, 0xd0, 0x64, 0xfa, 0x08, 0x41, 0xc1, 0xb6, 0x1a, 0x9d, 0xee,
+	0x1d, 0xc3, 0x72, 0xbe, 0x3b, 0x31, 0x4e, 0x8c, 0xe2, 0x0a, 0xd2, 0xa1, 0x94, 0x60, 0x66, 0xc7,
+	0x39, 0xb6, 0x8e, 0xee, 0x5a, 0x46, 0xb7, 0x5b, 0xcc, 0xa0, 0x12, 0x14, 0x5b, 0x46, 0xdb, 0xb8,
+	0xdb, 0xb0, 0xcd, 0xa3, 0x4e, 0xbc, 0x5e, 0x41, 0xfb, 0xf0, 0xc1, 0x0c, 0x3a, 0xeb, 0x91, 0xad,
+	0x54, 0x41, 0x93, 0x89, 0x23, 0x00, 0xad, 0x6b, 0x5b, 0x66, 0x2b, 0xda, 0x01, 0x41, 0xe1, 0x91,
+	0x69, 0xdf, 0x6b, 0x59, 0x8d, 0x47, 0x8d, 0xb6, 0x63, 0xde, 0x6e, 0x14, 0x95, 0xfb, 0x6a, 0x6e,
+	0xb5, 0xa8, 0x55, 0xfe, 0xd4, 0x60, 0xeb, 0x5e, 0x5c, 0x93, 0x13, 0xd2, 0xa3, 0x17, 0xb2, 0x54,
+	0x79, 0x0f, 0x96, 0x5e, 0x20, 0xe0, 0xcc, 0x7b, 0x0a, 0xf8, 0x1e, 0x6c, 0x4d, 0xb2, 0x62, 0x0e,
+	0xa7, 0x4e, 0x2f, 0x0c, 0x88, 0x9e, 0x5b, 0x26, 0x58, 0x21, 0xce, 0x8b, 0xd9, 0xb4, 0x19, 0x06,
+	0x04, 0xd9, 0xb0, 0x3b, 0x9b, 0x98, 0x88, 0x16, 0x8a, 0xd3, 0x2f, 0xa7, 0xc6, 0xd2, 0x4c, 0x72,
+	0xcc, 0xa6, 0xf2, 0xe2, 0xd0, 0x09, 0xec, 0x0a, 0xb5, 0xbb, 0xbd, 0x33, 0xec, 0xcc, 0xc5, 0xd7,
+	0x61, 0x99, 0xa8, 0x3b, 0x89, 0x77, 0x67, 0x26, 0x3c, 0xfa, 0x06, 0x2e, 0x87, 0xe4, 0x2d, 0xdc,
+	0x5b, 0x17, 0xdc, 0xdb, 0x0b, 0xc9, 0x05, 0xec, 0x7b, 0x6f, 0x81, 0x5e, 0x83, 0x42, 0x38, 0xa1,
+	0x84, 0xc3, 0xfd, 0x11, 0x16, 0x9d, 0x53, 0xb5, 0x36, 0x12, 0xd4, 0xf6, 0x47, 0x18, 0x7d, 0x9b,
+	0xd2, 0x71, 0x35, 0xad, 0xab, 0x05, 0x7e, 0xa5, 0xb5, 0xfc, 0x05, 0xec, 0x86, 0x0c, 0x07, 0x4e,
+	0x90, 0x4c, 0x0b, 0x27, 0xf6, 0xd5, 0xd7, 0x0e, 0xb3, 0xd5, 0xbc, 0xb5, 0x13, 0xbe, 0x61, 0x96,
+	0xb0, 0xca, 0x6f, 0x4a, 0xa2, 0xab, 0x6d, 0xd8, 0x3c, 0xe9, 0x34, 0x8f, 0x3a, 0x2d, 0xb3, 0x73,
+	0x37, 0x11, 0xd6, 0x1e, 0xec, 0x4c, 0xc1, 0x39, 0x9d, 0xcc, 0x9b, 0x2c, 0xc3, 0xb6, 0x7e, 0x88,
+	0xbd, 0x56, 0xd1, 0x2e, 0x6c, 0x1b, 0xdf, 0x9b, 0xb6, 0x93, 0xd2, 0xa9, 0x82, 0x0e, 0x60, 0x6f,
+	0xde, 0x30, 0x1b, 0x52, 0x45, 0x1b, 0x90, 0xbf, 0xdd, 0x6e, 0x98, 0x0f, 0x1b, 0xcd, 0xb6, 0x51,
+	0xcc, 0x54, 0x7e, 0x55, 0xa0, 0x24, 0xda, 0x4f, 0x72, 0xec, 0xb8, 0xed, 0xa6, 0xe7, 0x89, 0xb2,
+	0x38, 0x4f, 0xba, 0x50, 0x9a, 0xd6, 0x26, 0xb9, 0x6d, 0xa6, 0x67, 0x0f, 0xb3, 0xd5, 0xf5, 0x1b,
+	0x57, 0xdf, 0x79, 0xc1, 0x16, 0x1a, 0xa6, 0x21, 0x16, 0x8f, 0x81, 0x3f, 0x54, 0xd8, 0x6c, 0x77,
+	0x1f, 0x0a, 0x6e, 0xc5, 0x0d, 0x0f, 0x1d, 0x00, 0x4c, 0x7a, 0x69, 0x32, 0x9c, 0xf3, 0x31, 0x62,
+	0x7a, 0x68, 0x0f, 0x72, 0xfd, 0xa1, 0xeb, 0x93, 0xc8, 0x28, 0xb4, 0x6b, 0xad, 0x89, 0x6f, 0xd3,
+	0xbb, 0x80, 0x5a, 0x1f, 0x42, 0xde, 0xef, 0xf5, 0x1d, 0x69, 0x91, 0xbc, 0xca, 0xf9, 0xbd, 0x7e,
+	0x4b, 0x18, 0xaf, 0x41, 0x81, 0x71, 0xf7, 0x09, 0x0e, 0x1c, 0xd7, 0xf3, 0x04, 0x81, 0xe5, 0x3c,
+	0xde, 0x90, 0x68, 0x43, 0x82, 0xe8, 0x13, 0xd8, 0x3a, 0x77, 0xcf, 0x7c, 0xcf, 0xe5, 0x74, 0xba,
+	0x52, 0x0e, 0xe7, 0x62, 0x62, 0x98, 0x2c, 0x9e, 0x4e, 0xae, 0xb5, 0xff, 0x33, 0xb9, 0xbe, 0x84,
+	0xdc, 0xa4, 0xa1, 0x88, 0x3e, 0xb2, 0x7e, 0x63, 0xaf, 0x26, 0x3d, 0x6a, 0xd1, 0x73, 0xa7, 0x16,
+	0x3f, 0x77, 0x6a, 0xb7, 0xa9, 0x4f, 0x9a, 0x6a, 0x14, 0xd3, 0x5a, 0x8b, 0x3b, 0x09, 0xfa, 0x3a,
+	0x61, 0x7d, 0x5e, 0xb0, 0xfe, 0xe3, 0x74, 0x51, 0x52, 0x97, 0x9c, 0xe2, 0x7c, 0xe5, 0xf7, 0x39,
+	0xee, 0xb6, 0x8c, 0xe3, 0xa3, 0xae, 0x69, 0x3b, 0xc7, 0x86, 0x60, 0xa4, 0x6c, 0xd9, 0x0b, 0x04,
+	0xbc, 0x78, 0x50, 0x6c, 0xc3, 0x66, 0x62, 0xb9, 0xd3, 0x30, 0xdb, 0x46, 0xab, 0x98, 0x8d, 0x96,
+	0xb7, 0x0c, 0xfb, 0xe8, 0x81, 0xd1, 0x31, 0x1f, 0xcf, 0x4e, 0x10, 0x15, 0x95, 0x61, 0x3f, 0x65,
+	0x99, 0x0d, 0xb7, 0x1a, 0xa9, 0x23, 0x65, 0x8f, 0x83, 0x6a, 0x95, 0x7f, 0x33, 0x70, 0x60, 0x12,
+	0xc6, 0x5d, 0xc2, 0xa7, 0x82, 0x6c, 0x86, 0x83, 0x41, 0x24, 0x50, 0xc1, 0xef, 0x74, 0x63, 0x51,
+	0xde, 0xf9, 0xa2, 0xca, 0x2c, 0x2a, 0xa0, 0x09, 0x1b, 0x83, 0xa8, 0xa3, 0x79, 0x93, 0xa1, 0x91,
+	0x5d, 0xa6, 0xb0, 0x97, 0xa4, 0x4f, 0x3c, 0x2f, 0xee, 0xc0, 0x66, 0xd4, 0x51, 0xf0, 0x68, 0x1a,
+	0x65, 0xa9, 0xb7, 0x63, 0x61, 0xe2, 0x15, 0xc7, 0xb9, 0x05, 0x30, 0xc0, 0x78, 0x12, 0x62, 0x75,
+	0x99, 0x10, 0xf9, 0x01, 0xc6, 0xb3, 0x59, 0x0c, 0xfc, 0xb3, 0xb3, 0x69, 0x16, 0xda, 0x92, 0x59,
+	0x48, 0x2f, 0x19, 0xa7, 0xf9, 0xe0, 0xf9, 0xab, 0xb2, 0xf2, 0xe2, 0x55, 0x59, 0xf9, 0xe7, 0x55,
+	0x59, 0x79, 0xf6, 0xba, 0xbc, 0xf2, 0xe2, 0x75, 0x79, 0xe5, 0xaf, 0xd7, 0xe5, 0x95, 0xc7, 0xd7,
+	0x4f, 0x7d, 0x3e, 0x0c, 0x7b, 0xb5, 0x3e, 0x1d, 0xd5, 0xbb, 0x82, 0x84, 0x9f, 0xb6, 0xdd, 0x1e,
+	0xab, 0xc7, 0xef, 0xfc, 0xf3, 0x9b, 0x37, 0xeb, 0x4f, 0x93, 0xd7, 0x3e, 0xff, 0x69, 0x8c, 0x59,
+	0x4f, 0x13, 0xcf, 0xf4, 0x9b, 0xff, 0x0d, 0x00, 0x71, 0x88, 0x3f, 0xd9, 0x0c, 0x0c, 0x00, 0x00,
 }
 
 func (m *UserRedemptionRecord) Marshal() (dAtA []byte, err error) {
@@ -697,6 +793,20 @@ func (m *UserRedemptionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x52
+	}
 	{
 		size := m.StTokenAmount.Size()
 		i -= size
@@ -1078,6 +1188,81 @@ func (m *LSMTokenDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InstantRedemptionBufferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstantRedemptionBufferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstantRedemptionBufferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RefilledAmount.Size()
+		i -= size
+		if _, err := m.RefilledAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRecords(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FeeAmount.Size()
+		i -= size
+		if _, err := m.FeeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRecords(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RedeemedAmount.Size()
+		i -= size
+		if _, err := m.RedeemedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRecords(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FundedAmount.Size()
+		i -= size
+		if _, err := m.FundedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRecords(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRecords(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRecords(v)
 	base := offset
@@ -1121,6 +1306,14 @@ func (m *UserRedemptionRecord) Size() (n int) {
 	}
 	l = m.StTokenAmount.Size()
 	n += 1 + l + sovRecords(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
 	return n
 }
 
@@ -1258,6 +1451,30 @@ func (m *LSMTokenDeposit) Size() (n int) {
 	return n
 }
 
+func (m *InstantRedemptionBufferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovRecords(uint64(m.EpochNumber))
+	}
+	l = m.FundedAmount.Size()
+	n += 1 + l + sovRecords(uint64(l))
+	l = m.RedeemedAmount.Size()
+	n += 1 + l + sovRecords(uint64(l))
+	l = m.FeeAmount.Size()
+	n += 1 + l + sovRecords(uint64(l))
+	l = m.RefilledAmount.Size()
+	n += 1 + l + sovRecords(uint64(l))
+	return n
+}
+
 func sovRecords(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1528,6 +1745,70 @@ func (m *UserRedemptionRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecords(dAtA[iNdEx:])
@@ -2596,6 +2877,243 @@ func (m *LSMTokenDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InstantRedemptionBufferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstantRedemptionBufferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstantRedemptionBufferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefilledAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefilledAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRecords(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
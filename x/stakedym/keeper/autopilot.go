@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	autopilottypes "github.com/Stride-Labs/stride/v33/x/autopilot/types"
+	"github.com/Stride-Labs/stride/v33/x/stakedym/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Returns the actions that stakedym exposes through autopilot
+// The actions are routed to with the "stakedym" key in the autopilot memo, e.g.
+//
+//	{ "autopilot": { "receiver": "strideXXX", "stakedym": { "action": "LiquidStake" } } }
+func (k Keeper) AutopilotActions() autopilottypes.ModuleActions {
+	return []autopilottypes.AutopilotAction{
+		{
+			Module:  types.ModuleName,
+			Name:    autopilottypes.LiquidStake,
+			Handler: k.AutopilotLiquidStake,
+		},
+	}
+}
+
+// Autopilot action to liquid stake the tokens from an inbound transfer on behalf of the receiver
+// The tokens must match the host zone's native IBC denom, which confirms the packet was
+// sent over the host zone's transfer channel
+// Returns the minted stTokens
+func (k Keeper) AutopilotLiquidStake(
+	ctx sdk.Context,
+	input autopilottypes.AutopilotActionInput,
+	_ autopilottypes.ActionMetadata,
+) (stToken sdk.Coin, err error) {
+	hostZone, err := k.GetHostZone(ctx)
+	if err != nil {
+		return stToken, err
+	}
+	if input.Token.Denom != hostZone.NativeTokenIbcDenom {
+		return stToken, errorsmod.Wrapf(stakeibctypes.ErrInvalidToken,
+			"ibc denom %s is not equal to host zone ibc denom %s", input.Token.Denom, hostZone.NativeTokenIbcDenom)
+	}
+
+	msg := types.NewMsgLiquidStake(input.Receiver, input.Token.Amount)
+	if err := msg.ValidateBasic(); err != nil {
+		return stToken, err
+	}
+
+	return k.LiquidStake(ctx, msg.Staker, msg.NativeAmount)
+}
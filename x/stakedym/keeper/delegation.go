@@ -27,6 +27,9 @@ func (k Keeper) LiquidStake(ctx sdk.Context, liquidStaker string, nativeAmount s
 	if err != nil {
 		return stToken, err
 	}
+	if err := k.stakeibcKeeper.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, stakeibctypes.PAUSE_LIQUID_STAKE); err != nil {
+		return stToken, err
+	}
 
 	// Get user and deposit account addresses
 	liquidStakerAddress, err := sdk.AccAddressFromBech32(liquidStaker)
@@ -215,6 +218,9 @@ func (k Keeper) LiquidStakeAndDistributeFees(ctx sdk.Context) error {
 
 // Runs prepare delegations with a cache context wrapper so revert any partial state changes
 func (k Keeper) SafelyPrepareDelegation(ctx sdk.Context, epochNumber uint64, epochDuration time.Duration) error {
+	if err := k.CheckCircuitBreaker(ctx, stakeibctypes.PAUSE_DELEGATE_ICA); err != nil {
+		return err
+	}
 	return utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
 		return k.PrepareDelegation(ctx, epochNumber, epochDuration)
 	})
@@ -222,5 +228,8 @@ func (k Keeper) SafelyPrepareDelegation(ctx sdk.Context, epochNumber uint64, epo
 
 // Liquid stakes fees with a cache context wrapper so revert any partial state changes
 func (k Keeper) SafelyLiquidStakeAndDistributeFees(ctx sdk.Context) error {
+	if err := k.CheckCircuitBreaker(ctx, stakeibctypes.PAUSE_REWARD_REINVEST); err != nil {
+		return err
+	}
 	return utils.ApplyFuncIfNoError(ctx, k.LiquidStakeAndDistributeFees)
 }
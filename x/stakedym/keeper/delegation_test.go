@@ -12,6 +12,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/Stride-Labs/stride/v33/x/stakedym/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 )
 
 var InitialDelegation = sdkmath.NewInt(1_000_000)
@@ -172,6 +173,20 @@ func (s *KeeperTestSuite) TestLiquidStake_HostZoneHalted() {
 	s.Require().ErrorContains(err, "host zone is halted")
 }
 
+func (s *KeeperTestSuite) TestLiquidStake_CircuitBreakerTripped() {
+	tc := s.DefaultSetupTestLiquidStake()
+
+	// Pause liquid stakes from stakeibc's circuit breaker
+	s.App.StakeibcKeeper.SetCircuitBreakerFlag(s.Ctx, stakeibctypes.CircuitBreakerFlag{
+		Module:  types.ModuleName,
+		ChainId: HostChainId,
+		Action:  stakeibctypes.PAUSE_LIQUID_STAKE,
+	})
+
+	_, err := s.App.StakedymKeeper.LiquidStake(s.Ctx, tc.stakerAddress.String(), tc.liquidStakeAmount)
+	s.Require().ErrorIs(err, stakeibctypes.ErrCircuitBreakerTripped)
+}
+
 func (s *KeeperTestSuite) TestLiquidStake_InvalidAddresse() {
 	tc := s.DefaultSetupTestLiquidStake()
 
@@ -19,6 +19,7 @@ type Keeper struct {
 	bankKeeper      types.BankKeeper
 	icaOracleKeeper types.ICAOracleKeeper
 	ratelimitKeeper types.RatelimitKeeper
+	stakeibcKeeper  types.StakeibcKeeper
 	transferKeeper  types.TransferKeeper
 }
 
@@ -29,6 +30,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	icaOracleKeeper types.ICAOracleKeeper,
 	ratelimitKeeper types.RatelimitKeeper,
+	stakeibcKeeper types.StakeibcKeeper,
 	transferKeeper types.TransferKeeper,
 ) *Keeper {
 	return &Keeper{
@@ -38,6 +40,7 @@ func NewKeeper(
 		bankKeeper:      bankKeeper,
 		icaOracleKeeper: icaOracleKeeper,
 		ratelimitKeeper: ratelimitKeeper,
+		stakeibcKeeper:  stakeibcKeeper,
 		transferKeeper:  transferKeeper,
 	}
 }
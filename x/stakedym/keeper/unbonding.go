@@ -12,6 +12,7 @@ import (
 
 	"github.com/Stride-Labs/stride/v33/utils"
 	"github.com/Stride-Labs/stride/v33/x/stakedym/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 )
 
 // Takes custody of staked tokens in an escrow account, updates the current
@@ -26,6 +27,10 @@ func (k Keeper) RedeemStake(ctx sdk.Context, redeemer string, stTokenAmount sdkm
 		return nativeToken, err
 	}
 
+	if err := k.stakeibcKeeper.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, stakeibctypes.PAUSE_REDEEM); err != nil {
+		return nativeToken, err
+	}
+
 	escrowAccount, err := sdk.AccAddressFromBech32(hostZone.RedemptionAddress)
 	if err != nil {
 		return nativeToken, errorsmod.Wrapf(err, "could not bech32 decode redemption address %s on stride", hostZone.RedemptionAddress)
@@ -400,6 +405,9 @@ func (k Keeper) DistributeClaimsForUnbondingRecord(
 
 // Runs prepare undelegations with a cache context wrapper so revert any partial state changes
 func (k Keeper) SafelyPrepareUndelegation(ctx sdk.Context, epochNumber uint64) error {
+	if err := k.CheckCircuitBreaker(ctx, stakeibctypes.PAUSE_UNDELEGATE_ICA); err != nil {
+		return err
+	}
 	return utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
 		return k.PrepareUndelegation(ctx, epochNumber)
 	})
@@ -407,5 +415,8 @@ func (k Keeper) SafelyPrepareUndelegation(ctx sdk.Context, epochNumber uint64) e
 
 // Runs distribute claims with a cache context wrapper so revert any partial state changes
 func (k Keeper) SafelyDistributeClaims(ctx sdk.Context) error {
+	if err := k.CheckCircuitBreaker(ctx, stakeibctypes.PAUSE_CLAIM); err != nil {
+		return err
+	}
 	return utils.ApplyFuncIfNoError(ctx, k.DistributeClaims)
 }
@@ -6,6 +6,8 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 )
 
 // Required AccountKeeper functions
@@ -42,3 +44,8 @@ type RatelimitKeeper interface {
 type ICAOracleKeeper interface {
 	QueueMetricUpdate(ctx sdk.Context, key, value, metricType, attributes string)
 }
+
+// Required StakeibcKeeper functions
+type StakeibcKeeper interface {
+	CheckCircuitBreaker(ctx sdk.Context, module, chainId string, action stakeibctypes.CircuitBreakerAction) error
+}
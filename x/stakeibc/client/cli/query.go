@@ -33,6 +33,23 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowEpochTracker())
 	cmd.AddCommand(CmdNextPacketSequence())
 	cmd.AddCommand(CmdListTradeRoutes())
+	cmd.AddCommand(CmdShowBasket())
+	cmd.AddCommand(CmdListBaskets())
+	cmd.AddCommand(CmdShowRedemptionRateHistory())
+	cmd.AddCommand(CmdShowRedemptionRateApr())
+	cmd.AddCommand(CmdShowRedemptionRateDrawdown())
+	cmd.AddCommand(CmdShowHaltHistory())
+	cmd.AddCommand(CmdShowRebalancePlan())
+	cmd.AddCommand(CmdShowDelegationPlan())
+	cmd.AddCommand(CmdShowUnbondingPlan())
+	cmd.AddCommand(CmdShowRedemptionSweepPlan())
+	cmd.AddCommand(CmdShowCircuitBreakers())
+	cmd.AddCommand(CmdShowIcaGasEstimates())
+	cmd.AddCommand(CmdShowInsuranceFund())
+	cmd.AddCommand(CmdShowIcaReconciliationReport())
+	cmd.AddCommand(CmdShowIcaRecoveryStates())
+	cmd.AddCommand(CmdShowOnboardingChecklist())
+	cmd.AddCommand(CmdShowRedemptionCompletionEstimate())
 
 	return cmd
 }
@@ -318,3 +335,452 @@ func CmdListTradeRoutes() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowBasket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-basket [basket-id]",
+		Short: "shows a basket and the redemption rate of each component",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBasketRequest{
+				BasketId: args[0],
+			}
+
+			res, err := queryClient.Basket(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListBaskets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-baskets",
+		Short: "list all baskets",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllBasketsRequest{}
+			res, err := queryClient.AllBaskets(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRedemptionRateHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-rate-history [chain-id]",
+		Short: "shows the recorded redemption rate history of a host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRedemptionRateHistoryRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.RedemptionRateHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRedemptionRateApr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-rate-apr [chain-id]",
+		Short: "shows the trailing 7 and 30 day APR of a host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRedemptionRateAprRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.RedemptionRateApr(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRedemptionRateDrawdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-rate-drawdown [chain-id]",
+		Short: "shows the max drawdown of a host zone's redemption rate",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRedemptionRateDrawdownRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.RedemptionRateDrawdown(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowHaltHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt-history [chain-id]",
+		Short: "shows the redemption rate guard status changes of a host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryHaltHistoryRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.HaltHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRebalancePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-plan [chain-id]",
+		Short: "shows the redelegations that would be submitted if a host zone was rebalanced now",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRebalancePlanRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.RebalancePlan(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDelegationPlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-plan [chain-id]",
+		Short: "shows the delegation ICAs that would be submitted for a host zone's queued deposits",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDelegationPlanRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.DelegationPlan(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowUnbondingPlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-plan [chain-id]",
+		Short: "shows the undelegation ICAs that would be submitted for a host zone's queued redemptions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryUnbondingPlanRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.UnbondingPlan(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRedemptionSweepPlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-sweep-plan [chain-id]",
+		Short: "shows the ICA that would sweep a host zone's unbonded tokens to the redemption account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRedemptionSweepPlanRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.RedemptionSweepPlan(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowCircuitBreakers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breakers",
+		Short: "shows the circuit breaker guardian and all paused actions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CircuitBreakers(context.Background(), &types.QueryCircuitBreakersRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowIcaGasEstimates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-gas-estimates [chain-id]",
+		Short: "shows the learned gas estimate of each ICA message type on a host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIcaGasEstimatesRequest{ChainId: args[0]}
+			res, err := queryClient.IcaGasEstimates(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowInsuranceFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund [chain-id]",
+		Short: "shows the slashing insurance fund of a host zone and its coverage history",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInsuranceFundRequest{ChainId: args[0]}
+			res, err := queryClient.InsuranceFund(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowIcaReconciliationReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-reconciliation-report [chain-id]",
+		Short: "shows the latest balance reconciliation report of a host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIcaReconciliationReportRequest{ChainId: args[0]}
+			res, err := queryClient.IcaReconciliationReport(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowIcaRecoveryStates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-recovery-states",
+		Short: "shows the host zone ICAs that are being re-registered after a channel closure",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IcaRecoveryStates(context.Background(), &types.QueryIcaRecoveryStatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowOnboardingChecklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "onboarding-checklist [chain-id]",
+		Short: "shows the post-registration onboarding checklist of a host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryOnboardingChecklistRequest{ChainId: args[0]}
+			res, err := queryClient.OnboardingChecklist(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRedemptionCompletionEstimate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-completion-estimate [user-redemption-record-id]",
+		Short: "estimates when a user redemption record will be claimable",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRedemptionCompletionEstimateRequest{UserRedemptionRecordId: args[0]}
+			res, err := queryClient.RedemptionCompletionEstimate(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
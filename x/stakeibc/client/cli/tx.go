@@ -27,6 +27,7 @@ const (
 	FlagCommunityPoolTreasuryAddress = "community-pool-treasury-address"
 	FlagMaxMessagesPerIcaTx          = "max-messages-per-ica-tx"
 	FlagLegacy                       = "legacy"
+	FlagValidatorPreferences         = "validator-preferences"
 )
 
 var DefaultRelativePacketTimeoutTimestamp = cast.ToUint64((time.Duration(10) * time.Minute).Nanoseconds())
@@ -44,7 +45,14 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdLSMLiquidStake())
 	cmd.AddCommand(CmdRegisterHostZone())
 	cmd.AddCommand(CmdRedeemStake())
+	cmd.AddCommand(CmdInstantRedeemStake())
 	cmd.AddCommand(CmdClaimUndelegatedTokens())
+	cmd.AddCommand(CmdTransferRedemptionRecord())
+	cmd.AddCommand(CmdCancelRedemption())
+	cmd.AddCommand(CmdTokenizeRedemptionRecord())
+	cmd.AddCommand(CmdDetokenizeRedemptionRecord())
+	cmd.AddCommand(CmdLiquidStakeBasket())
+	cmd.AddCommand(CmdRedeemBasket())
 	cmd.AddCommand(CmdRebalanceValidators())
 	cmd.AddCommand(CmdAddValidators())
 	cmd.AddCommand(CmdChangeValidatorWeight())
@@ -59,6 +67,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdResumeHostZone())
 	cmd.AddCommand(CmdSetCommunityPoolRebate())
 	cmd.AddCommand(CmdToggleTradeController())
+	cmd.AddCommand(CmdTripCircuitBreaker())
+	cmd.AddCommand(CmdResetCircuitBreaker())
 
 	return cmd
 }
@@ -85,6 +95,18 @@ func CmdLiquidStake() *cobra.Command {
 				argAmount,
 				argHostDenom,
 			)
+
+			validatorPreferencesString, err := cmd.Flags().GetString(FlagValidatorPreferences)
+			if err != nil {
+				return err
+			}
+			if validatorPreferencesString != "" {
+				msg.ValidatorPreferences, err = parseValidatorPreferences(validatorPreferencesString)
+				if err != nil {
+					return err
+				}
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -93,6 +115,8 @@ func CmdLiquidStake() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagValidatorPreferences, "",
+		"optional validators to direct the deposit to, as comma separated {validator}:{weight} pairs")
 
 	return cmd
 }
@@ -259,6 +283,47 @@ func CmdRedeemStake() *cobra.Command {
 	return cmd
 }
 
+func CmdInstantRedeemStake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instant-redeem-stake [amount] [hostZoneID] [min-native-amount]",
+		Short: "Broadcast message instant-redeem-stake",
+		Long: strings.TrimSpace(`Redeems stTokens for native tokens immediately from the host zone's instant redemption buffer.
+The transaction will fail if the native tokens received after fees are less than the min-native-amount`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argAmount, found := sdkmath.NewIntFromString(args[0])
+			if !found {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidType, "can not convert string to int")
+			}
+			hostZoneID := args[1]
+			argMinNativeAmount, found := sdkmath.NewIntFromString(args[2])
+			if !found {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidType, "can not convert string to int")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInstantRedeemStake(
+				clientCtx.GetFromAddress().String(),
+				argAmount,
+				hostZoneID,
+				argMinNativeAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdClaimUndelegatedTokens() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "claim-undelegated-tokens [host-zone] [epoch] [receiver]",
@@ -295,6 +360,249 @@ func CmdClaimUndelegatedTokens() *cobra.Command {
 	return cmd
 }
 
+func CmdTransferRedemptionRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-redemption-record [host-zone] [epoch] [receiver] [new-owner] [new-receiver]",
+		Short: "Transfers an unclaimed redemption record to a new owner and receiver",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argHostZone := args[0]
+			argEpoch, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argReceiver := args[2]
+			argNewOwner := args[3]
+			argNewReceiver := args[4]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferRedemptionRecord(
+				clientCtx.GetFromAddress().String(),
+				argHostZone,
+				argEpoch,
+				argReceiver,
+				argNewOwner,
+				argNewReceiver,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-redemption [host-zone] [epoch] [receiver] [sttoken-amount]",
+		Short: "Cancels a redemption that has not yet started unbonding",
+		Long: strings.TrimSpace(`Returns the escrowed stTokens from a redemption that is still in the unbonding queue.
+If the sttoken-amount is omitted, the full redemption is cancelled`),
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argHostZone := args[0]
+			argEpoch, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argReceiver := args[2]
+			argStTokenAmount := sdkmath.ZeroInt()
+			if len(args) == 4 {
+				amount, found := sdkmath.NewIntFromString(args[3])
+				if !found {
+					return errorsmod.Wrap(sdkerrors.ErrInvalidType, "can not convert string to int")
+				}
+				argStTokenAmount = amount
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRedemption(
+				clientCtx.GetFromAddress().String(),
+				argHostZone,
+				argEpoch,
+				argReceiver,
+				argStTokenAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTokenizeRedemptionRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-redemption-record [host-zone] [epoch] [receiver]",
+		Short: "Mints an NFT representing an unclaimed redemption record",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argHostZone := args[0]
+			argEpoch, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argReceiver := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeRedemptionRecord(
+				clientCtx.GetFromAddress().String(),
+				argHostZone,
+				argEpoch,
+				argReceiver,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDetokenizeRedemptionRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "detokenize-redemption-record [host-zone] [epoch] [receiver]",
+		Short: "Burns a redemption record NFT and takes ownership of the record",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argHostZone := args[0]
+			argEpoch, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argReceiver := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDetokenizeRedemptionRecord(
+				clientCtx.GetFromAddress().String(),
+				argHostZone,
+				argEpoch,
+				argReceiver,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdLiquidStakeBasket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquid-stake-basket [basket-id] [amount]",
+		Short: "Liquid stakes across each host zone in a basket to mint the specified number of basket tokens",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argBasketId := args[0]
+			argAmount, found := sdkmath.NewIntFromString(args[1])
+			if !found {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidType, "can not convert string to int")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLiquidStakeBasket(
+				clientCtx.GetFromAddress().String(),
+				argBasketId,
+				argAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRedeemBasket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-basket [basket-id] [amount] [receivers]",
+		Short: "Burns basket tokens and redeems the underlying stTokens from each host zone",
+		Long: strings.TrimSpace(`Burns basket tokens and redeems the underlying stTokens from each host zone.
+Receivers are specified as a comma separated list of {host-zone-id}:{receiver-address}, with one receiver for each host zone in the basket
+
+Ex:
+>>> strided tx stakeibc redeem-basket cosmos 1000000 cosmoshub-4:cosmos1xxx,osmosis-1:osmo1xxx
+`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argBasketId := args[0]
+			argAmount, found := sdkmath.NewIntFromString(args[1])
+			if !found {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidType, "can not convert string to int")
+			}
+
+			receivers := []types.BasketRedemptionReceiver{}
+			for _, receiver := range strings.Split(args[2], ",") {
+				hostZoneId, address, found := strings.Cut(strings.TrimSpace(receiver), ":")
+				if !found {
+					return fmt.Errorf("invalid receiver %s, must be of the form {host-zone-id}:{receiver-address}", receiver)
+				}
+				receivers = append(receivers, types.BasketRedemptionReceiver{HostZoneId: hostZoneId, Receiver: address})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemBasket(
+				clientCtx.GetFromAddress().String(),
+				argBasketId,
+				argAmount,
+				receivers,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdRebalanceValidators() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rebalance-validators [host-zone] [num-to-rebalance]",
@@ -838,3 +1146,103 @@ Ex:
 
 	return cmd
 }
+
+// Parses a comma separated list of circuit breaker actions (e.g. PAUSE_LIQUID_STAKE,PAUSE_REDEEM)
+// Parses validator preferences from a comma separated list of {validator}:{weight} pairs
+func parseValidatorPreferences(preferencesString string) (preferences []types.ValidatorPreference, err error) {
+	for _, preferenceString := range strings.Split(preferencesString, ",") {
+		validator, weightString, found := strings.Cut(strings.TrimSpace(preferenceString), ":")
+		if !found {
+			return nil, fmt.Errorf("invalid validator preference %s, must be of the form {validator}:{weight}", preferenceString)
+		}
+		weight, err := strconv.ParseUint(weightString, 10, 64)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid weight for validator %s", validator)
+		}
+		preferences = append(preferences, types.ValidatorPreference{Validator: validator, Weight: weight})
+	}
+	return preferences, nil
+}
+
+func parseCircuitBreakerActions(actionsString string) (actions []types.CircuitBreakerAction, err error) {
+	for _, actionString := range strings.Split(actionsString, ",") {
+		actionInt, ok := types.CircuitBreakerAction_value[strings.ToUpper(strings.TrimSpace(actionString))]
+		if !ok {
+			return nil, fmt.Errorf("invalid circuit breaker action %s", actionString)
+		}
+		actions = append(actions, types.CircuitBreakerAction(actionInt))
+	}
+	return actions, nil
+}
+
+func CmdTripCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip-circuit-breaker [module] [chain-id] [actions]",
+		Short: "Pauses actions on a host zone in one of the liquid staking modules",
+		Long: strings.TrimSpace(`Pauses actions on a host zone in one of the liquid staking modules (stakeibc, staketia, or stakedym)
+Must be signed by the circuit breaker guardian
+Ex:
+>>> strided tx stakeibc trip-circuit-breaker stakeibc cosmoshub-4 PAUSE_LIQUID_STAKE,PAUSE_REDEEM
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			module := args[0]
+			chainId := args[1]
+			actions, err := parseCircuitBreakerActions(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), module, chainId, actions)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResetCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-circuit-breaker [module] [chain-id] [actions]",
+		Short: "Unpauses actions on a host zone in one of the liquid staking modules",
+		Long: strings.TrimSpace(`Unpauses actions on a host zone in one of the liquid staking modules (stakeibc, staketia, or stakedym)
+Must be signed by the circuit breaker guardian, and cannot reset actions that were paused by governance
+Ex:
+>>> strided tx stakeibc reset-circuit-breaker stakeibc cosmoshub-4 PAUSE_LIQUID_STAKE,PAUSE_REDEEM
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			module := args[0]
+			chainId := args[1]
+			actions, err := parseCircuitBreakerActions(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), module, chainId, actions)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -16,6 +16,12 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 
 	// Iterate over all host zones and verify redemption rate
 	for _, hz := range k.GetAllHostZone(ctx) {
+		// Host zones with the redemption rate guard enabled are degraded or halted by the guard
+		if hz.RedemptionRateGuardConfig != nil && !hz.Deprecated {
+			k.CheckRedemptionRateGuard(ctx, hz)
+			continue
+		}
+
 		rrSafe, err := k.IsRedemptionRateWithinSafetyBounds(ctx, hz)
 		if !rrSafe {
 			hz.Halted = true
@@ -37,6 +43,9 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	}
 
 	k.AssertStrideAndDayEpochRelationship(ctx)
+
+	// Re-register any host zone ICAs whose channel was closed
+	k.RecoverClosedIcaChannels(ctx)
 }
 
 func (k Keeper) EndBlocker(ctx sdk.Context) {
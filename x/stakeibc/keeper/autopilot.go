@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	autopilottypes "github.com/Stride-Labs/stride/v33/x/autopilot/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Returns the actions that stakeibc exposes through autopilot
+func (k Keeper) AutopilotActions() autopilottypes.ModuleActions {
+	return []autopilottypes.AutopilotAction{
+		{
+			Module:  autopilottypes.StakeibcRouteKey,
+			Name:    autopilottypes.LiquidStake,
+			Handler: k.AutopilotLiquidStake,
+		},
+		{
+			Module:  autopilottypes.StakeibcRouteKey,
+			Name:    autopilottypes.LSMLiquidStake,
+			Handler: k.AutopilotLSMLiquidStake,
+		},
+	}
+}
+
+// Autopilot action to liquid stake the tokens from an inbound transfer on behalf of the receiver
+// The host zone is identified from the base denom in the transfer packet (e.g. uatom)
+// Returns the minted stTokens
+func (k Keeper) AutopilotLiquidStake(
+	ctx sdk.Context,
+	input autopilottypes.AutopilotActionInput,
+	_ autopilottypes.ActionMetadata,
+) (stToken sdk.Coin, err error) {
+	msg := &types.MsgLiquidStake{
+		Creator:   input.Receiver,
+		Amount:    input.Token.Amount,
+		HostDenom: input.TransferMetadata.Denom,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return stToken, err
+	}
+
+	msgServer := NewMsgServerImpl(k)
+	msgResponse, err := msgServer.LiquidStake(ctx, msg)
+	if err != nil {
+		return stToken, errorsmod.Wrapf(err, "autopilot liquid stake failed")
+	}
+
+	return msgResponse.StToken, nil
+}
+
+// Autopilot action to liquid stake the LSM tokenized shares from an inbound transfer on behalf of the receiver
+// Returns the minted stTokens, or an empty coin if the liquid stake is waiting on a validator slash query
+// (in which case the LSM liquid stake callbacks are invoked once it finishes)
+func (k Keeper) AutopilotLSMLiquidStake(
+	ctx sdk.Context,
+	input autopilottypes.AutopilotActionInput,
+	_ autopilottypes.ActionMetadata,
+) (stToken sdk.Coin, err error) {
+	msg := &types.MsgLSMLiquidStake{
+		Creator:          input.Receiver,
+		Amount:           input.Token.Amount,
+		LsmTokenIbcDenom: input.Token.Denom,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return stToken, err
+	}
+
+	lsmLiquidStake, transactionComplete, err := k.LSMLiquidStake(ctx, msg)
+	if err != nil {
+		return stToken, errorsmod.Wrapf(err, "autopilot lsm liquid stake failed")
+	}
+	if !transactionComplete {
+		return stToken, nil
+	}
+
+	return lsmLiquidStake.Deposit.StToken, nil
+}
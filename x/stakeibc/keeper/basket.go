@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Baskets let a user hold stTokens from several host zones as a single fungible basket token
+//
+// Each basket token is backed by a fixed quantity (the component weight) of each
+// component's stToken, which is held in the basket's reserve account
+// As each stToken accrues staking rewards, the native value behind each basket token
+// grows with it, so the basket's redemption rate for a component is simply:
+//
+//	component redemption rate = weight * host zone redemption rate
+//
+// Minting and redeeming are built on the normal LiquidStake and RedeemStake flows
+
+// Stores a basket
+func (k Keeper) SetBasket(ctx sdk.Context, basket types.Basket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BasketKeyPrefix))
+	b := k.cdc.MustMarshal(&basket)
+	store.Set([]byte(basket.Id), b)
+}
+
+// Reads a basket from the store
+func (k Keeper) GetBasket(ctx sdk.Context, basketId string) (basket types.Basket, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BasketKeyPrefix))
+	b := store.Get([]byte(basketId))
+	if len(b) == 0 {
+		return basket, false
+	}
+	k.cdc.MustUnmarshal(b, &basket)
+	return basket, true
+}
+
+// Returns all baskets
+func (k Keeper) GetAllBaskets(ctx sdk.Context) (baskets []types.Basket) {
+	baskets = []types.Basket{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BasketKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var basket types.Basket
+		k.cdc.MustUnmarshal(iterator.Value(), &basket)
+		baskets = append(baskets, basket)
+	}
+
+	return baskets
+}
+
+// Registers a new basket and creates the reserve account that will hold its stTokens
+func (k Keeper) CreateBasket(ctx sdk.Context, basketId string, components []types.BasketComponent) error {
+	if _, found := k.GetBasket(ctx, basketId); found {
+		return errorsmod.Wrapf(types.ErrBasketAlreadyExists, "basket %s already exists", basketId)
+	}
+	for _, component := range components {
+		if _, found := k.GetHostZone(ctx, component.HostZoneId); !found {
+			return errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", component.HostZoneId)
+		}
+	}
+
+	reserveAddress := types.NewBasketReserveAddress(basketId)
+	if err := utils.CreateModuleAccount(ctx, k.AccountKeeper, reserveAddress); err != nil {
+		return errorsmod.Wrapf(err, "unable to create reserve account for basket %s", basketId)
+	}
+
+	basket := types.Basket{
+		Id:             basketId,
+		Denom:          types.BasketDenomFromId(basketId),
+		ReserveAddress: reserveAddress.String(),
+		Components:     components,
+	}
+	if err := basket.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidBasket, err.Error())
+	}
+	k.SetBasket(ctx, basket)
+
+	return nil
+}
+
+// Returns the number of native tokens each basket token can be redeemed for, from each component
+func (k Keeper) GetBasketRedemptionRates(ctx sdk.Context, basket types.Basket) ([]types.BasketComponentRedemptionRate, error) {
+	redemptionRates := []types.BasketComponentRedemptionRate{}
+	for _, component := range basket.Components {
+		hostZone, found := k.GetHostZone(ctx, component.HostZoneId)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", component.HostZoneId)
+		}
+		redemptionRates = append(redemptionRates, types.BasketComponentRedemptionRate{
+			HostZoneId:     hostZone.ChainId,
+			HostDenom:      hostZone.HostDenom,
+			RedemptionRate: component.Weight.Mul(hostZone.RedemptionRate),
+		})
+	}
+	return redemptionRates, nil
+}
+
+// Returns the number of native tokens that must be liquid staked to receive at least
+// the specified number of stTokens, after the host zone's liquid stake fee is deducted
+func GetNativeAmountForStTokens(stAmount sdkmath.Int, redemptionRate, liquidStakeFeeRate sdkmath.LegacyDec) sdkmath.Int {
+	nativeAmount := sdkmath.LegacyNewDecFromInt(stAmount).Mul(redemptionRate).Ceil().TruncateInt()
+
+	// Liquid stakes truncate the stToken amount, so bump the native amount if rounding would
+	// leave the user short
+	if sdkmath.LegacyNewDecFromInt(nativeAmount).Quo(redemptionRate).TruncateInt().LT(stAmount) {
+		nativeAmount = nativeAmount.Add(sdkmath.OneInt())
+	}
+	if liquidStakeFeeRate.IsZero() {
+		return nativeAmount
+	}
+
+	// The fee is taken out of the deposit before the stTokens are minted, so gross up
+	// the amount such that what's left after the fee still covers the stTokens
+	grossAmount := sdkmath.LegacyNewDecFromInt(nativeAmount).Quo(sdkmath.LegacyOneDec().Sub(liquidStakeFeeRate)).Ceil().TruncateInt()
+	feeAmount := sdkmath.LegacyNewDecFromInt(grossAmount).Mul(liquidStakeFeeRate).TruncateInt()
+	if grossAmount.Sub(feeAmount).LT(nativeAmount) {
+		grossAmount = grossAmount.Add(sdkmath.OneInt())
+	}
+	return grossAmount
+}
+
+// Liquid stakes into each host zone in the basket and mints the requested number of basket tokens
+//
+// For each component, the native tokens required to back the basket tokens are liquid staked
+// from the user's account, and the resulting stTokens are moved into the basket's reserve
+// Any stToken dust from rounding is left with the user
+func (k Keeper) LiquidStakeBasket(ctx sdk.Context, msg *types.MsgLiquidStakeBasket) (*types.MsgLiquidStakeBasketResponse, error) {
+	basket, found := k.GetBasket(ctx, msg.BasketId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrBasketNotFound, "basket %s not found", msg.BasketId)
+	}
+
+	staker, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "user's address is invalid")
+	}
+	reserveAddress, err := sdk.AccAddressFromBech32(basket.ReserveAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid reserve address for basket %s", basket.Id)
+	}
+
+	// TODO: Move LS function to keeper method instead of message server
+	msgServer := NewMsgServerImpl(k)
+
+	nativeTokens := sdk.NewCoins()
+	for _, component := range basket.Components {
+		hostZone, err := k.GetActiveHostZone(ctx, component.HostZoneId)
+		if err != nil {
+			return nil, err
+		}
+
+		stAmount := component.StTokenAmount(msg.Amount, true)
+		liquidStakeFeeRate := k.GetHostZoneFeeSchedule(ctx, hostZone).LiquidStakeFeeRate
+		nativeAmount := GetNativeAmountForStTokens(stAmount, hostZone.RedemptionRate, liquidStakeFeeRate)
+
+		_, err = msgServer.LiquidStake(ctx, &types.MsgLiquidStake{
+			Creator:   msg.Creator,
+			Amount:    nativeAmount,
+			HostDenom: hostZone.HostDenom,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to liquid stake basket component %s", hostZone.ChainId)
+		}
+
+		stToken := sdk.NewCoin(types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom), stAmount)
+		if err := k.bankKeeper.SendCoins(ctx, staker, reserveAddress, sdk.NewCoins(stToken)); err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to send %v to basket reserve", stToken)
+		}
+
+		nativeTokens = nativeTokens.Add(sdk.NewCoin(hostZone.IbcDenom, nativeAmount))
+	}
+
+	// Mint the basket tokens to the user
+	basketToken := sdk.NewCoin(basket.Denom, msg.Amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(basketToken)); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to mint basket tokens")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, staker, sdk.NewCoins(basketToken)); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to send %v to user", basketToken)
+	}
+
+	EmitSuccessfulLiquidStakeBasketEvent(ctx, msg, basket, nativeTokens)
+
+	return &types.MsgLiquidStakeBasketResponse{BasketToken: basketToken, NativeTokens: nativeTokens}, nil
+}
+
+// Burns basket tokens and redeems the underlying stTokens from each host zone
+//
+// The stTokens backing the basket tokens are released from the reserve to the user,
+// who then redeems them through the normal RedeemStake flow with the receiver
+// they specified for that host zone
+func (k Keeper) RedeemBasket(ctx sdk.Context, msg *types.MsgRedeemBasket) (*types.MsgRedeemBasketResponse, error) {
+	basket, found := k.GetBasket(ctx, msg.BasketId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrBasketNotFound, "basket %s not found", msg.BasketId)
+	}
+
+	redeemer, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "user's address is invalid")
+	}
+	reserveAddress, err := sdk.AccAddressFromBech32(basket.ReserveAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid reserve address for basket %s", basket.Id)
+	}
+
+	// Confirm a receiver was provided for each component
+	receivers := map[string]string{}
+	for _, receiver := range msg.Receivers {
+		receivers[receiver.HostZoneId] = receiver.Receiver
+	}
+	for _, component := range basket.Components {
+		if _, ok := receivers[component.HostZoneId]; !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidBasket, "no receiver provided for basket component %s", component.HostZoneId)
+		}
+	}
+
+	// Burn the basket tokens
+	basketToken := sdk.NewCoin(basket.Denom, msg.Amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleName, sdk.NewCoins(basketToken)); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to send %v from user", basketToken)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(basketToken)); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to burn basket tokens")
+	}
+
+	// Release each component's stTokens and redeem them
+	for _, component := range basket.Components {
+		hostZone, found := k.GetHostZone(ctx, component.HostZoneId)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", component.HostZoneId)
+		}
+
+		stAmount := component.StTokenAmount(msg.Amount, false)
+		if stAmount.IsZero() {
+			continue
+		}
+
+		stToken := sdk.NewCoin(types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom), stAmount)
+		if err := k.bankKeeper.SendCoins(ctx, reserveAddress, redeemer, sdk.NewCoins(stToken)); err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to release %v from basket reserve", stToken)
+		}
+
+		_, err := k.RedeemStake(ctx, &types.MsgRedeemStake{
+			Creator:  msg.Creator,
+			Amount:   stAmount,
+			HostZone: hostZone.ChainId,
+			Receiver: receivers[hostZone.ChainId],
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to redeem basket component %s", hostZone.ChainId)
+		}
+	}
+
+	EmitSuccessfulRedeemBasketEvent(ctx, msg, basket)
+
+	return &types.MsgRedeemBasketResponse{}, nil
+}
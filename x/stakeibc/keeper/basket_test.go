@@ -0,0 +1,342 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+const (
+	BasketId          = "index"
+	BasketDenom       = "stbasket/index"
+	OsmoHostAddress   = "osmo1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrqyeqwq"
+	BasketEpochNumber = uint64(1)
+)
+
+type BasketTestCase struct {
+	user           sdk.AccAddress
+	reserveAddress sdk.AccAddress
+}
+
+// Creates a basket backed by 0.5 stATOM (RR 1.0) and 2 stOSMO (RR 1.25) per basket token
+func (s *KeeperTestSuite) SetupBasket() BasketTestCase {
+	user := s.TestAccs[0]
+	s.FundAccount(user, sdk.NewInt64Coin(IbcAtom, 10_000))
+	s.FundAccount(user, sdk.NewInt64Coin(IbcOsmo, 10_000))
+
+	hostZones := []types.HostZone{
+		{
+			ChainId:            HostChainId,
+			HostDenom:          Atom,
+			IbcDenom:           IbcAtom,
+			Bech32Prefix:       GaiaPrefix,
+			RedemptionRate:     sdkmath.LegacyOneDec(),
+			TotalDelegations:   sdkmath.NewInt(1_000_000),
+			DepositAddress:     types.NewHostZoneDepositAddress(HostChainId).String(),
+			RedemptionsEnabled: true,
+		},
+		{
+			ChainId:            OsmoChainId,
+			HostDenom:          Osmo,
+			IbcDenom:           IbcOsmo,
+			Bech32Prefix:       OsmoPrefix,
+			RedemptionRate:     sdkmath.LegacyMustNewDecFromStr("1.25"),
+			TotalDelegations:   sdkmath.NewInt(1_000_000),
+			DepositAddress:     types.NewHostZoneDepositAddress(OsmoChainId).String(),
+			RedemptionsEnabled: true,
+		},
+	}
+
+	epochUnbondingRecord := recordtypes.EpochUnbondingRecord{EpochNumber: BasketEpochNumber}
+	for i, hostZone := range hostZones {
+		s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+		s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+			Id:                 uint64(i),
+			DepositEpochNumber: BasketEpochNumber,
+			HostZoneId:         hostZone.ChainId,
+			Amount:             sdkmath.ZeroInt(),
+			Status:             recordtypes.DepositRecord_TRANSFER_QUEUE,
+		})
+		epochUnbondingRecord.HostZoneUnbondings = append(epochUnbondingRecord.HostZoneUnbondings, &recordtypes.HostZoneUnbonding{
+			HostZoneId:        hostZone.ChainId,
+			Denom:             hostZone.HostDenom,
+			NativeTokenAmount: sdkmath.ZeroInt(),
+			StTokenAmount:     sdkmath.ZeroInt(),
+			Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+		})
+	}
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, epochUnbondingRecord)
+
+	for _, epochIdentifier := range []string{epochtypes.STRIDE_EPOCH, epochtypes.DAY_EPOCH} {
+		s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+			EpochIdentifier: epochIdentifier,
+			EpochNumber:     BasketEpochNumber,
+		})
+	}
+
+	_, err := s.GetMsgServer().CreateBasket(s.Ctx, &types.MsgCreateBasket{
+		Authority: Authority,
+		BasketId:  BasketId,
+		Components: []types.BasketComponent{
+			{HostZoneId: HostChainId, Weight: sdkmath.LegacyMustNewDecFromStr("0.5")},
+			{HostZoneId: OsmoChainId, Weight: sdkmath.LegacyMustNewDecFromStr("2")},
+		},
+	})
+	s.Require().NoError(err, "no error expected when creating basket")
+
+	return BasketTestCase{
+		user:           user,
+		reserveAddress: types.NewBasketReserveAddress(BasketId),
+	}
+}
+
+func (s *KeeperTestSuite) TestCreateBasket() {
+	tc := s.SetupBasket()
+
+	basket, found := s.App.StakeibcKeeper.GetBasket(s.Ctx, BasketId)
+	s.Require().True(found, "basket should have been created")
+	s.Require().Equal(BasketDenom, basket.Denom, "basket denom")
+	s.Require().Equal(tc.reserveAddress.String(), basket.ReserveAddress, "basket reserve address")
+	s.Require().NotNil(s.App.AccountKeeper.GetAccount(s.Ctx, tc.reserveAddress), "reserve account should exist")
+
+	validMsg := types.MsgCreateBasket{
+		Authority: Authority,
+		BasketId:  "other",
+		Components: []types.BasketComponent{
+			{HostZoneId: HostChainId, Weight: sdkmath.LegacyOneDec()},
+			{HostZoneId: OsmoChainId, Weight: sdkmath.LegacyOneDec()},
+		},
+	}
+
+	// Basket already exists
+	msg := validMsg
+	msg.BasketId = BasketId
+	_, err := s.GetMsgServer().CreateBasket(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrBasketAlreadyExists)
+
+	// Host zone not found
+	msg = validMsg
+	msg.Components = []types.BasketComponent{
+		{HostZoneId: HostChainId, Weight: sdkmath.LegacyOneDec()},
+		{HostZoneId: "fake-chain", Weight: sdkmath.LegacyOneDec()},
+	}
+	_, err = s.GetMsgServer().CreateBasket(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "host zone fake-chain not found")
+
+	// Invalid authority
+	msg = validMsg
+	msg.Authority = "not-gov-address"
+	_, err = s.GetMsgServer().CreateBasket(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "invalid authority")
+
+	// Valid second basket
+	_, err = s.GetMsgServer().CreateBasket(s.Ctx, &validMsg)
+	s.Require().NoError(err, "no error expected when creating second basket")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllBaskets(s.Ctx), 2, "number of baskets")
+}
+
+func (s *KeeperTestSuite) TestGetNativeAmountForStTokens() {
+	testCases := []struct {
+		name           string
+		stAmount       int64
+		redemptionRate string
+		feeRate        string
+		expectedNative int64
+	}{
+		{name: "redemption rate of one", stAmount: 100, redemptionRate: "1.0", feeRate: "0", expectedNative: 100},
+		{name: "no rounding", stAmount: 2000, redemptionRate: "1.25", feeRate: "0", expectedNative: 2500},
+		{name: "rounds up", stAmount: 3, redemptionRate: "1.3", feeRate: "0", expectedNative: 4},
+		{name: "redemption rate below one", stAmount: 10, redemptionRate: "0.95", feeRate: "0", expectedNative: 10},
+		{name: "liquid stake fee", stAmount: 900, redemptionRate: "1.0", feeRate: "0.1", expectedNative: 1000},
+		{name: "liquid stake fee with rounding", stAmount: 1000, redemptionRate: "1.0", feeRate: "0.1", expectedNative: 1112},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			redemptionRate := sdkmath.LegacyMustNewDecFromStr(tc.redemptionRate)
+			feeRate := sdkmath.LegacyMustNewDecFromStr(tc.feeRate)
+			nativeAmount := keeper.GetNativeAmountForStTokens(sdkmath.NewInt(tc.stAmount), redemptionRate, feeRate)
+			s.Require().Equal(tc.expectedNative, nativeAmount.Int64(), "native amount")
+
+			// Liquid staking the native amount should always return at least the requested stTokens
+			feeAmount := sdkmath.LegacyNewDecFromInt(nativeAmount).Mul(feeRate).TruncateInt()
+			stAmount := sdkmath.LegacyNewDecFromInt(nativeAmount.Sub(feeAmount)).Quo(redemptionRate).TruncateInt()
+			s.Require().True(stAmount.GTE(sdkmath.NewInt(tc.stAmount)), "sttokens received")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLiquidStakeBasket_Successful() {
+	tc := s.SetupBasket()
+
+	resp, err := s.GetMsgServer().LiquidStakeBasket(s.Ctx, &types.MsgLiquidStakeBasket{
+		Creator:  tc.user.String(),
+		BasketId: BasketId,
+		Amount:   sdkmath.NewInt(1000),
+	})
+	s.Require().NoError(err, "no error expected when liquid staking basket")
+
+	// 1000 basket tokens require 500 stATOM (500 ATOM) and 2000 stOSMO (2500 OSMO)
+	s.Require().Equal(sdk.NewInt64Coin(BasketDenom, 1000), resp.BasketToken, "basket token in response")
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(IbcAtom, 500), sdk.NewInt64Coin(IbcOsmo, 2500)), resp.NativeTokens, "native tokens")
+
+	s.Require().Equal(int64(1000), s.App.BankKeeper.GetBalance(s.Ctx, tc.user, BasketDenom).Amount.Int64(), "user basket balance")
+	s.Require().Equal(int64(9500), s.App.BankKeeper.GetBalance(s.Ctx, tc.user, IbcAtom).Amount.Int64(), "user atom balance")
+	s.Require().Equal(int64(7500), s.App.BankKeeper.GetBalance(s.Ctx, tc.user, IbcOsmo).Amount.Int64(), "user osmo balance")
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, tc.user, StAtom).Amount.Int64(), "user statom balance")
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, tc.user, StOsmo).Amount.Int64(), "user stosmo balance")
+
+	s.Require().Equal(int64(500), s.App.BankKeeper.GetBalance(s.Ctx, tc.reserveAddress, StAtom).Amount.Int64(), "reserve statom balance")
+	s.Require().Equal(int64(2000), s.App.BankKeeper.GetBalance(s.Ctx, tc.reserveAddress, StOsmo).Amount.Int64(), "reserve stosmo balance")
+}
+
+func (s *KeeperTestSuite) TestLiquidStakeBasket_Failures() {
+	tc := s.SetupBasket()
+
+	validMsg := types.MsgLiquidStakeBasket{
+		Creator:  tc.user.String(),
+		BasketId: BasketId,
+		Amount:   sdkmath.NewInt(1000),
+	}
+
+	// Basket not found
+	msg := validMsg
+	msg.BasketId = "fake"
+	_, err := s.GetMsgServer().LiquidStakeBasket(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrBasketNotFound)
+
+	// Insufficient balance for one of the components
+	msg = validMsg
+	msg.Amount = sdkmath.NewInt(10_000)
+	_, err = s.GetMsgServer().LiquidStakeBasket(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "unable to liquid stake basket component OSMO")
+
+	// Halted component
+	hostZone := s.MustGetHostZone(OsmoChainId)
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err = s.GetMsgServer().LiquidStakeBasket(s.Ctx, &validMsg)
+	s.Require().ErrorIs(err, types.ErrHaltedHostZone)
+}
+
+func (s *KeeperTestSuite) TestRedeemBasket_Successful() {
+	tc := s.SetupBasket()
+
+	_, err := s.GetMsgServer().LiquidStakeBasket(s.Ctx, &types.MsgLiquidStakeBasket{
+		Creator:  tc.user.String(),
+		BasketId: BasketId,
+		Amount:   sdkmath.NewInt(1000),
+	})
+	s.Require().NoError(err, "no error expected when liquid staking basket")
+
+	_, err = s.GetMsgServer().RedeemBasket(s.Ctx, &types.MsgRedeemBasket{
+		Creator:  tc.user.String(),
+		BasketId: BasketId,
+		Amount:   sdkmath.NewInt(400),
+		Receivers: []types.BasketRedemptionReceiver{
+			{HostZoneId: HostChainId, Receiver: ValidHostAddress},
+			{HostZoneId: OsmoChainId, Receiver: OsmoHostAddress},
+		},
+	})
+	s.Require().NoError(err, "no error expected when redeeming basket")
+
+	// 400 basket tokens are backed by 200 stATOM and 800 stOSMO
+	s.Require().Equal(int64(600), s.App.BankKeeper.GetBalance(s.Ctx, tc.user, BasketDenom).Amount.Int64(), "user basket balance")
+	s.Require().Equal(int64(600), s.App.BankKeeper.GetSupply(s.Ctx, BasketDenom).Amount.Int64(), "basket supply")
+	s.Require().Equal(int64(300), s.App.BankKeeper.GetBalance(s.Ctx, tc.reserveAddress, StAtom).Amount.Int64(), "reserve statom balance")
+	s.Require().Equal(int64(1200), s.App.BankKeeper.GetBalance(s.Ctx, tc.reserveAddress, StOsmo).Amount.Int64(), "reserve stosmo balance")
+
+	// A redemption record should be created for each host zone, owned by the user
+	expectedRecords := []struct {
+		chainId  string
+		receiver string
+		stAmount int64
+		native   int64
+	}{
+		{chainId: HostChainId, receiver: ValidHostAddress, stAmount: 200, native: 200},
+		{chainId: OsmoChainId, receiver: OsmoHostAddress, stAmount: 800, native: 1000},
+	}
+	for _, expected := range expectedRecords {
+		recordId := recordtypes.UserRedemptionRecordKeyFormatter(expected.chainId, BasketEpochNumber, expected.receiver)
+		record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+		s.Require().True(found, "redemption record for %s", expected.chainId)
+		s.Require().Equal(expected.stAmount, record.StTokenAmount.Int64(), "sttoken amount for %s", expected.chainId)
+		s.Require().Equal(expected.native, record.NativeTokenAmount.Int64(), "native amount for %s", expected.chainId)
+		s.Require().Equal(tc.user.String(), record.Owner, "owner for %s", expected.chainId)
+	}
+}
+
+func (s *KeeperTestSuite) TestRedeemBasket_Failures() {
+	tc := s.SetupBasket()
+
+	_, err := s.GetMsgServer().LiquidStakeBasket(s.Ctx, &types.MsgLiquidStakeBasket{
+		Creator:  tc.user.String(),
+		BasketId: BasketId,
+		Amount:   sdkmath.NewInt(1000),
+	})
+	s.Require().NoError(err, "no error expected when liquid staking basket")
+
+	validMsg := types.MsgRedeemBasket{
+		Creator:  tc.user.String(),
+		BasketId: BasketId,
+		Amount:   sdkmath.NewInt(400),
+		Receivers: []types.BasketRedemptionReceiver{
+			{HostZoneId: HostChainId, Receiver: ValidHostAddress},
+			{HostZoneId: OsmoChainId, Receiver: OsmoHostAddress},
+		},
+	}
+
+	// Basket not found
+	msg := validMsg
+	msg.BasketId = "fake"
+	_, err = s.GetMsgServer().RedeemBasket(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrBasketNotFound)
+
+	// Missing receiver
+	msg = validMsg
+	msg.Receivers = validMsg.Receivers[:1]
+	_, err = s.GetMsgServer().RedeemBasket(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "no receiver provided for basket component OSMO")
+
+	// Invalid receiver for the host zone
+	msg = validMsg
+	msg.Receivers = []types.BasketRedemptionReceiver{
+		{HostZoneId: HostChainId, Receiver: ValidHostAddress},
+		{HostZoneId: OsmoChainId, Receiver: ValidHostAddress},
+	}
+	_, err = s.GetMsgServer().RedeemBasket(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "unable to redeem basket component OSMO")
+
+	// Insufficient basket tokens
+	msg = validMsg
+	msg.Amount = sdkmath.NewInt(1001)
+	_, err = s.GetMsgServer().RedeemBasket(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "insufficient funds")
+}
+
+func (s *KeeperTestSuite) TestQueryBasket() {
+	s.SetupBasket()
+
+	resp, err := s.App.StakeibcKeeper.Basket(s.Ctx, &types.QueryBasketRequest{BasketId: BasketId})
+	s.Require().NoError(err, "no error expected when querying basket")
+	s.Require().Equal(BasketDenom, resp.Basket.Denom, "basket denom")
+
+	expectedRates := []types.BasketComponentRedemptionRate{
+		{HostZoneId: HostChainId, HostDenom: Atom, RedemptionRate: sdkmath.LegacyMustNewDecFromStr("0.5")},
+		{HostZoneId: OsmoChainId, HostDenom: Osmo, RedemptionRate: sdkmath.LegacyMustNewDecFromStr("2.5")},
+	}
+	s.Require().Equal(expectedRates, resp.RedemptionRates, "redemption rates")
+
+	_, err = s.App.StakeibcKeeper.Basket(s.Ctx, &types.QueryBasketRequest{BasketId: "fake"})
+	s.Require().ErrorContains(err, "basket fake not found")
+
+	allResp, err := s.App.StakeibcKeeper.AllBaskets(s.Ctx, &types.QueryAllBasketsRequest{})
+	s.Require().NoError(err, "no error expected when querying all baskets")
+	s.Require().Len(allResp.Baskets, 1, "number of baskets")
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Cancels all or part of a user's redemption and returns the escrowed stTokens to the record owner
+//
+// Redemptions can only be cancelled while the host zone unbonding is still in status
+// UNBONDING_QUEUE, meaning the undelegation has not been submitted and the stTokens are
+// still sitting in the deposit account
+// The native amount removed from the record is proportional to the stTokens cancelled,
+// and both amounts are deducted from the host zone unbonding
+func (k Keeper) CancelRedemption(ctx sdk.Context, msg *types.MsgCancelRedemption) (*types.MsgCancelRedemptionResponse, error) {
+	hostZone, err := k.GetActiveHostZone(ctx, msg.HostZoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Confirm the record exists and is owned by the sender
+	recordId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, msg.Epoch, msg.Receiver)
+	userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, recordId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"user redemption record %s not found on host zone %s", recordId, hostZone.ChainId)
+	}
+	// If the record is tokenized, the owner is the holder of the NFT
+	recordOwner := k.GetRedemptionRecordOwner(ctx, userRedemptionRecord)
+	if recordOwner == "" || recordOwner != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionRecordNotOwned,
+			"user redemption record %s is not owned by %s", recordId, msg.Creator)
+	}
+
+	// Confirm the unbonding has not started yet
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, msg.Epoch, hostZone.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"host zone unbonding not found for epoch %d on host zone %s", msg.Epoch, hostZone.ChainId)
+	}
+	if hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_UNBONDING_QUEUE {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"user redemption record %s cannot be cancelled, host zone unbonding has status: %s, requires status UNBONDING_QUEUE",
+			recordId, hostZoneUnbonding.Status)
+	}
+
+	// Determine the amount to cancel, defaulting to the full record
+	stAmount := userRedemptionRecord.StTokenAmount
+	if !msg.StTokenAmount.IsNil() && msg.StTokenAmount.IsPositive() {
+		stAmount = msg.StTokenAmount
+	}
+	if stAmount.GT(userRedemptionRecord.StTokenAmount) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount,
+			"cannot cancel %v stTokens, user redemption record %s only has %v",
+			stAmount, recordId, userRedemptionRecord.StTokenAmount)
+	}
+	if !stAmount.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "user redemption record %s has no stTokens to cancel", recordId)
+	}
+
+	nativeAmount := userRedemptionRecord.NativeTokenAmount
+	cancellingFullRecord := stAmount.Equal(userRedemptionRecord.StTokenAmount)
+	if !cancellingFullRecord {
+		nativeAmount = sdkmath.LegacyNewDecFromInt(userRedemptionRecord.NativeTokenAmount).
+			MulInt(stAmount).
+			QuoInt(userRedemptionRecord.StTokenAmount).
+			TruncateInt()
+	}
+
+	// Return the escrowed stTokens from the deposit account
+	depositAddress, err := sdk.AccAddressFromBech32(hostZone.DepositAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid deposit address for %s", hostZone.ChainId)
+	}
+	owner, err := sdk.AccAddressFromBech32(recordOwner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid owner address on user redemption record %s", recordId)
+	}
+	stToken := sdk.NewCoin(types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom), stAmount)
+	if err := k.bankKeeper.SendCoins(ctx, depositAddress, owner, sdk.NewCoins(stToken)); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to return stTokens from deposit account")
+	}
+
+	// Update the user redemption record, removing it (and burning its NFT) if the full amount was cancelled
+	if cancellingFullRecord {
+		if err := k.BurnRedemptionRecordNFT(ctx, userRedemptionRecord); err != nil {
+			return nil, err
+		}
+		k.RecordsKeeper.RemoveUserRedemptionRecord(ctx, recordId)
+
+		updatedRecordIds := []string{}
+		for _, id := range hostZoneUnbonding.UserRedemptionRecords {
+			if id != recordId {
+				updatedRecordIds = append(updatedRecordIds, id)
+			}
+		}
+		hostZoneUnbonding.UserRedemptionRecords = updatedRecordIds
+	} else {
+		userRedemptionRecord.StTokenAmount = userRedemptionRecord.StTokenAmount.Sub(stAmount)
+		userRedemptionRecord.NativeTokenAmount = userRedemptionRecord.NativeTokenAmount.Sub(nativeAmount)
+		k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+	}
+
+	// Deduct the cancelled amounts from the host zone unbonding
+	hostZoneUnbonding.StTokenAmount = sdkmath.MaxInt(hostZoneUnbonding.StTokenAmount.Sub(stAmount), sdkmath.ZeroInt())
+	hostZoneUnbonding.NativeTokenAmount = sdkmath.MaxInt(hostZoneUnbonding.NativeTokenAmount.Sub(nativeAmount), sdkmath.ZeroInt())
+	if err := k.RecordsKeeper.SetHostZoneUnbondingRecord(ctx, msg.Epoch, hostZone.ChainId, *hostZoneUnbonding); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Cancelled redemption of %v stTokens from user redemption record %s", stAmount, recordId))
+	EmitRedemptionCancelledEvent(ctx, msg, hostZone, nativeAmount, stAmount)
+
+	return &types.MsgCancelRedemptionResponse{StToken: stToken}, nil
+}
@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+type CancelRedemptionTestCase struct {
+	owner       sdk.AccAddress
+	recordId    string
+	epochNumber uint64
+	validMsg    types.MsgCancelRedemption
+}
+
+func (s *KeeperTestSuite) SetupCancelRedemption() CancelRedemptionTestCase {
+	owner := s.TestAccs[0]
+	receiver := "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf"
+	epochNumber := uint64(1)
+
+	// The escrowed stTokens from the redemption sit in the deposit account
+	depositAddress := types.NewHostZoneDepositAddress(HostChainId)
+	s.FundAccount(depositAddress, sdk.NewInt64Coin(StAtom, 1500))
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:        HostChainId,
+		HostDenom:      Atom,
+		Bech32Prefix:   "cosmos",
+		DepositAddress: depositAddress.String(),
+	})
+
+	// Create the user's record alongside another user's record in the same epoch
+	recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, receiver)
+	otherRecordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, "cosmosXXX")
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:                recordId,
+		Receiver:          receiver,
+		HostZoneId:        HostChainId,
+		EpochNumber:       epochNumber,
+		Denom:             Atom,
+		NativeTokenAmount: sdkmath.NewInt(1500),
+		StTokenAmount:     sdkmath.NewInt(1000),
+		Owner:             owner.String(),
+	})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: epochNumber,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+			HostZoneId:            HostChainId,
+			Status:                recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+			NativeTokenAmount:     sdkmath.NewInt(2250),
+			StTokenAmount:         sdkmath.NewInt(1500),
+			UserRedemptionRecords: []string{otherRecordId, recordId},
+		}},
+	})
+
+	return CancelRedemptionTestCase{
+		owner:       owner,
+		recordId:    recordId,
+		epochNumber: epochNumber,
+		validMsg: types.MsgCancelRedemption{
+			Creator:    owner.String(),
+			HostZoneId: HostChainId,
+			Epoch:      epochNumber,
+			Receiver:   receiver,
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_Full() {
+	tc := s.SetupCancelRedemption()
+
+	resp, err := s.GetMsgServer().CancelRedemption(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected when cancelling redemption")
+	s.Require().Equal(sdk.NewInt64Coin(StAtom, 1000), resp.StToken, "returned sttokens")
+
+	// The stTokens should be returned to the owner
+	s.Require().Equal(int64(1000), s.App.BankKeeper.GetBalance(s.Ctx, tc.owner, StAtom).Amount.Int64(), "owner balance")
+
+	// The record should be removed, and the host zone unbonding should be decremented
+	_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.recordId)
+	s.Require().False(found, "record should have been removed")
+
+	otherRecordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, "cosmosXXX")
+	hostZoneUnbonding := s.MustGetHostZoneUnbonding(tc.epochNumber, HostChainId)
+	s.Require().Equal([]string{otherRecordId}, hostZoneUnbonding.UserRedemptionRecords, "host zone unbonding record ids")
+	s.Require().Equal(int64(500), hostZoneUnbonding.StTokenAmount.Int64(), "host zone unbonding sttoken amount")
+	s.Require().Equal(int64(750), hostZoneUnbonding.NativeTokenAmount.Int64(), "host zone unbonding native amount")
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_Partial() {
+	tc := s.SetupCancelRedemption()
+
+	// Cancel 40% of the redemption
+	msg := tc.validMsg
+	msg.StTokenAmount = sdkmath.NewInt(400)
+
+	resp, err := s.GetMsgServer().CancelRedemption(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when partially cancelling redemption")
+	s.Require().Equal(sdk.NewInt64Coin(StAtom, 400), resp.StToken, "returned sttokens")
+	s.Require().Equal(int64(400), s.App.BankKeeper.GetBalance(s.Ctx, tc.owner, StAtom).Amount.Int64(), "owner balance")
+
+	// The native amount should be reduced proportionally
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.recordId)
+	s.Require().True(found, "record should still exist")
+	s.Require().Equal(int64(600), record.StTokenAmount.Int64(), "record sttoken amount")
+	s.Require().Equal(int64(900), record.NativeTokenAmount.Int64(), "record native amount")
+
+	hostZoneUnbonding := s.MustGetHostZoneUnbonding(tc.epochNumber, HostChainId)
+	s.Require().Len(hostZoneUnbonding.UserRedemptionRecords, 2, "host zone unbonding record ids")
+	s.Require().Equal(int64(1100), hostZoneUnbonding.StTokenAmount.Int64(), "host zone unbonding sttoken amount")
+	s.Require().Equal(int64(1650), hostZoneUnbonding.NativeTokenAmount.Int64(), "host zone unbonding native amount")
+
+	// Cancelling more than remains should fail
+	msg.StTokenAmount = sdkmath.NewInt(601)
+	_, err = s.GetMsgServer().CancelRedemption(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "cannot cancel 601 stTokens")
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_UnbondingStarted() {
+	tc := s.SetupCancelRedemption()
+
+	hostZoneUnbonding := s.MustGetHostZoneUnbonding(tc.epochNumber, HostChainId)
+	hostZoneUnbonding.Status = recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS
+	err := s.App.RecordsKeeper.SetHostZoneUnbondingRecord(s.Ctx, tc.epochNumber, HostChainId, hostZoneUnbonding)
+	s.Require().NoError(err, "no error expected when setting host zone unbonding")
+
+	_, err = s.GetMsgServer().CancelRedemption(s.Ctx, &tc.validMsg)
+	s.Require().ErrorContains(err, "requires status UNBONDING_QUEUE")
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_NotOwner() {
+	tc := s.SetupCancelRedemption()
+
+	msg := tc.validMsg
+	msg.Creator = s.TestAccs[1].String()
+
+	_, err := s.GetMsgServer().CancelRedemption(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordNotOwned)
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_RecordNotFound() {
+	tc := s.SetupCancelRedemption()
+
+	msg := tc.validMsg
+	msg.Receiver = "cosmosYYY"
+
+	_, err := s.GetMsgServer().CancelRedemption(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "user redemption record GAIA.1.cosmosYYY not found")
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_HaltedZone() {
+	tc := s.SetupCancelRedemption()
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().CancelRedemption(s.Ctx, &tc.validMsg)
+	s.Require().ErrorContains(err, "halted")
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_AfterRedeemFromDifferentAccount() {
+	tc := s.SetupRedeemStake()
+	owner := tc.user.acc
+
+	_, err := s.GetMsgServer().RedeemStake(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected during redemption")
+
+	// A second account attempts to redeem dust into the owner's record, which should be rejected
+	otherRedeemer := s.TestAccs[1]
+	s.FundAccount(otherRedeemer, sdk.NewInt64Coin("stuatom", 1))
+
+	dustMsg := tc.validMsg
+	dustMsg.Creator = otherRedeemer.String()
+	dustMsg.Amount = sdkmath.NewInt(1)
+	_, err = s.GetMsgServer().RedeemStake(s.Ctx, &dustMsg)
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordNotOwned)
+
+	// The owner should still be able to cancel their full redemption
+	ownerBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, owner, "stuatom")
+	resp, err := s.GetMsgServer().CancelRedemption(s.Ctx, &types.MsgCancelRedemption{
+		Creator:    owner.String(),
+		HostZoneId: HostChainId,
+		Epoch:      tc.initialState.epochNumber,
+		Receiver:   tc.validMsg.Receiver,
+	})
+	s.Require().NoError(err, "no error expected when the owner cancels")
+	s.Require().Equal(sdk.NewCoin("stuatom", tc.validMsg.Amount), resp.StToken, "returned sttokens")
+
+	ownerBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, owner, "stuatom")
+	s.Require().Equal(tc.validMsg.Amount, ownerBalanceAfter.Amount.Sub(ownerBalanceBefore.Amount), "owner balance change")
+
+	recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.initialState.epochNumber, tc.validMsg.Receiver)
+	_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+	s.Require().False(found, "record should have been removed")
+}
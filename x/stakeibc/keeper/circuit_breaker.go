@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// The circuit breaker lets a guardian account pause individual actions on a host zone
+// in any of the liquid staking modules (stakeibc, staketia, and stakedym) without waiting
+// on a governance vote
+//
+// Each paused action is stored as a flag keyed by module, host zone, and action. The
+// flags are checked by each module's msg server and epoch hooks before the action runs
+//
+// The guardian is set by governance with an expiration, after which it can no longer
+// trip or reset flags. Governance can trip and reset any flag at any time, and flags
+// tripped by governance can only be reset by governance
+
+// Stores the circuit breaker guardian
+func (k Keeper) SetCircuitBreakerGuardian(ctx sdk.Context, guardian types.CircuitBreakerGuardian) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.CircuitBreakerGuardianKey), k.cdc.MustMarshal(&guardian))
+}
+
+// Reads the circuit breaker guardian
+func (k Keeper) GetCircuitBreakerGuardian(ctx sdk.Context) (guardian types.CircuitBreakerGuardian, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.CircuitBreakerGuardianKey))
+	if len(bz) == 0 {
+		return guardian, false
+	}
+	k.cdc.MustUnmarshal(bz, &guardian)
+	return guardian, true
+}
+
+// Removes the circuit breaker guardian
+func (k Keeper) RemoveCircuitBreakerGuardian(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPrefix(types.CircuitBreakerGuardianKey))
+}
+
+// Stores a circuit breaker flag, pausing the action
+func (k Keeper) SetCircuitBreakerFlag(ctx sdk.Context, flag types.CircuitBreakerFlag) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CircuitBreakerFlagKeyPrefix))
+	key := types.CircuitBreakerFlagKey(flag.Module, flag.ChainId, flag.Action)
+	store.Set(key, k.cdc.MustMarshal(&flag))
+}
+
+// Reads the circuit breaker flag for an action on a host zone
+func (k Keeper) GetCircuitBreakerFlag(
+	ctx sdk.Context,
+	module string,
+	chainId string,
+	action types.CircuitBreakerAction,
+) (flag types.CircuitBreakerFlag, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CircuitBreakerFlagKeyPrefix))
+	bz := store.Get(types.CircuitBreakerFlagKey(module, chainId, action))
+	if len(bz) == 0 {
+		return flag, false
+	}
+	k.cdc.MustUnmarshal(bz, &flag)
+	return flag, true
+}
+
+// Removes the circuit breaker flag for an action on a host zone, unpausing the action
+func (k Keeper) RemoveCircuitBreakerFlag(ctx sdk.Context, module, chainId string, action types.CircuitBreakerAction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CircuitBreakerFlagKeyPrefix))
+	store.Delete(types.CircuitBreakerFlagKey(module, chainId, action))
+}
+
+// Returns all circuit breaker flags across each module and host zone
+func (k Keeper) GetAllCircuitBreakerFlags(ctx sdk.Context) (flags []types.CircuitBreakerFlag) {
+	flags = []types.CircuitBreakerFlag{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CircuitBreakerFlagKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var flag types.CircuitBreakerFlag
+		k.cdc.MustUnmarshal(iterator.Value(), &flag)
+		flags = append(flags, flag)
+	}
+
+	return flags
+}
+
+// Checks whether an action has been paused on a host zone
+func (k Keeper) IsCircuitBreakerTripped(ctx sdk.Context, module, chainId string, action types.CircuitBreakerAction) bool {
+	_, found := k.GetCircuitBreakerFlag(ctx, module, chainId, action)
+	return found
+}
+
+// Returns an error if an action has been paused on a host zone
+// This is called by each liquid staking module before processing the action
+func (k Keeper) CheckCircuitBreaker(ctx sdk.Context, module, chainId string, action types.CircuitBreakerAction) error {
+	if k.IsCircuitBreakerTripped(ctx, module, chainId, action) {
+		return errorsmod.Wrapf(types.ErrCircuitBreakerTripped, "%s paused for %s in %s", action, chainId, module)
+	}
+	return nil
+}
+
+// Confirms the signer is either governance or an unexpired guardian, and returns whether
+// the signer is governance
+func (k Keeper) ValidateCircuitBreakerSigner(ctx sdk.Context, signer string) (isGovernance bool, err error) {
+	if signer == k.authority {
+		return true, nil
+	}
+
+	guardian, found := k.GetCircuitBreakerGuardian(ctx)
+	if !found || guardian.Address != signer {
+		return false, errorsmod.Wrapf(types.ErrInvalidCircuitBreakerSigner,
+			"signer %s is neither the circuit breaker guardian nor governance", signer)
+	}
+	if !ctx.BlockTime().Before(guardian.Expiration) {
+		return false, errorsmod.Wrapf(types.ErrCircuitBreakerGuardianExpired,
+			"guardian %s expired at %s", guardian.Address, guardian.Expiration)
+	}
+
+	return false, nil
+}
+
+// Pauses each action on a host zone in the given module
+// If governance trips an action already paused by the guardian, the flag is upgraded
+// so that only governance can reset it
+func (k Keeper) TripCircuitBreaker(
+	ctx sdk.Context,
+	signer string,
+	module string,
+	chainId string,
+	actions []types.CircuitBreakerAction,
+) error {
+	isGovernance, err := k.ValidateCircuitBreakerSigner(ctx, signer)
+	if err != nil {
+		return err
+	}
+
+	for _, action := range actions {
+		existingFlag, found := k.GetCircuitBreakerFlag(ctx, module, chainId, action)
+		if found && (existingFlag.GovernanceOverride || !isGovernance) {
+			continue
+		}
+
+		k.SetCircuitBreakerFlag(ctx, types.CircuitBreakerFlag{
+			Module:             module,
+			ChainId:            chainId,
+			Action:             action,
+			TrippedBy:          signer,
+			TrippedAt:          ctx.BlockTime(),
+			GovernanceOverride: isGovernance,
+		})
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Circuit breaker tripped for %s in %s by %s", action, module, signer))
+		EmitCircuitBreakerEvent(ctx, types.EventTypeCircuitBreakerTripped, module, chainId, action, signer)
+	}
+
+	return nil
+}
+
+// Unpauses each action on a host zone in the given module
+// The guardian cannot reset an action that was paused by governance
+func (k Keeper) ResetCircuitBreaker(
+	ctx sdk.Context,
+	signer string,
+	module string,
+	chainId string,
+	actions []types.CircuitBreakerAction,
+) error {
+	isGovernance, err := k.ValidateCircuitBreakerSigner(ctx, signer)
+	if err != nil {
+		return err
+	}
+
+	for _, action := range actions {
+		existingFlag, found := k.GetCircuitBreakerFlag(ctx, module, chainId, action)
+		if !found {
+			continue
+		}
+		if existingFlag.GovernanceOverride && !isGovernance {
+			return errorsmod.Wrapf(types.ErrInvalidCircuitBreakerSigner,
+				"%s for %s in %s was paused by governance and can only be reset by governance", action, chainId, module)
+		}
+
+		k.RemoveCircuitBreakerFlag(ctx, module, chainId, action)
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Circuit breaker reset for %s in %s by %s", action, module, signer))
+		EmitCircuitBreakerEvent(ctx, types.EventTypeCircuitBreakerReset, module, chainId, action, signer)
+	}
+
+	return nil
+}
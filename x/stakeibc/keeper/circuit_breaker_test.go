@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Sets a guardian that expires an hour after the current block time
+func (s *KeeperTestSuite) SetupCircuitBreakerGuardian() string {
+	guardian := s.TestAccs[0].String()
+	_, err := s.GetMsgServer().SetCircuitBreakerGuardian(s.Ctx, &types.MsgSetCircuitBreakerGuardian{
+		Authority:  Authority,
+		Guardian:   guardian,
+		Expiration: s.Ctx.BlockTime().Add(time.Hour),
+	})
+	s.Require().NoError(err, "no error expected when setting guardian")
+	return guardian
+}
+
+func (s *KeeperTestSuite) TestSetCircuitBreakerGuardian() {
+	guardian := s.SetupCircuitBreakerGuardian()
+
+	storedGuardian, found := s.App.StakeibcKeeper.GetCircuitBreakerGuardian(s.Ctx)
+	s.Require().True(found, "guardian should have been stored")
+	s.Require().Equal(guardian, storedGuardian.Address, "guardian address")
+
+	// An empty guardian should remove the guardian
+	msg := types.MsgSetCircuitBreakerGuardian{Authority: Authority}
+	_, err := s.GetMsgServer().SetCircuitBreakerGuardian(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when removing guardian")
+
+	_, found = s.App.StakeibcKeeper.GetCircuitBreakerGuardian(s.Ctx)
+	s.Require().False(found, "guardian should have been removed")
+
+	// Invalid authority
+	msg.Authority = "not-gov-address"
+	_, err = s.GetMsgServer().SetCircuitBreakerGuardian(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
+
+func (s *KeeperTestSuite) TestTripAndResetCircuitBreaker() {
+	guardian := s.SetupCircuitBreakerGuardian()
+	actions := []types.CircuitBreakerAction{types.PAUSE_LIQUID_STAKE, types.PAUSE_REDEEM}
+
+	// The guardian trips the circuit breaker
+	tripMsg := types.MsgTripCircuitBreaker{
+		Signer:  guardian,
+		Module:  types.ModuleName,
+		ChainId: HostChainId,
+		Actions: actions,
+	}
+	_, err := s.GetMsgServer().TripCircuitBreaker(s.Ctx, &tripMsg)
+	s.Require().NoError(err, "no error expected when guardian trips circuit breaker")
+
+	for _, action := range actions {
+		err := s.App.StakeibcKeeper.CheckCircuitBreaker(s.Ctx, types.ModuleName, HostChainId, action)
+		s.Require().ErrorIs(err, types.ErrCircuitBreakerTripped, "%s should be paused", action)
+	}
+	s.Require().NoError(s.App.StakeibcKeeper.CheckCircuitBreaker(s.Ctx, types.ModuleName, HostChainId, types.PAUSE_CLAIM),
+		"claims should not be paused")
+	s.Require().NoError(s.App.StakeibcKeeper.CheckCircuitBreaker(s.Ctx, "stakedym", HostChainId, types.PAUSE_REDEEM),
+		"other modules should not be paused")
+	s.Require().NoError(s.App.StakeibcKeeper.CheckCircuitBreaker(s.Ctx, types.ModuleName, OsmoChainId, types.PAUSE_REDEEM),
+		"other host zones should not be paused")
+
+	// Governance trips redemptions, overriding the guardian's flag
+	tripMsg.Signer = Authority
+	tripMsg.Actions = []types.CircuitBreakerAction{types.PAUSE_REDEEM}
+	_, err = s.GetMsgServer().TripCircuitBreaker(s.Ctx, &tripMsg)
+	s.Require().NoError(err, "no error expected when governance trips circuit breaker")
+
+	flag, found := s.App.StakeibcKeeper.GetCircuitBreakerFlag(s.Ctx, types.ModuleName, HostChainId, types.PAUSE_REDEEM)
+	s.Require().True(found, "redeem flag should exist")
+	s.Require().True(flag.GovernanceOverride, "redeem flag should be owned by governance")
+	s.Require().Equal(Authority, flag.TrippedBy, "redeem flag tripped by")
+
+	// The guardian can reset liquid stakes, but not redemptions
+	resetMsg := types.MsgResetCircuitBreaker{
+		Signer:  guardian,
+		Module:  types.ModuleName,
+		ChainId: HostChainId,
+		Actions: []types.CircuitBreakerAction{types.PAUSE_LIQUID_STAKE},
+	}
+	_, err = s.GetMsgServer().ResetCircuitBreaker(s.Ctx, &resetMsg)
+	s.Require().NoError(err, "no error expected when guardian resets liquid stakes")
+	s.Require().NoError(s.App.StakeibcKeeper.CheckCircuitBreaker(s.Ctx, types.ModuleName, HostChainId, types.PAUSE_LIQUID_STAKE),
+		"liquid stakes should be unpaused")
+
+	resetMsg.Actions = []types.CircuitBreakerAction{types.PAUSE_REDEEM}
+	_, err = s.GetMsgServer().ResetCircuitBreaker(s.Ctx, &resetMsg)
+	s.Require().ErrorContains(err, "can only be reset by governance")
+
+	// Governance can reset redemptions
+	resetMsg.Signer = Authority
+	_, err = s.GetMsgServer().ResetCircuitBreaker(s.Ctx, &resetMsg)
+	s.Require().NoError(err, "no error expected when governance resets redemptions")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllCircuitBreakerFlags(s.Ctx), "all flags should be reset")
+}
+
+func (s *KeeperTestSuite) TestTripCircuitBreaker_InvalidSigner() {
+	guardian := s.SetupCircuitBreakerGuardian()
+
+	msg := types.MsgTripCircuitBreaker{
+		Signer:  s.TestAccs[1].String(),
+		Module:  types.ModuleName,
+		ChainId: HostChainId,
+		Actions: []types.CircuitBreakerAction{types.PAUSE_LIQUID_STAKE},
+	}
+	_, err := s.GetMsgServer().TripCircuitBreaker(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidCircuitBreakerSigner)
+
+	// Once the guardian has expired, it can no longer trip the circuit breaker
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	msg.Signer = guardian
+	_, err = s.GetMsgServer().TripCircuitBreaker(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrCircuitBreakerGuardianExpired)
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllCircuitBreakerFlags(s.Ctx), "no flags should be set")
+}
+
+func (s *KeeperTestSuite) TestCircuitBreaker_UserActions() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:            HostChainId,
+		HostDenom:          Atom,
+		RedemptionRate:     sdkmath.LegacyOneDec(),
+		RedemptionsEnabled: true,
+	})
+
+	err := s.App.StakeibcKeeper.TripCircuitBreaker(s.Ctx, Authority, types.ModuleName, HostChainId,
+		[]types.CircuitBreakerAction{types.PAUSE_LIQUID_STAKE, types.PAUSE_REDEEM})
+	s.Require().NoError(err, "no error expected when tripping circuit breaker")
+
+	_, err = s.GetMsgServer().LiquidStake(s.Ctx, &types.MsgLiquidStake{
+		Creator:   s.TestAccs[0].String(),
+		Amount:    sdkmath.NewInt(1000),
+		HostDenom: Atom,
+	})
+	s.Require().ErrorIs(err, types.ErrCircuitBreakerTripped, "liquid stake should be paused")
+
+	_, err = s.GetMsgServer().RedeemStake(s.Ctx, &types.MsgRedeemStake{
+		Creator:  s.TestAccs[0].String(),
+		Amount:   sdkmath.NewInt(1000),
+		HostZone: HostChainId,
+		Receiver: ValidHostAddress,
+	})
+	s.Require().ErrorIs(err, types.ErrCircuitBreakerTripped, "redemption should be paused")
+}
+
+func (s *KeeperTestSuite) TestQueryCircuitBreakers() {
+	guardian := s.SetupCircuitBreakerGuardian()
+
+	err := s.App.StakeibcKeeper.TripCircuitBreaker(s.Ctx, guardian, "staketia", "celestia",
+		[]types.CircuitBreakerAction{types.PAUSE_CLAIM})
+	s.Require().NoError(err, "no error expected when tripping circuit breaker")
+
+	response, err := s.App.StakeibcKeeper.CircuitBreakers(s.Ctx, &types.QueryCircuitBreakersRequest{})
+	s.Require().NoError(err, "no error expected when querying circuit breakers")
+	s.Require().Equal(guardian, response.Guardian.Address, "guardian")
+	s.Require().Len(response.Flags, 1, "number of flags")
+	s.Require().Equal("staketia", response.Flags[0].Module, "flag module")
+	s.Require().Equal(types.PAUSE_CLAIM, response.Flags[0].Action, "flag action")
+}
@@ -32,10 +32,20 @@ func (k msgServer) ClaimUndelegatedTokens(goCtx context.Context, msg *types.MsgC
 	}
 
 	// Confirm host zone is not halted
-	if _, err = k.GetActiveHostZone(ctx, msg.HostZoneId); err != nil {
+	hostZone, err := k.GetActiveHostZone(ctx, msg.HostZoneId)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, types.PAUSE_CLAIM); err != nil {
 		return nil, err
 	}
 
+	// Records owned by the instant redemption buffer are claimed automatically each epoch
+	if hostZone.InstantRedemptionBufferAddress != "" && userRedemptionRecord.Receiver == hostZone.InstantRedemptionBufferAddress {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"user redemption record %s belongs to the instant redemption buffer and cannot be claimed manually", userRedemptionRecord.Id)
+	}
+
 	icaTx, err := k.GetRedemptionTransferMsg(ctx, userRedemptionRecord, msg.HostZoneId)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "unable to build redemption transfer message")
@@ -90,6 +100,11 @@ func (k Keeper) GetClaimableRedemptionRecord(ctx sdk.Context, msg *types.MsgClai
 		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
 			"user redemption record %s is not claimable, pending ack", userRedemptionRecord.Id)
 	}
+	// tokenized records must be detokenized by the NFT holder before they can be claimed
+	if userRedemptionRecord.NftId != "" {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionRecordTokenized,
+			"user redemption record %s is not claimable until nft %s is detokenized", userRedemptionRecord.Id, userRedemptionRecord.NftId)
+	}
 	return &userRedemptionRecord, nil
 }
 
@@ -309,3 +309,32 @@ func (k Keeper) FundCommunityPool(
 
 	return nil
 }
+
+// Registers or updates a host zone's community pool rebate
+// The liquid staked amount cannot exceed the current stToken supply
+func (k Keeper) SetCommunityPoolRebate(ctx sdk.Context, chainId string, rebate types.CommunityPoolRebate) error {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return types.ErrHostZoneNotFound.Wrapf("host zone %s not found", chainId)
+	}
+
+	// Get the current stToken supply and confirm it's greater than or equal to the liquid staked amount
+	stDenom := utils.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	stTokenSupply := k.bankKeeper.GetSupply(ctx, stDenom).Amount
+	if rebate.LiquidStakedStTokenAmount.GT(stTokenSupply) {
+		return types.ErrFailedToRegisterRebate.Wrapf("liquid staked stToken amount (%v) is greater than current supply (%v)",
+			rebate.LiquidStakedStTokenAmount, stTokenSupply)
+	}
+
+	// If a zero rebate rate or zero LiquidStakedStTokenAmount is specified, set the rebate to nil
+	// Otherwise, update the struct
+	if rebate.LiquidStakedStTokenAmount.IsZero() || rebate.RebateRate.IsZero() {
+		hostZone.CommunityPoolRebate = nil
+	} else {
+		hostZone.CommunityPoolRebate = &rebate
+	}
+
+	k.SetHostZone(ctx, hostZone)
+
+	return nil
+}
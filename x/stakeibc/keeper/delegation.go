@@ -17,14 +17,16 @@ import (
 )
 
 // Builds the delegation ICA messages for a given deposit record
-// Each validator has a portion of the total amount on the record based on their weight
+// Any pending directed delegations are allocated first, and then each validator has a
+// portion of the remaining amount on the record based on their weight
 func (k Keeper) GetDelegationICAMessages(
 	ctx sdk.Context,
 	hostZone types.HostZone,
 	depositRecord recordstypes.DepositRecord,
 ) (msgs []proto.Message, delegations []*types.SplitDelegation, err error) {
 	// Construct the transaction
-	targetDelegationsByValidator, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, depositRecord.Amount)
+	pendingDirectedDelegations := GetPendingDirectedDelegations(hostZone)
+	targetDelegationsByValidator, err := k.GetTargetValAmtsWithDirectedDelegations(ctx, hostZone, depositRecord.Amount, pendingDirectedDelegations)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Error getting target delegation amounts for host zone %s", hostZone.ChainId))
 		return msgs, delegations, err
@@ -55,7 +57,9 @@ func (k Keeper) GetDelegationICAMessages(
 	return msgs, delegations, nil
 }
 
-// Submit undelegate ICA messages in small batches to reduce the gas size per tx
+// Submit delegation ICA messages in small batches to reduce the gas size per tx
+// Each batch is capped at batchSize messages, and at the host zone's max ICA tx gas
+// if one is configured (see GetIcaMsgBatchSizes)
 func (k Keeper) BatchSubmitDelegationICAMessages(
 	ctx sdk.Context,
 	hostZone types.HostZone,
@@ -65,11 +69,9 @@ func (k Keeper) BatchSubmitDelegationICAMessages(
 	batchSize int,
 ) (numTxsSubmitted uint64, err error) {
 	// Iterate the full list of messages and submit in batches
-	for start := 0; start < len(msgs); start += batchSize {
-		end := start + batchSize
-		if end > len(msgs) {
-			end = len(msgs)
-		}
+	start := 0
+	for _, msgsInBatch := range k.GetIcaMsgBatchSizes(ctx, hostZone, msgs, batchSize) {
+		end := start + msgsInBatch
 
 		msgBatch := msgs[start:end]
 		delegationsBatch := delegations[start:end]
@@ -101,21 +103,28 @@ func (k Keeper) BatchSubmitDelegationICAMessages(
 		k.SetHostZone(ctx, hostZone)
 
 		numTxsSubmitted += 1
+		start = end
 	}
 
 	return numTxsSubmitted, nil
 }
 
-// Iterate each deposit record marked DELEGATION_QUEUE and use the delegation ICA to delegate on the host zone
-func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber uint64, depositRecords []recordstypes.DepositRecord) {
-	k.Logger(ctx).Info("Staking deposit records...")
-
-	stakeDepositRecords := utils.FilterDepositRecords(depositRecords, func(record recordstypes.DepositRecord) (condition bool) {
+// Returns the deposit records that should be delegated in the given stride epoch
+// Records must be in DELEGATION_QUEUE, from a previous epoch, and have no delegations in progress
+func GetDepositRecordsToStake(epochNumber uint64, depositRecords []recordstypes.DepositRecord) []recordstypes.DepositRecord {
+	return utils.FilterDepositRecords(depositRecords, func(record recordstypes.DepositRecord) (condition bool) {
 		isStakeRecord := record.Status == recordstypes.DepositRecord_DELEGATION_QUEUE
 		isBeforeCurrentEpoch := record.DepositEpochNumber < epochNumber
 		isNotInProgress := record.DelegationTxsInProgress == 0
 		return isStakeRecord && isBeforeCurrentEpoch && isNotInProgress
 	})
+}
+
+// Iterate each deposit record marked DELEGATION_QUEUE and use the delegation ICA to delegate on the host zone
+func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber uint64, depositRecords []recordstypes.DepositRecord) {
+	k.Logger(ctx).Info("Staking deposit records...")
+
+	stakeDepositRecords := GetDepositRecordsToStake(epochNumber, depositRecords)
 
 	if len(stakeDepositRecords) == 0 {
 		k.Logger(ctx).Info("No deposit records in state DELEGATION_QUEUE")
@@ -140,8 +149,21 @@ func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber ui
 			continue
 		}
 
+		if err := k.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, types.PAUSE_DELEGATE_ICA); err != nil {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Not delegating deposit record %d: %s", depositRecord.Id, err.Error()))
+			continue
+		}
+
 		k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Staking %v%s", depositRecord.Amount, hostZone.HostDenom))
 
+		// Return any directed delegations to validators that have since lost their weight
+		// to the general pool so they are delegated by weight
+		if released := ReleaseZeroWeightPendingDirectedDelegations(&hostZone); released.IsPositive() {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+				"Released %v%s of pending directed delegations from zero-weight validators", released, hostZone.HostDenom))
+			k.SetHostZone(ctx, hostZone)
+		}
+
 		// Build the list of delegation messages for each validator
 		msgs, delegations, err := k.GetDelegationICAMessages(ctx, hostZone, depositRecord)
 		if err != nil {
@@ -184,6 +206,11 @@ func (k Keeper) ReinvestRewards(ctx sdk.Context) {
 			continue
 		}
 
+		if err := k.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, types.PAUSE_REWARD_REINVEST); err != nil {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Not reinvesting rewards: %s", err.Error()))
+			continue
+		}
+
 		// read clock time on host zone
 		blockTime, err := k.GetLightClientTime(ctx, hostZone.ConnectionId)
 		if err != nil {
@@ -15,6 +15,7 @@ import (
 	epochstypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
 	icacallbackstypes "github.com/Stride-Labs/stride/v33/x/icacallbacks/types"
 	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
 	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 )
 
@@ -399,6 +400,72 @@ func (s *KeeperTestSuite) TestStakeDepositRecords_NoDelegationAccount() {
 	s.CheckStateAfterStakingDepositRecords(tc, numFailed)
 }
 
+func (s *KeeperTestSuite) TestQueryDelegationPlan() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:              HostChainId,
+		HostDenom:            Atom,
+		DelegationIcaAddress: "cosmos_DELEGATION",
+		MaxMessagesPerIcaTx:  1,
+		Validators: []*types.Validator{
+			{Address: "val1", Weight: 1},
+			{Address: "val2", Weight: 1},
+		},
+	})
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochstypes.STRIDE_EPOCH,
+		EpochNumber:     2,
+	})
+
+	// Only the first record should be delegated next epoch
+	depositRecords := []recordstypes.DepositRecord{
+		{Id: 1, HostZoneId: HostChainId, Amount: sdkmath.NewInt(100), DepositEpochNumber: 2, Status: recordstypes.DepositRecord_DELEGATION_QUEUE},
+		{Id: 2, HostZoneId: HostChainId, Amount: sdkmath.NewInt(100), DepositEpochNumber: 2, Status: recordstypes.DepositRecord_TRANSFER_QUEUE},
+		{Id: 3, HostZoneId: OsmoChainId, Amount: sdkmath.NewInt(100), DepositEpochNumber: 2, Status: recordstypes.DepositRecord_DELEGATION_QUEUE},
+		{Id: 4, HostZoneId: HostChainId, Amount: sdkmath.NewInt(100), DepositEpochNumber: 3, Status: recordstypes.DepositRecord_DELEGATION_QUEUE},
+		{Id: 5, HostZoneId: HostChainId, Amount: sdkmath.NewInt(100), DepositEpochNumber: 1, Status: recordstypes.DepositRecord_DELEGATION_QUEUE,
+			DelegationTxsInProgress: 1},
+		{Id: 6, HostZoneId: HostChainId, Amount: sdkmath.ZeroInt(), DepositEpochNumber: 1, Status: recordstypes.DepositRecord_DELEGATION_QUEUE},
+	}
+	for _, depositRecord := range depositRecords {
+		s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+	}
+
+	resp, err := s.App.StakeibcKeeper.DelegationPlan(s.Ctx, &types.QueryDelegationPlanRequest{ChainId: HostChainId})
+	s.Require().NoError(err, "no error expected when querying delegation plan")
+
+	s.Require().Len(resp.Deposits, 1, "number of planned deposits")
+	deposit := resp.Deposits[0]
+	s.Require().Equal(uint64(1), deposit.DepositRecordId, "deposit record id")
+	s.Require().Equal(int64(100), deposit.Amount.Int64(), "deposit amount")
+
+	expectedDelegations := []types.SplitDelegation{
+		{Validator: "val1", Amount: sdkmath.NewInt(50)},
+		{Validator: "val2", Amount: sdkmath.NewInt(50)},
+	}
+	s.Require().Len(deposit.SplitDelegations, len(expectedDelegations), "number of split delegations")
+	for i, expected := range expectedDelegations {
+		s.Require().Equal(expected.Validator, deposit.SplitDelegations[i].Validator, "validator - index %d", i)
+		s.Require().Equal(expected.Amount.Int64(), deposit.SplitDelegations[i].Amount.Int64(), "amount - index %d", i)
+	}
+
+	// With one message per tx, each delegation should be in its own tx
+	s.Require().Len(deposit.Txs, 2, "number of planned txs")
+	for i, tx := range deposit.Txs {
+		s.Require().Equal(keeper.ICACallbackID_Delegate, tx.CallbackId, "callback id - index %d", i)
+		s.Require().Len(tx.Msgs, 1, "number of msgs - index %d", i)
+		s.Require().Equal("/cosmos.staking.v1beta1.MsgDelegate", tx.Msgs[0].TypeUrl, "msg type - index %d", i)
+	}
+
+	// The records should not have been modified
+	record, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, 1)
+	s.Require().True(found, "deposit record should have been found")
+	s.Require().Equal(recordstypes.DepositRecord_DELEGATION_QUEUE, record.Status, "deposit record status")
+
+	// Invalid host zone
+	_, err = s.App.StakeibcKeeper.DelegationPlan(s.Ctx, &types.QueryDelegationPlanRequest{ChainId: "fake-chain"})
+	s.Require().ErrorContains(err, "host zone fake-chain not found")
+}
+
 func (s *KeeperTestSuite) TestGetDelegationICAMessages() {
 	delegationAddress := "cosmos_DELEGATION"
 
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Directed delegations let a staker direct their liquid stake to specific validators on the
+// host zone, rather than having it spread across the validator set by weight
+//
+// The directed amounts are tracked on each validator in two stages:
+//  1. Pending: When the liquid stake is processed, each preferred validator's pending directed
+//     delegation is incremented. The next delegation ICA allocates the pending amounts before
+//     splitting the rest of the deposit by weight
+//  2. Directed: Once the delegation ICA succeeds, the delegated amount is moved from pending to
+//     directed. The directed amounts are allocated before the weight-based split whenever a
+//     target delegation is calculated (e.g. for unbonding or rebalancing)
+//
+// Since stTokens are fungible, a redemption can't be attributed to the original staker, so the
+// directed amounts are reduced proportionally whenever tokens are unbonded from the host zone
+
+// Splits a liquid stake across the staker's validator preferences, in proportion to each
+// preference's weight (with any remainder given to the last preference)
+// Each preferred validator must be on the host zone with a non-zero weight
+func GetValidatorPreferenceAmounts(
+	hostZone types.HostZone,
+	preferences []types.ValidatorPreference,
+	amount sdkmath.Int,
+) (amounts map[string]sdkmath.Int, err error) {
+	validatorWeights := map[string]uint64{}
+	for _, validator := range hostZone.Validators {
+		validatorWeights[validator.Address] = validator.Weight
+	}
+
+	totalPreferenceWeight := uint64(0)
+	for _, preference := range preferences {
+		weight, found := validatorWeights[preference.Validator]
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrValidatorNotFound,
+				"validator %s not found on host zone %s", preference.Validator, hostZone.ChainId)
+		}
+		if weight == 0 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidValidatorPreference,
+				"validator %s has no weight on host zone %s", preference.Validator, hostZone.ChainId)
+		}
+		totalPreferenceWeight += preference.Weight
+	}
+
+	amounts = map[string]sdkmath.Int{}
+	totalAllocated := sdkmath.ZeroInt()
+	for i, preference := range preferences {
+		if i == len(preferences)-1 {
+			amounts[preference.Validator] = amount.Sub(totalAllocated)
+			break
+		}
+		preferenceAmount := amount.Mul(sdkmath.NewIntFromUint64(preference.Weight)).Quo(sdkmath.NewIntFromUint64(totalPreferenceWeight))
+		amounts[preference.Validator] = preferenceAmount
+		totalAllocated = totalAllocated.Add(preferenceAmount)
+	}
+
+	return amounts, nil
+}
+
+// Adds the directed portions of a liquid stake to each validator's pending directed delegation
+func AddPendingDirectedDelegations(hostZone *types.HostZone, amounts map[string]sdkmath.Int) {
+	for _, validator := range hostZone.Validators {
+		if amount, ok := amounts[validator.Address]; ok && amount.IsPositive() {
+			validator.PendingDirectedDelegation = validator.GetPendingDirectedDelegationOrZero().Add(amount)
+		}
+	}
+}
+
+// Releases the pending directed delegation of each validator that no longer has weight on
+// the host zone, so that the amount is delegated with the rest of the deposit by weight
+// Validators with a delegation in progress are skipped, since the pending amount may be
+// confirmed by the ack of that delegation
+// If a validator is removed from the host zone, its pending amount is released with it
+// Returns the total amount released
+func ReleaseZeroWeightPendingDirectedDelegations(hostZone *types.HostZone) (totalReleased sdkmath.Int) {
+	totalReleased = sdkmath.ZeroInt()
+	for _, validator := range hostZone.Validators {
+		pending := validator.GetPendingDirectedDelegationOrZero()
+		if validator.Weight != 0 || validator.DelegationChangesInProgress > 0 || !pending.IsPositive() {
+			continue
+		}
+		validator.PendingDirectedDelegation = sdkmath.ZeroInt()
+		totalReleased = totalReleased.Add(pending)
+	}
+	return totalReleased
+}
+
+// Returns the directed delegation of each validator with a non-zero directed amount
+func GetDirectedDelegations(hostZone types.HostZone) map[string]sdkmath.Int {
+	directedDelegations := map[string]sdkmath.Int{}
+	for _, validator := range hostZone.Validators {
+		if directed := validator.GetDirectedDelegationOrZero(); directed.IsPositive() {
+			directedDelegations[validator.Address] = directed
+		}
+	}
+	return directedDelegations
+}
+
+// Returns the pending directed delegation of each validator with a non-zero pending amount
+func GetPendingDirectedDelegations(hostZone types.HostZone) map[string]sdkmath.Int {
+	pendingDirectedDelegations := map[string]sdkmath.Int{}
+	for _, validator := range hostZone.Validators {
+		if pending := validator.GetPendingDirectedDelegationOrZero(); pending.IsPositive() {
+			pendingDirectedDelegations[validator.Address] = pending
+		}
+	}
+	return pendingDirectedDelegations
+}
+
+// Returns the directed portion of a total delegation for each validator
+// Only validators with a non-zero weight are eligible, and if the directed amounts
+// exceed the total, each is scaled down proportionally
+func GetDirectedTargets(
+	validators []types.Validator,
+	totalDelegation sdkmath.Int,
+	directedDelegations map[string]sdkmath.Int,
+) map[string]sdkmath.Int {
+	directedTargets := map[string]sdkmath.Int{}
+	totalDirected := sdkmath.ZeroInt()
+	for _, validator := range validators {
+		directedAmount, ok := directedDelegations[validator.Address]
+		if !ok || validator.Weight == 0 || !directedAmount.IsPositive() {
+			continue
+		}
+		directedTargets[validator.Address] = directedAmount
+		totalDirected = totalDirected.Add(directedAmount)
+	}
+
+	if totalDirected.LTE(totalDelegation) {
+		return directedTargets
+	}
+
+	for address, directedAmount := range directedTargets {
+		directedTargets[address] = directedAmount.Mul(totalDelegation).Quo(totalDirected)
+	}
+	return directedTargets
+}
+
+// Scales each directed delegation by the ratio of the delegation after an unbonding to the
+// delegation before it, to estimate the directed amounts once the unbonding completes
+func GetDirectedDelegationsAfterUnbonding(
+	directedDelegations map[string]sdkmath.Int,
+	delegationBeforeUnbonding sdkmath.Int,
+	delegationAfterUnbonding sdkmath.Int,
+) map[string]sdkmath.Int {
+	scaledDelegations := map[string]sdkmath.Int{}
+	if !delegationBeforeUnbonding.IsPositive() {
+		return scaledDelegations
+	}
+	for address, directedAmount := range directedDelegations {
+		scaledDelegations[address] = directedAmount.Mul(delegationAfterUnbonding).Quo(delegationBeforeUnbonding)
+	}
+	return scaledDelegations
+}
+
+// Moves the delegated amounts from pending to directed after a successful delegation ICA
+// Only the pending amount on each validator can be confirmed - anything delegated beyond
+// that was from the weight-based split
+func ConfirmDirectedDelegations(hostZone *types.HostZone, splitDelegations []*types.SplitDelegation) {
+	delegatedAmounts := map[string]sdkmath.Int{}
+	for _, splitDelegation := range splitDelegations {
+		delegatedAmounts[splitDelegation.Validator] = splitDelegation.Amount
+	}
+
+	for _, validator := range hostZone.Validators {
+		delegatedAmount, ok := delegatedAmounts[validator.Address]
+		if !ok {
+			continue
+		}
+		confirmedAmount := sdkmath.MinInt(validator.GetPendingDirectedDelegationOrZero(), delegatedAmount)
+		if !confirmedAmount.IsPositive() {
+			continue
+		}
+		validator.PendingDirectedDelegation = validator.PendingDirectedDelegation.Sub(confirmedAmount)
+		validator.DirectedDelegation = validator.GetDirectedDelegationOrZero().Add(confirmedAmount)
+	}
+}
+
+// Reduces each validator's directed delegation in proportion to the amount unbonded from the
+// host zone, after a successful undelegation ICA
+func ReduceDirectedDelegationsAfterUnbonding(
+	hostZone *types.HostZone,
+	unbondedAmount sdkmath.Int,
+	delegationBeforeUnbonding sdkmath.Int,
+) {
+	if !delegationBeforeUnbonding.IsPositive() || !unbondedAmount.IsPositive() {
+		return
+	}
+	delegationAfterUnbonding := sdkmath.MaxInt(delegationBeforeUnbonding.Sub(unbondedAmount), sdkmath.ZeroInt())
+
+	for _, validator := range hostZone.Validators {
+		directed := validator.GetDirectedDelegationOrZero()
+		if !directed.IsPositive() {
+			continue
+		}
+		validator.DirectedDelegation = directed.Mul(delegationAfterUnbonding).Quo(delegationBeforeUnbonding)
+	}
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestGetValidatorPreferenceAmounts() {
+	hostZone := types.HostZone{
+		ChainId: HostChainId,
+		Validators: []*types.Validator{
+			{Address: "val1", Weight: 10},
+			{Address: "val2", Weight: 20},
+			{Address: "val3", Weight: 0},
+		},
+	}
+
+	// Split 1:2, with the remainder going to the last preference
+	amounts, err := keeper.GetValidatorPreferenceAmounts(hostZone, []types.ValidatorPreference{
+		{Validator: "val1", Weight: 1},
+		{Validator: "val2", Weight: 2},
+	}, sdkmath.NewInt(100))
+	s.Require().NoError(err, "no error expected when splitting preferences")
+	s.Require().Equal(int64(33), amounts["val1"].Int64(), "val1 amount")
+	s.Require().Equal(int64(67), amounts["val2"].Int64(), "val2 amount")
+
+	// No preferences
+	amounts, err = keeper.GetValidatorPreferenceAmounts(hostZone, []types.ValidatorPreference{}, sdkmath.NewInt(100))
+	s.Require().NoError(err, "no error expected with no preferences")
+	s.Require().Empty(amounts, "no amounts expected with no preferences")
+
+	// Validator not on the host zone
+	_, err = keeper.GetValidatorPreferenceAmounts(hostZone, []types.ValidatorPreference{
+		{Validator: "val4", Weight: 1},
+	}, sdkmath.NewInt(100))
+	s.Require().ErrorIs(err, types.ErrValidatorNotFound)
+
+	// Validator with zero weight
+	_, err = keeper.GetValidatorPreferenceAmounts(hostZone, []types.ValidatorPreference{
+		{Validator: "val3", Weight: 1},
+	}, sdkmath.NewInt(100))
+	s.Require().ErrorIs(err, types.ErrInvalidValidatorPreference)
+}
+
+func (s *KeeperTestSuite) TestGetTargetValAmtsForHostZone_DirectedDelegations() {
+	hostZone := types.HostZone{
+		ChainId: HostChainId,
+		Validators: []*types.Validator{
+			{Address: "val1", Weight: 50, DirectedDelegation: sdkmath.NewInt(300)},
+			{Address: "val2", Weight: 50},
+			{Address: "val3", Weight: 0, DirectedDelegation: sdkmath.NewInt(100)},
+		},
+	}
+
+	// The directed 300 goes to val1, and the remaining 700 is split by weight
+	// val3's directed delegation is ignored since it has no weight
+	targets, err := s.App.StakeibcKeeper.GetTargetValAmtsForHostZone(s.Ctx, hostZone, sdkmath.NewInt(1000))
+	s.Require().NoError(err, "no error expected when getting targets")
+	s.Require().Equal(int64(650), targets["val1"].Int64(), "val1 target")
+	s.Require().Equal(int64(350), targets["val2"].Int64(), "val2 target")
+	s.Require().Equal(int64(0), targets["val3"].Int64(), "val3 target")
+
+	// If the directed amounts exceed the total, they're scaled down to the total
+	targets, err = s.App.StakeibcKeeper.GetTargetValAmtsForHostZone(s.Ctx, hostZone, sdkmath.NewInt(150))
+	s.Require().NoError(err, "no error expected when directed exceeds total")
+	s.Require().Equal(int64(150), targets["val1"].Int64(), "val1 target when directed exceeds total")
+	s.Require().Equal(int64(0), targets["val2"].Int64(), "val2 target when directed exceeds total")
+}
+
+func (s *KeeperTestSuite) TestConfirmDirectedDelegations() {
+	hostZone := types.HostZone{
+		Validators: []*types.Validator{
+			{Address: "val1", PendingDirectedDelegation: sdkmath.NewInt(100), DirectedDelegation: sdkmath.NewInt(50)},
+			{Address: "val2", PendingDirectedDelegation: sdkmath.NewInt(100)},
+			{Address: "val3"},
+		},
+	}
+
+	keeper.ConfirmDirectedDelegations(&hostZone, []*types.SplitDelegation{
+		{Validator: "val1", Amount: sdkmath.NewInt(150)},
+		{Validator: "val2", Amount: sdkmath.NewInt(40)},
+		{Validator: "val3", Amount: sdkmath.NewInt(10)},
+	})
+
+	// val1's full pending amount is confirmed, and only part of val2's
+	s.Require().Equal(int64(0), hostZone.Validators[0].PendingDirectedDelegation.Int64(), "val1 pending")
+	s.Require().Equal(int64(150), hostZone.Validators[0].DirectedDelegation.Int64(), "val1 directed")
+	s.Require().Equal(int64(60), hostZone.Validators[1].PendingDirectedDelegation.Int64(), "val2 pending")
+	s.Require().Equal(int64(40), hostZone.Validators[1].DirectedDelegation.Int64(), "val2 directed")
+	s.Require().Equal(int64(0), hostZone.Validators[2].GetDirectedDelegationOrZero().Int64(), "val3 directed")
+}
+
+func (s *KeeperTestSuite) TestReleaseZeroWeightPendingDirectedDelegations() {
+	hostZone := types.HostZone{
+		Validators: []*types.Validator{
+			{Address: "val1", Weight: 10, PendingDirectedDelegation: sdkmath.NewInt(100)},
+			{Address: "val2", Weight: 0, PendingDirectedDelegation: sdkmath.NewInt(200)},
+			{Address: "val3", Weight: 0, PendingDirectedDelegation: sdkmath.NewInt(300), DelegationChangesInProgress: 1},
+			{Address: "val4", Weight: 0},
+		},
+	}
+
+	// Only val2's pending amount should be released, since val1 still has weight and
+	// val3 has a delegation in progress
+	released := keeper.ReleaseZeroWeightPendingDirectedDelegations(&hostZone)
+	s.Require().Equal(int64(200), released.Int64(), "total released")
+	s.Require().Equal(int64(100), hostZone.Validators[0].PendingDirectedDelegation.Int64(), "val1 pending")
+	s.Require().Equal(int64(0), hostZone.Validators[1].PendingDirectedDelegation.Int64(), "val2 pending")
+	s.Require().Equal(int64(300), hostZone.Validators[2].PendingDirectedDelegation.Int64(), "val3 pending")
+	s.Require().Equal(int64(0), hostZone.Validators[3].GetPendingDirectedDelegationOrZero().Int64(), "val4 pending")
+
+	// Once val2's pending amount is released, the full delegation should be split by weight
+	delegations, err := s.App.StakeibcKeeper.GetTargetValAmtsWithDirectedDelegations(s.Ctx, hostZone, sdkmath.NewInt(1000),
+		keeper.GetPendingDirectedDelegations(hostZone))
+	s.Require().NoError(err, "no error expected when getting targets")
+	s.Require().Equal(int64(1000), delegations["val1"].Int64(), "val1 target")
+	s.Require().Equal(int64(0), delegations["val2"].Int64(), "val2 target")
+}
+
+func (s *KeeperTestSuite) TestReduceDirectedDelegationsAfterUnbonding() {
+	hostZone := types.HostZone{
+		Validators: []*types.Validator{
+			{Address: "val1", DirectedDelegation: sdkmath.NewInt(400)},
+			{Address: "val2", DirectedDelegation: sdkmath.NewInt(100)},
+			{Address: "val3"},
+		},
+	}
+
+	// Unbonding a quarter of the delegation should reduce each directed amount by a quarter
+	keeper.ReduceDirectedDelegationsAfterUnbonding(&hostZone, sdkmath.NewInt(250), sdkmath.NewInt(1000))
+	s.Require().Equal(int64(300), hostZone.Validators[0].DirectedDelegation.Int64(), "val1 directed")
+	s.Require().Equal(int64(75), hostZone.Validators[1].DirectedDelegation.Int64(), "val2 directed")
+	s.Require().Equal(int64(0), hostZone.Validators[2].GetDirectedDelegationOrZero().Int64(), "val3 directed")
+}
+
+func (s *KeeperTestSuite) TestLiquidStake_ValidatorPreferences() {
+	tc := s.SetupLiquidStake()
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.Validators = []*types.Validator{
+		{Address: "val1", Weight: 10},
+		{Address: "val2", Weight: 10},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Direct the stake 3:1 across the two validators
+	msg := tc.validMsg
+	msg.ValidatorPreferences = []types.ValidatorPreference{
+		{Validator: "val1", Weight: 3},
+		{Validator: "val2", Weight: 1},
+	}
+	_, err := s.GetMsgServer().LiquidStake(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when liquid staking with preferences")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(int64(750_000), hostZone.Validators[0].PendingDirectedDelegation.Int64(), "val1 pending directed")
+	s.Require().Equal(int64(250_000), hostZone.Validators[1].PendingDirectedDelegation.Int64(), "val2 pending directed")
+
+	// A preference for a validator that's not on the host zone should fail without moving funds
+	msg.ValidatorPreferences = []types.ValidatorPreference{{Validator: "val3", Weight: 1}}
+	balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, IbcAtom)
+	_, err = s.GetMsgServer().LiquidStake(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrValidatorNotFound)
+
+	balanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, IbcAtom)
+	s.Require().Equal(balanceBefore, balanceAfter, "user balance should not change")
+	s.Require().Equal(sdk.NewInt64Coin(IbcAtom, 9_000_000), balanceAfter, "user balance after first stake")
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,7 +12,7 @@ import (
 )
 
 // Emits a successful liquid stake event, and displays metadata such as the stToken amount
-func EmitSuccessfulLiquidStakeEvent(ctx sdk.Context, msg *types.MsgLiquidStake, hostZone types.HostZone, stAmount sdkmath.Int) {
+func EmitSuccessfulLiquidStakeEvent(ctx sdk.Context, msg *types.MsgLiquidStake, hostZone types.HostZone, stAmount, feeAmount sdkmath.Int) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeLiquidStakeRequest,
@@ -21,12 +23,13 @@ func EmitSuccessfulLiquidStakeEvent(ctx sdk.Context, msg *types.MsgLiquidStake,
 			sdk.NewAttribute(types.AttributeKeyNativeIBCDenom, hostZone.IbcDenom),
 			sdk.NewAttribute(types.AttributeKeyNativeAmount, msg.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyStTokenAmount, stAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeAmount, feeAmount.String()),
 		),
 	)
 }
 
 // Emits a successful redeem stake event, and displays metadata such as the native amount
-func EmitSuccessfulRedeemStakeEvent(ctx sdk.Context, msg *types.MsgRedeemStake, hostZone types.HostZone, nativeAmount, stAmount sdkmath.Int) {
+func EmitSuccessfulRedeemStakeEvent(ctx sdk.Context, msg *types.MsgRedeemStake, hostZone types.HostZone, nativeAmount, stAmount, feeAmount sdkmath.Int) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeRedeemStakeRequest,
@@ -38,6 +41,140 @@ func EmitSuccessfulRedeemStakeEvent(ctx sdk.Context, msg *types.MsgRedeemStake,
 			sdk.NewAttribute(types.AttributeKeyNativeIBCDenom, hostZone.IbcDenom),
 			sdk.NewAttribute(types.AttributeKeyNativeAmount, nativeAmount.String()),
 			sdk.NewAttribute(types.AttributeKeyStTokenAmount, stAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeAmount, feeAmount.String()),
+		),
+	)
+}
+
+// Emits a successful instant redeem stake event, and displays metadata such as the native amount and fee
+func EmitSuccessfulInstantRedeemStakeEvent(ctx sdk.Context, msg *types.MsgInstantRedeemStake, hostZone types.HostZone, nativeAmount, feeAmount sdkmath.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInstantRedeemStakeRequest,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRedeemer, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyNativeBaseDenom, hostZone.HostDenom),
+			sdk.NewAttribute(types.AttributeKeyNativeIBCDenom, hostZone.IbcDenom),
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, nativeAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeAmount, feeAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyStTokenAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBufferBalance, hostZone.InstantRedemptionBufferBalance.String()),
+		),
+	)
+}
+
+// Emits an event when a user redemption record is reassigned to a new owner and receiver
+func EmitRedemptionRecordTransferEvent(
+	ctx sdk.Context,
+	msg *types.MsgTransferRedemptionRecord,
+	nativeAmount sdkmath.Int,
+	stAmount sdkmath.Int,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedemptionRecordTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, msg.HostZoneId),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", msg.Epoch)),
+			sdk.NewAttribute(types.AttributeKeyPreviousOwner, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner),
+			sdk.NewAttribute(types.AttributeKeyPreviousReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyNewReceiver, msg.NewReceiver),
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, nativeAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyStTokenAmount, stAmount.String()),
+		),
+	)
+}
+
+// Emits an event when all or part of a pending redemption is cancelled
+func EmitRedemptionCancelledEvent(ctx sdk.Context, msg *types.MsgCancelRedemption, hostZone types.HostZone, nativeAmount, stAmount sdkmath.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedemptionCancelled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRedeemer, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", msg.Epoch)),
+			sdk.NewAttribute(types.AttributeKeyNativeBaseDenom, hostZone.HostDenom),
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, nativeAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyStTokenAmount, stAmount.String()),
+		),
+	)
+}
+
+// Emits an event when a user redemption record is minted as an NFT (tokenized) or
+// when the NFT is burned and the record is returned to the holder (detokenized)
+func EmitRedemptionRecordNFTEvent(ctx sdk.Context, eventType string, record recordstypes.UserRedemptionRecord, nftId, owner string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, record.HostZoneId),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", record.EpochNumber)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, record.Receiver),
+			sdk.NewAttribute(types.AttributeKeyNftId, nftId),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, owner),
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, record.NativeTokenAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyStTokenAmount, record.StTokenAmount.String()),
+		),
+	)
+}
+
+// Emits a successful basket liquid stake event, and displays the native tokens staked for each component
+func EmitSuccessfulLiquidStakeBasketEvent(ctx sdk.Context, msg *types.MsgLiquidStakeBasket, basket types.Basket, nativeTokens sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLiquidStakeBasketRequest,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyLiquidStaker, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyBasketId, basket.Id),
+			sdk.NewAttribute(types.AttributeKeyBasketDenom, basket.Denom),
+			sdk.NewAttribute(types.AttributeKeyBasketAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyNativeTokens, nativeTokens.String()),
+		),
+	)
+}
+
+// Emits a successful basket redemption event
+func EmitSuccessfulRedeemBasketEvent(ctx sdk.Context, msg *types.MsgRedeemBasket, basket types.Basket) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemBasketRequest,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRedeemer, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyBasketId, basket.Id),
+			sdk.NewAttribute(types.AttributeKeyBasketDenom, basket.Denom),
+			sdk.NewAttribute(types.AttributeKeyBasketAmount, msg.Amount.String()),
+		),
+	)
+}
+
+// Emits an event when deposits are diverted into the instant redemption buffer
+func EmitInstantRedemptionBufferFundedEvent(ctx sdk.Context, hostZone types.HostZone, amount sdkmath.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInstantRedemptionBufferFunded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyNativeIBCDenom, hostZone.IbcDenom),
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBufferBalance, hostZone.InstantRedemptionBufferBalance.String()),
+		),
+	)
+}
+
+// Emits an event when the instant redemption buffer is refilled from a completed unbonding
+func EmitInstantRedemptionBufferRefilledEvent(ctx sdk.Context, hostZone types.HostZone, amount sdkmath.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInstantRedemptionBufferRefilled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyNativeIBCDenom, hostZone.IbcDenom),
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBufferBalance, hostZone.InstantRedemptionBufferBalance.String()),
 		),
 	)
 }
@@ -144,6 +281,84 @@ func EmitValidatorSlashEvent(
 	)
 }
 
+// Emits an event when the insurance fund covers a validator slash
+func EmitInsuranceFundSlashCoveredEvent(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	record types.InsuranceCoverageRecord,
+	fundBalance sdkmath.Int,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInsuranceFundSlashCovered,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyNativeIBCDenom, hostZone.IbcDenom),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeySlashAmount, record.SlashAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyCoveredAmount, record.CoveredAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyRedemptionRate, record.RedemptionRateAfter.String()),
+			sdk.NewAttribute(types.AttributeKeyInsuranceFundBalance, fundBalance.String()),
+		),
+	)
+}
+
+// Emits an event when an account's balance does not match the balance implied by the records
+func EmitIcaBalanceDiscrepancyEvent(ctx sdk.Context, hostZone types.HostZone, entry types.IcaReconciliationEntry) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIcaBalanceDiscrepancy,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyAccount, entry.Account),
+			sdk.NewAttribute(types.AttributeKeyAddress, entry.Address),
+			sdk.NewAttribute(types.AttributeKeyExpectedBalance, entry.ExpectedBalance.String()),
+			sdk.NewAttribute(types.AttributeKeyActualBalance, entry.ActualBalance.String()),
+			sdk.NewAttribute(types.AttributeKeyDiscrepancy, entry.Discrepancy.String()),
+		),
+	)
+}
+
+// Emits an event when a host zone ICA is re-registered after its channel was closed
+func EmitIcaRecoveryAttemptEvent(ctx sdk.Context, recoveryState types.IcaRecoveryState) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIcaRecoveryAttempt,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, recoveryState.ChainId),
+			sdk.NewAttribute(types.AttributeKeyIcaType, recoveryState.IcaType.String()),
+			sdk.NewAttribute(types.AttributeKeyRecoveryAttempts, fmt.Sprintf("%d", recoveryState.Attempts)),
+			sdk.NewAttribute(types.AttributeKeyNextAttemptTime, recoveryState.NextAttemptTime.String()),
+			sdk.NewAttribute(types.AttributeKeyError, recoveryState.LastError),
+		),
+	)
+}
+
+// Emits an event when a recovering host zone ICA's channel is open again
+func EmitIcaRecoveredEvent(ctx sdk.Context, recoveryState types.IcaRecoveryState) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIcaRecovered,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, recoveryState.ChainId),
+			sdk.NewAttribute(types.AttributeKeyIcaType, recoveryState.IcaType.String()),
+			sdk.NewAttribute(types.AttributeKeyRecoveryAttempts, fmt.Sprintf("%d", recoveryState.Attempts)),
+		),
+	)
+}
+
+// Emits an event when a host zone is registered and configured through an onboarding proposal
+func EmitHostZoneOnboardedEvent(ctx sdk.Context, chainId, connectionId string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostZoneOnboarded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, chainId),
+			sdk.NewAttribute(types.AttributeKeyConnectionId, connectionId),
+		),
+	)
+}
+
 // Emits an event if an undelegation ICA was submitted for a host zone
 func EmitUndelegationEvent(ctx sdk.Context, hostZone types.HostZone, totalUnbondAmount sdkmath.Int) {
 	ctx.EventManager().EmitEvent(
@@ -169,3 +384,73 @@ func EmitRedemptionSweepEvent(ctx sdk.Context, hostZone types.HostZone, sweptAmo
 		),
 	)
 }
+
+// Emits an event if a validator's weight was updated by automatic validator weighting
+func EmitValidatorWeightUpdateEvent(ctx sdk.Context, chainId string, validatorAddress string, previousWeight, currentWeight uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorWeightUpdate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, chainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, validatorAddress),
+			sdk.NewAttribute(types.AttributeKeyPreviousWeight, fmt.Sprintf("%d", previousWeight)),
+			sdk.NewAttribute(types.AttributeKeyCurrentWeight, fmt.Sprintf("%d", currentWeight)),
+		),
+	)
+}
+
+// Emits an event when a validator is blacklisted after a slash or jailing
+func EmitValidatorBlacklistedEvent(ctx sdk.Context, chainId string, validatorAddress string, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorBlacklisted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, chainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, validatorAddress),
+			sdk.NewAttribute(types.AttributeKeyBlacklistReason, reason),
+		),
+	)
+}
+
+// Emits an event when a host zone's redemption rate guard changes status
+func EmitRedemptionRateGuardStatusChangeEvent(
+	ctx sdk.Context,
+	chainId string,
+	previousStatus types.RedemptionRateGuardStatus,
+	newStatus types.RedemptionRateGuardStatus,
+	reason string,
+	redemptionRate sdkmath.LegacyDec,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedemptionRateGuardStatusChange,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, chainId),
+			sdk.NewAttribute(types.AttributeKeyPreviousGuardStatus, previousStatus.String()),
+			sdk.NewAttribute(types.AttributeKeyNewGuardStatus, newStatus.String()),
+			sdk.NewAttribute(types.AttributeKeyGuardReason, reason),
+			sdk.NewAttribute(types.AttributeKeyRedemptionRate, redemptionRate.String()),
+		),
+	)
+}
+
+// Emits an event when a circuit breaker flag is tripped or reset
+func EmitCircuitBreakerEvent(
+	ctx sdk.Context,
+	eventType string,
+	module string,
+	chainId string,
+	action types.CircuitBreakerAction,
+	signer string,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCircuitBreakerModule, module),
+			sdk.NewAttribute(types.AttributeKeyHostZone, chainId),
+			sdk.NewAttribute(types.AttributeKeyCircuitBreakerAction, action.String()),
+			sdk.NewAttribute(types.AttributeKeyCircuitBreakerSigner, signer),
+		),
+	)
+}
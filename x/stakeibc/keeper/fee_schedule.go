@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Returns the fee schedule that applies to a host zone
+// If the host zone does not have a fee schedule, the global stride commission is used
+// for rewards and no liquid stake or redemption fees are charged
+func (k Keeper) GetHostZoneFeeSchedule(ctx sdk.Context, hostZone types.HostZone) types.HostZoneFeeSchedule {
+	if hostZone.FeeSchedule != nil {
+		return *hostZone.FeeSchedule
+	}
+
+	strideCommission := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(k.GetParams(ctx).StrideCommission))
+	return types.HostZoneFeeSchedule{
+		RewardCommissionRate: strideCommission.Quo(sdkmath.LegacyNewDec(100)),
+		LiquidStakeFeeRate:   sdkmath.LegacyZeroDec(),
+		RedemptionFeeRate:    sdkmath.LegacyZeroDec(),
+	}
+}
+
+// Returns the portion of a liquid stake that's charged as a fee (in native tokens)
+// Liquid stakes from the reward collector are exempt since the fee would be paid to itself
+func (k Keeper) GetLiquidStakeFeeAmount(ctx sdk.Context, hostZone types.HostZone, staker sdk.AccAddress, amount sdkmath.Int) sdkmath.Int {
+	rewardCollectorAddress := k.AccountKeeper.GetModuleAccount(ctx, types.RewardCollectorName).GetAddress()
+	if staker.Equals(rewardCollectorAddress) {
+		return sdkmath.ZeroInt()
+	}
+	feeRate := k.GetHostZoneFeeSchedule(ctx, hostZone).LiquidStakeFeeRate
+	return sdkmath.LegacyNewDecFromInt(amount).Mul(feeRate).TruncateInt()
+}
+
+// Returns the portion of a redemption that's charged as a fee (in stTokens)
+func (k Keeper) GetRedemptionFeeAmount(ctx sdk.Context, hostZone types.HostZone, stAmount sdkmath.Int) sdkmath.Int {
+	feeRate := k.GetHostZoneFeeSchedule(ctx, hostZone).RedemptionFeeRate
+	return sdkmath.LegacyNewDecFromInt(stAmount).Mul(feeRate).TruncateInt()
+}
@@ -18,6 +18,39 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, tradeRoute := range genState.TradeRoutes {
 		k.SetTradeRoute(ctx, tradeRoute)
 	}
+	for _, basket := range genState.Baskets {
+		k.SetBasket(ctx, basket)
+	}
+	for _, snapshot := range genState.RedemptionRateHistory {
+		k.SetRedemptionRateSnapshot(ctx, snapshot)
+	}
+	for _, record := range genState.RedemptionRateGuardHistory {
+		k.SetRedemptionRateGuardRecord(ctx, record)
+	}
+	for _, redelegation := range genState.InFlightRedelegations {
+		k.SetInFlightRedelegation(ctx, redelegation)
+	}
+	if genState.CircuitBreakerGuardian != nil {
+		k.SetCircuitBreakerGuardian(ctx, *genState.CircuitBreakerGuardian)
+	}
+	for _, flag := range genState.CircuitBreakerFlags {
+		k.SetCircuitBreakerFlag(ctx, flag)
+	}
+	for _, estimate := range genState.IcaGasEstimates {
+		k.SetIcaGasEstimate(ctx, estimate)
+	}
+	for _, record := range genState.InsuranceCoverageHistory {
+		k.SetInsuranceCoverageRecord(ctx, record)
+	}
+	for _, report := range genState.IcaReconciliationReports {
+		k.SetIcaReconciliationReport(ctx, report)
+	}
+	for _, recoveryState := range genState.IcaRecoveryStates {
+		k.SetIcaRecoveryState(ctx, recoveryState)
+	}
+	for _, onboarding := range genState.HostZoneOnboardings {
+		k.SetHostZoneOnboarding(ctx, onboarding)
+	}
 
 	k.SetParams(ctx, genState.Params)
 }
@@ -30,6 +63,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis.HostZoneList = k.GetAllHostZone(ctx)
 	genesis.EpochTrackerList = k.GetAllEpochTracker(ctx)
 	genesis.TradeRoutes = k.GetAllTradeRoutes(ctx)
+	genesis.Baskets = k.GetAllBaskets(ctx)
+	genesis.RedemptionRateHistory = k.GetAllRedemptionRateHistory(ctx)
+	genesis.RedemptionRateGuardHistory = k.GetAllRedemptionRateGuardHistory(ctx)
+	genesis.InFlightRedelegations = k.GetAllInFlightRedelegations(ctx)
+	genesis.CircuitBreakerFlags = k.GetAllCircuitBreakerFlags(ctx)
+	if guardian, found := k.GetCircuitBreakerGuardian(ctx); found {
+		genesis.CircuitBreakerGuardian = &guardian
+	}
+	genesis.IcaGasEstimates = k.GetAllIcaGasEstimates(ctx)
+	genesis.InsuranceCoverageHistory = k.GetAllInsuranceCoverageHistory(ctx)
+	genesis.IcaReconciliationReports = k.GetAllIcaReconciliationReports(ctx)
+	genesis.IcaRecoveryStates = k.GetAllIcaRecoveryStates(ctx)
+	genesis.HostZoneOnboardings = k.GetAllHostZoneOnboardings(ctx)
 
 	return genesis
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
@@ -12,21 +14,136 @@ func (s *KeeperTestSuite) TestGenesis() {
 		PortId: types.PortID,
 		HostZoneList: []types.HostZone{
 			{
-				ChainId:                "A",
-				TotalDelegations:       sdkmath.OneInt(),
-				RedemptionRate:         sdkmath.LegacyOneDec(),
-				LastRedemptionRate:     sdkmath.LegacyOneDec(),
-				MinRedemptionRate:      sdkmath.LegacyOneDec(),
-				MaxRedemptionRate:      sdkmath.LegacyOneDec(),
-				MinInnerRedemptionRate: sdkmath.LegacyOneDec(),
-				MaxInnerRedemptionRate: sdkmath.LegacyOneDec(),
-				Validators:             []*types.Validator{},
+				ChainId:                        "A",
+				TotalDelegations:               sdkmath.OneInt(),
+				RedemptionRate:                 sdkmath.LegacyOneDec(),
+				LastRedemptionRate:             sdkmath.LegacyOneDec(),
+				MinRedemptionRate:              sdkmath.LegacyOneDec(),
+				MaxRedemptionRate:              sdkmath.LegacyOneDec(),
+				MinInnerRedemptionRate:         sdkmath.LegacyOneDec(),
+				MaxInnerRedemptionRate:         sdkmath.LegacyOneDec(),
+				Validators:                     []*types.Validator{},
+				InstantRedemptionBufferBalance: sdkmath.ZeroInt(),
 			},
 		},
 		EpochTrackerList: []types.EpochTracker{
 			{EpochIdentifier: "stride_epoch"},
 		},
 		TradeRoutes: []types.TradeRoute{},
+		Baskets:     []types.Basket{},
+		RedemptionRateHistory: []types.RedemptionRateSnapshot{
+			{
+				ChainId:          "A",
+				EpochNumber:      1,
+				Time:             time.Unix(1_700_000_000, 0).UTC(),
+				RedemptionRate:   sdkmath.LegacyOneDec(),
+				TotalDelegations: sdkmath.OneInt(),
+				StTokenSupply:    sdkmath.OneInt(),
+			},
+		},
+		RedemptionRateGuardHistory: []types.RedemptionRateGuardRecord{
+			{
+				ChainId:        "A",
+				Id:             1,
+				EpochNumber:    1,
+				Time:           time.Unix(1_700_000_000, 0).UTC(),
+				PreviousStatus: types.GUARD_HEALTHY,
+				NewStatus:      types.GUARD_DEGRADED,
+				Reason:         "redemption rate outside inner bounds",
+				RedemptionRate: sdkmath.LegacyOneDec(),
+			},
+		},
+		InFlightRedelegations: []types.InFlightRedelegation{
+			{
+				ChainId:      "A",
+				SrcValidator: "val1",
+				DstValidator: "val2",
+				Entries: []types.InFlightRedelegationEntry{
+					{Amount: sdkmath.NewInt(100), CompletionTime: 1_700_000_000_000_000_000},
+				},
+			},
+		},
+		CircuitBreakerGuardian: &types.CircuitBreakerGuardian{
+			Address:    "guardian",
+			Expiration: time.Unix(1_800_000_000, 0).UTC(),
+		},
+		CircuitBreakerFlags: []types.CircuitBreakerFlag{
+			{
+				Module:    types.ModuleName,
+				ChainId:   "A",
+				Action:    types.PAUSE_REDEEM,
+				TrippedBy: "guardian",
+				TrippedAt: time.Unix(1_700_000_000, 0).UTC(),
+			},
+		},
+		IcaGasEstimates: []types.IcaGasEstimate{
+			{ChainId: "A", MsgTypeUrl: "/cosmos.staking.v1beta1.MsgDelegate", GasPerMsg: 150_000},
+			{ChainId: "A", MsgTypeUrl: "/cosmos.staking.v1beta1.MsgUndelegate", GasPerMsg: 250_000, MinGasPerMsg: 200_000},
+		},
+		InsuranceCoverageHistory: []types.InsuranceCoverageRecord{
+			{
+				ChainId:              "A",
+				Id:                   1,
+				EpochNumber:          2,
+				Time:                 time.Unix(1_700_000_000, 0).UTC(),
+				Validator:            "valA",
+				SlashAmount:          sdkmath.NewInt(1000),
+				CoveredAmount:        sdkmath.NewInt(800),
+				RedemptionRateBefore: sdkmath.LegacyMustNewDecFromStr("1.2"),
+				RedemptionRateAfter:  sdkmath.LegacyMustNewDecFromStr("1.19"),
+			},
+		},
+		IcaReconciliationReports: []types.IcaReconciliationReport{
+			{
+				ChainId:     "A",
+				EpochNumber: 3,
+				Time:        time.Unix(1_700_000_000, 0).UTC(),
+				Entries: []types.IcaReconciliationEntry{
+					{
+						Account:         "DELEGATION",
+						Address:         "delegation-address",
+						ExpectedBalance: sdkmath.NewInt(100),
+						ActualBalance:   sdkmath.NewInt(90),
+						Discrepancy:     sdkmath.NewInt(-10),
+						Reconciled:      true,
+					},
+				},
+			},
+		},
+		IcaRecoveryStates: []types.IcaRecoveryState{
+			{
+				ChainId:         "A",
+				IcaType:         types.ICAAccountType_DELEGATION,
+				Attempts:        2,
+				LastAttemptTime: time.Unix(1_700_000_000, 0).UTC(),
+				NextAttemptTime: time.Unix(1_700_001_200, 0).UTC(),
+				LastError:       "connection not found",
+			},
+		},
+		HostZoneOnboardings: []types.HostZoneOnboarding{
+			{
+				ChainId:       "A",
+				OracleChainId: "osmosis-1",
+			},
+			{
+				ChainId: "B",
+				TradeRoute: &types.MsgCreateTradeRoute{
+					Authority:                       "authority",
+					HostChainId:                     "B",
+					StrideToRewardConnectionId:      "connection-1",
+					HostToRewardTransferChannelId:   "channel-1",
+					RewardToStrideTransferChannelId: "channel-2",
+					RewardDenomOnHost:               "ibc/reward",
+					RewardDenomOnReward:             "reward",
+					RewardDenomOnStride:             "ibc/reward-on-stride",
+					HostDenomOnHost:                 "host",
+					MinSwapAmount:                   sdkmath.NewInt(1),
+					MaxSwapAmount:                   sdkmath.NewInt(2),
+					MinTransferAmount:               sdkmath.NewInt(3),
+					TradeVenue:                      types.TradeVenue_STRIDE_AUCTION,
+				},
+			},
+		},
 	}
 
 	s.App.StakeibcKeeper.InitGenesis(s.Ctx, genesisState)
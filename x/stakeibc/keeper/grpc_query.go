@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -10,6 +11,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -223,6 +226,286 @@ func (k Keeper) AllTradeRoutes(c context.Context, req *types.QueryAllTradeRoutes
 	return &types.QueryAllTradeRoutesResponse{TradeRoutes: routes}, nil
 }
 
+// Queries a basket and the redemption rate of each of its components
+func (k Keeper) Basket(c context.Context, req *types.QueryBasketRequest) (*types.QueryBasketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	basket, found := k.GetBasket(ctx, req.BasketId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "basket %s not found", req.BasketId)
+	}
+	redemptionRates, err := k.GetBasketRedemptionRates(ctx, basket)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBasketResponse{Basket: basket, RedemptionRates: redemptionRates}, nil
+}
+
+// Queries all baskets
+func (k Keeper) AllBaskets(c context.Context, req *types.QueryAllBasketsRequest) (*types.QueryAllBasketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAllBasketsResponse{Baskets: k.GetAllBaskets(ctx)}, nil
+}
+
+// Queries the redemption rate history of a host zone
+func (k Keeper) RedemptionRateHistory(c context.Context, req *types.QueryRedemptionRateHistoryRequest) (*types.QueryRedemptionRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetHostZone(ctx, req.ChainId); !found {
+		return nil, status.Errorf(codes.NotFound, "host zone %s not found", req.ChainId)
+	}
+
+	return &types.QueryRedemptionRateHistoryResponse{Snapshots: k.GetRedemptionRateHistory(ctx, req.ChainId)}, nil
+}
+
+// Queries the trailing 7 and 30 day APR of a host zone
+func (k Keeper) RedemptionRateApr(c context.Context, req *types.QueryRedemptionRateAprRequest) (*types.QueryRedemptionRateAprResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetHostZone(ctx, req.ChainId); !found {
+		return nil, status.Errorf(codes.NotFound, "host zone %s not found", req.ChainId)
+	}
+
+	history := k.GetRedemptionRateHistory(ctx, req.ChainId)
+	return &types.QueryRedemptionRateAprResponse{
+		Apr_7D:  GetTrailingRedemptionRateApr(history, AprWindow7Day),
+		Apr_30D: GetTrailingRedemptionRateApr(history, AprWindow30Day),
+	}, nil
+}
+
+// Queries the max drawdown of a host zone's redemption rate
+func (k Keeper) RedemptionRateDrawdown(c context.Context, req *types.QueryRedemptionRateDrawdownRequest) (*types.QueryRedemptionRateDrawdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetHostZone(ctx, req.ChainId); !found {
+		return nil, status.Errorf(codes.NotFound, "host zone %s not found", req.ChainId)
+	}
+
+	history := k.GetRedemptionRateHistory(ctx, req.ChainId)
+	maxDrawdown, peakEpochNumber, troughEpochNumber := GetMaxRedemptionRateDrawdown(history)
+	return &types.QueryRedemptionRateDrawdownResponse{
+		MaxDrawdown:       maxDrawdown,
+		PeakEpochNumber:   peakEpochNumber,
+		TroughEpochNumber: troughEpochNumber,
+	}, nil
+}
+
+// Queries the redemption rate guard history of a host zone
+func (k Keeper) HaltHistory(c context.Context, req *types.QueryHaltHistoryRequest) (*types.QueryHaltHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetHostZone(ctx, req.ChainId); !found {
+		return nil, status.Errorf(codes.NotFound, "host zone %s not found", req.ChainId)
+	}
+
+	return &types.QueryHaltHistoryResponse{Records: k.GetRedemptionRateGuardHistory(ctx, req.ChainId)}, nil
+}
+
+// Dry-runs the rebalance planner, returning the redelegations that would be submitted
+// if the host zone was rebalanced in the current block
+func (k Keeper) RebalancePlan(c context.Context, req *types.QueryRebalancePlanRequest) (*types.QueryRebalancePlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "host zone %s not found", req.ChainId)
+	}
+
+	msgs, rebalancings, constraints, err := k.GetRebalancePlan(ctx, hostZone)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	plannedRebalancings := []types.Rebalancing{}
+	for _, rebalancing := range rebalancings {
+		plannedRebalancings = append(plannedRebalancings, *rebalancing)
+	}
+
+	lockedValidators := []string{}
+	for validator := range constraints.LockedValidators {
+		lockedValidators = append(lockedValidators, validator)
+	}
+	sort.Strings(lockedValidators)
+
+	txs, err := GetPlannedIcaTxs(msgs, RebalanceIcaBatchSize, ICACallbackID_Rebalance)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRebalancePlanResponse{
+		Rebalancings:          plannedRebalancings,
+		LockedValidators:      lockedValidators,
+		InFlightRedelegations: k.GetInFlightRedelegationsForHostZone(ctx, req.ChainId),
+		Txs:                   txs,
+	}, nil
+}
+
+// Dry-runs the delegation of each deposit record that would be staked in the next stride epoch
+func (k Keeper) DelegationPlan(c context.Context, req *types.QueryDelegationPlanRequest) (*types.QueryDelegationPlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, err := k.getHostZoneForIcaPlan(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	if !found {
+		return nil, status.Error(codes.NotFound, "stride epoch tracker not found")
+	}
+	nextEpochNumber := strideEpochTracker.EpochNumber + 1
+
+	deposits := []types.PlannedDepositDelegation{}
+	for _, depositRecord := range GetDepositRecordsToStake(nextEpochNumber, k.RecordsKeeper.GetAllDepositRecord(ctx)) {
+		if depositRecord.HostZoneId != req.ChainId || depositRecord.Amount.IsZero() {
+			continue
+		}
+
+		msgs, delegations, err := k.GetDelegationICAMessages(ctx, hostZone, depositRecord)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "unable to plan delegation for deposit record %d: %s", depositRecord.Id, err.Error())
+		}
+
+		batchSizes := k.GetIcaMsgBatchSizes(ctx, hostZone, msgs, int(utils.UintToInt(hostZone.MaxMessagesPerIcaTx)))
+		txs, err := GetPlannedIcaTxsFromBatchSizes(msgs, batchSizes, ICACallbackID_Delegate)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		splitDelegations := []types.SplitDelegation{}
+		for _, delegation := range delegations {
+			splitDelegations = append(splitDelegations, *delegation)
+		}
+
+		deposits = append(deposits, types.PlannedDepositDelegation{
+			DepositRecordId:  depositRecord.Id,
+			Amount:           depositRecord.Amount,
+			SplitDelegations: splitDelegations,
+			Txs:              txs,
+		})
+	}
+
+	return &types.QueryDelegationPlanResponse{Deposits: deposits}, nil
+}
+
+// Dry-runs the unbonding of the host zone's queued redemptions
+func (k Keeper) UnbondingPlan(c context.Context, req *types.QueryUnbondingPlanRequest) (*types.QueryUnbondingPlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, err := k.getHostZoneForIcaPlan(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Building the plan refreshes the native amounts on the queued records, so it's run against
+	// a cache context that's discarded, leaving the records unchanged
+	cacheCtx, _ := ctx.CacheContext()
+	plan, err := k.GetUnbondingPlan(cacheCtx, hostZone)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to plan unbonding: %s", err.Error())
+	}
+
+	batchSizes := k.GetIcaMsgBatchSizes(ctx, hostZone, plan.Msgs, int(utils.UintToInt(hostZone.MaxMessagesPerIcaTx)))
+	txs, err := GetPlannedIcaTxsFromBatchSizes(plan.Msgs, batchSizes, ICACallbackID_Undelegate)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	splitUndelegations := []types.SplitUndelegation{}
+	for _, unbonding := range plan.Unbondings {
+		splitUndelegations = append(splitUndelegations, *unbonding)
+	}
+
+	// Unbondings are triggered on the day epochs that are a multiple of the unbonding frequency
+	nextUnbondingDayEpoch := uint64(0)
+	if dayEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH); found {
+		unbondingFrequency := hostZone.GetUnbondingFrequency()
+		nextUnbondingDayEpoch = (dayEpochTracker.EpochNumber/unbondingFrequency + 1) * unbondingFrequency
+	}
+
+	return &types.QueryUnbondingPlanResponse{
+		NextUnbondingDayEpoch:   nextUnbondingDayEpoch,
+		TotalUnbondAmount:       plan.TotalUnbondAmount,
+		EpochUnbondingRecordIds: plan.EpochUnbondingRecordIds,
+		SplitUndelegations:      splitUndelegations,
+		Txs:                     txs,
+	}, nil
+}
+
+// Dry-runs the sweep of tokens that have finished unbonding to the redemption account
+func (k Keeper) RedemptionSweepPlan(c context.Context, req *types.QueryRedemptionSweepPlanRequest) (*types.QueryRedemptionSweepPlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, err := k.getHostZoneForIcaPlan(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, totalSweepAmount, epochUnbondingRecordIds, err := k.GetRedemptionSweepICAMessages(ctx, hostZone)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to plan redemption sweep: %s", err.Error())
+	}
+
+	txs, err := GetPlannedIcaTxs(msgs, len(msgs), ICACallbackID_Redemption)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedemptionSweepPlanResponse{
+		TotalSweepAmount:        totalSweepAmount,
+		EpochUnbondingRecordIds: epochUnbondingRecordIds,
+		Txs:                     txs,
+	}, nil
+}
+
+// Returns the host zone for an ICA dry-run query, erroring if the host zone would
+// be skipped by the epochly batches
+func (k Keeper) getHostZoneForIcaPlan(ctx sdk.Context, chainId string) (types.HostZone, error) {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return hostZone, status.Errorf(codes.NotFound, "host zone %s not found", chainId)
+	}
+	if hostZone.Halted {
+		return hostZone, status.Errorf(codes.FailedPrecondition, "host zone %s is halted", chainId)
+	}
+	if hostZone.DelegationIcaAddress == "" {
+		return hostZone, status.Errorf(codes.FailedPrecondition, "no delegation account found for %s", chainId)
+	}
+	return hostZone, nil
+}
+
 // InterchainAccountFromAddress implements the Query/InterchainAccountFromAddress gRPC method
 func (k Keeper) InterchainAccountFromAddress(goCtx context.Context, req *types.QueryInterchainAccountFromAddressRequest) (*types.QueryInterchainAccountFromAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -253,3 +536,125 @@ func (k Keeper) NextPacketSequence(c context.Context, req *types.QueryGetNextPac
 
 	return &types.QueryGetNextPacketSequenceResponse{Sequence: sequence}, nil
 }
+
+// Queries the circuit breaker guardian and all paused actions
+func (k Keeper) CircuitBreakers(c context.Context, req *types.QueryCircuitBreakersRequest) (*types.QueryCircuitBreakersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	response := &types.QueryCircuitBreakersResponse{Flags: k.GetAllCircuitBreakerFlags(ctx)}
+	if guardian, found := k.GetCircuitBreakerGuardian(ctx); found {
+		response.Guardian = &guardian
+	}
+
+	return response, nil
+}
+
+// Queries the learned gas estimate of each ICA message type on a host zone
+func (k Keeper) IcaGasEstimates(c context.Context, req *types.QueryIcaGasEstimatesRequest) (*types.QueryIcaGasEstimatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "host zone %s not found", req.ChainId)
+	}
+
+	return &types.QueryIcaGasEstimatesResponse{
+		MaxIcaTxGas: hostZone.MaxIcaTxGas,
+		Estimates:   k.GetIcaGasEstimatesForHostZone(ctx, req.ChainId),
+	}, nil
+}
+
+// Queries the slashing insurance fund of a host zone and its coverage history
+func (k Keeper) InsuranceFund(c context.Context, req *types.QueryInsuranceFundRequest) (*types.QueryInsuranceFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "host zone %s not found", req.ChainId)
+	}
+
+	return &types.QueryInsuranceFundResponse{
+		Config:          hostZone.InsuranceFundConfig,
+		Address:         hostZone.InsuranceFundAddress,
+		Balance:         sdk.NewCoin(hostZone.IbcDenom, k.GetInsuranceFundBalance(ctx, hostZone)),
+		CoverageHistory: k.GetInsuranceCoverageHistory(ctx, req.ChainId),
+	}, nil
+}
+
+// Queries the latest balance reconciliation report of a host zone
+func (k Keeper) IcaReconciliationReport(c context.Context, req *types.QueryIcaReconciliationReportRequest) (*types.QueryIcaReconciliationReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "host zone %s not found", req.ChainId)
+	}
+
+	report, found := k.GetIcaReconciliationReport(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no reconciliation report found for %s", req.ChainId)
+	}
+
+	threshold := hostZone.ReconciliationDiscrepancyThreshold
+	if threshold.IsNil() {
+		threshold = sdkmath.ZeroInt()
+	}
+
+	return &types.QueryIcaReconciliationReportResponse{
+		Report:    report,
+		Threshold: threshold,
+	}, nil
+}
+
+// Queries the host zone ICAs that are being re-registered after a channel closure
+func (k Keeper) IcaRecoveryStates(c context.Context, req *types.QueryIcaRecoveryStatesRequest) (*types.QueryIcaRecoveryStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryIcaRecoveryStatesResponse{
+		RecoveryStates: k.GetAllIcaRecoveryStates(ctx),
+	}, nil
+}
+
+func (k Keeper) OnboardingChecklist(c context.Context, req *types.QueryOnboardingChecklistRequest) (*types.QueryOnboardingChecklistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	checklist, err := k.GetHostZoneOnboardingChecklist(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryOnboardingChecklistResponse{Checklist: checklist}, nil
+}
+
+// Estimates when a user redemption record will be claimable
+func (k Keeper) RedemptionCompletionEstimate(c context.Context, req *types.QueryRedemptionCompletionEstimateRequest) (*types.QueryRedemptionCompletionEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	estimate, err := k.GetRedemptionCompletionEstimate(ctx, req.UserRedemptionRecordId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &estimate, nil
+}
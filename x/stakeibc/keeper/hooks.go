@@ -28,12 +28,16 @@ func (k Keeper) BeforeEpochStart(context context.Context, epochInfo epochstypes.
 
 	// Day Epoch - Process Unbondings
 	if epochInfo.Identifier == epochstypes.DAY_EPOCH {
+		// Recompute validator weights for host zones with automatic weighting enabled
+		k.UpdateAllAutoValidatorWeights(ctx)
 		// Initiate unbondings from any hostZone where it's appropriate
 		k.InitiateAllHostZoneUnbondings(ctx, epochNumber)
 		// Cleanup any records that are no longer needed
 		k.CleanupEpochUnbondingRecords(ctx, epochNumber)
 		// Create an empty unbonding record for this epoch
 		k.CreateEpochUnbondingRecord(ctx, epochNumber)
+		// Reconcile each account's balance against the records
+		k.ReconcileAllHostZoneBalances(ctx, epochNumber)
 	}
 
 	// Stride Epoch - Process Deposits and Delegations
@@ -54,6 +58,9 @@ func (k Keeper) BeforeEpochStart(context context.Context, epochInfo epochstypes.
 		// TODO: move this to an external function that anyone can call, so that we don't have to call it every epoch
 		k.SetWithdrawalAddress(ctx)
 
+		// Credit any instant redemption buffer refills that have landed since the last epoch
+		k.ReconcileInstantRedemptionBufferBalances(ctx)
+
 		// Update the redemption rate
 		if epochNumber%redemptionRateInterval == 0 {
 			k.UpdateRedemptionRates(ctx, depositRecords)
@@ -87,6 +94,9 @@ func (k Keeper) BeforeEpochStart(context context.Context, epochInfo epochstypes.
 		// to the redemption account
 		k.SweepUnbondedTokensAllHostZones(ctx)
 
+		// Return unbonded tokens from instant redemptions back to each host's instant redemption buffer
+		k.RefillInstantRedemptionBuffers(ctx)
+
 		// NOTE: Disabled in v28 as this feature is no longer being used. Uncomment to re-enable
 		// Transfers in and out of tokens for hostZones which have community pools
 		// k.ProcessAllCommunityPoolTokens(ctx)
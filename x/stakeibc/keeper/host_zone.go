@@ -141,6 +141,19 @@ func (k Keeper) UnregisterHostZone(ctx sdk.Context, chainId string) error {
 	k.AccountKeeper.RemoveAccount(ctx, k.AccountKeeper.GetAccount(ctx, communityPoolStakeAddress))
 	k.AccountKeeper.RemoveAccount(ctx, k.AccountKeeper.GetAccount(ctx, communityPoolRedeemAddress))
 
+	// The instant redemption buffer account is only created for host zones registered after instant
+	// redemptions were introduced, or after they've been enabled through governance
+	instantRedemptionBufferAddress := types.NewHostZoneModuleAddress(chainId, InstantRedemptionBufferAddressKey)
+	if instantRedemptionBufferAccount := k.AccountKeeper.GetAccount(ctx, instantRedemptionBufferAddress); instantRedemptionBufferAccount != nil {
+		k.AccountKeeper.RemoveAccount(ctx, instantRedemptionBufferAccount)
+	}
+
+	// The insurance fund account is only created once the fund has been enabled through governance
+	insuranceFundAddress := types.NewHostZoneModuleAddress(chainId, InsuranceFundAddressKey)
+	if insuranceFundAccount := k.AccountKeeper.GetAccount(ctx, insuranceFundAddress); insuranceFundAccount != nil {
+		k.AccountKeeper.RemoveAccount(ctx, insuranceFundAccount)
+	}
+
 	// Remove all deposit records for the host zone
 	for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
 		if depositRecord.HostZoneId == chainId {
@@ -160,9 +173,12 @@ func (k Keeper) UnregisterHostZone(ctx sdk.Context, chainId string) error {
 		k.RecordsKeeper.SetEpochUnbondingRecord(ctx, epochUnbondingRecord)
 	}
 
-	// Remove all user redemption records for the host zone
+	// Remove all user redemption records for the host zone, burning any NFTs that represent them
 	for _, userRedemptionRecord := range k.RecordsKeeper.GetAllUserRedemptionRecord(ctx) {
 		if userRedemptionRecord.HostZoneId == chainId {
+			if err := k.BurnRedemptionRecordNFT(ctx, userRedemptionRecord); err != nil {
+				return err
+			}
 			k.RecordsKeeper.RemoveUserRedemptionRecord(ctx, userRedemptionRecord.Id)
 		}
 	}
@@ -178,6 +194,10 @@ func (k Keeper) UnregisterHostZone(ctx sdk.Context, chainId string) error {
 	// Remove any blacklisted denoms from the rate limit module (may not be applicable)
 	k.RatelimitKeeper.RemoveDenomFromBlacklist(ctx, utils.StAssetDenomFromHostZoneDenom(hostZone.HostDenom))
 
+	// Remove the onboarding configuration, so that a re-registered host zone doesn't pick up
+	// the trade route or oracle from the previous onboarding
+	k.RemoveHostZoneOnboarding(ctx, chainId)
+
 	// Finally, remove the host zone struct
 	k.RemoveHostZone(ctx, chainId)
 
@@ -230,9 +250,23 @@ func (k Keeper) IterateHostZones(ctx sdk.Context, fn func(ctx sdk.Context, index
 }
 
 // This will split a total delegation amount across validators, according to weights
+// and on top of each validator's directed delegation
 // It returns a map of each portion, key'd on validator address
 // Validator's with a slash query in progress are excluded
 func (k Keeper) GetTargetValAmtsForHostZone(ctx sdk.Context, hostZone types.HostZone, totalDelegation sdkmath.Int) (map[string]sdkmath.Int, error) {
+	return k.GetTargetValAmtsWithDirectedDelegations(ctx, hostZone, totalDelegation, GetDirectedDelegations(hostZone))
+}
+
+// Splits a total delegation amount across validators, first allocating each validator's directed
+// amount and then splitting the remainder according to weights
+// Directed amounts are only honored for validators with a non-zero weight, and are scaled down
+// proportionally if they exceed the total delegation
+func (k Keeper) GetTargetValAmtsWithDirectedDelegations(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	totalDelegation sdkmath.Int,
+	directedDelegations map[string]sdkmath.Int,
+) (map[string]sdkmath.Int, error) {
 	// Confirm the expected delegation amount is greater than 0
 	if !totalDelegation.IsPositive() {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
@@ -264,17 +298,30 @@ func (k Keeper) GetTargetValAmtsForHostZone(ctx sdk.Context, hostZone types.Host
 		return validators[i].Address < validators[j].Address
 	})
 
-	// Assign each validator their portion of the delegation (and give any overflow to the last validator)
+	// Allocate the directed portion of the delegation first
+	directedTargets := GetDirectedTargets(validators, totalDelegation, directedDelegations)
+	totalDirected := sdkmath.ZeroInt()
+	for _, directedAmount := range directedTargets {
+		totalDirected = totalDirected.Add(directedAmount)
+	}
+	weightedDelegation := totalDelegation.Sub(totalDirected)
+
+	// Assign each validator their portion of the remaining delegation (and give any overflow to the last validator)
 	targetUnbondingsByValidator := make(map[string]sdkmath.Int)
 	totalAllocated := sdkmath.ZeroInt()
 	for i, validator := range validators {
+		directedAmount, ok := directedTargets[validator.Address]
+		if !ok {
+			directedAmount = sdkmath.ZeroInt()
+		}
+
 		// For the last element, we need to make sure that the totalAllocated is equal to the finalDelegation
 		if i == len(validators)-1 {
-			targetUnbondingsByValidator[validator.Address] = totalDelegation.Sub(totalAllocated)
+			targetUnbondingsByValidator[validator.Address] = weightedDelegation.Sub(totalAllocated).Add(directedAmount)
 		} else {
-			delegateAmt := sdkmath.NewIntFromUint64(validator.Weight).Mul(totalDelegation).Quo(sdkmath.NewIntFromUint64(totalWeight))
+			delegateAmt := sdkmath.NewIntFromUint64(validator.Weight).Mul(weightedDelegation).Quo(sdkmath.NewIntFromUint64(totalWeight))
 			totalAllocated = totalAllocated.Add(delegateAmt)
-			targetUnbondingsByValidator[validator.Address] = delegateAmt
+			targetUnbondingsByValidator[validator.Address] = delegateAmt.Add(directedAmount)
 		}
 	}
 
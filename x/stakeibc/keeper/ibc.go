@@ -114,6 +114,11 @@ func (k Keeper) StoreHostZoneIcaAddress(ctx sdk.Context, chainId, portId, addres
 
 	k.SetHostZone(ctx, hostZone)
 
+	// Once the withdrawal channel is registered, create the trade route from the host zone's onboarding
+	if portId == withdrawalPortID {
+		k.CreateOnboardingTradeRoute(ctx, chainId)
+	}
+
 	// Once the delegation channel is registered, whitelist epochly transfers so they're not rate limited
 	// Epochly transfers go from the deposit address to the delegation address
 	if portId == delegationPortID {
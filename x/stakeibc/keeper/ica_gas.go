@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	icacallbackstypes "github.com/Stride-Labs/stride/v33/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Delegation and undelegation ICAs are batched by the host zone's max messages per tx and,
+// if the host zone has a MaxIcaTxGas configured, by the summed gas estimate of each message
+//
+// Since acks don't include the gas used on the host, the per-message estimates are learned
+// from the outcome of each batch:
+//   - An out of gas ack with n messages implies each message used more than MaxIcaTxGas / n,
+//     so the estimate is raised above that bound
+//   - A successful ack decays the estimate slightly so that batches can grow back if the
+//     host's gas costs have come down, but never below the bound from the last out of gas ack
+//
+// Until a message type has been observed on a host zone, DefaultIcaMsgGasEstimate is used
+const (
+	DefaultIcaMsgGasEstimate = uint64(200_000)
+
+	// The estimate is decayed by 1/IcaGasEstimateDecayDivisor after each successful ack
+	IcaGasEstimateDecayDivisor = uint64(20)
+)
+
+// Stores the gas estimate for a message type on a host zone
+func (k Keeper) SetIcaGasEstimate(ctx sdk.Context, estimate types.IcaGasEstimate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaGasEstimateKeyPrefix))
+	key := types.IcaGasEstimateKey(estimate.ChainId, estimate.MsgTypeUrl)
+	store.Set(key, k.cdc.MustMarshal(&estimate))
+}
+
+// Reads the gas estimate for a message type on a host zone
+func (k Keeper) GetIcaGasEstimate(ctx sdk.Context, chainId, msgTypeUrl string) (estimate types.IcaGasEstimate, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaGasEstimateKeyPrefix))
+	bz := store.Get(types.IcaGasEstimateKey(chainId, msgTypeUrl))
+	if len(bz) == 0 {
+		return estimate, false
+	}
+	k.cdc.MustUnmarshal(bz, &estimate)
+	return estimate, true
+}
+
+// Returns the gas estimates for each message type on a host zone
+func (k Keeper) GetIcaGasEstimatesForHostZone(ctx sdk.Context, chainId string) (estimates []types.IcaGasEstimate) {
+	estimates = []types.IcaGasEstimate{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaGasEstimateKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, types.IcaGasEstimateChainPrefix(chainId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var estimate types.IcaGasEstimate
+		k.cdc.MustUnmarshal(iterator.Value(), &estimate)
+		estimates = append(estimates, estimate)
+	}
+
+	return estimates
+}
+
+// Returns the gas estimates across all host zones
+func (k Keeper) GetAllIcaGasEstimates(ctx sdk.Context) (estimates []types.IcaGasEstimate) {
+	estimates = []types.IcaGasEstimate{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaGasEstimateKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var estimate types.IcaGasEstimate
+		k.cdc.MustUnmarshal(iterator.Value(), &estimate)
+		estimates = append(estimates, estimate)
+	}
+
+	return estimates
+}
+
+// Returns the estimated gas per message for a message type, or the default if the
+// message type has not yet been observed on the host zone
+func (k Keeper) GetIcaMsgGasEstimate(ctx sdk.Context, chainId, msgTypeUrl string) uint64 {
+	estimate, found := k.GetIcaGasEstimate(ctx, chainId, msgTypeUrl)
+	if !found || estimate.GasPerMsg == 0 {
+		return DefaultIcaMsgGasEstimate
+	}
+	return estimate.GasPerMsg
+}
+
+// Returns the number of messages in each tx when batching a host zone's ICA messages
+// Batches are capped at maxMsgsPerTx messages and, if the host zone has a max gas
+// configured, at the max gas using the learned estimate of each message
+func (k Keeper) GetIcaMsgBatchSizes(ctx sdk.Context, hostZone types.HostZone, msgs []proto.Message, maxMsgsPerTx int) []int {
+	msgGasEstimates := make([]uint64, len(msgs))
+	if hostZone.MaxIcaTxGas > 0 {
+		for i, msg := range msgs {
+			msgGasEstimates[i] = k.GetIcaMsgGasEstimate(ctx, hostZone.ChainId, sdk.MsgTypeURL(msg))
+		}
+	}
+	return SplitIcaMsgBatches(msgGasEstimates, maxMsgsPerTx, hostZone.MaxIcaTxGas)
+}
+
+// Greedily groups consecutive messages into batches, closing a batch once adding the next
+// message would exceed either the message count or the summed gas
+// A limit of 0 is treated as no limit, and a single message that exceeds the max gas on
+// its own is still submitted in a batch by itself
+func SplitIcaMsgBatches(msgGasEstimates []uint64, maxMsgsPerTx int, maxGasPerTx uint64) (batchSizes []int) {
+	batchSizes = []int{}
+	batchSize := 0
+	batchGas := uint64(0)
+	for _, msgGas := range msgGasEstimates {
+		exceedsMaxMsgs := maxMsgsPerTx > 0 && batchSize >= maxMsgsPerTx
+		exceedsMaxGas := maxGasPerTx > 0 && batchGas+msgGas > maxGasPerTx
+		if batchSize > 0 && (exceedsMaxMsgs || exceedsMaxGas) {
+			batchSizes = append(batchSizes, batchSize)
+			batchSize = 0
+			batchGas = 0
+		}
+		batchSize += 1
+		batchGas += msgGas
+	}
+	if batchSize > 0 {
+		batchSizes = append(batchSizes, batchSize)
+	}
+	return batchSizes
+}
+
+// Checks whether an ICA ack error was caused by the host tx running out of gas
+// Depending on the host, error acks are formatted as either:
+//   - "ABCI error: {codespace}/{code}: {error}", in which case the error must be from the
+//     sdk codespace, since other modules can register errors with the same code
+//   - "ABCI code: {code}: {error}", in which case the codespace is not available and only
+//     the code can be checked
+func IsOutOfGasAckError(ackError string) bool {
+	outOfGasCode := sdkerrors.ErrOutOfGas.ABCICode()
+	if strings.HasPrefix(ackError, "ABCI error: ") {
+		codespacePrefix := fmt.Sprintf("ABCI error: %s/%d:", sdkerrors.ErrOutOfGas.Codespace(), outOfGasCode)
+		return strings.HasPrefix(ackError, codespacePrefix)
+	}
+	return strings.HasPrefix(ackError, fmt.Sprintf("ABCI code: %d:", outOfGasCode))
+}
+
+// Updates the gas estimate for a message type after the ack of a batch of numMsgs messages
+// This is a no-op if the host zone does not have a max gas configured, since there's no
+// bound to learn from
+func (k Keeper) UpdateIcaGasEstimateFromAck(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	msgTypeUrl string,
+	numMsgs int,
+	ackResponse *icacallbackstypes.AcknowledgementResponse,
+) {
+	if hostZone.MaxIcaTxGas == 0 || numMsgs == 0 {
+		return
+	}
+
+	estimate, found := k.GetIcaGasEstimate(ctx, hostZone.ChainId, msgTypeUrl)
+	if !found || estimate.GasPerMsg == 0 {
+		estimate = types.IcaGasEstimate{
+			ChainId:    hostZone.ChainId,
+			MsgTypeUrl: msgTypeUrl,
+			GasPerMsg:  DefaultIcaMsgGasEstimate,
+		}
+	}
+	previousGasPerMsg := estimate.GasPerMsg
+
+	switch {
+	case ackResponse.Status == icacallbackstypes.AckResponseStatus_SUCCESS:
+		estimate.GasPerMsg -= estimate.GasPerMsg / IcaGasEstimateDecayDivisor
+		if estimate.GasPerMsg < estimate.MinGasPerMsg {
+			estimate.GasPerMsg = estimate.MinGasPerMsg
+		}
+
+	case ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE && IsOutOfGasAckError(ackResponse.Error):
+		estimate.MinGasPerMsg = hostZone.MaxIcaTxGas/uint64(numMsgs) + 1
+		if estimate.GasPerMsg < estimate.MinGasPerMsg {
+			estimate.GasPerMsg = estimate.MinGasPerMsg
+		}
+
+	default:
+		return
+	}
+
+	k.SetIcaGasEstimate(ctx, estimate)
+
+	if estimate.GasPerMsg != previousGasPerMsg {
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Updated %s gas estimate from %d to %d",
+			msgTypeUrl, previousGasPerMsg, estimate.GasPerMsg))
+	}
+}
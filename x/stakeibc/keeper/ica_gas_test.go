@@ -0,0 +1,305 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	epochstypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	icacallbacktypes "github.com/Stride-Labs/stride/v33/x/icacallbacks/types"
+	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+const OutOfGasAckError = "ABCI code: 11: error handling packet: see events for details"
+
+func (s *KeeperTestSuite) TestSplitIcaMsgBatches() {
+	testCases := []struct {
+		name               string
+		msgGasEstimates    []uint64
+		maxMsgsPerTx       int
+		maxGasPerTx        uint64
+		expectedBatchSizes []int
+	}{
+		{
+			name:               "no messages",
+			msgGasEstimates:    []uint64{},
+			maxMsgsPerTx:       2,
+			maxGasPerTx:        100,
+			expectedBatchSizes: []int{},
+		},
+		{
+			name:               "message limit only",
+			msgGasEstimates:    []uint64{10, 10, 10, 10, 10},
+			maxMsgsPerTx:       2,
+			maxGasPerTx:        0,
+			expectedBatchSizes: []int{2, 2, 1},
+		},
+		{
+			name:               "gas limit only",
+			msgGasEstimates:    []uint64{40, 40, 40, 40, 40},
+			maxMsgsPerTx:       0,
+			maxGasPerTx:        100,
+			expectedBatchSizes: []int{2, 2, 1},
+		},
+		{
+			name:               "gas limit tighter than message limit",
+			msgGasEstimates:    []uint64{40, 40, 40, 40, 40},
+			maxMsgsPerTx:       3,
+			maxGasPerTx:        100,
+			expectedBatchSizes: []int{2, 2, 1},
+		},
+		{
+			name:               "message limit tighter than gas limit",
+			msgGasEstimates:    []uint64{10, 10, 10, 10, 10},
+			maxMsgsPerTx:       3,
+			maxGasPerTx:        100,
+			expectedBatchSizes: []int{3, 2},
+		},
+		{
+			name:               "mixed message gas",
+			msgGasEstimates:    []uint64{60, 30, 10, 90, 20},
+			maxMsgsPerTx:       5,
+			maxGasPerTx:        100,
+			expectedBatchSizes: []int{3, 1, 1},
+		},
+		{
+			name:               "single message over the gas limit",
+			msgGasEstimates:    []uint64{20, 150, 20},
+			maxMsgsPerTx:       5,
+			maxGasPerTx:        100,
+			expectedBatchSizes: []int{1, 1, 1},
+		},
+		{
+			name:               "no limits",
+			msgGasEstimates:    []uint64{10, 10, 10},
+			maxMsgsPerTx:       0,
+			maxGasPerTx:        0,
+			expectedBatchSizes: []int{3},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			batchSizes := keeper.SplitIcaMsgBatches(tc.msgGasEstimates, tc.maxMsgsPerTx, tc.maxGasPerTx)
+			s.Require().Equal(tc.expectedBatchSizes, batchSizes, "batch sizes")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetIcaMsgBatchSizes() {
+	delegateTypeUrl := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	undelegateTypeUrl := sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})
+
+	msgs := []proto.Message{}
+	for i := 0; i < 10; i++ {
+		msgs = append(msgs, &stakingtypes.MsgDelegate{})
+	}
+
+	// Without a max gas, only the message limit applies
+	hostZone := types.HostZone{ChainId: HostChainId}
+	s.Require().Equal([]int{5, 5}, s.App.StakeibcKeeper.GetIcaMsgBatchSizes(s.Ctx, hostZone, msgs, 5),
+		"batch sizes without max gas")
+
+	// With a max gas and no learned estimate, the default estimate is used
+	hostZone.MaxIcaTxGas = 3 * keeper.DefaultIcaMsgGasEstimate
+	s.Require().Equal([]int{3, 3, 3, 1}, s.App.StakeibcKeeper.GetIcaMsgBatchSizes(s.Ctx, hostZone, msgs, 5),
+		"batch sizes with default estimate")
+
+	// Once an estimate is learned for the message type, it's used in place of the default
+	s.App.StakeibcKeeper.SetIcaGasEstimate(s.Ctx, types.IcaGasEstimate{
+		ChainId:    HostChainId,
+		MsgTypeUrl: delegateTypeUrl,
+		GasPerMsg:  keeper.DefaultIcaMsgGasEstimate / 2,
+	})
+	s.Require().Equal([]int{5, 5}, s.App.StakeibcKeeper.GetIcaMsgBatchSizes(s.Ctx, hostZone, msgs, 5),
+		"batch sizes with learned estimate")
+
+	// Estimates for other message types or host zones should not be used
+	s.Require().Equal(keeper.DefaultIcaMsgGasEstimate,
+		s.App.StakeibcKeeper.GetIcaMsgGasEstimate(s.Ctx, HostChainId, undelegateTypeUrl), "other message type")
+	s.Require().Equal(keeper.DefaultIcaMsgGasEstimate,
+		s.App.StakeibcKeeper.GetIcaMsgGasEstimate(s.Ctx, OsmoChainId, delegateTypeUrl), "other host zone")
+}
+
+func (s *KeeperTestSuite) TestIsOutOfGasAckError() {
+	s.Require().True(keeper.IsOutOfGasAckError(OutOfGasAckError), "out of gas")
+	s.Require().False(keeper.IsOutOfGasAckError("ABCI code: 18: error handling packet: see events for details"), "invalid request")
+	s.Require().False(keeper.IsOutOfGasAckError("ABCI code: 111: error handling packet"), "code prefixed by 11")
+	s.Require().True(keeper.IsOutOfGasAckError("ABCI error: sdk/11: error handling packet: see events for details"), "sdk out of gas")
+	s.Require().False(keeper.IsOutOfGasAckError("ABCI error: wasm/11: error handling packet: see events for details"), "code 11 from another module")
+	s.Require().False(keeper.IsOutOfGasAckError("ABCI error: sdk/18: error handling packet: see events for details"), "sdk invalid request")
+	s.Require().False(keeper.IsOutOfGasAckError(""), "empty error")
+}
+
+func (s *KeeperTestSuite) TestUpdateIcaGasEstimateFromAck() {
+	msgTypeUrl := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	successAck := &icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	outOfGasAck := &icacallbacktypes.AcknowledgementResponse{
+		Status: icacallbacktypes.AckResponseStatus_FAILURE,
+		Error:  OutOfGasAckError,
+	}
+	otherFailureAck := &icacallbacktypes.AcknowledgementResponse{
+		Status: icacallbacktypes.AckResponseStatus_FAILURE,
+		Error:  "ABCI code: 18: error handling packet: see events for details",
+	}
+
+	// If the host zone doesn't have a max gas, nothing should be learned
+	hostZone := types.HostZone{ChainId: HostChainId}
+	s.App.StakeibcKeeper.UpdateIcaGasEstimateFromAck(s.Ctx, hostZone, msgTypeUrl, 4, outOfGasAck)
+	_, found := s.App.StakeibcKeeper.GetIcaGasEstimate(s.Ctx, HostChainId, msgTypeUrl)
+	s.Require().False(found, "no estimate should be stored without a max gas")
+
+	// A success decays the default estimate
+	hostZone.MaxIcaTxGas = 1_000_000
+	s.App.StakeibcKeeper.UpdateIcaGasEstimateFromAck(s.Ctx, hostZone, msgTypeUrl, 4, successAck)
+	estimate, found := s.App.StakeibcKeeper.GetIcaGasEstimate(s.Ctx, HostChainId, msgTypeUrl)
+	s.Require().True(found, "estimate should be stored after success")
+	s.Require().Equal(uint64(190_000), estimate.GasPerMsg, "estimate after success")
+	s.Require().Zero(estimate.MinGasPerMsg, "min estimate after success")
+
+	// Running out of gas with 4 messages implies each used more than 250,000
+	s.App.StakeibcKeeper.UpdateIcaGasEstimateFromAck(s.Ctx, hostZone, msgTypeUrl, 4, outOfGasAck)
+	estimate, _ = s.App.StakeibcKeeper.GetIcaGasEstimate(s.Ctx, HostChainId, msgTypeUrl)
+	s.Require().Equal(uint64(250_001), estimate.GasPerMsg, "estimate after out of gas")
+	s.Require().Equal(uint64(250_001), estimate.MinGasPerMsg, "min estimate after out of gas")
+
+	// A subsequent success should not decay the estimate below the out of gas bound
+	s.App.StakeibcKeeper.UpdateIcaGasEstimateFromAck(s.Ctx, hostZone, msgTypeUrl, 3, successAck)
+	estimate, _ = s.App.StakeibcKeeper.GetIcaGasEstimate(s.Ctx, HostChainId, msgTypeUrl)
+	s.Require().Equal(uint64(250_001), estimate.GasPerMsg, "estimate should be floored at the min")
+
+	// Failures unrelated to gas should not change the estimate
+	s.App.StakeibcKeeper.UpdateIcaGasEstimateFromAck(s.Ctx, hostZone, msgTypeUrl, 1, otherFailureAck)
+	estimate, _ = s.App.StakeibcKeeper.GetIcaGasEstimate(s.Ctx, HostChainId, msgTypeUrl)
+	s.Require().Equal(uint64(250_001), estimate.GasPerMsg, "estimate after unrelated failure")
+}
+
+func (s *KeeperTestSuite) TestDelegateCallback_OutOfGasRetry() {
+	// Create the delegation ICA channel
+	delegationAccountOwner := types.FormatHostZoneICAOwner(HostChainId, types.ICAAccountType_DELEGATION)
+	delegationChannelID, delegationPortID := s.CreateICAChannel(delegationAccountOwner)
+
+	// Create a host zone with 4 validators, each with a delegation in progress from the failed batch
+	numValidators := 4
+	validators := []*types.Validator{}
+	splitDelegations := []*types.SplitDelegation{}
+	for i := 0; i < numValidators; i++ {
+		validatorAddress := fmt.Sprintf("val%d", i)
+		validators = append(validators, &types.Validator{
+			Address:                     validatorAddress,
+			Delegation:                  sdkmath.NewInt(1000),
+			DelegationChangesInProgress: 1,
+		})
+		splitDelegations = append(splitDelegations, &types.SplitDelegation{
+			Validator: validatorAddress,
+			Amount:    sdkmath.NewInt(100),
+		})
+	}
+	hostZone := types.HostZone{
+		ChainId:              HostChainId,
+		ConnectionId:         ibctesting.FirstConnectionID,
+		HostDenom:            Atom,
+		DelegationIcaAddress: "cosmos_DELEGATION",
+		Validators:           validators,
+		TotalDelegations:     sdkmath.NewInt(4000),
+		MaxIcaTxGas:          1_000_000,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	depositRecord := recordtypes.DepositRecord{
+		Id:                      DepositRecordId,
+		HostZoneId:              HostChainId,
+		Amount:                  sdkmath.NewInt(400),
+		Status:                  recordtypes.DepositRecord_DELEGATION_IN_PROGRESS,
+		DelegationTxsInProgress: 1,
+	}
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+
+	// Mock the epoch tracker to timeout 90% through the epoch
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier:    epochstypes.STRIDE_EPOCH,
+		Duration:           10_000_000_000,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000),
+	})
+
+	startSequence := s.MustGetNextSequenceNumber(delegationPortID, delegationChannelID)
+
+	// Call the callback with an out of gas ack
+	ackResponse := &icacallbacktypes.AcknowledgementResponse{
+		Status: icacallbacktypes.AckResponseStatus_FAILURE,
+		Error:  OutOfGasAckError,
+	}
+	err := s.App.StakeibcKeeper.DelegateCallback(s.Ctx, channeltypes.Packet{}, ackResponse, s.mustMarshalDelegateCallback(splitDelegations))
+	s.Require().NoError(err, "no error expected during delegate callback")
+
+	// The estimate should be raised above 1,000,000 / 4, so the 4 delegations no longer fit in
+	// a single tx and are resubmitted across 2 txs
+	estimate, found := s.App.StakeibcKeeper.GetIcaGasEstimate(s.Ctx, HostChainId, sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}))
+	s.Require().True(found, "gas estimate should have been stored")
+	s.Require().Equal(uint64(250_001), estimate.GasPerMsg, "gas estimate")
+
+	endSequence := s.MustGetNextSequenceNumber(delegationPortID, delegationChannelID)
+	s.Require().Equal(startSequence+2, endSequence, "number of resubmitted ICAs")
+
+	// The deposit record should remain in progress with the new txs
+	depositRecord = s.MustGetDepositRecord(DepositRecordId)
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_IN_PROGRESS, depositRecord.Status, "deposit record status")
+	s.Require().Equal(uint64(2), depositRecord.DelegationTxsInProgress, "deposit record txs in progress")
+	s.Require().Equal(sdkmath.NewInt(400), depositRecord.Amount, "deposit record amount")
+
+	// Each validator should have its resubmitted delegation in progress
+	hostZone = s.MustGetHostZone(HostChainId)
+	for _, validator := range hostZone.Validators {
+		s.Require().Equal(uint64(1), validator.DelegationChangesInProgress, "%s delegation changes in progress", validator.Address)
+	}
+
+	// If the failed batch only had a single message, it can't be split further and the
+	// deposit record should fall back to the delegation queue
+	err = s.App.StakeibcKeeper.DelegateCallback(s.Ctx, channeltypes.Packet{}, ackResponse, s.mustMarshalDelegateCallback(splitDelegations[:1]))
+	s.Require().NoError(err, "no error expected during delegate callback with a single message")
+
+	depositRecord = s.MustGetDepositRecord(DepositRecordId)
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_QUEUE, depositRecord.Status, "deposit record status after single message")
+}
+
+func (s *KeeperTestSuite) mustMarshalDelegateCallback(splitDelegations []*types.SplitDelegation) []byte {
+	callbackArgsBz, err := proto.Marshal(&types.DelegateCallback{
+		HostZoneId:       HostChainId,
+		DepositRecordId:  DepositRecordId,
+		SplitDelegations: splitDelegations,
+	})
+	s.Require().NoError(err, "no error expected when marshalling callback args")
+	return callbackArgsBz
+}
+
+func (s *KeeperTestSuite) TestQueryIcaGasEstimates() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: HostChainId, MaxIcaTxGas: 1_000_000})
+	s.App.StakeibcKeeper.SetIcaGasEstimate(s.Ctx, types.IcaGasEstimate{
+		ChainId:    HostChainId,
+		MsgTypeUrl: sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		GasPerMsg:  150_000,
+	})
+	s.App.StakeibcKeeper.SetIcaGasEstimate(s.Ctx, types.IcaGasEstimate{
+		ChainId:    OsmoChainId,
+		MsgTypeUrl: sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		GasPerMsg:  300_000,
+	})
+
+	response, err := s.App.StakeibcKeeper.IcaGasEstimates(s.Ctx, &types.QueryIcaGasEstimatesRequest{ChainId: HostChainId})
+	s.Require().NoError(err, "no error expected when querying gas estimates")
+	s.Require().Equal(uint64(1_000_000), response.MaxIcaTxGas, "max ica tx gas")
+	s.Require().Len(response.Estimates, 1, "number of estimates")
+	s.Require().Equal(uint64(150_000), response.Estimates[0].GasPerMsg, "gas per msg")
+
+	_, err = s.App.StakeibcKeeper.IcaGasEstimates(s.Ctx, &types.QueryIcaGasEstimatesRequest{ChainId: "fake"})
+	s.Require().ErrorContains(err, "host zone fake not found")
+}
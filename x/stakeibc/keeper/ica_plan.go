@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Groups ICA messages into the transactions that would be submitted for a given batch size,
+// encoding each message as JSON so that the plan can be returned from a query
+func GetPlannedIcaTxs(msgs []proto.Message, batchSize int, callbackId string) ([]types.PlannedIcaTx, error) {
+	batchSizes := SplitIcaMsgBatches(make([]uint64, len(msgs)), batchSize, 0)
+	return GetPlannedIcaTxsFromBatchSizes(msgs, batchSizes, callbackId)
+}
+
+// Groups ICA messages into transactions with the given number of messages in each,
+// encoding each message as JSON so that the plan can be returned from a query
+func GetPlannedIcaTxsFromBatchSizes(msgs []proto.Message, batchSizes []int, callbackId string) ([]types.PlannedIcaTx, error) {
+	plannedTxs := []types.PlannedIcaTx{}
+	start := 0
+	for _, msgsInBatch := range batchSizes {
+		end := start + msgsInBatch
+
+		plannedTx := types.PlannedIcaTx{CallbackId: callbackId, Msgs: []types.PlannedIcaMsg{}}
+		for _, msg := range msgs[start:end] {
+			msgJson, err := codec.ProtoMarshalJSON(msg, nil)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "unable to marshal %s", sdk.MsgTypeURL(msg))
+			}
+			plannedTx.Msgs = append(plannedTx.Msgs, types.PlannedIcaMsg{
+				TypeUrl: sdk.MsgTypeURL(msg),
+				Json:    string(msgJson),
+			})
+		}
+		plannedTxs = append(plannedTxs, plannedTx)
+		start = end
+	}
+
+	return plannedTxs, nil
+}
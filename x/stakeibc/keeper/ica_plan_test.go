@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+)
+
+func (s *KeeperTestSuite) TestGetPlannedIcaTxs() {
+	msgs := []proto.Message{}
+	for i := 1; i <= 5; i++ {
+		msgs = append(msgs, &stakingtypes.MsgDelegate{
+			DelegatorAddress: "cosmos_DELEGATION",
+			ValidatorAddress: fmt.Sprintf("val%d", i),
+			Amount:           sdk.NewCoin(Atom, sdkmath.NewInt(int64(i))),
+		})
+	}
+
+	testCases := []struct {
+		name              string
+		batchSize         int
+		expectedTxLengths []int
+	}{
+		{name: "batch size of one", batchSize: 1, expectedTxLengths: []int{1, 1, 1, 1, 1}},
+		{name: "partial final batch", batchSize: 2, expectedTxLengths: []int{2, 2, 1}},
+		{name: "single batch", batchSize: 10, expectedTxLengths: []int{5}},
+		{name: "unset batch size", batchSize: 0, expectedTxLengths: []int{5}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			txs, err := keeper.GetPlannedIcaTxs(msgs, tc.batchSize, keeper.ICACallbackID_Delegate)
+			s.Require().NoError(err, "no error expected when planning txs")
+
+			s.Require().Len(txs, len(tc.expectedTxLengths), "number of txs")
+			msgIndex := 0
+			for i, tx := range txs {
+				s.Require().Equal(keeper.ICACallbackID_Delegate, tx.CallbackId, "callback id - tx %d", i)
+				s.Require().Len(tx.Msgs, tc.expectedTxLengths[i], "number of msgs - tx %d", i)
+
+				// Messages should stay in their original order
+				for _, msg := range tx.Msgs {
+					msgIndex++
+					s.Require().Equal("/cosmos.staking.v1beta1.MsgDelegate", msg.TypeUrl, "msg type")
+					s.Require().Contains(msg.Json, fmt.Sprintf(`"validator_address":"val%d"`, msgIndex), "msg json")
+				}
+			}
+		})
+	}
+
+	// No messages should result in no txs
+	txs, err := keeper.GetPlannedIcaTxs([]proto.Message{}, 5, keeper.ICACallbackID_Delegate)
+	s.Require().NoError(err, "no error expected with no messages")
+	s.Require().Empty(txs, "no txs expected")
+}
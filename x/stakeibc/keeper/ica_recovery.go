@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v11/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// ICA channels are ordered, so a single packet timeout closes the channel and nothing can be
+// sent from the account until it's re-registered on a new channel. Each block, the host zone
+// ICAs are checked for a closed channel and re-registered automatically
+//
+// Since the new channel's handshake must be relayed before the channel is open, the account is
+// not re-registered again until a backoff has elapsed, and the backoff doubles with each attempt
+// The number of accounts re-registered in a single block is also capped
+const (
+	IcaRecoveryInitialBackoff      = 10 * time.Minute
+	IcaRecoveryMaxBackoff          = 24 * time.Hour
+	MaxIcaRecoveryAttemptsPerBlock = 5
+)
+
+// Stores the recovery state of a host zone ICA
+func (k Keeper) SetIcaRecoveryState(ctx sdk.Context, recoveryState types.IcaRecoveryState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaRecoveryStateKeyPrefix))
+	key := types.IcaRecoveryStateKey(recoveryState.ChainId, recoveryState.IcaType)
+	store.Set(key, k.cdc.MustMarshal(&recoveryState))
+}
+
+// Reads the recovery state of a host zone ICA
+func (k Keeper) GetIcaRecoveryState(
+	ctx sdk.Context,
+	chainId string,
+	icaType types.ICAAccountType,
+) (recoveryState types.IcaRecoveryState, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaRecoveryStateKeyPrefix))
+	bz := store.Get(types.IcaRecoveryStateKey(chainId, icaType))
+	if len(bz) == 0 {
+		return recoveryState, false
+	}
+	k.cdc.MustUnmarshal(bz, &recoveryState)
+	return recoveryState, true
+}
+
+// Returns the recovery state of each host zone ICA that is being recovered
+func (k Keeper) GetAllIcaRecoveryStates(ctx sdk.Context) (recoveryStates []types.IcaRecoveryState) {
+	recoveryStates = []types.IcaRecoveryState{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaRecoveryStateKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var recoveryState types.IcaRecoveryState
+		k.cdc.MustUnmarshal(iterator.Value(), &recoveryState)
+		recoveryStates = append(recoveryStates, recoveryState)
+	}
+
+	return recoveryStates
+}
+
+// Removes the recovery state of a host zone ICA
+func (k Keeper) RemoveIcaRecoveryState(ctx sdk.Context, chainId string, icaType types.ICAAccountType) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaRecoveryStateKeyPrefix))
+	store.Delete(types.IcaRecoveryStateKey(chainId, icaType))
+}
+
+// Returns the backoff before the next recovery attempt, doubling with each attempt
+func GetIcaRecoveryBackoff(attempts uint64) time.Duration {
+	backoff := IcaRecoveryInitialBackoff
+	for i := uint64(1); i < attempts && backoff < IcaRecoveryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > IcaRecoveryMaxBackoff {
+		return IcaRecoveryMaxBackoff
+	}
+	return backoff
+}
+
+// Re-registers an existing ICA on a new channel
+// The account must have already been registered on the connection
+func (k Keeper) ReregisterInterchainAccount(ctx sdk.Context, connectionId, owner string) error {
+	// Get ConnectionEnd (for counterparty connection)
+	connectionEnd, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, connectionId)
+	if !found {
+		return errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "connection %s not found", connectionId)
+	}
+	counterpartyConnection := connectionEnd.Counterparty
+
+	// only allow restoring an account if it already exists
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+	_, exists := k.ICAControllerKeeper.GetInterchainAccountAddress(ctx, connectionId, portID)
+	if !exists {
+		return errorsmod.Wrapf(types.ErrInvalidInterchainAccountAddress,
+			"ICA controller account address not found: %s", owner)
+	}
+
+	appVersion := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: connectionId,
+		HostConnectionId:       counterpartyConnection.ConnectionId,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	if err := k.ICAControllerKeeper.RegisterInterchainAccount(ctx, connectionId, owner, appVersion, channeltypes.ORDERED); err != nil {
+		return errorsmod.Wrapf(err, "unable to register account for owner %s", owner)
+	}
+
+	return nil
+}
+
+// Resets the records that were in progress on a closed delegation channel, since
+// any ICAs along the original channel will never get relayed
+func (k Keeper) ResetDelegationIcaRecords(ctx sdk.Context, hostZone types.HostZone) error {
+	// Reset the delegation_changes_in_progress field on each validator
+	for _, validator := range hostZone.Validators {
+		validator.DelegationChangesInProgress = 0
+	}
+	k.SetHostZone(ctx, hostZone)
+
+	// revert DELEGATION_IN_PROGRESS records for the closed ICA channel (so that they can be staked)
+	depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
+	for _, depositRecord := range depositRecords {
+		// only revert records for the select host zone
+		if depositRecord.HostZoneId == hostZone.ChainId && depositRecord.Status == recordtypes.DepositRecord_DELEGATION_IN_PROGRESS {
+			depositRecord.Status = recordtypes.DepositRecord_DELEGATION_QUEUE
+			depositRecord.DelegationTxsInProgress = 0
+
+			k.Logger(ctx).Info(fmt.Sprintf("Setting DepositRecord %d to status DepositRecord_DELEGATION_IN_PROGRESS", depositRecord.Id))
+			k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+		}
+	}
+
+	// revert epoch unbonding records for the closed ICA channel
+	epochUnbondingRecords := k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx)
+	for _, epochUnbondingRecord := range epochUnbondingRecords {
+		// only revert records for the select host zone
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
+		if !found {
+			k.Logger(ctx).Info(fmt.Sprintf("No HostZoneUnbonding found for chainId: %s, epoch: %d", hostZone.ChainId, epochUnbondingRecord.EpochNumber))
+			continue
+		}
+
+		// Reset the number of undelegation txs in progress
+		hostZoneUnbonding.UndelegationTxsInProgress = 0
+
+		// Revert UNBONDING_IN_PROGRESS records to UNBONDING_RETRY_QUEUE
+		// and EXIT_TRANSFER_IN_PROGRESS records to EXIT_TRANSFER_QUEUE
+		if hostZoneUnbonding.Status == recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS {
+			k.Logger(ctx).Info(fmt.Sprintf("HostZoneUnbonding for %s at EpochNumber %d is stuck in status %s",
+				hostZone.ChainId, epochUnbondingRecord.EpochNumber, recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS.String(),
+			))
+			hostZoneUnbonding.Status = recordtypes.HostZoneUnbonding_UNBONDING_RETRY_QUEUE
+
+		} else if hostZoneUnbonding.Status == recordtypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS {
+			k.Logger(ctx).Info(fmt.Sprintf("HostZoneUnbonding for %s at EpochNumber %d to in status %s",
+				hostZone.ChainId, epochUnbondingRecord.EpochNumber, recordtypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS.String(),
+			))
+			hostZoneUnbonding.Status = recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE
+		}
+
+		err := k.RecordsKeeper.SetHostZoneUnbondingRecord(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId, *hostZoneUnbonding)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Revert all pending LSM Detokenizations from status DETOKENIZATION_IN_PROGRESS to status DETOKENIZATION_QUEUE
+	pendingDeposits := k.RecordsKeeper.GetLSMDepositsForHostZoneWithStatus(ctx, hostZone.ChainId, recordtypes.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS)
+	for _, lsmDeposit := range pendingDeposits {
+		k.Logger(ctx).Info(fmt.Sprintf("Setting LSMTokenDeposit %s to status DETOKENIZATION_QUEUE", lsmDeposit.Denom))
+		k.RecordsKeeper.UpdateLSMTokenDepositStatus(ctx, lsmDeposit, recordtypes.LSMTokenDeposit_DETOKENIZATION_QUEUE)
+	}
+
+	return nil
+}
+
+// Re-registers a host zone ICA on a new channel, and, for the delegation account, resets
+// the records that were in progress on the closed channel
+func (k Keeper) RecoverHostZoneIca(ctx sdk.Context, hostZone types.HostZone, icaType types.ICAAccountType) error {
+	owner := types.FormatHostZoneICAOwner(hostZone.ChainId, icaType)
+	if err := k.ReregisterInterchainAccount(ctx, hostZone.ConnectionId, owner); err != nil {
+		return err
+	}
+
+	if icaType == types.ICAAccountType_DELEGATION {
+		return k.ResetDelegationIcaRecords(ctx, hostZone)
+	}
+	return nil
+}
+
+// Checks whether a host zone ICA's channel is open
+// If the channel is open and the account was being recovered, the recovery state is removed
+// Otherwise, if the backoff has elapsed, the account is re-registered
+// Returns true if a recovery was attempted
+func (k Keeper) CheckAndRecoverHostZoneIca(ctx sdk.Context, hostZone types.HostZone, icaType types.ICAAccountType) (attempted bool, err error) {
+	owner := types.FormatHostZoneICAOwner(hostZone.ChainId, icaType)
+	portId, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return false, err
+	}
+
+	recoveryState, recovering := k.GetIcaRecoveryState(ctx, hostZone.ChainId, icaType)
+
+	// If the channel is open, the account is healthy (and any previous recovery has completed)
+	if _, isOpen := k.ICAControllerKeeper.GetOpenActiveChannel(ctx, hostZone.ConnectionId, portId); isOpen {
+		if recovering {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "%s ICA channel recovered after %d attempts",
+				icaType, recoveryState.Attempts))
+			k.RemoveIcaRecoveryState(ctx, hostZone.ChainId, icaType)
+			EmitIcaRecoveredEvent(ctx, recoveryState)
+		}
+		return false, nil
+	}
+
+	// Otherwise, wait for the previous attempt's handshake before trying again
+	if recovering && ctx.BlockTime().Before(recoveryState.NextAttemptTime) {
+		return false, nil
+	}
+
+	if !recovering {
+		recoveryState = types.IcaRecoveryState{
+			ChainId: hostZone.ChainId,
+			IcaType: icaType,
+		}
+	}
+
+	// Re-register the account in a cached context so a failed attempt doesn't leave partial state
+	// The attempt is recorded either way so that a failing account is retried with a backoff
+	recoveryErr := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.RecoverHostZoneIca(ctx, hostZone, icaType)
+	})
+
+	recoveryState.Attempts++
+	recoveryState.LastAttemptTime = ctx.BlockTime()
+	recoveryState.NextAttemptTime = ctx.BlockTime().Add(GetIcaRecoveryBackoff(recoveryState.Attempts))
+	recoveryState.LastError = ""
+	if recoveryErr != nil {
+		recoveryState.LastError = recoveryErr.Error()
+		k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId, "Unable to recover %s ICA channel: %s", icaType, recoveryErr))
+	} else {
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Re-registered %s ICA after channel closure (attempt %d)",
+			icaType, recoveryState.Attempts))
+	}
+	k.SetIcaRecoveryState(ctx, recoveryState)
+	EmitIcaRecoveryAttemptEvent(ctx, recoveryState)
+
+	return true, nil
+}
+
+// Checks each host zone ICA for a closed channel and re-registers any that were closed,
+// up to the max number of attempts per block
+func (k Keeper) RecoverClosedIcaChannels(ctx sdk.Context) {
+	attempts := 0
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		if hostZone.Deprecated {
+			continue
+		}
+		for _, icaType := range HostZoneIcaAccountTypes {
+			if GetHostZoneIcaAddress(hostZone, icaType) == "" {
+				continue
+			}
+			if attempts >= MaxIcaRecoveryAttemptsPerBlock {
+				return
+			}
+
+			attempted, err := k.CheckAndRecoverHostZoneIca(ctx, hostZone, icaType)
+			if err != nil {
+				k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId, "Unable to check %s ICA channel: %s", icaType, err))
+				continue
+			}
+			if attempted {
+				attempts++
+			}
+		}
+	}
+}
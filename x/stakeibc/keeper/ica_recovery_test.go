@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestGetIcaRecoveryBackoff() {
+	s.Require().Equal(10*time.Minute, keeper.GetIcaRecoveryBackoff(1), "first attempt")
+	s.Require().Equal(20*time.Minute, keeper.GetIcaRecoveryBackoff(2), "second attempt")
+	s.Require().Equal(40*time.Minute, keeper.GetIcaRecoveryBackoff(3), "third attempt")
+	s.Require().Equal(24*time.Hour, keeper.GetIcaRecoveryBackoff(20), "capped attempt")
+}
+
+// Re-uses the restore test setup, and stores the delegation address on the host zone
+// so that the account is checked for recovery
+func (s *KeeperTestSuite) SetupRecoverClosedIcaChannels() RestoreInterchainAccountTestCase {
+	tc := s.SetupRestoreInterchainAccount(true)
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.DelegationIcaAddress = s.IcaAddresses[tc.validMsg.AccountOwner]
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	return tc
+}
+
+// Returns the number of channels on a port in state INIT
+func (s *KeeperTestSuite) countInitChannels(portId string) (count int) {
+	for _, channel := range s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx) {
+		if channel.PortId == portId && channel.State == channeltypes.INIT {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *KeeperTestSuite) TestRecoverClosedIcaChannels_OpenChannel() {
+	tc := s.SetupRecoverClosedIcaChannels()
+
+	s.App.StakeibcKeeper.RecoverClosedIcaChannels(s.Ctx)
+
+	// Nothing should have been re-registered or reset
+	s.Require().Zero(s.countInitChannels(tc.delegationPortID), "no new channels")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllIcaRecoveryStates(s.Ctx), "no recovery states")
+	s.verifyDepositRecordsStatus(tc.depositRecordStatusUpdates, false)
+	s.CheckEventTypeNotEmitted(types.EventTypeIcaRecoveryAttempt)
+}
+
+func (s *KeeperTestSuite) TestRecoverClosedIcaChannels_Successful() {
+	tc := s.SetupRecoverClosedIcaChannels()
+	s.closeICAChannel(tc.delegationPortID, tc.delegationChannelID)
+
+	// Recover the channel, which should re-register the account and revert the records
+	s.App.StakeibcKeeper.RecoverClosedIcaChannels(s.Ctx)
+
+	s.Require().Equal(1, s.countInitChannels(tc.delegationPortID), "new channel after first attempt")
+	s.verifyDepositRecordsStatus(tc.depositRecordStatusUpdates, true)
+	s.verifyHostZoneUnbondingStatus(tc.unbondingRecordStatusUpdate, true)
+	s.verifyLSMDepositStatus(tc.lsmTokenDepositStatusUpdate, true)
+	s.verifyDelegationChangeInProgressReset(tc.depositRecordStatusUpdates)
+
+	recoveryState, found := s.App.StakeibcKeeper.GetIcaRecoveryState(s.Ctx, HostChainId, types.ICAAccountType_DELEGATION)
+	s.Require().True(found, "recovery state should have been created")
+	s.Require().Equal(uint64(1), recoveryState.Attempts, "attempts after first attempt")
+	s.Require().Equal(s.Ctx.BlockTime().Add(10*time.Minute).Unix(), recoveryState.NextAttemptTime.Unix(), "next attempt time")
+	s.Require().Empty(recoveryState.LastError, "no error expected")
+	s.CheckEventValueEmitted(types.EventTypeIcaRecoveryAttempt, types.AttributeKeyIcaType, "DELEGATION")
+
+	// Before the backoff has elapsed, the account should not be re-registered
+	s.App.StakeibcKeeper.RecoverClosedIcaChannels(s.Ctx)
+	s.Require().Equal(1, s.countInitChannels(tc.delegationPortID), "no new channel during backoff")
+
+	// Once the backoff has elapsed, the account should be re-registered again
+	s.Ctx = s.Ctx.WithBlockTime(recoveryState.NextAttemptTime)
+	s.App.StakeibcKeeper.RecoverClosedIcaChannels(s.Ctx)
+	s.Require().Equal(2, s.countInitChannels(tc.delegationPortID), "new channel after second attempt")
+
+	recoveryState, found = s.App.StakeibcKeeper.GetIcaRecoveryState(s.Ctx, HostChainId, types.ICAAccountType_DELEGATION)
+	s.Require().True(found, "recovery state should still exist")
+	s.Require().Equal(uint64(2), recoveryState.Attempts, "attempts after second attempt")
+	s.Require().Equal(s.Ctx.BlockTime().Add(20*time.Minute).Unix(), recoveryState.NextAttemptTime.Unix(), "next attempt time after backoff")
+
+	// Once the channel is open again, the recovery state should be removed
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx, tc.delegationPortID, tc.delegationChannelID)
+	s.Require().True(found, "channel should have been found")
+	channel.State = channeltypes.OPEN
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, tc.delegationPortID, tc.delegationChannelID, channel)
+
+	s.App.StakeibcKeeper.RecoverClosedIcaChannels(s.Ctx)
+	_, found = s.App.StakeibcKeeper.GetIcaRecoveryState(s.Ctx, HostChainId, types.ICAAccountType_DELEGATION)
+	s.Require().False(found, "recovery state should have been removed")
+	s.CheckEventValueEmitted(types.EventTypeIcaRecovered, types.AttributeKeyIcaType, "DELEGATION")
+}
+
+func (s *KeeperTestSuite) TestRecoverClosedIcaChannels_FailedAttempt() {
+	tc := s.SetupRecoverClosedIcaChannels()
+	s.closeICAChannel(tc.delegationPortID, tc.delegationChannelID)
+
+	// Point the host zone at a missing connection so the registration fails
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.ConnectionId = "connection-10"
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.RecoverClosedIcaChannels(s.Ctx)
+
+	// The attempt should be recorded with the error, and the records should be left alone
+	recoveryState, found := s.App.StakeibcKeeper.GetIcaRecoveryState(s.Ctx, HostChainId, types.ICAAccountType_DELEGATION)
+	s.Require().True(found, "recovery state should have been created")
+	s.Require().Equal(uint64(1), recoveryState.Attempts, "attempts")
+	s.Require().Contains(recoveryState.LastError, "connection connection-10 not found", "last error")
+
+	s.Require().Zero(s.countInitChannels(tc.delegationPortID), "no new channels")
+	s.verifyDepositRecordsStatus(tc.depositRecordStatusUpdates, false)
+}
+
+func (s *KeeperTestSuite) TestRecoverClosedIcaChannels_DeprecatedHostZone() {
+	tc := s.SetupRecoverClosedIcaChannels()
+	s.closeICAChannel(tc.delegationPortID, tc.delegationChannelID)
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.Deprecated = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.RecoverClosedIcaChannels(s.Ctx)
+
+	s.Require().Zero(s.countInitChannels(tc.delegationPortID), "no new channels")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllIcaRecoveryStates(s.Ctx), "no recovery states")
+}
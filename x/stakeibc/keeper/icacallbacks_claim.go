@@ -88,6 +88,9 @@ func (k Keeper) ClaimCallback(ctx sdk.Context, packet channeltypes.Packet, ackRe
 		return err
 	}
 
+	// Note: if the record was a refill of the instant redemption buffer, the buffer balance is not credited
+	// here, and is instead reconciled once the tokens land on stride (see ReconcileInstantRedemptionBufferBalance)
+
 	k.Logger(ctx).Info(fmt.Sprintf("[CLAIM] success on %s", userRedemptionRecord.GetHostZoneId()))
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/Stride-Labs/stride/v33/utils"
 	icacallbackstypes "github.com/Stride-Labs/stride/v33/x/icacallbacks/types"
@@ -21,7 +22,8 @@ import (
 // ICA Callback after delegating deposit records
 // * If successful: Updates deposit record status and records delegation changes on the host zone and validators
 // * If timeout:    Does nothing
-// * If failure:    Reverts deposit record status
+// * If failure:    Reverts deposit record status, unless the batch ran out of gas on the host,
+// in which case the delegations are resubmitted in smaller batches
 func (k Keeper) DelegateCallback(ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
 	// Deserialize the callback args
 	delegateCallback := types.DelegateCallback{}
@@ -69,12 +71,24 @@ func (k Keeper) DelegateCallback(ctx sdk.Context, packet channeltypes.Packet, ac
 		return nil
 	}
 
+	// Learn from the outcome of the batch for the sizing of future batches
+	msgTypeUrl := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	k.UpdateIcaGasEstimateFromAck(ctx, hostZone, msgTypeUrl, len(delegateCallback.SplitDelegations), ackResponse)
+
 	// Check for a failed transaction (ack error)
 	// Reset the deposit record status upon failure
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
 		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Delegate,
 			icacallbackstypes.AckResponseStatus_FAILURE, packet))
 
+		// If the batch ran out of gas, retry it in smaller batches
+		if IsOutOfGasAckError(ackResponse.Error) {
+			retried := k.RetryOutOfGasDelegations(ctx, hostZone, depositRecord, delegateCallback.SplitDelegations)
+			if retried {
+				return nil
+			}
+		}
+
 		// Reset deposit record status
 		depositRecord.Status = recordstypes.DepositRecord_DELEGATION_QUEUE
 		k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
@@ -100,8 +114,55 @@ func (k Keeper) DelegateCallback(ctx sdk.Context, packet channeltypes.Packet, ac
 			return errorsmod.Wrapf(err, "Failed to add delegation to validator")
 		}
 	}
+	ConfirmDirectedDelegations(&hostZone, delegateCallback.SplitDelegations)
 	k.SetHostZone(ctx, hostZone)
 
 	k.Logger(ctx).Info(fmt.Sprintf("[DELEGATION] success on %s", chainId))
 	return nil
 }
+
+// Resubmits the delegations from a batch that ran out of gas on the host, split across
+// at least two smaller batches, and returns whether the retry was submitted
+// Delegations are only retried if the batch had more than one message and the deposit
+// record is still in progress - otherwise the record falls back to the delegation queue
+func (k Keeper) RetryOutOfGasDelegations(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	depositRecord recordstypes.DepositRecord,
+	splitDelegations []*types.SplitDelegation,
+) (retried bool) {
+	if len(splitDelegations) <= 1 || hostZone.Halted ||
+		depositRecord.Status != recordstypes.DepositRecord_DELEGATION_IN_PROGRESS {
+		return false
+	}
+
+	msgs := []proto.Message{}
+	for _, splitDelegation := range splitDelegations {
+		msgs = append(msgs, &stakingtypes.MsgDelegate{
+			DelegatorAddress: hostZone.DelegationIcaAddress,
+			ValidatorAddress: splitDelegation.Validator,
+			Amount:           sdk.NewCoin(hostZone.HostDenom, splitDelegation.Amount),
+		})
+	}
+	retryBatchSize := (len(msgs) + 1) / 2
+
+	err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		numTxsSubmitted, err := k.BatchSubmitDelegationICAMessages(ctx, hostZone, depositRecord, msgs, splitDelegations, retryBatchSize)
+		if err != nil {
+			return err
+		}
+		depositRecord.DelegationTxsInProgress += numTxsSubmitted
+		k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+		return nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId,
+			"Unable to retry out of gas delegations for deposit record %d: %s", depositRecord.Id, err.Error()))
+		return false
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Delegation batch ran out of gas, resubmitted %d delegations for deposit record %d in smaller batches",
+		len(msgs), depositRecord.Id))
+	return true
+}
@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/Stride-Labs/stride/v33/utils"
 	icacallbackstypes "github.com/Stride-Labs/stride/v33/x/icacallbacks/types"
@@ -112,5 +113,43 @@ func (k Keeper) RebalanceCallback(ctx sdk.Context, packet channeltypes.Packet, a
 
 	k.SetHostZone(ctx, hostZone)
 
+	// Track each redelegation until it matures so that future rebalances do not conflict with it
+	completionTimes := k.GetRedelegationCompletionTimes(ctx, hostZone, ackResponse.MsgResponses, len(rebalanceCallback.Rebalancings))
+	for i, rebalancing := range rebalanceCallback.Rebalancings {
+		k.AddInFlightRedelegationEntry(ctx, chainId, rebalancing.SrcValidator, rebalancing.DstValidator, rebalancing.Amt, completionTimes[i])
+	}
+
 	return nil
 }
+
+// Returns the completion time of each redelegation in the ICA transaction
+// If the host's responses cannot be decoded, the completion time is conservatively
+// estimated as one day past the host's unbonding period
+func (k Keeper) GetRedelegationCompletionTimes(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	msgResponses [][]byte,
+	numRedelegations int,
+) []uint64 {
+	estimatedCompletionTime := utils.IntToUint(ctx.BlockTime().UnixNano()) + (hostZone.UnbondingPeriod+1)*nanosecondsInDay
+
+	completionTimes := make([]uint64, numRedelegations)
+	for i := range completionTimes {
+		completionTimes[i] = estimatedCompletionTime
+
+		if len(msgResponses) != numRedelegations {
+			continue
+		}
+		var redelegateResponse stakingtypes.MsgBeginRedelegateResponse
+		if err := proto.Unmarshal(msgResponses[i], &redelegateResponse); err != nil {
+			k.Logger(ctx).Error(utils.LogICACallbackWithHostZone(hostZone.ChainId, ICACallbackID_Rebalance,
+				"Unable to unmarshal redelegation response, estimating completion time: %s", err.Error()))
+			continue
+		}
+		if completionTime := redelegateResponse.CompletionTime.UnixNano(); completionTime > 0 {
+			completionTimes[i] = utils.IntToUint(completionTime)
+		}
+	}
+
+	return completionTimes
+}
@@ -1,12 +1,17 @@
 package keeper_test
 
 import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 	_ "github.com/stretchr/testify/suite"
 
 	sdkmath "cosmossdk.io/math"
 
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
 	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
 	icacallbacktypes "github.com/Stride-Labs/stride/v33/x/icacallbacks/types"
 	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
@@ -155,6 +160,62 @@ func (s *KeeperTestSuite) TestRebalanceCallback_Successful() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRebalanceCallback_TracksInFlightRedelegations() {
+	tc := s.SetupRebalanceCallback()
+
+	// Mock the completion times returned by the host for each redelegation
+	firstCompletionTime := s.Ctx.BlockTime().Add(time.Hour * 24 * 21)
+	secondCompletionTime := s.Ctx.BlockTime().Add(time.Hour * 24 * 22)
+
+	var err error
+	msgResponses := make([][]byte, 2)
+	msgResponses[0], err = proto.Marshal(&stakingtypes.MsgBeginRedelegateResponse{CompletionTime: firstCompletionTime})
+	s.Require().NoError(err, "marshal error")
+	msgResponses[1], err = proto.Marshal(&stakingtypes.MsgBeginRedelegateResponse{CompletionTime: secondCompletionTime})
+	s.Require().NoError(err, "marshal error")
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{
+		Status:       icacallbacktypes.AckResponseStatus_SUCCESS,
+		MsgResponses: msgResponses,
+	}
+
+	err = s.App.StakeibcKeeper.RebalanceCallback(s.Ctx, tc.validArgs.packet, &ackResponse, tc.validArgs.args)
+	s.Require().NoError(err, "rebalance callback succeeded")
+
+	// Each redelegation should be tracked with the completion time from the host
+	firstRedelegation, found := s.App.StakeibcKeeper.GetInFlightRedelegation(s.Ctx, HostChainId, "stride_VAL3", "stride_VAL1")
+	s.Require().True(found, "first redelegation should have been tracked")
+	s.Require().Len(firstRedelegation.Entries, 1, "first redelegation entries")
+	s.Require().Equal(int64(104), firstRedelegation.Entries[0].Amount.Int64(), "first redelegation amount")
+	s.Require().Equal(uint64(firstCompletionTime.UnixNano()), firstRedelegation.Entries[0].CompletionTime, "first completion time")
+
+	secondRedelegation, found := s.App.StakeibcKeeper.GetInFlightRedelegation(s.Ctx, HostChainId, "stride_VAL4", "stride_VAL1")
+	s.Require().True(found, "second redelegation should have been tracked")
+	s.Require().Len(secondRedelegation.Entries, 1, "second redelegation entries")
+	s.Require().Equal(int64(13), secondRedelegation.Entries[0].Amount.Int64(), "second redelegation amount")
+	s.Require().Equal(uint64(secondCompletionTime.UnixNano()), secondRedelegation.Entries[0].CompletionTime, "second completion time")
+}
+
+func (s *KeeperTestSuite) TestRebalanceCallback_EstimatesCompletionTime() {
+	tc := s.SetupRebalanceCallback()
+
+	hostZone := tc.initialState.hostZone
+	hostZone.UnbondingPeriod = 21
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Without any msg responses, the completion time should be estimated from the unbonding period
+	err := s.App.StakeibcKeeper.RebalanceCallback(s.Ctx, tc.validArgs.packet, tc.validArgs.ackResponse, tc.validArgs.args)
+	s.Require().NoError(err, "rebalance callback succeeded")
+
+	expectedCompletionTime := uint64(s.Ctx.BlockTime().Add(time.Hour * 24 * 22).UnixNano())
+	redelegations := s.App.StakeibcKeeper.GetInFlightRedelegationsForHostZone(s.Ctx, HostChainId)
+	s.Require().Len(redelegations, 2, "number of in-flight redelegations")
+	for _, redelegation := range redelegations {
+		s.Require().Len(redelegation.Entries, 1, "entries for %s", redelegation.SrcValidator)
+		s.Require().Equal(expectedCompletionTime, redelegation.Entries[0].CompletionTime, "completion time for %s", redelegation.SrcValidator)
+	}
+}
+
 func (s *KeeperTestSuite) checkDelegationStateIfCallbackFailed() {
 	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, "GAIA")
 	s.Require().True(found, "host zone found")
@@ -172,6 +233,9 @@ func (s *KeeperTestSuite) checkDelegationStateIfCallbackFailed() {
 	for i, validator := range validators {
 		s.Require().Equal(0, int(validator.DelegationChangesInProgress), "validator %d delegation changes in progress", i+1)
 	}
+
+	// No redelegations should be tracked
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllInFlightRedelegations(s.Ctx), "no in-flight redelegations")
 }
 
 func (s *KeeperTestSuite) TestRebalanceCallback_Timeout() {
@@ -25,10 +25,12 @@ import (
 //	  * Updates epoch unbonding record status
 //	  * Records delegation changes on the host zone and validators,
 //	  * Burns stTokens
+//	  * Queues the remainder of any records that were capped for the next unbonding epoch
 //	If timeout:
 //	  * Does nothing
 //	If failure:
-//	  * Sets epoch unbonding record status to RETRY
+//	  * If the batch ran out of gas, resubmits the undelegations in smaller batches
+//	  * Otherwise, sets epoch unbonding record status to RETRY
 func (k Keeper) UndelegateCallback(ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
 	// Fetch callback args
 	var undelegateCallback types.UndelegateCallback
@@ -59,12 +61,24 @@ func (k Keeper) UndelegateCallback(ctx sdk.Context, packet channeltypes.Packet,
 		return nil
 	}
 
+	// Learn from the outcome of the batch for the sizing of future batches
+	msgTypeUrl := sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})
+	k.UpdateIcaGasEstimateFromAck(ctx, hostZone, msgTypeUrl, len(undelegateCallback.SplitUndelegations), ackResponse)
+
 	// Check for a failed transaction (ack error)
 	// Set the status to RETRY_QUEUE if it fails
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
 		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Undelegate,
 			icacallbackstypes.AckResponseStatus_FAILURE, packet))
 
+		// If the batch ran out of gas, retry it in smaller batches
+		if IsOutOfGasAckError(ackResponse.Error) {
+			retried := k.RetryOutOfGasUndelegations(ctx, chainId, undelegateCallback)
+			if retried {
+				return nil
+			}
+		}
+
 		// Set any IN_PROGRESS records to RETRY_QUEUE
 		return k.HandleFailedUndelegation(ctx, chainId, undelegateCallback.EpochUnbondingRecordIds)
 	}
@@ -103,6 +117,11 @@ func (k Keeper) UndelegateCallback(ctx sdk.Context, packet channeltypes.Packet,
 		return err
 	}
 
+	// Queue the remainder of any records that were only partially unbonded due to the unbond cap
+	if err := k.RollOverCappedUnbondings(ctx, chainId, undelegateCallback.EpochUnbondingRecordIds); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -137,6 +156,70 @@ func (k Keeper) MarkUndelegationAckReceived(ctx sdk.Context, hostZone types.Host
 	return nil
 }
 
+// Resubmits the undelegations from a batch that ran out of gas on the host, split across
+// at least two smaller batches, and returns whether the retry was submitted
+// Undelegations are only retried if the batch had more than one message and each epoch
+// unbonding record is still in progress - otherwise the records fall back to the retry queue
+func (k Keeper) RetryOutOfGasUndelegations(ctx sdk.Context, chainId string, undelegateCallback types.UndelegateCallback) (retried bool) {
+	// The host zone is re-read since the ack was already marked on the validators
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found || hostZone.Halted || len(undelegateCallback.SplitUndelegations) <= 1 {
+		return false
+	}
+
+	hostZoneUnbondings := map[uint64]recordstypes.HostZoneUnbonding{}
+	for _, epochNumber := range undelegateCallback.EpochUnbondingRecordIds {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, chainId)
+		if !found || hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS {
+			return false
+		}
+		hostZoneUnbondings[epochNumber] = *hostZoneUnbonding
+	}
+
+	msgs := []proto.Message{}
+	for _, unbonding := range undelegateCallback.SplitUndelegations {
+		msgs = append(msgs, &stakingtypes.MsgUndelegate{
+			DelegatorAddress: hostZone.DelegationIcaAddress,
+			ValidatorAddress: unbonding.Validator,
+			Amount:           sdk.NewCoin(hostZone.HostDenom, unbonding.NativeTokenAmount),
+		})
+	}
+	retryBatchSize := (len(msgs) + 1) / 2
+
+	err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		numTxsSubmitted, err := k.BatchSubmitUndelegateICAMessages(
+			ctx,
+			hostZone,
+			undelegateCallback.EpochUnbondingRecordIds,
+			msgs,
+			undelegateCallback.SplitUndelegations,
+			retryBatchSize,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, epochNumber := range utils.Uint64MapKeys(hostZoneUnbondings) {
+			hostZoneUnbonding := hostZoneUnbondings[epochNumber]
+			hostZoneUnbonding.UndelegationTxsInProgress += numTxsSubmitted
+			if err := k.RecordsKeeper.SetHostZoneUnbondingRecord(ctx, epochNumber, chainId, hostZoneUnbonding); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error(utils.LogWithHostZone(chainId,
+			"Unable to retry out of gas undelegations for epochs %v: %s", undelegateCallback.EpochUnbondingRecordIds, err.Error()))
+		return false
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId,
+		"Undelegation batch ran out of gas, resubmitted %d undelegations for epochs %v in smaller batches",
+		len(msgs), undelegateCallback.EpochUnbondingRecordIds))
+	return true
+}
+
 // If the undelegation failed, set the unbonding status to RETRY_QUEUE, but only
 // for records that are currently in status UNBONDING_IN_PROGRESS
 // There may be some epoch numbers in this batch from records that have already had a full unbonding
@@ -181,7 +264,10 @@ func (k Keeper) HandleFailedUndelegation(ctx sdk.Context, chainId string, epochN
 }
 
 // Decrement the delegation field on the host zone and each validator's delegations after a successful unbonding ICA
+// The directed delegations are also reduced in proportion to the amount unbonded
 func (k Keeper) UpdateDelegationBalances(ctx sdk.Context, hostZone types.HostZone, undelegateCallback types.UndelegateCallback) error {
+	delegationBeforeUnbonding := hostZone.TotalDelegations
+
 	// Undelegate from each validator and update host zone staked balance, if successful
 	for _, undelegation := range undelegateCallback.SplitUndelegations {
 		err := k.AddDelegationToValidator(ctx, &hostZone, undelegation.Validator, undelegation.NativeTokenAmount.Neg(), ICACallbackID_Undelegate)
@@ -189,6 +275,10 @@ func (k Keeper) UpdateDelegationBalances(ctx sdk.Context, hostZone types.HostZon
 			return err
 		}
 	}
+
+	unbondedAmount := k.CalculateTotalUnbondedInBatch(undelegateCallback.SplitUndelegations)
+	ReduceDirectedDelegationsAfterUnbonding(&hostZone, unbondedAmount, delegationBeforeUnbonding)
+
 	k.SetHostZone(ctx, hostZone)
 	return nil
 }
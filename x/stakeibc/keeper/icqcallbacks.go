@@ -7,14 +7,15 @@ import (
 )
 
 const (
-	ICQCallbackID_WithdrawalHostBalance   = "withdrawalbalance"
-	ICQCallbackID_FeeBalance              = "feebalance"
-	ICQCallbackID_Delegation              = "delegation"
-	ICQCallbackID_Validator               = "validator"
-	ICQCallbackID_Calibrate               = "calibrate"
-	ICQCallbackID_CommunityPoolIcaBalance = "communitypoolicabalance"
-	ICQCallbackID_WithdrawalRewardBalance = "withdrawalrewardbalance"
-	ICQCallbackID_TradeConvertedBalance   = "tradeconvertedbalance"
+	ICQCallbackID_WithdrawalHostBalance    = "withdrawalbalance"
+	ICQCallbackID_FeeBalance               = "feebalance"
+	ICQCallbackID_Delegation               = "delegation"
+	ICQCallbackID_Validator                = "validator"
+	ICQCallbackID_Calibrate                = "calibrate"
+	ICQCallbackID_CommunityPoolIcaBalance  = "communitypoolicabalance"
+	ICQCallbackID_WithdrawalRewardBalance  = "withdrawalrewardbalance"
+	ICQCallbackID_TradeConvertedBalance    = "tradeconvertedbalance"
+	ICQCallbackID_IcaReconciliationBalance = "icareconciliationbalance"
 )
 
 // ICQCallbacks wrapper struct for stakeibc keeper
@@ -54,5 +55,6 @@ func (c ICQCallbacks) RegisterICQCallbacks() icqtypes.QueryCallbacks {
 		AddICQCallback(ICQCallbackID_Calibrate, ICQCallback(CalibrateDelegationCallback)).
 		AddICQCallback(ICQCallbackID_CommunityPoolIcaBalance, ICQCallback(CommunityPoolIcaBalanceCallback)).
 		AddICQCallback(ICQCallbackID_WithdrawalRewardBalance, ICQCallback(WithdrawalRewardBalanceCallback)).
-		AddICQCallback(ICQCallbackID_TradeConvertedBalance, ICQCallback(TradeConvertedBalanceCallback))
+		AddICQCallback(ICQCallbackID_TradeConvertedBalance, ICQCallback(TradeConvertedBalanceCallback)).
+		AddICQCallback(ICQCallbackID_IcaReconciliationBalance, ICQCallback(IcaReconciliationBalanceCallback))
 }
@@ -215,6 +215,7 @@ func (k Keeper) CheckForSlash(
 func (k Keeper) SlashValidatorOnHostZone(ctx sdk.Context, hostZone types.HostZone, valIndex int64, delegatedTokens sdkmath.Int) error {
 	chainId := hostZone.ChainId
 	validator := hostZone.Validators[valIndex]
+	redemptionRateBefore := hostZone.RedemptionRate
 
 	// There is a check upstream to verify that validator.Delegation is not 0
 	// This check is to explicitly avoid a division by zero error
@@ -239,6 +240,7 @@ func (k Keeper) SlashValidatorOnHostZone(ctx sdk.Context, hostZone types.HostZon
 
 	validator.Weight = sdkmath.LegacyNewDec(weight).Mul(weightAdjustment).TruncateInt().Uint64()
 	validator.Delegation = validator.Delegation.Sub(slashAmount)
+	validator.SlashCount += 1
 
 	// Update the validator on the host zone
 	hostZone.TotalDelegations = hostZone.TotalDelegations.Sub(slashAmount)
@@ -252,5 +254,17 @@ func (k Keeper) SlashValidatorOnHostZone(ctx sdk.Context, hostZone types.HostZon
 	depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
 	k.UpdateRedemptionRateForHostZone(ctx, hostZone, depositRecords)
 
-	return nil
+	// Cover the slash from the host zone's insurance fund (if enabled)
+	// A failed coverage should not prevent the slash from being recorded
+	err = utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		_, err := k.CoverSlashWithInsuranceFund(ctx, chainId, validator.Address, slashAmount, redemptionRateBefore)
+		return err
+	})
+	if err != nil {
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Delegation,
+			"Unable to cover slash with insurance fund: %s", err.Error()))
+	}
+
+	// Blacklist the validator and move its stake if the slash exceeded the host zone's threshold
+	return k.BlacklistValidatorIfSlashedPastThreshold(ctx, hostZone, validator.Address, slashPct)
 }
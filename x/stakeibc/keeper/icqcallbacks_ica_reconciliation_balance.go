@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	proto "github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	icqkeeper "github.com/Stride-Labs/stride/v33/x/interchainquery/keeper"
+	icqtypes "github.com/Stride-Labs/stride/v33/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// IcaReconciliationBalanceCallback is a callback handler for the reconciliation balance queries
+// The query response will return the native balance of one of the host zone's ICAs, which is
+// compared against the balance implied by the records and saved to the host zone's report
+// If the report has since been replaced by a later epoch's report, the response is ignored
+func IcaReconciliationBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_IcaReconciliationBalance,
+		"Starting ICA reconciliation balance callback, QueryId: %vs, QueryType: %s, Connection: %s",
+		query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	// Unmarshal the query response args to determine the balance
+	actualBalance, err := icqkeeper.UnmarshalAmountFromBalanceQuery(k.cdc, args)
+	if err != nil {
+		return errorsmod.Wrap(err, "unable to determine balance from query response")
+	}
+
+	// Unmarshal the callback data containing the ICA type and the epoch of the report
+	var callbackData types.IcaReconciliationCallback
+	if err := proto.Unmarshal(query.CallbackData, &callbackData); err != nil {
+		return errorsmod.Wrapf(err, "unable to unmarshal ICA reconciliation callback data")
+	}
+	icaType := callbackData.IcaType.String()
+
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_IcaReconciliationBalance,
+		"Query response - %s Balance: %v %s", icaType, actualBalance, hostZone.HostDenom))
+
+	// Ignore the response if the report is from a different epoch
+	report, found := k.GetIcaReconciliationReport(ctx, chainId)
+	if !found || report.EpochNumber != callbackData.EpochNumber {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_IcaReconciliationBalance,
+			"No reconciliation report found for epoch %d, ignoring response", callbackData.EpochNumber))
+		return nil
+	}
+
+	// Update the account's entry on the report
+	for i, entry := range report.Entries {
+		if entry.Account != icaType {
+			continue
+		}
+		expectedBalance := k.GetExpectedIcaBalance(ctx, chainId, callbackData.IcaType)
+		k.ReconcileEntry(ctx, hostZone, &report.Entries[i], expectedBalance, actualBalance)
+		k.SetIcaReconciliationReport(ctx, report)
+		return nil
+	}
+
+	return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no %s entry found in reconciliation report for %s", icaType, chainId)
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	icqtypes "github.com/Stride-Labs/stride/v33/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+type IcaReconciliationBalanceICQCallbackTestCase struct {
+	HostZone     types.HostZone
+	EpochNumber  uint64
+	ValidArgs    ICQCallbackArgs
+	CallbackData types.IcaReconciliationCallback
+}
+
+// Stores a report with pending delegation and fee entries, and a query response for the delegation account
+func (s *KeeperTestSuite) SetupIcaReconciliationBalanceCallbackTest(queriedBalance int64) IcaReconciliationBalanceICQCallbackTestCase {
+	s.SetupReconciliationRecords()
+
+	epochNumber := uint64(10)
+	hostZone := types.HostZone{
+		ChainId:                            HostChainId,
+		HostDenom:                          Atom,
+		DelegationIcaAddress:               "delegation-address",
+		FeeIcaAddress:                      "fee-address",
+		ReconciliationDiscrepancyThreshold: sdkmath.NewInt(10),
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	report := types.IcaReconciliationReport{
+		ChainId:     HostChainId,
+		EpochNumber: epochNumber,
+		Time:        s.Ctx.BlockTime(),
+		Entries: []types.IcaReconciliationEntry{
+			{
+				Account:         "DELEGATION",
+				Address:         "delegation-address",
+				ExpectedBalance: sdkmath.ZeroInt(),
+				ActualBalance:   sdkmath.ZeroInt(),
+				Discrepancy:     sdkmath.ZeroInt(),
+			},
+			{
+				Account:         "FEE",
+				Address:         "fee-address",
+				ExpectedBalance: sdkmath.ZeroInt(),
+				ActualBalance:   sdkmath.ZeroInt(),
+				Discrepancy:     sdkmath.ZeroInt(),
+			},
+		},
+	}
+	s.App.StakeibcKeeper.SetIcaReconciliationReport(s.Ctx, report)
+
+	callbackData := types.IcaReconciliationCallback{
+		IcaType:     types.ICAAccountType_DELEGATION,
+		EpochNumber: epochNumber,
+	}
+	callbackDataBz, err := proto.Marshal(&callbackData)
+	s.Require().NoError(err, "no error expected when marshaling callback data")
+
+	return IcaReconciliationBalanceICQCallbackTestCase{
+		HostZone:     hostZone,
+		EpochNumber:  epochNumber,
+		CallbackData: callbackData,
+		ValidArgs: ICQCallbackArgs{
+			Query: icqtypes.Query{
+				ChainId:      HostChainId,
+				CallbackData: callbackDataBz,
+			},
+			CallbackArgs: s.CreateBalanceQueryResponse(queriedBalance, Atom),
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestIcaReconciliationBalanceCallback_Discrepancy() {
+	// The delegation account is expected to have 524 tokens
+	tc := s.SetupIcaReconciliationBalanceCallbackTest(500)
+
+	err := keeper.IcaReconciliationBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.ValidArgs.CallbackArgs, tc.ValidArgs.Query)
+	s.Require().NoError(err, "no error expected during callback")
+
+	report, found := s.App.StakeibcKeeper.GetIcaReconciliationReport(s.Ctx, HostChainId)
+	s.Require().True(found, "report should have been found")
+
+	delegationEntry := report.Entries[0]
+	s.Require().True(delegationEntry.Reconciled, "delegation entry should be reconciled")
+	s.Require().Equal(int64(524), delegationEntry.ExpectedBalance.Int64(), "delegation expected balance")
+	s.Require().Equal(int64(500), delegationEntry.ActualBalance.Int64(), "delegation actual balance")
+	s.Require().Equal(int64(-24), delegationEntry.Discrepancy.Int64(), "delegation discrepancy")
+	s.Require().False(report.Entries[1].Reconciled, "fee entry should still be pending")
+
+	s.CheckEventValueEmitted(types.EventTypeIcaBalanceDiscrepancy, types.AttributeKeyAddress, "delegation-address")
+}
+
+func (s *KeeperTestSuite) TestIcaReconciliationBalanceCallback_WithinThreshold() {
+	tc := s.SetupIcaReconciliationBalanceCallbackTest(530)
+
+	err := keeper.IcaReconciliationBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.ValidArgs.CallbackArgs, tc.ValidArgs.Query)
+	s.Require().NoError(err, "no error expected during callback")
+
+	report, found := s.App.StakeibcKeeper.GetIcaReconciliationReport(s.Ctx, HostChainId)
+	s.Require().True(found, "report should have been found")
+	s.Require().True(report.Entries[0].Reconciled, "delegation entry should be reconciled")
+	s.Require().Equal(int64(6), report.Entries[0].Discrepancy.Int64(), "delegation discrepancy")
+
+	s.CheckEventTypeNotEmitted(types.EventTypeIcaBalanceDiscrepancy)
+}
+
+func (s *KeeperTestSuite) TestIcaReconciliationBalanceCallback_StaleReport() {
+	tc := s.SetupIcaReconciliationBalanceCallbackTest(500)
+
+	// Replace the report with one from a later epoch
+	report, found := s.App.StakeibcKeeper.GetIcaReconciliationReport(s.Ctx, HostChainId)
+	s.Require().True(found, "report should have been found")
+	report.EpochNumber = tc.EpochNumber + 1
+	s.App.StakeibcKeeper.SetIcaReconciliationReport(s.Ctx, report)
+
+	err := keeper.IcaReconciliationBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.ValidArgs.CallbackArgs, tc.ValidArgs.Query)
+	s.Require().NoError(err, "no error expected when the report is stale")
+
+	report, found = s.App.StakeibcKeeper.GetIcaReconciliationReport(s.Ctx, HostChainId)
+	s.Require().True(found, "report should have been found")
+	s.Require().False(report.Entries[0].Reconciled, "delegation entry should not have been updated")
+	s.CheckEventTypeNotEmitted(types.EventTypeIcaBalanceDiscrepancy)
+}
+
+func (s *KeeperTestSuite) TestIcaReconciliationBalanceCallback_MissingEntry() {
+	tc := s.SetupIcaReconciliationBalanceCallbackTest(500)
+
+	callbackData := tc.CallbackData
+	callbackData.IcaType = types.ICAAccountType_REDEMPTION
+	callbackDataBz, err := proto.Marshal(&callbackData)
+	s.Require().NoError(err, "no error expected when marshaling callback data")
+
+	invalidQuery := tc.ValidArgs.Query
+	invalidQuery.CallbackData = callbackDataBz
+	err = keeper.IcaReconciliationBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.ValidArgs.CallbackArgs, invalidQuery)
+	s.Require().ErrorContains(err, "no REDEMPTION entry found in reconciliation report")
+}
+
+func (s *KeeperTestSuite) TestIcaReconciliationBalanceCallback_HostZoneNotFound() {
+	tc := s.SetupIcaReconciliationBalanceCallbackTest(500)
+	s.App.StakeibcKeeper.RemoveHostZone(s.Ctx, HostChainId)
+
+	err := keeper.IcaReconciliationBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.ValidArgs.CallbackArgs, tc.ValidArgs.Query)
+	s.Require().ErrorContains(err, "no registered zone for queried chain ID")
+}
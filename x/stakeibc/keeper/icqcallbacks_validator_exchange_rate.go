@@ -13,6 +13,7 @@ import (
 
 	"github.com/Stride-Labs/stride/v33/utils"
 	icqtypes "github.com/Stride-Labs/stride/v33/x/interchainquery/types"
+	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
 	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 )
 
@@ -59,6 +60,11 @@ func ValidatorSharesToTokensRateCallback(k Keeper, ctx sdk.Context, args []byte,
 		"Query response - Validator: %s, Jailed: %v, Tokens: %v, Shares: %v",
 		queriedValidator.OperatorAddress, queriedValidator.Jailed, queriedValidator.Tokens, queriedValidator.DelegatorShares))
 
+	// Store the validator's jailed status and commission rate (used for automatic validator weighting)
+	if err := k.UpdateValidatorStatusFromQuery(ctx, hostZone, queriedValidator); err != nil {
+		return err
+	}
+
 	// Check the query response to identify if the validator was slashed
 	validatorWasSlashed, err := k.CheckIfValidatorWasSlashed(ctx, hostZone, queriedValidator)
 	if err != nil {
@@ -80,6 +86,12 @@ func ValidatorSharesToTokensRateCallback(k Keeper, ctx sdk.Context, args []byte,
 		}
 	}
 
+	// If the validator is jailed and the host zone blacklists jailed validators,
+	// blacklist the validator and move its stake
+	if err := k.BlacklistValidatorIfJailed(ctx, hostZone, queriedValidator.OperatorAddress, queriedValidator.Jailed); err != nil {
+		return errorsmod.Wrapf(err, "unable to blacklist jailed validator")
+	}
+
 	return nil
 }
 
@@ -181,8 +193,11 @@ func (k Keeper) LSMSlashQueryCallback(
 	if err != nil {
 		k.FailLSMLiquidStake(ctx, hostZone, lsmLiquidStake,
 			fmt.Sprintf("lsm liquid stake callback failed after slash query: %s", err.Error()))
+		return nil
 	}
 
+	k.CallLSMLiquidStakeCallbacks(ctx, *lsmLiquidStake.Deposit, true)
+
 	return nil
 }
 
@@ -193,4 +208,25 @@ func (k Keeper) FailLSMLiquidStake(ctx sdk.Context, hostZone types.HostZone, lsm
 
 	// Remove the LSMTokenDeposit
 	k.RecordsKeeper.RemoveLSMTokenDeposit(ctx, lsmLiquidStake.Deposit.ChainId, lsmLiquidStake.Deposit.Denom)
+
+	k.CallLSMLiquidStakeCallbacks(ctx, *lsmLiquidStake.Deposit, false)
+}
+
+// Invokes each registered module's callbacks after an asynchronous LSM liquid stake finishes or fails
+// Callback errors are logged and their state changes are discarded, but the outcome of the
+// liquid stake itself is not reverted
+func (k Keeper) CallLSMLiquidStakeCallbacks(ctx sdk.Context, deposit recordstypes.LSMTokenDeposit, finished bool) {
+	for _, module := range utils.StringMapKeys(k.lsmCallbacks) {
+		callbacks := k.lsmCallbacks[module]
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			if finished {
+				return callbacks.AfterLSMLiquidStakeFinished(ctx, deposit)
+			}
+			return callbacks.AfterLSMLiquidStakeFailed(ctx, deposit)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(utils.LogWithHostZone(deposit.ChainId,
+				"%s lsm liquid stake callback failed for deposit %s: %s", module, deposit.DepositId, err.Error()))
+		}
+	}
 }
@@ -33,6 +33,23 @@ type ValidatorICQCallbackArgs struct {
 	callbackArgs []byte
 }
 
+// Mock LSM liquid stake callbacks that record the deposits they were called with
+type MockLSMLiquidStakeCallbacks struct {
+	finished []recordstypes.LSMTokenDeposit
+	failed   []recordstypes.LSMTokenDeposit
+	err      error
+}
+
+func (m *MockLSMLiquidStakeCallbacks) AfterLSMLiquidStakeFinished(ctx sdk.Context, deposit recordstypes.LSMTokenDeposit) error {
+	m.finished = append(m.finished, deposit)
+	return m.err
+}
+
+func (m *MockLSMLiquidStakeCallbacks) AfterLSMLiquidStakeFailed(ctx sdk.Context, deposit recordstypes.LSMTokenDeposit) error {
+	m.failed = append(m.failed, deposit)
+	return m.err
+}
+
 type ValidatorICQCallbackTestCase struct {
 	initialState                ValidatorICQCallbackState
 	validArgs                   ValidatorICQCallbackArgs
@@ -289,7 +306,11 @@ func (s *KeeperTestSuite) TestValidatorSharesToTokensRateCallback_Successful_NoS
 	lsmCallback := true
 	tc := s.SetupValidatorICQCallback(validatorSlashed, lsmCallback)
 
-	err := keeper.ValidatorSharesToTokensRateCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
+	mockCallbacks := &MockLSMLiquidStakeCallbacks{}
+	err := s.App.StakeibcKeeper.SetLSMLiquidStakeCallbacks("mockmodule", mockCallbacks)
+	s.Require().NoError(err, "no error expected when registering lsm callbacks")
+
+	err = keeper.ValidatorSharesToTokensRateCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
 	s.Require().NoError(err, "validator sharesToTokens rate callback error")
 
 	// Confirm validator's sharesToTokens rate DID NOT update
@@ -301,6 +322,11 @@ func (s *KeeperTestSuite) TestValidatorSharesToTokensRateCallback_Successful_NoS
 
 	// Confirm the liquid stake was a success
 	s.checkLSMLiquidStakeSuccess()
+
+	// Confirm the registered callback was notified of the finished liquid stake
+	s.Require().Len(mockCallbacks.finished, 1, "number of finished callbacks")
+	s.Require().Len(mockCallbacks.failed, 0, "number of failed callbacks")
+	s.Require().Equal(tc.initialState.lsmTokenIBCDenom, mockCallbacks.finished[0].IbcDenom, "finished deposit denom")
 }
 
 // Test case where the callback was successful and this query was from a liquid stake,
@@ -348,6 +374,32 @@ func (s *KeeperTestSuite) TestValidatorSharesToTokensRateCallback_Successful_NoS
 		"staker balance after failed liquid stake")
 }
 
+func (s *KeeperTestSuite) TestCallLSMLiquidStakeCallbacks() {
+	deposit := recordstypes.LSMTokenDeposit{DepositId: "deposit-1", ChainId: HostChainId}
+
+	// Register two modules, one of which will fail
+	successfulCallbacks := &MockLSMLiquidStakeCallbacks{}
+	failingCallbacks := &MockLSMLiquidStakeCallbacks{err: fmt.Errorf("callback failed")}
+
+	err := s.App.StakeibcKeeper.SetLSMLiquidStakeCallbacks("module-a", successfulCallbacks)
+	s.Require().NoError(err, "no error expected when registering module-a")
+	err = s.App.StakeibcKeeper.SetLSMLiquidStakeCallbacks("module-b", failingCallbacks)
+	s.Require().NoError(err, "no error expected when registering module-b")
+
+	// Registering the same module twice should fail
+	err = s.App.StakeibcKeeper.SetLSMLiquidStakeCallbacks("module-a", successfulCallbacks)
+	s.Require().ErrorContains(err, "lsm liquid stake callbacks already set for module-a")
+
+	// A failing callback should not prevent the other callbacks from running
+	s.App.StakeibcKeeper.CallLSMLiquidStakeCallbacks(s.Ctx, deposit, true)
+	s.Require().Equal([]recordstypes.LSMTokenDeposit{deposit}, successfulCallbacks.finished, "module-a finished")
+	s.Require().Equal([]recordstypes.LSMTokenDeposit{deposit}, failingCallbacks.finished, "module-b finished")
+
+	s.App.StakeibcKeeper.CallLSMLiquidStakeCallbacks(s.Ctx, deposit, false)
+	s.Require().Equal([]recordstypes.LSMTokenDeposit{deposit}, successfulCallbacks.failed, "module-a failed")
+	s.Require().Equal([]recordstypes.LSMTokenDeposit{deposit}, failingCallbacks.failed, "module-b failed")
+}
+
 // Test case where the callback was successful, there was a slash, and this query was from a liquid stake
 // Here the sharesToTokens rate should update, the delegator shares query should be submitted,
 // and the liquid stake should be rejected
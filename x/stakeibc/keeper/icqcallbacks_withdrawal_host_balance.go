@@ -18,7 +18,8 @@ import (
 // WithdrawalHostBalanceCallback is a callback handler for WithdrawalBalance queries.
 // The query response will return the withdrawal account balance for the native denom (i.e. "host denom")
 // If the balance is non-zero, ICA MsgSends are submitted to transfer from the withdrawal account
-// to the delegation account (for reinvestment) and fee account (for commission)
+// to the delegation account (for reinvestment) and fee account (for commission), along with a
+// MsgTransfer to the insurance fund on stride (if enabled)
 //
 // Note: for now, to get proofs in your ICQs, you need to query the entire store on the host zone! e.g. "store/bank/key"
 func WithdrawalHostBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
@@ -68,6 +69,7 @@ func WithdrawalHostBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query
 	feeCoin := sdk.NewCoin(hostZone.HostDenom, rewardsSplit.StrideFeeAmount)
 	reinvestCoin := sdk.NewCoin(hostZone.HostDenom, rewardsSplit.ReinvestAmount)
 	rebateCoin := sdk.NewCoin(hostZone.HostDenom, rewardsSplit.RebateAmount)
+	insuranceCoin := sdk.NewCoin(hostZone.HostDenom, rewardsSplit.InsuranceAmount)
 
 	var msgs []proto.Message
 	if feeCoin.Amount.GT(sdkmath.ZeroInt()) {
@@ -95,6 +97,15 @@ func WithdrawalHostBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query
 		}
 		msgs = append(msgs, fundMsg...)
 	}
+	if insuranceCoin.Amount.GT(sdkmath.ZeroInt()) {
+		insuranceMsg, err := k.BuildInsuranceFundTransferMsg(ctx, hostZone, insuranceCoin.Amount)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, insuranceMsg)
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalHostBalance,
+			"Preparing MsgTransfer of %v from the withdrawal account to the insurance fund", insuranceCoin.String()))
+	}
 
 	// add callback data before calling reinvestment ICA
 	reinvestCallback := types.ReinvestCallback{
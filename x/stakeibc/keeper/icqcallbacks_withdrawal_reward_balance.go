@@ -17,7 +17,7 @@ import (
 // WithdrawalRewardBalanceCallback is a callback handler for WithdrawalRewardBalance queries.
 // The query response will return the withdrawal account balance for the reward denom in the case
 // of a host zone with a trade route (e.g. USDC in the case of the dYdX trade route)
-// If the balance is non-zero, ICA MsgSends are submitted to transfer the discovered balance to the tradeZone
+// If the balance is non-zero, ICA MsgSends are submitted to transfer the discovered balance to the trade venue
 //
 // Note: for now, to get proofs in your ICQs, you need to query the entire store on the host zone! e.g. "store/bank/key"
 func WithdrawalRewardBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
@@ -55,14 +55,18 @@ func WithdrawalRewardBalanceCallback(k Keeper, ctx sdk.Context, args []byte, que
 	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalRewardBalance,
 		"Query response - Withdrawal Reward Balance: %v %s", withdrawalRewardBalanceAmount, tradeRoute.RewardDenomOnHostZone))
 
-	// Transfer the reward amount to the trade zone so it can be swapped for the native token
-	if err := k.TransferRewardTokensHostToTrade(ctx, withdrawalRewardBalanceAmount, tradeRoute); err != nil {
-		return errorsmod.Wrapf(err, "initiating transfer of reward tokens to trade ICA failed")
+	// Transfer the reward amount to the trade venue so it can be swapped for the native token
+	venue, err := k.GetTradeVenueHandler(tradeRoute)
+	if err != nil {
+		return err
+	}
+	if err := venue.TransferRewardTokens(ctx, withdrawalRewardBalanceAmount, tradeRoute); err != nil {
+		return errorsmod.Wrapf(err, "initiating transfer of reward tokens to %s trade venue failed", tradeRoute.TradeVenue)
 	}
 
 	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalRewardBalance,
-		"Sending discovered reward tokens %v %s from hostZone to tradeZone",
-		withdrawalRewardBalanceAmount, tradeRoute.RewardDenomOnRewardZone))
+		"Sending discovered reward tokens %v %s from hostZone to %s trade venue",
+		withdrawalRewardBalanceAmount, tradeRoute.RewardDenomOnRewardZone, tradeRoute.TradeVenue))
 
 	return nil
 }
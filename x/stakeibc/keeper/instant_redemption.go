@@ -0,0 +1,475 @@
+package keeper
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Instant redemptions are served from a stride-side buffer of native tokens (in the ibc denom)
+// The lifecycle of the buffer is as follows:
+//  1. Funding: Each epoch, before deposits are transferred to the host, a portion of each
+//     deposit record is diverted into the buffer (up to the target buffer size)
+//  2. Redemption: A user's stTokens are escrowed and they're immediately paid out native tokens
+//     from the buffer, less a dynamic fee. The stTokens are queued into the current epoch's
+//     unbonding record, under a user redemption record owned by the buffer
+//  3. Refill: Once the unbonding completes, the buffer's redemption record is claimed by
+//     transferring the unbonded tokens from the redemption ICA back to the buffer
+//     The buffer balance is credited once the tokens arrive on stride
+//
+// The buffer balance and any outstanding refills are included in the redemption rate
+// Each movement is also tallied in an InstantRedemptionBufferRecord for the current day epoch
+
+// Exchanges a user's stTokens for native tokens immediately, using the instant redemption buffer
+func (k Keeper) InstantRedeemStake(ctx sdk.Context, msg *types.MsgInstantRedeemStake) (*types.MsgInstantRedeemStakeResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "creator address is invalid: %s. err: %s", msg.Creator, err.Error())
+	}
+
+	// Confirm the host zone is not halted and has instant redemptions enabled
+	hostZone, err := k.GetActiveHostZone(ctx, msg.HostZone)
+	if err != nil {
+		return nil, err
+	}
+	if !hostZone.RedemptionsEnabled {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionsDisabled, "redemptions disabled for %s", msg.HostZone)
+	}
+	if err := k.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, types.PAUSE_REDEEM); err != nil {
+		return nil, err
+	}
+	if hostZone.InstantRedemptionConfig == nil || hostZone.InstantRedemptionBufferAddress == "" {
+		return nil, errorsmod.Wrapf(types.ErrInstantRedemptionsDisabled, "instant redemptions disabled for %s", msg.HostZone)
+	}
+
+	// Safety check: redemption rate must be within safety bounds
+	rateIsSafe, err := k.IsRedemptionRateSafeForRedemptions(ctx, hostZone)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "unable to check if redemption rate is within safety bounds")
+	}
+	if !rateIsSafe {
+		return nil, types.ErrRedemptionRateOutsideSafetyBounds
+	}
+
+	// Determine the native amount from the redemption rate, and the fee from the buffer size
+	nativeAmount := sdkmath.LegacyNewDecFromInt(msg.Amount).Mul(hostZone.RedemptionRate).TruncateInt()
+	if !nativeAmount.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be greater than 0. found: %v", msg.Amount)
+	}
+	if nativeAmount.GT(hostZone.TotalDelegations) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "cannot unstake an amount g.t. staked balance on host zone: %v", msg.Amount)
+	}
+
+	bufferBalance := hostZone.InstantRedemptionBufferBalance
+	feeRate := GetInstantRedemptionFeeRate(*hostZone.InstantRedemptionConfig, bufferBalance, nativeAmount)
+	feeAmount := sdkmath.LegacyNewDecFromInt(nativeAmount).Mul(feeRate).TruncateInt()
+	payoutAmount := nativeAmount.Sub(feeAmount)
+
+	if !payoutAmount.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount after fees must be greater than 0. found: %v", payoutAmount)
+	}
+	if !msg.MinNativeAmount.IsNil() && payoutAmount.LT(msg.MinNativeAmount) {
+		return nil, errorsmod.Wrapf(types.ErrInstantRedemptionBelowMinimum,
+			"redemption would return %v%s, but minimum is %v", payoutAmount, hostZone.HostDenom, msg.MinNativeAmount)
+	}
+	if payoutAmount.GT(bufferBalance) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientInstantRedemptionBuffer,
+			"redemption requires %v%s, but buffer only has %v", payoutAmount, hostZone.HostDenom, bufferBalance)
+	}
+
+	// Queue the stTokens for unbonding on behalf of the buffer, so it's refilled once the unbonding completes
+	if err := k.QueueInstantRedemptionUnbonding(ctx, hostZone, msg.Amount, nativeAmount); err != nil {
+		return nil, err
+	}
+
+	// Escrow the user's stTokens in the deposit account, where they'll be burned after the unbonding
+	depositAddress, err := sdk.AccAddressFromBech32(hostZone.DepositAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid deposit address for %s", hostZone.ChainId)
+	}
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	stCoin := sdk.NewCoin(stDenom, msg.Amount)
+	if err := utils.SafeSendCoins(false, k.bankKeeper, ctx, sender, depositAddress, sdk.NewCoins(stCoin)); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't send %v to deposit account: %s", stCoin, err.Error())
+	}
+
+	// Pay the user out from the buffer
+	bufferAddress, err := sdk.AccAddressFromBech32(hostZone.InstantRedemptionBufferAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid instant redemption buffer address for %s", hostZone.ChainId)
+	}
+	payoutCoin := sdk.NewCoin(hostZone.IbcDenom, payoutAmount)
+	if err := utils.SafeSendCoins(false, k.bankKeeper, ctx, bufferAddress, sender, sdk.NewCoins(payoutCoin)); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to send %v from instant redemption buffer", payoutCoin)
+	}
+
+	// The fee remains in the buffer, so only the payout is removed from the balance
+	hostZone.InstantRedemptionBufferBalance = bufferBalance.Sub(payoutAmount)
+	k.SetHostZone(ctx, hostZone)
+
+	err = k.UpdateInstantRedemptionBufferRecord(ctx, hostZone.ChainId, func(record *recordstypes.InstantRedemptionBufferRecord) {
+		record.RedeemedAmount = record.RedeemedAmount.Add(payoutAmount)
+		record.FeeAmount = record.FeeAmount.Add(feeAmount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	EmitSuccessfulInstantRedeemStakeEvent(ctx, msg, hostZone, payoutAmount, feeAmount)
+
+	return &types.MsgInstantRedeemStakeResponse{
+		NativeToken: payoutCoin,
+		Fee:         sdk.NewCoin(hostZone.IbcDenom, feeAmount),
+	}, nil
+}
+
+// Returns the fee rate for an instant redemption, which scales linearly from the min fee rate
+// (when the buffer would remain full after the redemption) to the max fee rate (when the
+// redemption would fully drain the buffer)
+//
+//	depletion = 1 - (bufferBalance - nativeAmount) / targetBufferAmount
+//	feeRate = minFeeRate + (maxFeeRate - minFeeRate) * depletion
+func GetInstantRedemptionFeeRate(
+	config types.InstantRedemptionConfig,
+	bufferBalance sdkmath.Int,
+	nativeAmount sdkmath.Int,
+) sdkmath.LegacyDec {
+	if !config.TargetBufferAmount.IsPositive() {
+		return config.MaxFeeRate
+	}
+
+	remainingBalance := sdkmath.MaxInt(bufferBalance.Sub(nativeAmount), sdkmath.ZeroInt())
+	remainingRatio := sdkmath.LegacyNewDecFromInt(remainingBalance).Quo(sdkmath.LegacyNewDecFromInt(config.TargetBufferAmount))
+	depletion := sdkmath.LegacyMaxDec(sdkmath.LegacyOneDec().Sub(remainingRatio), sdkmath.LegacyZeroDec())
+
+	return config.MinFeeRate.Add(config.MaxFeeRate.Sub(config.MinFeeRate).Mul(depletion))
+}
+
+// Adds the stTokens from an instant redemption to the current epoch's unbonding record, under a user
+// redemption record whose receiver is the instant redemption buffer
+func (k Keeper) QueueInstantRedemptionUnbonding(ctx sdk.Context, hostZone types.HostZone, stAmount, nativeAmount sdkmath.Int) error {
+	epochTracker, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH)
+	if !found {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker found: %s", epochtypes.DAY_EPOCH)
+	}
+	epochNumber := epochTracker.EpochNumber
+
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, hostZone.ChainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone not found in unbondings: %s", hostZone.ChainId)
+	}
+
+	// Aggregate all instant redemptions from this epoch into a single record
+	redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, epochNumber, hostZone.InstantRedemptionBufferAddress)
+	userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
+	if found {
+		userRedemptionRecord.StTokenAmount = userRedemptionRecord.StTokenAmount.Add(stAmount)
+		userRedemptionRecord.NativeTokenAmount = userRedemptionRecord.NativeTokenAmount.Add(nativeAmount)
+	} else {
+		userRedemptionRecord = recordstypes.UserRedemptionRecord{
+			Id:                redemptionId,
+			Receiver:          hostZone.InstantRedemptionBufferAddress,
+			NativeTokenAmount: nativeAmount,
+			Denom:             hostZone.HostDenom,
+			HostZoneId:        hostZone.ChainId,
+			EpochNumber:       epochNumber,
+			StTokenAmount:     stAmount,
+			ClaimIsPending:    false,
+		}
+		hostZoneUnbonding.UserRedemptionRecords = append(hostZoneUnbonding.UserRedemptionRecords, redemptionId)
+	}
+
+	hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Add(nativeAmount)
+	hostZoneUnbonding.StTokenAmount = hostZoneUnbonding.StTokenAmount.Add(stAmount)
+
+	k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+	return k.RecordsKeeper.SetHostZoneUnbondingRecord(ctx, epochNumber, hostZone.ChainId, *hostZoneUnbonding)
+}
+
+// Returns the native tokens owed to the buffer from instant redemptions that have not yet been refilled
+// This is the sum of all outstanding user redemption records whose receiver is the buffer
+func (k Keeper) GetInstantRedemptionBufferPendingRefill(ctx sdk.Context, hostZone types.HostZone) sdkmath.Int {
+	total := sdkmath.ZeroInt()
+	if hostZone.InstantRedemptionBufferAddress == "" {
+		return total
+	}
+
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(
+			hostZone.ChainId, epochUnbondingRecord.EpochNumber, hostZone.InstantRedemptionBufferAddress)
+		userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
+		if found {
+			total = total.Add(userRedemptionRecord.NativeTokenAmount)
+		}
+	}
+
+	return total
+}
+
+// Returns the total value of the instant redemption buffer, including the current balance
+// and outstanding refills. This is used in the redemption rate calculation
+func (k Keeper) GetTotalInstantRedemptionBuffer(ctx sdk.Context, hostZone types.HostZone) sdkmath.LegacyDec {
+	pendingRefill := k.GetInstantRedemptionBufferPendingRefill(ctx, hostZone)
+	balance := hostZone.InstantRedemptionBufferBalance
+	if balance.IsNil() {
+		balance = sdkmath.ZeroInt()
+	}
+	return sdkmath.LegacyNewDecFromInt(balance.Add(pendingRefill))
+}
+
+// Diverts a portion of a deposit record into the instant redemption buffer, before the
+// deposit is transferred to the host zone. The amount diverted is determined by the
+// deposit allocation rate and is capped so the buffer does not exceed its target size
+// Returns the updated deposit record
+func (k Keeper) FundInstantRedemptionBuffer(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	depositRecord recordstypes.DepositRecord,
+) (recordstypes.DepositRecord, error) {
+	config := hostZone.InstantRedemptionConfig
+	if config == nil || hostZone.InstantRedemptionBufferAddress == "" {
+		return depositRecord, nil
+	}
+
+	// Determine how much room is left in the buffer
+	totalBuffer := k.GetTotalInstantRedemptionBuffer(ctx, hostZone).TruncateInt()
+	remainingCapacity := config.TargetBufferAmount.Sub(totalBuffer)
+	if !remainingCapacity.IsPositive() {
+		return depositRecord, nil
+	}
+
+	allocation := sdkmath.LegacyNewDecFromInt(depositRecord.Amount).Mul(config.DepositAllocationRate).TruncateInt()
+	allocation = sdkmath.MinInt(allocation, remainingCapacity)
+	if !allocation.IsPositive() {
+		return depositRecord, nil
+	}
+
+	// Move the tokens from the deposit account to the buffer
+	depositAddress, err := sdk.AccAddressFromBech32(hostZone.DepositAddress)
+	if err != nil {
+		return depositRecord, errorsmod.Wrapf(err, "invalid deposit address for %s", hostZone.ChainId)
+	}
+	bufferAddress, err := sdk.AccAddressFromBech32(hostZone.InstantRedemptionBufferAddress)
+	if err != nil {
+		return depositRecord, errorsmod.Wrapf(err, "invalid instant redemption buffer address for %s", hostZone.ChainId)
+	}
+	allocationCoin := sdk.NewCoin(hostZone.IbcDenom, allocation)
+	if err := utils.SafeSendCoins(false, k.bankKeeper, ctx, depositAddress, bufferAddress, sdk.NewCoins(allocationCoin)); err != nil {
+		return depositRecord, errorsmod.Wrapf(err, "unable to send %v to instant redemption buffer", allocationCoin)
+	}
+
+	// Remove the allocation from the deposit record and add it to the buffer
+	depositRecord.Amount = depositRecord.Amount.Sub(allocation)
+	k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+
+	hostZone.InstantRedemptionBufferBalance = hostZone.InstantRedemptionBufferBalance.Add(allocation)
+	k.SetHostZone(ctx, hostZone)
+
+	err = k.UpdateInstantRedemptionBufferRecord(ctx, hostZone.ChainId, func(record *recordstypes.InstantRedemptionBufferRecord) {
+		record.FundedAmount = record.FundedAmount.Add(allocation)
+	})
+	if err != nil {
+		return depositRecord, err
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Diverted %v from deposit record %d to the instant redemption buffer", allocationCoin, depositRecord.Id))
+	EmitInstantRedemptionBufferFundedEvent(ctx, hostZone, allocation)
+
+	return depositRecord, nil
+}
+
+// Iterates each host zone with an instant redemption buffer and submits a refill for each of the
+// buffer's redemption records that have finished unbonding
+func (k Keeper) RefillInstantRedemptionBuffers(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		if hostZone.InstantRedemptionBufferAddress == "" {
+			continue
+		}
+
+		for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+			hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
+			if !found || hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_CLAIMABLE {
+				continue
+			}
+
+			redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(
+				hostZone.ChainId, epochUnbondingRecord.EpochNumber, hostZone.InstantRedemptionBufferAddress)
+			userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
+			if !found || userRedemptionRecord.ClaimIsPending {
+				continue
+			}
+
+			if err := k.SubmitInstantRedemptionBufferRefill(ctx, hostZone, userRedemptionRecord); err != nil {
+				k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId,
+					"Unable to refill instant redemption buffer from record %s: %s", redemptionId, err.Error()))
+			}
+		}
+	}
+}
+
+// Submits an ICA to transfer the unbonded tokens for the buffer's redemption record from
+// the redemption ICA back to the buffer on stride
+// The claim callback is used so that the record is removed on success, or can be retried on failure
+func (k Keeper) SubmitInstantRedemptionBufferRefill(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	userRedemptionRecord recordstypes.UserRedemptionRecord,
+) error {
+	if hostZone.RedemptionIcaAddress == "" {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "redemption account not found for host zone %s", hostZone.ChainId)
+	}
+
+	// The transfer is sent from the host zone back to stride, so the counterparty channel is used
+	transferChannel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, hostZone.TransferChannelId)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "transfer channel %s not found", hostZone.TransferChannelId)
+	}
+	counterpartyChannelId := transferChannel.Counterparty.ChannelId
+
+	epochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	if !found {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker not found for epoch %s", epochtypes.STRIDE_EPOCH)
+	}
+	timeout := epochTracker.NextEpochStartTime + k.GetParam(ctx, types.KeyICATimeoutNanos)
+
+	msgs := []proto.Message{
+		transfertypes.NewMsgTransfer(
+			transfertypes.PortID,
+			counterpartyChannelId,
+			sdk.NewCoin(hostZone.HostDenom, userRedemptionRecord.NativeTokenAmount),
+			hostZone.RedemptionIcaAddress,
+			hostZone.InstantRedemptionBufferAddress,
+			clienttypes.Height{},
+			timeout,
+			"",
+		),
+	}
+
+	claimCallback := types.ClaimCallback{
+		UserRedemptionRecordId: userRedemptionRecord.Id,
+		ChainId:                hostZone.ChainId,
+		EpochNumber:            userRedemptionRecord.EpochNumber,
+	}
+	callbackArgsBz, err := k.MarshalClaimCallbackArgs(ctx, claimCallback)
+	if err != nil {
+		return errorsmod.Wrap(err, "unable to marshal claim callback args")
+	}
+
+	_, err = k.SubmitTxs(ctx, hostZone.ConnectionId, msgs, types.ICAAccountType_REDEMPTION, timeout, ICACallbackID_Claim, callbackArgsBz)
+	if err != nil {
+		return errorsmod.Wrap(err, "unable to submit buffer refill ICA")
+	}
+
+	// Flag the claim as pending so it's not submitted twice
+	userRedemptionRecord.ClaimIsPending = true
+	k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Submitted refill of %v%s to the instant redemption buffer", userRedemptionRecord.NativeTokenAmount, hostZone.HostDenom))
+
+	return nil
+}
+
+// Credits the instant redemption buffer with any refills that have landed on stride
+// The claim ack only confirms the refill transfer was initiated on the host, so the buffer balance
+// is instead reconciled against the buffer account's bank balance. Until the tokens arrive, the refill
+// is excluded from the redemption rate, since its user redemption record is removed on the ack
+// If the refill transfer times out, the tokens are returned to the redemption ICA and are not credited
+func (k Keeper) ReconcileInstantRedemptionBufferBalance(ctx sdk.Context, hostZone types.HostZone) error {
+	if hostZone.InstantRedemptionBufferAddress == "" {
+		return nil
+	}
+
+	bufferAddress, err := sdk.AccAddressFromBech32(hostZone.InstantRedemptionBufferAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid instant redemption buffer address for %s", hostZone.ChainId)
+	}
+	trackedBalance := hostZone.InstantRedemptionBufferBalance
+	if trackedBalance.IsNil() {
+		trackedBalance = sdkmath.ZeroInt()
+	}
+
+	// Every outflow from the buffer decrements the tracked balance in the same tx, so any
+	// excess in the account must be from refills that have arrived since the last reconciliation
+	bankBalance := k.bankKeeper.GetBalance(ctx, bufferAddress, hostZone.IbcDenom).Amount
+	refilledAmount := bankBalance.Sub(trackedBalance)
+	if !refilledAmount.IsPositive() {
+		return nil
+	}
+
+	hostZone.InstantRedemptionBufferBalance = bankBalance
+	k.SetHostZone(ctx, hostZone)
+
+	err = k.UpdateInstantRedemptionBufferRecord(ctx, hostZone.ChainId, func(record *recordstypes.InstantRedemptionBufferRecord) {
+		record.RefilledAmount = record.RefilledAmount.Add(refilledAmount)
+	})
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Instant redemption buffer refilled with %v%s", refilledAmount, hostZone.HostDenom))
+	EmitInstantRedemptionBufferRefilledEvent(ctx, hostZone, refilledAmount)
+
+	return nil
+}
+
+// Reconciles the instant redemption buffer balance for each host zone
+// This is run each stride epoch before the redemption rate is updated
+func (k Keeper) ReconcileInstantRedemptionBufferBalances(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		if err := k.ReconcileInstantRedemptionBufferBalance(ctx, hostZone); err != nil {
+			k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId,
+				"Unable to reconcile instant redemption buffer balance: %s", err.Error()))
+		}
+	}
+}
+
+// Applies a buffer movement to the host zone's instant redemption buffer record for the current day epoch
+func (k Keeper) UpdateInstantRedemptionBufferRecord(
+	ctx sdk.Context,
+	chainId string,
+	update func(record *recordstypes.InstantRedemptionBufferRecord),
+) error {
+	epochTracker, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH)
+	if !found {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker not found for epoch %s", epochtypes.DAY_EPOCH)
+	}
+
+	record, _ := k.RecordsKeeper.GetInstantRedemptionBufferRecord(ctx, chainId, epochTracker.EpochNumber)
+	update(&record)
+	k.RecordsKeeper.SetInstantRedemptionBufferRecord(ctx, record)
+
+	return nil
+}
+
+// Creates the instant redemption buffer module account for a host zone if it does not already exist
+func (k Keeper) CreateInstantRedemptionBufferAccount(ctx sdk.Context, hostZone *types.HostZone) error {
+	if hostZone.InstantRedemptionBufferAddress != "" {
+		return nil
+	}
+
+	bufferAddress := types.NewHostZoneModuleAddress(hostZone.ChainId, InstantRedemptionBufferAddressKey)
+	if err := utils.CreateModuleAccount(ctx, k.AccountKeeper, bufferAddress); err != nil {
+		return errorsmod.Wrapf(err, "unable to create instant redemption buffer account for host zone %s", hostZone.ChainId)
+	}
+
+	hostZone.InstantRedemptionBufferAddress = bufferAddress.String()
+	if hostZone.InstantRedemptionBufferBalance.IsNil() {
+		hostZone.InstantRedemptionBufferBalance = sdkmath.ZeroInt()
+	}
+	return nil
+}
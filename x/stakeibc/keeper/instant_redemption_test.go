@@ -0,0 +1,506 @@
+package keeper_test
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	icacallbacktypes "github.com/Stride-Labs/stride/v33/x/icacallbacks/types"
+	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+type InstantRedeemStakeTestCase struct {
+	user          sdk.AccAddress
+	bufferAddress sdk.AccAddress
+	hostZone      types.HostZone
+	validMsg      types.MsgInstantRedeemStake
+}
+
+func (s *KeeperTestSuite) getDefaultInstantRedemptionConfig() types.InstantRedemptionConfig {
+	return types.InstantRedemptionConfig{
+		DepositAllocationRate: sdkmath.LegacyMustNewDecFromStr("0.10"),
+		TargetBufferAmount:    sdkmath.NewInt(10_000_000),
+		MinFeeRate:            sdkmath.LegacyMustNewDecFromStr("0.01"),
+		MaxFeeRate:            sdkmath.LegacyMustNewDecFromStr("0.05"),
+	}
+}
+
+func (s *KeeperTestSuite) SetupInstantRedeemStake() InstantRedeemStakeTestCase {
+	initialBufferBalance := sdkmath.NewInt(5_000_000)
+
+	user := s.TestAccs[0]
+	s.FundAccount(user, sdk.NewInt64Coin("stuatom", 10_000_000))
+
+	depositAddress := types.NewHostZoneDepositAddress(HostChainId)
+	bufferAddress := types.NewHostZoneModuleAddress(HostChainId, keeper.InstantRedemptionBufferAddressKey)
+	s.FundAccount(bufferAddress, sdk.NewCoin("ibc/uatom", initialBufferBalance))
+
+	config := s.getDefaultInstantRedemptionConfig()
+	hostZone := types.HostZone{
+		ChainId:                        HostChainId,
+		HostDenom:                      "uatom",
+		IbcDenom:                       "ibc/uatom",
+		RedemptionRate:                 sdkmath.LegacyMustNewDecFromStr("1.5"),
+		TotalDelegations:               sdkmath.NewInt(1_000_000_000),
+		DepositAddress:                 depositAddress.String(),
+		RedemptionsEnabled:             true,
+		InstantRedemptionConfig:        &config,
+		InstantRedemptionBufferAddress: bufferAddress.String(),
+		InstantRedemptionBufferBalance: initialBufferBalance,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochtypes.DAY_EPOCH,
+		EpochNumber:     1,
+	})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{
+				HostZoneId:        HostChainId,
+				NativeTokenAmount: sdkmath.ZeroInt(),
+				StTokenAmount:     sdkmath.ZeroInt(),
+				Denom:             "uatom",
+				Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+			},
+		},
+	})
+
+	return InstantRedeemStakeTestCase{
+		user:          user,
+		bufferAddress: bufferAddress,
+		hostZone:      hostZone,
+		validMsg: types.MsgInstantRedeemStake{
+			Creator:         user.String(),
+			Amount:          sdkmath.NewInt(1_000_000),
+			HostZone:        HostChainId,
+			MinNativeAmount: sdkmath.NewInt(1_000_000),
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestInstantRedeemStake_Successful() {
+	tc := s.SetupInstantRedeemStake()
+
+	// 1,000,000 stuatom at a redemption rate of 1.5 is 1,500,000 uatom
+	// After the redemption, the buffer would have 3,500,000 remaining, or 35% of the target
+	// The fee rate is then 0.01 + (0.05 - 0.01) * 0.65 = 0.036, resulting in a fee of 54,000
+	expectedNativeAmount := sdkmath.NewInt(1_500_000)
+	expectedFee := sdkmath.NewInt(54_000)
+	expectedPayout := expectedNativeAmount.Sub(expectedFee)
+
+	resp, err := s.GetMsgServer().InstantRedeemStake(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected during instant redemption")
+	s.Require().Equal(expectedPayout.Int64(), resp.NativeToken.Amount.Int64(), "response native amount")
+	s.Require().Equal(expectedFee.Int64(), resp.Fee.Amount.Int64(), "response fee")
+
+	// Check the user was paid out and their stTokens were escrowed
+	userNativeBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.user, "ibc/uatom")
+	s.Require().Equal(expectedPayout.Int64(), userNativeBalance.Amount.Int64(), "user native balance")
+
+	userStBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.user, "stuatom")
+	s.Require().Equal(int64(9_000_000), userStBalance.Amount.Int64(), "user st balance")
+
+	depositStBalance := s.App.BankKeeper.GetBalance(s.Ctx, types.NewHostZoneDepositAddress(HostChainId), "stuatom")
+	s.Require().Equal(int64(1_000_000), depositStBalance.Amount.Int64(), "deposit account st balance")
+
+	// Check the buffer was decremented by only the payout amount
+	bufferBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.bufferAddress, "ibc/uatom")
+	s.Require().Equal(int64(5_000_000)-expectedPayout.Int64(), bufferBalance.Amount.Int64(), "buffer bank balance")
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Equal(bufferBalance.Amount.Int64(), hostZone.InstantRedemptionBufferBalance.Int64(), "host zone buffer balance")
+
+	// Check the stTokens were queued for unbonding under a record owned by the buffer
+	hostZoneUnbonding := s.MustGetHostZoneUnbonding(1, HostChainId)
+	s.Require().Equal(expectedNativeAmount, hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+	s.Require().Equal(tc.validMsg.Amount, hostZoneUnbonding.StTokenAmount, "host zone unbonding st amount")
+	s.Require().Len(hostZoneUnbonding.UserRedemptionRecords, 1, "number of redemption records")
+
+	redemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, hostZoneUnbonding.UserRedemptionRecords[0])
+	s.Require().True(found, "redemption record should have been created")
+	s.Require().Equal(tc.bufferAddress.String(), redemptionRecord.Receiver, "redemption record receiver")
+	s.Require().Equal(expectedNativeAmount, redemptionRecord.NativeTokenAmount, "redemption record native amount")
+
+	// Redeem again, the same redemption record should be updated
+	_, err = s.GetMsgServer().InstantRedeemStake(s.Ctx, &types.MsgInstantRedeemStake{
+		Creator:         tc.user.String(),
+		Amount:          sdkmath.NewInt(100),
+		HostZone:        HostChainId,
+		MinNativeAmount: sdkmath.ZeroInt(),
+	})
+	s.Require().NoError(err, "no error expected during second instant redemption")
+
+	hostZoneUnbonding = s.MustGetHostZoneUnbonding(1, HostChainId)
+	s.Require().Len(hostZoneUnbonding.UserRedemptionRecords, 1, "number of redemption records after second redemption")
+
+	redemptionRecord, _ = s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, hostZoneUnbonding.UserRedemptionRecords[0])
+	s.Require().Equal(int64(1_000_100), redemptionRecord.StTokenAmount.Int64(), "redemption record st amount after second redemption")
+
+	// Check both redemptions were tallied in the epoch's buffer record
+	// 100 stuatom is 150 uatom, with a fee rate of ~0.0358 the fee is truncated to 5
+	bufferRecord, found := s.App.RecordsKeeper.GetInstantRedemptionBufferRecord(s.Ctx, HostChainId, 1)
+	s.Require().True(found, "buffer record should have been created")
+	s.Require().Equal(expectedPayout.Int64()+145, bufferRecord.RedeemedAmount.Int64(), "buffer record redeemed amount")
+	s.Require().Equal(expectedFee.Int64()+5, bufferRecord.FeeAmount.Int64(), "buffer record fee amount")
+	s.Require().Zero(bufferRecord.FundedAmount.Int64(), "buffer record funded amount")
+	s.Require().Zero(bufferRecord.RefilledAmount.Int64(), "buffer record refilled amount")
+}
+
+func (s *KeeperTestSuite) TestInstantRedeemStake_Disabled() {
+	tc := s.SetupInstantRedeemStake()
+
+	hostZone := tc.hostZone
+	hostZone.InstantRedemptionConfig = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().InstantRedeemStake(s.Ctx, &tc.validMsg)
+	s.Require().ErrorIs(err, types.ErrInstantRedemptionsDisabled)
+}
+
+func (s *KeeperTestSuite) TestInstantRedeemStake_RedemptionsDisabled() {
+	tc := s.SetupInstantRedeemStake()
+
+	hostZone := tc.hostZone
+	hostZone.RedemptionsEnabled = false
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().InstantRedeemStake(s.Ctx, &tc.validMsg)
+	s.Require().ErrorIs(err, types.ErrRedemptionsDisabled)
+}
+
+func (s *KeeperTestSuite) TestInstantRedeemStake_BelowMinimum() {
+	tc := s.SetupInstantRedeemStake()
+
+	invalidMsg := tc.validMsg
+	invalidMsg.MinNativeAmount = sdkmath.NewInt(1_500_000)
+
+	_, err := s.GetMsgServer().InstantRedeemStake(s.Ctx, &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrInstantRedemptionBelowMinimum)
+}
+
+func (s *KeeperTestSuite) TestInstantRedeemStake_InsufficientBuffer() {
+	tc := s.SetupInstantRedeemStake()
+
+	invalidMsg := tc.validMsg
+	invalidMsg.Amount = sdkmath.NewInt(4_000_000)
+
+	_, err := s.GetMsgServer().InstantRedeemStake(s.Ctx, &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrInsufficientInstantRedemptionBuffer)
+}
+
+func (s *KeeperTestSuite) TestInstantRedeemStake_HostZoneHalted() {
+	tc := s.SetupInstantRedeemStake()
+
+	hostZone := tc.hostZone
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().InstantRedeemStake(s.Ctx, &tc.validMsg)
+	s.Require().ErrorContains(err, "host zone GAIA is halted")
+}
+
+func (s *KeeperTestSuite) TestGetInstantRedemptionFeeRate() {
+	config := s.getDefaultInstantRedemptionConfig()
+
+	testCases := []struct {
+		name            string
+		bufferBalance   int64
+		nativeAmount    int64
+		targetAmount    int64
+		expectedFeeRate string
+	}{
+		{
+			name:            "buffer remains full",
+			bufferBalance:   20_000_000,
+			nativeAmount:    1_000_000,
+			targetAmount:    10_000_000,
+			expectedFeeRate: "0.01",
+		},
+		{
+			name:            "buffer remains at target",
+			bufferBalance:   11_000_000,
+			nativeAmount:    1_000_000,
+			targetAmount:    10_000_000,
+			expectedFeeRate: "0.01",
+		},
+		{
+			name:            "buffer half depleted",
+			bufferBalance:   6_000_000,
+			nativeAmount:    1_000_000,
+			targetAmount:    10_000_000,
+			expectedFeeRate: "0.03",
+		},
+		{
+			name:            "buffer fully drained",
+			bufferBalance:   1_000_000,
+			nativeAmount:    1_000_000,
+			targetAmount:    10_000_000,
+			expectedFeeRate: "0.05",
+		},
+		{
+			name:            "redemption larger than buffer",
+			bufferBalance:   1_000_000,
+			nativeAmount:    2_000_000,
+			targetAmount:    10_000_000,
+			expectedFeeRate: "0.05",
+		},
+		{
+			name:            "zero target",
+			bufferBalance:   1_000_000,
+			nativeAmount:    1,
+			targetAmount:    0,
+			expectedFeeRate: "0.05",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			config.TargetBufferAmount = sdkmath.NewInt(tc.targetAmount)
+			actualFeeRate := keeper.GetInstantRedemptionFeeRate(config, sdkmath.NewInt(tc.bufferBalance), sdkmath.NewInt(tc.nativeAmount))
+			s.Require().Equal(sdkmath.LegacyMustNewDecFromStr(tc.expectedFeeRate), actualFeeRate, "fee rate")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFundInstantRedemptionBuffer() {
+	depositAddress := types.NewHostZoneDepositAddress(HostChainId)
+	bufferAddress := types.NewHostZoneModuleAddress(HostChainId, keeper.InstantRedemptionBufferAddressKey)
+	s.FundAccount(depositAddress, sdk.NewInt64Coin("ibc/uatom", 100_000_000))
+
+	config := s.getDefaultInstantRedemptionConfig()
+	hostZone := types.HostZone{
+		ChainId:                        HostChainId,
+		IbcDenom:                       "ibc/uatom",
+		DepositAddress:                 depositAddress.String(),
+		InstantRedemptionConfig:        &config,
+		InstantRedemptionBufferAddress: bufferAddress.String(),
+		InstantRedemptionBufferBalance: sdkmath.NewInt(9_000_000),
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{EpochIdentifier: epochtypes.DAY_EPOCH, EpochNumber: 2})
+
+	// With an allocation rate of 10%, a 5,000,000 deposit would divert 500,000
+	depositRecord := recordtypes.DepositRecord{
+		Id:         1,
+		HostZoneId: HostChainId,
+		Amount:     sdkmath.NewInt(5_000_000),
+		Status:     recordtypes.DepositRecord_TRANSFER_QUEUE,
+	}
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+
+	updatedRecord, err := s.App.StakeibcKeeper.FundInstantRedemptionBuffer(s.Ctx, hostZone, depositRecord)
+	s.Require().NoError(err, "no error expected when funding buffer")
+	s.Require().Equal(int64(4_500_000), updatedRecord.Amount.Int64(), "updated deposit record amount")
+
+	storedRecord, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, 1)
+	s.Require().True(found, "deposit record should exist")
+	s.Require().Equal(int64(4_500_000), storedRecord.Amount.Int64(), "stored deposit record amount")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(int64(9_500_000), hostZone.InstantRedemptionBufferBalance.Int64(), "buffer balance")
+	s.Require().Equal(int64(500_000), s.App.BankKeeper.GetBalance(s.Ctx, bufferAddress, "ibc/uatom").Amount.Int64(),
+		"buffer bank balance")
+
+	// Fund again from the remaining deposit, 10% of 4,500,000 is 450,000 which fits in the buffer
+	updatedRecord, err = s.App.StakeibcKeeper.FundInstantRedemptionBuffer(s.Ctx, hostZone, updatedRecord)
+	s.Require().NoError(err, "no error expected when funding buffer a second time")
+	s.Require().Equal(int64(4_050_000), updatedRecord.Amount.Int64(), "deposit record amount after second funding")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(int64(9_950_000), hostZone.InstantRedemptionBufferBalance.Int64(), "buffer balance after second funding")
+
+	// The next allocation should be capped so the buffer only fills up to the target
+	updatedRecord, err = s.App.StakeibcKeeper.FundInstantRedemptionBuffer(s.Ctx, hostZone, updatedRecord)
+	s.Require().NoError(err, "no error expected when filling buffer")
+	s.Require().Equal(int64(4_000_000), updatedRecord.Amount.Int64(), "deposit record amount after filling buffer")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(int64(10_000_000), hostZone.InstantRedemptionBufferBalance.Int64(), "buffer balance at target")
+
+	// Each allocation should have been tallied in the epoch's buffer record
+	bufferRecord, found := s.App.RecordsKeeper.GetInstantRedemptionBufferRecord(s.Ctx, HostChainId, 2)
+	s.Require().True(found, "buffer record should have been created")
+	s.Require().Equal(int64(1_000_000), bufferRecord.FundedAmount.Int64(), "buffer record funded amount")
+
+	// Once the buffer is at the target, nothing else should be diverted
+	updatedRecord, err = s.App.StakeibcKeeper.FundInstantRedemptionBuffer(s.Ctx, hostZone, updatedRecord)
+	s.Require().NoError(err, "no error expected when buffer is full")
+	s.Require().Equal(int64(4_000_000), updatedRecord.Amount.Int64(), "deposit record amount with full buffer")
+
+	// If instant redemptions are disabled, nothing should be diverted
+	hostZone.InstantRedemptionConfig = nil
+	hostZone.InstantRedemptionBufferBalance = sdkmath.ZeroInt()
+	updatedRecord, err = s.App.StakeibcKeeper.FundInstantRedemptionBuffer(s.Ctx, hostZone, updatedRecord)
+	s.Require().NoError(err, "no error expected when instant redemptions are disabled")
+	s.Require().Equal(int64(4_000_000), updatedRecord.Amount.Int64(), "deposit record amount when disabled")
+}
+
+func (s *KeeperTestSuite) TestGetTotalInstantRedemptionBuffer() {
+	bufferAddress := types.NewHostZoneModuleAddress(HostChainId, keeper.InstantRedemptionBufferAddressKey).String()
+	hostZone := types.HostZone{
+		ChainId:                        HostChainId,
+		InstantRedemptionBufferAddress: bufferAddress,
+		InstantRedemptionBufferBalance: sdkmath.NewInt(1_000),
+	}
+
+	// Create epoch unbonding records with buffer redemption records for two of the epochs
+	// and a regular user redemption on the third
+	for _, epochNumber := range []uint64{1, 2, 3} {
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{EpochNumber: epochNumber})
+	}
+	for _, epochNumber := range []uint64{1, 2} {
+		s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+			Id:                recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, bufferAddress),
+			NativeTokenAmount: sdkmath.NewInt(100),
+		})
+	}
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:                recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 3, "other-receiver"),
+		NativeTokenAmount: sdkmath.NewInt(100),
+	})
+
+	pendingRefill := s.App.StakeibcKeeper.GetInstantRedemptionBufferPendingRefill(s.Ctx, hostZone)
+	s.Require().Equal(int64(200), pendingRefill.Int64(), "pending refill")
+
+	totalBuffer := s.App.StakeibcKeeper.GetTotalInstantRedemptionBuffer(s.Ctx, hostZone)
+	s.Require().Equal(sdkmath.LegacyNewDec(1_200), totalBuffer, "total buffer")
+
+	// A host zone without a buffer should have no pending refills
+	hostZone.InstantRedemptionBufferAddress = ""
+	pendingRefill = s.App.StakeibcKeeper.GetInstantRedemptionBufferPendingRefill(s.Ctx, hostZone)
+	s.Require().Zero(pendingRefill.Int64(), "pending refill without buffer")
+}
+
+func (s *KeeperTestSuite) TestReconcileInstantRedemptionBufferBalance() {
+	bufferAddress := types.NewHostZoneModuleAddress(HostChainId, keeper.InstantRedemptionBufferAddressKey)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:                        HostChainId,
+		IbcDenom:                       "ibc/uatom",
+		InstantRedemptionBufferAddress: bufferAddress.String(),
+		InstantRedemptionBufferBalance: sdkmath.NewInt(1_000),
+	})
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{EpochIdentifier: epochtypes.DAY_EPOCH, EpochNumber: 3})
+	s.FundAccount(bufferAddress, sdk.NewInt64Coin("ibc/uatom", 1_000))
+
+	// With no refills in flight, the balance should not change
+	err := s.App.StakeibcKeeper.ReconcileInstantRedemptionBufferBalance(s.Ctx, s.MustGetHostZone(HostChainId))
+	s.Require().NoError(err, "no error expected when balance matches")
+	s.Require().Equal(int64(1_000), s.MustGetHostZone(HostChainId).InstantRedemptionBufferBalance.Int64(), "balance before refill")
+
+	// A refill that was acknowledged but has not yet landed should not be credited
+	// (its user redemption record has been removed, so it's excluded from the redemption rate)
+	totalBuffer := s.App.StakeibcKeeper.GetTotalInstantRedemptionBuffer(s.Ctx, s.MustGetHostZone(HostChainId))
+	s.Require().Equal(sdkmath.LegacyNewDec(1_000), totalBuffer, "total buffer before refill lands")
+
+	// Once the refill lands on stride, the buffer should be credited
+	s.FundAccount(bufferAddress, sdk.NewInt64Coin("ibc/uatom", 500))
+	err = s.App.StakeibcKeeper.ReconcileInstantRedemptionBufferBalance(s.Ctx, s.MustGetHostZone(HostChainId))
+	s.Require().NoError(err, "no error expected after refill")
+	s.Require().Equal(int64(1_500), s.MustGetHostZone(HostChainId).InstantRedemptionBufferBalance.Int64(), "balance after refill")
+
+	bufferRecord, found := s.App.RecordsKeeper.GetInstantRedemptionBufferRecord(s.Ctx, HostChainId, 3)
+	s.Require().True(found, "buffer record should have been created")
+	s.Require().Equal(int64(500), bufferRecord.RefilledAmount.Int64(), "buffer record refilled amount")
+
+	// Reconciling again should be a no-op
+	err = s.App.StakeibcKeeper.ReconcileInstantRedemptionBufferBalance(s.Ctx, s.MustGetHostZone(HostChainId))
+	s.Require().NoError(err, "no error expected on second reconciliation")
+	s.Require().Equal(int64(1_500), s.MustGetHostZone(HostChainId).InstantRedemptionBufferBalance.Int64(), "balance after second reconciliation")
+}
+
+func (s *KeeperTestSuite) TestClaimCallback_InstantRedemptionBufferRefillNotCredited() {
+	bufferAddress := types.NewHostZoneModuleAddress(HostChainId, keeper.InstantRedemptionBufferAddressKey).String()
+	epochNumber := uint64(1)
+	recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, bufferAddress)
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:                        HostChainId,
+		IbcDenom:                       "ibc/uatom",
+		InstantRedemptionBufferAddress: bufferAddress,
+		InstantRedemptionBufferBalance: sdkmath.NewInt(1_000),
+	})
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:                recordId,
+		Receiver:          bufferAddress,
+		HostZoneId:        HostChainId,
+		EpochNumber:       epochNumber,
+		NativeTokenAmount: sdkmath.NewInt(500),
+		ClaimIsPending:    true,
+	})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: epochNumber,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+			HostZoneId:            HostChainId,
+			Status:                recordtypes.HostZoneUnbonding_CLAIMABLE,
+			ClaimableNativeTokens: sdkmath.NewInt(500),
+			UserRedemptionRecords: []string{recordId},
+		}},
+	})
+
+	callbackArgs := types.ClaimCallback{
+		UserRedemptionRecordId: recordId,
+		ChainId:                HostChainId,
+		EpochNumber:            epochNumber,
+	}
+	callbackArgsBz, err := s.App.StakeibcKeeper.MarshalClaimCallbackArgs(s.Ctx, callbackArgs)
+	s.Require().NoError(err, "no error expected when marshalling callback args")
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	err = s.App.StakeibcKeeper.ClaimCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackArgsBz)
+	s.Require().NoError(err, "no error expected during claim callback")
+
+	// The record should be removed, but the buffer should not be credited until the tokens arrive
+	_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+	s.Require().False(found, "record should have been removed")
+	s.Require().Equal(int64(1_000), s.MustGetHostZone(HostChainId).InstantRedemptionBufferBalance.Int64(), "balance after ack")
+}
+
+func (s *KeeperTestSuite) TestSetInstantRedemptionConfig() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId: HostChainId,
+	})
+
+	// Enable instant redemptions, the buffer account should be created
+	config := s.getDefaultInstantRedemptionConfig()
+	msg := types.MsgSetInstantRedemptionConfig{
+		Authority: Authority,
+		ChainId:   HostChainId,
+		Config:    &config,
+	}
+	_, err := s.GetMsgServer().SetInstantRedemptionConfig(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when enabling instant redemptions")
+
+	expectedBufferAddress := types.NewHostZoneModuleAddress(HostChainId, keeper.InstantRedemptionBufferAddressKey)
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Equal(config, *hostZone.InstantRedemptionConfig, "instant redemption config")
+	s.Require().Equal(expectedBufferAddress.String(), hostZone.InstantRedemptionBufferAddress, "buffer address")
+	s.Require().Zero(hostZone.InstantRedemptionBufferBalance.Int64(), "buffer balance")
+	s.Require().NotNil(s.App.AccountKeeper.GetAccount(s.Ctx, expectedBufferAddress), "buffer account should exist")
+
+	// Disable instant redemptions, the buffer address should remain
+	msg.Config = nil
+	_, err = s.GetMsgServer().SetInstantRedemptionConfig(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when disabling instant redemptions")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Nil(hostZone.InstantRedemptionConfig, "config should be removed")
+	s.Require().Equal(expectedBufferAddress.String(), hostZone.InstantRedemptionBufferAddress, "buffer address after disabling")
+
+	// Invalid host zone
+	msg.ChainId = "fake-chain"
+	_, err = s.GetMsgServer().SetInstantRedemptionConfig(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "host zone fake-chain not found")
+
+	// Invalid authority
+	msg.ChainId = HostChainId
+	msg.Authority = "not-gov-address"
+	_, err = s.GetMsgServer().SetInstantRedemptionConfig(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
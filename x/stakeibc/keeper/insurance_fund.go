@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// The insurance fund protects stToken holders on a host zone from validator slashes
+// The lifecycle of the fund is as follows:
+//  1. Funding: Each time rewards are reinvested, a portion of the reinvested rewards is
+//     transferred from the withdrawal ICA to a stride-side module account (in the ibc denom),
+//     until the fund reaches its target size
+//  2. Coverage: When a slash is detected, the fund liquid stakes enough native tokens to make
+//     up for the slash, without minting any stTokens, so that the redemption rate is restored
+//     to its value from before the slash
+//
+// Unlike the instant redemption buffer, the fund is not included in the redemption rate,
+// since the tokens are only owed to stToken holders once a slash occurs
+
+// Stores an insurance coverage record
+func (k Keeper) SetInsuranceCoverageRecord(ctx sdk.Context, record types.InsuranceCoverageRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InsuranceCoverageHistoryKeyPrefix))
+	key := types.InsuranceCoverageHistoryKey(record.ChainId, record.Id)
+	store.Set(key, k.cdc.MustMarshal(&record))
+}
+
+// Returns the coverage history of a host zone, ordered from oldest to newest
+func (k Keeper) GetInsuranceCoverageHistory(ctx sdk.Context, chainId string) (records []types.InsuranceCoverageRecord) {
+	records = []types.InsuranceCoverageRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InsuranceCoverageHistoryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, types.InsuranceCoverageHistoryChainPrefix(chainId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.InsuranceCoverageRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// Returns the coverage history across all host zones
+func (k Keeper) GetAllInsuranceCoverageHistory(ctx sdk.Context) (records []types.InsuranceCoverageRecord) {
+	records = []types.InsuranceCoverageRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InsuranceCoverageHistoryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.InsuranceCoverageRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// Returns the ID for the next coverage record of a host zone, one greater than the latest record's ID
+func (k Keeper) getNextInsuranceCoverageRecordId(ctx sdk.Context, chainId string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InsuranceCoverageHistoryKeyPrefix))
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.InsuranceCoverageHistoryChainPrefix(chainId))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 1
+	}
+	key := iterator.Key()
+	return binary.BigEndian.Uint64(key[len(key)-8:]) + 1
+}
+
+// Creates the insurance fund module account for a host zone if it does not already exist
+func (k Keeper) CreateInsuranceFundAccount(ctx sdk.Context, hostZone *types.HostZone) error {
+	if hostZone.InsuranceFundAddress != "" {
+		return nil
+	}
+
+	fundAddress := types.NewHostZoneModuleAddress(hostZone.ChainId, InsuranceFundAddressKey)
+	if err := utils.CreateModuleAccount(ctx, k.AccountKeeper, fundAddress); err != nil {
+		return errorsmod.Wrapf(err, "unable to create insurance fund account for host zone %s", hostZone.ChainId)
+	}
+
+	hostZone.InsuranceFundAddress = fundAddress.String()
+	return nil
+}
+
+// Returns the native tokens (in the ibc denom) held in a host zone's insurance fund
+func (k Keeper) GetInsuranceFundBalance(ctx sdk.Context, hostZone types.HostZone) sdkmath.Int {
+	if hostZone.InsuranceFundAddress == "" {
+		return sdkmath.ZeroInt()
+	}
+	fundAddress, err := sdk.AccAddressFromBech32(hostZone.InsuranceFundAddress)
+	if err != nil {
+		return sdkmath.ZeroInt()
+	}
+	return k.bankKeeper.GetBalance(ctx, fundAddress, hostZone.IbcDenom).Amount
+}
+
+// Returns the portion of rewards that should be diverted into the insurance fund
+// The allocation is taken from the reinvested portion of rewards and is capped so the fund
+// does not exceed its target size
+// Since the balance is read from stride, transfers that are still in flight are not counted
+// towards the target, so the fund can overshoot the target by at most one epoch of allocations
+func (k Keeper) GetInsuranceFundAllocation(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	rewardsAmount sdkmath.Int,
+	reinvestAmount sdkmath.Int,
+) sdkmath.Int {
+	config := hostZone.InsuranceFundConfig
+	if config == nil || hostZone.InsuranceFundAddress == "" || config.RewardAllocationRate.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+
+	allocation := sdkmath.LegacyNewDecFromInt(rewardsAmount).Mul(config.RewardAllocationRate).TruncateInt()
+	allocation = sdkmath.MinInt(allocation, reinvestAmount)
+
+	if !config.TargetFundAmount.IsNil() && config.TargetFundAmount.IsPositive() {
+		remainingCapacity := config.TargetFundAmount.Sub(k.GetInsuranceFundBalance(ctx, hostZone))
+		allocation = sdkmath.MinInt(allocation, remainingCapacity)
+	}
+
+	return sdkmath.MaxInt(allocation, sdkmath.ZeroInt())
+}
+
+// Builds the ICA message to transfer the insurance fund's portion of rewards from the
+// withdrawal ICA back to the insurance fund on stride
+func (k Keeper) BuildInsuranceFundTransferMsg(ctx sdk.Context, hostZone types.HostZone, amount sdkmath.Int) (proto.Message, error) {
+	// The transfer is sent from the host zone back to stride, so the counterparty channel is used
+	transferChannel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, hostZone.TransferChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "transfer channel %s not found", hostZone.TransferChannelId)
+	}
+	counterpartyChannelId := transferChannel.Counterparty.ChannelId
+
+	epochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker not found for epoch %s", epochtypes.STRIDE_EPOCH)
+	}
+	timeout := epochTracker.NextEpochStartTime + k.GetParam(ctx, types.KeyICATimeoutNanos)
+
+	return transfertypes.NewMsgTransfer(
+		transfertypes.PortID,
+		counterpartyChannelId,
+		sdk.NewCoin(hostZone.HostDenom, amount),
+		hostZone.WithdrawalIcaAddress,
+		hostZone.InsuranceFundAddress,
+		clienttypes.Height{},
+		timeout,
+		"",
+	), nil
+}
+
+// Returns the amount the insurance fund will pay out to cover a slash
+// This is the amount required to restore the redemption rate, capped at the slash amount,
+// the max coverage per slash, and the fund balance
+func GetInsuranceCoverageAmount(
+	config types.InsuranceFundConfig,
+	fundBalance sdkmath.Int,
+	slashAmount sdkmath.Int,
+	redemptionRateBefore sdkmath.LegacyDec,
+	redemptionRateAfter sdkmath.LegacyDec,
+	stTokenSupply sdkmath.Int,
+) sdkmath.Int {
+	if !redemptionRateAfter.LT(redemptionRateBefore) {
+		return sdkmath.ZeroInt()
+	}
+
+	shortfall := redemptionRateBefore.Sub(redemptionRateAfter).MulInt(stTokenSupply).Ceil().TruncateInt()
+	coverage := sdkmath.MinInt(shortfall, slashAmount)
+	coverage = sdkmath.MinInt(coverage, fundBalance)
+	if !config.MaxCoveragePerSlash.IsNil() && config.MaxCoveragePerSlash.IsPositive() {
+		coverage = sdkmath.MinInt(coverage, config.MaxCoveragePerSlash)
+	}
+
+	return sdkmath.MaxInt(coverage, sdkmath.ZeroInt())
+}
+
+// Covers a slash on a host zone from the insurance fund, after the slash has been recorded and the
+// redemption rate has been updated
+// The coverage is liquid staked by sending it to the deposit account and adding it to the current
+// deposit record, without minting stTokens, which raises the redemption rate back towards its
+// value from before the slash
+// Returns the amount covered
+func (k Keeper) CoverSlashWithInsuranceFund(
+	ctx sdk.Context,
+	chainId string,
+	validatorAddress string,
+	slashAmount sdkmath.Int,
+	redemptionRateBefore sdkmath.LegacyDec,
+) (coveredAmount sdkmath.Int, err error) {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return sdkmath.ZeroInt(), types.ErrHostZoneNotFound.Wrapf("host zone %s not found", chainId)
+	}
+	config := hostZone.InsuranceFundConfig
+	if config == nil || hostZone.InsuranceFundAddress == "" || redemptionRateBefore.IsNil() {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	// Determine the amount needed to restore the redemption rate
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	stTokenSupply := k.bankKeeper.GetSupply(ctx, stDenom).Amount
+	fundBalance := k.GetInsuranceFundBalance(ctx, hostZone)
+
+	coveredAmount = GetInsuranceCoverageAmount(*config, fundBalance, slashAmount,
+		redemptionRateBefore, hostZone.RedemptionRate, stTokenSupply)
+	if !coveredAmount.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	// Liquid stake the coverage into the current epoch's deposit record
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	if !found {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(sdkerrors.ErrNotFound, "no epoch number for epoch (%s)", epochtypes.STRIDE_EPOCH)
+	}
+	depositRecord, found := k.RecordsKeeper.GetTransferDepositRecordByEpochAndChain(ctx, strideEpochTracker.EpochNumber, chainId)
+	if !found {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(sdkerrors.ErrNotFound, "no deposit record for epoch (%d)", strideEpochTracker.EpochNumber)
+	}
+
+	fundAddress, err := sdk.AccAddressFromBech32(hostZone.InsuranceFundAddress)
+	if err != nil {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(err, "invalid insurance fund address for %s", chainId)
+	}
+	depositAddress, err := sdk.AccAddressFromBech32(hostZone.DepositAddress)
+	if err != nil {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(err, "invalid deposit address for %s", chainId)
+	}
+	coverageCoin := sdk.NewCoin(hostZone.IbcDenom, coveredAmount)
+	if err := utils.SafeSendCoins(false, k.bankKeeper, ctx, fundAddress, depositAddress, sdk.NewCoins(coverageCoin)); err != nil {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(err, "unable to send %v from insurance fund", coverageCoin)
+	}
+
+	depositRecord.Amount = depositRecord.Amount.Add(coveredAmount)
+	k.RecordsKeeper.SetDepositRecord(ctx, *depositRecord)
+
+	// Update the redemption rate to reflect the coverage
+	k.UpdateRedemptionRateForHostZone(ctx, hostZone, k.RecordsKeeper.GetAllDepositRecord(ctx))
+	hostZone, _ = k.GetHostZone(ctx, chainId)
+
+	record := types.InsuranceCoverageRecord{
+		ChainId:              chainId,
+		Id:                   k.getNextInsuranceCoverageRecordId(ctx, chainId),
+		EpochNumber:          k.getCurrentStrideEpochNumber(ctx),
+		Time:                 ctx.BlockTime(),
+		Validator:            validatorAddress,
+		SlashAmount:          slashAmount,
+		CoveredAmount:        coveredAmount,
+		RedemptionRateBefore: redemptionRateBefore,
+		RedemptionRateAfter:  hostZone.RedemptionRate,
+	}
+	k.SetInsuranceCoverageRecord(ctx, record)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId,
+		"Insurance fund covered %v of the %v slash on %s, redemption rate restored to %v",
+		coverageCoin, slashAmount, validatorAddress, hostZone.RedemptionRate))
+	EmitInsuranceFundSlashCoveredEvent(ctx, hostZone, record, fundBalance.Sub(coveredAmount))
+
+	return coveredAmount, nil
+}
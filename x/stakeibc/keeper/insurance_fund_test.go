@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Stores a host zone with a single validator, 1M delegated, 1M stTokens, and an insurance fund
+func (s *KeeperTestSuite) SetupInsuranceFund(fundBalance int64, config types.InsuranceFundConfig) types.HostZone {
+	depositAddress := types.NewHostZoneDepositAddress(HostChainId)
+	fundAddress := types.NewHostZoneModuleAddress(HostChainId, keeper.InsuranceFundAddressKey)
+	s.FundAccount(fundAddress, sdk.NewInt64Coin(IbcAtom, fundBalance))
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StAtom, 1_000_000))
+
+	hostZone := types.HostZone{
+		ChainId:          HostChainId,
+		HostDenom:        Atom,
+		IbcDenom:         IbcAtom,
+		DepositAddress:   depositAddress.String(),
+		RedemptionRate:   sdkmath.LegacyOneDec(),
+		TotalDelegations: sdkmath.NewInt(1_000_000),
+		Validators: []*types.Validator{
+			{Address: "val1", Weight: 100, Delegation: sdkmath.NewInt(1_000_000)},
+		},
+		InsuranceFundConfig:  &config,
+		InsuranceFundAddress: fundAddress.String(),
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     1,
+	})
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Id:                 1,
+		HostZoneId:         HostChainId,
+		Amount:             sdkmath.ZeroInt(),
+		DepositEpochNumber: 1,
+		Status:             recordtypes.DepositRecord_TRANSFER_QUEUE,
+	})
+
+	return hostZone
+}
+
+func (s *KeeperTestSuite) TestGetInsuranceCoverageAmount() {
+	config := types.InsuranceFundConfig{MaxCoveragePerSlash: sdkmath.ZeroInt()}
+	rateBefore := sdkmath.LegacyOneDec()
+	rateAfter := sdkmath.LegacyMustNewDecFromStr("0.9")
+	stSupply := sdkmath.NewInt(1_000_000)
+
+	// The full shortfall is covered if the fund has enough
+	coverage := keeper.GetInsuranceCoverageAmount(config, sdkmath.NewInt(500_000), sdkmath.NewInt(100_000), rateBefore, rateAfter, stSupply)
+	s.Require().Equal(int64(100_000), coverage.Int64(), "full coverage")
+
+	// Coverage is capped at the fund balance
+	coverage = keeper.GetInsuranceCoverageAmount(config, sdkmath.NewInt(40_000), sdkmath.NewInt(100_000), rateBefore, rateAfter, stSupply)
+	s.Require().Equal(int64(40_000), coverage.Int64(), "coverage capped by balance")
+
+	// Coverage is capped at the max per slash
+	config.MaxCoveragePerSlash = sdkmath.NewInt(25_000)
+	coverage = keeper.GetInsuranceCoverageAmount(config, sdkmath.NewInt(500_000), sdkmath.NewInt(100_000), rateBefore, rateAfter, stSupply)
+	s.Require().Equal(int64(25_000), coverage.Int64(), "coverage capped by max per slash")
+
+	// Coverage is capped at the slash amount, even if the redemption rate dropped further
+	config.MaxCoveragePerSlash = sdkmath.ZeroInt()
+	coverage = keeper.GetInsuranceCoverageAmount(config, sdkmath.NewInt(500_000), sdkmath.NewInt(60_000), rateBefore, rateAfter, stSupply)
+	s.Require().Equal(int64(60_000), coverage.Int64(), "coverage capped by slash amount")
+
+	// Nothing is covered if the redemption rate did not decrease
+	coverage = keeper.GetInsuranceCoverageAmount(config, sdkmath.NewInt(500_000), sdkmath.NewInt(100_000), rateBefore, rateBefore, stSupply)
+	s.Require().Zero(coverage.Int64(), "no coverage without a redemption rate decrease")
+}
+
+func (s *KeeperTestSuite) TestSlashValidatorOnHostZone_InsuranceFundCoverage() {
+	hostZone := s.SetupInsuranceFund(500_000, types.InsuranceFundConfig{
+		RewardAllocationRate: sdkmath.LegacyMustNewDecFromStr("0.02"),
+		TargetFundAmount:     sdkmath.ZeroInt(),
+		MaxCoveragePerSlash:  sdkmath.ZeroInt(),
+	})
+
+	// Slash 10% of the delegation, which should be fully covered by the fund
+	err := s.App.StakeibcKeeper.SlashValidatorOnHostZone(s.Ctx, hostZone, 0, sdkmath.NewInt(900_000))
+	s.Require().NoError(err, "no error expected when slashing validator")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(int64(900_000), hostZone.TotalDelegations.Int64(), "total delegations after slash")
+	s.Require().Equal(sdkmath.LegacyOneDec().String(), hostZone.RedemptionRate.String(), "redemption rate should be restored")
+
+	depositRecord := s.MustGetDepositRecord(1)
+	s.Require().Equal(int64(100_000), depositRecord.Amount.Int64(), "coverage should be added to the deposit record")
+
+	fundBalance := s.App.StakeibcKeeper.GetInsuranceFundBalance(s.Ctx, hostZone)
+	s.Require().Equal(int64(400_000), fundBalance.Int64(), "fund balance after coverage")
+
+	history := s.App.StakeibcKeeper.GetInsuranceCoverageHistory(s.Ctx, HostChainId)
+	s.Require().Len(history, 1, "one coverage record")
+	s.Require().Equal(uint64(1), history[0].Id, "coverage record id")
+	s.Require().Equal("val1", history[0].Validator, "coverage record validator")
+	s.Require().Equal(int64(100_000), history[0].SlashAmount.Int64(), "coverage record slash amount")
+	s.Require().Equal(int64(100_000), history[0].CoveredAmount.Int64(), "coverage record covered amount")
+	s.Require().Equal(sdkmath.LegacyOneDec().String(), history[0].RedemptionRateAfter.String(), "coverage record redemption rate after")
+}
+
+func (s *KeeperTestSuite) TestSlashValidatorOnHostZone_InsuranceFundPartialCoverage() {
+	hostZone := s.SetupInsuranceFund(500_000, types.InsuranceFundConfig{
+		RewardAllocationRate: sdkmath.LegacyMustNewDecFromStr("0.02"),
+		TargetFundAmount:     sdkmath.ZeroInt(),
+		MaxCoveragePerSlash:  sdkmath.NewInt(60_000),
+	})
+
+	// Only 60k of the 100k slash is covered, so the redemption rate is only partially restored
+	err := s.App.StakeibcKeeper.SlashValidatorOnHostZone(s.Ctx, hostZone, 0, sdkmath.NewInt(900_000))
+	s.Require().NoError(err, "no error expected when slashing validator")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal("0.960000000000000000", hostZone.RedemptionRate.String(), "redemption rate after partial coverage")
+
+	history := s.App.StakeibcKeeper.GetInsuranceCoverageHistory(s.Ctx, HostChainId)
+	s.Require().Len(history, 1, "one coverage record")
+	s.Require().Equal(int64(60_000), history[0].CoveredAmount.Int64(), "coverage record covered amount")
+}
+
+func (s *KeeperTestSuite) TestSlashValidatorOnHostZone_InsuranceFundDisabled() {
+	hostZone := s.SetupInsuranceFund(500_000, types.InsuranceFundConfig{})
+	hostZone.InsuranceFundConfig = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.SlashValidatorOnHostZone(s.Ctx, hostZone, 0, sdkmath.NewInt(900_000))
+	s.Require().NoError(err, "no error expected when slashing validator")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal("0.900000000000000000", hostZone.RedemptionRate.String(), "redemption rate should not be restored")
+	s.Require().Empty(s.App.StakeibcKeeper.GetInsuranceCoverageHistory(s.Ctx, HostChainId), "no coverage records")
+}
+
+func (s *KeeperTestSuite) TestCalculateRewardsSplit_InsuranceFund() {
+	// The fund holds 15k of its 20k target, so only 5k of the 20k allocation can be diverted
+	hostZone := s.SetupInsuranceFund(15_000, types.InsuranceFundConfig{
+		RewardAllocationRate: sdkmath.LegacyMustNewDecFromStr("0.02"),
+		TargetFundAmount:     sdkmath.NewInt(20_000),
+		MaxCoveragePerSlash:  sdkmath.ZeroInt(),
+	})
+
+	params := types.DefaultParams()
+	params.StrideCommission = 10
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	rewardsSplit, err := s.App.StakeibcKeeper.CalculateRewardsSplit(s.Ctx, hostZone, sdkmath.NewInt(1_000_000))
+	s.Require().NoError(err, "no error expected when calculating rewards split")
+	s.Require().Equal(int64(100_000), rewardsSplit.StrideFeeAmount.Int64(), "stride fee amount")
+	s.Require().Equal(int64(5_000), rewardsSplit.InsuranceAmount.Int64(), "insurance amount")
+	s.Require().Equal(int64(895_000), rewardsSplit.ReinvestAmount.Int64(), "reinvest amount")
+
+	// Once the fund is at its target, nothing is diverted
+	fundAddress := sdk.MustAccAddressFromBech32(hostZone.InsuranceFundAddress)
+	s.FundAccount(fundAddress, sdk.NewInt64Coin(IbcAtom, 5_000))
+
+	rewardsSplit, err = s.App.StakeibcKeeper.CalculateRewardsSplit(s.Ctx, hostZone, sdkmath.NewInt(1_000_000))
+	s.Require().NoError(err, "no error expected when fund is full")
+	s.Require().Zero(rewardsSplit.InsuranceAmount.Int64(), "insurance amount when fund is full")
+	s.Require().Equal(int64(900_000), rewardsSplit.ReinvestAmount.Int64(), "reinvest amount when fund is full")
+}
+
+func (s *KeeperTestSuite) TestSetInsuranceFundConfig() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: HostChainId})
+
+	config := types.InsuranceFundConfig{
+		RewardAllocationRate: sdkmath.LegacyMustNewDecFromStr("0.02"),
+		TargetFundAmount:     sdkmath.NewInt(1_000_000),
+		MaxCoveragePerSlash:  sdkmath.NewInt(100_000),
+	}
+	msg := types.MsgSetInsuranceFundConfig{
+		Authority: Authority,
+		ChainId:   HostChainId,
+		Config:    &config,
+	}
+	_, err := s.GetMsgServer().SetInsuranceFundConfig(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when enabling insurance fund")
+
+	expectedAddress := types.NewHostZoneModuleAddress(HostChainId, keeper.InsuranceFundAddressKey)
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Equal(config, *hostZone.InsuranceFundConfig, "insurance fund config")
+	s.Require().Equal(expectedAddress.String(), hostZone.InsuranceFundAddress, "insurance fund address")
+	s.Require().NotNil(s.App.AccountKeeper.GetAccount(s.Ctx, expectedAddress), "insurance fund account should be created")
+
+	// Disabling the fund should keep the account
+	msg.Config = nil
+	_, err = s.GetMsgServer().SetInsuranceFundConfig(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when disabling insurance fund")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Nil(hostZone.InsuranceFundConfig, "insurance fund config should be removed")
+	s.Require().Equal(expectedAddress.String(), hostZone.InsuranceFundAddress, "insurance fund address should remain")
+
+	// Invalid authority
+	msg.Authority = "not-gov-address"
+	_, err = s.GetMsgServer().SetInsuranceFundConfig(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
@@ -26,6 +26,36 @@ const (
 	ClaimRewardsICABatchSize = 10
 )
 
+// The ICA accounts registered on each host zone
+var HostZoneIcaAccountTypes = []types.ICAAccountType{
+	types.ICAAccountType_DELEGATION,
+	types.ICAAccountType_FEE,
+	types.ICAAccountType_WITHDRAWAL,
+	types.ICAAccountType_REDEMPTION,
+	types.ICAAccountType_COMMUNITY_POOL_DEPOSIT,
+	types.ICAAccountType_COMMUNITY_POOL_RETURN,
+}
+
+// Returns the address of one of a host zone's ICAs
+func GetHostZoneIcaAddress(hostZone types.HostZone, icaType types.ICAAccountType) string {
+	switch icaType {
+	case types.ICAAccountType_DELEGATION:
+		return hostZone.DelegationIcaAddress
+	case types.ICAAccountType_FEE:
+		return hostZone.FeeIcaAddress
+	case types.ICAAccountType_WITHDRAWAL:
+		return hostZone.WithdrawalIcaAddress
+	case types.ICAAccountType_REDEMPTION:
+		return hostZone.RedemptionIcaAddress
+	case types.ICAAccountType_COMMUNITY_POOL_DEPOSIT:
+		return hostZone.CommunityPoolDepositIcaAddress
+	case types.ICAAccountType_COMMUNITY_POOL_RETURN:
+		return hostZone.CommunityPoolReturnIcaAddress
+	default:
+		return ""
+	}
+}
+
 func (k Keeper) SetWithdrawalAddressOnHost(ctx sdk.Context, hostZone types.HostZone) error {
 	// Fetch the relevant ICA
 	if hostZone.DelegationIcaAddress == "" {
@@ -42,8 +42,11 @@ type (
 		StakingKeeper         stakingkeeper.Keeper
 		ICACallbacksKeeper    icacallbackskeeper.Keeper
 		hooks                 types.StakeIBCHooks
+		lsmCallbacks          map[string]types.LSMLiquidStakeCallbacks
 		RatelimitKeeper       types.RatelimitKeeper
 		ICAOracleKeeper       types.ICAOracleKeeper
+		AuctionKeeper         types.AuctionKeeper
+		nftKeeper             types.NFTKeeper
 	}
 )
 
@@ -63,6 +66,7 @@ func NewKeeper(
 	ICACallbacksKeeper icacallbackskeeper.Keeper,
 	RatelimitKeeper types.RatelimitKeeper,
 	icaOracleKeeper types.ICAOracleKeeper,
+	auctionKeeper types.AuctionKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -85,6 +89,8 @@ func NewKeeper(
 		ICACallbacksKeeper:    ICACallbacksKeeper,
 		RatelimitKeeper:       RatelimitKeeper,
 		ICAOracleKeeper:       icaOracleKeeper,
+		AuctionKeeper:         auctionKeeper,
+		lsmCallbacks:          make(map[string]types.LSMLiquidStakeCallbacks),
 	}
 }
 
@@ -103,6 +109,27 @@ func (k *Keeper) SetHooks(gh types.StakeIBCHooks) *Keeper {
 	return k
 }
 
+// SetNFTKeeper sets the keeper used to represent user redemption records as NFTs
+// Until it is set, redemption records cannot be tokenized
+func (k *Keeper) SetNFTKeeper(nftKeeper types.NFTKeeper) *Keeper {
+	if k.nftKeeper != nil {
+		panic("cannot set nft keeper twice")
+	}
+
+	k.nftKeeper = nftKeeper
+
+	return k
+}
+
+// SetLSMLiquidStakeCallbacks registers a module's callbacks for asynchronous LSM liquid stakes
+func (k Keeper) SetLSMLiquidStakeCallbacks(module string, callbacks types.LSMLiquidStakeCallbacks) error {
+	if _, found := k.lsmCallbacks[module]; found {
+		return fmt.Errorf("lsm liquid stake callbacks already set for %s", module)
+	}
+	k.lsmCallbacks[module] = callbacks
+	return nil
+}
+
 // GetAuthority returns the x/stakeibc module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
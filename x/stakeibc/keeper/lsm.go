@@ -267,6 +267,12 @@ func (k Keeper) StartLSMLiquidStake(ctx sdk.Context, msg types.MsgLSMLiquidStake
 	if hostZone.Halted {
 		return types.LSMLiquidStake{}, errorsmod.Wrapf(types.ErrHaltedHostZone, "host zone %s is halted", hostZone.ChainId)
 	}
+	if GetRedemptionRateGuardStatus(*hostZone) == types.GUARD_DEGRADED {
+		return types.LSMLiquidStake{}, errorsmod.Wrapf(types.ErrHostZoneDegraded, "liquid stakes are paused for degraded host zone %s", hostZone.ChainId)
+	}
+	if err := k.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, types.PAUSE_LSM_LIQUID_STAKE); err != nil {
+		return types.LSMLiquidStake{}, err
+	}
 
 	// Check if we already have tokens with this denom in records
 	_, found := k.RecordsKeeper.GetLSMTokenDeposit(ctx, hostZone.ChainId, lsmLiquidStake.Deposit.Denom)
@@ -292,6 +298,36 @@ func (k Keeper) StartLSMLiquidStake(ctx sdk.Context, msg types.MsgLSMLiquidStake
 	return lsmLiquidStake, nil
 }
 
+// LSMLiquidStake exchanges a user's LSM tokenized shares for stTokens
+// If the validator is due for a slash query, the query is submitted and the liquid stake is
+// finished asynchronously in the query callback (in which case transactionComplete is false)
+func (k Keeper) LSMLiquidStake(
+	ctx sdk.Context,
+	msg *types.MsgLSMLiquidStake,
+) (lsmLiquidStake types.LSMLiquidStake, transactionComplete bool, err error) {
+	lsmLiquidStake, err = k.StartLSMLiquidStake(ctx, *msg)
+	if err != nil {
+		return lsmLiquidStake, false, err
+	}
+
+	if k.ShouldCheckIfValidatorWasSlashed(ctx, *lsmLiquidStake.Validator, msg.Amount) {
+		if err := k.SubmitValidatorSlashQuery(ctx, lsmLiquidStake); err != nil {
+			return lsmLiquidStake, false, err
+		}
+
+		EmitPendingLSMLiquidStakeEvent(ctx, *lsmLiquidStake.HostZone, *lsmLiquidStake.Deposit)
+
+		return lsmLiquidStake, false, nil
+	}
+
+	async := false
+	if err := k.FinishLSMLiquidStake(ctx, lsmLiquidStake, async); err != nil {
+		return lsmLiquidStake, false, err
+	}
+
+	return lsmLiquidStake, true, nil
+}
+
 // SubmitValidatorSlashQuery submits an interchain query for the validator's sharesToTokens rate
 // This is done periodically at checkpoints denominated in native tokens
 // (e.g. every 100k ATOM that's LSM liquid staked with validator X)
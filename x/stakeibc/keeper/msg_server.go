@@ -6,10 +6,7 @@ import (
 	"time"
 
 	proto "github.com/cosmos/gogoproto/proto"
-	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
-	connectiontypes "github.com/cosmos/ibc-go/v11/modules/core/03-connection/types"
-	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
 	"github.com/spf13/cast"
 
 	errorsmod "cosmossdk.io/errors"
@@ -22,8 +19,6 @@ import (
 
 	"github.com/Stride-Labs/stride/v33/utils"
 	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
-	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
-	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
 	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 )
 
@@ -44,6 +39,38 @@ func (k msgServer) RegisterHostZone(goCtx context.Context, msg *types.MsgRegiste
 	return k.Keeper.RegisterHostZone(ctx, msg)
 }
 
+// Gov tx to update host zone params, including the host zone's fee schedule
+// The fee schedule is only updated if one is provided, so that a proposal for a different param
+// does not wipe it. To fall back to the global stride commission (with no liquid stake or
+// redemption fees), the fee schedule must be explicitly removed with remove_fee_schedule
+// Similarly, the max ICA tx gas and min rebalance amount are only cleared with
+// remove_max_ica_tx_gas and remove_min_rebalance_amount
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Update the fee schedule on host chain X",
+//		   "metadata": "Update the fee schedule on host chain X",
+//		   "summary": "Update the fee schedule on host chain X",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgUpdateHostZoneParams",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "chain_id": "cosmoshub-4",
+//		         "max_messages_per_ica_tx": "32",
+//		         "max_ica_tx_gas": "4000000",
+//		         "min_rebalance_amount": "1000000000",
+//		         "fee_schedule": {
+//		            "reward_commission_rate": "0.08",
+//		            "liquid_stake_fee_rate": "0.001",
+//		            "redemption_fee_rate": "0.001"
+//		         }
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
 func (ms msgServer) UpdateHostZoneParams(goCtx context.Context, msg *types.MsgUpdateHostZoneParams) (*types.MsgUpdateHostZoneParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if ms.authority != msg.Authority {
@@ -60,6 +87,21 @@ func (ms msgServer) UpdateHostZoneParams(goCtx context.Context, msg *types.MsgUp
 		maxMessagesPerTx = DefaultMaxMessagesPerIcaTx
 	}
 	hostZone.MaxMessagesPerIcaTx = maxMessagesPerTx
+	if msg.MaxIcaTxGas != 0 {
+		hostZone.MaxIcaTxGas = msg.MaxIcaTxGas
+	} else if msg.RemoveMaxIcaTxGas {
+		hostZone.MaxIcaTxGas = 0
+	}
+	if msg.FeeSchedule != nil {
+		hostZone.FeeSchedule = msg.FeeSchedule
+	} else if msg.RemoveFeeSchedule {
+		hostZone.FeeSchedule = nil
+	}
+	if !msg.MinRebalanceAmount.IsNil() && msg.MinRebalanceAmount.IsPositive() {
+		hostZone.MinRebalanceAmount = msg.MinRebalanceAmount
+	} else if msg.RemoveMinRebalanceAmount {
+		hostZone.MinRebalanceAmount = sdkmath.ZeroInt()
+	}
 	ms.Keeper.SetHostZone(ctx, hostZone)
 
 	return &types.MsgUpdateHostZoneParamsResponse{}, nil
@@ -86,6 +128,483 @@ func (ms msgServer) DeprecateHostZone(goCtx context.Context, msg *types.MsgDepre
 	return &types.MsgDeprecateHostZoneResponse{}, nil
 }
 
+// Gov tx to enable, update, or disable automatic validator weighting on a host zone
+// If the config is nil, automatic weighting is disabled and the current weights are left in place
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Enable automatic validator weighting on host chain X",
+//		   "metadata": "Enable automatic validator weighting on host chain X",
+//		   "summary": "Enable automatic validator weighting on host chain X",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgSetAutoValidatorWeighting",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "chain_id": "cosmoshub-4",
+//		         "config": {
+//		            "base_weight": "100",
+//		            "max_commission_rate": "0.10",
+//		            "commission_penalty": "1.0",
+//		            "slash_penalty": "0.25",
+//		            "max_shares_to_tokens_rate_drift": "0.05"
+//		         }
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
+func (ms msgServer) SetAutoValidatorWeighting(goCtx context.Context, msg *types.MsgSetAutoValidatorWeighting) (*types.MsgSetAutoValidatorWeightingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	hostZone, found := ms.Keeper.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, types.ErrHostZoneNotFound.Wrapf("host zone %s not found", msg.ChainId)
+	}
+
+	hostZone.AutoValidatorWeighting = msg.Config
+	ms.Keeper.SetHostZone(ctx, hostZone)
+
+	return &types.MsgSetAutoValidatorWeightingResponse{}, nil
+}
+
+// Gov tx to enable, update, or disable instant redemptions on a host zone
+// If the host zone does not yet have a buffer account, one is created
+// If the config is nil, instant redemptions are disabled, but the existing buffer is still refilled
+// and included in the redemption rate
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Enable instant redemptions on host chain X",
+//		   "metadata": "Enable instant redemptions on host chain X",
+//		   "summary": "Enable instant redemptions on host chain X",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgSetInstantRedemptionConfig",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "chain_id": "cosmoshub-4",
+//		         "config": {
+//		            "deposit_allocation_rate": "0.10",
+//		            "target_buffer_amount": "1000000000000",
+//		            "min_fee_rate": "0.001",
+//		            "max_fee_rate": "0.02"
+//		         }
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
+func (ms msgServer) SetInstantRedemptionConfig(goCtx context.Context, msg *types.MsgSetInstantRedemptionConfig) (*types.MsgSetInstantRedemptionConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	hostZone, found := ms.Keeper.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, types.ErrHostZoneNotFound.Wrapf("host zone %s not found", msg.ChainId)
+	}
+
+	// Host zones registered before instant redemptions were introduced will not yet have a buffer account
+	if err := ms.Keeper.CreateInstantRedemptionBufferAccount(ctx, &hostZone); err != nil {
+		return nil, err
+	}
+
+	hostZone.InstantRedemptionConfig = msg.Config
+	ms.Keeper.SetHostZone(ctx, hostZone)
+
+	return &types.MsgSetInstantRedemptionConfigResponse{}, nil
+}
+
+// Gov tx to enable, update, or disable automatic validator blacklisting on a host zone
+// If the policy is nil, validators are no longer blacklisted automatically, but any
+// existing blacklisted validators remain on the blacklist
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Blacklist slashed and jailed validators on host chain X",
+//		   "metadata": "Blacklist slashed and jailed validators on host chain X",
+//		   "summary": "Blacklist slashed and jailed validators on host chain X",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgSetValidatorBlacklistPolicy",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "chain_id": "cosmoshub-4",
+//		         "policy": {
+//		            "slash_threshold": "0.01",
+//		            "blacklist_jailed": true
+//		         }
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
+func (ms msgServer) SetValidatorBlacklistPolicy(goCtx context.Context, msg *types.MsgSetValidatorBlacklistPolicy) (*types.MsgSetValidatorBlacklistPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	hostZone, found := ms.Keeper.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, types.ErrHostZoneNotFound.Wrapf("host zone %s not found", msg.ChainId)
+	}
+
+	hostZone.ValidatorBlacklistPolicy = msg.Policy
+	ms.Keeper.SetHostZone(ctx, hostZone)
+
+	return &types.MsgSetValidatorBlacklistPolicyResponse{}, nil
+}
+
+// Gov tx to enable, update, or disable the redemption rate guard on a host zone
+// If the config is nil, the guard is disabled and the default halting behavior applies
+// A degraded host zone is returned to healthy when the guard is disabled, while a halted
+// host zone remains halted until it's resumed
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Enable the redemption rate guard on host chain X",
+//		   "metadata": "Enable the redemption rate guard on host chain X",
+//		   "summary": "Enable the redemption rate guard on host chain X",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgSetRedemptionRateGuardConfig",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "chain_id": "cosmoshub-4",
+//		         "config": {
+//		            "resume_cooldown_epochs": "4",
+//		            "auto_resume_epochs": "8"
+//		         }
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
+func (ms msgServer) SetRedemptionRateGuardConfig(goCtx context.Context, msg *types.MsgSetRedemptionRateGuardConfig) (*types.MsgSetRedemptionRateGuardConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	hostZone, found := ms.Keeper.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, types.ErrHostZoneNotFound.Wrapf("host zone %s not found", msg.ChainId)
+	}
+
+	hostZone.RedemptionRateGuardConfig = msg.Config
+	ms.Keeper.SetHostZone(ctx, hostZone)
+
+	if msg.Config == nil && GetRedemptionRateGuardStatus(hostZone) == types.GUARD_DEGRADED {
+		ms.Keeper.ResetRedemptionRateGuard(ctx, hostZone, "redemption rate guard disabled")
+	}
+
+	return &types.MsgSetRedemptionRateGuardConfigResponse{}, nil
+}
+
+// Gov tx to set or remove the circuit breaker guardian
+// Removing the guardian does not reset any actions it has already paused
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Set the circuit breaker guardian",
+//		   "metadata": "Set the circuit breaker guardian",
+//		   "summary": "Set the circuit breaker guardian",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgSetCircuitBreakerGuardian",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "guardian": "stride1...",
+//		         "expiration": "2027-01-01T00:00:00Z"
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
+func (ms msgServer) SetCircuitBreakerGuardian(goCtx context.Context, msg *types.MsgSetCircuitBreakerGuardian) (*types.MsgSetCircuitBreakerGuardianResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if msg.Guardian == "" {
+		ms.Keeper.RemoveCircuitBreakerGuardian(ctx)
+		return &types.MsgSetCircuitBreakerGuardianResponse{}, nil
+	}
+
+	ms.Keeper.SetCircuitBreakerGuardian(ctx, types.CircuitBreakerGuardian{
+		Address:    msg.Guardian,
+		Expiration: msg.Expiration,
+	})
+
+	return &types.MsgSetCircuitBreakerGuardianResponse{}, nil
+}
+
+// Guardian or gov tx to pause actions on a host zone in one of the liquid staking modules
+func (ms msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.Keeper.TripCircuitBreaker(ctx, msg.Signer, msg.Module, msg.ChainId, msg.Actions); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// Guardian or gov tx to unpause actions on a host zone in one of the liquid staking modules
+func (ms msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.Keeper.ResetCircuitBreaker(ctx, msg.Signer, msg.Module, msg.ChainId, msg.Actions); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// Gov tx to enable, update, or disable the slashing insurance fund on a host zone
+// If the config is nil, rewards are no longer diverted to the fund and slashes are no
+// longer covered, but the fund's existing balance remains in the fund account
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Enable slashing insurance on host chain X",
+//		   "metadata": "Enable slashing insurance on host chain X",
+//		   "summary": "Enable slashing insurance on host chain X",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgSetInsuranceFundConfig",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "chain_id": "cosmoshub-4",
+//		         "config": {
+//		            "reward_allocation_rate": "0.02",
+//		            "target_fund_amount": "500000000000",
+//		            "max_coverage_per_slash": "100000000000"
+//		         }
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
+func (ms msgServer) SetInsuranceFundConfig(goCtx context.Context, msg *types.MsgSetInsuranceFundConfig) (*types.MsgSetInsuranceFundConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	hostZone, found := ms.Keeper.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, types.ErrHostZoneNotFound.Wrapf("host zone %s not found", msg.ChainId)
+	}
+
+	// The fund account is created the first time the fund is enabled
+	if msg.Config != nil {
+		if err := ms.Keeper.CreateInsuranceFundAccount(ctx, &hostZone); err != nil {
+			return nil, err
+		}
+	}
+
+	hostZone.InsuranceFundConfig = msg.Config
+	ms.Keeper.SetHostZone(ctx, hostZone)
+
+	return &types.MsgSetInsuranceFundConfigResponse{}, nil
+}
+
+// Gov tx to set the minimum balance discrepancy (in native tokens) that emits an event during
+// a host zone's balance reconciliation
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Set reconciliation threshold on host chain X",
+//		   "metadata": "Set reconciliation threshold on host chain X",
+//		   "summary": "Set reconciliation threshold on host chain X",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgSetReconciliationThreshold",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "chain_id": "cosmoshub-4",
+//		         "threshold": "1000000"
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
+func (ms msgServer) SetReconciliationThreshold(goCtx context.Context, msg *types.MsgSetReconciliationThreshold) (*types.MsgSetReconciliationThresholdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	hostZone, found := ms.Keeper.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, types.ErrHostZoneNotFound.Wrapf("host zone %s not found", msg.ChainId)
+	}
+
+	hostZone.ReconciliationDiscrepancyThreshold = msg.Threshold
+	ms.Keeper.SetHostZone(ctx, hostZone)
+
+	return &types.MsgSetReconciliationThresholdResponse{}, nil
+}
+
+// Gov tx to register a new host zone and apply its initial configuration in one proposal
+// The validators, community pool rebate, oracle and rate limit whitelist are applied immediately,
+// while the trade route is created once the host zone's withdrawal ICA is registered
+// Use the onboarding-checklist query to follow the steps that complete after registration
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Onboard host chain X",
+//		   "metadata": "Onboard host chain X",
+//		   "summary": "Onboard host chain X",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgOnboardHostZone",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "host_zone": {
+//		            "connection_id": "connection-0",
+//		            "bech32prefix": "cosmos",
+//		            "host_denom": "uatom",
+//		            "ibc_denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+//		            "transfer_channel_id": "channel-0",
+//		            "unbonding_period": "21"
+//		         },
+//		         "validators": [
+//		            {"name": "validator-1", "address": "cosmosvaloper1xxx", "weight": "10"}
+//		         ],
+//		         "oracle_connection_id": "connection-1",
+//		         "rate_limit_whitelist": [
+//		            {"sender": "cosmos1xxx", "receiver": "stride1xxx"}
+//		         ]
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// A "trade_route" (with the same fields as MsgCreateTradeRoute) and a "community_pool_rebate"
+// can also be included
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
+func (ms msgServer) OnboardHostZone(goCtx context.Context, msg *types.MsgOnboardHostZone) (*types.MsgOnboardHostZoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.Keeper.OnboardHostZone(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOnboardHostZoneResponse{}, nil
+}
+
+// Gov tx to cap the native tokens unbonded from a host zone in a single unbonding epoch
+// Redemptions beyond the cap roll over to the next unbonding epoch in the order they were queued
+// Setting the cap to 0 removes it
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Cap unbondings on host chain X",
+//		   "metadata": "Cap unbondings on host chain X",
+//		   "summary": "Cap unbondings on host chain X",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgSetUnbondCap",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "chain_id": "cosmoshub-4",
+//		         "max_unbond_per_epoch": "1000000000000"
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
+func (ms msgServer) SetUnbondCap(goCtx context.Context, msg *types.MsgSetUnbondCap) (*types.MsgSetUnbondCapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	hostZone, found := ms.Keeper.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, types.ErrHostZoneNotFound.Wrapf("host zone %s not found", msg.ChainId)
+	}
+
+	hostZone.MaxUnbondPerEpoch = msg.MaxUnbondPerEpoch
+	ms.Keeper.SetHostZone(ctx, hostZone)
+
+	return &types.MsgSetUnbondCapResponse{}, nil
+}
+
+// Gov tx to remove a validator from a host zone's blacklist
+// The validator's weight is not restored and must be updated separately
+func (ms msgServer) RemoveBlacklistedValidator(goCtx context.Context, msg *types.MsgRemoveBlacklistedValidator) (*types.MsgRemoveBlacklistedValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.Keeper.RemoveBlacklistedValidator(ctx, msg.ChainId, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveBlacklistedValidatorResponse{}, nil
+}
+
+// Governance transaction to create a basket of host zones that can be liquid staked into
+// with a single basket token
+// Each component's weight is the number of that host zone's stTokens backing each basket token
+//
+// Example proposal:
+//
+//		{
+//		   "title": "Create a Cosmos staking basket",
+//		   "metadata": "Create a Cosmos staking basket",
+//		   "summary": "Create a Cosmos staking basket",
+//		   "messages":[
+//		      {
+//		         "@type": "/stride.stakeibc.MsgCreateBasket",
+//		         "authority": "stride10d07y265gmmuvt4z0w9aw880jnsr700jefnezl",
+//		         "basket_id": "cosmos",
+//		         "components": [
+//		            {"host_zone_id": "cosmoshub-4", "weight": "0.5"},
+//		            {"host_zone_id": "osmosis-1", "weight": "5.0"}
+//		         ]
+//		      }
+//		   ],
+//		   "deposit": "2000000000ustrd"
+//	   }
+//
+// >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
+func (ms msgServer) CreateBasket(goCtx context.Context, msg *types.MsgCreateBasket) (*types.MsgCreateBasketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.Keeper.CreateBasket(ctx, msg.BasketId, msg.Components); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateBasketResponse{}, nil
+}
+
 func (k msgServer) AddValidators(goCtx context.Context, msg *types.MsgAddValidators) (*types.MsgAddValidatorsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -127,7 +646,17 @@ func (k msgServer) ChangeValidatorWeight(goCtx context.Context, msg *types.MsgCh
 		return nil, types.ErrInvalidHostZone
 	}
 
+	// Weights cannot be changed manually if they're computed automatically each epoch
+	if hostZone.AutoValidatorWeighting != nil {
+		return nil, errorsmod.Wrapf(types.ErrAutoValidatorWeightingEnabled,
+			"validator weights on %s must be changed through the weighting config", msg.HostZone)
+	}
+
 	for _, weightChange := range msg.ValidatorWeights {
+		// Blacklisted validators must remain at zero weight
+		if weightChange.Weight > 0 && hostZone.IsValidatorBlacklisted(weightChange.Address) {
+			return nil, errorsmod.Wrapf(types.ErrValidatorBlacklisted, "validator %s is blacklisted on %s", weightChange.Address, msg.HostZone)
+		}
 
 		validatorFound := false
 		for _, validator := range hostZone.Validators {
@@ -234,6 +763,12 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 	if hostZone.Halted {
 		return nil, errorsmod.Wrapf(types.ErrHaltedHostZone, "halted host zone found for denom (%s)", msg.HostDenom)
 	}
+	if GetRedemptionRateGuardStatus(*hostZone) == types.GUARD_DEGRADED {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneDegraded, "liquid stakes are paused for degraded host zone %s", hostZone.ChainId)
+	}
+	if err := k.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, types.PAUSE_LIQUID_STAKE); err != nil {
+		return nil, err
+	}
 
 	// Get user and module account addresses
 	liquidStakerAddress, err := sdk.AccAddressFromBech32(msg.Creator)
@@ -274,8 +809,18 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "balance is lower than staking amount. staking amount: %v, balance: %v", msg.Amount, balance.Amount)
 	}
 
+	// Take the host zone's liquid stake fee (if applicable) out of the deposit
+	feeAmount := k.GetLiquidStakeFeeAmount(ctx, *hostZone, liquidStakerAddress, msg.Amount)
+	stakeAmount := msg.Amount.Sub(feeAmount)
+
+	// If the staker specified validator preferences, split the deposit across the preferred validators
+	directedAmounts, err := GetValidatorPreferenceAmounts(*hostZone, msg.ValidatorPreferences, stakeAmount)
+	if err != nil {
+		return nil, err
+	}
+
 	// Determine the amount of stTokens to mint using the redemption rate
-	stAmount := (sdkmath.LegacyNewDecFromInt(msg.Amount).Quo(hostZone.RedemptionRate)).TruncateInt()
+	stAmount := (sdkmath.LegacyNewDecFromInt(stakeAmount).Quo(hostZone.RedemptionRate)).TruncateInt()
 	if stAmount.IsZero() {
 		return nil, errorsmod.Wrapf(types.ErrInsufficientLiquidStake,
 			"Liquid stake of %s%s would return 0 stTokens", msg.Amount.String(), hostZone.HostDenom)
@@ -283,10 +828,19 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 
 	// Transfer the native tokens from the user to module account
 	// Note: checkBlockedAddr=false because hostZoneDepositAddress is a module
-	if err := utils.SafeSendCoins(false, k.bankKeeper, ctx, liquidStakerAddress, hostZoneDepositAddress, sdk.NewCoins(nativeCoin)); err != nil {
+	stakeCoin := sdk.NewCoin(nativeDenom, stakeAmount)
+	if err := utils.SafeSendCoins(false, k.bankKeeper, ctx, liquidStakerAddress, hostZoneDepositAddress, sdk.NewCoins(stakeCoin)); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to send tokens from Account to Module")
 	}
 
+	// Send the fee to the reward collector, where it's auctioned off with the reward commission
+	if feeAmount.IsPositive() {
+		feeCoin := sdk.NewCoin(nativeDenom, feeAmount)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, liquidStakerAddress, types.RewardCollectorName, sdk.NewCoins(feeCoin)); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to send liquid stake fee %v to reward collector", feeCoin)
+		}
+	}
+
 	// Mint the stTokens and transfer them to the user
 	stDenom := types.StAssetDenomFromHostZoneDenom(msg.HostDenom)
 	stCoin := sdk.NewCoin(stDenom, stAmount)
@@ -298,11 +852,17 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 	}
 
 	// Update the liquid staked amount on the deposit record
-	depositRecord.Amount = depositRecord.Amount.Add(msg.Amount)
+	depositRecord.Amount = depositRecord.Amount.Add(stakeAmount)
 	k.RecordsKeeper.SetDepositRecord(ctx, *depositRecord)
 
+	// Track the directed portion of the deposit so that it's delegated to the preferred validators
+	if len(directedAmounts) > 0 {
+		AddPendingDirectedDelegations(hostZone, directedAmounts)
+		k.SetHostZone(ctx, *hostZone)
+	}
+
 	// Emit liquid stake event
-	EmitSuccessfulLiquidStakeEvent(ctx, msg, *hostZone, stAmount)
+	EmitSuccessfulLiquidStakeEvent(ctx, msg, *hostZone, stAmount, feeAmount)
 
 	k.hooks.AfterLiquidStake(ctx, liquidStakerAddress)
 	return &types.MsgLiquidStakeResponse{StToken: stCoin}, nil
@@ -313,6 +873,48 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 	return k.Keeper.RedeemStake(ctx, msg)
 }
 
+// Exchanges a user's stTokens for native tokens immediately, paid out from the host zone's instant redemption buffer
+func (k msgServer) InstantRedeemStake(goCtx context.Context, msg *types.MsgInstantRedeemStake) (*types.MsgInstantRedeemStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.Keeper.InstantRedeemStake(ctx, msg)
+}
+
+// Reassigns an unclaimed redemption record to a new owner and receiver
+func (k msgServer) TransferRedemptionRecord(goCtx context.Context, msg *types.MsgTransferRedemptionRecord) (*types.MsgTransferRedemptionRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.Keeper.TransferRedemptionRecord(ctx, msg)
+}
+
+// Cancels all or part of a redemption that is still in the unbonding queue
+func (k msgServer) CancelRedemption(goCtx context.Context, msg *types.MsgCancelRedemption) (*types.MsgCancelRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.Keeper.CancelRedemption(ctx, msg)
+}
+
+// Mints an NFT representing an unclaimed redemption record to the record owner
+func (k msgServer) TokenizeRedemptionRecord(goCtx context.Context, msg *types.MsgTokenizeRedemptionRecord) (*types.MsgTokenizeRedemptionRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.Keeper.TokenizeRedemptionRecord(ctx, msg)
+}
+
+// Burns a redemption record NFT and assigns the record to the NFT holder
+func (k msgServer) DetokenizeRedemptionRecord(goCtx context.Context, msg *types.MsgDetokenizeRedemptionRecord) (*types.MsgDetokenizeRedemptionRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.Keeper.DetokenizeRedemptionRecord(ctx, msg)
+}
+
+// Liquid stakes across each host zone in a basket and mints the basket token
+func (k msgServer) LiquidStakeBasket(goCtx context.Context, msg *types.MsgLiquidStakeBasket) (*types.MsgLiquidStakeBasketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.Keeper.LiquidStakeBasket(ctx, msg)
+}
+
+// Burns basket tokens and redeems the underlying stTokens from each host zone
+func (k msgServer) RedeemBasket(goCtx context.Context, msg *types.MsgRedeemBasket) (*types.MsgRedeemBasketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.Keeper.RedeemBasket(ctx, msg)
+}
+
 // Exchanges a user's LSM tokenized shares for stTokens using the current redemption rate
 // The LSM tokens must live on Stride as an IBC voucher (whose denomtrace we recognize)
 // before this function is called
@@ -334,27 +936,12 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 func (k msgServer) LSMLiquidStake(goCtx context.Context, msg *types.MsgLSMLiquidStake) (*types.MsgLSMLiquidStakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	lsmLiquidStake, err := k.StartLSMLiquidStake(ctx, *msg)
+	_, transactionComplete, err := k.Keeper.LSMLiquidStake(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	if k.ShouldCheckIfValidatorWasSlashed(ctx, *lsmLiquidStake.Validator, msg.Amount) {
-		if err := k.SubmitValidatorSlashQuery(ctx, lsmLiquidStake); err != nil {
-			return nil, err
-		}
-
-		EmitPendingLSMLiquidStakeEvent(ctx, *lsmLiquidStake.HostZone, *lsmLiquidStake.Deposit)
-
-		return &types.MsgLSMLiquidStakeResponse{TransactionComplete: false}, nil
-	}
-
-	async := false
-	if err := k.FinishLSMLiquidStake(ctx, lsmLiquidStake, async); err != nil {
-		return nil, err
-	}
-
-	return &types.MsgLSMLiquidStakeResponse{TransactionComplete: true}, nil
+	return &types.MsgLSMLiquidStakeResponse{TransactionComplete: transactionComplete}, nil
 }
 
 // Gov tx to register a trade route that swaps reward tokens for a different denom
@@ -390,6 +977,11 @@ func (k msgServer) LSMLiquidStake(goCtx context.Context, msg *types.MsgLSMLiquid
 //		   "deposit": "2000000000ustrd"
 //	   }
 //
+// To sell the rewards through x/auction instead, set "trade_venue" to "STRIDE_AUCTION", and replace the
+// trade connection, trade channels and trade denoms with "reward_to_stride_transfer_channel_id" and
+// "reward_denom_on_stride". The auction must be created separately with the route's auction proceeds
+// address as the beneficiary and the host zone's ibc denom as the payment denom
+//
 // >>> strided tx gov submit-proposal {proposal_file.json} --from wallet
 func (ms msgServer) CreateTradeRoute(goCtx context.Context, msg *types.MsgCreateTradeRoute) (*types.MsgCreateTradeRouteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -397,65 +989,9 @@ func (ms msgServer) CreateTradeRoute(goCtx context.Context, msg *types.MsgCreate
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
 	}
 
-	// Validate trade route does not already exist for this denom
-	_, found := ms.Keeper.GetTradeRoute(ctx, msg.RewardDenomOnReward, msg.HostDenomOnHost)
-	if found {
-		return nil, errorsmod.Wrapf(types.ErrTradeRouteAlreadyExists,
-			"trade route already exists for rewardDenom %s, hostDenom %s", msg.RewardDenomOnReward, msg.HostDenomOnHost)
-	}
-
-	// Confirm the host chain exists and the withdrawal address has been initialized
-	hostZone, err := ms.Keeper.GetActiveHostZone(ctx, msg.HostChainId)
-	if err != nil {
+	if err := ms.Keeper.CreateTradeRoute(ctx, msg); err != nil {
 		return nil, err
 	}
-	if hostZone.WithdrawalIcaAddress == "" {
-		return nil, errorsmod.Wrapf(types.ErrICAAccountNotFound, "withdrawal account not initialized on host zone")
-	}
-
-	// Register the new ICA accounts
-	tradeRouteId := types.GetTradeRouteId(msg.RewardDenomOnReward, msg.HostDenomOnHost)
-	hostICA := types.ICAAccount{
-		ChainId:      msg.HostChainId,
-		Type:         types.ICAAccountType_WITHDRAWAL,
-		ConnectionId: hostZone.ConnectionId,
-		Address:      hostZone.WithdrawalIcaAddress,
-	}
-
-	unwindConnectionId := msg.StrideToRewardConnectionId
-	unwindICAType := types.ICAAccountType_CONVERTER_UNWIND
-	unwindICA, err := ms.Keeper.RegisterTradeRouteICAAccount(ctx, tradeRouteId, unwindConnectionId, unwindICAType)
-	if err != nil {
-		return nil, errorsmod.Wrapf(err, "unable to register the unwind ICA account")
-	}
-
-	tradeConnectionId := msg.StrideToTradeConnectionId
-	tradeICAType := types.ICAAccountType_CONVERTER_TRADE
-	tradeICA, err := ms.Keeper.RegisterTradeRouteICAAccount(ctx, tradeRouteId, tradeConnectionId, tradeICAType)
-	if err != nil {
-		return nil, errorsmod.Wrapf(err, "unable to register the trade ICA account")
-	}
-
-	// Finally build and store the main trade route
-	tradeRoute := types.TradeRoute{
-		RewardDenomOnHostZone:   msg.RewardDenomOnHost,
-		RewardDenomOnRewardZone: msg.RewardDenomOnReward,
-		RewardDenomOnTradeZone:  msg.RewardDenomOnTrade,
-		HostDenomOnTradeZone:    msg.HostDenomOnTrade,
-		HostDenomOnHostZone:     msg.HostDenomOnHost,
-
-		HostAccount:   hostICA,
-		RewardAccount: unwindICA,
-		TradeAccount:  tradeICA,
-
-		HostToRewardChannelId:  msg.HostToRewardTransferChannelId,
-		RewardToTradeChannelId: msg.RewardToTradeTransferChannelId,
-		TradeToHostChannelId:   msg.TradeToHostTransferChannelId,
-
-		MinTransferAmount: msg.MinTransferAmount,
-	}
-
-	ms.Keeper.SetTradeRoute(ctx, tradeRoute)
 
 	return &types.MsgCreateTradeRouteResponse{}, nil
 }
@@ -537,35 +1073,9 @@ func (ms msgServer) UpdateTradeRoute(goCtx context.Context, msg *types.MsgUpdate
 func (k msgServer) RestoreInterchainAccount(goCtx context.Context, msg *types.MsgRestoreInterchainAccount) (*types.MsgRestoreInterchainAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Get ConnectionEnd (for counterparty connection)
-	connectionEnd, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, msg.ConnectionId)
-	if !found {
-		return nil, errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "connection %s not found", msg.ConnectionId)
-	}
-	counterpartyConnection := connectionEnd.Counterparty
-
-	// only allow restoring an account if it already exists
-	portID, err := icatypes.NewControllerPortID(msg.AccountOwner)
-	if err != nil {
+	if err := k.ReregisterInterchainAccount(ctx, msg.ConnectionId, msg.AccountOwner); err != nil {
 		return nil, err
 	}
-	_, exists := k.ICAControllerKeeper.GetInterchainAccountAddress(ctx, msg.ConnectionId, portID)
-	if !exists {
-		return nil, errorsmod.Wrapf(types.ErrInvalidInterchainAccountAddress,
-			"ICA controller account address not found: %s", msg.AccountOwner)
-	}
-
-	appVersion := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
-		Version:                icatypes.Version,
-		ControllerConnectionId: msg.ConnectionId,
-		HostConnectionId:       counterpartyConnection.ConnectionId,
-		Encoding:               icatypes.EncodingProtobuf,
-		TxType:                 icatypes.TxTypeSDKMultiMsg,
-	}))
-
-	if err := k.ICAControllerKeeper.RegisterInterchainAccount(ctx, msg.ConnectionId, msg.AccountOwner, appVersion, channeltypes.ORDERED); err != nil {
-		return nil, errorsmod.Wrapf(err, "unable to register account for owner %s", msg.AccountOwner)
-	}
 
 	// If we're restoring a delegation account, we also have to reset record state
 	if msg.AccountOwner == types.FormatHostZoneICAOwner(msg.ChainId, types.ICAAccountType_DELEGATION) {
@@ -573,66 +1083,8 @@ func (k msgServer) RestoreInterchainAccount(goCtx context.Context, msg *types.Ms
 		if !found {
 			return nil, types.ErrHostZoneNotFound.Wrapf("delegation ICA supplied, but no associated host zone")
 		}
-
-		// Since any ICAs along the original channel will never get relayed,
-		// we have to reset the delegation_changes_in_progress field on each validator
-		for _, validator := range hostZone.Validators {
-			validator.DelegationChangesInProgress = 0
-		}
-		k.SetHostZone(ctx, hostZone)
-
-		// revert DELEGATION_IN_PROGRESS records for the closed ICA channel (so that they can be staked)
-		depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
-		for _, depositRecord := range depositRecords {
-			// only revert records for the select host zone
-			if depositRecord.HostZoneId == hostZone.ChainId && depositRecord.Status == recordtypes.DepositRecord_DELEGATION_IN_PROGRESS {
-				depositRecord.Status = recordtypes.DepositRecord_DELEGATION_QUEUE
-				depositRecord.DelegationTxsInProgress = 0
-
-				k.Logger(ctx).Info(fmt.Sprintf("Setting DepositRecord %d to status DepositRecord_DELEGATION_IN_PROGRESS", depositRecord.Id))
-				k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
-			}
-		}
-
-		// revert epoch unbonding records for the closed ICA channel
-		epochUnbondingRecords := k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx)
-		for _, epochUnbondingRecord := range epochUnbondingRecords {
-			// only revert records for the select host zone
-			hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
-			if !found {
-				k.Logger(ctx).Info(fmt.Sprintf("No HostZoneUnbonding found for chainId: %s, epoch: %d", hostZone.ChainId, epochUnbondingRecord.EpochNumber))
-				continue
-			}
-
-			// Reset the number of undelegation txs in progress
-			hostZoneUnbonding.UndelegationTxsInProgress = 0
-
-			// Revert UNBONDING_IN_PROGRESS records to UNBONDING_RETRY_QUEUE
-			// and EXIT_TRANSFER_IN_PROGRESS records to EXIT_TRANSFER_QUEUE
-			if hostZoneUnbonding.Status == recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS {
-				k.Logger(ctx).Info(fmt.Sprintf("HostZoneUnbonding for %s at EpochNumber %d is stuck in status %s",
-					hostZone.ChainId, epochUnbondingRecord.EpochNumber, recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS.String(),
-				))
-				hostZoneUnbonding.Status = recordstypes.HostZoneUnbonding_UNBONDING_RETRY_QUEUE
-
-			} else if hostZoneUnbonding.Status == recordtypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS {
-				k.Logger(ctx).Info(fmt.Sprintf("HostZoneUnbonding for %s at EpochNumber %d to in status %s",
-					hostZone.ChainId, epochUnbondingRecord.EpochNumber, recordtypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS.String(),
-				))
-				hostZoneUnbonding.Status = recordstypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE
-			}
-
-			err := k.RecordsKeeper.SetHostZoneUnbondingRecord(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId, *hostZoneUnbonding)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		// Revert all pending LSM Detokenizations from status DETOKENIZATION_IN_PROGRESS to status DETOKENIZATION_QUEUE
-		pendingDeposits := k.RecordsKeeper.GetLSMDepositsForHostZoneWithStatus(ctx, hostZone.ChainId, recordtypes.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS)
-		for _, lsmDeposit := range pendingDeposits {
-			k.Logger(ctx).Info(fmt.Sprintf("Setting LSMTokenDeposit %s to status DETOKENIZATION_QUEUE", lsmDeposit.Denom))
-			k.RecordsKeeper.UpdateLSMTokenDepositStatus(ctx, lsmDeposit, recordtypes.LSMTokenDeposit_DETOKENIZATION_QUEUE)
+		if err := k.ResetDelegationIcaRecords(ctx, hostZone); err != nil {
+			return nil, err
 		}
 	}
 
@@ -746,6 +1198,16 @@ func (k msgServer) ResumeHostZone(goCtx context.Context, msg *types.MsgResumeHos
 		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
 	}
 
+	// If the redemption rate guard tripped, resume through the guard once the cooldown has elapsed
+	// This also covers host zones that are degraded rather than halted
+	if hostZone.RedemptionRateGuard != nil {
+		if err := k.CheckRedemptionRateGuardCooldown(ctx, hostZone); err != nil {
+			return nil, err
+		}
+		k.ResetRedemptionRateGuard(ctx, hostZone, fmt.Sprintf("resumed by %s", msg.Creator))
+		return &types.MsgResumeHostZoneResponse{}, nil
+	}
+
 	// Check the zone is halted
 	if !hostZone.Halted {
 		return nil, errorsmod.Wrapf(types.ErrHostZoneNotHalted, "host zone %s is not halted", msg.ChainId)
@@ -769,32 +1231,14 @@ func (k msgServer) SetCommunityPoolRebate(
 ) (*types.MsgSetCommunityPoolRebateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
-	if !found {
-		return nil, types.ErrHostZoneNotFound.Wrapf("host zone %s not found", msg.ChainId)
-	}
-
-	// Get the current stToken supply and confirm it's greater than or equal to the liquid staked amount
-	stDenom := utils.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
-	stTokenSupply := k.bankKeeper.GetSupply(ctx, stDenom).Amount
-	if msg.LiquidStakedStTokenAmount.GT(stTokenSupply) {
-		return nil, types.ErrFailedToRegisterRebate.Wrapf("liquid staked stToken amount (%v) is greater than current supply (%v)",
-			msg.LiquidStakedStTokenAmount, stTokenSupply)
+	rebate := types.CommunityPoolRebate{
+		LiquidStakedStTokenAmount: msg.LiquidStakedStTokenAmount,
+		RebateRate:                msg.RebateRate,
 	}
-
-	// If a zero rebate rate or zero LiquidStakedStTokenAmount is specified, set the rebate to nil
-	// Otherwise, update the struct
-	if msg.LiquidStakedStTokenAmount.IsZero() || msg.RebateRate.IsZero() {
-		hostZone.CommunityPoolRebate = nil
-	} else {
-		hostZone.CommunityPoolRebate = &types.CommunityPoolRebate{
-			LiquidStakedStTokenAmount: msg.LiquidStakedStTokenAmount,
-			RebateRate:                msg.RebateRate,
-		}
+	if err := k.Keeper.SetCommunityPoolRebate(ctx, msg.ChainId, rebate); err != nil {
+		return nil, err
 	}
 
-	k.SetHostZone(ctx, hostZone)
-
 	return &types.MsgSetCommunityPoolRebateResponse{}, nil
 }
 
@@ -66,6 +66,107 @@ func (s *KeeperTestSuite) TestUpdateHostZoneParams() {
 	hostZone = s.MustGetHostZone(HostChainId)
 	s.Require().Equal(keeper.DefaultMaxMessagesPerIcaTx, hostZone.MaxMessagesPerIcaTx, "max messages")
 
+	// Set a fee schedule on the host zone
+	feeSchedule := types.HostZoneFeeSchedule{
+		RewardCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.05"),
+		LiquidStakeFeeRate:   sdkmath.LegacyMustNewDecFromStr("0.001"),
+		RedemptionFeeRate:    sdkmath.LegacyMustNewDecFromStr("0.002"),
+	}
+	validUpdateMsg = types.MsgUpdateHostZoneParams{
+		Authority:           Authority,
+		ChainId:             HostChainId,
+		MaxMessagesPerIcaTx: updatedMessages,
+		FeeSchedule:         &feeSchedule,
+	}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(s.Ctx, &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when setting fee schedule")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().NotNil(hostZone.FeeSchedule, "fee schedule should be set")
+	s.Require().Equal(feeSchedule, *hostZone.FeeSchedule, "fee schedule")
+	s.Require().Equal(feeSchedule, s.App.StakeibcKeeper.GetHostZoneFeeSchedule(s.Ctx, hostZone), "effective fee schedule")
+
+	// Update a different param without a fee schedule, the existing fee schedule should be kept
+	validUpdateMsg.FeeSchedule = nil
+	validUpdateMsg.MaxMessagesPerIcaTx = initialMessages
+	_, err = s.GetMsgServer().UpdateHostZoneParams(s.Ctx, &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when updating without a fee schedule")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(initialMessages, hostZone.MaxMessagesPerIcaTx, "max messages after partial update")
+	s.Require().NotNil(hostZone.FeeSchedule, "fee schedule should not have been removed")
+	s.Require().Equal(feeSchedule, *hostZone.FeeSchedule, "fee schedule after partial update")
+
+	// Remove the fee schedule, the global commission should be used instead
+	validUpdateMsg.RemoveFeeSchedule = true
+	_, err = s.GetMsgServer().UpdateHostZoneParams(s.Ctx, &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when removing fee schedule")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Nil(hostZone.FeeSchedule, "fee schedule should be removed")
+
+	strideCommission := s.App.StakeibcKeeper.GetParams(s.Ctx).StrideCommission
+	expectedCommission := sdkmath.LegacyNewDec(int64(strideCommission)).Quo(sdkmath.LegacyNewDec(100))
+	effectiveFeeSchedule := s.App.StakeibcKeeper.GetHostZoneFeeSchedule(s.Ctx, hostZone)
+	s.Require().Equal(expectedCommission, effectiveFeeSchedule.RewardCommissionRate, "default reward commission")
+	s.Require().True(effectiveFeeSchedule.LiquidStakeFeeRate.IsZero(), "default liquid stake fee")
+	s.Require().True(effectiveFeeSchedule.RedemptionFeeRate.IsZero(), "default redemption fee")
+
+	// Set the max ICA tx gas
+	validUpdateMsg.RemoveFeeSchedule = false
+	validUpdateMsg.MaxIcaTxGas = 4_000_000
+	_, err = s.GetMsgServer().UpdateHostZoneParams(s.Ctx, &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when setting the max ica tx gas")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(uint64(4_000_000), hostZone.MaxIcaTxGas, "max ica tx gas")
+
+	// Update a different param without the max ICA tx gas, it should be kept
+	validUpdateMsg.MaxIcaTxGas = 0
+	validUpdateMsg.MaxMessagesPerIcaTx = updatedMessages
+	_, err = s.GetMsgServer().UpdateHostZoneParams(s.Ctx, &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when updating without a max ica tx gas")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(uint64(4_000_000), hostZone.MaxIcaTxGas, "max ica tx gas after partial update")
+
+	// Remove the max ICA tx gas
+	validUpdateMsg.RemoveMaxIcaTxGas = true
+	_, err = s.GetMsgServer().UpdateHostZoneParams(s.Ctx, &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when removing the max ica tx gas")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Zero(hostZone.MaxIcaTxGas, "max ica tx gas should be removed")
+	validUpdateMsg.RemoveMaxIcaTxGas = false
+
+	// Set the min rebalance amount
+	validUpdateMsg.MinRebalanceAmount = sdkmath.NewInt(1000)
+	_, err = s.GetMsgServer().UpdateHostZoneParams(s.Ctx, &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when setting the min rebalance amount")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(int64(1000), hostZone.MinRebalanceAmount.Int64(), "min rebalance amount")
+
+	// Update a different param without the min rebalance amount, it should be kept
+	validUpdateMsg.MinRebalanceAmount = sdkmath.Int{}
+	_, err = s.GetMsgServer().UpdateHostZoneParams(s.Ctx, &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when updating without a min rebalance amount")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(int64(1000), hostZone.MinRebalanceAmount.Int64(), "min rebalance amount after partial update")
+
+	// Remove the min rebalance amount, the default minimum should be used instead
+	validUpdateMsg.RemoveMinRebalanceAmount = true
+	_, err = s.GetMsgServer().UpdateHostZoneParams(s.Ctx, &validUpdateMsg)
+	s.Require().NoError(err, "no error expected when removing the min rebalance amount")
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().True(hostZone.MinRebalanceAmount.IsZero(), "min rebalance amount should be removed")
+
+	totalDelegations := sdkmath.NewInt(1_000_000)
+	expectedDefault := sdkmath.LegacyNewDecFromInt(totalDelegations).Mul(types.DefaultMinRebalanceRate).TruncateInt()
+	s.Require().Equal(expectedDefault, hostZone.GetMinRebalanceAmount(totalDelegations), "default min rebalance amount")
+
 	// Attempt it again with an invalid chain ID, it should fail
 	invalidUpdateMsg := types.MsgUpdateHostZoneParams{
 		Authority:           Authority,
@@ -674,6 +775,41 @@ func (s *KeeperTestSuite) TestLiquidStake_Successful() {
 	s.Require().Equal(expectedDepositRecordAmount, actualDepositRecordAmount, "deposit record amount")
 }
 
+func (s *KeeperTestSuite) TestLiquidStake_WithFee() {
+	tc := s.SetupLiquidStake()
+	user := tc.user
+	zoneAccount := tc.zoneAccount
+	msg := tc.validMsg
+
+	// Add a 1% liquid stake fee to the host zone
+	hostZone := tc.initialState.hostZone
+	hostZone.FeeSchedule = &stakeibctypes.HostZoneFeeSchedule{
+		RewardCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.1"),
+		LiquidStakeFeeRate:   sdkmath.LegacyMustNewDecFromStr("0.01"),
+		RedemptionFeeRate:    sdkmath.LegacyZeroDec(),
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	resp, err := s.GetMsgServer().LiquidStake(s.Ctx, &msg)
+	s.Require().NoError(err)
+
+	// 1% of the 1,000,000 stake is taken as a fee, leaving 990,000 to be staked
+	expectedFee := sdkmath.NewInt(10_000)
+	expectedStakeAmount := sdkmath.NewInt(990_000)
+	s.Require().Equal(expectedStakeAmount, resp.StToken.Amount, "sttokens minted")
+
+	// The user should be charged the full amount, while the fee is sent to the reward collector
+	rewardCollectorAddress := s.App.AccountKeeper.GetModuleAccount(s.Ctx, stakeibctypes.RewardCollectorName).GetAddress()
+	s.CompareCoins(user.atomBalance.SubAmount(msg.Amount), s.App.BankKeeper.GetBalance(s.Ctx, user.acc, IbcAtom), "user ibc/uatom balance")
+	s.CompareCoins(zoneAccount.atomBalance.AddAmount(expectedStakeAmount), s.App.BankKeeper.GetBalance(s.Ctx, zoneAccount.acc, IbcAtom), "zoneAccount ibc/uatom balance")
+	s.CompareCoins(sdk.NewCoin(IbcAtom, expectedFee), s.App.BankKeeper.GetBalance(s.Ctx, rewardCollectorAddress, IbcAtom), "reward collector ibc/uatom balance")
+
+	// The deposit record should only include the amount after the fee
+	records := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx)
+	s.Require().Len(records, 1, "number of deposit records")
+	s.Require().Equal(tc.initialState.depositRecordAmount.Add(expectedStakeAmount), records[0].Amount, "deposit record amount")
+}
+
 func (s *KeeperTestSuite) TestLiquidStake_DifferentRedemptionRates() {
 	tc := s.SetupLiquidStake()
 	user := tc.user
@@ -1333,6 +1469,11 @@ func (s *KeeperTestSuite) submitCreateTradeRouteAndValidate(msg types.MsgCreateT
 	s.Require().Equal(expectedRoute.TradeToHostChannelId, actualRoute.TradeToHostChannelId, "trade route trade to host")
 
 	s.Require().Equal(expectedRoute.MinTransferAmount, actualRoute.MinTransferAmount, "trade route min transfer amount")
+
+	s.Require().Equal(expectedRoute.TradeVenue, actualRoute.TradeVenue, "trade route venue")
+	s.Require().Equal(expectedRoute.RewardToStrideChannelId, actualRoute.RewardToStrideChannelId, "trade route reward to stride")
+	s.Require().Equal(expectedRoute.RewardDenomOnStride, actualRoute.RewardDenomOnStride, "trade route reward on stride denom")
+	s.Require().Equal(expectedRoute.AuctionProceedsAddress, actualRoute.AuctionProceedsAddress, "trade route auction proceeds address")
 }
 
 // Tests a successful trade route creation
@@ -1341,6 +1482,40 @@ func (s *KeeperTestSuite) TestCreateTradeRoute_Success() {
 	s.submitCreateTradeRouteAndValidate(msg, expectedRoute)
 }
 
+// Tests a successful trade route creation with the auction venue
+func (s *KeeperTestSuite) TestCreateTradeRoute_Success_StrideAuction() {
+	msg, expectedRoute := s.SetupTestCreateTradeRoute()
+
+	// Swap out the trade zone fields for the auction fields
+	msg.TradeVenue = types.TradeVenue_STRIDE_AUCTION
+	msg.StrideToTradeConnectionId = ""
+	msg.RewardToTradeTransferChannelId = ""
+	msg.TradeToHostTransferChannelId = ""
+	msg.RewardDenomOnTrade = ""
+	msg.HostDenomOnTrade = ""
+	msg.RewardToStrideTransferChannelId = "channel-400"
+	msg.RewardDenomOnStride = "ibc/reward-on-stride"
+
+	tradeRouteId := types.GetTradeRouteId(msg.RewardDenomOnReward, msg.HostDenomOnHost)
+	proceedsAddress := types.NewHostZoneModuleAddress(HostChainId, keeper.AuctionProceedsAddressKey+"-"+tradeRouteId)
+
+	expectedRoute.TradeVenue = types.TradeVenue_STRIDE_AUCTION
+	expectedRoute.TradeAccount = types.ICAAccount{}
+	expectedRoute.RewardToTradeChannelId = ""
+	expectedRoute.TradeToHostChannelId = ""
+	expectedRoute.RewardDenomOnTradeZone = ""
+	expectedRoute.HostDenomOnTradeZone = ""
+	expectedRoute.RewardToStrideChannelId = "channel-400"
+	expectedRoute.RewardDenomOnStride = "ibc/reward-on-stride"
+	expectedRoute.AuctionProceedsAddress = proceedsAddress.String()
+
+	s.submitCreateTradeRouteAndValidate(msg, expectedRoute)
+
+	// Confirm the proceeds module account was created
+	proceedsAccount := s.App.AccountKeeper.GetAccount(s.Ctx, proceedsAddress)
+	s.Require().NotNil(proceedsAccount, "auction proceeds account should have been created")
+}
+
 // Tests trying to create a route from an invalid authority
 func (s *KeeperTestSuite) TestCreateTradeRoute_Failure_Authority() {
 	msg, _ := s.SetupTestCreateTradeRoute()
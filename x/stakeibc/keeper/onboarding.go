@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	ratelimittypes "github.com/cosmos/ibc-go/v11/modules/apps/rate-limiting/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Stores the onboarding configuration of a host zone
+func (k Keeper) SetHostZoneOnboarding(ctx sdk.Context, onboarding types.HostZoneOnboarding) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HostZoneOnboardingKeyPrefix))
+	store.Set([]byte(onboarding.ChainId), k.cdc.MustMarshal(&onboarding))
+}
+
+// Reads the onboarding configuration of a host zone
+func (k Keeper) GetHostZoneOnboarding(ctx sdk.Context, chainId string) (onboarding types.HostZoneOnboarding, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HostZoneOnboardingKeyPrefix))
+	bz := store.Get([]byte(chainId))
+	if len(bz) == 0 {
+		return onboarding, false
+	}
+	k.cdc.MustUnmarshal(bz, &onboarding)
+	return onboarding, true
+}
+
+// Returns the onboarding configuration of each host zone onboarded through MsgOnboardHostZone
+func (k Keeper) GetAllHostZoneOnboardings(ctx sdk.Context) (onboardings []types.HostZoneOnboarding) {
+	onboardings = []types.HostZoneOnboarding{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HostZoneOnboardingKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var onboarding types.HostZoneOnboarding
+		k.cdc.MustUnmarshal(iterator.Value(), &onboarding)
+		onboardings = append(onboardings, onboarding)
+	}
+
+	return onboardings
+}
+
+// Removes the onboarding configuration of a host zone when the host zone is unregistered
+// The configuration is kept for the life of the host zone since it backs the onboarding checklist
+func (k Keeper) RemoveHostZoneOnboarding(ctx sdk.Context, chainId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HostZoneOnboardingKeyPrefix))
+	store.Delete([]byte(chainId))
+}
+
+// Registers a host zone and applies each step of its initial configuration
+// If any step fails, the error is returned and the tx reverts, so none of the configuration is applied
+//
+// The steps that depend on the host zone's ICAs (the trade route) or on an ICQ response
+// (the validator sharesToTokens rates) complete after registration, and are tracked by
+// the onboarding checklist
+func (k Keeper) OnboardHostZone(ctx sdk.Context, msg *types.MsgOnboardHostZone) error {
+	registerMsg := msg.HostZone
+	if _, err := k.RegisterHostZone(ctx, &registerMsg); err != nil {
+		return errorsmod.Wrap(err, "unable to register host zone")
+	}
+
+	chainId, err := k.GetChainIdFromConnectionId(ctx, registerMsg.ConnectionId)
+	if err != nil {
+		return err
+	}
+	onboarding := types.HostZoneOnboarding{ChainId: chainId}
+
+	// Add the validators and submit the ICQs for their sharesToTokens rates
+	for _, validator := range msg.Validators {
+		if err := k.AddValidatorToHostZone(ctx, chainId, *validator, false); err != nil {
+			return errorsmod.Wrapf(err, "unable to add validator %s", validator.Address)
+		}
+		if err := k.QueryValidatorSharesToTokensRate(ctx, chainId, validator.Address); err != nil {
+			return errorsmod.Wrapf(err, "unable to query sharesToTokens rate for validator %s", validator.Address)
+		}
+	}
+	if err := k.CheckValidatorWeightsBelowCap(ctx, chainId); err != nil {
+		return err
+	}
+
+	if msg.CommunityPoolRebate != nil {
+		if err := k.SetCommunityPoolRebate(ctx, chainId, *msg.CommunityPoolRebate); err != nil {
+			return errorsmod.Wrap(err, "unable to set community pool rebate")
+		}
+	}
+
+	// The trade route can't be created until the withdrawal ICA is registered, so it's validated
+	// against the new host zone now and stored so that it can be created from the ICA callback
+	if msg.TradeRoute != nil {
+		if msg.TradeRoute.HostChainId != chainId {
+			return types.ErrFailedToOnboardHostZone.Wrapf("trade route host chain (%s) does not match the registered host zone (%s)",
+				msg.TradeRoute.HostChainId, chainId)
+		}
+		if _, found := k.GetTradeRoute(ctx, msg.TradeRoute.RewardDenomOnReward, msg.TradeRoute.HostDenomOnHost); found {
+			return errorsmod.Wrapf(types.ErrTradeRouteAlreadyExists, "trade route already exists for rewardDenom %s, hostDenom %s",
+				msg.TradeRoute.RewardDenomOnReward, msg.TradeRoute.HostDenomOnHost)
+		}
+
+		tradeRoute := *msg.TradeRoute
+		tradeRoute.Authority = msg.Authority
+		onboarding.TradeRoute = &tradeRoute
+	}
+
+	if msg.OracleConnectionId != "" {
+		oracleChainId, err := k.GetChainIdFromConnectionId(ctx, msg.OracleConnectionId)
+		if err != nil {
+			return err
+		}
+		if err := k.ICAOracleKeeper.AddOracle(ctx, msg.OracleConnectionId); err != nil {
+			return errorsmod.Wrap(err, "unable to add oracle")
+		}
+		onboarding.OracleChainId = oracleChainId
+	}
+
+	for _, entry := range msg.RateLimitWhitelist {
+		k.RatelimitKeeper.SetWhitelistedAddressPair(ctx, ratelimittypes.WhitelistedAddressPair{
+			Sender:   entry.Sender,
+			Receiver: entry.Receiver,
+		})
+	}
+
+	k.SetHostZoneOnboarding(ctx, onboarding)
+	EmitHostZoneOnboardedEvent(ctx, chainId, registerMsg.ConnectionId)
+
+	return nil
+}
+
+// Creates the trade route from a host zone's onboarding proposal, once the withdrawal ICA is registered
+// A failure is logged rather than returned so that it doesn't fail the channel handshake;
+// in that case, the route is left off the checklist and can be created with MsgCreateTradeRoute
+func (k Keeper) CreateOnboardingTradeRoute(ctx sdk.Context, chainId string) {
+	onboarding, found := k.GetHostZoneOnboarding(ctx, chainId)
+	if !found || onboarding.TradeRoute == nil {
+		return
+	}
+
+	tradeRoute := onboarding.TradeRoute
+	if _, found := k.GetTradeRoute(ctx, tradeRoute.RewardDenomOnReward, tradeRoute.HostDenomOnHost); found {
+		return
+	}
+
+	err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.CreateTradeRoute(ctx, tradeRoute)
+	})
+	if err != nil {
+		k.Logger(ctx).Error(utils.LogWithHostZone(chainId, "Unable to create trade route from onboarding: %s", err.Error()))
+		return
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Created trade route from onboarding for %s",
+		types.GetTradeRouteId(tradeRoute.RewardDenomOnReward, tradeRoute.HostDenomOnHost)))
+}
+
+// Builds the post-registration checklist for a host zone, covering the ICA channels,
+// the validator ICQs, and the trade route and oracle from the onboarding proposal
+func (k Keeper) GetHostZoneOnboardingChecklist(ctx sdk.Context, chainId string) (checklist types.HostZoneOnboardingChecklist, err error) {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return checklist, types.ErrHostZoneNotFound.Wrapf("host zone %s not found", chainId)
+	}
+
+	checklist.ChainId = chainId
+	complete := true
+
+	for _, icaType := range HostZoneIcaAccountTypes {
+		owner := types.FormatHostZoneICAOwner(chainId, icaType)
+		portId, err := icatypes.NewControllerPortID(owner)
+		if err != nil {
+			return checklist, err
+		}
+		_, channelOpen := k.ICAControllerKeeper.GetOpenActiveChannel(ctx, hostZone.ConnectionId, portId)
+
+		icaStatus := types.OnboardingIcaStatus{
+			IcaType:     icaType,
+			Address:     GetHostZoneIcaAddress(hostZone, icaType),
+			ChannelOpen: channelOpen,
+		}
+		checklist.IcaChannels = append(checklist.IcaChannels, icaStatus)
+		complete = complete && icaStatus.ChannelOpen && icaStatus.Address != ""
+	}
+
+	// A validator's sharesToTokens rate is only set once its ICQ has returned
+	checklist.NumValidators = uint64(len(hostZone.Validators))
+	for _, validator := range hostZone.Validators {
+		if !validator.SharesToTokensRate.IsNil() && validator.SharesToTokensRate.IsPositive() {
+			checklist.NumValidatorsQueried++
+		}
+	}
+	for _, query := range k.InterchainQueryKeeper.AllQueries(ctx) {
+		if query.ChainId == chainId {
+			checklist.NumPendingIcqs++
+		}
+	}
+	complete = complete && checklist.NumValidators > 0 && checklist.NumValidatorsQueried == checklist.NumValidators
+
+	onboarding, found := k.GetHostZoneOnboarding(ctx, chainId)
+	if found && onboarding.TradeRoute != nil {
+		_, tradeRouteFound := k.GetTradeRoute(ctx, onboarding.TradeRoute.RewardDenomOnReward, onboarding.TradeRoute.HostDenomOnHost)
+		checklist.TradeRouteRequested = true
+		checklist.TradeRouteCreated = tradeRouteFound
+		complete = complete && tradeRouteFound
+	}
+
+	if found && onboarding.OracleChainId != "" {
+		checklist.OracleChainId = onboarding.OracleChainId
+		oracle, oracleFound := k.ICAOracleKeeper.GetOracle(ctx, onboarding.OracleChainId)
+		if oracleFound {
+			checklist.OracleChannelOpen = k.ICAOracleKeeper.IsOracleICAChannelOpen(ctx, oracle)
+			checklist.OracleInstantiated = oracle.ContractAddress != ""
+		}
+		complete = complete && checklist.OracleChannelOpen && checklist.OracleInstantiated
+	}
+
+	checklist.Complete = complete
+
+	return checklist, nil
+}
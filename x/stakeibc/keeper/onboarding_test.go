@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icaoracletypes "github.com/Stride-Labs/stride/v33/x/icaoracle/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Builds an onboarding message with every optional step included, on top of the register host zone setup
+func (s *KeeperTestSuite) SetupOnboardHostZone() types.MsgOnboardHostZone {
+	tc := s.SetupRegisterHostZone()
+
+	// Mint stTokens so that the community pool rebate is below the supply
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StAtom, 10_000))
+
+	return types.MsgOnboardHostZone{
+		Authority: Authority,
+		HostZone:  tc.validMsg,
+		Validators: []*types.Validator{
+			{Name: "val1", Address: ValAddress, Weight: 1},
+			{Name: "val2", Address: "cosmosvaloper1pcag0cj4ttxg8l7pcg0q4ksuglswuuedadj7ne", Weight: 1},
+		},
+		TradeRoute: &types.MsgCreateTradeRoute{
+			HostChainId:                     HostChainId,
+			StrideToRewardConnectionId:      ibctesting.FirstConnectionID,
+			HostToRewardTransferChannelId:   "channel-1",
+			RewardToStrideTransferChannelId: "channel-2",
+			RewardDenomOnHost:               "ibc/reward-on-host",
+			RewardDenomOnReward:             RewardDenom,
+			RewardDenomOnStride:             "ibc/reward-on-stride",
+			HostDenomOnHost:                 Atom,
+			MinTransferAmount:               sdkmath.ZeroInt(),
+			TradeVenue:                      types.TradeVenue_STRIDE_AUCTION,
+		},
+		CommunityPoolRebate: &types.CommunityPoolRebate{
+			RebateRate:                sdkmath.LegacyMustNewDecFromStr("0.2"),
+			LiquidStakedStTokenAmount: sdkmath.NewInt(1_000),
+		},
+		OracleConnectionId: ibctesting.FirstConnectionID,
+		RateLimitWhitelist: []types.RateLimitWhitelistEntry{
+			{Sender: "cosmos-sender", Receiver: "stride-receiver"},
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestOnboardHostZone_Successful() {
+	msg := s.SetupOnboardHostZone()
+
+	_, err := s.GetMsgServer().OnboardHostZone(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when onboarding host zone")
+
+	// Confirm the host zone was registered with the validators and rebate
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Len(hostZone.Validators, 2, "number of validators")
+	s.Require().NotNil(hostZone.CommunityPoolRebate, "community pool rebate")
+	s.Require().Equal(sdkmath.NewInt(1_000), hostZone.CommunityPoolRebate.LiquidStakedStTokenAmount, "rebate liquid staked amount")
+
+	// Confirm the oracle was added and the whitelist was set
+	_, found := s.App.ICAOracleKeeper.GetOracle(s.Ctx, HostChainId)
+	s.Require().True(found, "oracle should have been added")
+	s.Require().True(s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, "cosmos-sender", "stride-receiver"),
+		"address pair should be whitelisted")
+
+	// Confirm the trade route was stored on the onboarding, but not yet created
+	onboarding, found := s.App.StakeibcKeeper.GetHostZoneOnboarding(s.Ctx, HostChainId)
+	s.Require().True(found, "onboarding should have been stored")
+	s.Require().Equal(HostChainId, onboarding.OracleChainId, "oracle chain ID")
+	s.Require().NotNil(onboarding.TradeRoute, "pending trade route")
+	s.Require().Equal(Authority, onboarding.TradeRoute.Authority, "trade route authority")
+
+	_, found = s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, RewardDenom, Atom)
+	s.Require().False(found, "trade route should not be created before the withdrawal ICA")
+
+	s.CheckEventValueEmitted(types.EventTypeHostZoneOnboarded, types.AttributeKeyHostZone, HostChainId)
+
+	// Check the checklist right after registration - the handshakes and ICQs are still outstanding
+	checklist, err := s.App.StakeibcKeeper.GetHostZoneOnboardingChecklist(s.Ctx, HostChainId)
+	s.Require().NoError(err, "no error expected when building checklist")
+	s.Require().Len(checklist.IcaChannels, len(keeper.HostZoneIcaAccountTypes), "number of ICAs")
+	for _, icaStatus := range checklist.IcaChannels {
+		s.Require().False(icaStatus.ChannelOpen, "%s channel should not be open", icaStatus.IcaType)
+	}
+	s.Require().Equal(uint64(2), checklist.NumValidators, "number of validators")
+	s.Require().Equal(uint64(0), checklist.NumValidatorsQueried, "number of validators queried")
+	s.Require().Equal(uint64(2), checklist.NumPendingIcqs, "number of pending ICQs")
+	s.Require().True(checklist.TradeRouteRequested, "trade route requested")
+	s.Require().False(checklist.TradeRouteCreated, "trade route created")
+	s.Require().Equal(HostChainId, checklist.OracleChainId, "oracle chain ID")
+	s.Require().False(checklist.OracleInstantiated, "oracle instantiated")
+	s.Require().False(checklist.Complete, "checklist complete")
+}
+
+func (s *KeeperTestSuite) TestOnboardHostZone_InvalidAuthority() {
+	msg := s.SetupOnboardHostZone()
+	msg.Authority = "invalid"
+
+	_, err := s.GetMsgServer().OnboardHostZone(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
+
+func (s *KeeperTestSuite) TestOnboardHostZone_FailedSteps() {
+	testCases := []struct {
+		name          string
+		malleate      func(msg *types.MsgOnboardHostZone)
+		expectedError string
+	}{
+		{
+			name:          "failed registration",
+			malleate:      func(msg *types.MsgOnboardHostZone) { msg.HostZone.ConnectionId = "connection-10" },
+			expectedError: "unable to register host zone",
+		},
+		{
+			name: "failed validator",
+			malleate: func(msg *types.MsgOnboardHostZone) {
+				msg.Validators[1].Address = "osmovaloper1pcag0cj4ttxg8l7pcg0q4ksuglswuuedadj7ne"
+			},
+			expectedError: "osmovaloper1pcag0cj4ttxg8l7pcg0q4ksuglswuuedadj7ne",
+		},
+		{
+			name: "rebate exceeds supply",
+			malleate: func(msg *types.MsgOnboardHostZone) {
+				msg.CommunityPoolRebate.LiquidStakedStTokenAmount = sdkmath.NewInt(10_001)
+			},
+			expectedError: "unable to set community pool rebate",
+		},
+		{
+			name:          "trade route for a different host",
+			malleate:      func(msg *types.MsgOnboardHostZone) { msg.TradeRoute.HostChainId = OsmoChainId },
+			expectedError: "does not match the registered host zone",
+		},
+		{
+			name:          "missing oracle connection",
+			malleate:      func(msg *types.MsgOnboardHostZone) { msg.OracleConnectionId = "connection-10" },
+			expectedError: "connection-10",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			msg := s.SetupOnboardHostZone()
+			tc.malleate(&msg)
+
+			_, err := s.GetMsgServer().OnboardHostZone(s.Ctx, &msg)
+			s.Require().ErrorContains(err, tc.expectedError)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCreateOnboardingTradeRoute() {
+	msg := s.SetupOnboardHostZone()
+	_, err := s.GetMsgServer().OnboardHostZone(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when onboarding host zone")
+
+	// Before the withdrawal ICA is registered, the route should fail to be created
+	s.App.StakeibcKeeper.CreateOnboardingTradeRoute(s.Ctx, HostChainId)
+	_, found := s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, RewardDenom, Atom)
+	s.Require().False(found, "trade route should not be created without the withdrawal ICA")
+
+	// Once the withdrawal ICA is registered, the route should be created
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.WithdrawalIcaAddress = HostICAAddress
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.CreateOnboardingTradeRoute(s.Ctx, HostChainId)
+	tradeRoute, found := s.App.StakeibcKeeper.GetTradeRoute(s.Ctx, RewardDenom, Atom)
+	s.Require().True(found, "trade route should have been created")
+	s.Require().Equal(HostICAAddress, tradeRoute.HostAccount.Address, "trade route host account")
+	s.Require().Equal(types.TradeVenue_STRIDE_AUCTION, tradeRoute.TradeVenue, "trade route venue")
+
+	checklist, err := s.App.StakeibcKeeper.GetHostZoneOnboardingChecklist(s.Ctx, HostChainId)
+	s.Require().NoError(err, "no error expected when building checklist")
+	s.Require().True(checklist.TradeRouteCreated, "trade route created")
+
+	// Calling it again should be a no-op
+	s.App.StakeibcKeeper.CreateOnboardingTradeRoute(s.Ctx, HostChainId)
+	s.Require().Len(s.App.StakeibcKeeper.GetAllTradeRoutes(s.Ctx), 1, "number of trade routes")
+}
+
+func (s *KeeperTestSuite) TestGetHostZoneOnboardingChecklist() {
+	oracleChainId := "osmosis-1"
+	oraclePortId := "icacontroller-osmosis-1.ORACLE"
+	oracleChannelId := "channel-10"
+
+	// Register each of the host zone's ICAs on an open channel
+	hostZone := types.HostZone{
+		ChainId:                        HostChainId,
+		ConnectionId:                   ibctesting.FirstConnectionID,
+		DelegationIcaAddress:           "delegation",
+		FeeIcaAddress:                  "fee",
+		WithdrawalIcaAddress:           "withdrawal",
+		RedemptionIcaAddress:           "redemption",
+		CommunityPoolDepositIcaAddress: "community-pool-deposit",
+		CommunityPoolReturnIcaAddress:  "community-pool-return",
+		Validators: []*types.Validator{
+			{Address: "val1", SharesToTokensRate: sdkmath.LegacyOneDec()},
+			{Address: "val2", SharesToTokensRate: sdkmath.LegacyMustNewDecFromStr("0.99")},
+		},
+	}
+	for i, icaType := range keeper.HostZoneIcaAccountTypes {
+		owner := types.FormatHostZoneICAOwner(HostChainId, icaType)
+		address := keeper.GetHostZoneIcaAddress(hostZone, icaType)
+		s.MockICAChannel(ibctesting.FirstConnectionID, fmt.Sprintf("channel-%d", i), owner, address)
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Create the trade route and an instantiated oracle on an open channel
+	s.App.StakeibcKeeper.SetHostZoneOnboarding(s.Ctx, types.HostZoneOnboarding{
+		ChainId:       HostChainId,
+		TradeRoute:    &types.MsgCreateTradeRoute{RewardDenomOnReward: RewardDenom, HostDenomOnHost: Atom},
+		OracleChainId: oracleChainId,
+	})
+	s.App.StakeibcKeeper.SetTradeRoute(s.Ctx, types.TradeRoute{
+		RewardDenomOnRewardZone: RewardDenom,
+		HostDenomOnHostZone:     Atom,
+	})
+
+	oracle := icaoracletypes.Oracle{
+		ChainId:         oracleChainId,
+		PortId:          oraclePortId,
+		ChannelId:       oracleChannelId,
+		ContractAddress: "contract",
+	}
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracle)
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, oraclePortId, oracleChannelId, channeltypes.Channel{State: channeltypes.OPEN})
+
+	// Every step has completed
+	checklist, err := s.App.StakeibcKeeper.GetHostZoneOnboardingChecklist(s.Ctx, HostChainId)
+	s.Require().NoError(err, "no error expected when building checklist")
+	for _, icaStatus := range checklist.IcaChannels {
+		s.Require().True(icaStatus.ChannelOpen, "%s channel should be open", icaStatus.IcaType)
+		s.Require().NotEmpty(icaStatus.Address, "%s address", icaStatus.IcaType)
+	}
+	s.Require().Equal(uint64(2), checklist.NumValidatorsQueried, "number of validators queried")
+	s.Require().True(checklist.TradeRouteCreated, "trade route created")
+	s.Require().True(checklist.OracleChannelOpen, "oracle channel open")
+	s.Require().True(checklist.OracleInstantiated, "oracle instantiated")
+	s.Require().True(checklist.Complete, "checklist complete")
+
+	// If the oracle has not been instantiated, the checklist is incomplete
+	oracle.ContractAddress = ""
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracle)
+
+	checklist, err = s.App.StakeibcKeeper.GetHostZoneOnboardingChecklist(s.Ctx, HostChainId)
+	s.Require().NoError(err, "no error expected when building checklist")
+	s.Require().False(checklist.OracleInstantiated, "oracle instantiated")
+	s.Require().False(checklist.Complete, "checklist complete")
+
+	// If a validator's ICQ has not returned, the checklist is incomplete
+	oracle.ContractAddress = "contract"
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracle)
+
+	hostZone.Validators = append(hostZone.Validators, &types.Validator{Address: "val3"})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	checklist, err = s.App.StakeibcKeeper.GetHostZoneOnboardingChecklist(s.Ctx, HostChainId)
+	s.Require().NoError(err, "no error expected when building checklist")
+	s.Require().Equal(uint64(3), checklist.NumValidators, "number of validators")
+	s.Require().Equal(uint64(2), checklist.NumValidatorsQueried, "number of validators queried")
+	s.Require().False(checklist.Complete, "checklist complete")
+
+	// Host zone not found
+	_, err = s.App.StakeibcKeeper.GetHostZoneOnboardingChecklist(s.Ctx, "missing")
+	s.Require().ErrorContains(err, "host zone missing not found")
+}
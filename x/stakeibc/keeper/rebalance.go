@@ -14,7 +14,6 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/Stride-Labs/stride/v33/utils"
-	epochstypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
 	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 )
 
@@ -25,31 +24,48 @@ type RebalanceValidatorDelegationChange struct {
 	Delta            sdkmath.Int
 }
 
+// Constraints on which redelegations can be submitted, derived from the host's redelegation limits
+//   - LockedValidators cannot be redelegated away from, since they are the destination
+//     of a redelegation that has not yet matured (i.e. a transitive redelegation), or
+//     since they have a delegation change that has not yet been acknowledged
+//   - PairEntryCounts stores the number of outstanding redelegations between each
+//     source and destination validator, keyed by "{src}/{dst}"
+//   - MinRedelegationAmount is the smallest redelegation worth using one of the host's
+//     limited redelegation entries on
+type RedelegationConstraints struct {
+	LockedValidators      map[string]bool
+	PairEntryCounts       map[string]int
+	MinRedelegationAmount sdkmath.Int
+}
+
+// Returns a constraint set that allows all redelegations
+func NewUnconstrainedRedelegations() RedelegationConstraints {
+	return RedelegationConstraints{
+		LockedValidators:      map[string]bool{},
+		PairEntryCounts:       map[string]int{},
+		MinRedelegationAmount: sdkmath.ZeroInt(),
+	}
+}
+
+// Returns true if the host will reject another redelegation between the two validators
+func (c RedelegationConstraints) IsPairAtCapacity(srcValidator, dstValidator string) bool {
+	return c.PairEntryCounts[srcValidator+"/"+dstValidator] >= types.MaxRedelegationEntries
+}
+
 // Iterate each active host zone and issues redelegation messages to rebalance each
 // validator's stake according to their weights
 //
 // This is required when accepting LSM LiquidStakes as the distribution of stake
 // from the LSM Tokens will be inconsistend with the host zone's validator set
 //
-// Note: this cannot be run more than once in a single unbonding period
+// The host only allows redelegating away from a validator once all redelegations
+// _to_ that validator have matured, so rather than waiting a full unbonding period
+// between rebalances, each day we submit the largest rebalance that does not conflict
+// with the redelegations that are still in flight
+// Redelegations below the host zone's minimum rebalance amount are skipped so that
+// minor weight drift does not use up the host's redelegation entries
 func (k Keeper) RebalanceAllHostZones(ctx sdk.Context) {
-	dayEpoch, found := k.GetEpochTracker(ctx, epochstypes.DAY_EPOCH)
-	if !found {
-		k.Logger(ctx).Error("Unable to get day epoch tracker")
-		return
-	}
-
 	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
-		// We add 1 to the UnbondingPeriod to avoid any race conditions
-		// In particular, you can only rebalance _away_ from a validator once per UnbondingPeriod
-		// Roughly half the time, a rebalance message will get sent a few seconds _before_
-		// the last rebalance fully completed. By adding an extra day, we ensure that
-		// all rebalances are completed before initiating any new ones
-		if dayEpoch.EpochNumber%(hostZone.UnbondingPeriod+1) != 0 {
-			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
-				"Host does not rebalance this epoch (Unbonding Period: %d, Epoch: %d)", hostZone.UnbondingPeriod, dayEpoch.EpochNumber))
-			continue
-		}
 		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Rebalancing delegations"))
 
 		if err := k.RebalanceDelegationsForHostZone(ctx, hostZone.ChainId); err != nil {
@@ -71,14 +87,14 @@ func (k Keeper) RebalanceDelegationsForHostZone(ctx sdk.Context, chainId string)
 		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", chainId)
 	}
 
-	// Get the difference between the actual and expected validator delegations
-	valDeltaList, err := k.GetValidatorDelegationDifferences(ctx, hostZone)
+	// Clear out any redelegations that have since matured so they no longer constrain the rebalance
+	k.PruneMaturedRedelegations(ctx, chainId)
+
+	msgs, rebalancings, _, err := k.GetRebalancePlan(ctx, hostZone)
 	if err != nil {
-		return errorsmod.Wrapf(err, "unable to get validator deltas for host zone %s", chainId)
+		return err
 	}
 
-	msgs, rebalancings := k.GetRebalanceICAMessages(hostZone, valDeltaList)
-
 	for start := 0; start < len(msgs); start += RebalanceIcaBatchSize {
 		end := start + RebalanceIcaBatchSize
 		if end > len(msgs) {
@@ -126,12 +142,83 @@ func (k Keeper) RebalanceDelegationsForHostZone(ctx sdk.Context, chainId string)
 	return nil
 }
 
+// Builds the redelegations that would be submitted if the host zone was rebalanced now,
+// taking into account the in-flight redelegations that the host would conflict with
+// Returns the ICA messages, the callback data, and the constraints used to build the plan
+func (k Keeper) GetRebalancePlan(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+) (msgs []proto.Message, rebalancings []*types.Rebalancing, constraints RedelegationConstraints, err error) {
+	// Get the difference between the actual and expected validator delegations
+	valDeltaList, err := k.GetValidatorDelegationDifferences(ctx, hostZone)
+	if err != nil {
+		return nil, nil, constraints, errorsmod.Wrapf(err, "unable to get validator deltas for host zone %s", hostZone.ChainId)
+	}
+
+	constraints = k.GetRedelegationConstraints(ctx, hostZone)
+	msgs, rebalancings = k.GetConstrainedRebalanceICAMessages(hostZone, valDeltaList, constraints)
+
+	return msgs, rebalancings, constraints, nil
+}
+
+// Builds the redelegation constraints for a host zone from the redelegations that have not
+// yet matured, any validators with unacknowledged delegation changes, and the host zone's
+// minimum rebalance amount
+func (k Keeper) GetRedelegationConstraints(ctx sdk.Context, hostZone types.HostZone) RedelegationConstraints {
+	constraints := NewUnconstrainedRedelegations()
+
+	totalDelegations := sdkmath.ZeroInt()
+	for _, validator := range hostZone.Validators {
+		if validator.DelegationChangesInProgress > 0 {
+			constraints.LockedValidators[validator.Address] = true
+		}
+		if !validator.Delegation.IsNil() {
+			totalDelegations = totalDelegations.Add(validator.Delegation)
+		}
+	}
+	constraints.MinRedelegationAmount = hostZone.GetMinRebalanceAmount(totalDelegations)
+
+	blockTime := utils.IntToUint(ctx.BlockTime().UnixNano())
+	for _, redelegation := range k.GetInFlightRedelegationsForHostZone(ctx, hostZone.ChainId) {
+		activeEntries := 0
+		for _, entry := range redelegation.Entries {
+			if entry.CompletionTime > blockTime {
+				activeEntries++
+			}
+		}
+		if activeEntries == 0 {
+			continue
+		}
+
+		constraints.LockedValidators[redelegation.DstValidator] = true
+		constraints.PairEntryCounts[redelegation.SrcValidator+"/"+redelegation.DstValidator] = activeEntries
+	}
+
+	return constraints
+}
+
 // Given a list of target delegation changes, builds the individual re-delegation messages by redelegating
 // from surplus validators to deficit validators
 // Returns the list of messages and the callback data for the ICA
 func (k Keeper) GetRebalanceICAMessages(
 	hostZone types.HostZone,
 	validatorDeltas []RebalanceValidatorDelegationChange,
+) (msgs []proto.Message, rebalancings []*types.Rebalancing) {
+	return k.GetConstrainedRebalanceICAMessages(hostZone, validatorDeltas, NewUnconstrainedRedelegations())
+}
+
+// Builds the re-delegation messages from surplus validators to deficit validators, skipping
+// any redelegation that the host would reject given the redelegations already in flight
+//   - Locked validators are never used as a source
+//   - Validator pairs that are at the host's max entries are skipped, and the surplus
+//     validator is paired with the next deficit validator instead
+//   - Redelegations below the minimum amount are skipped
+//
+// Returns the list of messages and the callback data for the ICA
+func (k Keeper) GetConstrainedRebalanceICAMessages(
+	hostZone types.HostZone,
+	validatorDeltas []RebalanceValidatorDelegationChange,
+	constraints RedelegationConstraints,
 ) (msgs []proto.Message, rebalancings []*types.Rebalancing) {
 	// Sort the list of delegation changes by the size of the change
 	// Sort descending so the surplus validators appear first
@@ -144,72 +231,71 @@ func (k Keeper) GetRebalanceICAMessages(
 	}
 	sort.SliceStable(validatorDeltas, lessFunc)
 
-	// Pair surplus and deficit validators, with a redelegation from the surplus
-	// validator to the deficit one
-	// The list is sorted with the surplus validators (who should lose stake) at index 0
-	//   and the deficit validators (who should gain stake) at index N-1
+	// Split the sorted list into surplus validators (who should lose stake), ordered from largest
+	// surplus to smallest, and deficit validators (who should gain stake), ordered from largest
+	// deficit to smallest
 	// The surplus validator's have a positive delta and the deficit validators have a negative delta
-	surplusIndex := 0
-	deficitIndex := len(validatorDeltas) - 1
-	for surplusIndex <= deficitIndex {
-		// surplus validator delta is positive, deficit validator delta is negative
-		deficitValidator := validatorDeltas[deficitIndex]
-		surplusValidator := validatorDeltas[surplusIndex]
-
-		// If the indicies flipped, or either delta is 0, we're done rebalancing
-		if surplusIndex > deficitIndex || deficitValidator.Delta.IsZero() || surplusValidator.Delta.IsZero() {
-			break
+	surplusValidators := []RebalanceValidatorDelegationChange{}
+	for _, validatorDelta := range validatorDeltas {
+		if validatorDelta.Delta.IsPositive() && !constraints.LockedValidators[validatorDelta.ValidatorAddress] {
+			surplusValidators = append(surplusValidators, validatorDelta)
 		}
-
-		var redelegationAmount sdkmath.Int
-		if deficitValidator.Delta.Abs().GT(surplusValidator.Delta.Abs()) {
-			// If the deficit validator needs more stake than the surplus validator has to give,
-			// transfer the full surplus to deficit validator
-			redelegationAmount = surplusValidator.Delta.Abs()
-
-			// Update the deficit validator, and zero out the surplus validator
-			validatorDeltas[deficitIndex].Delta = deficitValidator.Delta.Add(redelegationAmount)
-			validatorDeltas[surplusIndex].Delta = sdkmath.ZeroInt()
-			surplusIndex += 1
-
-		} else if surplusValidator.Delta.Abs().GT(deficitValidator.Delta.Abs()) {
-			// If one validator's deficit is less than the other validator's surplus,
-			// move only enough of the surplus to cover the shortage
-			redelegationAmount = deficitValidator.Delta.Abs()
-
-			// Update the surplus validator, and zero out the deficit validator
-			validatorDeltas[surplusIndex].Delta = surplusValidator.Delta.Sub(redelegationAmount)
-			validatorDeltas[deficitIndex].Delta = sdkmath.ZeroInt()
-			deficitIndex -= 1
-
-		} else {
-			// if one validator's surplus is equal to the other validator's deficit,
-			// we'll transfer that amount and both validators will now be balanced
-			redelegationAmount = deficitValidator.Delta.Abs()
-
-			validatorDeltas[surplusIndex].Delta = sdkmath.ZeroInt()
-			validatorDeltas[deficitIndex].Delta = sdkmath.ZeroInt()
-
-			surplusIndex += 1
-			deficitIndex -= 1
+	}
+	deficitValidators := []RebalanceValidatorDelegationChange{}
+	for i := len(validatorDeltas) - 1; i >= 0; i-- {
+		if validatorDeltas[i].Delta.IsNegative() {
+			deficitValidators = append(deficitValidators, validatorDeltas[i])
 		}
+	}
+
+	// Pair surplus and deficit validators, with a redelegation from the surplus
+	// validator to the deficit one
+	// Each surplus validator gives to the largest remaining deficits until either its
+	// surplus runs out or there are no deficit validators that it can redelegate to
+	for surplusIndex := range surplusValidators {
+		for deficitIndex := range deficitValidators {
+			surplusValidator := surplusValidators[surplusIndex]
+			deficitValidator := deficitValidators[deficitIndex]
+
+			// If the surplus has been used up, move onto the next surplus validator
+			if surplusValidator.Delta.IsZero() {
+				break
+			}
+			// Skip deficit validators that have already been filled
+			if deficitValidator.Delta.IsZero() {
+				continue
+			}
+
+			// We always send from the surplus validator to the deficit validator
+			srcValidator := surplusValidator.ValidatorAddress
+			dstValidator := deficitValidator.ValidatorAddress
+
+			// Skip validator pairs that the host will not accept another redelegation for
+			if constraints.IsPairAtCapacity(srcValidator, dstValidator) {
+				continue
+			}
 
-		// Append the new Redelegation message and Rebalancing struct for the callback
-		// We always send from the surplus validator to the deficit validator
-		srcValidator := surplusValidator.ValidatorAddress
-		dstValidator := deficitValidator.ValidatorAddress
-
-		msgs = append(msgs, &stakingtypes.MsgBeginRedelegate{
-			DelegatorAddress:    hostZone.DelegationIcaAddress,
-			ValidatorSrcAddress: srcValidator,
-			ValidatorDstAddress: dstValidator,
-			Amount:              sdk.NewCoin(hostZone.HostDenom, redelegationAmount),
-		})
-		rebalancings = append(rebalancings, &types.Rebalancing{
-			SrcValidator: srcValidator,
-			DstValidator: dstValidator,
-			Amt:          redelegationAmount,
-		})
+			// Transfer the smaller of the surplus and deficit, skipping dust redelegations
+			redelegationAmount := sdkmath.MinInt(surplusValidator.Delta, deficitValidator.Delta.Abs())
+			if redelegationAmount.LT(constraints.MinRedelegationAmount) {
+				continue
+			}
+			surplusValidators[surplusIndex].Delta = surplusValidator.Delta.Sub(redelegationAmount)
+			deficitValidators[deficitIndex].Delta = deficitValidator.Delta.Add(redelegationAmount)
+
+			// Append the new Redelegation message and Rebalancing struct for the callback
+			msgs = append(msgs, &stakingtypes.MsgBeginRedelegate{
+				DelegatorAddress:    hostZone.DelegationIcaAddress,
+				ValidatorSrcAddress: srcValidator,
+				ValidatorDstAddress: dstValidator,
+				Amount:              sdk.NewCoin(hostZone.HostDenom, redelegationAmount),
+			})
+			rebalancings = append(rebalancings, &types.Rebalancing{
+				SrcValidator: srcValidator,
+				DstValidator: dstValidator,
+				Amt:          redelegationAmount,
+			})
+		}
 	}
 
 	return msgs, rebalancings
@@ -331,6 +331,223 @@ func (s *KeeperTestSuite) TestGetRebalanceICAMessages_OddNumberValidators() {
 	s.checkRebalanceICAMessages(validatorDeltas, expectedRebalancings)
 }
 
+func (s *KeeperTestSuite) TestGetConstrainedRebalanceICAMessages() {
+	validatorDeltas := []keeper.RebalanceValidatorDelegationChange{
+		// Overweight validators - they should lose some of their stake
+		{ValidatorAddress: "val1", Delta: sdkmath.NewInt(20)}, // locked, cannot give
+		{ValidatorAddress: "val2", Delta: sdkmath.NewInt(10)}, // at capacity with val5, 10 to val4
+		{ValidatorAddress: "val3", Delta: sdkmath.NewInt(5)},  // 5 to val5
+
+		// Underweight validators - they should gain stake
+		{ValidatorAddress: "val4", Delta: sdkmath.NewInt(-15)}, // 10 from val2
+		{ValidatorAddress: "val5", Delta: sdkmath.NewInt(-20)}, // 5 from val3
+	}
+
+	constraints := keeper.NewUnconstrainedRedelegations()
+	constraints.LockedValidators["val1"] = true
+	constraints.PairEntryCounts["val2/val5"] = types.MaxRedelegationEntries
+
+	hostZone := types.HostZone{
+		HostDenom:            Atom,
+		DelegationIcaAddress: "cosmos_DELEGATION",
+	}
+	_, actualRebalancings := s.App.StakeibcKeeper.GetConstrainedRebalanceICAMessages(hostZone, validatorDeltas, constraints)
+
+	expectedRebalancings := []types.Rebalancing{
+		{SrcValidator: "val2", DstValidator: "val4", Amt: sdkmath.NewInt(10)},
+		{SrcValidator: "val3", DstValidator: "val5", Amt: sdkmath.NewInt(5)},
+	}
+	s.Require().Len(actualRebalancings, len(expectedRebalancings), "length of rebalancings")
+	for i, expected := range expectedRebalancings {
+		s.Require().Equal(expected.SrcValidator, actualRebalancings[i].SrcValidator, "rebalancing src validator, index %d", i)
+		s.Require().Equal(expected.DstValidator, actualRebalancings[i].DstValidator, "rebalancing dst validator, index %d", i)
+		s.Require().Equal(expected.Amt.Int64(), actualRebalancings[i].Amt.Int64(), "rebalancing amount, index %d", i)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetRedelegationConstraints() {
+	blockTime := uint64(s.Ctx.BlockTime().UnixNano())
+
+	hostZone := types.HostZone{
+		ChainId: HostChainId,
+		Validators: []*types.Validator{
+			{Address: "val1"},
+			{Address: "val2", DelegationChangesInProgress: 1},
+			{Address: "val3"},
+			{Address: "val4"},
+		},
+	}
+
+	// val1 -> val3 has one active and one matured entry
+	s.App.StakeibcKeeper.SetInFlightRedelegation(s.Ctx, types.InFlightRedelegation{
+		ChainId:      HostChainId,
+		SrcValidator: "val1",
+		DstValidator: "val3",
+		Entries: []types.InFlightRedelegationEntry{
+			{Amount: sdkmath.NewInt(10), CompletionTime: blockTime - 1},
+			{Amount: sdkmath.NewInt(10), CompletionTime: blockTime + 1},
+		},
+	})
+	// val1 -> val4 has only matured entries, so val4 should not be locked
+	s.App.StakeibcKeeper.SetInFlightRedelegation(s.Ctx, types.InFlightRedelegation{
+		ChainId:      HostChainId,
+		SrcValidator: "val1",
+		DstValidator: "val4",
+		Entries: []types.InFlightRedelegationEntry{
+			{Amount: sdkmath.NewInt(10), CompletionTime: blockTime},
+		},
+	})
+	// A redelegation on a different host should be ignored
+	s.App.StakeibcKeeper.SetInFlightRedelegation(s.Ctx, types.InFlightRedelegation{
+		ChainId:      OsmoChainId,
+		SrcValidator: "val3",
+		DstValidator: "val1",
+		Entries: []types.InFlightRedelegationEntry{
+			{Amount: sdkmath.NewInt(10), CompletionTime: blockTime + 1},
+		},
+	})
+
+	constraints := s.App.StakeibcKeeper.GetRedelegationConstraints(s.Ctx, hostZone)
+
+	expectedLocked := map[string]bool{"val2": true, "val3": true}
+	s.Require().Equal(expectedLocked, constraints.LockedValidators, "locked validators")
+	s.Require().Equal(map[string]int{"val1/val3": 1}, constraints.PairEntryCounts, "pair entry counts")
+	s.Require().Zero(constraints.MinRedelegationAmount.Int64(), "min redelegation amount with no delegations")
+}
+
+func (s *KeeperTestSuite) TestGetRedelegationConstraints_MinRedelegationAmount() {
+	hostZone := types.HostZone{
+		ChainId: HostChainId,
+		Validators: []*types.Validator{
+			{Address: "val1", Delegation: sdkmath.NewInt(600_000)},
+			{Address: "val2", Delegation: sdkmath.NewInt(400_000)},
+		},
+	}
+
+	// Without a min rebalance amount, the default rate of the total delegations should be used
+	constraints := s.App.StakeibcKeeper.GetRedelegationConstraints(s.Ctx, hostZone)
+	s.Require().Equal(int64(1000), constraints.MinRedelegationAmount.Int64(), "default min redelegation amount")
+
+	// With a min rebalance amount, it should be used instead
+	hostZone.MinRebalanceAmount = sdkmath.NewInt(5000)
+	constraints = s.App.StakeibcKeeper.GetRedelegationConstraints(s.Ctx, hostZone)
+	s.Require().Equal(int64(5000), constraints.MinRedelegationAmount.Int64(), "host zone min redelegation amount")
+}
+
+func (s *KeeperTestSuite) TestGetConstrainedRebalanceICAMessages_MinRedelegationAmount() {
+	validatorDeltas := []keeper.RebalanceValidatorDelegationChange{
+		// Overweight validators - they should lose some of their stake
+		{ValidatorAddress: "val1", Delta: sdkmath.NewInt(20)}, // 15 to val4, remaining 5 is below the min
+		{ValidatorAddress: "val2", Delta: sdkmath.NewInt(9)},  // below the min, cannot give
+
+		// Underweight validators - they should gain stake
+		{ValidatorAddress: "val3", Delta: sdkmath.NewInt(-14)}, // neither remaining surplus is above the min
+		{ValidatorAddress: "val4", Delta: sdkmath.NewInt(-15)}, // 15 from val1
+	}
+
+	constraints := keeper.NewUnconstrainedRedelegations()
+	constraints.MinRedelegationAmount = sdkmath.NewInt(10)
+
+	hostZone := types.HostZone{
+		HostDenom:            Atom,
+		DelegationIcaAddress: "cosmos_DELEGATION",
+	}
+	_, actualRebalancings := s.App.StakeibcKeeper.GetConstrainedRebalanceICAMessages(hostZone, validatorDeltas, constraints)
+
+	s.Require().Len(actualRebalancings, 1, "length of rebalancings")
+	s.Require().Equal("val1", actualRebalancings[0].SrcValidator, "rebalancing src validator")
+	s.Require().Equal("val4", actualRebalancings[0].DstValidator, "rebalancing dst validator")
+	s.Require().Equal(int64(15), actualRebalancings[0].Amt.Int64(), "rebalancing amount")
+}
+
+func (s *KeeperTestSuite) TestRebalanceDelegationsForHostZone_InFlightRedelegation() {
+	tc := s.SetupTestRebalanceDelegationsForHostZone()
+
+	// Lock val3 by marking it as the destination of an active redelegation
+	// Only val1's surplus should be redelegated
+	s.App.StakeibcKeeper.SetInFlightRedelegation(s.Ctx, types.InFlightRedelegation{
+		ChainId:      HostChainId,
+		SrcValidator: "val2",
+		DstValidator: "val3",
+		Entries: []types.InFlightRedelegationEntry{
+			{Amount: sdkmath.NewInt(100), CompletionTime: uint64(s.Ctx.BlockTime().UnixNano()) + 1},
+		},
+	})
+	// Add a matured redelegation that should be pruned
+	s.App.StakeibcKeeper.SetInFlightRedelegation(s.Ctx, types.InFlightRedelegation{
+		ChainId:      HostChainId,
+		SrcValidator: "val2",
+		DstValidator: "val1",
+		Entries: []types.InFlightRedelegationEntry{
+			{Amount: sdkmath.NewInt(100), CompletionTime: uint64(s.Ctx.BlockTime().UnixNano())},
+		},
+	})
+
+	err := s.App.StakeibcKeeper.RebalanceDelegationsForHostZone(s.Ctx, HostChainId)
+	s.Require().NoError(err, "no error expected with successful rebalancing")
+
+	// Check the callback data only includes the redelegation from val1
+	allCallbackData := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
+	s.Require().Len(allCallbackData, 1, "length of callback data")
+
+	var callbackData types.RebalanceCallback
+	err = proto.Unmarshal(allCallbackData[0].CallbackArgs, &callbackData)
+	s.Require().NoError(err, "no error expected when unmarshalling callback data")
+
+	s.Require().Len(callbackData.Rebalancings, 1, "number of rebalancings")
+	s.Require().Equal("val1", callbackData.Rebalancings[0].SrcValidator, "source validator")
+	s.Require().Equal("val2", callbackData.Rebalancings[0].DstValidator, "destination validator")
+	s.Require().Equal(int64(1000), callbackData.Rebalancings[0].Amt.Int64(), "amount")
+
+	// The matured redelegation should have been removed
+	_, found := s.App.StakeibcKeeper.GetInFlightRedelegation(s.Ctx, HostChainId, "val2", "val1")
+	s.Require().False(found, "matured redelegation should have been pruned")
+	_, found = s.App.StakeibcKeeper.GetInFlightRedelegation(s.Ctx, HostChainId, "val2", "val3")
+	s.Require().True(found, "active redelegation should remain")
+
+	// Confirm the ICA was sent
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.delegationPortID, tc.delegationChannelID)
+	s.Require().True(found, "sequence number not found after ICA")
+	s.Require().Equal(tc.channelStartSequence+1, endSequence, "sequence number should have been incremented from ICA submission")
+}
+
+func (s *KeeperTestSuite) TestQueryRebalancePlan() {
+	tc := s.SetupTestRebalanceDelegationsForHostZone()
+
+	s.App.StakeibcKeeper.SetInFlightRedelegation(s.Ctx, types.InFlightRedelegation{
+		ChainId:      HostChainId,
+		SrcValidator: "val2",
+		DstValidator: "val3",
+		Entries: []types.InFlightRedelegationEntry{
+			{Amount: sdkmath.NewInt(100), CompletionTime: uint64(s.Ctx.BlockTime().UnixNano()) + 1},
+		},
+	})
+
+	resp, err := s.App.StakeibcKeeper.RebalancePlan(s.Ctx, &types.QueryRebalancePlanRequest{ChainId: HostChainId})
+	s.Require().NoError(err, "no error expected when querying rebalance plan")
+
+	s.Require().Len(resp.Rebalancings, 1, "number of rebalancings")
+	s.Require().Equal("val1", resp.Rebalancings[0].SrcValidator, "source validator")
+	s.Require().Equal("val2", resp.Rebalancings[0].DstValidator, "destination validator")
+	s.Require().Equal(int64(1000), resp.Rebalancings[0].Amt.Int64(), "amount")
+	s.Require().Equal([]string{"val3"}, resp.LockedValidators, "locked validators")
+	s.Require().Len(resp.InFlightRedelegations, 1, "in-flight redelegations")
+
+	s.Require().Len(resp.Txs, 1, "number of planned txs")
+	s.Require().Equal(keeper.ICACallbackID_Rebalance, resp.Txs[0].CallbackId, "callback id")
+	s.Require().Len(resp.Txs[0].Msgs, 1, "number of planned msgs")
+	s.Require().Equal("/cosmos.staking.v1beta1.MsgBeginRedelegate", resp.Txs[0].Msgs[0].TypeUrl, "msg type")
+
+	// The query should not submit any ICAs
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.delegationPortID, tc.delegationChannelID)
+	s.Require().True(found, "sequence number not found")
+	s.Require().Equal(tc.channelStartSequence, endSequence, "sequence number should not have changed")
+
+	// Invalid host zone
+	_, err = s.App.StakeibcKeeper.RebalancePlan(s.Ctx, &types.QueryRebalancePlanRequest{ChainId: "fake-chain"})
+	s.Require().ErrorContains(err, "host zone fake-chain not found")
+}
+
 func (s *KeeperTestSuite) TestGetValidatorDelegationDifferences() {
 	hostZone := types.HostZone{
 		ChainId: HostChainId,
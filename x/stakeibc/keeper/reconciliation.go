@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	bankv3types "github.com/cosmos/cosmos-sdk/x/bank/migrations/v3"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	epochstypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	icqtypes "github.com/Stride-Labs/stride/v33/x/interchainquery/types"
+	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Each day epoch, the balance of each of a host zone's accounts is compared against the
+// balance implied by its records:
+//   - Deposit (stride-side): deposit records that have not yet been transferred to the host
+//   - Delegation: deposit records that have been transferred but not yet delegated, plus
+//     unbonded tokens that have not yet been swept to the redemption account
+//   - Redemption: unbonded tokens that have been swept but not yet claimed
+//   - Fee, Withdrawal and Community Pool: nothing, since they're swept every epoch
+//
+// The ICA balances are queried with an ICQ, and the expected balance is computed when the
+// query returns. Since ICAs and transfers may be in flight, small transient discrepancies
+// are expected, and only those above the host zone's threshold emit an event
+
+// Name of the stride-side deposit account's entry in the report
+const DepositReconciliationAccount = "DEPOSIT"
+
+// Stores the latest reconciliation report of a host zone
+func (k Keeper) SetIcaReconciliationReport(ctx sdk.Context, report types.IcaReconciliationReport) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaReconciliationReportKeyPrefix))
+	store.Set([]byte(report.ChainId), k.cdc.MustMarshal(&report))
+}
+
+// Reads the latest reconciliation report of a host zone
+func (k Keeper) GetIcaReconciliationReport(ctx sdk.Context, chainId string) (report types.IcaReconciliationReport, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaReconciliationReportKeyPrefix))
+	bz := store.Get([]byte(chainId))
+	if len(bz) == 0 {
+		return report, false
+	}
+	k.cdc.MustUnmarshal(bz, &report)
+	return report, true
+}
+
+// Returns the latest reconciliation report of each host zone
+func (k Keeper) GetAllIcaReconciliationReports(ctx sdk.Context) (reports []types.IcaReconciliationReport) {
+	reports = []types.IcaReconciliationReport{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaReconciliationReportKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var report types.IcaReconciliationReport
+		k.cdc.MustUnmarshal(iterator.Value(), &report)
+		reports = append(reports, report)
+	}
+
+	return reports
+}
+
+// Removes the reconciliation report of a host zone
+func (k Keeper) RemoveIcaReconciliationReport(ctx sdk.Context, chainId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaReconciliationReportKeyPrefix))
+	store.Delete([]byte(chainId))
+}
+
+// Returns the balance of the stride-side deposit account implied by the host zone's records
+func (k Keeper) GetExpectedDepositBalance(ctx sdk.Context, chainId string) sdkmath.Int {
+	expected := sdkmath.ZeroInt()
+	for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
+		if depositRecord.HostZoneId == chainId && depositRecord.Status == recordstypes.DepositRecord_TRANSFER_QUEUE {
+			expected = expected.Add(depositRecord.Amount)
+		}
+	}
+	return expected
+}
+
+// Returns the balance of an ICA implied by the host zone's records
+func (k Keeper) GetExpectedIcaBalance(ctx sdk.Context, chainId string, icaType types.ICAAccountType) sdkmath.Int {
+	expected := sdkmath.ZeroInt()
+
+	switch icaType {
+	case types.ICAAccountType_DELEGATION:
+		for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
+			if depositRecord.HostZoneId != chainId {
+				continue
+			}
+			if depositRecord.Status == recordstypes.DepositRecord_DELEGATION_QUEUE ||
+				depositRecord.Status == recordstypes.DepositRecord_DELEGATION_IN_PROGRESS {
+				expected = expected.Add(depositRecord.Amount)
+			}
+		}
+		for _, hostZoneUnbonding := range k.GetHostZoneUnbondingsForChain(ctx, chainId) {
+			if hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE ||
+				hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS {
+				expected = expected.Add(hostZoneUnbonding.NativeTokenAmount)
+			}
+		}
+
+	case types.ICAAccountType_REDEMPTION:
+		for _, hostZoneUnbonding := range k.GetHostZoneUnbondingsForChain(ctx, chainId) {
+			if hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_CLAIMABLE && !hostZoneUnbonding.ClaimableNativeTokens.IsNil() {
+				expected = expected.Add(hostZoneUnbonding.ClaimableNativeTokens)
+			}
+		}
+	}
+
+	return expected
+}
+
+// Returns the host zone unbonding records for a host zone across all epochs
+func (k Keeper) GetHostZoneUnbondingsForChain(ctx sdk.Context, chainId string) (hostZoneUnbondings []recordstypes.HostZoneUnbonding) {
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			if hostZoneUnbonding.HostZoneId == chainId {
+				hostZoneUnbondings = append(hostZoneUnbondings, *hostZoneUnbonding)
+			}
+		}
+	}
+	return hostZoneUnbondings
+}
+
+// Records an account's balance on the report entry and emits an event if the discrepancy
+// exceeds the host zone's threshold
+func (k Keeper) ReconcileEntry(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	entry *types.IcaReconciliationEntry,
+	expectedBalance sdkmath.Int,
+	actualBalance sdkmath.Int,
+) {
+	entry.ExpectedBalance = expectedBalance
+	entry.ActualBalance = actualBalance
+	entry.Discrepancy = actualBalance.Sub(expectedBalance)
+	entry.Reconciled = true
+
+	threshold := hostZone.ReconciliationDiscrepancyThreshold
+	if threshold.IsNil() {
+		threshold = sdkmath.ZeroInt()
+	}
+	if entry.Discrepancy.Abs().GT(threshold) {
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Balance discrepancy of %v on %s account (expected: %v, actual: %v)",
+			entry.Discrepancy, entry.Account, expectedBalance, actualBalance))
+		EmitIcaBalanceDiscrepancyEvent(ctx, hostZone, *entry)
+	}
+}
+
+// Starts a new reconciliation report for a host zone
+// The deposit account is reconciled immediately, and an ICQ is submitted for each registered ICA
+func (k Keeper) ReconcileHostZoneBalances(ctx sdk.Context, hostZone types.HostZone, epochNumber uint64) error {
+	report := types.IcaReconciliationReport{
+		ChainId:     hostZone.ChainId,
+		EpochNumber: epochNumber,
+		Time:        ctx.BlockTime(),
+		Entries:     []types.IcaReconciliationEntry{},
+	}
+
+	// The deposit account is on Stride, so it can be reconciled without a query
+	if hostZone.DepositAddress != "" {
+		depositAddress, err := sdk.AccAddressFromBech32(hostZone.DepositAddress)
+		if err != nil {
+			return errorsmod.Wrapf(err, "invalid deposit address")
+		}
+		depositEntry := types.IcaReconciliationEntry{
+			Account: DepositReconciliationAccount,
+			Address: hostZone.DepositAddress,
+		}
+		actualBalance := k.bankKeeper.GetBalance(ctx, depositAddress, hostZone.IbcDenom).Amount
+		expectedBalance := k.GetExpectedDepositBalance(ctx, hostZone.ChainId)
+		k.ReconcileEntry(ctx, hostZone, &depositEntry, expectedBalance, actualBalance)
+		report.Entries = append(report.Entries, depositEntry)
+	}
+
+	// Add a pending entry for each registered ICA and submit the balance query
+	for _, icaType := range HostZoneIcaAccountTypes {
+		icaAddress := GetHostZoneIcaAddress(hostZone, icaType)
+		if icaAddress == "" {
+			continue
+		}
+		if err := k.SubmitIcaReconciliationBalanceQuery(ctx, hostZone, icaType, icaAddress, epochNumber); err != nil {
+			return errorsmod.Wrapf(err, "unable to submit %s balance query", icaType)
+		}
+		report.Entries = append(report.Entries, types.IcaReconciliationEntry{
+			Account:         icaType.String(),
+			Address:         icaAddress,
+			ExpectedBalance: sdkmath.ZeroInt(),
+			ActualBalance:   sdkmath.ZeroInt(),
+			Discrepancy:     sdkmath.ZeroInt(),
+		})
+	}
+
+	k.SetIcaReconciliationReport(ctx, report)
+	return nil
+}
+
+// Submits an ICQ for the native balance of one of a host zone's ICAs
+func (k Keeper) SubmitIcaReconciliationBalanceQuery(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	icaType types.ICAAccountType,
+	icaAddress string,
+	epochNumber uint64,
+) error {
+	_, addressBz, err := bech32.DecodeAndConvert(icaAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid %s account address (%s), could not decode", icaType, icaAddress)
+	}
+	queryData := append(bankv3types.CreateAccountBalancesPrefix(addressBz), []byte(hostZone.HostDenom)...)
+
+	// Timeout the query at the end of the day epoch, before the next report is started
+	dayEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.DAY_EPOCH)
+	if !found {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch %s not found", epochstypes.DAY_EPOCH)
+	}
+	timeout := time.Unix(0, utils.UintToInt(dayEpochTracker.NextEpochStartTime))
+	timeoutDuration := timeout.Sub(ctx.BlockTime())
+
+	callbackData := types.IcaReconciliationCallback{
+		IcaType:     icaType,
+		EpochNumber: epochNumber,
+	}
+	callbackDataBz, err := proto.Marshal(&callbackData)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to marshal reconciliation callback data")
+	}
+
+	query := icqtypes.Query{
+		ChainId:         hostZone.ChainId,
+		ConnectionId:    hostZone.ConnectionId,
+		QueryType:       icqtypes.BANK_STORE_QUERY_WITH_PROOF,
+		RequestData:     queryData,
+		CallbackModule:  types.ModuleName,
+		CallbackId:      ICQCallbackID_IcaReconciliationBalance,
+		CallbackData:    callbackDataBz,
+		TimeoutDuration: timeoutDuration,
+		TimeoutPolicy:   icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	}
+	return k.InterchainQueryKeeper.SubmitICQRequest(ctx, query, false)
+}
+
+// Epochly trigger to reconcile the balances of each active host zone
+func (k Keeper) ReconcileAllHostZoneBalances(ctx sdk.Context, epochNumber uint64) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.ReconcileHostZoneBalances(ctx, hostZone, epochNumber)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to reconcile balances for %s: %s", hostZone.ChainId, err))
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"time"
+
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Stores deposit and unbonding records across each status, for the host zone and another chain
+func (s *KeeperTestSuite) SetupReconciliationRecords() {
+	depositRecords := []recordtypes.DepositRecord{
+		{Id: 1, HostZoneId: HostChainId, Amount: sdkmath.NewInt(1), Status: recordtypes.DepositRecord_TRANSFER_QUEUE},
+		{Id: 2, HostZoneId: HostChainId, Amount: sdkmath.NewInt(2), Status: recordtypes.DepositRecord_TRANSFER_QUEUE},
+		{Id: 3, HostZoneId: HostChainId, Amount: sdkmath.NewInt(4), Status: recordtypes.DepositRecord_TRANSFER_IN_PROGRESS},
+		{Id: 4, HostZoneId: HostChainId, Amount: sdkmath.NewInt(8), Status: recordtypes.DepositRecord_DELEGATION_QUEUE},
+		{Id: 5, HostZoneId: HostChainId, Amount: sdkmath.NewInt(16), Status: recordtypes.DepositRecord_DELEGATION_IN_PROGRESS},
+		{Id: 6, HostZoneId: "different", Amount: sdkmath.NewInt(32), Status: recordtypes.DepositRecord_TRANSFER_QUEUE},
+		{Id: 7, HostZoneId: "different", Amount: sdkmath.NewInt(64), Status: recordtypes.DepositRecord_DELEGATION_QUEUE},
+	}
+	for _, depositRecord := range depositRecords {
+		s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+	}
+
+	epochUnbondingRecords := []recordtypes.EpochUnbondingRecord{
+		{
+			EpochNumber: 1,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{HostZoneId: HostChainId, NativeTokenAmount: sdkmath.NewInt(100), Status: recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE},
+				{HostZoneId: "different", NativeTokenAmount: sdkmath.NewInt(200), Status: recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE},
+			},
+		},
+		{
+			EpochNumber: 2,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{HostZoneId: HostChainId, NativeTokenAmount: sdkmath.NewInt(400), Status: recordtypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS},
+			},
+		},
+		{
+			EpochNumber: 3,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{
+					HostZoneId:            HostChainId,
+					NativeTokenAmount:     sdkmath.NewInt(800),
+					ClaimableNativeTokens: sdkmath.NewInt(700),
+					Status:                recordtypes.HostZoneUnbonding_CLAIMABLE,
+				},
+			},
+		},
+		{
+			EpochNumber: 4,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{HostZoneId: HostChainId, NativeTokenAmount: sdkmath.NewInt(1600), Status: recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS},
+			},
+		},
+	}
+	for _, epochUnbondingRecord := range epochUnbondingRecords {
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, epochUnbondingRecord)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetExpectedBalances() {
+	s.SetupReconciliationRecords()
+
+	// Deposit: 1 + 2 (transfer queue)
+	depositBalance := s.App.StakeibcKeeper.GetExpectedDepositBalance(s.Ctx, HostChainId)
+	s.Require().Equal(int64(3), depositBalance.Int64(), "expected deposit balance")
+
+	// Delegation: 8 + 16 (delegation queue and in progress) + 100 + 400 (exit transfer queue and in progress)
+	delegationBalance := s.App.StakeibcKeeper.GetExpectedIcaBalance(s.Ctx, HostChainId, types.ICAAccountType_DELEGATION)
+	s.Require().Equal(int64(524), delegationBalance.Int64(), "expected delegation balance")
+
+	// Redemption: 700 (claimable)
+	redemptionBalance := s.App.StakeibcKeeper.GetExpectedIcaBalance(s.Ctx, HostChainId, types.ICAAccountType_REDEMPTION)
+	s.Require().Equal(int64(700), redemptionBalance.Int64(), "expected redemption balance")
+
+	// Swept accounts should be empty
+	feeBalance := s.App.StakeibcKeeper.GetExpectedIcaBalance(s.Ctx, HostChainId, types.ICAAccountType_FEE)
+	s.Require().Zero(feeBalance.Int64(), "expected fee balance")
+}
+
+func (s *KeeperTestSuite) TestReconcileEntry() {
+	hostZone := types.HostZone{ChainId: HostChainId, ReconciliationDiscrepancyThreshold: sdkmath.NewInt(10)}
+
+	// Discrepancy within the threshold - no event
+	entry := types.IcaReconciliationEntry{Account: "FEE", Address: "fee-address"}
+	s.App.StakeibcKeeper.ReconcileEntry(s.Ctx, hostZone, &entry, sdkmath.NewInt(100), sdkmath.NewInt(90))
+	s.Require().True(entry.Reconciled, "entry should be reconciled")
+	s.Require().Equal(int64(-10), entry.Discrepancy.Int64(), "discrepancy within threshold")
+	s.CheckEventTypeNotEmitted(types.EventTypeIcaBalanceDiscrepancy)
+
+	// Discrepancy above the threshold - event emitted
+	s.App.StakeibcKeeper.ReconcileEntry(s.Ctx, hostZone, &entry, sdkmath.NewInt(100), sdkmath.NewInt(111))
+	s.Require().Equal(int64(11), entry.Discrepancy.Int64(), "discrepancy above threshold")
+	s.CheckEventValueEmitted(types.EventTypeIcaBalanceDiscrepancy, types.AttributeKeyDiscrepancy, "11")
+	s.CheckEventValueEmitted(types.EventTypeIcaBalanceDiscrepancy, types.AttributeKeyAccount, "FEE")
+
+	// Without a threshold, any discrepancy should emit an event
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	hostZone.ReconciliationDiscrepancyThreshold = sdkmath.Int{}
+	s.App.StakeibcKeeper.ReconcileEntry(s.Ctx, hostZone, &entry, sdkmath.NewInt(100), sdkmath.NewInt(101))
+	s.CheckEventValueEmitted(types.EventTypeIcaBalanceDiscrepancy, types.AttributeKeyDiscrepancy, "1")
+}
+
+type ReconcileHostZoneBalancesTestCase struct {
+	HostZone          types.HostZone
+	EpochNumber       uint64
+	DelegationAddress string
+	FeeAddress        string
+}
+
+func (s *KeeperTestSuite) SetupReconcileHostZoneBalances() ReconcileHostZoneBalancesTestCase {
+	s.CreateTransferChannel(HostChainId)
+	s.SetupReconciliationRecords()
+	s.CreateEpochForICATimeout(epochtypes.DAY_EPOCH, time.Hour)
+
+	// Only the delegation and fee accounts are registered
+	depositAddress := types.NewHostZoneDepositAddress(HostChainId)
+	hostZone := types.HostZone{
+		ChainId:                            HostChainId,
+		HostDenom:                          Atom,
+		IbcDenom:                           IbcAtom,
+		ConnectionId:                       ibctesting.FirstConnectionID,
+		DepositAddress:                     depositAddress.String(),
+		DelegationIcaAddress:               s.TestAccs[1].String(),
+		FeeIcaAddress:                      s.TestAccs[2].String(),
+		ReconciliationDiscrepancyThreshold: sdkmath.ZeroInt(),
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Fund the deposit account with 2 more tokens than expected
+	s.FundAccount(depositAddress, sdk.NewInt64Coin(IbcAtom, 5))
+
+	return ReconcileHostZoneBalancesTestCase{
+		HostZone:          hostZone,
+		EpochNumber:       10,
+		DelegationAddress: hostZone.DelegationIcaAddress,
+		FeeAddress:        hostZone.FeeIcaAddress,
+	}
+}
+
+func (s *KeeperTestSuite) TestReconcileHostZoneBalances_Successful() {
+	tc := s.SetupReconcileHostZoneBalances()
+
+	err := s.App.StakeibcKeeper.ReconcileHostZoneBalances(s.Ctx, tc.HostZone, tc.EpochNumber)
+	s.Require().NoError(err, "no error expected when reconciling balances")
+
+	// Confirm a query was submitted for each registered ICA
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 2, "one query per ICA")
+	for _, query := range queries {
+		s.Require().Equal(keeper.ICQCallbackID_IcaReconciliationBalance, query.CallbackId, "query callback ID")
+		s.Require().Equal(HostChainId, query.ChainId, "query chain ID")
+		s.Require().Equal(time.Hour, query.TimeoutDuration, "query timeout")
+	}
+
+	// The deposit entry should be reconciled immediately, and the ICA entries should be pending
+	report, found := s.App.StakeibcKeeper.GetIcaReconciliationReport(s.Ctx, HostChainId)
+	s.Require().True(found, "report should have been created")
+	s.Require().Equal(tc.EpochNumber, report.EpochNumber, "report epoch")
+	s.Require().Len(report.Entries, 3, "report entries")
+
+	depositEntry := report.Entries[0]
+	s.Require().Equal(keeper.DepositReconciliationAccount, depositEntry.Account, "deposit entry account")
+	s.Require().True(depositEntry.Reconciled, "deposit entry reconciled")
+	s.Require().Equal(int64(3), depositEntry.ExpectedBalance.Int64(), "deposit expected balance")
+	s.Require().Equal(int64(5), depositEntry.ActualBalance.Int64(), "deposit actual balance")
+	s.Require().Equal(int64(2), depositEntry.Discrepancy.Int64(), "deposit discrepancy")
+	s.CheckEventValueEmitted(types.EventTypeIcaBalanceDiscrepancy, types.AttributeKeyAccount, keeper.DepositReconciliationAccount)
+
+	s.Require().Equal("DELEGATION", report.Entries[1].Account, "delegation entry account")
+	s.Require().Equal(tc.DelegationAddress, report.Entries[1].Address, "delegation entry address")
+	s.Require().False(report.Entries[1].Reconciled, "delegation entry should be pending")
+
+	s.Require().Equal("FEE", report.Entries[2].Account, "fee entry account")
+	s.Require().Equal(tc.FeeAddress, report.Entries[2].Address, "fee entry address")
+	s.Require().False(report.Entries[2].Reconciled, "fee entry should be pending")
+}
+
+func (s *KeeperTestSuite) TestReconcileHostZoneBalances_MissingEpoch() {
+	tc := s.SetupReconcileHostZoneBalances()
+	s.App.StakeibcKeeper.RemoveEpochTracker(s.Ctx, epochtypes.DAY_EPOCH)
+
+	err := s.App.StakeibcKeeper.ReconcileHostZoneBalances(s.Ctx, tc.HostZone, tc.EpochNumber)
+	s.Require().ErrorContains(err, "epoch day not found")
+}
+
+func (s *KeeperTestSuite) TestReconcileHostZoneBalances_InvalidIcaAddress() {
+	tc := s.SetupReconcileHostZoneBalances()
+	tc.HostZone.FeeIcaAddress = "invalid"
+
+	err := s.App.StakeibcKeeper.ReconcileHostZoneBalances(s.Ctx, tc.HostZone, tc.EpochNumber)
+	s.Require().ErrorContains(err, "unable to submit FEE balance query")
+}
+
+func (s *KeeperTestSuite) TestSetReconciliationThreshold() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: HostChainId})
+
+	msg := types.MsgSetReconciliationThreshold{
+		Authority: Authority,
+		ChainId:   HostChainId,
+		Threshold: sdkmath.NewInt(1000),
+	}
+	_, err := s.GetMsgServer().SetReconciliationThreshold(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when setting threshold")
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Equal(int64(1000), hostZone.ReconciliationDiscrepancyThreshold.Int64(), "threshold")
+
+	// Missing host zone
+	invalidMsg := msg
+	invalidMsg.ChainId = "missing"
+	_, err = s.GetMsgServer().SetReconciliationThreshold(s.Ctx, &invalidMsg)
+	s.Require().ErrorContains(err, "host zone missing not found")
+
+	// Invalid authority
+	invalidMsg = msg
+	invalidMsg.Authority = "not-gov-address"
+	_, err = s.GetMsgServer().SetReconciliationThreshold(s.Ctx, &invalidMsg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
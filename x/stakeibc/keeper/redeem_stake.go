@@ -33,6 +33,9 @@ func (k Keeper) RedeemStake(ctx sdk.Context, msg *types.MsgRedeemStake) (*types.
 	if !hostZone.RedemptionsEnabled {
 		return nil, errorsmod.Wrapf(types.ErrRedemptionsDisabled, "redemptions disabled for %s", msg.HostZone)
 	}
+	if err := k.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, types.PAUSE_REDEEM); err != nil {
+		return nil, err
+	}
 
 	// ensure the recipient address is a valid bech32 address on the hostZone
 	_, err = utils.AccAddressFromBech32(msg.Receiver, hostZone.Bech32Prefix)
@@ -41,7 +44,7 @@ func (k Keeper) RedeemStake(ctx sdk.Context, msg *types.MsgRedeemStake) (*types.
 	}
 
 	// safety check: redemption rate must be within safety bounds
-	rateIsSafe, err := k.IsRedemptionRateWithinSafetyBounds(ctx, hostZone)
+	rateIsSafe, err := k.IsRedemptionRateSafeForRedemptions(ctx, hostZone)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "unable to check if redemption rate is within safety bounds")
 	}
@@ -49,8 +52,12 @@ func (k Keeper) RedeemStake(ctx sdk.Context, msg *types.MsgRedeemStake) (*types.
 		return nil, types.ErrRedemptionRateOutsideSafetyBounds
 	}
 
+	// take the host zone's redemption fee (if applicable) out of the stTokens being redeemed
+	feeAmount := k.GetRedemptionFeeAmount(ctx, hostZone, msg.Amount)
+	redeemAmount := msg.Amount.Sub(feeAmount)
+
 	// construct desired unstaking amount from host zone
-	nativeAmount := sdkmath.LegacyNewDecFromInt(msg.Amount).Mul(hostZone.RedemptionRate).TruncateInt()
+	nativeAmount := sdkmath.LegacyNewDecFromInt(redeemAmount).Mul(hostZone.RedemptionRate).TruncateInt()
 	if nativeAmount.LTE(sdkmath.ZeroInt()) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be greater than 0. found: %v", msg.Amount)
 	}
@@ -69,9 +76,23 @@ func (k Keeper) RedeemStake(ctx sdk.Context, msg *types.MsgRedeemStake) (*types.
 	userRedemptionRecord, userHasRedeemedThisEpoch := k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
 	if userHasRedeemedThisEpoch {
 		k.Logger(ctx).Info(fmt.Sprintf("UserRedemptionRecord found for %s", redemptionId))
+
+		// A record owned by a different account can't be redeemed into, otherwise the owner would
+		// lose the ability to transfer or cancel it
+		// Records without an owner (created before ownership was tracked) keep accepting redemptions
+		if userRedemptionRecord.Owner != "" && userRedemptionRecord.Owner != msg.Creator {
+			return nil, errorsmod.Wrapf(types.ErrRedemptionRecordNotOwned,
+				"user redemption record %s is owned by a different account, redeem to a different receiver", redemptionId)
+		}
+		// Tokenized records can't be redeemed into, since that would change the value of the NFT
+		if userRedemptionRecord.NftId != "" {
+			return nil, errorsmod.Wrapf(types.ErrRedemptionRecordTokenized,
+				"user redemption record %s is tokenized, redeem to a different receiver", redemptionId)
+		}
+
 		// Add the unbonded amount to the UserRedemptionRecord
 		// The record is set below
-		userRedemptionRecord.StTokenAmount = userRedemptionRecord.StTokenAmount.Add(msg.Amount)
+		userRedemptionRecord.StTokenAmount = userRedemptionRecord.StTokenAmount.Add(redeemAmount)
 		userRedemptionRecord.NativeTokenAmount = userRedemptionRecord.NativeTokenAmount.Add(nativeAmount)
 	} else {
 		// First time a user is redeeming this epoch
@@ -82,7 +103,8 @@ func (k Keeper) RedeemStake(ctx sdk.Context, msg *types.MsgRedeemStake) (*types.
 			Denom:             hostZone.HostDenom,
 			HostZoneId:        hostZone.ChainId,
 			EpochNumber:       epochTracker.EpochNumber,
-			StTokenAmount:     msg.Amount,
+			StTokenAmount:     redeemAmount,
+			Owner:             msg.Creator,
 			// claimIsPending represents whether a redemption is currently being claimed,
 			// contingent on the host zone unbonding having status CLAIMABLE
 			ClaimIsPending: false,
@@ -109,7 +131,7 @@ func (k Keeper) RedeemStake(ctx sdk.Context, msg *types.MsgRedeemStake) (*types.
 
 	// Escrow user's balance
 	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
-	redeemCoin := sdk.NewCoins(sdk.NewCoin(stDenom, msg.Amount))
+	redeemCoin := sdk.NewCoins(sdk.NewCoin(stDenom, redeemAmount))
 	depositAddress, err := sdk.AccAddressFromBech32(hostZone.DepositAddress)
 	if err != nil {
 		return nil, fmt.Errorf("could not bech32 decode address %s of zone with id: %s", hostZone.DepositAddress, hostZone.ChainId)
@@ -121,8 +143,16 @@ func (k Keeper) RedeemStake(ctx sdk.Context, msg *types.MsgRedeemStake) (*types.
 		return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't send %v derivative %s tokens to module account. err: %s", msg.Amount, hostZone.HostDenom, err.Error())
 	}
 
+	// Send the fee to the reward collector, where it's auctioned off with the reward commission
+	if feeAmount.IsPositive() {
+		feeCoin := sdk.NewCoin(stDenom, feeAmount)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.RewardCollectorName, sdk.NewCoins(feeCoin)); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't send redemption fee %v to reward collector. err: %s", feeCoin, err.Error())
+		}
+	}
+
 	// record the number of stAssets that should be burned after unbonding
-	hostZoneUnbonding.StTokenAmount = hostZoneUnbonding.StTokenAmount.Add(msg.Amount)
+	hostZoneUnbonding.StTokenAmount = hostZoneUnbonding.StTokenAmount.Add(redeemAmount)
 
 	// Actually set the records, we wait until now to prevent any errors
 	k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
@@ -138,7 +168,7 @@ func (k Keeper) RedeemStake(ctx sdk.Context, msg *types.MsgRedeemStake) (*types.
 	}
 
 	k.Logger(ctx).Info(fmt.Sprintf("executed redeem stake: %s", msg.String()))
-	EmitSuccessfulRedeemStakeEvent(ctx, msg, hostZone, nativeAmount, msg.Amount)
+	EmitSuccessfulRedeemStakeEvent(ctx, msg, hostZone, nativeAmount, redeemAmount, feeAmount)
 
 	return &types.MsgRedeemStakeResponse{}, nil
 }
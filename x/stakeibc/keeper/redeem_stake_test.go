@@ -155,10 +155,100 @@ func (s *KeeperTestSuite) TestRedeemStake_Successful() {
 	s.Require().Equal(tc.expectedNativeAmount, userRedemptionRecord.NativeTokenAmount, "redemption record native amount")
 	s.Require().Equal(msg.Receiver, userRedemptionRecord.Receiver, "redemption record receiver")
 	s.Require().Equal(msg.HostZone, userRedemptionRecord.HostZoneId, "redemption record host zone")
+	s.Require().Equal(msg.Creator, userRedemptionRecord.Owner, "redemption record owner")
 	s.Require().False(userRedemptionRecord.ClaimIsPending, "redemption record is not claimable")
 	s.Require().NotEqual(hostZoneUnbonding.Status, recordtypes.HostZoneUnbonding_CLAIMABLE, "host zone unbonding should NOT be marked as CLAIMABLE")
 }
 
+func (s *KeeperTestSuite) TestRedeemStake_WithFee() {
+	tc := s.SetupRedeemStake()
+	msg := tc.validMsg
+
+	// Add a 1% redemption fee to the host zone
+	hostZone := tc.hostZone
+	hostZone.FeeSchedule = &stakeibctypes.HostZoneFeeSchedule{
+		RewardCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.1"),
+		LiquidStakeFeeRate:   sdkmath.LegacyZeroDec(),
+		RedemptionFeeRate:    sdkmath.LegacyMustNewDecFromStr("0.01"),
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().RedeemStake(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected during redemption")
+
+	// 1% of the 1,000,000 stTokens are taken as a fee, leaving 990,000 to be redeemed at a rate of 1.5
+	expectedFee := sdkmath.NewInt(10_000)
+	expectedRedeemAmount := sdkmath.NewInt(990_000)
+	expectedNativeAmount := sdkmath.NewInt(1_485_000)
+
+	// The user should be charged the full amount, while the fee is sent to the reward collector
+	rewardCollectorAddress := s.App.AccountKeeper.GetModuleAccount(s.Ctx, stakeibctypes.RewardCollectorName).GetAddress()
+	s.CompareCoins(tc.user.stAtomBalance.SubAmount(msg.Amount), s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, "stuatom"), "user stuatom balance")
+	s.CompareCoins(tc.zoneAccount.stAtomBalance.AddAmount(expectedRedeemAmount), s.App.BankKeeper.GetBalance(s.Ctx, tc.zoneAccount.acc, "stuatom"), "deposit stuatom balance")
+	s.CompareCoins(sdk.NewCoin("stuatom", expectedFee), s.App.BankKeeper.GetBalance(s.Ctx, rewardCollectorAddress, "stuatom"), "reward collector stuatom balance")
+
+	// The unbonding records should only include the amount after the fee
+	hostZoneUnbonding := s.MustGetHostZoneUnbonding(tc.initialState.epochNumber, HostChainId)
+	s.Require().Equal(expectedRedeemAmount, hostZoneUnbonding.StTokenAmount, "host zone unbonding sttoken amount")
+	s.Require().Equal(expectedNativeAmount, hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+
+	userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, hostZoneUnbonding.UserRedemptionRecords[0])
+	s.Require().True(found, "user redemption record")
+	s.Require().Equal(expectedRedeemAmount, userRedemptionRecord.StTokenAmount, "redemption record sttoken amount")
+	s.Require().Equal(expectedNativeAmount, userRedemptionRecord.NativeTokenAmount, "redemption record native amount")
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_RecordOwnedByDifferentAccount() {
+	tc := s.SetupRedeemStake()
+
+	_, err := s.GetMsgServer().RedeemStake(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected during first redemption")
+
+	// A different account redeeming to the same receiver in the same epoch should be rejected
+	otherRedeemer := s.TestAccs[1]
+	s.FundAccount(otherRedeemer, sdk.NewInt64Coin("stuatom", 1))
+
+	otherMsg := tc.validMsg
+	otherMsg.Creator = otherRedeemer.String()
+	otherMsg.Amount = sdkmath.NewInt(1)
+	_, err = s.GetMsgServer().RedeemStake(s.Ctx, &otherMsg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrRedemptionRecordNotOwned)
+
+	// Confirm the record is unchanged and still owned by the original redeemer
+	recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.initialState.epochNumber, tc.validMsg.Receiver)
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+	s.Require().True(found, "user redemption record")
+	s.Require().Equal(tc.validMsg.Creator, record.Owner, "redemption record owner")
+	s.Require().Equal(tc.validMsg.Amount, record.StTokenAmount, "redemption record sttoken amount")
+
+	s.CompareCoins(sdk.NewInt64Coin("stuatom", 1), s.App.BankKeeper.GetBalance(s.Ctx, otherRedeemer, "stuatom"), "other redeemer balance")
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_RecordWithoutOwner() {
+	tc := s.SetupRedeemStake()
+
+	// Store a record from before ownership was tracked
+	recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.initialState.epochNumber, tc.validMsg.Receiver)
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:                recordId,
+		Receiver:          tc.validMsg.Receiver,
+		NativeTokenAmount: sdkmath.NewInt(150),
+		StTokenAmount:     sdkmath.NewInt(100),
+		Denom:             "uatom",
+		HostZoneId:        HostChainId,
+		EpochNumber:       tc.initialState.epochNumber,
+	})
+
+	// Redeeming into the record should still succeed, and the record should remain without an owner
+	_, err := s.GetMsgServer().RedeemStake(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected when redeeming into a record without an owner")
+
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+	s.Require().True(found, "user redemption record")
+	s.Require().Empty(record.Owner, "redemption record owner")
+	s.Require().Equal(tc.validMsg.Amount.AddRaw(100), record.StTokenAmount, "redemption record sttoken amount")
+}
+
 func (s *KeeperTestSuite) TestRedeemStake_InvalidCreatorAddress() {
 	tc := s.SetupRedeemStake()
 	invalidMsg := tc.validMsg
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Stores the in-flight redelegations between a pair of validators
+func (k Keeper) SetInFlightRedelegation(ctx sdk.Context, redelegation types.InFlightRedelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InFlightRedelegationKeyPrefix))
+	key := types.InFlightRedelegationKey(redelegation.ChainId, redelegation.SrcValidator, redelegation.DstValidator)
+	store.Set(key, k.cdc.MustMarshal(&redelegation))
+}
+
+// Reads the in-flight redelegations between a pair of validators
+func (k Keeper) GetInFlightRedelegation(
+	ctx sdk.Context,
+	chainId string,
+	srcValidator string,
+	dstValidator string,
+) (redelegation types.InFlightRedelegation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InFlightRedelegationKeyPrefix))
+	bz := store.Get(types.InFlightRedelegationKey(chainId, srcValidator, dstValidator))
+	if len(bz) == 0 {
+		return redelegation, false
+	}
+	k.cdc.MustUnmarshal(bz, &redelegation)
+	return redelegation, true
+}
+
+// Removes the in-flight redelegations between a pair of validators
+func (k Keeper) RemoveInFlightRedelegation(ctx sdk.Context, chainId, srcValidator, dstValidator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InFlightRedelegationKeyPrefix))
+	store.Delete(types.InFlightRedelegationKey(chainId, srcValidator, dstValidator))
+}
+
+// Returns all in-flight redelegations for a host zone
+func (k Keeper) GetInFlightRedelegationsForHostZone(ctx sdk.Context, chainId string) (redelegations []types.InFlightRedelegation) {
+	redelegations = []types.InFlightRedelegation{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InFlightRedelegationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, types.InFlightRedelegationChainPrefix(chainId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var redelegation types.InFlightRedelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &redelegation)
+		redelegations = append(redelegations, redelegation)
+	}
+
+	return redelegations
+}
+
+// Returns all in-flight redelegations across all host zones
+func (k Keeper) GetAllInFlightRedelegations(ctx sdk.Context) (redelegations []types.InFlightRedelegation) {
+	redelegations = []types.InFlightRedelegation{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InFlightRedelegationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var redelegation types.InFlightRedelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &redelegation)
+		redelegations = append(redelegations, redelegation)
+	}
+
+	return redelegations
+}
+
+// Records a new redelegation entry between a pair of validators after it was acknowledged by the host
+func (k Keeper) AddInFlightRedelegationEntry(
+	ctx sdk.Context,
+	chainId string,
+	srcValidator string,
+	dstValidator string,
+	amount sdkmath.Int,
+	completionTime uint64,
+) {
+	redelegation, found := k.GetInFlightRedelegation(ctx, chainId, srcValidator, dstValidator)
+	if !found {
+		redelegation = types.InFlightRedelegation{
+			ChainId:      chainId,
+			SrcValidator: srcValidator,
+			DstValidator: dstValidator,
+		}
+	}
+	redelegation.Entries = append(redelegation.Entries, types.InFlightRedelegationEntry{
+		Amount:         amount,
+		CompletionTime: completionTime,
+	})
+	k.SetInFlightRedelegation(ctx, redelegation)
+}
+
+// Removes every redelegation entry on a host zone that has matured as of the current block time,
+// deleting the validator pair once it has no outstanding entries
+func (k Keeper) PruneMaturedRedelegations(ctx sdk.Context, chainId string) {
+	blockTime := utils.IntToUint(ctx.BlockTime().UnixNano())
+	for _, redelegation := range k.GetInFlightRedelegationsForHostZone(ctx, chainId) {
+		activeEntries := []types.InFlightRedelegationEntry{}
+		for _, entry := range redelegation.Entries {
+			if entry.CompletionTime > blockTime {
+				activeEntries = append(activeEntries, entry)
+			}
+		}
+
+		if len(activeEntries) == 0 {
+			k.RemoveInFlightRedelegation(ctx, chainId, redelegation.SrcValidator, redelegation.DstValidator)
+			continue
+		}
+		if len(activeEntries) != len(redelegation.Entries) {
+			redelegation.Entries = activeEntries
+			k.SetInFlightRedelegation(ctx, redelegation)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+const (
+	RedemptionNFTClassId          = "stakeibc-redemption"
+	RedemptionNFTClassName        = "Stride Redemption Records"
+	RedemptionNFTClassDescription = "Claims on unbonding native tokens from stakeibc redemptions"
+	RedemptionNFTIdPrefix         = "redemption-"
+)
+
+// Returns the NFT ID for a user redemption record
+// The record ID contains "." which is not permitted in NFT IDs, so the ID is hashed instead
+func GetRedemptionNFTId(recordId string) string {
+	hash := sha256.Sum256([]byte(recordId))
+	return RedemptionNFTIdPrefix + hex.EncodeToString(hash[:])
+}
+
+// Returns the account with authority over a user redemption record
+// If the record is tokenized, it's owned by the holder of the NFT, otherwise it's owned by the
+// owner on the record. An empty string is returned if the record has no owner
+func (k Keeper) GetRedemptionRecordOwner(ctx sdk.Context, record recordstypes.UserRedemptionRecord) string {
+	if record.NftId == "" {
+		return record.Owner
+	}
+	if k.nftKeeper == nil {
+		return ""
+	}
+	holder := k.nftKeeper.GetOwner(ctx, RedemptionNFTClassId, record.NftId)
+	if holder.Empty() {
+		return ""
+	}
+	return holder.String()
+}
+
+// Burns the NFT associated with a user redemption record, if applicable
+// The record itself is not updated
+func (k Keeper) BurnRedemptionRecordNFT(ctx sdk.Context, record recordstypes.UserRedemptionRecord) error {
+	if record.NftId == "" {
+		return nil
+	}
+	if k.nftKeeper == nil {
+		return errorsmod.Wrapf(types.ErrRedemptionNFTsDisabled, "unable to burn nft for user redemption record %s", record.Id)
+	}
+	if err := k.nftKeeper.Burn(ctx, RedemptionNFTClassId, record.NftId); err != nil {
+		return errorsmod.Wrapf(err, "unable to burn nft %s for user redemption record %s", record.NftId, record.Id)
+	}
+	return nil
+}
+
+// Mints an NFT representing an unclaimed user redemption record to the record owner
+// While the NFT exists, the holder of the NFT is treated as the owner of the record, and the
+// record can be traded through x/nft. The record cannot be claimed until it is detokenized
+func (k Keeper) TokenizeRedemptionRecord(
+	ctx sdk.Context,
+	msg *types.MsgTokenizeRedemptionRecord,
+) (*types.MsgTokenizeRedemptionRecordResponse, error) {
+	if k.nftKeeper == nil {
+		return nil, types.ErrRedemptionNFTsDisabled
+	}
+
+	hostZone, err := k.GetActiveHostZone(ctx, msg.HostZoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Confirm the record exists, is owned by the sender, and is not already tokenized or being claimed
+	recordId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, msg.Epoch, msg.Receiver)
+	userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, recordId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"user redemption record %s not found on host zone %s", recordId, hostZone.ChainId)
+	}
+	if userRedemptionRecord.NftId != "" {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionRecordTokenized,
+			"user redemption record %s is already tokenized as %s", recordId, userRedemptionRecord.NftId)
+	}
+	if userRedemptionRecord.Owner == "" || userRedemptionRecord.Owner != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionRecordNotOwned,
+			"user redemption record %s is not owned by %s", recordId, msg.Creator)
+	}
+	if userRedemptionRecord.ClaimIsPending {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"user redemption record %s cannot be tokenized while a claim is pending", recordId)
+	}
+
+	// Register the NFT class the first time a record is tokenized
+	if !k.nftKeeper.HasClass(ctx, RedemptionNFTClassId) {
+		if err := k.nftKeeper.SaveClass(ctx, RedemptionNFTClassId, RedemptionNFTClassName, RedemptionNFTClassDescription); err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to create redemption nft class")
+		}
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid owner address on user redemption record %s", recordId)
+	}
+	nftId := GetRedemptionNFTId(recordId)
+	if err := k.nftKeeper.Mint(ctx, RedemptionNFTClassId, nftId, owner); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to mint nft for user redemption record %s", recordId)
+	}
+
+	userRedemptionRecord.NftId = nftId
+	k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Tokenized user redemption record %s as nft %s", recordId, nftId))
+	EmitRedemptionRecordNFTEvent(ctx, types.EventTypeRedemptionRecordTokenized, userRedemptionRecord, nftId, msg.Creator)
+
+	return &types.MsgTokenizeRedemptionRecordResponse{NftId: nftId}, nil
+}
+
+// Burns the NFT representing a user redemption record and assigns the record to the NFT holder
+// This must be called by the holder before the record can be claimed
+func (k Keeper) DetokenizeRedemptionRecord(
+	ctx sdk.Context,
+	msg *types.MsgDetokenizeRedemptionRecord,
+) (*types.MsgDetokenizeRedemptionRecordResponse, error) {
+	if k.nftKeeper == nil {
+		return nil, types.ErrRedemptionNFTsDisabled
+	}
+
+	hostZone, err := k.GetActiveHostZone(ctx, msg.HostZoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	recordId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, msg.Epoch, msg.Receiver)
+	userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, recordId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"user redemption record %s not found on host zone %s", recordId, hostZone.ChainId)
+	}
+	if userRedemptionRecord.NftId == "" {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"user redemption record %s is not tokenized", recordId)
+	}
+	if k.GetRedemptionRecordOwner(ctx, userRedemptionRecord) != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionRecordNotOwned,
+			"nft %s for user redemption record %s is not held by %s", userRedemptionRecord.NftId, recordId, msg.Creator)
+	}
+
+	nftId := userRedemptionRecord.NftId
+	if err := k.BurnRedemptionRecordNFT(ctx, userRedemptionRecord); err != nil {
+		return nil, err
+	}
+
+	userRedemptionRecord.Owner = msg.Creator
+	userRedemptionRecord.NftId = ""
+	k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Detokenized user redemption record %s from nft %s", recordId, nftId))
+	EmitRedemptionRecordNFTEvent(ctx, types.EventTypeRedemptionRecordDetokenized, userRedemptionRecord, nftId, msg.Creator)
+
+	return &types.MsgDetokenizeRedemptionRecordResponse{}, nil
+}
@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// In-memory NFT keeper used in place of x/nft
+type MockNFTKeeper struct {
+	classes map[string]bool
+	owners  map[string]sdk.AccAddress
+}
+
+func NewMockNFTKeeper() *MockNFTKeeper {
+	return &MockNFTKeeper{
+		classes: map[string]bool{},
+		owners:  map[string]sdk.AccAddress{},
+	}
+}
+
+func (m *MockNFTKeeper) HasClass(ctx context.Context, classId string) bool {
+	return m.classes[classId]
+}
+
+func (m *MockNFTKeeper) SaveClass(ctx context.Context, classId, name, description string) error {
+	m.classes[classId] = true
+	return nil
+}
+
+func (m *MockNFTKeeper) Mint(ctx context.Context, classId, nftId string, receiver sdk.AccAddress) error {
+	if !m.classes[classId] {
+		return errors.New("class not found")
+	}
+	if _, found := m.owners[nftId]; found {
+		return errors.New("nft already exists")
+	}
+	m.owners[nftId] = receiver
+	return nil
+}
+
+func (m *MockNFTKeeper) Burn(ctx context.Context, classId, nftId string) error {
+	if _, found := m.owners[nftId]; !found {
+		return errors.New("nft not found")
+	}
+	delete(m.owners, nftId)
+	return nil
+}
+
+func (m *MockNFTKeeper) GetOwner(ctx context.Context, classId, nftId string) sdk.AccAddress {
+	return m.owners[nftId]
+}
+
+// Tokenizes the record from the cancel redemption setup and returns the mock NFT keeper
+func (s *KeeperTestSuite) SetupTokenizedRedemptionRecord() (CancelRedemptionTestCase, *MockNFTKeeper, string) {
+	tc := s.SetupCancelRedemption()
+
+	nftKeeper := NewMockNFTKeeper()
+	s.App.StakeibcKeeper.SetNFTKeeper(nftKeeper)
+
+	resp, err := s.GetMsgServer().TokenizeRedemptionRecord(s.Ctx, &types.MsgTokenizeRedemptionRecord{
+		Creator:    tc.owner.String(),
+		HostZoneId: HostChainId,
+		Epoch:      tc.epochNumber,
+		Receiver:   tc.validMsg.Receiver,
+	})
+	s.Require().NoError(err, "no error expected when tokenizing")
+
+	return tc, nftKeeper, resp.NftId
+}
+
+func (s *KeeperTestSuite) TestTokenizeRedemptionRecord_Successful() {
+	tc, nftKeeper, nftId := s.SetupTokenizedRedemptionRecord()
+
+	s.Require().Equal(keeper.GetRedemptionNFTId(tc.recordId), nftId, "nft id")
+	s.Require().True(nftKeeper.HasClass(s.Ctx, keeper.RedemptionNFTClassId), "nft class should be created")
+	s.Require().Equal(tc.owner, nftKeeper.GetOwner(s.Ctx, keeper.RedemptionNFTClassId, nftId), "nft owner")
+
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.recordId)
+	s.Require().True(found, "record should exist")
+	s.Require().Equal(nftId, record.NftId, "record nft id")
+
+	// Tokenizing a second time should fail
+	_, err := s.GetMsgServer().TokenizeRedemptionRecord(s.Ctx, &types.MsgTokenizeRedemptionRecord{
+		Creator:    tc.owner.String(),
+		HostZoneId: HostChainId,
+		Epoch:      tc.epochNumber,
+		Receiver:   tc.validMsg.Receiver,
+	})
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordTokenized)
+}
+
+func (s *KeeperTestSuite) TestTokenizeRedemptionRecord_Failures() {
+	tc := s.SetupCancelRedemption()
+	msg := types.MsgTokenizeRedemptionRecord{
+		Creator:    tc.owner.String(),
+		HostZoneId: HostChainId,
+		Epoch:      tc.epochNumber,
+		Receiver:   tc.validMsg.Receiver,
+	}
+
+	// Fails before the nft keeper is set
+	_, err := s.GetMsgServer().TokenizeRedemptionRecord(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrRedemptionNFTsDisabled)
+
+	s.App.StakeibcKeeper.SetNFTKeeper(NewMockNFTKeeper())
+
+	// Fails if the sender does not own the record
+	invalidMsg := msg
+	invalidMsg.Creator = s.TestAccs[1].String()
+	_, err = s.GetMsgServer().TokenizeRedemptionRecord(s.Ctx, &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordNotOwned)
+
+	// Fails if the record does not exist
+	invalidMsg = msg
+	invalidMsg.Receiver = "cosmosYYY"
+	_, err = s.GetMsgServer().TokenizeRedemptionRecord(s.Ctx, &invalidMsg)
+	s.Require().ErrorContains(err, "user redemption record GAIA.1.cosmosYYY not found")
+
+	// Fails if a claim is pending
+	record, _ := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.recordId)
+	record.ClaimIsPending = true
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, record)
+	_, err = s.GetMsgServer().TokenizeRedemptionRecord(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "cannot be tokenized while a claim is pending")
+}
+
+func (s *KeeperTestSuite) TestDetokenizeRedemptionRecord_AfterNFTTransfer() {
+	tc, nftKeeper, nftId := s.SetupTokenizedRedemptionRecord()
+
+	// Transfer the NFT to a buyer, who becomes the owner of the record
+	buyer := s.TestAccs[1]
+	nftKeeper.owners[nftId] = buyer
+
+	record, _ := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.recordId)
+	s.Require().Equal(buyer.String(), s.App.StakeibcKeeper.GetRedemptionRecordOwner(s.Ctx, record), "owner after nft transfer")
+
+	// The original owner can no longer detokenize or cancel
+	msg := types.MsgDetokenizeRedemptionRecord{
+		Creator:    tc.owner.String(),
+		HostZoneId: HostChainId,
+		Epoch:      tc.epochNumber,
+		Receiver:   tc.validMsg.Receiver,
+	}
+	_, err := s.GetMsgServer().DetokenizeRedemptionRecord(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordNotOwned)
+
+	_, err = s.GetMsgServer().CancelRedemption(s.Ctx, &tc.validMsg)
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordNotOwned)
+
+	// The buyer detokenizes, which burns the NFT and assigns them the record
+	msg.Creator = buyer.String()
+	_, err = s.GetMsgServer().DetokenizeRedemptionRecord(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when detokenizing")
+
+	s.Require().True(nftKeeper.GetOwner(s.Ctx, keeper.RedemptionNFTClassId, nftId).Empty(), "nft should be burned")
+
+	record, _ = s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.recordId)
+	s.Require().Equal(buyer.String(), record.Owner, "record owner")
+	s.Require().Empty(record.NftId, "record nft id")
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_Tokenized() {
+	tc, nftKeeper, nftId := s.SetupTokenizedRedemptionRecord()
+
+	// The NFT holder cancels the full redemption, which should return the stTokens to them and burn the NFT
+	holder := s.TestAccs[1]
+	nftKeeper.owners[nftId] = holder
+
+	msg := tc.validMsg
+	msg.Creator = holder.String()
+	_, err := s.GetMsgServer().CancelRedemption(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when cancelling")
+
+	s.Require().Equal(int64(1000), s.App.BankKeeper.GetBalance(s.Ctx, holder, StAtom).Amount.Int64(), "holder balance")
+	s.Require().True(nftKeeper.GetOwner(s.Ctx, keeper.RedemptionNFTClassId, nftId).Empty(), "nft should be burned")
+
+	_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.recordId)
+	s.Require().False(found, "record should have been removed")
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_Tokenized() {
+	tc, nftKeeper, nftId := s.SetupTokenizedRedemptionRecord()
+
+	newOwner := s.TestAccs[2]
+	newReceiver := "cosmos1rq3pjmk9hr4xq99e8jx683rukjmxhcp4vtjhmy"
+	_, err := s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &types.MsgTransferRedemptionRecord{
+		Creator:     tc.owner.String(),
+		HostZoneId:  HostChainId,
+		Epoch:       tc.epochNumber,
+		Receiver:    tc.validMsg.Receiver,
+		NewOwner:    newOwner.String(),
+		NewReceiver: newReceiver,
+	})
+	s.Require().NoError(err, "no error expected when transferring")
+
+	// The NFT should be burned and the new record should not be tokenized
+	s.Require().True(nftKeeper.GetOwner(s.Ctx, keeper.RedemptionNFTClassId, nftId).Empty(), "nft should be burned")
+
+	newRecordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, newReceiver)
+	newRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, newRecordId)
+	s.Require().True(found, "new record should exist")
+	s.Require().Equal(newOwner.String(), newRecord.Owner, "new record owner")
+	s.Require().Empty(newRecord.NftId, "new record nft id")
+}
+
+func (s *KeeperTestSuite) TestClaimUndelegatedTokens_Tokenized() {
+	tc, _, _ := s.SetupTokenizedRedemptionRecord()
+
+	hostZoneUnbonding := s.MustGetHostZoneUnbonding(tc.epochNumber, HostChainId)
+	hostZoneUnbonding.Status = recordtypes.HostZoneUnbonding_CLAIMABLE
+	err := s.App.RecordsKeeper.SetHostZoneUnbondingRecord(s.Ctx, tc.epochNumber, HostChainId, hostZoneUnbonding)
+	s.Require().NoError(err, "no error expected when setting host zone unbonding")
+
+	_, err = s.App.StakeibcKeeper.GetClaimableRedemptionRecord(s.Ctx, &types.MsgClaimUndelegatedTokens{
+		Creator:    tc.owner.String(),
+		HostZoneId: HostChainId,
+		Epoch:      tc.epochNumber,
+		Receiver:   tc.validMsg.Receiver,
+	})
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordTokenized)
+}
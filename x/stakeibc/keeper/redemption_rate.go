@@ -23,17 +23,26 @@ import (
 //	     2. Undelegated Balance:     native tokens that have been transferred to the host zone, but have not been delegated yet
 //	     3. Tokenized Delegations:   Delegations inherent in LSM Tokens that have not yet been converted to native stake
 //	     4. Native Delegations:      Delegations either from native tokens, or LSM Tokens that have been detokenized
+//	     5. Instant Redemption Buffer: native tokens held on Stride for instant redemptions, plus tokens
+//	                                   owed to the buffer from instant redemptions that are still unbonding
 //	  StToken Amount:
 //	     1. Total Supply of the stToken
 //
 //	Redemption Rate =
-//	(Deposit Account Balance + Undelegated Balance + Tokenized Delegation + Native Delegation) / (stToken Supply)
+//	(Deposit Account Balance + Undelegated Balance + Tokenized Delegation + Native Delegation + Instant Redemption Buffer) / (stToken Supply)
 func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordstypes.DepositRecord) {
 	k.Logger(ctx).Info("Updating Redemption Rates...")
 
 	// Update the redemption rate for each host zone
-	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+	// Host zones halted by the redemption rate guard are included so that they can recover
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		haltedByGuard := GetRedemptionRateGuardStatus(hostZone) == types.GUARD_HALTED && !hostZone.Deprecated
+		if hostZone.Halted && !haltedByGuard {
+			continue
+		}
+
 		k.UpdateRedemptionRateForHostZone(ctx, hostZone, depositRecords)
+		k.UpdateRedemptionRateGuardRecovery(ctx, hostZone.ChainId)
 	}
 }
 
@@ -50,15 +59,17 @@ func (k Keeper) UpdateRedemptionRateForHostZone(ctx sdk.Context, hostZone types.
 	undelegatedBalance := k.GetUndelegatedBalance(hostZone.ChainId, depositRecords)
 	tokenizedDelegation := k.GetTotalTokenizedDelegations(ctx, hostZone)
 	nativeDelegation := sdkmath.LegacyNewDecFromInt(hostZone.TotalDelegations)
+	instantRedemptionBuffer := k.GetTotalInstantRedemptionBuffer(ctx, hostZone)
 
 	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
 		"Redemption Rate Components - Deposit Account Balance: %v, Undelegated Balance: %v, "+
-			"LSM Delegated Balance: %v, Native Delegations: %v, stToken Supply: %v",
+			"LSM Delegated Balance: %v, Native Delegations: %v, Instant Redemption Buffer: %v, stToken Supply: %v",
 		depositAccountBalance, undelegatedBalance, tokenizedDelegation,
-		nativeDelegation, stSupply))
+		nativeDelegation, instantRedemptionBuffer, stSupply))
 
 	// Calculate the redemption rate
-	nativeTokensLocked := depositAccountBalance.Add(undelegatedBalance).Add(tokenizedDelegation).Add(nativeDelegation)
+	nativeTokensLocked := depositAccountBalance.Add(undelegatedBalance).Add(tokenizedDelegation).Add(nativeDelegation).
+		Add(instantRedemptionBuffer)
 	redemptionRate := nativeTokensLocked.Quo(sdkmath.LegacyNewDecFromInt(stSupply))
 
 	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
@@ -69,6 +80,9 @@ func (k Keeper) UpdateRedemptionRateForHostZone(ctx sdk.Context, hostZone types.
 	hostZone.RedemptionRate = redemptionRate
 	k.SetHostZone(ctx, hostZone)
 
+	// Record the new rate in the host zone's redemption rate history
+	k.RecordRedemptionRateSnapshot(ctx, hostZone, stSupply)
+
 	// If the redemption rate is outside of safety bounds, exit so the redemption rate is not pushed to the oracle
 	redemptionRateSafe, _ := k.IsRedemptionRateWithinSafetyBounds(ctx, hostZone)
 	if !redemptionRateSafe {
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	epochstypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// The redemption rate guard is an opt-in replacement for the default halt behavior
+//
+// Without the guard, a host zone is halted as soon as the redemption rate leaves
+// either the inner or outer bounds, and must be resumed manually
+//
+// With the guard enabled:
+//   - Leaving the inner bounds degrades the host zone: liquid stakes are paused, but
+//     redemptions continue so long as the rate is inside the outer bounds
+//   - Leaving the outer bounds halts the host zone
+//   - Once the rate has been back inside the inner bounds for a configured number of
+//     consecutive redemption rate updates, and the cooldown has elapsed, the host zone
+//     is resumed automatically
+//
+// Each status change is recorded in the host zone's guard history
+
+// Returns the guard status of a host zone, defaulting to healthy if the guard has not tripped
+func GetRedemptionRateGuardStatus(hostZone types.HostZone) types.RedemptionRateGuardStatus {
+	if hostZone.RedemptionRateGuard == nil {
+		return types.GUARD_HEALTHY
+	}
+	return hostZone.RedemptionRateGuard.Status
+}
+
+// Checks whether the redemption rate is safe enough to process a redemption
+// While a host zone is degraded, redemptions only require the rate to be inside the outer bounds
+func (k Keeper) IsRedemptionRateSafeForRedemptions(ctx sdk.Context, hostZone types.HostZone) (bool, error) {
+	if GetRedemptionRateGuardStatus(hostZone) != types.GUARD_DEGRADED {
+		return k.IsRedemptionRateWithinSafetyBounds(ctx, hostZone)
+	}
+
+	minSafetyThreshold, maxSafetyThreshold := k.GetOuterSafetyBounds(ctx, hostZone)
+	if hostZone.RedemptionRate.LT(minSafetyThreshold) || hostZone.RedemptionRate.GT(maxSafetyThreshold) {
+		return false, errorsmod.Wrapf(types.ErrRedemptionRateOutsideSafetyBounds,
+			"redemption rate %v is outside safety bounds [%v, %v]", hostZone.RedemptionRate, minSafetyThreshold, maxSafetyThreshold)
+	}
+	return true, nil
+}
+
+// Stores a redemption rate guard record
+func (k Keeper) SetRedemptionRateGuardRecord(ctx sdk.Context, record types.RedemptionRateGuardRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateGuardHistoryKeyPrefix))
+	key := types.RedemptionRateGuardHistoryKey(record.ChainId, record.Id)
+	store.Set(key, k.cdc.MustMarshal(&record))
+}
+
+// Returns the guard history of a host zone, ordered from oldest to newest
+func (k Keeper) GetRedemptionRateGuardHistory(ctx sdk.Context, chainId string) (records []types.RedemptionRateGuardRecord) {
+	records = []types.RedemptionRateGuardRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateGuardHistoryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, types.RedemptionRateGuardHistoryChainPrefix(chainId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.RedemptionRateGuardRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// Returns the guard history across all host zones
+func (k Keeper) GetAllRedemptionRateGuardHistory(ctx sdk.Context) (records []types.RedemptionRateGuardRecord) {
+	records = []types.RedemptionRateGuardRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateGuardHistoryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.RedemptionRateGuardRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// Returns the ID for the next guard record of a host zone, one greater than the latest record's ID
+func (k Keeper) getNextRedemptionRateGuardRecordId(ctx sdk.Context, chainId string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateGuardHistoryKeyPrefix))
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.RedemptionRateGuardHistoryChainPrefix(chainId))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 1
+	}
+	key := iterator.Key()
+	return binary.BigEndian.Uint64(key[len(key)-8:]) + 1
+}
+
+// Returns the current stride epoch number, or zero if the epoch tracker has not been initialized
+func (k Keeper) getCurrentStrideEpochNumber(ctx sdk.Context) uint64 {
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		return 0
+	}
+	return strideEpochTracker.EpochNumber
+}
+
+// Records a guard status change in the host zone's history and emits an event
+func (k Keeper) recordRedemptionRateGuardStatusChange(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	previousStatus types.RedemptionRateGuardStatus,
+	newStatus types.RedemptionRateGuardStatus,
+	reason string,
+) {
+	k.SetRedemptionRateGuardRecord(ctx, types.RedemptionRateGuardRecord{
+		ChainId:        hostZone.ChainId,
+		Id:             k.getNextRedemptionRateGuardRecordId(ctx, hostZone.ChainId),
+		EpochNumber:    k.getCurrentStrideEpochNumber(ctx),
+		Time:           ctx.BlockTime(),
+		PreviousStatus: previousStatus,
+		NewStatus:      newStatus,
+		Reason:         reason,
+		RedemptionRate: hostZone.RedemptionRate,
+	})
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Redemption rate guard changed from %s to %s: %s", previousStatus, newStatus, reason))
+	EmitRedemptionRateGuardStatusChangeEvent(ctx, hostZone.ChainId, previousStatus, newStatus, reason, hostZone.RedemptionRate)
+}
+
+// Trips the guard, moving the host zone into either the degraded or halted status
+// Halting the host zone also blacklists the stToken from being transferred
+func (k Keeper) TripRedemptionRateGuard(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	newStatus types.RedemptionRateGuardStatus,
+	minBound sdkmath.LegacyDec,
+	maxBound sdkmath.LegacyDec,
+	reason string,
+) {
+	previousStatus := GetRedemptionRateGuardStatus(hostZone)
+
+	hostZone.RedemptionRateGuard = &types.RedemptionRateGuardState{
+		Status:                newStatus,
+		Reason:                reason,
+		TriggerRedemptionRate: hostZone.RedemptionRate,
+		TriggerMinBound:       minBound,
+		TriggerMaxBound:       maxBound,
+		TrippedEpochNumber:    k.getCurrentStrideEpochNumber(ctx),
+		HealthyEpochs:         0,
+	}
+	if newStatus == types.GUARD_HALTED {
+		hostZone.Halted = true
+
+		stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+		k.RatelimitKeeper.AddDenomToBlacklist(ctx, stDenom)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeHostZoneHalt,
+				sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyRedemptionRate, hostZone.RedemptionRate.String()),
+			),
+		)
+	}
+	k.SetHostZone(ctx, hostZone)
+
+	k.recordRedemptionRateGuardStatusChange(ctx, hostZone, previousStatus, newStatus, reason)
+}
+
+// Clears the guard, returning the host zone to the healthy status
+// If the host zone was halted, it is resumed and the stToken is removed from the transfer blacklist
+func (k Keeper) ResetRedemptionRateGuard(ctx sdk.Context, hostZone types.HostZone, reason string) {
+	previousStatus := GetRedemptionRateGuardStatus(hostZone)
+
+	if hostZone.Halted {
+		hostZone.Halted = false
+
+		stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+		k.RatelimitKeeper.RemoveDenomFromBlacklist(ctx, stDenom)
+	}
+	hostZone.RedemptionRateGuard = nil
+	k.SetHostZone(ctx, hostZone)
+
+	k.recordRedemptionRateGuardStatusChange(ctx, hostZone, previousStatus, types.GUARD_HEALTHY, reason)
+}
+
+// Returns an error if the guard's cooldown has not yet elapsed since it tripped
+func (k Keeper) CheckRedemptionRateGuardCooldown(ctx sdk.Context, hostZone types.HostZone) error {
+	if hostZone.RedemptionRateGuardConfig == nil || hostZone.RedemptionRateGuard == nil {
+		return nil
+	}
+
+	resumeEpochNumber := hostZone.RedemptionRateGuard.TrippedEpochNumber + hostZone.RedemptionRateGuardConfig.ResumeCooldownEpochs
+	currentEpochNumber := k.getCurrentStrideEpochNumber(ctx)
+	if currentEpochNumber < resumeEpochNumber {
+		return errorsmod.Wrapf(types.ErrRedemptionRateGuardCooldown,
+			"host zone %s cannot be resumed until epoch %d (current epoch: %d)", hostZone.ChainId, resumeEpochNumber, currentEpochNumber)
+	}
+	return nil
+}
+
+// Checks the redemption rate of a host zone with the guard enabled, and degrades or halts
+// the host zone if the rate has left the inner or outer bounds respectively
+// This is run each block, and a host zone only moves to a more severe status
+func (k Keeper) CheckRedemptionRateGuard(ctx sdk.Context, hostZone types.HostZone) {
+	status := GetRedemptionRateGuardStatus(hostZone)
+	redemptionRate := hostZone.RedemptionRate
+
+	minOuterBound, maxOuterBound := k.GetOuterSafetyBounds(ctx, hostZone)
+	if redemptionRate.LT(minOuterBound) || redemptionRate.GT(maxOuterBound) {
+		if status != types.GUARD_HALTED {
+			reason := fmt.Sprintf("redemption rate %v is outside outer bounds [%v, %v]", redemptionRate, minOuterBound, maxOuterBound)
+			k.TripRedemptionRateGuard(ctx, hostZone, types.GUARD_HALTED, minOuterBound, maxOuterBound, reason)
+		}
+		return
+	}
+
+	// A host zone that was halted for some other reason is not downgraded to degraded
+	minInnerBound, maxInnerBound := k.GetInnerSafetyBounds(ctx, hostZone)
+	if redemptionRate.LT(minInnerBound) || redemptionRate.GT(maxInnerBound) {
+		if status == types.GUARD_HEALTHY && !hostZone.Halted {
+			reason := fmt.Sprintf("redemption rate %v is outside inner bounds [%v, %v]", redemptionRate, minInnerBound, maxInnerBound)
+			k.TripRedemptionRateGuard(ctx, hostZone, types.GUARD_DEGRADED, minInnerBound, maxInnerBound, reason)
+		}
+	}
+}
+
+// Called after each epochly redemption rate update to track how long the rate has been
+// back inside the inner bounds, and resumes the host zone once it has recovered for
+// the configured number of consecutive updates and the cooldown has elapsed
+func (k Keeper) UpdateRedemptionRateGuardRecovery(ctx sdk.Context, chainId string) {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found || hostZone.RedemptionRateGuardConfig == nil || hostZone.RedemptionRateGuard == nil {
+		return
+	}
+	config := *hostZone.RedemptionRateGuardConfig
+
+	minInnerBound, maxInnerBound := k.GetInnerSafetyBounds(ctx, hostZone)
+	if hostZone.RedemptionRate.LT(minInnerBound) || hostZone.RedemptionRate.GT(maxInnerBound) {
+		hostZone.RedemptionRateGuard.HealthyEpochs = 0
+	} else {
+		hostZone.RedemptionRateGuard.HealthyEpochs++
+	}
+
+	recovered := config.AutoResumeEpochs > 0 && hostZone.RedemptionRateGuard.HealthyEpochs >= config.AutoResumeEpochs
+	if recovered && k.CheckRedemptionRateGuardCooldown(ctx, hostZone) == nil {
+		reason := fmt.Sprintf("redemption rate within inner bounds for %d consecutive epochs", hostZone.RedemptionRateGuard.HealthyEpochs)
+		k.ResetRedemptionRateGuard(ctx, hostZone, reason)
+		return
+	}
+
+	k.SetHostZone(ctx, hostZone)
+}
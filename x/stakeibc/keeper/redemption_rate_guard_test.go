@@ -0,0 +1,314 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Sets up a host zone with the redemption rate guard enabled
+// The outer bounds are [0.9, 1.5] and the inner bounds are [1.0, 1.2]
+func (s *KeeperTestSuite) SetupRedemptionRateGuard(redemptionRate string, epochNumber uint64) types.HostZone {
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     epochNumber,
+	})
+
+	hostZone := types.HostZone{
+		ChainId:                HostChainId,
+		HostDenom:              Atom,
+		RedemptionRate:         sdkmath.LegacyMustNewDecFromStr(redemptionRate),
+		MinRedemptionRate:      sdkmath.LegacyMustNewDecFromStr("0.9"),
+		MaxRedemptionRate:      sdkmath.LegacyMustNewDecFromStr("1.5"),
+		MinInnerRedemptionRate: sdkmath.LegacyMustNewDecFromStr("1.0"),
+		MaxInnerRedemptionRate: sdkmath.LegacyMustNewDecFromStr("1.2"),
+		RedemptionRateGuardConfig: &types.RedemptionRateGuardConfig{
+			ResumeCooldownEpochs: 2,
+			AutoResumeEpochs:     3,
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	return hostZone
+}
+
+// Helper to update the redemption rate on the host zone in the store
+func (s *KeeperTestSuite) setGuardedRedemptionRate(redemptionRate string) types.HostZone {
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.RedemptionRate = sdkmath.LegacyMustNewDecFromStr(redemptionRate)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	return hostZone
+}
+
+func (s *KeeperTestSuite) TestCheckRedemptionRateGuard() {
+	testCases := []struct {
+		name           string
+		redemptionRate string
+		initialStatus  types.RedemptionRateGuardStatus
+		expectedStatus types.RedemptionRateGuardStatus
+		expectedHalted bool
+	}{
+		{
+			name:           "within inner bounds",
+			redemptionRate: "1.1",
+			initialStatus:  types.GUARD_HEALTHY,
+			expectedStatus: types.GUARD_HEALTHY,
+			expectedHalted: false,
+		},
+		{
+			name:           "outside inner bounds",
+			redemptionRate: "1.3",
+			initialStatus:  types.GUARD_HEALTHY,
+			expectedStatus: types.GUARD_DEGRADED,
+			expectedHalted: false,
+		},
+		{
+			name:           "outside outer bounds",
+			redemptionRate: "0.8",
+			initialStatus:  types.GUARD_HEALTHY,
+			expectedStatus: types.GUARD_HALTED,
+			expectedHalted: true,
+		},
+		{
+			name:           "degraded to halted",
+			redemptionRate: "1.6",
+			initialStatus:  types.GUARD_DEGRADED,
+			expectedStatus: types.GUARD_HALTED,
+			expectedHalted: true,
+		},
+		{
+			name:           "halted remains halted inside outer bounds",
+			redemptionRate: "1.3",
+			initialStatus:  types.GUARD_HALTED,
+			expectedStatus: types.GUARD_HALTED,
+			expectedHalted: true,
+		},
+		{
+			name:           "degraded remains degraded inside inner bounds",
+			redemptionRate: "1.1",
+			initialStatus:  types.GUARD_DEGRADED,
+			expectedStatus: types.GUARD_DEGRADED,
+			expectedHalted: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			hostZone := s.SetupRedemptionRateGuard(tc.redemptionRate, 10)
+			if tc.initialStatus != types.GUARD_HEALTHY {
+				hostZone.RedemptionRateGuard = &types.RedemptionRateGuardState{
+					Status:                tc.initialStatus,
+					TriggerRedemptionRate: sdkmath.LegacyZeroDec(),
+					TriggerMinBound:       sdkmath.LegacyZeroDec(),
+					TriggerMaxBound:       sdkmath.LegacyZeroDec(),
+				}
+				hostZone.Halted = tc.initialStatus == types.GUARD_HALTED
+				s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+			}
+
+			s.App.StakeibcKeeper.BeginBlocker(s.Ctx)
+
+			hostZone = s.MustGetHostZone(HostChainId)
+			s.Require().Equal(tc.expectedStatus, keeper.GetRedemptionRateGuardStatus(hostZone), "guard status")
+			s.Require().Equal(tc.expectedHalted, hostZone.Halted, "halted")
+
+			// A history record should only be written if the status changed
+			history := s.App.StakeibcKeeper.GetRedemptionRateGuardHistory(s.Ctx, HostChainId)
+			if tc.initialStatus == tc.expectedStatus {
+				s.Require().Empty(history, "no history expected")
+			} else {
+				s.Require().Len(history, 1, "history")
+				s.Require().Equal(tc.initialStatus, history[0].PreviousStatus, "previous status")
+				s.Require().Equal(tc.expectedStatus, history[0].NewStatus, "new status")
+				s.Require().Equal(uint64(10), history[0].EpochNumber, "epoch number")
+				s.Require().Equal(uint64(10), hostZone.RedemptionRateGuard.TrippedEpochNumber, "tripped epoch")
+				s.Require().Equal(sdkmath.LegacyMustNewDecFromStr(tc.redemptionRate).String(),
+					hostZone.RedemptionRateGuard.TriggerRedemptionRate.String(), "trigger redemption rate")
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateRedemptionRateGuardRecovery() {
+	s.SetupRedemptionRateGuard("1.6", 10)
+
+	// Halt the host zone
+	s.App.StakeibcKeeper.BeginBlocker(s.Ctx)
+	s.Require().True(s.MustGetHostZone(HostChainId).Halted, "host zone should be halted")
+
+	// Two epochs inside the inner bounds, followed by one outside should reset the count
+	s.setGuardedRedemptionRate("1.1")
+	s.App.StakeibcKeeper.UpdateRedemptionRateGuardRecovery(s.Ctx, HostChainId)
+	s.App.StakeibcKeeper.UpdateRedemptionRateGuardRecovery(s.Ctx, HostChainId)
+	s.Require().Equal(uint64(2), s.MustGetHostZone(HostChainId).RedemptionRateGuard.HealthyEpochs, "healthy epochs")
+
+	s.setGuardedRedemptionRate("1.3")
+	s.App.StakeibcKeeper.UpdateRedemptionRateGuardRecovery(s.Ctx, HostChainId)
+	s.Require().Zero(s.MustGetHostZone(HostChainId).RedemptionRateGuard.HealthyEpochs, "healthy epochs after reset")
+
+	// Three epochs inside the inner bounds, but still within the cooldown
+	s.setGuardedRedemptionRate("1.1")
+	for i := 0; i < 3; i++ {
+		s.App.StakeibcKeeper.UpdateRedemptionRateGuardRecovery(s.Ctx, HostChainId)
+	}
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().True(hostZone.Halted, "host zone should still be halted during cooldown")
+	s.Require().Equal(types.GUARD_HALTED, keeper.GetRedemptionRateGuardStatus(hostZone), "status during cooldown")
+
+	// Once the cooldown elapses, the host zone should be resumed
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     12,
+	})
+	s.App.StakeibcKeeper.UpdateRedemptionRateGuardRecovery(s.Ctx, HostChainId)
+
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().False(hostZone.Halted, "host zone should be resumed")
+	s.Require().Nil(hostZone.RedemptionRateGuard, "guard should be cleared")
+
+	history := s.App.StakeibcKeeper.GetRedemptionRateGuardHistory(s.Ctx, HostChainId)
+	s.Require().Len(history, 2, "history")
+	s.Require().Equal(uint64(1), history[0].Id, "first record id")
+	s.Require().Equal(types.GUARD_HALTED, history[0].NewStatus, "first record status")
+	s.Require().Equal(uint64(2), history[1].Id, "second record id")
+	s.Require().Equal(types.GUARD_HEALTHY, history[1].NewStatus, "second record status")
+	s.Require().Equal(uint64(12), history[1].EpochNumber, "second record epoch")
+}
+
+func (s *KeeperTestSuite) TestUpdateRedemptionRateGuardRecovery_AutoResumeDisabled() {
+	hostZone := s.SetupRedemptionRateGuard("1.3", 10)
+	hostZone.RedemptionRateGuardConfig.AutoResumeEpochs = 0
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Degrade the host zone, then recover the redemption rate well past the cooldown
+	s.App.StakeibcKeeper.BeginBlocker(s.Ctx)
+	s.setGuardedRedemptionRate("1.1")
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     100,
+	})
+	for i := 0; i < 10; i++ {
+		s.App.StakeibcKeeper.UpdateRedemptionRateGuardRecovery(s.Ctx, HostChainId)
+	}
+
+	// The host zone must be resumed manually
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(types.GUARD_DEGRADED, keeper.GetRedemptionRateGuardStatus(hostZone), "status")
+	s.Require().Equal(uint64(10), hostZone.RedemptionRateGuard.HealthyEpochs, "healthy epochs")
+}
+
+func (s *KeeperTestSuite) TestIsRedemptionRateSafeForRedemptions() {
+	// Healthy host zones use the inner bounds
+	hostZone := s.SetupRedemptionRateGuard("1.3", 10)
+	safe, _ := s.App.StakeibcKeeper.IsRedemptionRateSafeForRedemptions(s.Ctx, hostZone)
+	s.Require().False(safe, "healthy host zone outside inner bounds")
+
+	// Degraded host zones only require the rate to be inside the outer bounds
+	s.App.StakeibcKeeper.BeginBlocker(s.Ctx)
+	hostZone = s.MustGetHostZone(HostChainId)
+	s.Require().Equal(types.GUARD_DEGRADED, keeper.GetRedemptionRateGuardStatus(hostZone), "status")
+
+	safe, err := s.App.StakeibcKeeper.IsRedemptionRateSafeForRedemptions(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected for degraded host zone inside outer bounds")
+	s.Require().True(safe, "degraded host zone inside outer bounds")
+
+	hostZone.RedemptionRate = sdkmath.LegacyMustNewDecFromStr("1.6")
+	safe, err = s.App.StakeibcKeeper.IsRedemptionRateSafeForRedemptions(s.Ctx, hostZone)
+	s.Require().ErrorIs(err, types.ErrRedemptionRateOutsideSafetyBounds)
+	s.Require().False(safe, "degraded host zone outside outer bounds")
+}
+
+func (s *KeeperTestSuite) TestLiquidStake_DegradedZone() {
+	hostZone := s.SetupRedemptionRateGuard("1.1", 10)
+	hostZone.RedemptionRateGuard = &types.RedemptionRateGuardState{
+		Status:                types.GUARD_DEGRADED,
+		TriggerRedemptionRate: sdkmath.LegacyZeroDec(),
+		TriggerMinBound:       sdkmath.LegacyZeroDec(),
+		TriggerMaxBound:       sdkmath.LegacyZeroDec(),
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().LiquidStake(s.Ctx, &types.MsgLiquidStake{
+		Creator:   s.TestAccs[0].String(),
+		Amount:    sdkmath.NewInt(1000),
+		HostDenom: Atom,
+	})
+	s.Require().ErrorIs(err, types.ErrHostZoneDegraded)
+}
+
+func (s *KeeperTestSuite) TestResumeHostZone_RedemptionRateGuard() {
+	s.SetupRedemptionRateGuard("1.3", 10)
+
+	// Degrade the host zone
+	s.App.StakeibcKeeper.BeginBlocker(s.Ctx)
+	msg := types.MsgResumeHostZone{
+		Creator: s.TestAccs[0].String(),
+		ChainId: HostChainId,
+	}
+
+	// Resuming before the cooldown elapses should fail
+	_, err := s.GetMsgServer().ResumeHostZone(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrRedemptionRateGuardCooldown)
+
+	// Once the cooldown elapses, the degraded host zone can be resumed
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     12,
+	})
+	_, err = s.GetMsgServer().ResumeHostZone(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when resuming after cooldown")
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Nil(hostZone.RedemptionRateGuard, "guard should be cleared")
+	s.Require().False(hostZone.Halted, "host zone should not be halted")
+
+	history := s.App.StakeibcKeeper.GetRedemptionRateGuardHistory(s.Ctx, HostChainId)
+	s.Require().Len(history, 2, "history")
+	s.Require().Equal(types.GUARD_HEALTHY, history[1].NewStatus, "resumed status")
+
+	// The history should also be available through the query
+	response, err := s.App.StakeibcKeeper.HaltHistory(s.Ctx, &types.QueryHaltHistoryRequest{ChainId: HostChainId})
+	s.Require().NoError(err, "no error expected when querying halt history")
+	s.Require().Equal(history, response.Records, "queried history")
+}
+
+func (s *KeeperTestSuite) TestSetRedemptionRateGuardConfig() {
+	s.SetupRedemptionRateGuard("1.3", 10)
+
+	// Degrade the host zone
+	s.App.StakeibcKeeper.BeginBlocker(s.Ctx)
+	s.Require().Equal(types.GUARD_DEGRADED, keeper.GetRedemptionRateGuardStatus(s.MustGetHostZone(HostChainId)), "status")
+
+	// Update the config
+	config := types.RedemptionRateGuardConfig{ResumeCooldownEpochs: 5, AutoResumeEpochs: 5}
+	msg := types.MsgSetRedemptionRateGuardConfig{
+		Authority: Authority,
+		ChainId:   HostChainId,
+		Config:    &config,
+	}
+	_, err := s.GetMsgServer().SetRedemptionRateGuardConfig(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when updating config")
+	s.Require().Equal(config, *s.MustGetHostZone(HostChainId).RedemptionRateGuardConfig, "config")
+
+	// Disabling the guard should return the degraded host zone to healthy
+	msg.Config = nil
+	_, err = s.GetMsgServer().SetRedemptionRateGuardConfig(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when disabling guard")
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Nil(hostZone.RedemptionRateGuardConfig, "config should be removed")
+	s.Require().Nil(hostZone.RedemptionRateGuard, "guard should be cleared")
+
+	// Invalid host zone
+	msg.ChainId = "fake-chain"
+	_, err = s.GetMsgServer().SetRedemptionRateGuardConfig(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "host zone fake-chain not found")
+
+	// Invalid authority
+	msg.ChainId = HostChainId
+	msg.Authority = "not-gov-address"
+	_, err = s.GetMsgServer().SetRedemptionRateGuardConfig(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
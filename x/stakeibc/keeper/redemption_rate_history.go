@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+var (
+	AprWindow7Day  = 7 * 24 * time.Hour
+	AprWindow30Day = 30 * 24 * time.Hour
+
+	secondsPerYear = int64(365 * 24 * time.Hour / time.Second)
+)
+
+// Stores a redemption rate snapshot
+func (k Keeper) SetRedemptionRateSnapshot(ctx sdk.Context, snapshot types.RedemptionRateSnapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateHistoryKeyPrefix))
+	key := types.RedemptionRateHistoryKey(snapshot.ChainId, snapshot.EpochNumber)
+	store.Set(key, k.cdc.MustMarshal(&snapshot))
+}
+
+// Returns the redemption rate history of a host zone, ordered from oldest to newest
+func (k Keeper) GetRedemptionRateHistory(ctx sdk.Context, chainId string) (snapshots []types.RedemptionRateSnapshot) {
+	snapshots = []types.RedemptionRateSnapshot{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateHistoryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, types.RedemptionRateHistoryChainPrefix(chainId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.RedemptionRateSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+// Returns the redemption rate history across all host zones
+func (k Keeper) GetAllRedemptionRateHistory(ctx sdk.Context) (snapshots []types.RedemptionRateSnapshot) {
+	snapshots = []types.RedemptionRateSnapshot{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateHistoryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.RedemptionRateSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+// Removes all snapshots of a host zone from before the retention window
+// Since snapshots are keyed by epoch number, this only iterates the expired entries
+func (k Keeper) PruneRedemptionRateHistory(ctx sdk.Context, chainId string, currentEpochNumber uint64) {
+	if currentEpochNumber < types.RedemptionRateHistoryRetentionEpochs {
+		return
+	}
+	cutoffEpochNumber := currentEpochNumber - types.RedemptionRateHistoryRetentionEpochs
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateHistoryKeyPrefix))
+	start := types.RedemptionRateHistoryChainPrefix(chainId)
+	end := types.RedemptionRateHistoryKey(chainId, cutoffEpochNumber+1)
+	iterator := store.Iterator(start, end)
+
+	expiredKeys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+}
+
+// Records the host zone's current redemption rate under the current stride epoch,
+// overwriting any earlier snapshot from the same epoch, and prunes expired snapshots
+func (k Keeper) RecordRedemptionRateSnapshot(ctx sdk.Context, hostZone types.HostZone, stSupply sdkmath.Int) {
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		k.Logger(ctx).Error("Stride epoch tracker not found, unable to record redemption rate snapshot")
+		return
+	}
+	epochNumber := strideEpochTracker.EpochNumber
+
+	k.SetRedemptionRateSnapshot(ctx, types.RedemptionRateSnapshot{
+		ChainId:          hostZone.ChainId,
+		EpochNumber:      epochNumber,
+		Time:             ctx.BlockTime(),
+		RedemptionRate:   hostZone.RedemptionRate,
+		TotalDelegations: hostZone.TotalDelegations,
+		StTokenSupply:    stSupply,
+	})
+	k.PruneRedemptionRateHistory(ctx, hostZone.ChainId, epochNumber)
+}
+
+// Calculates the simple (non-compounded) annualized growth in the redemption rate over
+// the trailing window, measured from the oldest snapshot inside the window to the latest snapshot
+//
+//	APR = (latest rate / starting rate - 1) * (seconds per year / seconds elapsed)
+//
+// Returns zero if there are not at least two snapshots inside the window
+func GetTrailingRedemptionRateApr(history []types.RedemptionRateSnapshot, window time.Duration) sdkmath.LegacyDec {
+	if len(history) < 2 {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	latest := history[len(history)-1]
+	windowStartTime := latest.Time.Add(-window)
+
+	for _, start := range history[:len(history)-1] {
+		if start.Time.Before(windowStartTime) {
+			continue
+		}
+
+		secondsElapsed := int64(latest.Time.Sub(start.Time) / time.Second)
+		if secondsElapsed <= 0 || !start.RedemptionRate.IsPositive() {
+			return sdkmath.LegacyZeroDec()
+		}
+
+		growth := latest.RedemptionRate.Quo(start.RedemptionRate).Sub(sdkmath.LegacyOneDec())
+		return growth.MulInt64(secondsPerYear).QuoInt64(secondsElapsed)
+	}
+
+	return sdkmath.LegacyZeroDec()
+}
+
+// Finds the largest decline in the redemption rate from a prior peak, as a fraction of that peak
+// If the redemption rate never declined, the drawdown is zero and the epoch numbers are zero
+func GetMaxRedemptionRateDrawdown(history []types.RedemptionRateSnapshot) (
+	maxDrawdown sdkmath.LegacyDec,
+	peakEpochNumber uint64,
+	troughEpochNumber uint64,
+) {
+	maxDrawdown = sdkmath.LegacyZeroDec()
+	if len(history) == 0 {
+		return maxDrawdown, 0, 0
+	}
+
+	peak := history[0]
+	for _, snapshot := range history {
+		if snapshot.RedemptionRate.GT(peak.RedemptionRate) {
+			peak = snapshot
+			continue
+		}
+		if !peak.RedemptionRate.IsPositive() {
+			continue
+		}
+
+		drawdown := peak.RedemptionRate.Sub(snapshot.RedemptionRate).Quo(peak.RedemptionRate)
+		if drawdown.GT(maxDrawdown) {
+			maxDrawdown = drawdown
+			peakEpochNumber = peak.EpochNumber
+			troughEpochNumber = snapshot.EpochNumber
+		}
+	}
+
+	return maxDrawdown, peakEpochNumber, troughEpochNumber
+}
@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Helper to build a snapshot history from a list of redemption rates, with one snapshot per day
+func newRedemptionRateHistory(startTime time.Time, redemptionRates ...string) []types.RedemptionRateSnapshot {
+	history := []types.RedemptionRateSnapshot{}
+	for i, redemptionRate := range redemptionRates {
+		history = append(history, types.RedemptionRateSnapshot{
+			ChainId:          HostChainId,
+			EpochNumber:      uint64(i + 1),
+			Time:             startTime.Add(time.Duration(i) * 24 * time.Hour),
+			RedemptionRate:   sdkmath.LegacyMustNewDecFromStr(redemptionRate),
+			TotalDelegations: sdkmath.ZeroInt(),
+			StTokenSupply:    sdkmath.ZeroInt(),
+		})
+	}
+	return history
+}
+
+func (s *KeeperTestSuite) TestRecordRedemptionRateSnapshot() {
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     types.RedemptionRateHistoryRetentionEpochs + 10,
+	})
+
+	// Store snapshots for epochs before, at, and after the retention cutoff, as well as
+	// a snapshot for a different host zone that should not be pruned
+	cutoffEpoch := uint64(10)
+	for _, epochNumber := range []uint64{cutoffEpoch - 1, cutoffEpoch, cutoffEpoch + 1} {
+		s.App.StakeibcKeeper.SetRedemptionRateSnapshot(s.Ctx, types.RedemptionRateSnapshot{
+			ChainId:          HostChainId,
+			EpochNumber:      epochNumber,
+			RedemptionRate:   sdkmath.LegacyOneDec(),
+			TotalDelegations: sdkmath.ZeroInt(),
+			StTokenSupply:    sdkmath.ZeroInt(),
+		})
+	}
+	s.App.StakeibcKeeper.SetRedemptionRateSnapshot(s.Ctx, types.RedemptionRateSnapshot{
+		ChainId:          OsmoChainId,
+		EpochNumber:      1,
+		RedemptionRate:   sdkmath.LegacyOneDec(),
+		TotalDelegations: sdkmath.ZeroInt(),
+		StTokenSupply:    sdkmath.ZeroInt(),
+	})
+
+	hostZone := types.HostZone{
+		ChainId:          HostChainId,
+		RedemptionRate:   sdkmath.LegacyMustNewDecFromStr("1.2"),
+		TotalDelegations: sdkmath.NewInt(1000),
+	}
+	s.App.StakeibcKeeper.RecordRedemptionRateSnapshot(s.Ctx, hostZone, sdkmath.NewInt(800))
+
+	// Snapshots at or before the cutoff should be removed
+	history := s.App.StakeibcKeeper.GetRedemptionRateHistory(s.Ctx, HostChainId)
+	s.Require().Len(history, 2, "number of snapshots")
+	s.Require().Equal(cutoffEpoch+1, history[0].EpochNumber, "oldest remaining snapshot")
+
+	latest := history[1]
+	s.Require().Equal(uint64(types.RedemptionRateHistoryRetentionEpochs+10), latest.EpochNumber, "latest epoch number")
+	s.Require().True(s.Ctx.BlockTime().Equal(latest.Time), "latest time")
+	s.Require().Equal("1.200000000000000000", latest.RedemptionRate.String(), "latest redemption rate")
+	s.Require().Equal(int64(1000), latest.TotalDelegations.Int64(), "latest total delegations")
+	s.Require().Equal(int64(800), latest.StTokenSupply.Int64(), "latest stToken supply")
+
+	// The other host zone's history should be untouched
+	s.Require().Len(s.App.StakeibcKeeper.GetRedemptionRateHistory(s.Ctx, OsmoChainId), 1, "other host zone")
+
+	// Recording again in the same epoch should overwrite the latest snapshot
+	hostZone.RedemptionRate = sdkmath.LegacyMustNewDecFromStr("1.3")
+	s.App.StakeibcKeeper.RecordRedemptionRateSnapshot(s.Ctx, hostZone, sdkmath.NewInt(800))
+
+	history = s.App.StakeibcKeeper.GetRedemptionRateHistory(s.Ctx, HostChainId)
+	s.Require().Len(history, 2, "number of snapshots after overwrite")
+	s.Require().Equal("1.300000000000000000", history[1].RedemptionRate.String(), "overwritten redemption rate")
+}
+
+func (s *KeeperTestSuite) TestGetTrailingRedemptionRateApr() {
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+
+	testCases := []struct {
+		name        string
+		history     []types.RedemptionRateSnapshot
+		window      time.Duration
+		expectedApr sdkmath.LegacyDec
+	}{
+		{
+			name:        "no history",
+			history:     []types.RedemptionRateSnapshot{},
+			window:      keeper.AprWindow7Day,
+			expectedApr: sdkmath.LegacyZeroDec(),
+		},
+		{
+			name:        "single snapshot",
+			history:     newRedemptionRateHistory(startTime, "1.0"),
+			window:      keeper.AprWindow7Day,
+			expectedApr: sdkmath.LegacyZeroDec(),
+		},
+		{
+			// 0.01 growth over 1 day => 0.01 * 365 = 3.65
+			name:        "two snapshots",
+			history:     newRedemptionRateHistory(startTime, "1.0", "1.01"),
+			window:      keeper.AprWindow7Day,
+			expectedApr: sdkmath.LegacyMustNewDecFromStr("3.65"),
+		},
+		{
+			// The first snapshot is outside the 7 day window, so the window starts from "1.1" on day 1
+			// 0.11 / 1.1 = 0.1 growth over 7 days => 0.1 * 365 / 7
+			name:        "history longer than window",
+			history:     newRedemptionRateHistory(startTime, "1.0", "1.1", "1.1", "1.1", "1.1", "1.1", "1.1", "1.1", "1.21"),
+			window:      keeper.AprWindow7Day,
+			expectedApr: sdkmath.LegacyMustNewDecFromStr("0.1").MulInt64(365).QuoInt64(7),
+		},
+		{
+			// The full history is within the 30 day window
+			// 0.21 growth over 8 days => 0.21 * 365 / 8
+			name:        "history shorter than window",
+			history:     newRedemptionRateHistory(startTime, "1.0", "1.1", "1.1", "1.1", "1.1", "1.1", "1.1", "1.1", "1.21"),
+			window:      keeper.AprWindow30Day,
+			expectedApr: sdkmath.LegacyMustNewDecFromStr("0.21").MulInt64(365).QuoInt64(8),
+		},
+		{
+			// -0.1 growth over 1 day
+			name:        "declining redemption rate",
+			history:     newRedemptionRateHistory(startTime, "1.0", "0.9"),
+			window:      keeper.AprWindow7Day,
+			expectedApr: sdkmath.LegacyMustNewDecFromStr("-36.5"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			actualApr := keeper.GetTrailingRedemptionRateApr(tc.history, tc.window)
+			s.Require().Equal(tc.expectedApr.String(), actualApr.String(), "apr")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetMaxRedemptionRateDrawdown() {
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+
+	testCases := []struct {
+		name                string
+		history             []types.RedemptionRateSnapshot
+		expectedDrawdown    sdkmath.LegacyDec
+		expectedPeakEpoch   uint64
+		expectedTroughEpoch uint64
+	}{
+		{
+			name:             "no history",
+			history:          []types.RedemptionRateSnapshot{},
+			expectedDrawdown: sdkmath.LegacyZeroDec(),
+		},
+		{
+			name:             "monotonically increasing",
+			history:          newRedemptionRateHistory(startTime, "1.0", "1.1", "1.2"),
+			expectedDrawdown: sdkmath.LegacyZeroDec(),
+		},
+		{
+			// 1.2 -> 0.9 = 0.25 decline
+			name:                "single drawdown",
+			history:             newRedemptionRateHistory(startTime, "1.0", "1.2", "1.0", "0.9", "1.3"),
+			expectedDrawdown:    sdkmath.LegacyMustNewDecFromStr("0.25"),
+			expectedPeakEpoch:   2,
+			expectedTroughEpoch: 4,
+		},
+		{
+			// 1.0 -> 0.9 = 0.1 decline, then 2.0 -> 1.0 = 0.5 decline
+			name:                "largest drawdown after a new peak",
+			history:             newRedemptionRateHistory(startTime, "1.0", "0.9", "2.0", "1.0", "1.5"),
+			expectedDrawdown:    sdkmath.LegacyMustNewDecFromStr("0.5"),
+			expectedPeakEpoch:   3,
+			expectedTroughEpoch: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			drawdown, peakEpoch, troughEpoch := keeper.GetMaxRedemptionRateDrawdown(tc.history)
+			s.Require().Equal(tc.expectedDrawdown.String(), drawdown.String(), "drawdown")
+			s.Require().Equal(tc.expectedPeakEpoch, peakEpoch, "peak epoch")
+			s.Require().Equal(tc.expectedTroughEpoch, troughEpoch, "trough epoch")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRedemptionRateHistoryQueries() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: HostChainId})
+
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	history := newRedemptionRateHistory(startTime, "1.0", "1.2", "0.9", "1.01")
+	for _, snapshot := range history {
+		s.App.StakeibcKeeper.SetRedemptionRateSnapshot(s.Ctx, snapshot)
+	}
+
+	historyResponse, err := s.App.StakeibcKeeper.RedemptionRateHistory(s.Ctx, &types.QueryRedemptionRateHistoryRequest{
+		ChainId: HostChainId,
+	})
+	s.Require().NoError(err, "no error expected when querying history")
+	s.Require().Equal(history, historyResponse.Snapshots, "history")
+
+	// 0.01 growth over 3 days => 0.01 * 365 / 3
+	aprResponse, err := s.App.StakeibcKeeper.RedemptionRateApr(s.Ctx, &types.QueryRedemptionRateAprRequest{
+		ChainId: HostChainId,
+	})
+	s.Require().NoError(err, "no error expected when querying apr")
+	expectedApr := sdkmath.LegacyMustNewDecFromStr("0.01").MulInt64(365).QuoInt64(3)
+	s.Require().Equal(expectedApr.String(), aprResponse.Apr_7D.String(), "7 day apr")
+	s.Require().Equal(expectedApr.String(), aprResponse.Apr_30D.String(), "30 day apr")
+
+	drawdownResponse, err := s.App.StakeibcKeeper.RedemptionRateDrawdown(s.Ctx, &types.QueryRedemptionRateDrawdownRequest{
+		ChainId: HostChainId,
+	})
+	s.Require().NoError(err, "no error expected when querying drawdown")
+	s.Require().Equal(sdkmath.LegacyMustNewDecFromStr("0.25").String(), drawdownResponse.MaxDrawdown.String(), "max drawdown")
+	s.Require().Equal(uint64(2), drawdownResponse.PeakEpochNumber, "peak epoch")
+	s.Require().Equal(uint64(3), drawdownResponse.TroughEpochNumber, "trough epoch")
+
+	// Invalid host zone
+	_, err = s.App.StakeibcKeeper.RedemptionRateApr(s.Ctx, &types.QueryRedemptionRateAprRequest{ChainId: "fake-chain"})
+	s.Require().ErrorContains(err, "host zone fake-chain not found")
+}
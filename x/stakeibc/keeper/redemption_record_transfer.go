@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Reassigns an unclaimed user redemption record to a new owner and receiver
+//
+// Since user redemption records are keyed by {chain_id}.{epoch}.{receiver}, changing the
+// receiver moves the record to a new key. If the new receiver already has a record in the
+// same epoch, the two are merged, provided that record is owned by the new owner
+// The record ID is swapped on the host zone unbonding so that the unbonding and claim
+// flows pick up the new record
+func (k Keeper) TransferRedemptionRecord(ctx sdk.Context, msg *types.MsgTransferRedemptionRecord) (*types.MsgTransferRedemptionRecordResponse, error) {
+	hostZone, err := k.GetActiveHostZone(ctx, msg.HostZoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Confirm the new receiver is a valid address on the host zone
+	if _, err := utils.AccAddressFromBech32(msg.NewReceiver, hostZone.Bech32Prefix); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new receiver address (%s)", err)
+	}
+	if hostZone.InstantRedemptionBufferAddress != "" && msg.NewReceiver == hostZone.InstantRedemptionBufferAddress {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"redemption records cannot be transferred to the instant redemption buffer")
+	}
+
+	// Confirm the record exists, is owned by the sender, and has not yet been claimed
+	previousRecordId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, msg.Epoch, msg.Receiver)
+	userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, previousRecordId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"user redemption record %s not found on host zone %s", previousRecordId, hostZone.ChainId)
+	}
+	// If the record is tokenized, the owner is the holder of the NFT
+	recordOwner := k.GetRedemptionRecordOwner(ctx, userRedemptionRecord)
+	if recordOwner == "" || recordOwner != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionRecordNotOwned,
+			"user redemption record %s is not owned by %s", previousRecordId, msg.Creator)
+	}
+	if userRedemptionRecord.ClaimIsPending {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"user redemption record %s cannot be transferred while a claim is pending", previousRecordId)
+	}
+
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, msg.Epoch, hostZone.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+			"host zone unbonding not found for epoch %d on host zone %s", msg.Epoch, hostZone.ChainId)
+	}
+
+	// Transferring a tokenized record burns the NFT, so the new owner holds a plain record
+	if err := k.BurnRedemptionRecordNFT(ctx, userRedemptionRecord); err != nil {
+		return nil, err
+	}
+	userRedemptionRecord.NftId = ""
+
+	// If only the owner is changing, the record can be updated in place
+	newRecordId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, msg.Epoch, msg.NewReceiver)
+	if newRecordId == previousRecordId {
+		userRedemptionRecord.Owner = msg.NewOwner
+		k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+
+		EmitRedemptionRecordTransferEvent(ctx, msg, userRedemptionRecord.NativeTokenAmount, userRedemptionRecord.StTokenAmount)
+		return &types.MsgTransferRedemptionRecordResponse{}, nil
+	}
+
+	// Otherwise, either merge into the new receiver's existing record or create a new one
+	newRecord, newRecordExists := k.RecordsKeeper.GetUserRedemptionRecord(ctx, newRecordId)
+	if newRecordExists {
+		if newRecord.NftId != "" {
+			return nil, errorsmod.Wrapf(types.ErrRedemptionRecordTokenized,
+				"user redemption record %s is tokenized and cannot be merged into", newRecordId)
+		}
+		if newRecord.Owner != msg.NewOwner {
+			return nil, errorsmod.Wrapf(types.ErrRedemptionRecordNotOwned,
+				"user redemption record %s already exists and is not owned by %s", newRecordId, msg.NewOwner)
+		}
+		if newRecord.ClaimIsPending {
+			return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord,
+				"user redemption record %s cannot be merged into while a claim is pending", newRecordId)
+		}
+		newRecord.NativeTokenAmount = newRecord.NativeTokenAmount.Add(userRedemptionRecord.NativeTokenAmount)
+		newRecord.StTokenAmount = newRecord.StTokenAmount.Add(userRedemptionRecord.StTokenAmount)
+	} else {
+		newRecord = userRedemptionRecord
+		newRecord.Id = newRecordId
+		newRecord.Receiver = msg.NewReceiver
+		newRecord.Owner = msg.NewOwner
+	}
+
+	// Swap the record ID on the host zone unbonding
+	updatedRecordIds := []string{}
+	for _, recordId := range hostZoneUnbonding.UserRedemptionRecords {
+		if recordId != previousRecordId {
+			updatedRecordIds = append(updatedRecordIds, recordId)
+		}
+	}
+	if !newRecordExists {
+		updatedRecordIds = append(updatedRecordIds, newRecordId)
+	}
+	hostZoneUnbonding.UserRedemptionRecords = updatedRecordIds
+
+	k.RecordsKeeper.RemoveUserRedemptionRecord(ctx, previousRecordId)
+	k.RecordsKeeper.SetUserRedemptionRecord(ctx, newRecord)
+	if err := k.RecordsKeeper.SetHostZoneUnbondingRecord(ctx, msg.Epoch, hostZone.ChainId, *hostZoneUnbonding); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Transferred user redemption record %s to %s", previousRecordId, newRecordId))
+	EmitRedemptionRecordTransferEvent(ctx, msg, userRedemptionRecord.NativeTokenAmount, userRedemptionRecord.StTokenAmount)
+
+	return &types.MsgTransferRedemptionRecordResponse{}, nil
+}
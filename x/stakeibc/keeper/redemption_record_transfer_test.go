@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+type TransferRedemptionRecordTestCase struct {
+	owner       string
+	newOwner    string
+	receiver    string
+	newReceiver string
+	epochNumber uint64
+	validMsg    types.MsgTransferRedemptionRecord
+}
+
+func (s *KeeperTestSuite) SetupTransferRedemptionRecord() TransferRedemptionRecordTestCase {
+	owner := s.TestAccs[0].String()
+	newOwner := s.TestAccs[1].String()
+	receiver := "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf"
+	newReceiver := "cosmos1rq3pjmk9hr4xq99e8jx683rukjmxhcp4vtjhmy"
+	epochNumber := uint64(1)
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:      HostChainId,
+		HostDenom:    "uatom",
+		Bech32Prefix: "cosmos",
+	})
+
+	// Create two records on the host zone unbonding, one of which will be transferred
+	recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, receiver)
+	otherRecordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, "cosmosXXX")
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:                recordId,
+		Receiver:          receiver,
+		HostZoneId:        HostChainId,
+		EpochNumber:       epochNumber,
+		Denom:             "uatom",
+		NativeTokenAmount: sdkmath.NewInt(1500),
+		StTokenAmount:     sdkmath.NewInt(1000),
+		Owner:             owner,
+	})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: epochNumber,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+			HostZoneId:            HostChainId,
+			Status:                recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+			NativeTokenAmount:     sdkmath.NewInt(1500),
+			StTokenAmount:         sdkmath.NewInt(1000),
+			UserRedemptionRecords: []string{otherRecordId, recordId},
+		}},
+	})
+
+	return TransferRedemptionRecordTestCase{
+		owner:       owner,
+		newOwner:    newOwner,
+		receiver:    receiver,
+		newReceiver: newReceiver,
+		epochNumber: epochNumber,
+		validMsg: types.MsgTransferRedemptionRecord{
+			Creator:     owner,
+			HostZoneId:  HostChainId,
+			Epoch:       epochNumber,
+			Receiver:    receiver,
+			NewOwner:    newOwner,
+			NewReceiver: newReceiver,
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_Successful() {
+	tc := s.SetupTransferRedemptionRecord()
+
+	_, err := s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected when transferring record")
+
+	// The old record should be removed and the new record should be created under the new receiver
+	oldRecordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, tc.receiver)
+	newRecordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, tc.newReceiver)
+
+	_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, oldRecordId)
+	s.Require().False(found, "old record should have been removed")
+
+	newRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, newRecordId)
+	s.Require().True(found, "new record should have been created")
+	s.Require().Equal(tc.newReceiver, newRecord.Receiver, "new record receiver")
+	s.Require().Equal(tc.newOwner, newRecord.Owner, "new record owner")
+	s.Require().Equal(int64(1500), newRecord.NativeTokenAmount.Int64(), "new record native amount")
+	s.Require().Equal(int64(1000), newRecord.StTokenAmount.Int64(), "new record sttoken amount")
+
+	// The record ID should be swapped on the host zone unbonding, and the amounts should be unchanged
+	hostZoneUnbonding := s.MustGetHostZoneUnbonding(tc.epochNumber, HostChainId)
+	otherRecordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, "cosmosXXX")
+	s.Require().Equal([]string{otherRecordId, newRecordId}, hostZoneUnbonding.UserRedemptionRecords, "host zone unbonding record ids")
+	s.Require().Equal(int64(1500), hostZoneUnbonding.NativeTokenAmount.Int64(), "host zone unbonding native amount")
+
+	// The new owner should now be able to transfer the record back
+	_, err = s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &types.MsgTransferRedemptionRecord{
+		Creator:     tc.newOwner,
+		HostZoneId:  HostChainId,
+		Epoch:       tc.epochNumber,
+		Receiver:    tc.newReceiver,
+		NewOwner:    tc.owner,
+		NewReceiver: tc.receiver,
+	})
+	s.Require().NoError(err, "no error expected when transferring record back")
+
+	// But the previous owner should not be able to transfer it again
+	_, err = s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &types.MsgTransferRedemptionRecord{
+		Creator:     tc.newOwner,
+		HostZoneId:  HostChainId,
+		Epoch:       tc.epochNumber,
+		Receiver:    tc.receiver,
+		NewOwner:    tc.newOwner,
+		NewReceiver: tc.newReceiver,
+	})
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordNotOwned)
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_OwnerOnly() {
+	tc := s.SetupTransferRedemptionRecord()
+
+	// Transfer the record without changing the receiver
+	msg := tc.validMsg
+	msg.NewReceiver = tc.receiver
+
+	_, err := s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when transferring record owner")
+
+	recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, tc.receiver)
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+	s.Require().True(found, "record should still exist")
+	s.Require().Equal(tc.newOwner, record.Owner, "record owner")
+	s.Require().Equal(tc.receiver, record.Receiver, "record receiver")
+
+	hostZoneUnbonding := s.MustGetHostZoneUnbonding(tc.epochNumber, HostChainId)
+	s.Require().Len(hostZoneUnbonding.UserRedemptionRecords, 2, "number of records on host zone unbonding")
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_MergeIntoExistingRecord() {
+	tc := s.SetupTransferRedemptionRecord()
+
+	// Create a record for the new receiver that's already owned by the new owner
+	newRecordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, tc.newReceiver)
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:                newRecordId,
+		Receiver:          tc.newReceiver,
+		HostZoneId:        HostChainId,
+		EpochNumber:       tc.epochNumber,
+		NativeTokenAmount: sdkmath.NewInt(300),
+		StTokenAmount:     sdkmath.NewInt(200),
+		Owner:             tc.newOwner,
+	})
+	hostZoneUnbonding := s.MustGetHostZoneUnbonding(tc.epochNumber, HostChainId)
+	hostZoneUnbonding.UserRedemptionRecords = append(hostZoneUnbonding.UserRedemptionRecords, newRecordId)
+	err := s.App.RecordsKeeper.SetHostZoneUnbondingRecord(s.Ctx, tc.epochNumber, HostChainId, hostZoneUnbonding)
+	s.Require().NoError(err, "no error expected when setting host zone unbonding")
+
+	_, err = s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected when merging records")
+
+	// The amounts should be combined on the existing record
+	mergedRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, newRecordId)
+	s.Require().True(found, "merged record should exist")
+	s.Require().Equal(int64(1800), mergedRecord.NativeTokenAmount.Int64(), "merged record native amount")
+	s.Require().Equal(int64(1200), mergedRecord.StTokenAmount.Int64(), "merged record sttoken amount")
+
+	// The old record should be removed from the host zone unbonding, without duplicating the new record
+	otherRecordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, "cosmosXXX")
+	hostZoneUnbonding = s.MustGetHostZoneUnbonding(tc.epochNumber, HostChainId)
+	s.Require().Equal([]string{otherRecordId, newRecordId}, hostZoneUnbonding.UserRedemptionRecords, "host zone unbonding record ids")
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_MergeIntoRecordWithDifferentOwner() {
+	tc := s.SetupTransferRedemptionRecord()
+
+	// Create a record for the new receiver that's owned by a different account
+	newRecordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, tc.newReceiver)
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:                newRecordId,
+		Receiver:          tc.newReceiver,
+		HostZoneId:        HostChainId,
+		EpochNumber:       tc.epochNumber,
+		NativeTokenAmount: sdkmath.NewInt(300),
+		StTokenAmount:     sdkmath.NewInt(200),
+		Owner:             s.TestAccs[2].String(),
+	})
+
+	_, err := s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &tc.validMsg)
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordNotOwned)
+	s.Require().ErrorContains(err, "already exists")
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_NotOwner() {
+	tc := s.SetupTransferRedemptionRecord()
+
+	msg := tc.validMsg
+	msg.Creator = s.TestAccs[2].String()
+
+	_, err := s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordNotOwned)
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_RecordWithoutOwner() {
+	tc := s.SetupTransferRedemptionRecord()
+
+	// Remove the owner from the record (e.g. a record created before ownership was tracked)
+	recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, tc.receiver)
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+	s.Require().True(found, "record should exist")
+	record.Owner = ""
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, record)
+
+	_, err := s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &tc.validMsg)
+	s.Require().ErrorIs(err, types.ErrRedemptionRecordNotOwned)
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_ClaimPending() {
+	tc := s.SetupTransferRedemptionRecord()
+
+	recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.epochNumber, tc.receiver)
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+	s.Require().True(found, "record should exist")
+	record.ClaimIsPending = true
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, record)
+
+	_, err := s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &tc.validMsg)
+	s.Require().ErrorContains(err, "cannot be transferred while a claim is pending")
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_RecordNotFound() {
+	tc := s.SetupTransferRedemptionRecord()
+
+	msg := tc.validMsg
+	msg.Epoch = 2
+
+	_, err := s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "user redemption record GAIA.2")
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_InvalidNewReceiver() {
+	tc := s.SetupTransferRedemptionRecord()
+
+	msg := tc.validMsg
+	msg.NewReceiver = "stride1xxx"
+
+	_, err := s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "invalid new receiver address")
+}
+
+func (s *KeeperTestSuite) TestTransferRedemptionRecord_HaltedZone() {
+	tc := s.SetupTransferRedemptionRecord()
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().TransferRedemptionRecord(s.Ctx, &tc.validMsg)
+	s.Require().ErrorContains(err, "halted")
+}
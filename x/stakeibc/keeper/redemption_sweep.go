@@ -54,44 +54,66 @@ func (k Keeper) GetTotalRedemptionSweepAmountAndRecordIds(
 	return totalSweepAmount, unbondingRecordIds
 }
 
-// Batch transfers any unbonded tokens from the delegation account to the redemption account
-func (k Keeper) SweepUnbondedTokensForHostZone(ctx sdk.Context, hostZone types.HostZone) error {
+// Builds the bank send ICA message that transfers any unbonded tokens from the delegation
+// account to the redemption account, without submitting it
+// If no tokens have finished unbonding, no message is returned
+func (k Keeper) GetRedemptionSweepICAMessages(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+) (msgs []proto.Message, totalSweepAmount sdkmath.Int, epochUnbondingRecordIds []uint64, err error) {
 	chainId := hostZone.ChainId
-	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sweeping unbonded tokens"))
 
 	// Confirm the delegation (destination) and redemption (source) accounts are registered
 	if hostZone.DelegationIcaAddress == "" {
-		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", chainId)
+		return nil, totalSweepAmount, nil, errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", chainId)
 	}
 	if hostZone.RedemptionIcaAddress == "" {
-		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no redemption account found for %s", chainId)
+		return nil, totalSweepAmount, nil, errorsmod.Wrapf(types.ErrICAAccountNotFound, "no redemption account found for %s", chainId)
 	}
 
 	// Get latest blockTime from light client
 	hostBlockTime, err := k.GetLightClientTime(ctx, hostZone.ConnectionId)
 	if err != nil {
-		return errorsmod.Wrapf(err, "could not get light client block time for host zone")
+		return nil, totalSweepAmount, nil, errorsmod.Wrapf(err, "could not get light client block time for host zone")
 	}
 
 	// Determine the total unbonded amount that has finished unbonding
-	totalSweepAmount, epochUnbondingRecordIds := k.GetTotalRedemptionSweepAmountAndRecordIds(ctx, chainId, hostBlockTime)
+	totalSweepAmount, epochUnbondingRecordIds = k.GetTotalRedemptionSweepAmountAndRecordIds(ctx, chainId, hostBlockTime)
 
-	// If we have any amount to sweep, then we can send the ICA call to sweep them
+	// Only build the message if we have an amount to sweep
 	if totalSweepAmount.LTE(sdkmath.ZeroInt()) {
-		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "No tokens ready for sweep"))
-		return nil
+		return nil, totalSweepAmount, epochUnbondingRecordIds, nil
 	}
-	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Batch transferring %v to host zone", totalSweepAmount))
 
 	// Build transfer message to transfer from the delegation account to redemption account
 	sweepCoin := sdk.NewCoin(hostZone.HostDenom, totalSweepAmount)
-	msgs := []proto.Message{
+	msgs = []proto.Message{
 		&banktypes.MsgSend{
 			FromAddress: hostZone.DelegationIcaAddress,
 			ToAddress:   hostZone.RedemptionIcaAddress,
 			Amount:      sdk.NewCoins(sweepCoin),
 		},
 	}
+
+	return msgs, totalSweepAmount, epochUnbondingRecordIds, nil
+}
+
+// Batch transfers any unbonded tokens from the delegation account to the redemption account
+func (k Keeper) SweepUnbondedTokensForHostZone(ctx sdk.Context, hostZone types.HostZone) error {
+	chainId := hostZone.ChainId
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sweeping unbonded tokens"))
+
+	msgs, totalSweepAmount, epochUnbondingRecordIds, err := k.GetRedemptionSweepICAMessages(ctx, hostZone)
+	if err != nil {
+		return err
+	}
+
+	// If there's nothing to sweep, there's no need to send an ICA
+	if len(msgs) == 0 {
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "No tokens ready for sweep"))
+		return nil
+	}
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Batch transferring %v to host zone", totalSweepAmount))
 	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Preparing MsgSend from Delegation Account to Redemption Account"))
 
 	// Store the epoch numbers in the callback to identify the epoch unbonding records
@@ -9,6 +9,7 @@ import (
 
 	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
 	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
 	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 )
 
@@ -153,6 +154,47 @@ func (s *KeeperTestSuite) TestSweepUnbondedTokensForHostZone_Successful() {
 	s.CheckEventValueEmitted(types.EventTypeRedemptionSweep, types.AttributeKeySweptAmount, "4000000")
 }
 
+func (s *KeeperTestSuite) TestQueryRedemptionSweepPlan() {
+	tc := s.SetupSweepUnbondedTokens()
+
+	resp, err := s.App.StakeibcKeeper.RedemptionSweepPlan(s.Ctx, &types.QueryRedemptionSweepPlanRequest{ChainId: HostChainId})
+	s.Require().NoError(err, "no error expected when querying sweep plan")
+
+	s.Require().Equal(int64(4_000_000), resp.TotalSweepAmount.Int64(), "total sweep amount")
+	s.Require().Equal([]uint64{1, 2}, resp.EpochUnbondingRecordIds, "epoch unbonding record ids")
+
+	s.Require().Len(resp.Txs, 1, "number of planned txs")
+	s.Require().Equal(keeper.ICACallbackID_Redemption, resp.Txs[0].CallbackId, "callback id")
+	s.Require().Len(resp.Txs[0].Msgs, 1, "number of planned msgs")
+	s.Require().Equal("/cosmos.bank.v1beta1.MsgSend", resp.Txs[0].Msgs[0].TypeUrl, "msg type")
+	s.Require().Contains(resp.Txs[0].Msgs[0].Json, "cosmos_REDEMPTION", "msg json")
+
+	// No ICA should have been submitted and the record statuses should be unchanged
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.delegationPortID, tc.delegationChannelID)
+	s.Require().True(found, "sequence number not found")
+	s.Require().Equal(tc.channelStartSequence, endSequence, "sequence number should not have changed")
+	for _, epochUnbondingRecord := range s.App.RecordsKeeper.GetAllEpochUnbondingRecord(s.Ctx) {
+		for _, hostZoneUnbondingRecord := range epochUnbondingRecord.HostZoneUnbondings {
+			s.Require().Equal(recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE.String(), hostZoneUnbondingRecord.Status.String(),
+				"epoch unbonding record status for record %d and host zone %s",
+				epochUnbondingRecord.EpochNumber, hostZoneUnbondingRecord.HostZoneId)
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryRedemptionSweepPlan_NothingToSweep() {
+	s.SetupSweepUnbondedTokens()
+
+	// Move the records out of the transfer queue so there's nothing to sweep
+	err := s.App.RecordsKeeper.SetHostZoneUnbondingStatus(s.Ctx, HostChainId, []uint64{1, 2}, recordtypes.HostZoneUnbonding_CLAIMABLE)
+	s.Require().NoError(err, "no error expected when updating status")
+
+	resp, err := s.App.StakeibcKeeper.RedemptionSweepPlan(s.Ctx, &types.QueryRedemptionSweepPlanRequest{ChainId: HostChainId})
+	s.Require().NoError(err, "no error expected when querying sweep plan")
+	s.Require().Zero(resp.TotalSweepAmount.Int64(), "total sweep amount")
+	s.Require().Empty(resp.Txs, "planned txs")
+}
+
 func (s *KeeperTestSuite) TestSweepUnbondedTokensForHostZone_MissingDelegationAccount() {
 	tc := s.SetupSweepUnbondedTokens()
 	hostZone := tc.hostZones[0]
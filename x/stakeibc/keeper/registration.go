@@ -19,6 +19,9 @@ import (
 var (
 	CommunityPoolStakeHoldingAddressKey  = "community-pool-stake"
 	CommunityPoolRedeemHoldingAddressKey = "community-pool-redeem"
+	InstantRedemptionBufferAddressKey    = "instant-redemption-buffer"
+	InsuranceFundAddressKey              = "insurance-fund"
+	AuctionProceedsAddressKey            = "auction-proceeds"
 
 	DefaultMaxMessagesPerIcaTx = uint64(32)
 )
@@ -76,6 +79,12 @@ func (k Keeper) RegisterHostZone(ctx sdk.Context, msg *types.MsgRegisterHostZone
 		return nil, errorsmod.Wrapf(err, "unable to create community pool redeem account for host zone %s", chainId)
 	}
 
+	// Create the host zone's instant redemption buffer account
+	instantRedemptionBufferAddress := types.NewHostZoneModuleAddress(chainId, InstantRedemptionBufferAddressKey)
+	if err := utils.CreateModuleAccount(ctx, k.AccountKeeper, instantRedemptionBufferAddress); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to create instant redemption buffer account for host zone %s", chainId)
+	}
+
 	// Validate the community pool treasury address if it's non-empty
 	if msg.CommunityPoolTreasuryAddress != "" {
 		_, err := utils.AccAddressFromBech32(msg.CommunityPoolTreasuryAddress, msg.Bech32Prefix)
@@ -122,6 +131,9 @@ func (k Keeper) RegisterHostZone(ctx sdk.Context, msg *types.MsgRegisterHostZone
 		CommunityPoolTreasuryAddress: msg.CommunityPoolTreasuryAddress,
 		MaxMessagesPerIcaTx:          maxMessagesPerIcaTx,
 		RedemptionsEnabled:           true,
+		// Instant redemptions are disabled until a config is set through governance
+		InstantRedemptionBufferAddress: instantRedemptionBufferAddress.String(),
+		InstantRedemptionBufferBalance: sdkmath.ZeroInt(),
 	}
 	// write the zone back to the store
 	k.SetHostZone(ctx, zone)
@@ -206,14 +206,17 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success_Unregister() {
 	depositAddress := types.NewHostZoneDepositAddress(chainId)
 	communityPoolStakeAddress := types.NewHostZoneModuleAddress(chainId, keeper.CommunityPoolStakeHoldingAddressKey)
 	communityPoolRedeemAddress := types.NewHostZoneModuleAddress(chainId, keeper.CommunityPoolRedeemHoldingAddressKey)
+	instantRedemptionBufferAddress := types.NewHostZoneModuleAddress(chainId, keeper.InstantRedemptionBufferAddressKey)
 
 	depositAccount := s.App.AccountKeeper.GetAccount(s.Ctx, depositAddress)
 	communityPoolStakeAccount := s.App.AccountKeeper.GetAccount(s.Ctx, communityPoolStakeAddress)
 	communityPoolRedeemAccount := s.App.AccountKeeper.GetAccount(s.Ctx, communityPoolRedeemAddress)
+	instantRedemptionBufferAccount := s.App.AccountKeeper.GetAccount(s.Ctx, instantRedemptionBufferAddress)
 
 	s.Require().NotNil(depositAccount, "deposit account should exist")
 	s.Require().NotNil(communityPoolStakeAccount, "community pool stake account should exist")
 	s.Require().NotNil(communityPoolRedeemAccount, "community pool redeem account should exist")
+	s.Require().NotNil(instantRedemptionBufferAccount, "instant redemption buffer account should exist")
 
 	// Confirm records were created
 	depositRecords := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx)
@@ -223,6 +226,9 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success_Unregister() {
 	s.Require().Len(epochUnbondingRecords, 1, "there should be one epoch unbonding record")
 	s.Require().Len(epochUnbondingRecords[0].HostZoneUnbondings, 1, "there should be one host zone unbonding record")
 
+	// Store an onboarding configuration for the host zone
+	s.App.StakeibcKeeper.SetHostZoneOnboarding(s.Ctx, types.HostZoneOnboarding{ChainId: HostChainId, OracleChainId: "oracle"})
+
 	// Unregister the host zone
 	err = s.App.StakeibcKeeper.UnregisterHostZone(s.Ctx, HostChainId)
 	s.Require().NoError(err, "no error expected when unregistering host zone")
@@ -231,10 +237,12 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success_Unregister() {
 	depositAccount = s.App.AccountKeeper.GetAccount(s.Ctx, depositAddress)
 	communityPoolStakeAccount = s.App.AccountKeeper.GetAccount(s.Ctx, communityPoolStakeAddress)
 	communityPoolRedeemAccount = s.App.AccountKeeper.GetAccount(s.Ctx, communityPoolRedeemAddress)
+	instantRedemptionBufferAccount = s.App.AccountKeeper.GetAccount(s.Ctx, instantRedemptionBufferAddress)
 
 	s.Require().Nil(depositAccount, "deposit account should have been deleted")
 	s.Require().Nil(communityPoolStakeAccount, "community pool stake account should have been deleted")
 	s.Require().Nil(communityPoolRedeemAccount, "community pool redeem account should have been deleted")
+	s.Require().Nil(instantRedemptionBufferAccount, "instant redemption buffer account should have been deleted")
 
 	// Confirm records were deleted
 	depositRecords = s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx)
@@ -243,6 +251,9 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success_Unregister() {
 	epochUnbondingRecords = s.App.RecordsKeeper.GetAllEpochUnbondingRecord(s.Ctx)
 	s.Require().Empty(epochUnbondingRecords[0].HostZoneUnbondings, "host zone unbonding record should have been deleted")
 
+	_, found := s.App.StakeibcKeeper.GetHostZoneOnboarding(s.Ctx, HostChainId)
+	s.Require().False(found, "host zone onboarding should have been deleted")
+
 	// Attempt to re-register, it should succeed
 	_, err = s.GetMsgServer().RegisterHostZone(s.Ctx, &msg)
 	s.Require().NoError(err, "no error expected when re-registering host")
@@ -16,7 +16,10 @@ import (
 // Sends 15% to PoA validators, and the remainder to the auction module
 // ConsumerRedistributionFraction = what Stride keeps = 0.85 on mainnet
 // ICS Portion = 1 - ConsumerRedistributionFraction = 0.15
-// Fees arrive in the reward collector account as native tokens
+// Fees arrive in the reward collector account as native tokens, with the exception of
+// redemption fees which arrive as stTokens
+// Redemption fees are only sent to the auction module if there's an auction selling the stToken,
+// otherwise they're held in the reward collector until one is created
 func (k Keeper) AuctionOffRewardCollectorBalance(ctx sdk.Context) {
 	rewardCollectorAddress := k.AccountKeeper.GetModuleAccount(ctx, types.RewardCollectorName).GetAddress()
 
@@ -87,4 +90,36 @@ func (k Keeper) AuctionOffRewardCollectorBalance(ctx sdk.Context) {
 			continue
 		}
 	}
+
+	// Redemption fees arrive in the reward collector as stTokens and are sent directly to the auction module
+	for _, hz := range k.GetAllActiveHostZone(ctx) {
+		if hz.HostDenom == "" {
+			continue
+		}
+		stTokenBalance := k.bankKeeper.GetBalance(ctx, rewardCollectorAddress, utils.StAssetDenomFromHostZoneDenom(hz.HostDenom))
+		if stTokenBalance.IsZero() {
+			continue
+		}
+		if !k.HasAuctionForDenom(ctx, stTokenBalance.Denom) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hz.ChainId,
+				"No auction found for %s, holding redemption fees in the RewardCollector", stTokenBalance.Denom))
+			continue
+		}
+
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.RewardCollectorName, auctiontypes.ModuleName, sdk.NewCoins(stTokenBalance))
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Cannot send redemption fees from RewardCollector to Auction module: %s", err))
+			continue
+		}
+	}
+}
+
+// Returns true if an auction exists that sells the given denom
+func (k Keeper) HasAuctionForDenom(ctx sdk.Context, denom string) bool {
+	for _, auction := range k.AuctionKeeper.GetAllAuctions(ctx) {
+		if auction.SellingDenom == denom {
+			return true
+		}
+	}
+	return false
 }
@@ -132,6 +132,33 @@ func (s *KeeperTestSuite) TestLiquidStakeRewardCollectorBalance_Success() {
 	s.checkModuleAccountBalance(stakeibctypes.RewardCollectorName, IbcOsmo, sdkmath.ZeroInt())
 }
 
+func (s *KeeperTestSuite) TestAuctionOffRewardCollectorBalance_RedemptionFees() {
+	s.SetupTestRewardAllocation()
+	feeAmount := sdkmath.NewInt(1000)
+
+	// Fund the reward collector with redemption fees for both host zones
+	s.FundModuleAccount(stakeibctypes.RewardCollectorName, sdk.NewCoin(StAtom, feeAmount))
+	s.FundModuleAccount(stakeibctypes.RewardCollectorName, sdk.NewCoin(StOsmo, feeAmount))
+
+	// Only create an auction for stAtom
+	s.App.AuctionKeeper.SetAuction(s.Ctx, &auctiontypes.Auction{
+		Name:         "auction-statom",
+		SellingDenom: StAtom,
+		PaymentDenom: "ustrd",
+		Enabled:      true,
+	})
+
+	s.App.StakeibcKeeper.AuctionOffRewardCollectorBalance(s.Ctx)
+
+	// The stAtom fees should be sent to the auction module
+	s.checkModuleAccountBalance(auctiontypes.ModuleName, StAtom, feeAmount)
+	s.checkModuleAccountBalance(stakeibctypes.RewardCollectorName, StAtom, sdkmath.ZeroInt())
+
+	// Since there's no stOsmo auction, the fees should be held in the reward collector
+	s.checkModuleAccountBalance(auctiontypes.ModuleName, StOsmo, sdkmath.ZeroInt())
+	s.checkModuleAccountBalance(stakeibctypes.RewardCollectorName, StOsmo, feeAmount)
+}
+
 func (s *KeeperTestSuite) TestLiquidStakeRewardCollectorBalance_NoRewardsAccrued() {
 	s.SetupTestRewardAllocation()
 
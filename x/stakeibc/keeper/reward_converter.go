@@ -44,6 +44,7 @@ type RewardsSplit struct {
 	RebateAmount    sdkmath.Int
 	StrideFeeAmount sdkmath.Int
 	ReinvestAmount  sdkmath.Int
+	InsuranceAmount sdkmath.Int
 }
 
 //////////////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -62,10 +63,13 @@ type RewardsSplit struct {
 //
 // Normal staking flow continues from there. So the host denom tokens will land on the original host zone
 // and the normal staking and distribution flow will continue from there.
+//
+// The above describes the OSMOSIS_AUTHZ trade venue. Steps 1 and 3 depend on where the swap happens,
+// and are dispatched to the trade route's venue (see trade_venue.go)
 //////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // Breaks down the split of native rewards into the portions intended for (a) a rebate, (b) stride commission,
-// and (c) reinvestment
+// (c) reinvestment, and (d) the host zone's insurance fund
 // For most host zones, the rewards here were generated from normal staking rewards, but in the case of dYdX,
 // this is called on the rewards that were converted from USDC to DYDX during the trade route
 //
@@ -73,22 +77,29 @@ type RewardsSplit struct {
 //
 // E.g. Community pool liquid staked 1M, TVL is 10M, rebate is 20%
 // Total rewards this epoch are 1000, and the stride fee is 10%
+// (the stride fee is the host zone's reward commission, or the StrideCommission param if unset)
 // => Then the rebate is 1000 rewards * 10% stride fee * (1M / 10M) * 20% rebate = 2 tokens
 // => Stride fee is 1000 rewards * 10% stride fee - 2 rebate = 98 tokens
 // => Reinvestment is 1000 rewards * (100% - 10% stride fee) = 900 tokens
+//
+// If the host zone has an insurance fund, the fund's allocation is then taken out of the reinvestment
+// E.g. with a 2% allocation rate, 1000 rewards * 2% = 20 tokens go to the fund and 880 are reinvested
 func (k Keeper) CalculateRewardsSplit(
 	ctx sdk.Context,
 	hostZone types.HostZone,
 	rewardsAmount sdkmath.Int,
 ) (rewardSplit RewardsSplit, err error) {
-	// Get the fee rate and total fees from params (e.g. 0.1 for 10% fee)
-	strideFeeParam := sdkmath.NewIntFromUint64(k.GetParams(ctx).StrideCommission)
-	totalFeeRate := sdkmath.LegacyNewDecFromInt(strideFeeParam).Quo(sdkmath.LegacyNewDec(100))
+	// Get the fee rate from the host zone's fee schedule, falling back to the param (e.g. 0.1 for 10% fee)
+	totalFeeRate := k.GetHostZoneFeeSchedule(ctx, hostZone).RewardCommissionRate
 
 	// Get the total fee amount from the fee percentage
 	totalFeesAmount := sdkmath.LegacyNewDecFromInt(rewardsAmount).Mul(totalFeeRate).TruncateInt()
 	reinvestAmount := rewardsAmount.Sub(totalFeesAmount)
 
+	// Divert the insurance fund's portion out of the reinvestment
+	insuranceAmount := k.GetInsuranceFundAllocation(ctx, hostZone, rewardsAmount, reinvestAmount)
+	reinvestAmount = reinvestAmount.Sub(insuranceAmount)
+
 	// Check if the chain has a rebate
 	// If there's no rebate, return 0 rebate and send all fees as stride commission
 	rebateInfo, chainHasRebate := hostZone.SafelyGetCommunityPoolRebate()
@@ -97,6 +108,7 @@ func (k Keeper) CalculateRewardsSplit(
 			RebateAmount:    sdkmath.ZeroInt(),
 			StrideFeeAmount: totalFeesAmount,
 			ReinvestAmount:  reinvestAmount,
+			InsuranceAmount: insuranceAmount,
 		}
 		return rewardSplit, nil
 	}
@@ -128,6 +140,7 @@ func (k Keeper) CalculateRewardsSplit(
 		RebateAmount:    rebateAmount,
 		StrideFeeAmount: strideFeeAmount,
 		ReinvestAmount:  reinvestAmount,
+		InsuranceAmount: insuranceAmount,
 	}
 
 	return rewardSplit, nil
@@ -181,6 +194,26 @@ func (k Keeper) BuildHostToTradeTransferMsg(
 	ctx sdk.Context,
 	amount sdkmath.Int,
 	route types.TradeRoute,
+) (msg transfertypes.MsgTransfer, err error) {
+	tradeIcaAddress := route.TradeAccount.Address
+	if tradeIcaAddress == "" {
+		return msg, errorsmod.Wrapf(types.ErrICAAccountNotFound, "no trade account found for %s", route.Description())
+	}
+
+	// This transfer channel id is a channel on the reward Zone for transfers to the trade zone
+	// (not to be confused with a transfer channel on Stride or the Host Zone)
+	return k.BuildRewardUnwindTransferMsg(ctx, amount, route, tradeIcaAddress, route.RewardToTradeChannelId)
+}
+
+// Builds a PFM transfer message to send reward tokens from the host zone's withdrawal ICA,
+// through the reward zone (to unwind the ibc denom) and then forwards them to the final
+// receiver along the specified channel on the reward zone
+func (k Keeper) BuildRewardUnwindTransferMsg(
+	ctx sdk.Context,
+	amount sdkmath.Int,
+	route types.TradeRoute,
+	forwardReceiver string,
+	forwardChannelId string,
 ) (msg transfertypes.MsgTransfer, err error) {
 	// Get the epoch tracker to determine the timeouts
 	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
@@ -199,7 +232,6 @@ func (k Keeper) BuildHostToTradeTransferMsg(
 
 	withdrawlIcaAddress := route.HostAccount.Address
 	unwindIcaAddress := route.RewardAccount.Address
-	tradeIcaAddress := route.TradeAccount.Address
 
 	// Validate ICAs were registered
 	if withdrawlIcaAddress == "" {
@@ -208,18 +240,13 @@ func (k Keeper) BuildHostToTradeTransferMsg(
 	if unwindIcaAddress == "" {
 		return msg, errorsmod.Wrapf(types.ErrICAAccountNotFound, "no reward account found for %s", route.Description())
 	}
-	if tradeIcaAddress == "" {
-		return msg, errorsmod.Wrapf(types.ErrICAAccountNotFound, "no trade account found for %s", route.Description())
-	}
 
 	// Build the pfm memo to specify the forwarding logic
-	// This transfer channel id is a channel on the reward Zone for transfers to the trade zone
-	// (not to be confused with a transfer channel on Stride or the Host Zone)
 	memo := PacketForwardMetadata{
 		Forward: &ForwardMetadata{
-			Receiver: tradeIcaAddress,
+			Receiver: forwardReceiver,
 			Port:     transfertypes.PortID,
-			Channel:  route.RewardToTradeChannelId,
+			Channel:  forwardChannelId,
 			Timeout:  transfer2TimeoutDuration,
 			Retries:  0,
 		},
@@ -436,18 +463,24 @@ func (k Keeper) TradeConvertedBalanceQuery(ctx sdk.Context, route types.TradeRou
 // Main epochly trigger for the trade route tokens swap
 //
 // The current design assumes foreign reward tokens start and end in the hostZone withdrawal address
-// Step 1: transfer reward tokens to trade chain
-// Step 2: (off-chain) perform the swap in small batches
+// Step 1: transfer reward tokens to the trade venue
+// Step 2: perform the swap (off-chain for OSMOSIS_AUTHZ, or through x/auction for STRIDE_AUCTION)
 // Step 3: return the swapped tokens to the withdrawal ICA on hostZone
 func (k Keeper) TransferAllRewardTokens(ctx sdk.Context) {
 	for _, route := range k.GetAllTradeRoutes(ctx) {
-		// Step 1: ICQ reward balance on hostZone, transfer funds with unwinding to trade chain
+		venue, err := k.GetTradeVenueHandler(route)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to get trade venue for %s: %s", route.Description(), err))
+			continue
+		}
+
+		// Step 1: ICQ reward balance on hostZone, transfer funds with unwinding to the trade venue
 		if err := k.WithdrawalRewardBalanceQuery(ctx, route); err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("Unable to submit query for reward balance in withdrawal ICA: %s", err))
 		}
-		// Step 3: ICQ converted tokens in trade ICA, transfer funds back to hostZone withdrawal ICA
-		if err := k.TradeConvertedBalanceQuery(ctx, route); err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Unable to submit query for converted balance in trade ICA: %s", err))
+		// Step 3: Transfer converted tokens from the trade venue back to hostZone withdrawal ICA
+		if err := venue.ReturnConvertedTokens(ctx, route); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to return converted tokens for %s: %s", route.Description(), err))
 		}
 	}
 }
@@ -49,6 +49,7 @@ func (s *KeeperTestSuite) TestCalculateRewardsSplit() {
 		rewardAmount             sdkmath.Int
 		strideFee                uint64
 		rebateRate               sdkmath.LegacyDec
+		hostZoneCommissionRate   sdkmath.LegacyDec
 		expectedRebateAmount     sdkmath.Int
 		expectedStrideFeeAmount  sdkmath.Int
 		expectedReinvestAmount   sdkmath.Int
@@ -252,6 +253,38 @@ func (s *KeeperTestSuite) TestCalculateRewardsSplit() {
 			expectedStrideFeeAmount: sdkmath.NewInt(0),
 			expectedReinvestAmount:  sdkmath.NewInt(900),
 		},
+		{
+			// Host zone fee schedule overrides the param
+			// 20% fees off 1000 rewards = 200 stride fees, 800 reinvest
+			name:                   "host zone fee schedule",
+			totalStTokenSupply:     sdkmath.NewInt(100),
+			rewardAmount:           sdkmath.NewInt(1000),
+			strideFee:              10,
+			hostZoneCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.20"),
+
+			expectedRebateAmount:    sdkmath.NewInt(0),
+			expectedStrideFeeAmount: sdkmath.NewInt(200),
+			expectedReinvestAmount:  sdkmath.NewInt(800),
+		},
+		{
+			// Host zone fee schedule with a rebate
+			// 10 CP Liquid Stake, 100 TVL => 10% contribution
+			// 1000 rewards, 5% host zone commission => 50 total fees
+			// 50 total fees * 10% contribution * 50% rebate => 2.5 rebate (truncated to 2)
+			// 50 total fees - 2 rebate => 48 stride fee
+			// 1000 rewards - 50 total fees => 950 reinvested
+			name:                     "host zone fee schedule with rebate",
+			communityPoolLiquidStake: sdkmath.NewInt(10),
+			totalStTokenSupply:       sdkmath.NewInt(100),
+			rewardAmount:             sdkmath.NewInt(1000),
+			strideFee:                10,
+			rebateRate:               sdkmath.LegacyMustNewDecFromStr("0.5"),
+			hostZoneCommissionRate:   sdkmath.LegacyMustNewDecFromStr("0.05"),
+
+			expectedRebateAmount:    sdkmath.NewInt(2),
+			expectedStrideFeeAmount: sdkmath.NewInt(48),
+			expectedReinvestAmount:  sdkmath.NewInt(950),
+		},
 		{
 			// No tvl - should error
 			name:                     "no tvl",
@@ -291,6 +324,13 @@ func (s *KeeperTestSuite) TestCalculateRewardsSplit() {
 					LiquidStakedStTokenAmount: tc.communityPoolLiquidStake,
 				}
 			}
+			if !tc.hostZoneCommissionRate.IsNil() {
+				hostZone.FeeSchedule = &types.HostZoneFeeSchedule{
+					RewardCommissionRate: tc.hostZoneCommissionRate,
+					LiquidStakeFeeRate:   sdkmath.LegacyZeroDec(),
+					RedemptionFeeRate:    sdkmath.LegacyZeroDec(),
+				}
+			}
 
 			// Store the fee as a param
 			params := types.DefaultParams()
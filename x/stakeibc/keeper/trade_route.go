@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -63,3 +65,88 @@ func (k Keeper) GetTradeRouteFromTradeAccountChainId(ctx sdk.Context, chainId st
 	}
 	return tradeRoute, false
 }
+
+// Creates a new trade route, registering the ICA accounts required by the route's trade venue
+// The host zone's withdrawal ICA must already be registered
+func (k Keeper) CreateTradeRoute(ctx sdk.Context, msg *types.MsgCreateTradeRoute) error {
+	// Validate trade route does not already exist for this denom
+	_, found := k.GetTradeRoute(ctx, msg.RewardDenomOnReward, msg.HostDenomOnHost)
+	if found {
+		return errorsmod.Wrapf(types.ErrTradeRouteAlreadyExists,
+			"trade route already exists for rewardDenom %s, hostDenom %s", msg.RewardDenomOnReward, msg.HostDenomOnHost)
+	}
+
+	// Confirm the host chain exists and the withdrawal address has been initialized
+	hostZone, err := k.GetActiveHostZone(ctx, msg.HostChainId)
+	if err != nil {
+		return err
+	}
+	if hostZone.WithdrawalIcaAddress == "" {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "withdrawal account not initialized on host zone")
+	}
+
+	// Register the new ICA accounts
+	tradeRouteId := types.GetTradeRouteId(msg.RewardDenomOnReward, msg.HostDenomOnHost)
+	hostICA := types.ICAAccount{
+		ChainId:      msg.HostChainId,
+		Type:         types.ICAAccountType_WITHDRAWAL,
+		ConnectionId: hostZone.ConnectionId,
+		Address:      hostZone.WithdrawalIcaAddress,
+	}
+
+	unwindConnectionId := msg.StrideToRewardConnectionId
+	unwindICAType := types.ICAAccountType_CONVERTER_UNWIND
+	unwindICA, err := k.RegisterTradeRouteICAAccount(ctx, tradeRouteId, unwindConnectionId, unwindICAType)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to register the unwind ICA account")
+	}
+
+	// Build the main trade route
+	tradeRoute := types.TradeRoute{
+		RewardDenomOnHostZone:   msg.RewardDenomOnHost,
+		RewardDenomOnRewardZone: msg.RewardDenomOnReward,
+		HostDenomOnHostZone:     msg.HostDenomOnHost,
+
+		HostAccount:   hostICA,
+		RewardAccount: unwindICA,
+
+		HostToRewardChannelId: msg.HostToRewardTransferChannelId,
+
+		MinTransferAmount: msg.MinTransferAmount,
+		TradeVenue:        msg.TradeVenue,
+	}
+
+	// Finally, setup the accounts and channels specific to the trade venue
+	switch msg.TradeVenue {
+	case types.TradeVenue_OSMOSIS_AUTHZ:
+		tradeConnectionId := msg.StrideToTradeConnectionId
+		tradeICAType := types.ICAAccountType_CONVERTER_TRADE
+		tradeICA, err := k.RegisterTradeRouteICAAccount(ctx, tradeRouteId, tradeConnectionId, tradeICAType)
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to register the trade ICA account")
+		}
+
+		tradeRoute.TradeAccount = tradeICA
+		tradeRoute.RewardDenomOnTradeZone = msg.RewardDenomOnTrade
+		tradeRoute.HostDenomOnTradeZone = msg.HostDenomOnTrade
+		tradeRoute.RewardToTradeChannelId = msg.RewardToTradeTransferChannelId
+		tradeRoute.TradeToHostChannelId = msg.TradeToHostTransferChannelId
+
+	case types.TradeVenue_STRIDE_AUCTION:
+		proceedsAddress, err := k.CreateAuctionProceedsAccount(ctx, msg.HostChainId, tradeRouteId)
+		if err != nil {
+			return err
+		}
+
+		tradeRoute.RewardDenomOnStride = msg.RewardDenomOnStride
+		tradeRoute.RewardToStrideChannelId = msg.RewardToStrideTransferChannelId
+		tradeRoute.AuctionProceedsAddress = proceedsAddress.String()
+
+	default:
+		return errorsmod.Wrapf(types.ErrUnsupportedTradeVenue, "trade venue %s", msg.TradeVenue)
+	}
+
+	k.SetTradeRoute(ctx, tradeRoute)
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	auctiontypes "github.com/Stride-Labs/stride/v33/x/auction/types"
+	epochstypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// A trade venue is where a trade route's reward tokens are converted to the host denom
+// The reward balance query is shared across venues, but the transfer of the reward tokens
+// to the venue, and the return of the converted tokens, depend on where the swap happens
+type TradeVenueHandler interface {
+	// Kicks off the transfer of reward tokens from the withdrawal ICA to the venue
+	TransferRewardTokens(ctx sdk.Context, amount sdkmath.Int, route types.TradeRoute) error
+	// Kicks off the return of converted tokens from the venue to the withdrawal ICA
+	ReturnConvertedTokens(ctx sdk.Context, route types.TradeRoute) error
+}
+
+// Map of trade venues to their handlers
+var tradeVenueHandlers = map[types.TradeVenue]func(k Keeper) TradeVenueHandler{
+	types.TradeVenue_OSMOSIS_AUTHZ:  func(k Keeper) TradeVenueHandler { return osmosisAuthzVenue{k: k} },
+	types.TradeVenue_STRIDE_AUCTION: func(k Keeper) TradeVenueHandler { return strideAuctionVenue{k: k} },
+}
+
+// Returns the handler for a trade route's venue
+func (k Keeper) GetTradeVenueHandler(route types.TradeRoute) (TradeVenueHandler, error) {
+	newHandler, found := tradeVenueHandlers[route.TradeVenue]
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrUnsupportedTradeVenue, "%s has venue %s", route.Description(), route.TradeVenue)
+	}
+	return newHandler(k), nil
+}
+
+// The reward tokens are sent to a trade ICA on Osmosis, where they're swapped off-chain by an
+// authz grantee, and the converted tokens are discovered by an ICQ on the trade ICA
+type osmosisAuthzVenue struct {
+	k Keeper
+}
+
+func (v osmosisAuthzVenue) TransferRewardTokens(ctx sdk.Context, amount sdkmath.Int, route types.TradeRoute) error {
+	return v.k.TransferRewardTokensHostToTrade(ctx, amount, route)
+}
+
+func (v osmosisAuthzVenue) ReturnConvertedTokens(ctx sdk.Context, route types.TradeRoute) error {
+	return v.k.TradeConvertedBalanceQuery(ctx, route)
+}
+
+// The reward tokens are sold on Stride through x/auction
+// The auction module account is blocked from receiving IBC transfers, so the rewards are
+// forwarded to the route's proceeds account, and then moved into the auction module each epoch
+// The auction's beneficiary is also the route's proceeds account, and the proceeds (in the host's
+// ibc denom) are sent back to the withdrawal ICA each epoch
+type strideAuctionVenue struct {
+	k Keeper
+}
+
+func (v strideAuctionVenue) TransferRewardTokens(ctx sdk.Context, amount sdkmath.Int, route types.TradeRoute) error {
+	return v.k.TransferRewardTokensHostToStride(ctx, amount, route)
+}
+
+func (v strideAuctionVenue) ReturnConvertedTokens(ctx sdk.Context, route types.TradeRoute) error {
+	if err := v.k.SendRewardTokensToAuction(ctx, route); err != nil {
+		return err
+	}
+	return v.k.TransferAuctionProceedsToHost(ctx, route)
+}
+
+// Creates the stride-side account that receives the auction proceeds for a trade route
+// If the route was previously removed and added back, the existing account is reused
+func (k Keeper) CreateAuctionProceedsAccount(ctx sdk.Context, hostChainId, tradeRouteId string) (address sdk.AccAddress, err error) {
+	proceedsAddress := types.NewHostZoneModuleAddress(hostChainId, AuctionProceedsAddressKey+"-"+tradeRouteId)
+	if _, isModuleAccount := k.AccountKeeper.GetAccount(ctx, proceedsAddress).(sdk.ModuleAccountI); isModuleAccount {
+		return proceedsAddress, nil
+	}
+	if err := utils.CreateModuleAccount(ctx, k.AccountKeeper, proceedsAddress); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to create auction proceeds account for trade route %s", tradeRouteId)
+	}
+	return proceedsAddress, nil
+}
+
+// ICA tx to kick off transfering the reward tokens from the hostZone withdrawal ICA to the route's
+// proceeds account on Stride, from which they're moved into the auction module
+// This will be two hops to unwind the ibc denom through the rewardZone using pfm in the transfer memo
+func (k Keeper) TransferRewardTokensHostToStride(ctx sdk.Context, amount sdkmath.Int, route types.TradeRoute) error {
+	// Confirm the reward amount exceeds the transfer threshold, otherwise exit prematurely
+	if route.MinTransferAmount.GT(amount) {
+		k.Logger(ctx).Info(fmt.Sprintf("Balance of %v is below transfer minimum of %v, skipping transfer",
+			amount, route.MinTransferAmount))
+		return nil
+	}
+
+	// Build the PFM transfer message from the host zone to the proceeds account
+	// The auction module account can't be used as the receiver since it's blocked from
+	// receiving transfers, which would cause the inbound transfer to fail
+	if _, err := sdk.AccAddressFromBech32(route.AuctionProceedsAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid auction proceeds address for %s", route.Description())
+	}
+	msg, err := k.BuildRewardUnwindTransferMsg(ctx, amount, route, route.AuctionProceedsAddress, route.RewardToStrideChannelId)
+	if err != nil {
+		return err
+	}
+	msgs := []proto.Message{&msg}
+
+	hostZoneId := route.HostAccount.ChainId
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZoneId,
+		"Preparing MsgTransfer of %+v from %s to %s to the auction proceeds account", msg.Token, hostZoneId, route.RewardAccount.ChainId))
+
+	// Send the ICA tx to kick off transfer from hostZone through rewardZone to Stride (no callbacks)
+	hostAccount := route.HostAccount
+	withdrawalOwner := types.FormatHostZoneICAOwner(hostAccount.ChainId, hostAccount.Type)
+	err = k.SubmitICATxWithoutCallback(ctx, hostAccount.ConnectionId, withdrawalOwner, msgs, msg.TimeoutTimestamp)
+	if err != nil {
+		return errorsmod.Wrapf(err, "Failed to submit ICA tx, Messages: %+v", msgs)
+	}
+
+	return nil
+}
+
+// Moves the reward tokens that have arrived in a trade route's proceeds account into the
+// auction module, where they're sold
+func (k Keeper) SendRewardTokensToAuction(ctx sdk.Context, route types.TradeRoute) error {
+	proceedsAddress, err := sdk.AccAddressFromBech32(route.AuctionProceedsAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid auction proceeds address for %s", route.Description())
+	}
+
+	rewards := k.bankKeeper.GetBalance(ctx, proceedsAddress, route.RewardDenomOnStride)
+	if !rewards.Amount.IsPositive() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, proceedsAddress, auctiontypes.ModuleName, sdk.NewCoins(rewards)); err != nil {
+		return errorsmod.Wrapf(err, "unable to send reward tokens %v to the auction module", rewards)
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(route.HostAccount.ChainId,
+		"Sent reward tokens %v for %s to the auction module", rewards, route.Description()))
+
+	return nil
+}
+
+// Transfers the auction proceeds for a trade route from Stride back to the hostZone withdrawal ICA,
+// where they'll be picked up by the withdrawal balance query and reinvested
+func (k Keeper) TransferAuctionProceedsToHost(ctx sdk.Context, route types.TradeRoute) error {
+	hostZone, found := k.GetHostZone(ctx, route.HostAccount.ChainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", route.HostAccount.ChainId)
+	}
+
+	proceedsAddress, err := sdk.AccAddressFromBech32(route.AuctionProceedsAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid auction proceeds address for %s", route.Description())
+	}
+	withdrawalIcaAddress := route.HostAccount.Address
+	if withdrawalIcaAddress == "" {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no host account found for %s", route.Description())
+	}
+
+	proceeds := k.bankKeeper.GetBalance(ctx, proceedsAddress, hostZone.IbcDenom)
+	if !proceeds.Amount.IsPositive() {
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "No auction proceeds to return for %s", route.Description()))
+		return nil
+	}
+
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		return errorsmod.Wrap(types.ErrEpochNotFound, epochstypes.STRIDE_EPOCH)
+	}
+	timeout := strideEpochTracker.NextEpochStartTime
+
+	msg := transfertypes.NewMsgTransfer(
+		transfertypes.PortID,
+		hostZone.TransferChannelId,
+		proceeds,
+		route.AuctionProceedsAddress,
+		withdrawalIcaAddress,
+		clienttypes.Height{},
+		timeout,
+		"",
+	)
+	if _, err := k.RecordsKeeper.TransferKeeper.Transfer(ctx, msg); err != nil {
+		return errorsmod.Wrapf(err, "unable to transfer auction proceeds %v to host zone", proceeds)
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Transferring auction proceeds %v for %s to the withdrawal ICA", proceeds, route.Description()))
+
+	return nil
+}
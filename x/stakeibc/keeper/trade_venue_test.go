@@ -0,0 +1,286 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	auctiontypes "github.com/Stride-Labs/stride/v33/x/auction/types"
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestGetTradeVenueHandler() {
+	route := types.TradeRoute{RewardDenomOnRewardZone: RewardDenom, HostDenomOnHostZone: HostDenom}
+
+	route.TradeVenue = types.TradeVenue_OSMOSIS_AUTHZ
+	_, err := s.App.StakeibcKeeper.GetTradeVenueHandler(route)
+	s.Require().NoError(err, "no error expected for osmosis venue")
+
+	route.TradeVenue = types.TradeVenue_STRIDE_AUCTION
+	_, err = s.App.StakeibcKeeper.GetTradeVenueHandler(route)
+	s.Require().NoError(err, "no error expected for auction venue")
+
+	route.TradeVenue = types.TradeVenue(99)
+	_, err = s.App.StakeibcKeeper.GetTradeVenueHandler(route)
+	s.Require().ErrorIs(err, types.ErrUnsupportedTradeVenue)
+}
+
+// --------------------------------------------------------------
+//                   Transfer Host to Stride
+// --------------------------------------------------------------
+
+func (s *KeeperTestSuite) SetupTransferRewardTokensHostToStrideTestCase() TransferRewardHostToTradeTestCase {
+	// Re-use the trade route from the osmosis flow, but forward to the proceeds account on Stride instead
+	tc := s.SetupTransferRewardTokensHostToTradeTestCase()
+
+	proceedsAddress, err := s.App.StakeibcKeeper.CreateAuctionProceedsAccount(s.Ctx, HostChainId, tc.TradeRoute.GetRouteId())
+	s.Require().NoError(err, "no error expected when creating proceeds account")
+
+	rewardToStrideChannelId := "channel-5"
+	tc.TradeRoute.TradeVenue = types.TradeVenue_STRIDE_AUCTION
+	tc.TradeRoute.RewardToStrideChannelId = rewardToStrideChannelId
+	tc.TradeRoute.RewardToTradeChannelId = ""
+	tc.TradeRoute.TradeAccount = types.ICAAccount{}
+	tc.TradeRoute.AuctionProceedsAddress = proceedsAddress.String()
+
+	tc.ExpectedTransferMsg.Memo = fmt.Sprintf(`{"forward":{"receiver":"%s","port":"transfer","channel":"%s","timeout":"5s","retries":0}}`,
+		proceedsAddress, rewardToStrideChannelId)
+
+	return tc
+}
+
+func (s *KeeperTestSuite) TestBuildRewardUnwindTransferMsg_StrideAuction() {
+	tc := s.SetupTransferRewardTokensHostToStrideTestCase()
+
+	actualMsg, err := s.App.StakeibcKeeper.BuildRewardUnwindTransferMsg(s.Ctx, tc.TransferAmount, tc.TradeRoute,
+		tc.TradeRoute.AuctionProceedsAddress, tc.TradeRoute.RewardToStrideChannelId)
+	s.Require().NoError(err, "no error expected when building transfer message")
+	s.Require().Equal(tc.ExpectedTransferMsg, actualMsg, "transfer message should have matched")
+}
+
+func (s *KeeperTestSuite) TestTransferRewardTokensHostToStride_Success() {
+	tc := s.SetupTransferRewardTokensHostToStrideTestCase()
+
+	// Check that the transfer ICA is submitted when the function is called
+	s.CheckICATxSubmitted(tc.PortID, tc.ChannelID, func() error {
+		return s.App.StakeibcKeeper.TransferRewardTokensHostToStride(s.Ctx, tc.TransferAmount, tc.TradeRoute)
+	})
+}
+
+func (s *KeeperTestSuite) TestTransferRewardTokensHostToStride_TransferAmountBelowMin() {
+	tc := s.SetupTransferRewardTokensHostToStrideTestCase()
+
+	// Attempt to call the function with an transfer amount below the min,
+	// it should not submit an ICA
+	invalidTransferAmount := tc.TradeRoute.MinTransferAmount.Sub(sdkmath.OneInt())
+	s.CheckICATxNotSubmitted(tc.PortID, tc.ChannelID, func() error {
+		return s.App.StakeibcKeeper.TransferRewardTokensHostToStride(s.Ctx, invalidTransferAmount, tc.TradeRoute)
+	})
+}
+
+func (s *KeeperTestSuite) TestTransferRewardTokensHostToStride_MissingProceedsAddress() {
+	tc := s.SetupTransferRewardTokensHostToStrideTestCase()
+
+	tc.TradeRoute.AuctionProceedsAddress = ""
+	err := s.App.StakeibcKeeper.TransferRewardTokensHostToStride(s.Ctx, tc.TransferAmount, tc.TradeRoute)
+	s.Require().ErrorContains(err, "invalid auction proceeds address")
+}
+
+// Helper function to receive the final hop of the reward transfer from the reward zone
+// Returns whether the packet was acknowledged successfully
+func (s *KeeperTestSuite) receiveRewardTransferPacket(receiver string, amount sdkmath.Int) bool {
+	packetData := transfertypes.FungibleTokenPacketData{
+		Denom:    RewardDenom,
+		Amount:   amount.String(),
+		Sender:   "reward-zone-address",
+		Receiver: receiver,
+	}
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-5",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: ibctesting.FirstChannelID,
+		Data:               transfertypes.ModuleCdc.MustMarshalJSON(&packetData),
+	}
+
+	transferIBCModule := transfer.NewIBCModule(s.App.TransferKeeper)
+	ack := transferIBCModule.OnRecvPacket(s.Ctx, transfertypes.V1, packet, s.TestAccs[0])
+	return ack.Success()
+}
+
+func (s *KeeperTestSuite) TestTransferRewardTokensHostToStride_ReceiveAndAuction() {
+	tc := s.SetupTransferRewardTokensHostToStrideTestCase()
+	s.CreateTransferChannel(HostChainId)
+
+	rewardDenomOnStride := utils.GetIBCDenom(transfertypes.PortID, ibctesting.FirstChannelID, RewardDenom)
+	tc.TradeRoute.RewardDenomOnStride = rewardDenomOnStride
+	proceedsAddress := sdk.MustAccAddressFromBech32(tc.TradeRoute.AuctionProceedsAddress)
+	auctionAddress := authtypes.NewModuleAddress(auctiontypes.ModuleName)
+
+	// The auction module is blocked from receiving transfers, so forwarding to it directly would fail
+	s.Require().False(s.receiveRewardTransferPacket(auctionAddress.String(), tc.TransferAmount),
+		"transfer to the auction module should fail")
+
+	// The final hop of the reward transfer is received by the forward receiver (the proceeds account)
+	msg, err := s.App.StakeibcKeeper.BuildRewardUnwindTransferMsg(s.Ctx, tc.TransferAmount, tc.TradeRoute,
+		tc.TradeRoute.AuctionProceedsAddress, tc.TradeRoute.RewardToStrideChannelId)
+	s.Require().NoError(err, "no error expected when building transfer message")
+	s.Require().Contains(msg.Memo, tc.TradeRoute.AuctionProceedsAddress, "forward receiver should be the proceeds account")
+
+	s.Require().True(s.receiveRewardTransferPacket(tc.TradeRoute.AuctionProceedsAddress, tc.TransferAmount),
+		"transfer to the proceeds account should succeed")
+
+	proceedsBalance := s.App.BankKeeper.GetBalance(s.Ctx, proceedsAddress, rewardDenomOnStride)
+	s.Require().Equal(tc.TransferAmount.Int64(), proceedsBalance.Amount.Int64(), "proceeds account balance after transfer")
+
+	// The rewards should then be moved from the proceeds account into the auction module
+	err = s.App.StakeibcKeeper.SendRewardTokensToAuction(s.Ctx, tc.TradeRoute)
+	s.Require().NoError(err, "no error expected when sending rewards to the auction")
+
+	proceedsBalance = s.App.BankKeeper.GetBalance(s.Ctx, proceedsAddress, rewardDenomOnStride)
+	s.Require().Zero(proceedsBalance.Amount.Int64(), "proceeds account balance after auction transfer")
+
+	auctionBalance := s.App.BankKeeper.GetBalance(s.Ctx, auctionAddress, rewardDenomOnStride)
+	s.Require().Equal(tc.TransferAmount.Int64(), auctionBalance.Amount.Int64(), "auction module balance")
+
+	// Calling it again with no rewards should be a no-op
+	err = s.App.StakeibcKeeper.SendRewardTokensToAuction(s.Ctx, tc.TradeRoute)
+	s.Require().NoError(err, "no error expected when there are no rewards to send")
+}
+
+// --------------------------------------------------------------
+//                Transfer Auction Proceeds to Host
+// --------------------------------------------------------------
+
+type TransferAuctionProceedsTestCase struct {
+	HostZone        types.HostZone
+	TradeRoute      types.TradeRoute
+	ProceedsAddress sdk.AccAddress
+	Proceeds        sdk.Coin
+}
+
+func (s *KeeperTestSuite) SetupTransferAuctionProceedsToHost() TransferAuctionProceedsTestCase {
+	s.CreateTransferChannel(HostChainId)
+
+	// The proceeds are paid in the host's ibc denom, which must have a denom trace to be transferred
+	ibcDenomTrace := s.GetIBCDenomTrace(Atom)
+	s.App.TransferKeeper.SetDenom(s.Ctx, ibcDenomTrace)
+
+	hostZone := types.HostZone{
+		ChainId:           HostChainId,
+		HostDenom:         Atom,
+		IbcDenom:          ibcDenomTrace.IBCDenom(),
+		TransferChannelId: ibctesting.FirstChannelID,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	tradeRouteId := types.GetTradeRouteId(RewardDenom, HostDenom)
+	proceedsAddress, err := s.App.StakeibcKeeper.CreateAuctionProceedsAccount(s.Ctx, HostChainId, tradeRouteId)
+	s.Require().NoError(err, "no error expected when creating proceeds account")
+
+	route := types.TradeRoute{
+		RewardDenomOnRewardZone: RewardDenom,
+		HostDenomOnHostZone:     HostDenom,
+		TradeVenue:              types.TradeVenue_STRIDE_AUCTION,
+		AuctionProceedsAddress:  proceedsAddress.String(),
+		HostAccount: types.ICAAccount{
+			ChainId: HostChainId,
+			Address: "withdrawal-address",
+			Type:    types.ICAAccountType_WITHDRAWAL,
+		},
+	}
+
+	strideEpoch := types.EpochTracker{
+		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000), // used for transfer timeout
+	}
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, strideEpoch)
+
+	proceeds := sdk.NewCoin(hostZone.IbcDenom, sdkmath.NewInt(1_000_000))
+	s.FundAccount(proceedsAddress, proceeds)
+
+	return TransferAuctionProceedsTestCase{
+		HostZone:        hostZone,
+		TradeRoute:      route,
+		ProceedsAddress: proceedsAddress,
+		Proceeds:        proceeds,
+	}
+}
+
+func (s *KeeperTestSuite) TestTransferAuctionProceedsToHost_Successful() {
+	tc := s.SetupTransferAuctionProceedsToHost()
+
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx,
+		transfertypes.PortID, tc.HostZone.TransferChannelId)
+	s.Require().True(found)
+
+	err := s.App.StakeibcKeeper.TransferAuctionProceedsToHost(s.Ctx, tc.TradeRoute)
+	s.Require().NoError(err, "no error expected when transferring proceeds")
+
+	// Confirm the transfer was sent and the proceeds account was emptied
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx,
+		transfertypes.PortID, tc.HostZone.TransferChannelId)
+	s.Require().True(found)
+	s.Require().Equal(startSequence+1, endSequence, "sequence number should have incremented")
+
+	proceedsBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.ProceedsAddress, tc.Proceeds.Denom)
+	s.Require().Zero(proceedsBalance.Amount.Int64(), "proceeds account should be empty")
+}
+
+func (s *KeeperTestSuite) TestTransferAuctionProceedsToHost_NoProceeds() {
+	tc := s.SetupTransferAuctionProceedsToHost()
+
+	// Drain the proceeds account so there's nothing to transfer
+	err := s.App.BankKeeper.SendCoins(s.Ctx, tc.ProceedsAddress, s.TestAccs[0], sdk.NewCoins(tc.Proceeds))
+	s.Require().NoError(err, "no error expected when draining proceeds")
+
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx,
+		transfertypes.PortID, tc.HostZone.TransferChannelId)
+	s.Require().True(found)
+
+	err = s.App.StakeibcKeeper.TransferAuctionProceedsToHost(s.Ctx, tc.TradeRoute)
+	s.Require().NoError(err, "no error expected when there are no proceeds")
+
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx,
+		transfertypes.PortID, tc.HostZone.TransferChannelId)
+	s.Require().True(found)
+	s.Require().Equal(startSequence, endSequence, "no transfer should have been sent")
+}
+
+func (s *KeeperTestSuite) TestTransferAuctionProceedsToHost_Failure() {
+	tc := s.SetupTransferAuctionProceedsToHost()
+
+	// Missing host zone
+	invalidRoute := tc.TradeRoute
+	invalidRoute.HostAccount.ChainId = "missing-chain"
+	err := s.App.StakeibcKeeper.TransferAuctionProceedsToHost(s.Ctx, invalidRoute)
+	s.Require().ErrorContains(err, "host zone missing-chain not found")
+
+	// Missing proceeds address
+	invalidRoute = tc.TradeRoute
+	invalidRoute.AuctionProceedsAddress = ""
+	err = s.App.StakeibcKeeper.TransferAuctionProceedsToHost(s.Ctx, invalidRoute)
+	s.Require().ErrorContains(err, "invalid auction proceeds address")
+
+	// Missing withdrawal address
+	invalidRoute = tc.TradeRoute
+	invalidRoute.HostAccount.Address = ""
+	err = s.App.StakeibcKeeper.TransferAuctionProceedsToHost(s.Ctx, invalidRoute)
+	s.Require().ErrorContains(err, "no host account found")
+
+	// Missing epoch tracker
+	s.App.StakeibcKeeper.RemoveEpochTracker(s.Ctx, epochtypes.STRIDE_EPOCH)
+	err = s.App.StakeibcKeeper.TransferAuctionProceedsToHost(s.Ctx, tc.TradeRoute)
+	s.Require().ErrorContains(err, "epoch not found")
+}
@@ -60,6 +60,20 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 			continue
 		}
 
+		// Divert a portion of the deposit into the instant redemption buffer before transferring the remainder
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			var err error
+			depositRecord, err = k.FundInstantRedemptionBuffer(ctx, hostZone, depositRecord)
+			return err
+		})
+		if err != nil {
+			k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId, "Unable to fund instant redemption buffer: %s", err.Error()))
+		}
+		if depositRecord.Amount.IsZero() {
+			k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Full deposit allocated to instant redemption buffer"))
+			continue
+		}
+
 		k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Transferring %v%s", depositRecord.Amount, hostZone.HostDenom))
 		transferCoin := sdk.NewCoin(hostZone.IbcDenom, depositRecord.Amount)
 
@@ -82,7 +96,7 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 		k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Transfer Msg: %+v", msg))
 
 		// transfer the deposit record and update its status to TRANSFER_IN_PROGRESS
-		err := k.RecordsKeeper.IBCTransferNativeTokens(ctx, msg, depositRecord)
+		err = k.RecordsKeeper.IBCTransferNativeTokens(ctx, msg, depositRecord)
 		if err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("[TransferExistingDepositsToHostZones] Failed to initiate IBC transfer to host zone, HostZone: %v, Channel: %v, Amount: %v, ModuleAddress: %v, DelegateAddress: %v, Timeout: %v",
 				hostZone.ChainId, hostZone.TransferChannelId, transferCoin, hostZone.DepositAddress, hostZone.DelegationIcaAddress, timeoutTimestamp))
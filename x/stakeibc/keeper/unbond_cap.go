@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Limits the queued host zone unbondings to the host zone's max unbond per epoch
+// Records are included in FIFO order (starting from the earliest epoch) until the cap is reached
+// The last record included may only be partially unbonded - its remainder is moved to the retry
+// queue once the undelegation lands (see RollOverCappedUnbondings)
+// Any records that don't fit under the cap are left in the queue for the next unbonding epoch
+//
+// Returns the epoch numbers and records to unbond this epoch, as well as the capped unbond amount
+func ApplyUnbondCap(
+	maxUnbond sdkmath.Int,
+	epochNumbers []uint64,
+	hostZoneUnbondings map[uint64]recordstypes.HostZoneUnbonding,
+) (cappedEpochNumbers []uint64, cappedHostZoneUnbondings map[uint64]recordstypes.HostZoneUnbonding, unbondAmount sdkmath.Int) {
+	cappedHostZoneUnbondings = map[uint64]recordstypes.HostZoneUnbonding{}
+	unbondAmount = sdkmath.ZeroInt()
+
+	for _, epochNumber := range epochNumbers {
+		remainingCapacity := maxUnbond.Sub(unbondAmount)
+		if !remainingCapacity.IsPositive() {
+			break
+		}
+
+		hostZoneUnbonding, ok := hostZoneUnbondings[epochNumber]
+		if !ok {
+			continue
+		}
+
+		cappedEpochNumbers = append(cappedEpochNumbers, epochNumber)
+		cappedHostZoneUnbondings[epochNumber] = hostZoneUnbonding
+		unbondAmount = unbondAmount.Add(sdkmath.MinInt(hostZoneUnbonding.NativeTokensToUnbond, remainingCapacity))
+	}
+
+	return cappedEpochNumbers, cappedHostZoneUnbondings, unbondAmount
+}
+
+// Called after a successful undelegation callback to move any records that were only partially
+// unbonded (because of the unbond cap) back to the queue, so their remainder is unbonded in the
+// next unbonding epoch
+// A record is only rolled over once all of its undelegation txs have landed, since the remainder
+// may otherwise still be covered by a batch that's in progress
+func (k Keeper) RollOverCappedUnbondings(ctx sdk.Context, chainId string, epochNumbers []uint64) error {
+	for _, epochNumber := range epochNumbers {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, chainId)
+		if !found {
+			return recordstypes.ErrHostUnbondingRecordNotFound.Wrapf("epoch number %d, chain %s", epochNumber, chainId)
+		}
+
+		inProgress := hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS
+		if !inProgress || hostZoneUnbonding.UndelegationTxsInProgress != 0 || !hostZoneUnbonding.NativeTokensToUnbond.IsPositive() {
+			continue
+		}
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId,
+			"Epoch Unbonding Record: %d - Rolling over %v remaining native tokens to the next unbonding epoch",
+			epochNumber, hostZoneUnbonding.NativeTokensToUnbond))
+
+		hostZoneUnbonding.Status = recordstypes.HostZoneUnbonding_UNBONDING_RETRY_QUEUE
+		if err := k.RecordsKeeper.SetHostZoneUnbondingRecord(ctx, epochNumber, chainId, *hostZoneUnbonding); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the native tokens that are still queued to be unbonded from a host zone unbonding record
+// Records in UNBONDING_QUEUE have not yet had their native amount refreshed, so the amount is
+// estimated from the stTokens and the current redemption rate
+func getQueuedNativeAmount(hostZoneUnbonding recordstypes.HostZoneUnbonding, redemptionRate sdkmath.LegacyDec) sdkmath.Int {
+	switch {
+	case hostZoneUnbonding.ShouldInitiateUnbonding():
+		return sdkmath.LegacyNewDecFromInt(hostZoneUnbonding.StTokenAmount).Mul(redemptionRate).TruncateInt()
+	case hostZoneUnbonding.ShouldRetryUnbonding():
+		return hostZoneUnbonding.NativeTokensToUnbond
+	default:
+		return sdkmath.ZeroInt()
+	}
+}
+
+// Estimates when a user redemption record will be claimable
+//
+// If the record's unbonding has already been initiated, the estimate is based on the completion time
+// from the undelegation. Otherwise, the native tokens queued ahead of the record (including the
+// record's own epoch) determine how many unbonding epochs are needed to unbond it under the host
+// zone's unbond cap, and the completion time is estimated from the last of those unbonding epochs
+func (k Keeper) GetRedemptionCompletionEstimate(
+	ctx sdk.Context,
+	userRedemptionRecordId string,
+) (response types.QueryRedemptionCompletionEstimateResponse, err error) {
+	response.NativeTokensQueuedAhead = sdkmath.ZeroInt()
+
+	userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, userRedemptionRecordId)
+	if !found {
+		return response, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord, "user redemption record %s not found", userRedemptionRecordId)
+	}
+	hostZone, found := k.GetHostZone(ctx, userRedemptionRecord.HostZoneId)
+	if !found {
+		return response, types.ErrHostZoneNotFound.Wrapf("host zone %s not found", userRedemptionRecord.HostZoneId)
+	}
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, userRedemptionRecord.EpochNumber, hostZone.ChainId)
+	if !found {
+		return response, recordstypes.ErrHostUnbondingRecordNotFound.Wrapf("epoch number %d, chain %s",
+			userRedemptionRecord.EpochNumber, hostZone.ChainId)
+	}
+	dayEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH)
+	if !found {
+		return response, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker (%s) not found", epochtypes.DAY_EPOCH)
+	}
+
+	// One day is added to each estimate to account for the sweep to the redemption account
+	unbondingPeriod := hostZone.UnbondingPeriod * nanosecondsInDay
+	queued := hostZoneUnbonding.ShouldInitiateUnbonding() || hostZoneUnbonding.ShouldRetryUnbonding()
+	if !queued {
+		completionTime := hostZoneUnbonding.UnbondingTime
+		if completionTime == 0 {
+			completionTime = utils.IntToUint(ctx.BlockTime().UnixNano()) + unbondingPeriod
+		}
+		response.EstimatedCompletionTime = completionTime + nanosecondsInDay
+		return response, nil
+	}
+
+	// Sum the native tokens queued in each record up to and including the record's epoch
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		if epochUnbondingRecord.EpochNumber > userRedemptionRecord.EpochNumber {
+			break
+		}
+		queuedUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
+		if !found {
+			continue
+		}
+		queuedAmount := getQueuedNativeAmount(*queuedUnbonding, hostZone.RedemptionRate)
+		response.NativeTokensQueuedAhead = response.NativeTokensQueuedAhead.Add(queuedAmount)
+	}
+
+	// Determine the number of unbonding epochs required to clear the queue ahead of the record
+	numUnbondingEpochs := uint64(1)
+	if maxUnbond, capped := hostZone.SafelyGetMaxUnbondPerEpoch(); capped && response.NativeTokensQueuedAhead.IsPositive() {
+		numUnbondingEpochs = response.NativeTokensQueuedAhead.Add(maxUnbond).SubRaw(1).Quo(maxUnbond).Uint64()
+	}
+
+	// Unbondings are triggered on the day epochs that are a multiple of the unbonding frequency
+	unbondingFrequency := hostZone.GetUnbondingFrequency()
+	nextUnbondingDayEpoch := (dayEpochTracker.EpochNumber/unbondingFrequency + 1) * unbondingFrequency
+	response.UnbondingDayEpoch = nextUnbondingDayEpoch + (numUnbondingEpochs-1)*unbondingFrequency
+
+	unbondingStartTime := dayEpochTracker.NextEpochStartTime +
+		(response.UnbondingDayEpoch-dayEpochTracker.EpochNumber-1)*dayEpochTracker.Duration
+	response.EstimatedCompletionTime = unbondingStartTime + unbondingPeriod + nanosecondsInDay
+
+	return response, nil
+}
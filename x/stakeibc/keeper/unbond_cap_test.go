@@ -0,0 +1,309 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	epochtypes "github.com/Stride-Labs/stride/v33/x/epochs/types"
+	recordtypes "github.com/Stride-Labs/stride/v33/x/records/types"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestApplyUnbondCap() {
+	// Three queued records, with 1000, 2000, and 3000 native tokens to unbond
+	epochNumbers := []uint64{1, 2, 3}
+	hostZoneUnbondings := map[uint64]recordtypes.HostZoneUnbonding{
+		1: {HostZoneId: HostChainId, NativeTokensToUnbond: sdkmath.NewInt(1000)},
+		2: {HostZoneId: HostChainId, NativeTokensToUnbond: sdkmath.NewInt(2000)},
+		3: {HostZoneId: HostChainId, NativeTokensToUnbond: sdkmath.NewInt(3000)},
+	}
+
+	testCases := []struct {
+		name                 string
+		maxUnbond            int64
+		expectedEpochNumbers []uint64
+		expectedUnbondAmount int64
+	}{
+		{
+			name:                 "cap below first record",
+			maxUnbond:            500,
+			expectedEpochNumbers: []uint64{1},
+			expectedUnbondAmount: 500,
+		},
+		{
+			name:                 "cap equal to first record",
+			maxUnbond:            1000,
+			expectedEpochNumbers: []uint64{1},
+			expectedUnbondAmount: 1000,
+		},
+		{
+			name:                 "cap partially through second record",
+			maxUnbond:            1500,
+			expectedEpochNumbers: []uint64{1, 2},
+			expectedUnbondAmount: 1500,
+		},
+		{
+			name:                 "cap partially through last record",
+			maxUnbond:            5999,
+			expectedEpochNumbers: []uint64{1, 2, 3},
+			expectedUnbondAmount: 5999,
+		},
+		{
+			name:                 "cap above total",
+			maxUnbond:            10_000,
+			expectedEpochNumbers: []uint64{1, 2, 3},
+			expectedUnbondAmount: 6000,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			actualEpochNumbers, actualHostZoneUnbondings, actualUnbondAmount := keeper.ApplyUnbondCap(
+				sdkmath.NewInt(tc.maxUnbond),
+				epochNumbers,
+				hostZoneUnbondings,
+			)
+
+			s.Require().Equal(tc.expectedEpochNumbers, actualEpochNumbers, "epoch numbers")
+			s.Require().Equal(tc.expectedUnbondAmount, actualUnbondAmount.Int64(), "unbond amount")
+
+			s.Require().Len(actualHostZoneUnbondings, len(tc.expectedEpochNumbers), "number of records")
+			for _, epochNumber := range tc.expectedEpochNumbers {
+				s.Require().Equal(hostZoneUnbondings[epochNumber], actualHostZoneUnbondings[epochNumber],
+					"record for epoch %d", epochNumber)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnbondFromHostZone_UnbondCap() {
+	// Two records are queued with 500 tokens each
+	// With a cap of 300, only 300 tokens from the first record should be unbonded
+	totalStake := sdkmath.NewInt(10_000)
+	totalUnbondAmount := sdkmath.NewInt(1000)
+	maxUnbond := sdkmath.NewInt(300)
+
+	validators := []*types.Validator{
+		{Address: "valA", Weight: 100, Delegation: totalStake},
+	}
+	expectedUnbondings := []ValidatorUnbonding{
+		{Validator: "valA", UnbondAmount: maxUnbond},
+	}
+
+	tc := s.SetupTestUnbondFromHostZone(100, totalStake, totalUnbondAmount, validators)
+
+	tc.hostZone.MaxUnbondPerEpoch = maxUnbond
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, tc.hostZone)
+
+	tc.totalUnbondAmount = maxUnbond
+	tc.expectedUnbondingRecordIds = []uint64{1}
+	s.CheckUnbondingMessages(tc, expectedUnbondings)
+
+	// The first record should be in progress with the full amount still to unbond (until the callback)
+	// and the second record should remain in the queue for the next unbonding epoch
+	firstRecord := s.MustGetHostZoneUnbonding(1, HostChainId)
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS, firstRecord.Status, "first record status")
+	s.Require().Equal(int64(500), firstRecord.NativeTokensToUnbond.Int64(), "first record native to unbond")
+	s.Require().Equal(uint64(1), firstRecord.UndelegationTxsInProgress, "first record txs in progress")
+
+	secondRecord := s.MustGetHostZoneUnbonding(2, HostChainId)
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_QUEUE, secondRecord.Status, "second record status")
+	s.Require().Zero(secondRecord.UndelegationTxsInProgress, "second record txs in progress")
+}
+
+func (s *KeeperTestSuite) TestRollOverCappedUnbondings() {
+	inProgress := recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS
+	retry := recordtypes.HostZoneUnbonding_UNBONDING_RETRY_QUEUE
+	complete := recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE
+
+	testCases := []struct {
+		epochNumber    uint64
+		status         recordtypes.HostZoneUnbonding_Status
+		remaining      int64
+		txsInProgress  uint64
+		expectedStatus recordtypes.HostZoneUnbonding_Status
+	}{
+		// Partially unbonded with no txs in progress - should be rolled over
+		{epochNumber: 1, status: inProgress, remaining: 200, txsInProgress: 0, expectedStatus: retry},
+		// Partially unbonded, but with another batch in progress - should not change
+		{epochNumber: 2, status: inProgress, remaining: 200, txsInProgress: 1, expectedStatus: inProgress},
+		// Fully unbonded - should not change
+		{epochNumber: 3, status: complete, remaining: 0, txsInProgress: 0, expectedStatus: complete},
+		// In progress with nothing remaining - should not change
+		{epochNumber: 4, status: inProgress, remaining: 0, txsInProgress: 0, expectedStatus: inProgress},
+	}
+
+	epochNumbers := []uint64{}
+	for _, tc := range testCases {
+		epochNumbers = append(epochNumbers, tc.epochNumber)
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+			EpochNumber: tc.epochNumber,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{
+					HostZoneId:                HostChainId,
+					Status:                    tc.status,
+					StTokenAmount:             sdkmath.NewInt(1000),
+					NativeTokenAmount:         sdkmath.NewInt(1000),
+					NativeTokensToUnbond:      sdkmath.NewInt(tc.remaining),
+					StTokensToBurn:            sdkmath.NewInt(tc.remaining),
+					UndelegationTxsInProgress: tc.txsInProgress,
+				},
+			},
+		})
+	}
+
+	err := s.App.StakeibcKeeper.RollOverCappedUnbondings(s.Ctx, HostChainId, epochNumbers)
+	s.Require().NoError(err, "no error expected when rolling over unbondings")
+
+	for _, tc := range testCases {
+		hostZoneUnbonding := s.MustGetHostZoneUnbonding(tc.epochNumber, HostChainId)
+		s.Require().Equal(tc.expectedStatus, hostZoneUnbonding.Status, "status for epoch %d", tc.epochNumber)
+		s.Require().Equal(tc.remaining, hostZoneUnbonding.NativeTokensToUnbond.Int64(), "remaining for epoch %d", tc.epochNumber)
+	}
+
+	// Rolled over records should be picked up in the next unbonding
+	queuedEpochNumbers, _ := s.App.StakeibcKeeper.GetQueuedHostZoneUnbondingRecords(s.Ctx, HostChainId)
+	s.Require().Equal([]uint64{1}, queuedEpochNumbers, "queued epoch numbers")
+
+	// Missing record
+	err = s.App.StakeibcKeeper.RollOverCappedUnbondings(s.Ctx, HostChainId, []uint64{99})
+	s.Require().ErrorContains(err, "epoch number 99")
+}
+
+func (s *KeeperTestSuite) TestGetRedemptionCompletionEstimate() {
+	dayDuration := uint64(86_400_000_000_000)
+	nextEpochStartTime := uint64(1_000) * dayDuration
+	completedUnbondingTime := uint64(900) * dayDuration
+
+	// Unbonding period of 21 days implies an unbonding frequency of 4
+	// The current day epoch is 10, so the next unbonding epoch is 12
+	hostZone := types.HostZone{
+		ChainId:           HostChainId,
+		UnbondingPeriod:   21,
+		RedemptionRate:    sdkmath.LegacyMustNewDecFromStr("2.0"),
+		MaxUnbondPerEpoch: sdkmath.NewInt(1000),
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier:    epochtypes.DAY_EPOCH,
+		EpochNumber:        10,
+		NextEpochStartTime: nextEpochStartTime,
+		Duration:           dayDuration,
+	})
+
+	// Epoch 1: 600 native left to unbond from a previous partial unbonding
+	// Epoch 2: 450 stTokens queued (900 native at the current redemption rate)
+	// Epoch 3: 500 stTokens queued (1000 native at the current redemption rate)
+	// Epoch 4: already unbonded
+	hostZoneUnbondings := map[uint64]recordtypes.HostZoneUnbonding{
+		1: {
+			Status:               recordtypes.HostZoneUnbonding_UNBONDING_RETRY_QUEUE,
+			StTokenAmount:        sdkmath.NewInt(600),
+			NativeTokensToUnbond: sdkmath.NewInt(600),
+		},
+		2: {
+			Status:        recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+			StTokenAmount: sdkmath.NewInt(450),
+		},
+		3: {
+			Status:        recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+			StTokenAmount: sdkmath.NewInt(500),
+		},
+		4: {
+			Status:        recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE,
+			StTokenAmount: sdkmath.NewInt(500),
+			UnbondingTime: completedUnbondingTime,
+		},
+	}
+	for epochNumber := uint64(1); epochNumber <= 4; epochNumber++ {
+		hostZoneUnbonding := hostZoneUnbondings[epochNumber]
+		hostZoneUnbonding.HostZoneId = HostChainId
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+			EpochNumber:        epochNumber,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{&hostZoneUnbonding},
+		})
+		s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+			Id:          recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, "receiver"),
+			HostZoneId:  HostChainId,
+			EpochNumber: epochNumber,
+		})
+	}
+	recordId := func(epochNumber uint64) string {
+		return recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, "receiver")
+	}
+
+	// Epoch 2 record: 1500 queued ahead, requiring 2 unbonding epochs (12 and 16)
+	// Unbonding starts 5 days after the next epoch start, then 21 days of unbonding and 1 day for the sweep
+	estimate, err := s.App.StakeibcKeeper.GetRedemptionCompletionEstimate(s.Ctx, recordId(2))
+	s.Require().NoError(err, "no error expected for epoch 2 record")
+	s.Require().Equal(int64(1500), estimate.NativeTokensQueuedAhead.Int64(), "epoch 2 queued ahead")
+	s.Require().Equal(uint64(16), estimate.UnbondingDayEpoch, "epoch 2 unbonding day epoch")
+	s.Require().Equal(nextEpochStartTime+(5+21+1)*dayDuration, estimate.EstimatedCompletionTime, "epoch 2 completion time")
+
+	// Epoch 3 record: 2500 queued ahead, requiring 3 unbonding epochs (12, 16, and 20)
+	estimate, err = s.App.StakeibcKeeper.GetRedemptionCompletionEstimate(s.Ctx, recordId(3))
+	s.Require().NoError(err, "no error expected for epoch 3 record")
+	s.Require().Equal(int64(2500), estimate.NativeTokensQueuedAhead.Int64(), "epoch 3 queued ahead")
+	s.Require().Equal(uint64(20), estimate.UnbondingDayEpoch, "epoch 3 unbonding day epoch")
+	s.Require().Equal(nextEpochStartTime+(9+21+1)*dayDuration, estimate.EstimatedCompletionTime, "epoch 3 completion time")
+
+	// Epoch 4 record: already unbonded, so the estimate comes from the record's unbonding time
+	estimate, err = s.App.StakeibcKeeper.GetRedemptionCompletionEstimate(s.Ctx, recordId(4))
+	s.Require().NoError(err, "no error expected for epoch 4 record")
+	s.Require().Zero(estimate.NativeTokensQueuedAhead.Int64(), "epoch 4 queued ahead")
+	s.Require().Zero(estimate.UnbondingDayEpoch, "epoch 4 unbonding day epoch")
+	s.Require().Equal(completedUnbondingTime+dayDuration, estimate.EstimatedCompletionTime, "epoch 4 completion time")
+
+	// Remove the cap - the epoch 3 record should be unbonded in the next unbonding epoch
+	hostZone.MaxUnbondPerEpoch = sdkmath.ZeroInt()
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	estimate, err = s.App.StakeibcKeeper.GetRedemptionCompletionEstimate(s.Ctx, recordId(3))
+	s.Require().NoError(err, "no error expected for epoch 3 record without cap")
+	s.Require().Equal(uint64(12), estimate.UnbondingDayEpoch, "epoch 3 unbonding day epoch without cap")
+	s.Require().Equal(nextEpochStartTime+(1+21+1)*dayDuration, estimate.EstimatedCompletionTime, "epoch 3 completion time without cap")
+
+	// Missing record
+	_, err = s.App.StakeibcKeeper.GetRedemptionCompletionEstimate(s.Ctx, "missing")
+	s.Require().ErrorContains(err, "user redemption record missing not found")
+
+	// Query
+	response, err := s.App.StakeibcKeeper.RedemptionCompletionEstimate(s.Ctx, &types.QueryRedemptionCompletionEstimateRequest{
+		UserRedemptionRecordId: recordId(3),
+	})
+	s.Require().NoError(err, "no error expected when querying estimate")
+	s.Require().Equal(uint64(12), response.UnbondingDayEpoch, "queried unbonding day epoch")
+
+	_, err = s.App.StakeibcKeeper.RedemptionCompletionEstimate(s.Ctx, &types.QueryRedemptionCompletionEstimateRequest{
+		UserRedemptionRecordId: "missing",
+	})
+	s.Require().ErrorContains(err, "not found")
+}
+
+func (s *KeeperTestSuite) TestSetUnbondCap() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: HostChainId})
+
+	msg := types.MsgSetUnbondCap{
+		Authority:         Authority,
+		ChainId:           HostChainId,
+		MaxUnbondPerEpoch: sdkmath.NewInt(1000),
+	}
+	_, err := s.GetMsgServer().SetUnbondCap(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when setting unbond cap")
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Equal(int64(1000), hostZone.MaxUnbondPerEpoch.Int64(), "unbond cap")
+
+	// Missing host zone
+	invalidMsg := msg
+	invalidMsg.ChainId = "missing"
+	_, err = s.GetMsgServer().SetUnbondCap(s.Ctx, &invalidMsg)
+	s.Require().ErrorContains(err, "host zone missing not found")
+
+	// Invalid authority
+	invalidMsg = msg
+	invalidMsg.Authority = "not-gov-address"
+	_, err = s.GetMsgServer().SetUnbondCap(s.Ctx, &invalidMsg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
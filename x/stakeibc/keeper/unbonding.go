@@ -349,6 +349,8 @@ func (k Keeper) GetUnbondingICAMessages(
 }
 
 // Submit undelegate ICA messages in small batches to reduce the gas size per tx
+// Each batch is capped at batchSize messages, and at the host zone's max ICA tx gas
+// if one is configured (see GetIcaMsgBatchSizes)
 func (k Keeper) BatchSubmitUndelegateICAMessages(
 	ctx sdk.Context,
 	hostZone types.HostZone,
@@ -358,11 +360,9 @@ func (k Keeper) BatchSubmitUndelegateICAMessages(
 	batchSize int,
 ) (numTxsSubmitted uint64, err error) {
 	// Iterate the full list of messages and submit in batches
-	for start := 0; start < len(msgs); start += batchSize {
-		end := start + batchSize
-		if end > len(msgs) {
-			end = len(msgs)
-		}
+	start := 0
+	for _, msgsInBatch := range k.GetIcaMsgBatchSizes(ctx, hostZone, msgs, batchSize) {
+		end := start + msgsInBatch
 
 		msgsBatch := msgs[start:end]
 		unbondingsBatch := unbondings[start:end]
@@ -398,30 +398,38 @@ func (k Keeper) BatchSubmitUndelegateICAMessages(
 			}
 		}
 		k.SetHostZone(ctx, hostZone)
+
+		start = end
 	}
 
 	return numTxsSubmitted, nil
 }
 
-// Submits undelegation ICA messages for a given host zone
+// The undelegations that would be submitted for a host zone's queued unbonding records
+type UnbondingPlan struct {
+	EpochUnbondingRecordIds          []uint64
+	EpochNumbersToHostZoneUnbondings map[uint64]recordstypes.HostZoneUnbonding
+	TotalUnbondAmount                sdkmath.Int
+	Msgs                             []proto.Message
+	Unbondings                       []*types.SplitUndelegation
+}
+
+// Builds the undelegation ICA messages for a given host zone, without submitting them
 //
 // First, the total unbond amount is determined from the epoch unbonding records
+// (limited to the host zone's unbond cap, if one is set - see ApplyUnbondCap)
 // Then that unbond amount is allowed to cascade across the validators in order of how proportionally
 // different their current delegations are from the weight implied target delegation,
 // until their capacities have consumed the full amount
 // As a result, unbondings lead to a more balanced distribution of stake across validators
 //
 // Context: Over time, as LSM Liquid stakes are accepted, the total stake managed by the protocol becomes unbalanced
-// as liquid stakes are not aligned with the validator weights. This is only rebalanced once per unbonding period
-func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (err error) {
-	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
-		"Preparing MsgUndelegates from the delegation account to each validator"))
-
-	// Confirm the delegation account was registered
-	if hostZone.DelegationIcaAddress == "" {
-		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", hostZone.ChainId)
-	}
-
+// as liquid stakes are not aligned with the validator weights
+//
+// Note: this refreshes the native amounts on the queued unbonding records, so callers that
+// should not modify state (e.g. queries) must pass a cache context
+// If nothing is queued, the plan will have a zero TotalUnbondAmount and no messages
+func (k Keeper) GetUnbondingPlan(ctx sdk.Context, hostZone types.HostZone) (plan UnbondingPlan, err error) {
 	// Get the list of relevant records that should unbond
 	epochUnbondingRecordIds, epochNumbersToHostZoneUnbondings := k.GetQueuedHostZoneUnbondingRecords(ctx, hostZone.ChainId)
 
@@ -429,17 +437,38 @@ func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (er
 	// The native amount is calculated from the stTokens
 	epochNumbersToHostZoneUnbondings, err = k.RefreshUnbondingNativeTokenAmounts(ctx, hostZone.ChainId, epochNumbersToHostZoneUnbondings)
 	if err != nil {
-		return err
+		return plan, err
 	}
 
 	// Sum the total number of native tokens from the records above that are ready to unbond
 	totalNativeUnbondAmount := k.GetTotalUnbondAmount(epochNumbersToHostZoneUnbondings)
+
+	// If the host zone has an unbond cap, only unbond the earliest records up to the cap
+	// The remaining records roll over to the next unbonding epoch
+	if maxUnbond, capped := hostZone.SafelyGetMaxUnbondPerEpoch(); capped && totalNativeUnbondAmount.GT(maxUnbond) {
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+			"Queued unbond amount %v%s exceeds the unbond cap of %v%s, rolling over the remainder to the next unbonding epoch",
+			totalNativeUnbondAmount, hostZone.HostDenom, maxUnbond, hostZone.HostDenom))
+
+		epochUnbondingRecordIds, epochNumbersToHostZoneUnbondings, totalNativeUnbondAmount = ApplyUnbondCap(
+			maxUnbond,
+			epochUnbondingRecordIds,
+			epochNumbersToHostZoneUnbondings,
+		)
+	}
+
 	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
 		"Total unbonded amount: %v%s", totalNativeUnbondAmount, hostZone.HostDenom))
 
+	plan = UnbondingPlan{
+		EpochUnbondingRecordIds:          epochUnbondingRecordIds,
+		EpochNumbersToHostZoneUnbondings: epochNumbersToHostZoneUnbondings,
+		TotalUnbondAmount:                totalNativeUnbondAmount,
+	}
+
 	// If there's nothing to unbond, return and move on to the next host zone
 	if totalNativeUnbondAmount.IsZero() {
-		return nil
+		return plan, nil
 	}
 
 	// Determine the total eligible unbond amount - excluding delegations to validators with a slash query in progress
@@ -452,19 +481,30 @@ func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (er
 
 	// Determine the ideal balanced delegation for each validator after the unbonding
 	//   (as if we were to unbond and then rebalance)
+	// The directed delegations are scaled down proportionally with the unbonding
 	delegationAfterUnbonding := totalValidDelegationBeforeUnbonding.Sub(totalNativeUnbondAmount)
-	balancedDelegationsAfterUnbonding, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, delegationAfterUnbonding)
+	directedDelegationsAfterUnbonding := GetDirectedDelegationsAfterUnbonding(
+		GetDirectedDelegations(hostZone),
+		totalValidDelegationBeforeUnbonding,
+		delegationAfterUnbonding,
+	)
+	balancedDelegationsAfterUnbonding, err := k.GetTargetValAmtsWithDirectedDelegations(
+		ctx,
+		hostZone,
+		delegationAfterUnbonding,
+		directedDelegationsAfterUnbonding,
+	)
 	if err != nil {
-		return errorsmod.Wrapf(err, "unable to get target val amounts for host zone %s", hostZone.ChainId)
+		return plan, errorsmod.Wrapf(err, "unable to get target val amounts for host zone %s", hostZone.ChainId)
 	}
 
 	// Determine the unbond capacity for each validator
 	// Each validator can only unbond up to the difference between their current delegation and their balanced delegation
 	// The validator's current delegation will be above their balanced delegation if they've received LSM Liquid Stakes
-	//   (which is only rebalanced once per unbonding period)
+	//   (which have not yet been rebalanced)
 	validatorUnbondCapacity := k.GetValidatorUnbondCapacity(ctx, hostZone.Validators, balancedDelegationsAfterUnbonding)
 	if len(validatorUnbondCapacity) == 0 {
-		return fmt.Errorf("there are no validators on %s with sufficient unbond capacity", hostZone.ChainId)
+		return plan, fmt.Errorf("there are no validators on %s with sufficient unbond capacity", hostZone.ChainId)
 	}
 
 	// Sort the unbonding capacity by priority
@@ -472,32 +512,59 @@ func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (er
 	// Zero weight validators will come first in the list
 	prioritizedUnbondCapacity, err := SortUnbondingCapacityByPriority(validatorUnbondCapacity)
 	if err != nil {
-		return err
+		return plan, err
 	}
 
 	// Get the undelegation ICA messages and split delegations for the callback
-	undelegateBatchSize := int(utils.UintToInt(hostZone.MaxMessagesPerIcaTx))
 	msgs, unbondings, err := k.GetUnbondingICAMessages(
 		hostZone,
 		totalNativeUnbondAmount,
 		prioritizedUnbondCapacity,
 	)
 	if err != nil {
-		return err
+		return plan, err
 	}
 
 	// Shouldn't be possible, but if all the validator's had a target unbonding of zero, do not send an ICA
 	if len(msgs) == 0 {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Target unbonded amount was 0 for each validator")
+		return plan, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Target unbonded amount was 0 for each validator")
+	}
+
+	plan.Msgs = msgs
+	plan.Unbondings = unbondings
+
+	return plan, nil
+}
+
+// Submits undelegation ICA messages for a given host zone
+// See GetUnbondingPlan for how the undelegations are split across validators
+func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (err error) {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Preparing MsgUndelegates from the delegation account to each validator"))
+
+	// Confirm the delegation account was registered
+	if hostZone.DelegationIcaAddress == "" {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", hostZone.ChainId)
+	}
+
+	plan, err := k.GetUnbondingPlan(ctx, hostZone)
+	if err != nil {
+		return err
+	}
+
+	// If there's nothing to unbond, return and move on to the next host zone
+	if plan.TotalUnbondAmount.IsZero() {
+		return nil
 	}
 
 	// Send the messages in batches so the gas limit isn't exceedeed
+	undelegateBatchSize := int(utils.UintToInt(hostZone.MaxMessagesPerIcaTx))
 	numTxsSubmitted, err := k.BatchSubmitUndelegateICAMessages(
 		ctx,
 		hostZone,
-		epochUnbondingRecordIds,
-		msgs,
-		unbondings,
+		plan.EpochUnbondingRecordIds,
+		plan.Msgs,
+		plan.Unbondings,
 		undelegateBatchSize,
 	)
 	if err != nil {
@@ -505,8 +572,8 @@ func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (er
 	}
 
 	// Update the epoch unbonding record status and number of undelegation ICAs
-	for _, epochNumber := range utils.Uint64MapKeys(epochNumbersToHostZoneUnbondings) {
-		hostZoneUnbonding := epochNumbersToHostZoneUnbondings[epochNumber]
+	for _, epochNumber := range utils.Uint64MapKeys(plan.EpochNumbersToHostZoneUnbondings) {
+		hostZoneUnbonding := plan.EpochNumbersToHostZoneUnbondings[epochNumber]
 		hostZoneUnbonding.Status = recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS
 		hostZoneUnbonding.UndelegationTxsInProgress += numTxsSubmitted
 		err := k.RecordsKeeper.SetHostZoneUnbondingRecord(ctx, epochNumber, hostZone.ChainId, hostZoneUnbonding)
@@ -515,7 +582,7 @@ func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (er
 		}
 	}
 
-	EmitUndelegationEvent(ctx, hostZone, totalNativeUnbondAmount)
+	EmitUndelegationEvent(ctx, hostZone, plan.TotalUnbondAmount)
 
 	return nil
 }
@@ -536,6 +603,11 @@ func (k Keeper) InitiateAllHostZoneUnbondings(ctx sdk.Context, dayNumber uint64)
 			continue
 		}
 
+		if err := k.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, types.PAUSE_UNDELEGATE_ICA); err != nil {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Not unbonding: %s", err.Error()))
+			continue
+		}
+
 		// Get host zone unbonding message by summing up the unbonding records
 		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
 			return k.UnbondFromHostZone(ctx, hostZone)
@@ -217,6 +217,83 @@ func (s *KeeperTestSuite) TestUnbondFromHostZone_Successful_UnbondOnlyZeroWeight
 	s.CheckUnbondingMessages(tc, expectedUnbondings)
 }
 
+func (s *KeeperTestSuite) TestQueryUnbondingPlan() {
+	// Same setup as the zero weight validator case above, where valC and valE are unbonded from
+	totalUnbondAmount := sdkmath.NewInt(50)
+	totalStake := sdkmath.NewInt(1250)
+	totalWeight := int64(100)
+
+	validators := []*types.Validator{
+		{Address: "valA", Weight: 10, Delegation: sdkmath.NewInt(100)},
+		{Address: "valB", Weight: 35, Delegation: sdkmath.NewInt(420)},
+		{Address: "valC", Weight: 0, Delegation: sdkmath.NewInt(40)},
+		{Address: "valD", Weight: 30, Delegation: sdkmath.NewInt(300)},
+		{Address: "valE", Weight: 0, Delegation: sdkmath.NewInt(30)},
+		{Address: "valF", Weight: 10, Delegation: sdkmath.NewInt(200)},
+		{Address: "valG", Weight: 15, Delegation: sdkmath.NewInt(160)},
+	}
+	tc := s.SetupTestUnbondFromHostZone(totalWeight, totalStake, totalUnbondAmount, validators)
+
+	resp, err := s.App.StakeibcKeeper.UnbondingPlan(s.Ctx, &types.QueryUnbondingPlanRequest{ChainId: HostChainId})
+	s.Require().NoError(err, "no error expected when querying unbonding plan")
+
+	s.Require().Equal(totalUnbondAmount.Int64(), resp.TotalUnbondAmount.Int64(), "total unbond amount")
+	s.Require().Equal(tc.expectedUnbondingRecordIds, resp.EpochUnbondingRecordIds, "epoch unbonding record ids")
+	s.Require().Equal(uint64(1), resp.NextUnbondingDayEpoch, "next unbonding day epoch")
+
+	expectedUnbondings := []ValidatorUnbonding{
+		{Validator: "valC", UnbondAmount: sdkmath.NewInt(40)},
+		{Validator: "valE", UnbondAmount: sdkmath.NewInt(10)},
+	}
+	s.Require().Len(resp.SplitUndelegations, len(expectedUnbondings), "number of split undelegations")
+	for i, expected := range expectedUnbondings {
+		s.Require().Equal(expected.Validator, resp.SplitUndelegations[i].Validator, "validator - index %d", i)
+		s.Require().Equal(expected.UnbondAmount.Int64(), resp.SplitUndelegations[i].NativeTokenAmount.Int64(), "amount - index %d", i)
+	}
+
+	s.Require().Len(resp.Txs, 1, "number of planned txs")
+	s.Require().Equal(keeper.ICACallbackID_Undelegate, resp.Txs[0].CallbackId, "callback id")
+	s.Require().Len(resp.Txs[0].Msgs, 2, "number of planned msgs")
+	s.Require().Equal("/cosmos.staking.v1beta1.MsgUndelegate", resp.Txs[0].Msgs[0].TypeUrl, "msg type")
+	s.Require().Contains(resp.Txs[0].Msgs[0].Json, "valC", "msg json")
+
+	// No ICA should have been submitted
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.delegationPortID, tc.delegationChannelID)
+	s.Require().True(found, "sequence number not found")
+	s.Require().Equal(tc.channelStartSequence, endSequence, "sequence number should not have changed")
+
+	// Halted host zones are skipped by the epochly unbonding
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err = s.App.StakeibcKeeper.UnbondingPlan(s.Ctx, &types.QueryUnbondingPlanRequest{ChainId: HostChainId})
+	s.Require().ErrorContains(err, "host zone GAIA is halted")
+}
+
+func (s *KeeperTestSuite) TestQueryUnbondingPlan_DoesNotModifyRecords() {
+	validators := []*types.Validator{
+		{Address: "valA", Weight: 50, Delegation: sdkmath.NewInt(500)},
+		{Address: "valB", Weight: 50, Delegation: sdkmath.NewInt(500)},
+	}
+	s.SetupTestUnbondFromHostZone(int64(100), sdkmath.NewInt(1000), sdkmath.NewInt(50), validators)
+
+	// Update the redemption rate so that building the plan would refresh the native amounts
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.RedemptionRate = hostZone.RedemptionRate.Mul(sdkmath.LegacyNewDec(2))
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	initialEpochUnbondingRecords := s.App.RecordsKeeper.GetAllEpochUnbondingRecord(s.Ctx)
+	initialUserRedemptionRecords := s.App.RecordsKeeper.GetAllUserRedemptionRecord(s.Ctx)
+
+	_, err := s.App.StakeibcKeeper.UnbondingPlan(s.Ctx, &types.QueryUnbondingPlanRequest{ChainId: HostChainId})
+	s.Require().NoError(err, "no error expected when querying unbonding plan")
+
+	// The records should be unchanged after the query
+	s.Require().Equal(initialEpochUnbondingRecords, s.App.RecordsKeeper.GetAllEpochUnbondingRecord(s.Ctx), "epoch unbonding records")
+	s.Require().Equal(initialUserRedemptionRecords, s.App.RecordsKeeper.GetAllUserRedemptionRecord(s.Ctx), "user redemption records")
+}
+
 func (s *KeeperTestSuite) TestUnbondFromHostZone_Successful_UnbondIgnoresSlashQueryInProgress() {
 	// Native Stake:       100
 	// LSM Stake:           0
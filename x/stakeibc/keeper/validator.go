@@ -34,6 +34,11 @@ func (k Keeper) AddValidatorToHostZone(ctx sdk.Context, chainId string, validato
 		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "Host Zone (%s) not found", chainId)
 	}
 
+	// Blacklisted validators cannot be added back to the set
+	if hostZone.IsValidatorBlacklisted(validator.Address) {
+		return errorsmod.Wrapf(types.ErrValidatorBlacklisted, "Validator address (%s) is blacklisted on Host Zone (%s)", validator.Address, chainId)
+	}
+
 	// Check that we don't already have this validator
 	// Grab the minimum weight in the process (to assign to validator's added through governance)
 	var minWeight uint64 = math.MaxUint64
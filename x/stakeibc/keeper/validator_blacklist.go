@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Adds a validator to the host zone's blacklist and sets its weight to zero
+// If the validator is already blacklisted, this is a no-op
+func (k Keeper) BlacklistValidator(ctx sdk.Context, chainId string, validatorAddress string, reason string) error {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return types.ErrHostZoneNotFound.Wrapf("host zone %s not found", chainId)
+	}
+	if hostZone.IsValidatorBlacklisted(validatorAddress) {
+		return nil
+	}
+
+	hostZone.BlacklistedValidators = append(hostZone.BlacklistedValidators, validatorAddress)
+	for _, validator := range hostZone.Validators {
+		if validator.Address == validatorAddress && validator.Weight != 0 {
+			EmitValidatorWeightUpdateEvent(ctx, chainId, validator.Address, validator.Weight, 0)
+			validator.Weight = 0
+		}
+	}
+	k.SetHostZone(ctx, hostZone)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Validator %s blacklisted: %s", validatorAddress, reason))
+	EmitValidatorBlacklistedEvent(ctx, chainId, validatorAddress, reason)
+
+	return nil
+}
+
+// Blacklists a validator and immediately redelegates its stake to the rest of the set
+// using the rebalance ICA
+// If the rebalance cannot be submitted, the validator remains blacklisted and its stake
+// will be moved during the next scheduled rebalance
+func (k Keeper) BlacklistValidatorAndRedelegate(ctx sdk.Context, chainId string, validatorAddress string, reason string) error {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return types.ErrHostZoneNotFound.Wrapf("host zone %s not found", chainId)
+	}
+	if hostZone.IsValidatorBlacklisted(validatorAddress) {
+		return nil
+	}
+
+	if err := k.BlacklistValidator(ctx, chainId, validatorAddress, reason); err != nil {
+		return err
+	}
+
+	err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.RebalanceDelegationsForHostZone(ctx, chainId)
+	})
+	if err != nil {
+		k.Logger(ctx).Error(utils.LogWithHostZone(chainId,
+			"Unable to redelegate away from blacklisted validator %s: %s", validatorAddress, err.Error()))
+	}
+
+	return nil
+}
+
+// Blacklists a slashed validator if the slash was at least the threshold in the host zone's policy
+func (k Keeper) BlacklistValidatorIfSlashedPastThreshold(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	validatorAddress string,
+	slashPct sdkmath.LegacyDec,
+) error {
+	policy := hostZone.ValidatorBlacklistPolicy
+	if policy == nil || !policy.SlashThreshold.IsPositive() || slashPct.LT(policy.SlashThreshold) {
+		return nil
+	}
+
+	reason := fmt.Sprintf("slashed by %v", slashPct)
+	return k.BlacklistValidatorAndRedelegate(ctx, hostZone.ChainId, validatorAddress, reason)
+}
+
+// Blacklists a validator that was observed as jailed, if the host zone's policy blacklists jailed validators
+// Tombstoned validators are permanently jailed, so they're captured here as well
+func (k Keeper) BlacklistValidatorIfJailed(ctx sdk.Context, hostZone types.HostZone, validatorAddress string, jailed bool) error {
+	policy := hostZone.ValidatorBlacklistPolicy
+	if policy == nil || !policy.BlacklistJailed || !jailed {
+		return nil
+	}
+	return k.BlacklistValidatorAndRedelegate(ctx, hostZone.ChainId, validatorAddress, "jailed")
+}
+
+// Removes a validator from the host zone's blacklist
+// The validator's weight is left at zero and must be updated separately
+func (k Keeper) RemoveBlacklistedValidator(ctx sdk.Context, chainId string, validatorAddress string) error {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return types.ErrHostZoneNotFound.Wrapf("host zone %s not found", chainId)
+	}
+
+	blacklist := []string{}
+	for _, blacklistedAddress := range hostZone.BlacklistedValidators {
+		if blacklistedAddress != validatorAddress {
+			blacklist = append(blacklist, blacklistedAddress)
+		}
+	}
+	if len(blacklist) == len(hostZone.BlacklistedValidators) {
+		return types.ErrValidatorNotFound.Wrapf("validator %s is not blacklisted on %s", validatorAddress, chainId)
+	}
+
+	hostZone.BlacklistedValidators = blacklist
+	k.SetHostZone(ctx, hostZone)
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) setupValidatorBlacklist(policy *types.ValidatorBlacklistPolicy) {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId: HostChainId,
+		Validators: []*types.Validator{
+			{Address: "val1", Weight: 10, Delegation: sdkmath.NewInt(100)},
+			{Address: "val2", Weight: 20, Delegation: sdkmath.NewInt(200)},
+		},
+		ValidatorBlacklistPolicy: policy,
+	})
+}
+
+func (s *KeeperTestSuite) TestBlacklistValidator() {
+	s.setupValidatorBlacklist(nil)
+
+	err := s.App.StakeibcKeeper.BlacklistValidator(s.Ctx, HostChainId, "val1", "test")
+	s.Require().NoError(err, "no error expected when blacklisting validator")
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Equal([]string{"val1"}, hostZone.BlacklistedValidators, "blacklist")
+	s.Require().Zero(hostZone.Validators[0].Weight, "blacklisted validator weight")
+	s.Require().Equal(uint64(20), hostZone.Validators[1].Weight, "other validator weight")
+
+	// Blacklisting the same validator again should not add a duplicate
+	err = s.App.StakeibcKeeper.BlacklistValidator(s.Ctx, HostChainId, "val1", "test")
+	s.Require().NoError(err, "no error expected when blacklisting validator a second time")
+	s.Require().Equal([]string{"val1"}, s.MustGetHostZone(HostChainId).BlacklistedValidators, "blacklist after duplicate")
+
+	// Invalid host zone
+	err = s.App.StakeibcKeeper.BlacklistValidator(s.Ctx, "fake-chain", "val1", "test")
+	s.Require().ErrorContains(err, "host zone fake-chain not found")
+}
+
+func (s *KeeperTestSuite) TestBlacklistValidatorIfSlashedPastThreshold() {
+	testCases := []struct {
+		name                string
+		policy              *types.ValidatorBlacklistPolicy
+		slashPct            sdkmath.LegacyDec
+		expectedBlacklisted bool
+	}{
+		{
+			name:                "no policy",
+			policy:              nil,
+			slashPct:            sdkmath.LegacyMustNewDecFromStr("0.50"),
+			expectedBlacklisted: false,
+		},
+		{
+			name:                "threshold disabled",
+			policy:              &types.ValidatorBlacklistPolicy{SlashThreshold: sdkmath.LegacyZeroDec()},
+			slashPct:            sdkmath.LegacyMustNewDecFromStr("0.50"),
+			expectedBlacklisted: false,
+		},
+		{
+			name:                "slash below threshold",
+			policy:              &types.ValidatorBlacklistPolicy{SlashThreshold: sdkmath.LegacyMustNewDecFromStr("0.05")},
+			slashPct:            sdkmath.LegacyMustNewDecFromStr("0.01"),
+			expectedBlacklisted: false,
+		},
+		{
+			name:                "slash at threshold",
+			policy:              &types.ValidatorBlacklistPolicy{SlashThreshold: sdkmath.LegacyMustNewDecFromStr("0.05")},
+			slashPct:            sdkmath.LegacyMustNewDecFromStr("0.05"),
+			expectedBlacklisted: true,
+		},
+		{
+			name:                "slash above threshold",
+			policy:              &types.ValidatorBlacklistPolicy{SlashThreshold: sdkmath.LegacyMustNewDecFromStr("0.05")},
+			slashPct:            sdkmath.LegacyMustNewDecFromStr("0.10"),
+			expectedBlacklisted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setupValidatorBlacklist(tc.policy)
+
+			// The rebalance ICA cannot be submitted without a delegation account,
+			// but the validator should still be blacklisted
+			hostZone := s.MustGetHostZone(HostChainId)
+			err := s.App.StakeibcKeeper.BlacklistValidatorIfSlashedPastThreshold(s.Ctx, hostZone, "val1", tc.slashPct)
+			s.Require().NoError(err)
+
+			hostZone = s.MustGetHostZone(HostChainId)
+			s.Require().Equal(tc.expectedBlacklisted, hostZone.IsValidatorBlacklisted("val1"), "blacklisted")
+			if tc.expectedBlacklisted {
+				s.Require().Zero(hostZone.Validators[0].Weight, "weight")
+			} else {
+				s.Require().Equal(uint64(10), hostZone.Validators[0].Weight, "weight")
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBlacklistValidatorIfJailed() {
+	testCases := []struct {
+		name                string
+		policy              *types.ValidatorBlacklistPolicy
+		jailed              bool
+		expectedBlacklisted bool
+	}{
+		{
+			name:                "no policy",
+			policy:              nil,
+			jailed:              true,
+			expectedBlacklisted: false,
+		},
+		{
+			name:                "policy does not blacklist jailed validators",
+			policy:              &types.ValidatorBlacklistPolicy{SlashThreshold: sdkmath.LegacyZeroDec(), BlacklistJailed: false},
+			jailed:              true,
+			expectedBlacklisted: false,
+		},
+		{
+			name:                "validator not jailed",
+			policy:              &types.ValidatorBlacklistPolicy{SlashThreshold: sdkmath.LegacyZeroDec(), BlacklistJailed: true},
+			jailed:              false,
+			expectedBlacklisted: false,
+		},
+		{
+			name:                "validator jailed",
+			policy:              &types.ValidatorBlacklistPolicy{SlashThreshold: sdkmath.LegacyZeroDec(), BlacklistJailed: true},
+			jailed:              true,
+			expectedBlacklisted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setupValidatorBlacklist(tc.policy)
+
+			hostZone := s.MustGetHostZone(HostChainId)
+			err := s.App.StakeibcKeeper.BlacklistValidatorIfJailed(s.Ctx, hostZone, "val1", tc.jailed)
+			s.Require().NoError(err)
+
+			hostZone = s.MustGetHostZone(HostChainId)
+			s.Require().Equal(tc.expectedBlacklisted, hostZone.IsValidatorBlacklisted("val1"), "blacklisted")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRemoveBlacklistedValidator() {
+	s.setupValidatorBlacklist(nil)
+
+	err := s.App.StakeibcKeeper.BlacklistValidator(s.Ctx, HostChainId, "val1", "test")
+	s.Require().NoError(err, "no error expected when blacklisting validator")
+
+	// Remove via the gov message, the weight should remain at zero
+	msg := types.MsgRemoveBlacklistedValidator{
+		Authority:        Authority,
+		ChainId:          HostChainId,
+		ValidatorAddress: "val1",
+	}
+	_, err = s.GetMsgServer().RemoveBlacklistedValidator(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when removing validator from blacklist")
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().Empty(hostZone.BlacklistedValidators, "blacklist should be empty")
+	s.Require().Zero(hostZone.Validators[0].Weight, "weight should not be restored")
+
+	// Removing a validator that's not on the blacklist should fail
+	_, err = s.GetMsgServer().RemoveBlacklistedValidator(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "validator val1 is not blacklisted")
+
+	// Invalid authority
+	msg.Authority = "not-gov-address"
+	_, err = s.GetMsgServer().RemoveBlacklistedValidator(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
+
+func (s *KeeperTestSuite) TestSetValidatorBlacklistPolicy() {
+	s.setupValidatorBlacklist(nil)
+
+	policy := types.ValidatorBlacklistPolicy{
+		SlashThreshold:  sdkmath.LegacyMustNewDecFromStr("0.05"),
+		BlacklistJailed: true,
+	}
+	msg := types.MsgSetValidatorBlacklistPolicy{
+		Authority: Authority,
+		ChainId:   HostChainId,
+		Policy:    &policy,
+	}
+	_, err := s.GetMsgServer().SetValidatorBlacklistPolicy(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when setting policy")
+	s.Require().Equal(policy, *s.MustGetHostZone(HostChainId).ValidatorBlacklistPolicy, "policy")
+
+	// Clear the policy
+	msg.Policy = nil
+	_, err = s.GetMsgServer().SetValidatorBlacklistPolicy(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when clearing policy")
+	s.Require().Nil(s.MustGetHostZone(HostChainId).ValidatorBlacklistPolicy, "policy should be removed")
+
+	// Invalid host zone
+	msg.ChainId = "fake-chain"
+	_, err = s.GetMsgServer().SetValidatorBlacklistPolicy(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "host zone fake-chain not found")
+
+	// Invalid authority
+	msg.ChainId = HostChainId
+	msg.Authority = "not-gov-address"
+	_, err = s.GetMsgServer().SetValidatorBlacklistPolicy(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
+
+func (s *KeeperTestSuite) TestChangeValidatorWeight_Blacklisted() {
+	s.setupValidatorBlacklist(nil)
+
+	err := s.App.StakeibcKeeper.BlacklistValidator(s.Ctx, HostChainId, "val1", "test")
+	s.Require().NoError(err, "no error expected when blacklisting validator")
+
+	msg := types.MsgChangeValidatorWeights{
+		HostZone:         HostChainId,
+		ValidatorWeights: []*types.ValidatorWeight{{Address: "val1", Weight: 5}},
+	}
+	_, err = s.GetMsgServer().ChangeValidatorWeight(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrValidatorBlacklisted)
+}
+
+func (s *KeeperTestSuite) TestAddValidatorToHostZone_Blacklisted() {
+	s.setupValidatorBlacklist(nil)
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	hostZone.BlacklistedValidators = []string{"val3"}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	validator := types.Validator{Address: "val3", Weight: 10}
+	err := s.App.StakeibcKeeper.AddValidatorToHostZone(s.Ctx, HostChainId, validator, false)
+	s.Require().ErrorIs(err, types.ErrValidatorBlacklisted)
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v33/utils"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+// Iterates each active host zone with automatic validator weighting enabled and
+// recomputes the validator weights from the latest validator signals
+// Afterwards, validator queries are submitted to refresh the jailed status and commission
+// rate of each validator so they can be used in the next epoch
+// Queries are also submitted for host zones that blacklist jailed validators
+//
+// The new weights are picked up by the normal delegation, unbonding, and rebalance flows
+func (k Keeper) UpdateAllAutoValidatorWeights(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		blacklistsJailedValidators := hostZone.ValidatorBlacklistPolicy != nil && hostZone.ValidatorBlacklistPolicy.BlacklistJailed
+		if hostZone.AutoValidatorWeighting == nil && !blacklistsJailedValidators {
+			continue
+		}
+
+		if hostZone.AutoValidatorWeighting != nil {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Updating validator weights"))
+			err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.UpdateAutoValidatorWeightsForHostZone(ctx, hostZone.ChainId)
+			})
+			if err != nil {
+				k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId, "Unable to update validator weights: %s", err.Error()))
+			}
+		}
+
+		for _, validator := range hostZone.Validators {
+			if err := k.QueryValidatorSharesToTokensRate(ctx, hostZone.ChainId, validator.Address); err != nil {
+				k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId,
+					"Unable to submit validator query for %s: %s", validator.Address, err.Error()))
+			}
+		}
+	}
+}
+
+// Recomputes the weight of each validator on the host zone using the automatic weighting config
+// If the new weights would cause a validator to exceed the weight cap, an error is returned
+// and the caller is expected to discard the state changes
+func (k Keeper) UpdateAutoValidatorWeightsForHostZone(ctx sdk.Context, chainId string) error {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return types.ErrHostZoneNotFound.Wrapf("host zone %s not found", chainId)
+	}
+	if hostZone.AutoValidatorWeighting == nil {
+		return errorsmod.Wrapf(types.ErrInvalidHostZone, "automatic validator weighting is not enabled for %s", chainId)
+	}
+	config := *hostZone.AutoValidatorWeighting
+
+	// Calculate the new weights, and confirm at least one validator has a non-zero weight
+	newWeights := map[string]uint64{}
+	totalWeight := uint64(0)
+	for _, validator := range hostZone.Validators {
+		weight := GetAutoValidatorWeight(config, *validator)
+		if hostZone.IsValidatorBlacklisted(validator.Address) {
+			weight = 0
+		}
+		newWeights[validator.Address] = weight
+		totalWeight += weight
+	}
+	if totalWeight == 0 {
+		return errorsmod.Wrapf(types.ErrNoValidatorWeights, "all validators on %s would have zero weight", chainId)
+	}
+
+	// Update the weights on the host zone
+	for _, validator := range hostZone.Validators {
+		newWeight := newWeights[validator.Address]
+		if newWeight == validator.Weight {
+			continue
+		}
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId,
+			"Validator %s weight updated from %d to %d", validator.Address, validator.Weight, newWeight))
+		EmitValidatorWeightUpdateEvent(ctx, chainId, validator.Address, validator.Weight, newWeight)
+
+		validator.Weight = newWeight
+	}
+	k.SetHostZone(ctx, hostZone)
+
+	// Confirm none of the validators exceed the weight cap
+	return k.CheckValidatorWeightsBelowCap(ctx, chainId)
+}
+
+// Calculates a validator's weight from the automatic weighting config
+//
+// A validator's score starts at 1 and is reduced by its commission and each slash:
+//
+//	score = 1 - (commission * commissionPenalty) - (slashCount * slashPenalty)
+//
+// The weight is then the score multiplied by the base weight
+// Validators that are jailed, have a commission above the max, or whose shares to tokens
+// rate has drifted too far below 1 are assigned zero weight
+func GetAutoValidatorWeight(config types.AutoValidatorWeightingConfig, validator types.Validator) uint64 {
+	if validator.Jailed {
+		return 0
+	}
+
+	commissionRate := sdkmath.LegacyZeroDec()
+	if !validator.CommissionRate.IsNil() {
+		commissionRate = validator.CommissionRate
+	}
+	if commissionRate.GT(config.MaxCommissionRate) {
+		return 0
+	}
+
+	if !validator.SharesToTokensRate.IsNil() && validator.SharesToTokensRate.IsPositive() {
+		drift := sdkmath.LegacyOneDec().Sub(validator.SharesToTokensRate)
+		if drift.GT(config.MaxSharesToTokensRateDrift) {
+			return 0
+		}
+	}
+
+	slashCount := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(validator.SlashCount))
+	score := sdkmath.LegacyOneDec().
+		Sub(commissionRate.Mul(config.CommissionPenalty)).
+		Sub(slashCount.Mul(config.SlashPenalty))
+	if !score.IsPositive() {
+		return 0
+	}
+
+	return score.MulInt64(utils.UintToInt(config.BaseWeight)).TruncateInt().Uint64()
+}
+
+// Stores the jailed status and commission rate from a validator query response
+// These are used as inputs to automatic validator weighting
+// Note: This modifies the original host zone struct
+func (k Keeper) UpdateValidatorStatusFromQuery(ctx sdk.Context, hostZone types.HostZone, queriedValidator stakingtypes.Validator) error {
+	validator, valIndex, found := GetValidatorFromAddress(hostZone.Validators, queriedValidator.OperatorAddress)
+	if !found {
+		return errorsmod.Wrapf(types.ErrValidatorNotFound, "no registered validator for address (%s)", queriedValidator.OperatorAddress)
+	}
+
+	validator.Jailed = queriedValidator.Jailed
+	validator.CommissionRate = queriedValidator.Commission.Rate
+	hostZone.Validators[valIndex] = &validator
+	k.SetHostZone(ctx, hostZone)
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) getDefaultAutoValidatorWeightingConfig() types.AutoValidatorWeightingConfig {
+	return types.AutoValidatorWeightingConfig{
+		BaseWeight:                 100,
+		MaxCommissionRate:          sdkmath.LegacyMustNewDecFromStr("0.10"),
+		CommissionPenalty:          sdkmath.LegacyMustNewDecFromStr("2.0"),
+		SlashPenalty:               sdkmath.LegacyMustNewDecFromStr("0.25"),
+		MaxSharesToTokensRateDrift: sdkmath.LegacyMustNewDecFromStr("0.05"),
+	}
+}
+
+func (s *KeeperTestSuite) TestGetAutoValidatorWeight() {
+	config := s.getDefaultAutoValidatorWeightingConfig()
+
+	testCases := []struct {
+		name           string
+		validator      types.Validator
+		expectedWeight uint64
+	}{
+		{
+			name:           "no commission or slashes",
+			validator:      types.Validator{CommissionRate: sdkmath.LegacyZeroDec(), SharesToTokensRate: sdkmath.LegacyOneDec()},
+			expectedWeight: 100,
+		},
+		{
+			name:           "commission rate not yet queried",
+			validator:      types.Validator{SharesToTokensRate: sdkmath.LegacyOneDec()},
+			expectedWeight: 100,
+		},
+		{
+			name:           "shares to tokens rate not yet queried",
+			validator:      types.Validator{CommissionRate: sdkmath.LegacyZeroDec()},
+			expectedWeight: 100,
+		},
+		{
+			// 1 - (0.05 * 2) = 0.9
+			name:           "with commission",
+			validator:      types.Validator{CommissionRate: sdkmath.LegacyMustNewDecFromStr("0.05")},
+			expectedWeight: 90,
+		},
+		{
+			// 1 - (0.05 * 2) - (1 * 0.25) = 0.65
+			name: "with commission and slash",
+			validator: types.Validator{
+				CommissionRate: sdkmath.LegacyMustNewDecFromStr("0.05"),
+				SlashCount:     1,
+			},
+			expectedWeight: 65,
+		},
+		{
+			// 1 - (4 * 0.25) = 0
+			name:           "slashed too many times",
+			validator:      types.Validator{CommissionRate: sdkmath.LegacyZeroDec(), SlashCount: 4},
+			expectedWeight: 0,
+		},
+		{
+			name:           "commission at max",
+			validator:      types.Validator{CommissionRate: sdkmath.LegacyMustNewDecFromStr("0.10")},
+			expectedWeight: 80,
+		},
+		{
+			name:           "commission above max",
+			validator:      types.Validator{CommissionRate: sdkmath.LegacyMustNewDecFromStr("0.11")},
+			expectedWeight: 0,
+		},
+		{
+			name: "jailed",
+			validator: types.Validator{
+				CommissionRate: sdkmath.LegacyZeroDec(),
+				Jailed:         true,
+			},
+			expectedWeight: 0,
+		},
+		{
+			name: "shares to tokens rate drift at max",
+			validator: types.Validator{
+				CommissionRate:     sdkmath.LegacyZeroDec(),
+				SharesToTokensRate: sdkmath.LegacyMustNewDecFromStr("0.95"),
+			},
+			expectedWeight: 100,
+		},
+		{
+			name: "shares to tokens rate drift above max",
+			validator: types.Validator{
+				CommissionRate:     sdkmath.LegacyZeroDec(),
+				SharesToTokensRate: sdkmath.LegacyMustNewDecFromStr("0.94"),
+			},
+			expectedWeight: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			actualWeight := keeper.GetAutoValidatorWeight(config, tc.validator)
+			s.Require().Equal(tc.expectedWeight, actualWeight, "weight")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateAutoValidatorWeightsForHostZone() {
+	config := s.getDefaultAutoValidatorWeightingConfig()
+
+	hostZone := types.HostZone{
+		ChainId:                HostChainId,
+		AutoValidatorWeighting: &config,
+		Validators: []*types.Validator{
+			{Address: "val1", Weight: 10, CommissionRate: sdkmath.LegacyZeroDec()},
+			{Address: "val2", Weight: 10, CommissionRate: sdkmath.LegacyMustNewDecFromStr("0.05")},
+			{Address: "val3", Weight: 10, CommissionRate: sdkmath.LegacyMustNewDecFromStr("0.20")},
+			{Address: "val4", Weight: 10, CommissionRate: sdkmath.LegacyZeroDec(), Jailed: true},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.UpdateAutoValidatorWeightsForHostZone(s.Ctx, HostChainId)
+	s.Require().NoError(err, "no error expected when updating weights")
+
+	expectedWeights := map[string]uint64{"val1": 100, "val2": 90, "val3": 0, "val4": 0}
+	for _, validator := range s.MustGetHostZone(HostChainId).Validators {
+		s.Require().Equal(expectedWeights[validator.Address], validator.Weight, "weight for %s", validator.Address)
+	}
+
+	// If every validator would have zero weight, it should error
+	for _, validator := range hostZone.Validators {
+		validator.Jailed = true
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err = s.App.StakeibcKeeper.UpdateAutoValidatorWeightsForHostZone(s.Ctx, HostChainId)
+	s.Require().ErrorIs(err, types.ErrNoValidatorWeights)
+
+	// If the host zone does not have weighting enabled, it should error
+	hostZone.AutoValidatorWeighting = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err = s.App.StakeibcKeeper.UpdateAutoValidatorWeightsForHostZone(s.Ctx, HostChainId)
+	s.Require().ErrorContains(err, "automatic validator weighting is not enabled")
+
+	// Finally, it should error if the host zone is not found
+	err = s.App.StakeibcKeeper.UpdateAutoValidatorWeightsForHostZone(s.Ctx, "fake-chain")
+	s.Require().ErrorContains(err, "host zone fake-chain not found")
+}
+
+func (s *KeeperTestSuite) TestSetAutoValidatorWeighting() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:    HostChainId,
+		Validators: []*types.Validator{{Address: "val1", Weight: 10}},
+	})
+
+	// Enable automatic weighting
+	config := s.getDefaultAutoValidatorWeightingConfig()
+	msg := types.MsgSetAutoValidatorWeighting{
+		Authority: Authority,
+		ChainId:   HostChainId,
+		Config:    &config,
+	}
+	_, err := s.GetMsgServer().SetAutoValidatorWeighting(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when enabling weighting")
+
+	hostZone := s.MustGetHostZone(HostChainId)
+	s.Require().NotNil(hostZone.AutoValidatorWeighting, "weighting config should be set")
+	s.Require().Equal(config, *hostZone.AutoValidatorWeighting, "weighting config")
+
+	// Manual weight changes should now be rejected
+	_, err = s.GetMsgServer().ChangeValidatorWeight(s.Ctx, &types.MsgChangeValidatorWeights{
+		HostZone:         HostChainId,
+		ValidatorWeights: []*types.ValidatorWeight{{Address: "val1", Weight: 20}},
+	})
+	s.Require().ErrorIs(err, types.ErrAutoValidatorWeightingEnabled)
+
+	// Disable automatic weighting, manual weight changes should succeed again
+	msg.Config = nil
+	_, err = s.GetMsgServer().SetAutoValidatorWeighting(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when disabling weighting")
+	s.Require().Nil(s.MustGetHostZone(HostChainId).AutoValidatorWeighting, "weighting config should be removed")
+
+	_, err = s.GetMsgServer().ChangeValidatorWeight(s.Ctx, &types.MsgChangeValidatorWeights{
+		HostZone:         HostChainId,
+		ValidatorWeights: []*types.ValidatorWeight{{Address: "val1", Weight: 20}},
+	})
+	s.Require().NoError(err, "no error expected when changing weight manually")
+
+	// Invalid host zone
+	msg.ChainId = "fake-chain"
+	_, err = s.GetMsgServer().SetAutoValidatorWeighting(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "host zone fake-chain not found")
+
+	// Invalid authority
+	msg.ChainId = HostChainId
+	msg.Authority = "not-gov-address"
+	_, err = s.GetMsgServer().SetAutoValidatorWeighting(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
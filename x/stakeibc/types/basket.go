@@ -0,0 +1,83 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const BasketDenomPrefix = "stbasket/"
+
+var basketIdRegex = regexp.MustCompile(`^[a-z][a-z0-9]{2,31}$`)
+
+// Returns the denom of a basket token from the basket ID
+func BasketDenomFromId(basketId string) string {
+	return BasketDenomPrefix + basketId
+}
+
+// Generates the module account that holds the stTokens backing a basket
+func NewBasketReserveAddress(basketId string) sdk.AccAddress {
+	key := append([]byte("basket"), []byte(basketId)...)
+	return address.Module(ModuleName, key)
+}
+
+// Validates a basket ID, which must be lowercase alphanumeric and start with a letter
+func ValidateBasketId(basketId string) error {
+	if !basketIdRegex.MatchString(basketId) {
+		return fmt.Errorf("invalid basket id %s, must be 3-32 lowercase alphanumeric characters starting with a letter", basketId)
+	}
+	return nil
+}
+
+// Validates the basket components, confirming there are at least two unique
+// host zones, each with a positive weight
+func ValidateBasketComponents(components []BasketComponent) error {
+	if len(components) < 2 {
+		return errors.New("basket must have at least two components")
+	}
+	hostZones := map[string]bool{}
+	for _, component := range components {
+		if component.HostZoneId == "" {
+			return errors.New("basket component host zone cannot be empty")
+		}
+		if hostZones[component.HostZoneId] {
+			return fmt.Errorf("duplicate basket component %s", component.HostZoneId)
+		}
+		hostZones[component.HostZoneId] = true
+
+		if component.Weight.IsNil() || !component.Weight.IsPositive() {
+			return fmt.Errorf("weight for basket component %s must be positive", component.HostZoneId)
+		}
+	}
+	return nil
+}
+
+// Validates the basket ID, denom, reserve address, and components
+func (b Basket) Validate() error {
+	if err := ValidateBasketId(b.Id); err != nil {
+		return err
+	}
+	if b.Denom != BasketDenomFromId(b.Id) {
+		return fmt.Errorf("invalid denom %s for basket %s", b.Denom, b.Id)
+	}
+	if b.ReserveAddress != NewBasketReserveAddress(b.Id).String() {
+		return fmt.Errorf("invalid reserve address %s for basket %s", b.ReserveAddress, b.Id)
+	}
+	return ValidateBasketComponents(b.Components)
+}
+
+// Returns the number of stTokens for a component that back the given number of basket tokens
+// When minting, the amount is rounded up so that each basket token is fully backed,
+// and when redeeming, it's rounded down so that the reserve is never overdrawn
+func (c BasketComponent) StTokenAmount(basketAmount sdkmath.Int, roundUp bool) sdkmath.Int {
+	stAmount := c.Weight.MulInt(basketAmount)
+	if roundUp {
+		return stAmount.Ceil().TruncateInt()
+	}
+	return stAmount.TruncateInt()
+}
@@ -0,0 +1,723 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/basket.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A single host zone in a basket
+type BasketComponent struct {
+	// Chain ID of the host zone whose stToken backs the basket
+	HostZoneId string `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	// Number of stTokens from this host zone backing each basket token
+	// The relative value of each component is what determines the basket's
+	// target weights
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *BasketComponent) Reset()         { *m = BasketComponent{} }
+func (m *BasketComponent) String() string { return proto.CompactTextString(m) }
+func (*BasketComponent) ProtoMessage()    {}
+func (*BasketComponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a40fe2c7cd0ec3cf, []int{0}
+}
+func (m *BasketComponent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasketComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasketComponent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BasketComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasketComponent.Merge(m, src)
+}
+func (m *BasketComponent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasketComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasketComponent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasketComponent proto.InternalMessageInfo
+
+func (m *BasketComponent) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+// A basket of stTokens across multiple host zones, represented as a single
+// fungible basket token
+// Each basket token is backed by a fixed quantity of each component's stToken,
+// which is held in the basket's reserve account
+type Basket struct {
+	// Unique identifier for the basket, used to build the basket denom
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Denom of the basket token (stbasket/{id})
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Module account that holds the stTokens backing the basket
+	ReserveAddress string            `protobuf:"bytes,3,opt,name=reserve_address,json=reserveAddress,proto3" json:"reserve_address,omitempty"`
+	Components     []BasketComponent `protobuf:"bytes,4,rep,name=components,proto3" json:"components"`
+}
+
+func (m *Basket) Reset()         { *m = Basket{} }
+func (m *Basket) String() string { return proto.CompactTextString(m) }
+func (*Basket) ProtoMessage()    {}
+func (*Basket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a40fe2c7cd0ec3cf, []int{1}
+}
+func (m *Basket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Basket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Basket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Basket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Basket.Merge(m, src)
+}
+func (m *Basket) XXX_Size() int {
+	return m.Size()
+}
+func (m *Basket) XXX_DiscardUnknown() {
+	xxx_messageInfo_Basket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Basket proto.InternalMessageInfo
+
+func (m *Basket) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Basket) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Basket) GetReserveAddress() string {
+	if m != nil {
+		return m.ReserveAddress
+	}
+	return ""
+}
+
+func (m *Basket) GetComponents() []BasketComponent {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BasketComponent)(nil), "stride.stakeibc.BasketComponent")
+	proto.RegisterType((*Basket)(nil), "stride.stakeibc.Basket")
+}
+
+func init() { proto.RegisterFile("stride/stakeibc/basket.proto", fileDescriptor_a40fe2c7cd0ec3cf) }
+
+var fileDescriptor_a40fe2c7cd0ec3cf = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x4d, 0xd4, 0x27, 0xbc, 0x79, 0x0f, 0x85, 0xe0, 0x22, 0xb5, 0x25, 0x06, 0x57, 0x6e, 0x4c,
+	0xa8, 0x7e, 0x81, 0xa9, 0x14, 0x04, 0x57, 0x71, 0xe7, 0x26, 0x24, 0x99, 0x4b, 0x32, 0x48, 0x72,
+	0x25, 0x33, 0xb5, 0xb5, 0x8b, 0x7e, 0x43, 0x3f, 0xc6, 0x4d, 0xff, 0xc0, 0xa5, 0xb8, 0x2a, 0x5d,
+	0x48, 0xd1, 0x1f, 0x29, 0xc9, 0xc4, 0xb6, 0xb8, 0x9b, 0x7b, 0xce, 0xdc, 0x7b, 0xce, 0xbd, 0x87,
+	0xdc, 0x70, 0x91, 0x31, 0x0a, 0x36, 0x17, 0xfe, 0x02, 0x58, 0x10, 0xda, 0x81, 0xcf, 0x17, 0x20,
+	0xac, 0x65, 0x86, 0x02, 0xb5, 0xa6, 0x64, 0xad, 0x33, 0xdb, 0xbe, 0x0a, 0x91, 0x27, 0xc8, 0xbd,
+	0x82, 0xb6, 0x65, 0x21, 0xff, 0xb6, 0x5b, 0x11, 0x46, 0x28, 0xf1, 0xfc, 0x25, 0xd1, 0xee, 0x0b,
+	0x69, 0x3a, 0xc5, 0xc4, 0x3b, 0x4c, 0x96, 0x98, 0x42, 0x2a, 0x34, 0x93, 0xfc, 0x8f, 0x91, 0x0b,
+	0xef, 0x19, 0x53, 0xf0, 0x18, 0xd5, 0x55, 0x53, 0xed, 0xfd, 0x75, 0x49, 0x8e, 0xcd, 0x31, 0x85,
+	0x09, 0xd5, 0x26, 0xa4, 0xfe, 0x08, 0x2c, 0x8a, 0x85, 0x5e, 0xc9, 0x39, 0xe7, 0x76, 0x7b, 0xe8,
+	0x28, 0x1f, 0x87, 0xce, 0xb5, 0x14, 0xe4, 0x74, 0x61, 0x31, 0xb4, 0x13, 0x5f, 0xc4, 0xd6, 0x14,
+	0x22, 0x3f, 0x5c, 0x8f, 0x21, 0xdc, 0x6f, 0xfa, 0xa4, 0xf4, 0x33, 0x86, 0xd0, 0x2d, 0x07, 0x74,
+	0xdf, 0x54, 0x52, 0x97, 0x06, 0xb4, 0x06, 0xa9, 0x7c, 0xab, 0x55, 0x18, 0xd5, 0x5a, 0xe4, 0x0f,
+	0x85, 0x14, 0x13, 0x29, 0xe2, 0xca, 0x42, 0x1b, 0x91, 0x66, 0x06, 0x1c, 0xb2, 0x15, 0x78, 0x3e,
+	0xa5, 0x19, 0x70, 0xae, 0x57, 0x0b, 0x13, 0xfa, 0x7e, 0xd3, 0x6f, 0x95, 0x0a, 0x23, 0xc9, 0xcc,
+	0x44, 0xc6, 0xd2, 0xc8, 0x6d, 0x94, 0x0d, 0x25, 0xaa, 0xdd, 0x13, 0x12, 0x9e, 0xb7, 0xe5, 0x7a,
+	0xcd, 0xac, 0xf6, 0xfe, 0x0d, 0x4c, 0xeb, 0xe2, 0x94, 0xd6, 0xc5, 0x59, 0x9c, 0x5a, 0xbe, 0xa4,
+	0xfb, 0xab, 0xd3, 0x99, 0x6e, 0x8f, 0x86, 0xba, 0x3b, 0x1a, 0xea, 0xe7, 0xd1, 0x50, 0x5f, 0x4f,
+	0x86, 0xb2, 0x3b, 0x19, 0xca, 0xfb, 0xc9, 0x50, 0xe6, 0x83, 0x88, 0x89, 0xf8, 0x21, 0xb0, 0x42,
+	0x4c, 0xec, 0x59, 0x31, 0xb7, 0x3f, 0xf5, 0x03, 0x6e, 0x97, 0x61, 0xae, 0x86, 0x43, 0xfb, 0xe9,
+	0x27, 0x52, 0xb1, 0x5e, 0x02, 0x0f, 0xea, 0x45, 0x20, 0xc3, 0xaf, 0x01, 0x00, 0x55, 0xce, 0x6f,
+	0xd2, 0xf2, 0x01, 0x00, 0x00,
+}
+
+func (m *BasketComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasketComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasketComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBasket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintBasket(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Basket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Basket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Basket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBasket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ReserveAddress) > 0 {
+		i -= len(m.ReserveAddress)
+		copy(dAtA[i:], m.ReserveAddress)
+		i = encodeVarintBasket(dAtA, i, uint64(len(m.ReserveAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBasket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBasket(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBasket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBasket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BasketComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovBasket(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovBasket(uint64(l))
+	return n
+}
+
+func (m *Basket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBasket(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBasket(uint64(l))
+	}
+	l = len(m.ReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovBasket(uint64(l))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovBasket(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBasket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBasket(x uint64) (n int) {
+	return sovBasket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BasketComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBasket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasketComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasketComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBasket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Basket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBasket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Basket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Basket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, BasketComponent{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBasket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBasket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBasket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBasket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBasket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBasket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBasket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBasket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBasket = fmt.Errorf("proto: unexpected end of group")
+)
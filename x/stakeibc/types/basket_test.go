@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestValidateBasketId(t *testing.T) {
+	require.NoError(t, types.ValidateBasketId("index"), "valid id")
+	require.NoError(t, types.ValidateBasketId("top5"), "valid id with number")
+
+	require.Error(t, types.ValidateBasketId(""), "empty id")
+	require.Error(t, types.ValidateBasketId("ab"), "id too short")
+	require.Error(t, types.ValidateBasketId("1index"), "id starting with number")
+	require.Error(t, types.ValidateBasketId("Index"), "id with uppercase")
+	require.Error(t, types.ValidateBasketId("my/index"), "id with slash")
+}
+
+func TestValidateBasketComponents(t *testing.T) {
+	one := sdkmath.LegacyOneDec()
+
+	testCases := []struct {
+		name          string
+		components    []types.BasketComponent
+		expectedError string
+	}{
+		{
+			name: "valid components",
+			components: []types.BasketComponent{
+				{HostZoneId: "GAIA", Weight: one},
+				{HostZoneId: "OSMO", Weight: sdkmath.LegacyMustNewDecFromStr("0.5")},
+			},
+		},
+		{
+			name:          "single component",
+			components:    []types.BasketComponent{{HostZoneId: "GAIA", Weight: one}},
+			expectedError: "basket must have at least two components",
+		},
+		{
+			name: "empty host zone",
+			components: []types.BasketComponent{
+				{HostZoneId: "GAIA", Weight: one},
+				{HostZoneId: "", Weight: one},
+			},
+			expectedError: "basket component host zone cannot be empty",
+		},
+		{
+			name: "duplicate host zone",
+			components: []types.BasketComponent{
+				{HostZoneId: "GAIA", Weight: one},
+				{HostZoneId: "GAIA", Weight: one},
+			},
+			expectedError: "duplicate basket component GAIA",
+		},
+		{
+			name: "zero weight",
+			components: []types.BasketComponent{
+				{HostZoneId: "GAIA", Weight: one},
+				{HostZoneId: "OSMO", Weight: sdkmath.LegacyZeroDec()},
+			},
+			expectedError: "weight for basket component OSMO must be positive",
+		},
+		{
+			name: "nil weight",
+			components: []types.BasketComponent{
+				{HostZoneId: "GAIA", Weight: one},
+				{HostZoneId: "OSMO"},
+			},
+			expectedError: "weight for basket component OSMO must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateBasketComponents(tc.components)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestBasketComponentStTokenAmount(t *testing.T) {
+	component := types.BasketComponent{HostZoneId: "GAIA", Weight: sdkmath.LegacyMustNewDecFromStr("0.5")}
+
+	require.Equal(t, int64(500), component.StTokenAmount(sdkmath.NewInt(1000), true).Int64(), "round up, no remainder")
+	require.Equal(t, int64(500), component.StTokenAmount(sdkmath.NewInt(1000), false).Int64(), "round down, no remainder")
+	require.Equal(t, int64(2), component.StTokenAmount(sdkmath.NewInt(3), true).Int64(), "round up, remainder")
+	require.Equal(t, int64(1), component.StTokenAmount(sdkmath.NewInt(3), false).Int64(), "round down, remainder")
+}
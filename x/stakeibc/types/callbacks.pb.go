@@ -795,6 +795,59 @@ func (m *TradeRouteCallback) GetHostDenom() string {
 	return ""
 }
 
+type IcaReconciliationCallback struct {
+	IcaType ICAAccountType `protobuf:"varint,1,opt,name=ica_type,json=icaType,proto3,enum=stride.stakeibc.ICAAccountType" json:"ica_type,omitempty"`
+	// Day epoch of the report that the query belongs to
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *IcaReconciliationCallback) Reset()         { *m = IcaReconciliationCallback{} }
+func (m *IcaReconciliationCallback) String() string { return proto.CompactTextString(m) }
+func (*IcaReconciliationCallback) ProtoMessage()    {}
+func (*IcaReconciliationCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41c99b09b96a5ac, []int{15}
+}
+func (m *IcaReconciliationCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaReconciliationCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaReconciliationCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaReconciliationCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaReconciliationCallback.Merge(m, src)
+}
+func (m *IcaReconciliationCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaReconciliationCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaReconciliationCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaReconciliationCallback proto.InternalMessageInfo
+
+func (m *IcaReconciliationCallback) GetIcaType() ICAAccountType {
+	if m != nil {
+		return m.IcaType
+	}
+	return ICAAccountType_DELEGATION
+}
+
+func (m *IcaReconciliationCallback) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SplitDelegation)(nil), "stride.stakeibc.SplitDelegation")
 	proto.RegisterType((*SplitUndelegation)(nil), "stride.stakeibc.SplitUndelegation")
@@ -811,74 +864,76 @@ func init() {
 	proto.RegisterType((*DelegatorSharesQueryCallback)(nil), "stride.stakeibc.DelegatorSharesQueryCallback")
 	proto.RegisterType((*CommunityPoolBalanceQueryCallback)(nil), "stride.stakeibc.CommunityPoolBalanceQueryCallback")
 	proto.RegisterType((*TradeRouteCallback)(nil), "stride.stakeibc.TradeRouteCallback")
+	proto.RegisterType((*IcaReconciliationCallback)(nil), "stride.stakeibc.IcaReconciliationCallback")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/callbacks.proto", fileDescriptor_f41c99b09b96a5ac) }
 
 var fileDescriptor_f41c99b09b96a5ac = []byte{
-	// 982 bytes of a gzipped FileDescriptorProto
+	// 1003 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0xad, 0x34, 0xb6, 0xc7, 0xff, 0x4c, 0xda, 0xca, 0x82, 0x22, 0xd9, 0x4c, 0x81, 0x06,
+	0x10, 0x36, 0xad, 0x34, 0xb6, 0xc7, 0xff, 0x4c, 0xda, 0xca, 0x86, 0x23, 0xd9, 0x4c, 0x81, 0x06,
 	0x05, 0x42, 0x22, 0x36, 0x5a, 0xf4, 0xe7, 0x52, 0x5b, 0x3e, 0x54, 0x80, 0x5c, 0xb4, 0x94, 0x9d,
-	0x43, 0x2e, 0xc4, 0x92, 0xdc, 0x4a, 0x0b, 0x91, 0xbb, 0x0a, 0x77, 0xa9, 0xd4, 0xb9, 0xb4, 0xc7,
+	0x43, 0x2e, 0xc4, 0x92, 0xdc, 0x4a, 0x0b, 0x91, 0xbb, 0x0a, 0x77, 0xa9, 0xd4, 0xbe, 0xb4, 0xc7,
 	0x1c, 0xfb, 0x1a, 0xed, 0xa5, 0x4f, 0xd0, 0xbb, 0x8f, 0x39, 0x16, 0x3d, 0xa4, 0x85, 0xfd, 0x22,
-	0xc5, 0xfe, 0x90, 0xa2, 0xe4, 0x34, 0xa9, 0x91, 0x93, 0xc8, 0xd9, 0x6f, 0x76, 0xbe, 0x99, 0x6f,
-	0x66, 0x44, 0x68, 0x73, 0x91, 0x91, 0x18, 0x7b, 0x5c, 0xa0, 0x11, 0x26, 0x61, 0xe4, 0x45, 0x28,
-	0x49, 0x42, 0x14, 0x8d, 0xb8, 0x3b, 0xce, 0x98, 0x60, 0xf6, 0xa6, 0x06, 0xb8, 0x05, 0xa0, 0xd1,
-	0x8a, 0x18, 0x4f, 0x19, 0xf7, 0x42, 0xc4, 0xb1, 0x37, 0x79, 0x14, 0x62, 0x81, 0x1e, 0x79, 0x11,
-	0x23, 0x54, 0x3b, 0x34, 0xee, 0x0e, 0xd8, 0x80, 0xa9, 0x47, 0x4f, 0x3e, 0x19, 0x6b, 0xd3, 0xc4,
-	0xc9, 0x70, 0xc4, 0xb2, 0x98, 0x17, 0xbf, 0xe6, 0xf4, 0x1a, 0x8b, 0x21, 0xe3, 0x22, 0x78, 0xce,
-	0x28, 0x36, 0x80, 0xbd, 0x79, 0x00, 0x89, 0x50, 0x80, 0xa2, 0x88, 0xe5, 0x54, 0xfc, 0xd7, 0x1d,
-	0x13, 0x94, 0x90, 0x18, 0x09, 0x96, 0x69, 0x80, 0xf3, 0x03, 0x6c, 0xf6, 0xc7, 0x09, 0x11, 0xc7,
-	0x38, 0xc1, 0x03, 0x24, 0x08, 0xa3, 0x76, 0x13, 0x56, 0x4a, 0x54, 0xdd, 0xda, 0xb5, 0x1e, 0xac,
-	0xf8, 0x53, 0x83, 0xfd, 0x29, 0xdc, 0x46, 0xa9, 0x8c, 0x50, 0x5f, 0x94, 0x47, 0x47, 0xf7, 0x2e,
-	0x5e, 0xb5, 0x17, 0xfe, 0x7a, 0xd5, 0x7e, 0x5f, 0x57, 0x80, 0xc7, 0x23, 0x97, 0x30, 0x2f, 0x45,
-	0x62, 0xe8, 0x76, 0xa9, 0xf0, 0x0d, 0xd8, 0xf9, 0xd9, 0x82, 0x6d, 0x15, 0xe8, 0x8c, 0xc6, 0xff,
-	0x37, 0xd4, 0x09, 0xdc, 0xa1, 0x48, 0x90, 0x09, 0x0e, 0x04, 0x1b, 0x61, 0x1a, 0xdc, 0x24, 0xee,
-	0xb6, 0xf6, 0x3c, 0x95, 0x8e, 0x87, 0x9a, 0xc2, 0xef, 0x16, 0x6c, 0x99, 0x34, 0x71, 0xc7, 0x08,
-	0x6a, 0xef, 0xc2, 0x5a, 0x59, 0xd6, 0x80, 0xc4, 0x86, 0x04, 0x48, 0xdb, 0x13, 0x46, 0x71, 0x37,
-	0xb6, 0x3f, 0x81, 0xed, 0x18, 0x8f, 0x19, 0x27, 0x22, 0xd0, 0xfa, 0x48, 0x98, 0xe4, 0x70, 0xcb,
-	0xdf, 0x34, 0x07, 0xbe, 0xb2, 0x77, 0x63, 0xfb, 0x04, 0xb6, 0xb9, 0x4c, 0x32, 0x98, 0xe6, 0xc8,
-	0xeb, 0xb5, 0xdd, 0xda, 0x83, 0xd5, 0xfd, 0x5d, 0x77, 0xae, 0x67, 0xdc, 0xb9, 0xba, 0xfb, 0x5b,
-	0x7c, 0xd6, 0xc0, 0x9d, 0x17, 0x16, 0xac, 0x77, 0x12, 0x44, 0xd2, 0x92, 0xee, 0x17, 0xb0, 0x93,
-	0x73, 0x9c, 0x05, 0x19, 0x8e, 0x71, 0x3a, 0x96, 0xa8, 0x0a, 0x29, 0xcd, 0xfd, 0x03, 0x09, 0xf0,
-	0xcb, 0xf3, 0x92, 0xdb, 0x0e, 0x2c, 0x47, 0x43, 0x44, 0x68, 0x41, 0x7f, 0xc5, 0x5f, 0x52, 0xef,
-	0xdd, 0xd8, 0xde, 0x83, 0x35, 0x3c, 0x66, 0xd1, 0x30, 0xa0, 0x79, 0x1a, 0xe2, 0xac, 0x5e, 0x53,
-	0xd9, 0xad, 0x2a, 0xdb, 0xb7, 0xca, 0xe4, 0xfc, 0x6a, 0xc1, 0x96, 0x8f, 0x09, 0x9d, 0x60, 0x2e,
-	0x4a, 0x36, 0x1c, 0x36, 0x33, 0x63, 0x2b, 0xc4, 0x91, 0x1c, 0x56, 0xf7, 0x77, 0x5c, 0xad, 0x8a,
-	0x2b, 0xe7, 0xc1, 0x35, 0xf3, 0xe0, 0x76, 0x18, 0xa1, 0x47, 0x9e, 0xd4, 0xed, 0xb7, 0xbf, 0xdb,
-	0x1f, 0x0f, 0x88, 0x18, 0xe6, 0xa1, 0x1b, 0xb1, 0xd4, 0x33, 0xc3, 0xa3, 0x7f, 0x1e, 0xf2, 0x78,
-	0xe4, 0x89, 0xf3, 0x31, 0xe6, 0xca, 0xc1, 0xdf, 0x28, 0x42, 0x68, 0x19, 0xaf, 0x29, 0x56, 0x9b,
-	0x57, 0xcc, 0xb9, 0xb0, 0xc0, 0x2e, 0xdb, 0xec, 0x26, 0x52, 0xf7, 0xe1, 0x8e, 0x96, 0x2f, 0xa7,
-	0x55, 0x01, 0x17, 0x95, 0x80, 0xce, 0xeb, 0x05, 0xac, 0xf6, 0xb3, 0x6f, 0xf3, 0x79, 0x13, 0xb7,
-	0xbf, 0x82, 0x86, 0x2e, 0x6e, 0x4e, 0x43, 0x46, 0x63, 0x42, 0x07, 0x53, 0xc9, 0x74, 0x73, 0xdc,
-	0xf2, 0x3f, 0x54, 0x88, 0xb3, 0x02, 0x50, 0x68, 0xc6, 0x1d, 0x0e, 0xf6, 0x54, 0xca, 0x1b, 0x64,
-	0xf2, 0xe6, 0xa0, 0x8b, 0x6f, 0x0e, 0xfa, 0xc2, 0x82, 0x55, 0x1f, 0x87, 0x28, 0x41, 0x34, 0x22,
-	0x74, 0x60, 0xdf, 0x87, 0x75, 0x9e, 0x45, 0xc1, 0xfc, 0xa4, 0xae, 0xf1, 0x2c, 0x7a, 0x5c, 0x0e,
-	0xeb, 0x7d, 0x58, 0x8f, 0xb9, 0xa8, 0x80, 0x74, 0x8f, 0xad, 0xc5, 0x5c, 0x4c, 0x41, 0x1e, 0xd4,
-	0x50, 0x2a, 0xb4, 0x64, 0x6f, 0x9b, 0x60, 0x89, 0x74, 0x9e, 0xc1, 0x76, 0xc1, 0xe4, 0x26, 0x42,
-	0x7e, 0x0d, 0x6b, 0xd9, 0x34, 0x81, 0x42, 0xc1, 0xe6, 0x35, 0x05, 0x2b, 0x59, 0xfa, 0x33, 0x1e,
-	0xce, 0x19, 0xd4, 0x8f, 0xb1, 0x5a, 0x3b, 0xe4, 0x39, 0xee, 0x0f, 0x51, 0x86, 0x79, 0x65, 0x08,
-	0x97, 0xcc, 0xe0, 0x9b, 0x76, 0x6f, 0x17, 0x17, 0x17, 0x0b, 0xbc, 0xd7, 0x3f, 0x51, 0x9b, 0xe7,
-	0xd8, 0xec, 0x87, 0x02, 0xef, 0xfc, 0x61, 0xc1, 0x46, 0xaf, 0x7f, 0xd2, 0x23, 0x4f, 0x73, 0x12,
-	0xf7, 0x25, 0x8d, 0x77, 0xb8, 0xcd, 0xfe, 0x0c, 0x56, 0xca, 0x42, 0xa8, 0x7a, 0xcb, 0xc9, 0x9b,
-	0xcf, 0xf1, 0x1b, 0x53, 0x16, 0x7f, 0xb9, 0x28, 0x90, 0xfd, 0x79, 0x75, 0xed, 0xd6, 0x94, 0x5f,
-	0xe3, 0x9a, 0x5f, 0xa9, 0x5a, 0x65, 0x25, 0x3b, 0x4f, 0xe1, 0xa3, 0xd2, 0xae, 0xab, 0x72, 0xca,
-	0x14, 0x37, 0xfe, 0x7d, 0x8e, 0xb3, 0xf3, 0xb2, 0x44, 0x5d, 0xd8, 0x4a, 0x78, 0x1a, 0x24, 0x2a,
-	0xcf, 0x40, 0xdd, 0x39, 0x9f, 0x5d, 0x19, 0x68, 0xb6, 0x1e, 0xfe, 0x46, 0xc2, 0xd3, 0xca, 0xbb,
-	0xf3, 0x13, 0x34, 0xcd, 0x4e, 0x2c, 0x42, 0xce, 0x86, 0x0a, 0xa0, 0x49, 0x28, 0x11, 0x04, 0x25,
-	0xd3, 0xe6, 0xab, 0xec, 0x5f, 0xdd, 0x1d, 0x6f, 0x6b, 0xb6, 0x86, 0xb9, 0xa2, 0x4c, 0x6e, 0xba,
-	0x86, 0x9d, 0x1c, 0xf6, 0x3a, 0x2c, 0x4d, 0x73, 0x4a, 0xc4, 0xf9, 0x77, 0x8c, 0x25, 0x47, 0xba,
-	0x1d, 0x67, 0x59, 0x7c, 0x09, 0xcb, 0xf2, 0xdf, 0x57, 0xee, 0x2d, 0x15, 0x71, 0xe3, 0x35, 0x89,
-	0x76, 0x3b, 0x87, 0x87, 0xfa, 0xdf, 0xf9, 0xf4, 0x7c, 0x8c, 0xfd, 0x25, 0x12, 0x21, 0xf9, 0x60,
-	0xdf, 0x85, 0xf7, 0x62, 0x4c, 0x59, 0x6a, 0x46, 0x46, 0xbf, 0x38, 0x8f, 0xc1, 0x3e, 0xcd, 0x50,
-	0x8c, 0x7d, 0x96, 0x57, 0x96, 0xd8, 0x9e, 0xec, 0xec, 0x67, 0x28, 0x8b, 0x03, 0xed, 0xa2, 0x7b,
-	0x7f, 0x55, 0xdb, 0x8e, 0xa5, 0xc9, 0xbe, 0x07, 0x6a, 0x14, 0x82, 0xea, 0x9d, 0xaa, 0x4f, 0xd4,
-	0xf1, 0x51, 0xef, 0xe2, 0xb2, 0x65, 0xbd, 0xbc, 0x6c, 0x59, 0xff, 0x5c, 0xb6, 0xac, 0x5f, 0xae,
-	0x5a, 0x0b, 0x2f, 0xaf, 0x5a, 0x0b, 0x7f, 0x5e, 0xb5, 0x16, 0x9e, 0xec, 0x57, 0x56, 0x72, 0x5f,
-	0x71, 0x7f, 0xd8, 0x43, 0x21, 0xf7, 0xcc, 0x37, 0xc4, 0xe4, 0xe0, 0xc0, 0xfb, 0x71, 0xfa, 0x25,
-	0xa1, 0x56, 0x74, 0x78, 0x5b, 0x7d, 0x46, 0x1c, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xa3,
-	0xe5, 0xfc, 0x33, 0x09, 0x00, 0x00,
+	0xc5, 0xfe, 0x90, 0xa2, 0xa4, 0x24, 0xb0, 0x91, 0x13, 0xc9, 0xd9, 0x6f, 0x77, 0xbe, 0x99, 0x6f,
+	0x66, 0x96, 0xd0, 0xe4, 0x22, 0x23, 0x31, 0xf6, 0xb8, 0x40, 0x03, 0x4c, 0xc2, 0xc8, 0x8b, 0x50,
+	0x92, 0x84, 0x28, 0x1a, 0x70, 0x77, 0x98, 0x31, 0xc1, 0xec, 0x75, 0x0d, 0x70, 0x0b, 0xc0, 0x76,
+	0x23, 0x62, 0x3c, 0x65, 0xdc, 0x0b, 0x11, 0xc7, 0xde, 0xe8, 0x49, 0x88, 0x05, 0x7a, 0xe2, 0x45,
+	0x8c, 0x50, 0xbd, 0x61, 0xfb, 0x7e, 0x8f, 0xf5, 0x98, 0x7a, 0xf5, 0xe4, 0x9b, 0xb1, 0xee, 0x18,
+	0x3f, 0x19, 0x8e, 0x58, 0x16, 0xf3, 0xe2, 0x69, 0x56, 0x67, 0x58, 0xf4, 0x19, 0x17, 0xc1, 0x05,
+	0xa3, 0xd8, 0x00, 0xf6, 0xa6, 0x01, 0x24, 0x42, 0x01, 0x8a, 0x22, 0x96, 0x53, 0xf1, 0xb6, 0x33,
+	0x46, 0x28, 0x21, 0x31, 0x12, 0x2c, 0xd3, 0x00, 0xe7, 0x27, 0x58, 0xef, 0x0e, 0x13, 0x22, 0x8e,
+	0x71, 0x82, 0x7b, 0x48, 0x10, 0x46, 0xed, 0x1d, 0x58, 0x2a, 0x51, 0x75, 0x6b, 0xd7, 0x7a, 0xb4,
+	0xe4, 0x8f, 0x0d, 0xf6, 0xe7, 0x70, 0x17, 0xa5, 0xd2, 0x43, 0x7d, 0x5e, 0x2e, 0x1d, 0x3d, 0xb8,
+	0x7c, 0xdd, 0x9c, 0xfb, 0xe7, 0x75, 0xf3, 0x43, 0x9d, 0x01, 0x1e, 0x0f, 0x5c, 0xc2, 0xbc, 0x14,
+	0x89, 0xbe, 0xdb, 0xa6, 0xc2, 0x37, 0x60, 0xe7, 0x57, 0x0b, 0x36, 0x95, 0xa3, 0x33, 0x1a, 0xdf,
+	0xd4, 0xd5, 0x09, 0xdc, 0xa3, 0x48, 0x90, 0x11, 0x0e, 0x04, 0x1b, 0x60, 0x1a, 0xdc, 0xc6, 0xef,
+	0xa6, 0xde, 0x79, 0x2a, 0x37, 0x1e, 0x6a, 0x0a, 0x7f, 0x5a, 0xb0, 0x61, 0xc2, 0xc4, 0x2d, 0x23,
+	0xa8, 0xbd, 0x0b, 0x2b, 0x65, 0x5a, 0x03, 0x12, 0x1b, 0x12, 0x20, 0x6d, 0xcf, 0x18, 0xc5, 0xed,
+	0xd8, 0xfe, 0x0c, 0x36, 0x63, 0x3c, 0x64, 0x9c, 0x88, 0x40, 0xeb, 0x23, 0x61, 0x92, 0xc3, 0x1d,
+	0x7f, 0xdd, 0x2c, 0xf8, 0xca, 0xde, 0x8e, 0xed, 0x13, 0xd8, 0xe4, 0x32, 0xc8, 0x60, 0x1c, 0x23,
+	0xaf, 0xd7, 0x76, 0x6b, 0x8f, 0x96, 0xf7, 0x77, 0xdd, 0xa9, 0x9a, 0x71, 0xa7, 0xf2, 0xee, 0x6f,
+	0xf0, 0x49, 0x03, 0x77, 0x5e, 0x5a, 0xb0, 0xda, 0x4a, 0x10, 0x49, 0x4b, 0xba, 0x5f, 0xc1, 0x56,
+	0xce, 0x71, 0x16, 0x64, 0x38, 0xc6, 0xe9, 0x50, 0xa2, 0x2a, 0xa4, 0x34, 0xf7, 0x8f, 0x24, 0xc0,
+	0x2f, 0xd7, 0x4b, 0x6e, 0x5b, 0xb0, 0x18, 0xf5, 0x11, 0xa1, 0x05, 0xfd, 0x25, 0x7f, 0x41, 0x7d,
+	0xb7, 0x63, 0x7b, 0x0f, 0x56, 0xf0, 0x90, 0x45, 0xfd, 0x80, 0xe6, 0x69, 0x88, 0xb3, 0x7a, 0x4d,
+	0x45, 0xb7, 0xac, 0x6c, 0xdf, 0x2b, 0x93, 0xf3, 0xbb, 0x05, 0x1b, 0x3e, 0x26, 0x74, 0x84, 0xb9,
+	0x28, 0xd9, 0x70, 0x58, 0xcf, 0x8c, 0xad, 0x10, 0x47, 0x72, 0x58, 0xde, 0xdf, 0x72, 0xb5, 0x2a,
+	0xae, 0xec, 0x07, 0xd7, 0xf4, 0x83, 0xdb, 0x62, 0x84, 0x1e, 0x79, 0x52, 0xb7, 0x3f, 0xfe, 0x6d,
+	0x7e, 0xda, 0x23, 0xa2, 0x9f, 0x87, 0x6e, 0xc4, 0x52, 0xcf, 0x34, 0x8f, 0x7e, 0x3c, 0xe6, 0xf1,
+	0xc0, 0x13, 0xe7, 0x43, 0xcc, 0xd5, 0x06, 0x7f, 0xad, 0x70, 0xa1, 0x65, 0x9c, 0x51, 0xac, 0x36,
+	0xad, 0x98, 0x73, 0x69, 0x81, 0x5d, 0x96, 0xd9, 0x6d, 0xa4, 0xee, 0xc2, 0x3d, 0x2d, 0x5f, 0x4e,
+	0xab, 0x02, 0xce, 0x2b, 0x01, 0x9d, 0x37, 0x0b, 0x58, 0xad, 0x67, 0xdf, 0xe6, 0xd3, 0x26, 0x6e,
+	0x7f, 0x03, 0xdb, 0x3a, 0xb9, 0x39, 0x0d, 0x19, 0x8d, 0x09, 0xed, 0x8d, 0x25, 0xd3, 0xc5, 0x71,
+	0xc7, 0xff, 0x58, 0x21, 0xce, 0x0a, 0x40, 0xa1, 0x19, 0x77, 0x38, 0xd8, 0x63, 0x29, 0x6f, 0x11,
+	0xc9, 0xbb, 0x9d, 0xce, 0xbf, 0xdb, 0xe9, 0x4b, 0x0b, 0x96, 0x7d, 0x1c, 0xa2, 0x04, 0xd1, 0x88,
+	0xd0, 0x9e, 0xfd, 0x10, 0x56, 0x79, 0x16, 0x05, 0xd3, 0x9d, 0xba, 0xc2, 0xb3, 0xe8, 0x69, 0xd9,
+	0xac, 0x0f, 0x61, 0x35, 0xe6, 0xa2, 0x02, 0xd2, 0x35, 0xb6, 0x12, 0x73, 0x31, 0x06, 0x79, 0x50,
+	0x43, 0xa9, 0xa8, 0xd7, 0x6e, 0xd2, 0xc1, 0x12, 0xe9, 0xbc, 0x80, 0xcd, 0x82, 0xc9, 0x6d, 0x84,
+	0xfc, 0x16, 0x56, 0xb2, 0x71, 0x00, 0x85, 0x82, 0x3b, 0x33, 0x0a, 0x56, 0xa2, 0xf4, 0x27, 0x76,
+	0x38, 0x67, 0x50, 0x3f, 0xc6, 0x6a, 0xec, 0x90, 0x0b, 0xdc, 0xed, 0xa3, 0x0c, 0xf3, 0x4a, 0x13,
+	0x2e, 0x98, 0xc6, 0x37, 0xe5, 0xde, 0x2c, 0x0e, 0x2e, 0x06, 0x78, 0xa7, 0x7b, 0xa2, 0x26, 0xcf,
+	0xb1, 0x99, 0x0f, 0x05, 0xde, 0xf9, 0xcb, 0x82, 0xb5, 0x4e, 0xf7, 0xa4, 0x43, 0x9e, 0xe7, 0x24,
+	0xee, 0x4a, 0x1a, 0xef, 0x71, 0x9a, 0xfd, 0x05, 0x2c, 0x95, 0x89, 0xa8, 0xcf, 0x9b, 0xce, 0x9b,
+	0x8e, 0xf1, 0x3b, 0x93, 0x16, 0x7f, 0xb1, 0x48, 0x90, 0xfd, 0x65, 0x75, 0xec, 0xd6, 0xd4, 0xbe,
+	0xed, 0x99, 0x7d, 0xa5, 0x6a, 0x95, 0x91, 0xec, 0x3c, 0x87, 0x4f, 0x4a, 0xbb, 0xce, 0xca, 0x29,
+	0x53, 0xdc, 0xf8, 0x8f, 0x39, 0xce, 0xce, 0xcb, 0x14, 0xb5, 0x61, 0x23, 0xe1, 0x69, 0x90, 0xa8,
+	0x38, 0x03, 0x75, 0xe6, 0x74, 0x74, 0xa5, 0xa3, 0xc9, 0x7c, 0xf8, 0x6b, 0x09, 0x4f, 0x2b, 0xdf,
+	0xce, 0x2f, 0xb0, 0x63, 0x66, 0x62, 0xe1, 0x72, 0xd2, 0x55, 0x00, 0x3b, 0x84, 0x12, 0x41, 0x50,
+	0x32, 0x2e, 0xbe, 0xca, 0xfc, 0xad, 0x5b, 0x37, 0x29, 0xb6, 0x6d, 0x73, 0x44, 0x19, 0xdc, 0x78,
+	0x0c, 0x3b, 0x39, 0xec, 0xb5, 0x58, 0x9a, 0xe6, 0x94, 0x88, 0xf3, 0x1f, 0x18, 0x4b, 0x8e, 0x74,
+	0x39, 0x4e, 0xb2, 0xf8, 0x1a, 0x16, 0xe5, 0xed, 0x2b, 0xe7, 0x96, 0xf2, 0xb8, 0xf6, 0x86, 0x40,
+	0xdb, 0xad, 0xc3, 0x43, 0x7d, 0x3b, 0x9f, 0x9e, 0x0f, 0xb1, 0xbf, 0x40, 0x22, 0x24, 0x5f, 0xec,
+	0xfb, 0xf0, 0x41, 0x8c, 0x29, 0x4b, 0x4d, 0xcb, 0xe8, 0x0f, 0xe7, 0x29, 0xd8, 0xa7, 0x19, 0x8a,
+	0xb1, 0xcf, 0xf2, 0xca, 0x10, 0xdb, 0x93, 0x95, 0xfd, 0x02, 0x65, 0x71, 0xa0, 0xb7, 0xe8, 0xda,
+	0x5f, 0xd6, 0xb6, 0x63, 0x69, 0xb2, 0x1f, 0x80, 0x6a, 0x85, 0xa0, 0x7a, 0xa6, 0xaa, 0x13, 0xb5,
+	0xec, 0x5c, 0xc0, 0x56, 0x3b, 0x42, 0xb2, 0xdb, 0x69, 0x44, 0x12, 0x82, 0x26, 0x26, 0xcb, 0xfb,
+	0x84, 0x31, 0x7d, 0x8b, 0xcc, 0xcf, 0xdc, 0x22, 0x47, 0x9d, 0xcb, 0xab, 0x86, 0xf5, 0xea, 0xaa,
+	0x61, 0xfd, 0x77, 0xd5, 0xb0, 0x7e, 0xbb, 0x6e, 0xcc, 0xbd, 0xba, 0x6e, 0xcc, 0xfd, 0x7d, 0xdd,
+	0x98, 0x7b, 0xb6, 0x5f, 0xb9, 0x0e, 0xba, 0xca, 0xe1, 0xe3, 0x0e, 0x0a, 0xb9, 0x67, 0xfe, 0x5f,
+	0x46, 0x07, 0x07, 0xde, 0xcf, 0xe3, 0xbf, 0x18, 0x75, 0x3d, 0x84, 0x77, 0xd5, 0x2f, 0xcc, 0xc1,
+	0xff, 0x03, 0x00, 0x54, 0x37, 0x39, 0x3a, 0xaf, 0x09, 0x00, 0x00,
 }
 
 func (m *SplitDelegation) Marshal() (dAtA []byte, err error) {
@@ -1527,6 +1582,39 @@ func (m *TradeRouteCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IcaReconciliationCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaReconciliationCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaReconciliationCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IcaType != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.IcaType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCallbacks(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCallbacks(v)
 	base := offset
@@ -1800,6 +1888,21 @@ func (m *TradeRouteCallback) Size() (n int) {
 	return n
 }
 
+func (m *IcaReconciliationCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IcaType != 0 {
+		n += 1 + sovCallbacks(uint64(m.IcaType))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovCallbacks(uint64(m.EpochNumber))
+	}
+	return n
+}
+
 func sovCallbacks(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3664,6 +3767,94 @@ func (m *TradeRouteCallback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IcaReconciliationCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcaReconciliationCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcaReconciliationCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaType", wireType)
+			}
+			m.IcaType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IcaType |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCallbacks(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
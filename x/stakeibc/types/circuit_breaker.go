@@ -0,0 +1,44 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Liquid staking modules whose actions can be paused by the circuit breaker
+// The multisig modules are referenced by name since they depend on stakeibc
+var CircuitBreakerModules = []string{ModuleName, "staketia", "stakedym"}
+
+// Validates the module, host zone, and actions targeted by a circuit breaker message
+func ValidateCircuitBreakerScope(module, chainId string, actions []CircuitBreakerAction) error {
+	validModule := false
+	for _, circuitBreakerModule := range CircuitBreakerModules {
+		if module == circuitBreakerModule {
+			validModule = true
+			break
+		}
+	}
+	if !validModule {
+		return fmt.Errorf("invalid circuit breaker module %s, must be one of %v", module, CircuitBreakerModules)
+	}
+
+	if chainId == "" {
+		return errors.New("chain ID must be specified")
+	}
+
+	if len(actions) == 0 {
+		return errors.New("at least one action must be specified")
+	}
+	seenActions := map[CircuitBreakerAction]bool{}
+	for _, action := range actions {
+		if _, ok := CircuitBreakerAction_name[int32(action)]; !ok || action == CIRCUIT_BREAKER_UNSPECIFIED {
+			return fmt.Errorf("invalid circuit breaker action %d", action)
+		}
+		if seenActions[action] {
+			return fmt.Errorf("duplicate circuit breaker action %s", action)
+		}
+		seenActions[action] = true
+	}
+
+	return nil
+}
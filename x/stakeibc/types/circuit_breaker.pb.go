@@ -0,0 +1,854 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/circuit_breaker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// An action that can be paused by the circuit breaker
+type CircuitBreakerAction int32
+
+const (
+	CIRCUIT_BREAKER_UNSPECIFIED CircuitBreakerAction = 0
+	// User liquid stakes (including fee liquid stakes in the multisig modules)
+	PAUSE_LIQUID_STAKE CircuitBreakerAction = 1
+	// User LSM liquid stakes
+	PAUSE_LSM_LIQUID_STAKE CircuitBreakerAction = 2
+	// User redemptions (standard and instant)
+	PAUSE_REDEEM CircuitBreakerAction = 3
+	// Claims of finished redemptions
+	PAUSE_CLAIM CircuitBreakerAction = 4
+	// Epochly delegations to the host zone
+	PAUSE_DELEGATE_ICA CircuitBreakerAction = 5
+	// Epochly undelegations from the host zone
+	PAUSE_UNDELEGATE_ICA CircuitBreakerAction = 6
+	// Reinvestment of staking rewards and fees
+	PAUSE_REWARD_REINVEST CircuitBreakerAction = 7
+)
+
+var CircuitBreakerAction_name = map[int32]string{
+	0: "CIRCUIT_BREAKER_UNSPECIFIED",
+	1: "PAUSE_LIQUID_STAKE",
+	2: "PAUSE_LSM_LIQUID_STAKE",
+	3: "PAUSE_REDEEM",
+	4: "PAUSE_CLAIM",
+	5: "PAUSE_DELEGATE_ICA",
+	6: "PAUSE_UNDELEGATE_ICA",
+	7: "PAUSE_REWARD_REINVEST",
+}
+
+var CircuitBreakerAction_value = map[string]int32{
+	"CIRCUIT_BREAKER_UNSPECIFIED": 0,
+	"PAUSE_LIQUID_STAKE":          1,
+	"PAUSE_LSM_LIQUID_STAKE":      2,
+	"PAUSE_REDEEM":                3,
+	"PAUSE_CLAIM":                 4,
+	"PAUSE_DELEGATE_ICA":          5,
+	"PAUSE_UNDELEGATE_ICA":        6,
+	"PAUSE_REWARD_REINVEST":       7,
+}
+
+func (x CircuitBreakerAction) String() string {
+	return proto.EnumName(CircuitBreakerAction_name, int32(x))
+}
+
+func (CircuitBreakerAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d1ecece6e54101ae, []int{0}
+}
+
+// The account that can trip and reset circuit breakers without a governance
+// vote, until its expiration
+type CircuitBreakerGuardian struct {
+	Address    string    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Expiration time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *CircuitBreakerGuardian) Reset()         { *m = CircuitBreakerGuardian{} }
+func (m *CircuitBreakerGuardian) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerGuardian) ProtoMessage()    {}
+func (*CircuitBreakerGuardian) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1ecece6e54101ae, []int{0}
+}
+func (m *CircuitBreakerGuardian) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerGuardian) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerGuardian.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerGuardian) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerGuardian.Merge(m, src)
+}
+func (m *CircuitBreakerGuardian) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerGuardian) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerGuardian.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerGuardian proto.InternalMessageInfo
+
+func (m *CircuitBreakerGuardian) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CircuitBreakerGuardian) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// A paused action for a host zone in one of the liquid staking modules
+type CircuitBreakerFlag struct {
+	// Module that the pause applies to (stakeibc, staketia, or stakedym)
+	Module  string               `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	ChainId string               `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Action  CircuitBreakerAction `protobuf:"varint,3,opt,name=action,proto3,enum=stride.stakeibc.CircuitBreakerAction" json:"action,omitempty"`
+	// Address that tripped the circuit breaker
+	TrippedBy string    `protobuf:"bytes,4,opt,name=tripped_by,json=trippedBy,proto3" json:"tripped_by,omitempty"`
+	TrippedAt time.Time `protobuf:"bytes,5,opt,name=tripped_at,json=trippedAt,proto3,stdtime" json:"tripped_at"`
+	// Indicates the flag was tripped by governance, in which case only
+	// governance can reset it
+	GovernanceOverride bool `protobuf:"varint,6,opt,name=governance_override,json=governanceOverride,proto3" json:"governance_override,omitempty"`
+}
+
+func (m *CircuitBreakerFlag) Reset()         { *m = CircuitBreakerFlag{} }
+func (m *CircuitBreakerFlag) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerFlag) ProtoMessage()    {}
+func (*CircuitBreakerFlag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1ecece6e54101ae, []int{1}
+}
+func (m *CircuitBreakerFlag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerFlag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerFlag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerFlag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerFlag.Merge(m, src)
+}
+func (m *CircuitBreakerFlag) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerFlag) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerFlag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerFlag proto.InternalMessageInfo
+
+func (m *CircuitBreakerFlag) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *CircuitBreakerFlag) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *CircuitBreakerFlag) GetAction() CircuitBreakerAction {
+	if m != nil {
+		return m.Action
+	}
+	return CIRCUIT_BREAKER_UNSPECIFIED
+}
+
+func (m *CircuitBreakerFlag) GetTrippedBy() string {
+	if m != nil {
+		return m.TrippedBy
+	}
+	return ""
+}
+
+func (m *CircuitBreakerFlag) GetTrippedAt() time.Time {
+	if m != nil {
+		return m.TrippedAt
+	}
+	return time.Time{}
+}
+
+func (m *CircuitBreakerFlag) GetGovernanceOverride() bool {
+	if m != nil {
+		return m.GovernanceOverride
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("stride.stakeibc.CircuitBreakerAction", CircuitBreakerAction_name, CircuitBreakerAction_value)
+	proto.RegisterType((*CircuitBreakerGuardian)(nil), "stride.stakeibc.CircuitBreakerGuardian")
+	proto.RegisterType((*CircuitBreakerFlag)(nil), "stride.stakeibc.CircuitBreakerFlag")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/circuit_breaker.proto", fileDescriptor_d1ecece6e54101ae)
+}
+
+var fileDescriptor_d1ecece6e54101ae = []byte{
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcb, 0x6e, 0xda, 0x40,
+	0x14, 0x86, 0xed, 0x84, 0x70, 0x99, 0x54, 0x8d, 0x35, 0xa5, 0xc8, 0xa1, 0xaa, 0x41, 0x91, 0x22,
+	0xa1, 0x4a, 0xb5, 0x25, 0x58, 0x77, 0x61, 0xec, 0x49, 0x64, 0x05, 0x68, 0x3a, 0x86, 0x56, 0xea,
+	0xc6, 0x1a, 0xdb, 0x53, 0x67, 0x14, 0xc0, 0x96, 0x3d, 0x44, 0xf0, 0x06, 0x5d, 0x66, 0xdf, 0x65,
+	0x5f, 0x26, 0xcb, 0x2c, 0xbb, 0xea, 0x05, 0x5e, 0xa4, 0xc2, 0x17, 0x51, 0xaa, 0x6e, 0xba, 0x9b,
+	0x73, 0xfe, 0x7f, 0xfe, 0xef, 0xd8, 0xa3, 0x03, 0xce, 0x13, 0x1e, 0x33, 0x9f, 0x6a, 0x09, 0x27,
+	0xb7, 0x94, 0xb9, 0x9e, 0xe6, 0xb1, 0xd8, 0x5b, 0x30, 0xee, 0xb8, 0x31, 0x25, 0xb7, 0x34, 0x56,
+	0xa3, 0x38, 0xe4, 0x21, 0x3c, 0xc9, 0x6c, 0x6a, 0x61, 0x6b, 0xd6, 0x83, 0x30, 0x08, 0x53, 0x4d,
+	0xdb, 0x9e, 0x32, 0x5b, 0xb3, 0x15, 0x84, 0x61, 0x30, 0xa5, 0x5a, 0x5a, 0xb9, 0x8b, 0x4f, 0x1a,
+	0x67, 0x33, 0x9a, 0x70, 0x32, 0x8b, 0x32, 0xc3, 0xd9, 0x12, 0x34, 0x8c, 0x0c, 0xd0, 0xcf, 0xf2,
+	0x2f, 0x17, 0x24, 0xf6, 0x19, 0x99, 0x43, 0x19, 0x54, 0x88, 0xef, 0xc7, 0x34, 0x49, 0x64, 0xb1,
+	0x2d, 0x76, 0x6a, 0xb8, 0x28, 0xa1, 0x09, 0x00, 0x5d, 0x46, 0x2c, 0x26, 0x9c, 0x85, 0x73, 0xf9,
+	0xa0, 0x2d, 0x76, 0x8e, 0xbb, 0x4d, 0x35, 0x23, 0xa9, 0x05, 0x49, 0x1d, 0x17, 0xa4, 0x7e, 0xf5,
+	0xe1, 0x7b, 0x4b, 0xb8, 0xff, 0xd1, 0x12, 0xf1, 0x1f, 0xf7, 0xce, 0xbe, 0x1c, 0x00, 0xb8, 0x8f,
+	0xbe, 0x98, 0x92, 0x00, 0x36, 0x40, 0x79, 0x16, 0xfa, 0x8b, 0x29, 0xcd, 0xa9, 0x79, 0x05, 0x4f,
+	0x41, 0xd5, 0xbb, 0x21, 0x6c, 0xee, 0x30, 0x3f, 0x45, 0xd6, 0x70, 0x25, 0xad, 0x2d, 0x1f, 0xbe,
+	0x01, 0x65, 0xe2, 0xa5, 0xb3, 0x1c, 0xb6, 0xc5, 0xce, 0xd3, 0xee, 0xb9, 0xfa, 0xd7, 0xcf, 0x51,
+	0xf7, 0x39, 0x7a, 0x6a, 0xc6, 0xf9, 0x25, 0xf8, 0x12, 0x00, 0x1e, 0xb3, 0x28, 0xa2, 0xbe, 0xe3,
+	0xae, 0xe4, 0x52, 0x9a, 0x5d, 0xcb, 0x3b, 0xfd, 0x15, 0x34, 0x76, 0x32, 0xe1, 0xf2, 0xd1, 0x7f,
+	0x7c, 0x6d, 0x11, 0xa2, 0x73, 0xa8, 0x81, 0x67, 0x41, 0x78, 0x47, 0xe3, 0x39, 0x99, 0x7b, 0xd4,
+	0xd9, 0x9e, 0xb6, 0x03, 0xca, 0xe5, 0xb6, 0xd8, 0xa9, 0x62, 0xb8, 0x93, 0xde, 0xe6, 0xca, 0xab,
+	0x5f, 0x22, 0xa8, 0xff, 0x6b, 0x6a, 0xd8, 0x02, 0x2f, 0x0c, 0x0b, 0x1b, 0x13, 0x6b, 0xec, 0xf4,
+	0x31, 0xd2, 0xaf, 0x10, 0x76, 0x26, 0x23, 0xfb, 0x1a, 0x19, 0xd6, 0x85, 0x85, 0x4c, 0x49, 0x80,
+	0x0d, 0x00, 0xaf, 0xf5, 0x89, 0x8d, 0x9c, 0x81, 0xf5, 0x6e, 0x62, 0x99, 0x8e, 0x3d, 0xd6, 0xaf,
+	0x90, 0x24, 0xc2, 0x26, 0x68, 0xe4, 0x7d, 0x7b, 0xb8, 0xaf, 0x1d, 0x40, 0x09, 0x3c, 0xc9, 0x34,
+	0x8c, 0x4c, 0x84, 0x86, 0xd2, 0x21, 0x3c, 0x01, 0xc7, 0x59, 0xc7, 0x18, 0xe8, 0xd6, 0x50, 0x2a,
+	0xed, 0x62, 0x4d, 0x34, 0x40, 0x97, 0xfa, 0x18, 0x39, 0x96, 0xa1, 0x4b, 0x47, 0x50, 0x06, 0xf5,
+	0xac, 0x3f, 0x19, 0xed, 0x29, 0x65, 0x78, 0x0a, 0x9e, 0x17, 0xa1, 0x1f, 0x74, 0x6c, 0x3a, 0x18,
+	0x59, 0xa3, 0xf7, 0xc8, 0x1e, 0x4b, 0x95, 0x66, 0xe9, 0xf3, 0x57, 0x45, 0xe8, 0x0f, 0x1e, 0xd6,
+	0x8a, 0xf8, 0xb8, 0x56, 0xc4, 0x9f, 0x6b, 0x45, 0xbc, 0xdf, 0x28, 0xc2, 0xe3, 0x46, 0x11, 0xbe,
+	0x6d, 0x14, 0xe1, 0x63, 0x37, 0x60, 0xfc, 0x66, 0xe1, 0xaa, 0x5e, 0x38, 0xd3, 0xec, 0xf4, 0x2d,
+	0x5f, 0x0f, 0x88, 0x9b, 0x68, 0xf9, 0x6e, 0xdc, 0xf5, 0x7a, 0xda, 0x72, 0xb7, 0x21, 0x7c, 0x15,
+	0xd1, 0xc4, 0x2d, 0xa7, 0x6f, 0xd1, 0xfb, 0x3d, 0x00, 0xe8, 0x53, 0xa3, 0xbe, 0x41, 0x03, 0x00,
+	0x00,
+}
+
+func (m *CircuitBreakerGuardian) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerGuardian) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerGuardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCircuitBreaker(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerFlag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerFlag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GovernanceOverride {
+		i--
+		if m.GovernanceOverride {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TrippedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TrippedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCircuitBreaker(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TrippedBy) > 0 {
+		i -= len(m.TrippedBy)
+		copy(dAtA[i:], m.TrippedBy)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.TrippedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Action != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CircuitBreakerGuardian) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	return n
+}
+
+func (m *CircuitBreakerFlag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.Action))
+	}
+	l = len(m.TrippedBy)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TrippedAt)
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	if m.GovernanceOverride {
+		n += 2
+	}
+	return n
+}
+
+func sovCircuitBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitBreaker(x uint64) (n int) {
+	return sovCircuitBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CircuitBreakerGuardian) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerGuardian: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerGuardian: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerFlag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerFlag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= CircuitBreakerAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrippedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TrippedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceOverride", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GovernanceOverride = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitBreaker = fmt.Errorf("proto: unexpected end of group")
+)
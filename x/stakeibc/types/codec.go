@@ -16,6 +16,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterHostZone{}, "stakeibc/MsgRegisterHostZone")
 	legacy.RegisterAminoMsg(cdc, &MsgRedeemStake{}, "stakeibc/MsgRedeemStake")
 	legacy.RegisterAminoMsg(cdc, &MsgClaimUndelegatedTokens{}, "stakeibc/MsgClaimUndelegatedTokens")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferRedemptionRecord{}, "stakeibc/MsgTransferRedemptionRecord")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelRedemption{}, "stakeibc/MsgCancelRedemption")
+	legacy.RegisterAminoMsg(cdc, &MsgTokenizeRedemptionRecord{}, "stakeibc/MsgTokenizeRedemptionRecord")
+	legacy.RegisterAminoMsg(cdc, &MsgDetokenizeRedemptionRecord{}, "stakeibc/MsgDetokenizeRedemptionRecord")
+	legacy.RegisterAminoMsg(cdc, &MsgLiquidStakeBasket{}, "stakeibc/MsgLiquidStakeBasket")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemBasket{}, "stakeibc/MsgRedeemBasket")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateBasket{}, "stakeibc/MsgCreateBasket")
 	legacy.RegisterAminoMsg(cdc, &MsgRebalanceValidators{}, "stakeibc/MsgRebalanceValidators")
 	legacy.RegisterAminoMsg(cdc, &MsgAddValidators{}, "stakeibc/MsgAddValidators")
 	legacy.RegisterAminoMsg(cdc, &MsgChangeValidatorWeights{}, "stakeibc/MsgChangeValidatorWeights")
@@ -35,6 +42,19 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgToggleTradeController{}, "stakeibc/MsgToggleTradeController")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateHostZoneParams{}, "stakeibc/MsgUpdateHostZoneParams")
 	legacy.RegisterAminoMsg(cdc, &MsgDeprecateHostZone{}, "stakeibc/MsgDeprecateHostZone")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoValidatorWeighting{}, "stakeibc/MsgSetAutoValidatorWeighting")
+	legacy.RegisterAminoMsg(cdc, &MsgInstantRedeemStake{}, "stakeibc/MsgInstantRedeemStake")
+	legacy.RegisterAminoMsg(cdc, &MsgSetInstantRedemptionConfig{}, "stakeibc/MsgSetInstantRedemptionConfig")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorBlacklistPolicy{}, "stakeibc/MsgSetValidatorBlacklistPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveBlacklistedValidator{}, "stakeibc/MsgRemoveBlacklistedValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgSetRedemptionRateGuardConfig{}, "stakeibc/MsgSetRedemptionRateGuardConfig")
+	legacy.RegisterAminoMsg(cdc, &MsgSetCircuitBreakerGuardian{}, "stakeibc/MsgSetCircuitBreakerGuardian")
+	legacy.RegisterAminoMsg(cdc, &MsgTripCircuitBreaker{}, "stakeibc/MsgTripCircuitBreaker")
+	legacy.RegisterAminoMsg(cdc, &MsgResetCircuitBreaker{}, "stakeibc/MsgResetCircuitBreaker")
+	legacy.RegisterAminoMsg(cdc, &MsgSetInsuranceFundConfig{}, "stakeibc/MsgSetInsuranceFundConfig")
+	legacy.RegisterAminoMsg(cdc, &MsgSetReconciliationThreshold{}, "stakeibc/MsgSetReconciliationThreshold")
+	legacy.RegisterAminoMsg(cdc, &MsgOnboardHostZone{}, "stakeibc/MsgOnboardHostZone")
+	legacy.RegisterAminoMsg(cdc, &MsgSetUnbondCap{}, "stakeibc/MsgSetUnbondCap")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -44,6 +64,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRegisterHostZone{},
 		&MsgRedeemStake{},
 		&MsgClaimUndelegatedTokens{},
+		&MsgTransferRedemptionRecord{},
+		&MsgCancelRedemption{},
+		&MsgTokenizeRedemptionRecord{},
+		&MsgDetokenizeRedemptionRecord{},
+		&MsgLiquidStakeBasket{},
+		&MsgRedeemBasket{},
+		&MsgCreateBasket{},
 		&MsgRebalanceValidators{},
 		&MsgAddValidators{},
 		&MsgChangeValidatorWeights{},
@@ -58,6 +85,19 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgToggleTradeController{},
 		&MsgUpdateHostZoneParams{},
 		&MsgDeprecateHostZone{},
+		&MsgSetAutoValidatorWeighting{},
+		&MsgInstantRedeemStake{},
+		&MsgSetInstantRedemptionConfig{},
+		&MsgSetValidatorBlacklistPolicy{},
+		&MsgRemoveBlacklistedValidator{},
+		&MsgSetRedemptionRateGuardConfig{},
+		&MsgSetCircuitBreakerGuardian{},
+		&MsgTripCircuitBreaker{},
+		&MsgResetCircuitBreaker{},
+		&MsgSetInsuranceFundConfig{},
+		&MsgSetReconciliationThreshold{},
+		&MsgOnboardHostZone{},
+		&MsgSetUnbondCap{},
 	)
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
@@ -70,4 +70,23 @@ var (
 	ErrInvalidDelegationsInProgress        = errorsmod.Register(ModuleName, 1563, "invalid delegation changes in progress")
 	ErrInvalidUndelegationsInProgress      = errorsmod.Register(ModuleName, 1564, "invalid undelegation changes in progress")
 	ErrRedemptionsDisabled                 = errorsmod.Register(ModuleName, 1565, "redemptions disabled")
+	ErrAutoValidatorWeightingEnabled       = errorsmod.Register(ModuleName, 1566, "automatic validator weighting is enabled")
+	ErrInstantRedemptionsDisabled          = errorsmod.Register(ModuleName, 1567, "instant redemptions are disabled")
+	ErrInsufficientInstantRedemptionBuffer = errorsmod.Register(ModuleName, 1568, "insufficient liquidity in instant redemption buffer")
+	ErrInstantRedemptionBelowMinimum       = errorsmod.Register(ModuleName, 1569, "instant redemption amount below minimum")
+	ErrRedemptionRecordNotOwned            = errorsmod.Register(ModuleName, 1570, "redemption record not owned by sender")
+	ErrBasketNotFound                      = errorsmod.Register(ModuleName, 1571, "basket not found")
+	ErrBasketAlreadyExists                 = errorsmod.Register(ModuleName, 1572, "basket already exists")
+	ErrInvalidBasket                       = errorsmod.Register(ModuleName, 1573, "invalid basket")
+	ErrValidatorBlacklisted                = errorsmod.Register(ModuleName, 1574, "validator is blacklisted")
+	ErrHostZoneDegraded                    = errorsmod.Register(ModuleName, 1575, "host zone is degraded")
+	ErrRedemptionRateGuardCooldown         = errorsmod.Register(ModuleName, 1576, "redemption rate guard cooldown has not elapsed")
+	ErrCircuitBreakerTripped               = errorsmod.Register(ModuleName, 1577, "action paused by circuit breaker")
+	ErrInvalidCircuitBreakerSigner         = errorsmod.Register(ModuleName, 1578, "invalid circuit breaker signer")
+	ErrCircuitBreakerGuardianExpired       = errorsmod.Register(ModuleName, 1579, "circuit breaker guardian has expired")
+	ErrInvalidValidatorPreference          = errorsmod.Register(ModuleName, 1580, "invalid validator preference")
+	ErrUnsupportedTradeVenue               = errorsmod.Register(ModuleName, 1581, "unsupported trade venue")
+	ErrFailedToOnboardHostZone             = errorsmod.Register(ModuleName, 1582, "failed to onboard host zone")
+	ErrRedemptionNFTsDisabled              = errorsmod.Register(ModuleName, 1583, "redemption record nfts are disabled")
+	ErrRedemptionRecordTokenized           = errorsmod.Register(ModuleName, 1584, "redemption record is tokenized")
 )
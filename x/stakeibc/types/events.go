@@ -22,6 +22,25 @@ const (
 	EventTypeUndelegation                      = "undelegation"
 	EventTypeUndelegationFailed                = "undelegation_failed"
 	EventTypeRedemptionSweep                   = "redemption_sweep"
+	EventTypeValidatorWeightUpdate             = "validator_weight_update"
+	EventTypeInstantRedeemStakeRequest         = "instant_redeem_stake"
+	EventTypeInstantRedemptionBufferFunded     = "instant_redemption_buffer_funded"
+	EventTypeInstantRedemptionBufferRefilled   = "instant_redemption_buffer_refilled"
+	EventTypeRedemptionRecordTransfer          = "redemption_record_transfer"
+	EventTypeRedemptionCancelled               = "redemption_cancelled"
+	EventTypeRedemptionRecordTokenized         = "redemption_record_tokenized"
+	EventTypeRedemptionRecordDetokenized       = "redemption_record_detokenized"
+	EventTypeLiquidStakeBasketRequest          = "liquid_stake_basket"
+	EventTypeRedeemBasketRequest               = "redeem_basket"
+	EventTypeValidatorBlacklisted              = "validator_blacklisted"
+	EventTypeRedemptionRateGuardStatusChange   = "redemption_rate_guard_status_change"
+	EventTypeCircuitBreakerTripped             = "circuit_breaker_tripped"
+	EventTypeCircuitBreakerReset               = "circuit_breaker_reset"
+	EventTypeInsuranceFundSlashCovered         = "insurance_fund_slash_covered"
+	EventTypeIcaBalanceDiscrepancy             = "ica_balance_discrepancy"
+	EventTypeIcaRecoveryAttempt                = "ica_recovery_attempt"
+	EventTypeIcaRecovered                      = "ica_recovered"
+	EventTypeHostZoneOnboarded                 = "host_zone_onboarded"
 
 	AttributeKeyHostZone         = "host_zone"
 	AttributeKeyConnectionId     = "connection_id"
@@ -53,6 +72,37 @@ const (
 	AttributeKeySlashPercent               = "slash_percent"
 	AttributeKeySlashAmount                = "slash_amount"
 	AttributeKeyCurrentDelegation          = "current_delegation"
+	AttributeKeyPreviousWeight             = "previous_weight"
+	AttributeKeyCurrentWeight              = "current_weight"
+	AttributeKeyFeeAmount                  = "fee_amount"
+	AttributeKeyBufferBalance              = "buffer_balance"
+	AttributeKeyPreviousOwner              = "previous_owner"
+	AttributeKeyNewOwner                   = "new_owner"
+	AttributeKeyPreviousReceiver           = "previous_receiver"
+	AttributeKeyNewReceiver                = "new_receiver"
+	AttributeKeyEpochNumber                = "epoch_number"
+	AttributeKeyNftId                      = "nft_id"
+	AttributeKeyBasketId                   = "basket_id"
+	AttributeKeyBasketDenom                = "basket_denom"
+	AttributeKeyBasketAmount               = "basket_amount"
+	AttributeKeyNativeTokens               = "native_tokens"
+	AttributeKeyBlacklistReason            = "blacklist_reason"
+	AttributeKeyPreviousGuardStatus        = "previous_guard_status"
+	AttributeKeyNewGuardStatus             = "new_guard_status"
+	AttributeKeyGuardReason                = "guard_reason"
+	AttributeKeyCircuitBreakerModule       = "circuit_breaker_module"
+	AttributeKeyCircuitBreakerAction       = "circuit_breaker_action"
+	AttributeKeyCircuitBreakerSigner       = "circuit_breaker_signer"
+	AttributeKeyCoveredAmount              = "covered_amount"
+	AttributeKeyInsuranceFundBalance       = "insurance_fund_balance"
+	AttributeKeyAccount                    = "account"
+	AttributeKeyAddress                    = "address"
+	AttributeKeyExpectedBalance            = "expected_balance"
+	AttributeKeyActualBalance              = "actual_balance"
+	AttributeKeyDiscrepancy                = "discrepancy"
+	AttributeKeyIcaType                    = "ica_type"
+	AttributeKeyRecoveryAttempts           = "recovery_attempts"
+	AttributeKeyNextAttemptTime            = "next_attempt_time"
 
 	AttributeKeyError = "error"
 
@@ -6,6 +6,9 @@ import (
 	ratelimittypes "github.com/cosmos/ibc-go/v11/modules/apps/rate-limiting/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/Stride-Labs/stride/v33/x/auction/types"
+	icaoracletypes "github.com/Stride-Labs/stride/v33/x/icaoracle/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -43,6 +46,23 @@ type StakeIBCHooks interface {
 
 type ICAOracleKeeper interface {
 	QueueMetricUpdate(ctx sdk.Context, key, value, metricType, attributes string)
+	AddOracle(ctx sdk.Context, controllerConnectionId string) error
+	GetOracle(ctx sdk.Context, chainId string) (oracle icaoracletypes.Oracle, found bool)
+	IsOracleICAChannelOpen(ctx sdk.Context, oracle icaoracletypes.Oracle) bool
+}
+
+// Subset of the x/nft keeper used to represent user redemption records as NFTs
+// The app wires in an adapter around the x/nft keeper, which handles the class and NFT types
+type NFTKeeper interface {
+	HasClass(ctx context.Context, classId string) bool
+	SaveClass(ctx context.Context, classId, name, description string) error
+	Mint(ctx context.Context, classId, nftId string, receiver sdk.AccAddress) error
+	Burn(ctx context.Context, classId, nftId string) error
+	GetOwner(ctx context.Context, classId, nftId string) sdk.AccAddress
+}
+
+type AuctionKeeper interface {
+	GetAllAuctions(ctx sdk.Context) []auctiontypes.Auction
 }
 
 type RatelimitKeeper interface {
@@ -46,5 +46,112 @@ func (gs GenesisState) Validate() error {
 		epochTrackerIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated or invalid baskets
+	basketIds := make(map[string]struct{})
+	for _, basket := range gs.Baskets {
+		if _, ok := basketIds[basket.Id]; ok {
+			return fmt.Errorf("duplicated basket %s", basket.Id)
+		}
+		basketIds[basket.Id] = struct{}{}
+
+		if err := basket.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated redemption rate snapshots
+	snapshotKeys := make(map[string]struct{})
+	for _, snapshot := range gs.RedemptionRateHistory {
+		key := string(RedemptionRateHistoryKey(snapshot.ChainId, snapshot.EpochNumber))
+		if _, ok := snapshotKeys[key]; ok {
+			return fmt.Errorf("duplicated redemption rate snapshot for %s in epoch %d", snapshot.ChainId, snapshot.EpochNumber)
+		}
+		snapshotKeys[key] = struct{}{}
+	}
+
+	// Check for duplicated redemption rate guard records
+	guardRecordKeys := make(map[string]struct{})
+	for _, record := range gs.RedemptionRateGuardHistory {
+		key := string(RedemptionRateGuardHistoryKey(record.ChainId, record.Id))
+		if _, ok := guardRecordKeys[key]; ok {
+			return fmt.Errorf("duplicated redemption rate guard record %d for %s", record.Id, record.ChainId)
+		}
+		guardRecordKeys[key] = struct{}{}
+	}
+
+	// Check for duplicated in-flight redelegations
+	redelegationKeys := make(map[string]struct{})
+	for _, redelegation := range gs.InFlightRedelegations {
+		key := string(InFlightRedelegationKey(redelegation.ChainId, redelegation.SrcValidator, redelegation.DstValidator))
+		if _, ok := redelegationKeys[key]; ok {
+			return fmt.Errorf("duplicated in-flight redelegation from %s to %s on %s",
+				redelegation.SrcValidator, redelegation.DstValidator, redelegation.ChainId)
+		}
+		redelegationKeys[key] = struct{}{}
+	}
+
+	// Check for duplicated or invalid circuit breaker flags
+	circuitBreakerKeys := make(map[string]struct{})
+	for _, flag := range gs.CircuitBreakerFlags {
+		actions := []CircuitBreakerAction{flag.Action}
+		if err := ValidateCircuitBreakerScope(flag.Module, flag.ChainId, actions); err != nil {
+			return err
+		}
+
+		key := string(CircuitBreakerFlagKey(flag.Module, flag.ChainId, flag.Action))
+		if _, ok := circuitBreakerKeys[key]; ok {
+			return fmt.Errorf("duplicated circuit breaker flag %s for %s on %s", flag.Action, flag.Module, flag.ChainId)
+		}
+		circuitBreakerKeys[key] = struct{}{}
+	}
+
+	// Check for duplicated ICA gas estimates
+	icaGasEstimateKeys := make(map[string]struct{})
+	for _, estimate := range gs.IcaGasEstimates {
+		key := string(IcaGasEstimateKey(estimate.ChainId, estimate.MsgTypeUrl))
+		if _, ok := icaGasEstimateKeys[key]; ok {
+			return fmt.Errorf("duplicated ICA gas estimate for %s on %s", estimate.MsgTypeUrl, estimate.ChainId)
+		}
+		icaGasEstimateKeys[key] = struct{}{}
+	}
+
+	// Check for duplicated insurance coverage records
+	coverageRecordKeys := make(map[string]struct{})
+	for _, record := range gs.InsuranceCoverageHistory {
+		key := string(InsuranceCoverageHistoryKey(record.ChainId, record.Id))
+		if _, ok := coverageRecordKeys[key]; ok {
+			return fmt.Errorf("duplicated insurance coverage record %d for %s", record.Id, record.ChainId)
+		}
+		coverageRecordKeys[key] = struct{}{}
+	}
+
+	// Check for duplicated reconciliation reports
+	reconciliationReportChainIds := make(map[string]struct{})
+	for _, report := range gs.IcaReconciliationReports {
+		if _, ok := reconciliationReportChainIds[report.ChainId]; ok {
+			return fmt.Errorf("duplicated reconciliation report for %s", report.ChainId)
+		}
+		reconciliationReportChainIds[report.ChainId] = struct{}{}
+	}
+
+	// Check for duplicated ICA recovery states
+	icaRecoveryStateKeys := make(map[string]struct{})
+	for _, recoveryState := range gs.IcaRecoveryStates {
+		key := string(IcaRecoveryStateKey(recoveryState.ChainId, recoveryState.IcaType))
+		if _, ok := icaRecoveryStateKeys[key]; ok {
+			return fmt.Errorf("duplicated ICA recovery state for %s on %s", recoveryState.IcaType, recoveryState.ChainId)
+		}
+		icaRecoveryStateKeys[key] = struct{}{}
+	}
+
+	// Check for duplicated host zone onboardings
+	hostZoneOnboardingChainIds := make(map[string]struct{})
+	for _, onboarding := range gs.HostZoneOnboardings {
+		if _, ok := hostZoneOnboardingChainIds[onboarding.ChainId]; ok {
+			return fmt.Errorf("duplicated host zone onboarding for %s", onboarding.ChainId)
+		}
+		hostZoneOnboardingChainIds[onboarding.ChainId] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
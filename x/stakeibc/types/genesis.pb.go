@@ -25,11 +25,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the stakeibc module's genesis state.
 type GenesisState struct {
-	Params           Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId           string         `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	HostZoneList     []HostZone     `protobuf:"bytes,5,rep,name=host_zone_list,json=hostZoneList,proto3" json:"host_zone_list"`
-	EpochTrackerList []EpochTracker `protobuf:"bytes,10,rep,name=epoch_tracker_list,json=epochTrackerList,proto3" json:"epoch_tracker_list"`
-	TradeRoutes      []TradeRoute   `protobuf:"bytes,12,rep,name=trade_routes,json=tradeRoutes,proto3" json:"trade_routes"`
+	Params                     Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId                     string                      `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	HostZoneList               []HostZone                  `protobuf:"bytes,5,rep,name=host_zone_list,json=hostZoneList,proto3" json:"host_zone_list"`
+	EpochTrackerList           []EpochTracker              `protobuf:"bytes,10,rep,name=epoch_tracker_list,json=epochTrackerList,proto3" json:"epoch_tracker_list"`
+	TradeRoutes                []TradeRoute                `protobuf:"bytes,12,rep,name=trade_routes,json=tradeRoutes,proto3" json:"trade_routes"`
+	Baskets                    []Basket                    `protobuf:"bytes,13,rep,name=baskets,proto3" json:"baskets"`
+	RedemptionRateHistory      []RedemptionRateSnapshot    `protobuf:"bytes,14,rep,name=redemption_rate_history,json=redemptionRateHistory,proto3" json:"redemption_rate_history"`
+	RedemptionRateGuardHistory []RedemptionRateGuardRecord `protobuf:"bytes,15,rep,name=redemption_rate_guard_history,json=redemptionRateGuardHistory,proto3" json:"redemption_rate_guard_history"`
+	InFlightRedelegations      []InFlightRedelegation      `protobuf:"bytes,16,rep,name=in_flight_redelegations,json=inFlightRedelegations,proto3" json:"in_flight_redelegations"`
+	CircuitBreakerGuardian     *CircuitBreakerGuardian     `protobuf:"bytes,17,opt,name=circuit_breaker_guardian,json=circuitBreakerGuardian,proto3" json:"circuit_breaker_guardian,omitempty"`
+	CircuitBreakerFlags        []CircuitBreakerFlag        `protobuf:"bytes,18,rep,name=circuit_breaker_flags,json=circuitBreakerFlags,proto3" json:"circuit_breaker_flags"`
+	IcaGasEstimates            []IcaGasEstimate            `protobuf:"bytes,19,rep,name=ica_gas_estimates,json=icaGasEstimates,proto3" json:"ica_gas_estimates"`
+	InsuranceCoverageHistory   []InsuranceCoverageRecord   `protobuf:"bytes,20,rep,name=insurance_coverage_history,json=insuranceCoverageHistory,proto3" json:"insurance_coverage_history"`
+	IcaReconciliationReports   []IcaReconciliationReport   `protobuf:"bytes,21,rep,name=ica_reconciliation_reports,json=icaReconciliationReports,proto3" json:"ica_reconciliation_reports"`
+	IcaRecoveryStates          []IcaRecoveryState          `protobuf:"bytes,22,rep,name=ica_recovery_states,json=icaRecoveryStates,proto3" json:"ica_recovery_states"`
+	HostZoneOnboardings        []HostZoneOnboarding        `protobuf:"bytes,23,rep,name=host_zone_onboardings,json=hostZoneOnboardings,proto3" json:"host_zone_onboardings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +111,83 @@ func (m *GenesisState) GetTradeRoutes() []TradeRoute {
 	return nil
 }
 
+func (m *GenesisState) GetBaskets() []Basket {
+	if m != nil {
+		return m.Baskets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedemptionRateHistory() []RedemptionRateSnapshot {
+	if m != nil {
+		return m.RedemptionRateHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedemptionRateGuardHistory() []RedemptionRateGuardRecord {
+	if m != nil {
+		return m.RedemptionRateGuardHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInFlightRedelegations() []InFlightRedelegation {
+	if m != nil {
+		return m.InFlightRedelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerGuardian() *CircuitBreakerGuardian {
+	if m != nil {
+		return m.CircuitBreakerGuardian
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerFlags() []CircuitBreakerFlag {
+	if m != nil {
+		return m.CircuitBreakerFlags
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaGasEstimates() []IcaGasEstimate {
+	if m != nil {
+		return m.IcaGasEstimates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInsuranceCoverageHistory() []InsuranceCoverageRecord {
+	if m != nil {
+		return m.InsuranceCoverageHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaReconciliationReports() []IcaReconciliationReport {
+	if m != nil {
+		return m.IcaReconciliationReports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaRecoveryStates() []IcaRecoveryState {
+	if m != nil {
+		return m.IcaRecoveryStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostZoneOnboardings() []HostZoneOnboarding {
+	if m != nil {
+		return m.HostZoneOnboardings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.stakeibc.GenesisState")
 }
@@ -107,31 +195,57 @@ func init() {
 func init() { proto.RegisterFile("stride/stakeibc/genesis.proto", fileDescriptor_dea81129ed6fb77a) }
 
 var fileDescriptor_dea81129ed6fb77a = []byte{
-	// 382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x6e, 0xaa, 0x40,
-	0x14, 0x87, 0x41, 0x47, 0xc4, 0x81, 0xdc, 0x4b, 0xc8, 0x4d, 0xe4, 0x7a, 0xaf, 0xe8, 0xbd, 0xdd,
-	0xb8, 0x29, 0x24, 0x9a, 0xbe, 0x80, 0xa9, 0x69, 0x4b, 0x5c, 0xb4, 0xea, 0xca, 0x0d, 0xe1, 0xcf,
-	0x04, 0x88, 0xd5, 0x21, 0x33, 0x63, 0xd3, 0xf6, 0x29, 0xfa, 0x58, 0x2e, 0x5d, 0x76, 0xd1, 0x34,
-	0x8d, 0xbe, 0x48, 0x03, 0x4c, 0x8d, 0xc5, 0x1d, 0xe7, 0xfc, 0xbe, 0x7c, 0x9c, 0x39, 0x07, 0xb6,
-	0x29, 0x23, 0x49, 0x88, 0x6c, 0xca, 0xbc, 0x05, 0x4a, 0xfc, 0xc0, 0x8e, 0xd0, 0x0a, 0xd1, 0x84,
-	0x5a, 0x29, 0xc1, 0x0c, 0xeb, 0x3f, 0x8b, 0xd8, 0xfa, 0x8a, 0x5b, 0xbf, 0x22, 0x1c, 0xe1, 0x3c,
-	0xb3, 0xb3, 0xaf, 0x02, 0x6b, 0x9d, 0x95, 0x2d, 0x28, 0xc5, 0x41, 0xec, 0x32, 0xe2, 0x05, 0x0b,
-	0x44, 0x38, 0xd4, 0x29, 0x43, 0x31, 0xa6, 0xcc, 0x7d, 0xc6, 0x2b, 0xc4, 0x81, 0xbf, 0x65, 0x20,
-	0xf5, 0x88, 0xb7, 0xe4, 0xa3, 0xb4, 0xfe, 0x95, 0x53, 0x46, 0xbc, 0x10, 0xb9, 0x04, 0xaf, 0x19,
-	0x17, 0xfc, 0x7f, 0xab, 0x40, 0xf5, 0xaa, 0x98, 0x7f, 0xca, 0x3c, 0x86, 0xf4, 0x0b, 0x28, 0x15,
-	0x0e, 0x43, 0xec, 0x8a, 0x3d, 0xa5, 0xdf, 0xb4, 0x4a, 0xef, 0xb1, 0x6e, 0xf3, 0x78, 0x08, 0x36,
-	0xef, 0x1d, 0x61, 0xc2, 0x61, 0xbd, 0x09, 0xeb, 0x29, 0x26, 0xcc, 0x4d, 0x42, 0xa3, 0xd2, 0x15,
-	0x7b, 0x8d, 0x89, 0x94, 0x95, 0x37, 0xa1, 0x3e, 0x82, 0x3f, 0x0e, 0x43, 0xbb, 0xf7, 0x09, 0x65,
-	0x46, 0xad, 0x5b, 0xed, 0x29, 0xfd, 0xdf, 0x27, 0xde, 0x6b, 0x4c, 0xd9, 0x1c, 0xaf, 0x10, 0x37,
-	0xab, 0x31, 0xaf, 0xc7, 0x09, 0x65, 0xfa, 0x1d, 0xd4, 0xbf, 0x2d, 0xa8, 0x50, 0xc1, 0x5c, 0xd5,
-	0x3e, 0x51, 0x8d, 0x32, 0x74, 0x56, 0x90, 0x5c, 0xa7, 0xa1, 0xa3, 0x5e, 0xae, 0xbc, 0x84, 0xea,
-	0xd1, 0x3e, 0xa8, 0xa1, 0xe6, 0xb2, 0x3f, 0x27, 0xb2, 0x59, 0x06, 0x4d, 0x32, 0x86, 0xab, 0x14,
-	0x76, 0xe8, 0x50, 0x07, 0xc8, 0x55, 0x0d, 0x38, 0x40, 0x06, 0x5a, 0xcd, 0x01, 0xb2, 0xa4, 0xd5,
-	0x1d, 0x20, 0x37, 0x34, 0xe8, 0x00, 0x59, 0xd1, 0xd4, 0xe1, 0x78, 0xb3, 0x33, 0xc5, 0xed, 0xce,
-	0x14, 0x3f, 0x76, 0xa6, 0xf8, 0xb2, 0x37, 0x85, 0xed, 0xde, 0x14, 0x5e, 0xf7, 0xa6, 0x30, 0xef,
-	0x47, 0x09, 0x8b, 0xd7, 0xbe, 0x15, 0xe0, 0xa5, 0x3d, 0xcd, 0xff, 0x78, 0x3e, 0xf6, 0x7c, 0x6a,
-	0xf3, 0x93, 0x3d, 0x0c, 0x06, 0xf6, 0xe3, 0xd1, 0xe1, 0x9e, 0x52, 0x44, 0x7d, 0x29, 0xbf, 0xd9,
-	0xe0, 0x33, 0x00, 0x00, 0xff, 0xff, 0x25, 0xaa, 0x0c, 0xc0, 0x82, 0x02, 0x00, 0x00,
+	// 792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x5f, 0x4f, 0xeb, 0x36,
+	0x18, 0xc6, 0xdb, 0x11, 0x4a, 0x71, 0x3b, 0x28, 0xe1, 0x4f, 0xb3, 0x6e, 0x94, 0x02, 0x43, 0xab,
+	0x26, 0xd1, 0x4a, 0xa0, 0x69, 0xf7, 0x65, 0x50, 0xa8, 0x90, 0x36, 0x02, 0xd2, 0x24, 0xa4, 0x29,
+	0x72, 0x1d, 0x93, 0x58, 0xb4, 0x71, 0xe5, 0xd7, 0x45, 0x63, 0x9f, 0x62, 0x1f, 0x8b, 0x4b, 0x2e,
+	0xcf, 0xd5, 0xd1, 0x11, 0x7c, 0x90, 0x73, 0x14, 0xc7, 0xe9, 0x9f, 0x24, 0x15, 0x77, 0xad, 0xdf,
+	0x9f, 0x9f, 0xc7, 0x7e, 0x5f, 0xe7, 0x41, 0xbb, 0x20, 0x05, 0x73, 0x69, 0x1b, 0x24, 0x7e, 0xa4,
+	0xac, 0x4f, 0xda, 0x1e, 0x0d, 0x28, 0x30, 0x68, 0x8d, 0x04, 0x97, 0xdc, 0x5c, 0x8f, 0xca, 0xad,
+	0xb8, 0x5c, 0xdb, 0xf2, 0xb8, 0xc7, 0x55, 0xad, 0x1d, 0xfe, 0x8a, 0xb0, 0xda, 0x4f, 0x49, 0x95,
+	0x3e, 0x86, 0x47, 0x2a, 0x75, 0xf5, 0x28, 0x59, 0x25, 0x4c, 0x90, 0x31, 0x93, 0x4e, 0x5f, 0x50,
+	0xfc, 0x48, 0x85, 0xc6, 0x0e, 0x93, 0x18, 0x1d, 0x71, 0xe2, 0x3b, 0x52, 0x60, 0x32, 0x85, 0xf6,
+	0x92, 0x90, 0xcf, 0x41, 0x3a, 0xff, 0xf1, 0x80, 0x6a, 0x20, 0x75, 0x21, 0x46, 0xb0, 0xe3, 0x61,
+	0x7d, 0xa1, 0xda, 0x41, 0x56, 0x59, 0x50, 0xc2, 0x9f, 0xa8, 0x78, 0xd6, 0xcc, 0xcf, 0x29, 0x26,
+	0x80, 0xb1, 0xc0, 0x01, 0xa1, 0xce, 0xc3, 0x38, 0x70, 0x35, 0xd5, 0x48, 0x52, 0x3c, 0xe8, 0x73,
+	0x2c, 0x5c, 0x16, 0x78, 0x8b, 0xba, 0x32, 0xc2, 0x02, 0x0f, 0x61, 0x91, 0x4b, 0x78, 0x8a, 0x80,
+	0xb0, 0x01, 0xc3, 0x92, 0xf1, 0x60, 0xd1, 0x79, 0x05, 0x75, 0xe9, 0x80, 0x7a, 0xb3, 0xcc, 0x71,
+	0x16, 0x33, 0x1c, 0x85, 0x84, 0x23, 0xb0, 0xa4, 0x8e, 0xcf, 0x40, 0xf2, 0xc9, 0xf5, 0xf6, 0x93,
+	0xb8, 0x14, 0xd8, 0xa5, 0x8e, 0xe0, 0x63, 0xa9, 0x9b, 0x78, 0xf0, 0x15, 0xa1, 0x72, 0x37, 0x7a,
+	0x08, 0xb7, 0x12, 0x4b, 0x6a, 0xfe, 0x86, 0x0a, 0xd1, 0xe1, 0xad, 0x7c, 0x23, 0xdf, 0x2c, 0x9d,
+	0x54, 0x5b, 0x89, 0x87, 0xd1, 0xfa, 0x4b, 0x95, 0x3b, 0xc6, 0xcb, 0xe7, 0xbd, 0x9c, 0xad, 0x61,
+	0xb3, 0x8a, 0x56, 0x46, 0x5c, 0x48, 0x87, 0xb9, 0xd6, 0x77, 0x8d, 0x7c, 0x73, 0xd5, 0x2e, 0x84,
+	0x7f, 0xaf, 0x5c, 0xf3, 0x1c, 0xad, 0x4d, 0x06, 0xe7, 0x0c, 0x18, 0x48, 0x6b, 0xb9, 0xb1, 0xd4,
+	0x2c, 0x9d, 0xfc, 0x90, 0xd2, 0xbd, 0xe4, 0x20, 0xef, 0x79, 0x40, 0xb5, 0x72, 0xd9, 0xd7, 0xff,
+	0xaf, 0x19, 0x48, 0xf3, 0x06, 0x99, 0x73, 0x8f, 0x24, 0x92, 0x42, 0x4a, 0x6a, 0x37, 0x25, 0x75,
+	0x1e, 0xa2, 0x77, 0x11, 0xa9, 0xe5, 0x2a, 0x74, 0x66, 0x4d, 0x49, 0xfe, 0x81, 0xca, 0x33, 0xfd,
+	0x00, 0xab, 0xac, 0xc4, 0x7e, 0x4c, 0x89, 0xdd, 0x85, 0x90, 0x1d, 0x32, 0x5a, 0xaa, 0x24, 0x27,
+	0x2b, 0x60, 0xfe, 0x8e, 0x56, 0xa2, 0x4f, 0x00, 0xac, 0xef, 0x1b, 0x4b, 0x99, 0x0d, 0xeb, 0xa8,
+	0xba, 0xde, 0x1c, 0xd3, 0x26, 0x45, 0xd5, 0x05, 0xd3, 0xb3, 0xd6, 0x94, 0xd0, 0x2f, 0x29, 0x21,
+	0x7b, 0xc2, 0xdb, 0x58, 0xd2, 0xdb, 0x00, 0x8f, 0xc0, 0xe7, 0xb1, 0xf0, 0xb6, 0x98, 0xab, 0x5e,
+	0x46, 0x5a, 0x26, 0xa0, 0xdd, 0xa4, 0x8d, 0x37, 0xc6, 0xc2, 0x9d, 0x98, 0xad, 0x2b, 0xb3, 0x5f,
+	0x3f, 0x30, 0xeb, 0x86, 0x7b, 0x6c, 0x4a, 0xb8, 0x70, 0xb5, 0x5f, 0x4d, 0xa4, 0x81, 0xd8, 0x94,
+	0xa0, 0x2a, 0x0b, 0x9c, 0x87, 0x01, 0xf3, 0x7c, 0xe9, 0xcc, 0xbe, 0x63, 0xb0, 0x2a, 0xca, 0xee,
+	0x28, 0x65, 0x77, 0x15, 0x5c, 0x28, 0xdc, 0x9e, 0xa1, 0xe3, 0x9b, 0xb1, 0x8c, 0x1a, 0x98, 0x18,
+	0x59, 0x89, 0x78, 0x89, 0x6e, 0xc6, 0x70, 0x60, 0x6d, 0x34, 0xf2, 0x99, 0x1d, 0x3c, 0x8b, 0x36,
+	0x74, 0x22, 0xbe, 0xab, 0x71, 0x7b, 0x87, 0x64, 0xae, 0x9b, 0xff, 0xa0, 0xed, 0xa4, 0xc5, 0xc3,
+	0x00, 0x7b, 0x60, 0x99, 0xea, 0x16, 0x87, 0x1f, 0xe8, 0x5f, 0x0c, 0xb0, 0xa7, 0xef, 0xb0, 0x49,
+	0x52, 0x15, 0x30, 0x6f, 0xd0, 0x86, 0xce, 0x2c, 0x87, 0x82, 0x64, 0x43, 0x1c, 0x3e, 0xc3, 0x4d,
+	0x25, 0xbd, 0x97, 0x6e, 0x10, 0xc1, 0x5d, 0x0c, 0xe7, 0x9a, 0xd3, 0xb2, 0xeb, 0x6c, 0x6e, 0x15,
+	0xcc, 0x01, 0xaa, 0x4d, 0x33, 0x4c, 0x65, 0x1d, 0xf6, 0xa6, 0x0f, 0x6b, 0x4b, 0x69, 0x37, 0x33,
+	0x9a, 0xaf, 0xb7, 0x9c, 0xe9, 0x1d, 0x73, 0x93, 0xb6, 0x58, 0xb2, 0x1c, 0xcf, 0x39, 0x74, 0xd3,
+	0xa9, 0x3a, 0xcd, 0x33, 0x47, 0xd0, 0xf0, 0xdb, 0x07, 0x6b, 0x7b, 0x91, 0x1b, 0xc1, 0xf6, 0xdc,
+	0x0e, 0x5b, 0x6d, 0x98, 0xb8, 0x65, 0x97, 0xc1, 0xfc, 0x1b, 0x6d, 0xce, 0x66, 0xb8, 0x03, 0x52,
+	0x35, 0x6c, 0x47, 0xd9, 0xec, 0x2f, 0xb2, 0x09, 0x51, 0x15, 0x6d, 0x5a, 0x7f, 0x83, 0x25, 0xd6,
+	0x21, 0x1c, 0xf3, 0x34, 0xa3, 0xa6, 0xe1, 0x0e, 0x56, 0x75, 0xc1, 0x98, 0xe3, 0xa8, 0xfa, 0x73,
+	0xc2, 0xc6, 0x63, 0xf6, 0x53, 0x15, 0xe8, 0x19, 0xc5, 0xa5, 0x8a, 0xd1, 0x33, 0x8a, 0x46, 0x65,
+	0xb9, 0x67, 0x14, 0x0b, 0x95, 0x95, 0x9e, 0x51, 0x5c, 0xad, 0xa0, 0x9e, 0x51, 0x2c, 0x55, 0xca,
+	0x9d, 0xeb, 0x97, 0xb7, 0x7a, 0xfe, 0xf5, 0xad, 0x9e, 0xff, 0xf2, 0x56, 0xcf, 0xff, 0xff, 0x5e,
+	0xcf, 0xbd, 0xbe, 0xd7, 0x73, 0x9f, 0xde, 0xeb, 0xb9, 0xfb, 0x13, 0x8f, 0x49, 0x7f, 0xdc, 0x6f,
+	0x11, 0x3e, 0x6c, 0xdf, 0xaa, 0x13, 0x1c, 0x5f, 0xe3, 0x3e, 0xb4, 0x75, 0xaa, 0x3f, 0x9d, 0x9e,
+	0xb6, 0xff, 0x9d, 0xc9, 0xf6, 0xe7, 0x11, 0x85, 0x7e, 0x41, 0xc5, 0xfa, 0xe9, 0xb7, 0x01, 0x00,
+	0xb1, 0x30, 0xbd, 0x67, 0xee, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -154,6 +268,174 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HostZoneOnboardings) > 0 {
+		for iNdEx := len(m.HostZoneOnboardings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostZoneOnboardings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.IcaRecoveryStates) > 0 {
+		for iNdEx := len(m.IcaRecoveryStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaRecoveryStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.IcaReconciliationReports) > 0 {
+		for iNdEx := len(m.IcaReconciliationReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaReconciliationReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.InsuranceCoverageHistory) > 0 {
+		for iNdEx := len(m.InsuranceCoverageHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceCoverageHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.IcaGasEstimates) > 0 {
+		for iNdEx := len(m.IcaGasEstimates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaGasEstimates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.CircuitBreakerFlags) > 0 {
+		for iNdEx := len(m.CircuitBreakerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.CircuitBreakerGuardian != nil {
+		{
+			size, err := m.CircuitBreakerGuardian.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.InFlightRedelegations) > 0 {
+		for iNdEx := len(m.InFlightRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RedemptionRateGuardHistory) > 0 {
+		for iNdEx := len(m.RedemptionRateGuardHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRateGuardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RedemptionRateHistory) > 0 {
+		for iNdEx := len(m.RedemptionRateHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRateHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Baskets) > 0 {
+		for iNdEx := len(m.Baskets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Baskets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.TradeRoutes) > 0 {
 		for iNdEx := len(m.TradeRoutes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -257,6 +539,70 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Baskets) > 0 {
+		for _, e := range m.Baskets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedemptionRateHistory) > 0 {
+		for _, e := range m.RedemptionRateHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedemptionRateGuardHistory) > 0 {
+		for _, e := range m.RedemptionRateGuardHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InFlightRedelegations) > 0 {
+		for _, e := range m.InFlightRedelegations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CircuitBreakerGuardian != nil {
+		l = m.CircuitBreakerGuardian.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.CircuitBreakerFlags) > 0 {
+		for _, e := range m.CircuitBreakerFlags {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaGasEstimates) > 0 {
+		for _, e := range m.IcaGasEstimates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InsuranceCoverageHistory) > 0 {
+		for _, e := range m.InsuranceCoverageHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaReconciliationReports) > 0 {
+		for _, e := range m.IcaReconciliationReports {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaRecoveryStates) > 0 {
+		for _, e := range m.IcaRecoveryStates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostZoneOnboardings) > 0 {
+		for _, e := range m.HostZoneOnboardings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -462,6 +808,382 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Baskets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Baskets = append(m.Baskets, Basket{})
+			if err := m.Baskets[len(m.Baskets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRateHistory = append(m.RedemptionRateHistory, RedemptionRateSnapshot{})
+			if err := m.RedemptionRateHistory[len(m.RedemptionRateHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateGuardHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRateGuardHistory = append(m.RedemptionRateGuardHistory, RedemptionRateGuardRecord{})
+			if err := m.RedemptionRateGuardHistory[len(m.RedemptionRateGuardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightRedelegations = append(m.InFlightRedelegations, InFlightRedelegation{})
+			if err := m.InFlightRedelegations[len(m.InFlightRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerGuardian", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreakerGuardian == nil {
+				m.CircuitBreakerGuardian = &CircuitBreakerGuardian{}
+			}
+			if err := m.CircuitBreakerGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerFlags = append(m.CircuitBreakerFlags, CircuitBreakerFlag{})
+			if err := m.CircuitBreakerFlags[len(m.CircuitBreakerFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaGasEstimates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaGasEstimates = append(m.IcaGasEstimates, IcaGasEstimate{})
+			if err := m.IcaGasEstimates[len(m.IcaGasEstimates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceCoverageHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceCoverageHistory = append(m.InsuranceCoverageHistory, InsuranceCoverageRecord{})
+			if err := m.InsuranceCoverageHistory[len(m.InsuranceCoverageHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaReconciliationReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaReconciliationReports = append(m.IcaReconciliationReports, IcaReconciliationReport{})
+			if err := m.IcaReconciliationReports[len(m.IcaReconciliationReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaRecoveryStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaRecoveryStates = append(m.IcaRecoveryStates, IcaRecoveryState{})
+			if err := m.IcaRecoveryStates[len(m.IcaRecoveryStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneOnboardings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneOnboardings = append(m.HostZoneOnboardings, HostZoneOnboarding{})
+			if err := m.HostZoneOnboardings[len(m.HostZoneOnboardings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
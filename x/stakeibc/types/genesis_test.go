@@ -35,6 +35,125 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated redemption rate snapshot",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RedemptionRateHistory: []types.RedemptionRateSnapshot{
+					{ChainId: "0", EpochNumber: 1},
+					{ChainId: "0", EpochNumber: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated redemption rate guard record",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RedemptionRateGuardHistory: []types.RedemptionRateGuardRecord{
+					{ChainId: "0", Id: 1},
+					{ChainId: "0", Id: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated in-flight redelegation",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				InFlightRedelegations: []types.InFlightRedelegation{
+					{ChainId: "0", SrcValidator: "val1", DstValidator: "val2"},
+					{ChainId: "0", SrcValidator: "val1", DstValidator: "val2"},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated circuit breaker flag",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				CircuitBreakerFlags: []types.CircuitBreakerFlag{
+					{Module: types.ModuleName, ChainId: "0", Action: types.PAUSE_REDEEM},
+					{Module: types.ModuleName, ChainId: "0", Action: types.PAUSE_REDEEM},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid circuit breaker module",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				CircuitBreakerFlags: []types.CircuitBreakerFlag{
+					{Module: "bank", ChainId: "0", Action: types.PAUSE_REDEEM},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated ica gas estimate",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				IcaGasEstimates: []types.IcaGasEstimate{
+					{ChainId: "0", MsgTypeUrl: "/cosmos.staking.v1beta1.MsgDelegate", GasPerMsg: 1},
+					{ChainId: "0", MsgTypeUrl: "/cosmos.staking.v1beta1.MsgDelegate", GasPerMsg: 2},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated insurance coverage record",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				InsuranceCoverageHistory: []types.InsuranceCoverageRecord{
+					{ChainId: "0", Id: 1},
+					{ChainId: "0", Id: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated reconciliation report",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				IcaReconciliationReports: []types.IcaReconciliationReport{
+					{ChainId: "0", EpochNumber: 1},
+					{ChainId: "0", EpochNumber: 2},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated ica recovery state",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				IcaRecoveryStates: []types.IcaRecoveryState{
+					{ChainId: "0", IcaType: types.ICAAccountType_DELEGATION, Attempts: 1},
+					{ChainId: "0", IcaType: types.ICAAccountType_DELEGATION, Attempts: 2},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated host zone onboarding",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				HostZoneOnboardings: []types.HostZoneOnboarding{
+					{ChainId: "0", OracleChainId: "A"},
+					{ChainId: "0", OracleChainId: "B"},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
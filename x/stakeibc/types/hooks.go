@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	recordstypes "github.com/Stride-Labs/stride/v33/x/records/types"
 )
 
 // combine multiple staking hooks, all hook functions are run in array sequence
@@ -18,3 +20,11 @@ func (h MultiStakeIBCHooks) AfterLiquidStake(ctx context.Context, addr sdk.AccAd
 		h[i].AfterLiquidStake(ctx, addr)
 	}
 }
+
+// Callbacks for modules that start LSM liquid stakes on behalf of a user (e.g. autopilot)
+// They're invoked once an LSM liquid stake that was interrupted by a slash query either
+// finishes or fails, so the module can act on the outcome (e.g. forward the stTokens)
+type LSMLiquidStakeCallbacks interface {
+	AfterLSMLiquidStakeFinished(ctx sdk.Context, deposit recordstypes.LSMTokenDeposit) error
+	AfterLSMLiquidStakeFailed(ctx sdk.Context, deposit recordstypes.LSMTokenDeposit) error
+}
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"errors"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -11,6 +14,10 @@ const (
 	MaxUnbondingEntries = 7
 )
 
+// If the host zone does not specify a minimum rebalance amount, redelegations
+// smaller than this fraction of the total delegated stake are skipped
+var DefaultMinRebalanceRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+
 // Per an SDK constraint, we can issue no more than 7 undelegation messages
 // in a given unbonding period
 //
@@ -34,6 +41,113 @@ func (h HostZone) SafelyGetCommunityPoolRebate() (rebate CommunityPoolRebate, ex
 	return *h.CommunityPoolRebate, true
 }
 
+// Gets the max native tokens that can be unbonded in a single unbonding epoch,
+// if the host zone has an unbond cap
+func (h HostZone) SafelyGetMaxUnbondPerEpoch() (maxUnbond sdkmath.Int, capped bool) {
+	if h.MaxUnbondPerEpoch.IsNil() || !h.MaxUnbondPerEpoch.IsPositive() {
+		return sdkmath.ZeroInt(), false
+	}
+	return h.MaxUnbondPerEpoch, true
+}
+
+// Gets the minimum size of a rebalancing redelegation, falling back to the default
+// fraction of the total delegated stake if the host zone does not specify one
+func (h HostZone) GetMinRebalanceAmount(totalDelegations sdkmath.Int) sdkmath.Int {
+	if !h.MinRebalanceAmount.IsNil() && h.MinRebalanceAmount.IsPositive() {
+		return h.MinRebalanceAmount
+	}
+	return sdkmath.LegacyNewDecFromInt(totalDelegations).Mul(DefaultMinRebalanceRate).TruncateInt()
+}
+
+// Checks if a validator is on the host zone's blacklist
+func (h HostZone) IsValidatorBlacklisted(validatorAddress string) bool {
+	for _, blacklistedAddress := range h.BlacklistedValidators {
+		if blacklistedAddress == validatorAddress {
+			return true
+		}
+	}
+	return false
+}
+
+// Validates the automatic validator weighting config
+func (c AutoValidatorWeightingConfig) Validate() error {
+	if c.BaseWeight == 0 {
+		return errors.New("base weight must be greater than zero")
+	}
+	if c.MaxCommissionRate.IsNil() || c.MaxCommissionRate.IsNegative() || c.MaxCommissionRate.GT(sdkmath.LegacyOneDec()) {
+		return errors.New("max commission rate must be between 0 and 1")
+	}
+	if c.CommissionPenalty.IsNil() || c.CommissionPenalty.IsNegative() {
+		return errors.New("commission penalty must be non-negative")
+	}
+	if c.SlashPenalty.IsNil() || c.SlashPenalty.IsNegative() {
+		return errors.New("slash penalty must be non-negative")
+	}
+	if c.MaxSharesToTokensRateDrift.IsNil() || c.MaxSharesToTokensRateDrift.IsNegative() {
+		return errors.New("max shares to tokens rate drift must be non-negative")
+	}
+	return nil
+}
+
+// Validates the instant redemption config
+func (c InstantRedemptionConfig) Validate() error {
+	if c.DepositAllocationRate.IsNil() || c.DepositAllocationRate.IsNegative() || c.DepositAllocationRate.GT(sdkmath.LegacyOneDec()) {
+		return errors.New("deposit allocation rate must be between 0 and 1")
+	}
+	if c.TargetBufferAmount.IsNil() || c.TargetBufferAmount.IsNegative() {
+		return errors.New("target buffer amount must be non-negative")
+	}
+	if c.MinFeeRate.IsNil() || c.MinFeeRate.IsNegative() || c.MinFeeRate.GT(sdkmath.LegacyOneDec()) {
+		return errors.New("min fee rate must be between 0 and 1")
+	}
+	if c.MaxFeeRate.IsNil() || c.MaxFeeRate.GT(sdkmath.LegacyOneDec()) {
+		return errors.New("max fee rate must be between 0 and 1")
+	}
+	if c.MaxFeeRate.LT(c.MinFeeRate) {
+		return errors.New("max fee rate must be greater than or equal to the min fee rate")
+	}
+	return nil
+}
+
+// Validates the insurance fund config
+// The allocation rate must be strictly less than 1 so that some rewards are still reinvested
+func (c InsuranceFundConfig) Validate() error {
+	if c.RewardAllocationRate.IsNil() || c.RewardAllocationRate.IsNegative() || c.RewardAllocationRate.GTE(sdkmath.LegacyOneDec()) {
+		return errors.New("reward allocation rate must be at least 0 and less than 1")
+	}
+	if c.TargetFundAmount.IsNil() || c.TargetFundAmount.IsNegative() {
+		return errors.New("target fund amount must be non-negative")
+	}
+	if c.MaxCoveragePerSlash.IsNil() || c.MaxCoveragePerSlash.IsNegative() {
+		return errors.New("max coverage per slash must be non-negative")
+	}
+	return nil
+}
+
+// Validates the host zone fee schedule
+// The liquid stake and redemption fees must be strictly less than 1 so that
+// every liquid stake and redemption has a non-zero amount left after the fee
+func (f HostZoneFeeSchedule) Validate() error {
+	if f.RewardCommissionRate.IsNil() || f.RewardCommissionRate.IsNegative() || f.RewardCommissionRate.GT(sdkmath.LegacyOneDec()) {
+		return errors.New("reward commission rate must be between 0 and 1")
+	}
+	if f.LiquidStakeFeeRate.IsNil() || f.LiquidStakeFeeRate.IsNegative() || f.LiquidStakeFeeRate.GTE(sdkmath.LegacyOneDec()) {
+		return errors.New("liquid stake fee rate must be at least 0 and less than 1")
+	}
+	if f.RedemptionFeeRate.IsNil() || f.RedemptionFeeRate.IsNegative() || f.RedemptionFeeRate.GTE(sdkmath.LegacyOneDec()) {
+		return errors.New("redemption fee rate must be at least 0 and less than 1")
+	}
+	return nil
+}
+
+// Validates the validator blacklist policy
+func (p ValidatorBlacklistPolicy) Validate() error {
+	if p.SlashThreshold.IsNil() || p.SlashThreshold.IsNegative() || p.SlashThreshold.GT(sdkmath.LegacyOneDec()) {
+		return errors.New("slash threshold must be between 0 and 1")
+	}
+	return nil
+}
+
 // Generates a new stride-side address on the host zone to escrow deposits
 func NewHostZoneDepositAddress(chainId string) sdk.AccAddress {
 	key := append([]byte("zone"), []byte(chainId)...)
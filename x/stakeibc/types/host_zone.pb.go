@@ -25,6 +25,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Status of a host zone's redemption rate guard
+type RedemptionRateGuardStatus int32
+
+const (
+	// The redemption rate is within the inner bounds
+	GUARD_HEALTHY RedemptionRateGuardStatus = 0
+	// The redemption rate left the inner bounds - liquid stakes are paused but
+	// redemptions continue
+	GUARD_DEGRADED RedemptionRateGuardStatus = 1
+	// The redemption rate left the outer bounds - the host zone is halted
+	GUARD_HALTED RedemptionRateGuardStatus = 2
+)
+
+var RedemptionRateGuardStatus_name = map[int32]string{
+	0: "GUARD_HEALTHY",
+	1: "GUARD_DEGRADED",
+	2: "GUARD_HALTED",
+}
+
+var RedemptionRateGuardStatus_value = map[string]int32{
+	"GUARD_HEALTHY":  0,
+	"GUARD_DEGRADED": 1,
+	"GUARD_HALTED":   2,
+}
+
+func (x RedemptionRateGuardStatus) String() string {
+	return proto.EnumName(RedemptionRateGuardStatus_name, int32(x))
+}
+
+func (RedemptionRateGuardStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{0}
+}
+
 // CommunityPoolRebate stores the size of the community pool liquid stake
 // (denominated in stTokens) and the rebate rate as a decimal
 type CommunityPoolRebate struct {
@@ -67,6 +100,395 @@ func (m *CommunityPoolRebate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolRebate proto.InternalMessageInfo
 
+// Configuration for automatically computing validator weights each day epoch
+// from on-chain signals (jailed status, commission, slashes, and drift in the
+// validator's shares to tokens rate)
+type AutoValidatorWeightingConfig struct {
+	// Weight assigned to a validator with a perfect score
+	BaseWeight uint64 `protobuf:"varint,1,opt,name=base_weight,json=baseWeight,proto3" json:"base_weight,omitempty"`
+	// Validators with a commission above this rate are assigned zero weight
+	MaxCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_rate"`
+	// Score reduction per unit of commission
+	// (e.g. a penalty of 1.0 with a 5% commission reduces the score by 0.05)
+	CommissionPenalty cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=commission_penalty,json=commissionPenalty,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_penalty"`
+	// Score reduction for each slash observed on the validator
+	SlashPenalty cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_penalty,json=slashPenalty,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_penalty"`
+	// Validators whose shares to tokens rate has fallen below 1 by more than
+	// this amount are assigned zero weight
+	MaxSharesToTokensRateDrift cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_shares_to_tokens_rate_drift,json=maxSharesToTokensRateDrift,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_shares_to_tokens_rate_drift"`
+}
+
+func (m *AutoValidatorWeightingConfig) Reset()         { *m = AutoValidatorWeightingConfig{} }
+func (m *AutoValidatorWeightingConfig) String() string { return proto.CompactTextString(m) }
+func (*AutoValidatorWeightingConfig) ProtoMessage()    {}
+func (*AutoValidatorWeightingConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{1}
+}
+func (m *AutoValidatorWeightingConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoValidatorWeightingConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoValidatorWeightingConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoValidatorWeightingConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoValidatorWeightingConfig.Merge(m, src)
+}
+func (m *AutoValidatorWeightingConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoValidatorWeightingConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoValidatorWeightingConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoValidatorWeightingConfig proto.InternalMessageInfo
+
+func (m *AutoValidatorWeightingConfig) GetBaseWeight() uint64 {
+	if m != nil {
+		return m.BaseWeight
+	}
+	return 0
+}
+
+// Configuration for instant redemptions, which pay out native tokens
+// immediately from a stride-side liquidity buffer
+type InstantRedemptionConfig struct {
+	// Portion of each liquid stake deposit record that's diverted into the
+	// buffer before the deposit is transferred to the host zone
+	DepositAllocationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=deposit_allocation_rate,json=depositAllocationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deposit_allocation_rate"`
+	// Size of the buffer (including pending refills) at which deposits are no
+	// longer diverted
+	TargetBufferAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=target_buffer_amount,json=targetBufferAmount,proto3,customtype=cosmossdk.io/math.Int" json:"target_buffer_amount"`
+	// Fee rate charged when the buffer is full
+	MinFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_fee_rate,json=minFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_fee_rate"`
+	// Fee rate charged when a redemption would fully drain the buffer
+	// The fee scales linearly between the min and max as the buffer is depleted
+	MaxFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_fee_rate,json=maxFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_fee_rate"`
+}
+
+func (m *InstantRedemptionConfig) Reset()         { *m = InstantRedemptionConfig{} }
+func (m *InstantRedemptionConfig) String() string { return proto.CompactTextString(m) }
+func (*InstantRedemptionConfig) ProtoMessage()    {}
+func (*InstantRedemptionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{2}
+}
+func (m *InstantRedemptionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstantRedemptionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstantRedemptionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InstantRedemptionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstantRedemptionConfig.Merge(m, src)
+}
+func (m *InstantRedemptionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstantRedemptionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstantRedemptionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstantRedemptionConfig proto.InternalMessageInfo
+
+// Configuration for a host zone's slashing insurance fund, which is funded from
+// staking rewards and covers the loss from validator slashes
+type InsuranceFundConfig struct {
+	// Portion of staking rewards diverted into the fund (taken from the
+	// reinvested portion, after the stride commission)
+	RewardAllocationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=reward_allocation_rate,json=rewardAllocationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_allocation_rate"`
+	// Fund balance at which rewards are no longer diverted
+	// If zero, the fund is not capped
+	TargetFundAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=target_fund_amount,json=targetFundAmount,proto3,customtype=cosmossdk.io/math.Int" json:"target_fund_amount"`
+	// Max amount the fund will cover for a single slash
+	// If zero, each slash is covered up to the fund balance
+	MaxCoveragePerSlash cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_coverage_per_slash,json=maxCoveragePerSlash,proto3,customtype=cosmossdk.io/math.Int" json:"max_coverage_per_slash"`
+}
+
+func (m *InsuranceFundConfig) Reset()         { *m = InsuranceFundConfig{} }
+func (m *InsuranceFundConfig) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundConfig) ProtoMessage()    {}
+func (*InsuranceFundConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{3}
+}
+func (m *InsuranceFundConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceFundConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundConfig.Merge(m, src)
+}
+func (m *InsuranceFundConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundConfig proto.InternalMessageInfo
+
+// Fee schedule negotiated with a host zone, overriding the global stride
+// commission param
+type HostZoneFeeSchedule struct {
+	// Portion of staking rewards taken as commission (e.g. 0.1 for 10%)
+	RewardCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=reward_commission_rate,json=rewardCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_commission_rate"`
+	// Portion of each liquid stake deposit taken as a fee, in native tokens
+	LiquidStakeFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=liquid_stake_fee_rate,json=liquidStakeFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquid_stake_fee_rate"`
+	// Portion of each redemption taken as a fee, in stTokens
+	RedemptionFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=redemption_fee_rate,json=redemptionFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"redemption_fee_rate"`
+}
+
+func (m *HostZoneFeeSchedule) Reset()         { *m = HostZoneFeeSchedule{} }
+func (m *HostZoneFeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*HostZoneFeeSchedule) ProtoMessage()    {}
+func (*HostZoneFeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{4}
+}
+func (m *HostZoneFeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostZoneFeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostZoneFeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostZoneFeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostZoneFeeSchedule.Merge(m, src)
+}
+func (m *HostZoneFeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostZoneFeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostZoneFeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostZoneFeeSchedule proto.InternalMessageInfo
+
+// Policy for automatically blacklisting validators that are slashed or jailed
+// Blacklisted validators are set to zero weight and their stake is
+// redelegated away
+type ValidatorBlacklistPolicy struct {
+	// Validators slashed by at least this portion of their delegation are
+	// blacklisted (e.g. 0.05 for 5%). If zero, slashes do not trigger a blacklist
+	SlashThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=slash_threshold,json=slashThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_threshold"`
+	// Whether validators observed as jailed (which includes tombstoned
+	// validators) are blacklisted
+	BlacklistJailed bool `protobuf:"varint,2,opt,name=blacklist_jailed,json=blacklistJailed,proto3" json:"blacklist_jailed,omitempty"`
+}
+
+func (m *ValidatorBlacklistPolicy) Reset()         { *m = ValidatorBlacklistPolicy{} }
+func (m *ValidatorBlacklistPolicy) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBlacklistPolicy) ProtoMessage()    {}
+func (*ValidatorBlacklistPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{5}
+}
+func (m *ValidatorBlacklistPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBlacklistPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBlacklistPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBlacklistPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBlacklistPolicy.Merge(m, src)
+}
+func (m *ValidatorBlacklistPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBlacklistPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBlacklistPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBlacklistPolicy proto.InternalMessageInfo
+
+func (m *ValidatorBlacklistPolicy) GetBlacklistJailed() bool {
+	if m != nil {
+		return m.BlacklistJailed
+	}
+	return false
+}
+
+// Configures the redemption rate guard on a host zone
+// When enabled, a redemption rate outside the inner bounds degrades the host
+// zone rather than halting it, and the host zone can recover automatically
+type RedemptionRateGuardConfig struct {
+	// Number of stride epochs after the guard trips before the host zone can be
+	// resumed (either automatically or manually)
+	ResumeCooldownEpochs uint64 `protobuf:"varint,1,opt,name=resume_cooldown_epochs,json=resumeCooldownEpochs,proto3" json:"resume_cooldown_epochs,omitempty"`
+	// Number of consecutive redemption rate updates that must land inside the
+	// inner bounds before the host zone is automatically resumed
+	// If zero, the host zone must be resumed manually
+	AutoResumeEpochs uint64 `protobuf:"varint,2,opt,name=auto_resume_epochs,json=autoResumeEpochs,proto3" json:"auto_resume_epochs,omitempty"`
+}
+
+func (m *RedemptionRateGuardConfig) Reset()         { *m = RedemptionRateGuardConfig{} }
+func (m *RedemptionRateGuardConfig) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRateGuardConfig) ProtoMessage()    {}
+func (*RedemptionRateGuardConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{6}
+}
+func (m *RedemptionRateGuardConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRateGuardConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRateGuardConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRateGuardConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRateGuardConfig.Merge(m, src)
+}
+func (m *RedemptionRateGuardConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRateGuardConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRateGuardConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRateGuardConfig proto.InternalMessageInfo
+
+func (m *RedemptionRateGuardConfig) GetResumeCooldownEpochs() uint64 {
+	if m != nil {
+		return m.ResumeCooldownEpochs
+	}
+	return 0
+}
+
+func (m *RedemptionRateGuardConfig) GetAutoResumeEpochs() uint64 {
+	if m != nil {
+		return m.AutoResumeEpochs
+	}
+	return 0
+}
+
+// The current state of a host zone's redemption rate guard, along with the
+// values that tripped it
+type RedemptionRateGuardState struct {
+	Status RedemptionRateGuardStatus `protobuf:"varint,1,opt,name=status,proto3,enum=stride.stakeibc.RedemptionRateGuardStatus" json:"status,omitempty"`
+	// Description of why the guard tripped
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Redemption rate at the time the guard tripped
+	TriggerRedemptionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=trigger_redemption_rate,json=triggerRedemptionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trigger_redemption_rate"`
+	// Bounds that the redemption rate violated
+	TriggerMinBound cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=trigger_min_bound,json=triggerMinBound,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trigger_min_bound"`
+	TriggerMaxBound cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=trigger_max_bound,json=triggerMaxBound,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trigger_max_bound"`
+	// Stride epoch in which the guard tripped
+	TrippedEpochNumber uint64 `protobuf:"varint,6,opt,name=tripped_epoch_number,json=trippedEpochNumber,proto3" json:"tripped_epoch_number,omitempty"`
+	// Number of consecutive redemption rate updates inside the inner bounds
+	// since the guard tripped
+	HealthyEpochs uint64 `protobuf:"varint,7,opt,name=healthy_epochs,json=healthyEpochs,proto3" json:"healthy_epochs,omitempty"`
+}
+
+func (m *RedemptionRateGuardState) Reset()         { *m = RedemptionRateGuardState{} }
+func (m *RedemptionRateGuardState) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRateGuardState) ProtoMessage()    {}
+func (*RedemptionRateGuardState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{7}
+}
+func (m *RedemptionRateGuardState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRateGuardState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRateGuardState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRateGuardState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRateGuardState.Merge(m, src)
+}
+func (m *RedemptionRateGuardState) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRateGuardState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRateGuardState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRateGuardState proto.InternalMessageInfo
+
+func (m *RedemptionRateGuardState) GetStatus() RedemptionRateGuardStatus {
+	if m != nil {
+		return m.Status
+	}
+	return GUARD_HEALTHY
+}
+
+func (m *RedemptionRateGuardState) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RedemptionRateGuardState) GetTrippedEpochNumber() uint64 {
+	if m != nil {
+		return m.TrippedEpochNumber
+	}
+	return 0
+}
+
+func (m *RedemptionRateGuardState) GetHealthyEpochs() uint64 {
+	if m != nil {
+		return m.HealthyEpochs
+	}
+	return 0
+}
+
 // Core data structure to track liquid staking zones
 type HostZone struct {
 	// Chain ID of the host zone
@@ -150,13 +572,63 @@ type HostZone struct {
 	// stakes. This is only used as documentation - it doesn't affect any
 	// functionality `Halted` is used to stop business logic
 	Deprecated bool `protobuf:"varint,38,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// Optional config to automatically recompute validator weights each day
+	// epoch. If this is nil, weights are only updated manually
+	AutoValidatorWeighting *AutoValidatorWeightingConfig `protobuf:"bytes,39,opt,name=auto_validator_weighting,json=autoValidatorWeighting,proto3" json:"auto_validator_weighting,omitempty"`
+	// Optional config to enable instant redemptions. If this is nil, instant
+	// redemptions are disabled
+	InstantRedemptionConfig *InstantRedemptionConfig `protobuf:"bytes,40,opt,name=instant_redemption_config,json=instantRedemptionConfig,proto3" json:"instant_redemption_config,omitempty"`
+	// Stride-side module account holding the instant redemption buffer
+	InstantRedemptionBufferAddress string `protobuf:"bytes,41,opt,name=instant_redemption_buffer_address,json=instantRedemptionBufferAddress,proto3" json:"instant_redemption_buffer_address,omitempty"`
+	// Native tokens (in the ibc denom) currently held in the instant
+	// redemption buffer
+	InstantRedemptionBufferBalance cosmossdk_io_math.Int `protobuf:"bytes,42,opt,name=instant_redemption_buffer_balance,json=instantRedemptionBufferBalance,proto3,customtype=cosmossdk.io/math.Int" json:"instant_redemption_buffer_balance"`
+	// Optional fee schedule for the host zone. If this is nil, the global stride
+	// commission is applied to rewards and no liquid stake or redemption fees
+	// are charged
+	FeeSchedule *HostZoneFeeSchedule `protobuf:"bytes,43,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule,omitempty"`
+	// Optional policy to automatically blacklist slashed or jailed validators.
+	// If this is nil, validators are only removed through governance
+	ValidatorBlacklistPolicy *ValidatorBlacklistPolicy `protobuf:"bytes,44,opt,name=validator_blacklist_policy,json=validatorBlacklistPolicy,proto3" json:"validator_blacklist_policy,omitempty"`
+	// Validator addresses that are blacklisted on this host zone and cannot be
+	// added back to the validator set
+	BlacklistedValidators []string `protobuf:"bytes,45,rep,name=blacklisted_validators,json=blacklistedValidators,proto3" json:"blacklisted_validators,omitempty"`
+	// Optional redemption rate guard config. If this is nil, the host zone is
+	// halted whenever the redemption rate leaves the inner or outer bounds
+	RedemptionRateGuardConfig *RedemptionRateGuardConfig `protobuf:"bytes,46,opt,name=redemption_rate_guard_config,json=redemptionRateGuardConfig,proto3" json:"redemption_rate_guard_config,omitempty"`
+	// State of the redemption rate guard. If this is nil, the guard has not
+	// tripped
+	RedemptionRateGuard *RedemptionRateGuardState `protobuf:"bytes,47,opt,name=redemption_rate_guard,json=redemptionRateGuard,proto3" json:"redemption_rate_guard,omitempty"`
+	// The estimated gas limit of a delegation or undelegation ICA tx on the
+	// host. If set, ICA batches are also sized so that the summed gas estimate
+	// of each message stays below this limit. If 0, batches are only sized by
+	// max_messages_per_ica_tx
+	MaxIcaTxGas uint64 `protobuf:"varint,48,opt,name=max_ica_tx_gas,json=maxIcaTxGas,proto3" json:"max_ica_tx_gas,omitempty"`
+	// Optional config to enable the slashing insurance fund. If this is nil,
+	// rewards are not diverted to the fund and slashes are not covered
+	InsuranceFundConfig *InsuranceFundConfig `protobuf:"bytes,49,opt,name=insurance_fund_config,json=insuranceFundConfig,proto3" json:"insurance_fund_config,omitempty"`
+	// Stride-side module account holding the insurance fund (in the ibc denom)
+	InsuranceFundAddress string `protobuf:"bytes,50,opt,name=insurance_fund_address,json=insuranceFundAddress,proto3" json:"insurance_fund_address,omitempty"`
+	// Minimum discrepancy (in native tokens) between an account's balance and its
+	// expected balance before a discrepancy event is emitted during
+	// reconciliation. If 0, any discrepancy emits an event
+	ReconciliationDiscrepancyThreshold cosmossdk_io_math.Int `protobuf:"bytes,51,opt,name=reconciliation_discrepancy_threshold,json=reconciliationDiscrepancyThreshold,proto3,customtype=cosmossdk.io/math.Int" json:"reconciliation_discrepancy_threshold"`
+	// Maximum native tokens that can be unbonded in a single unbonding epoch.
+	// Redemptions beyond the cap roll over to the next unbonding epoch in the
+	// order they were queued. If 0, unbondings are not capped
+	MaxUnbondPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,52,opt,name=max_unbond_per_epoch,json=maxUnbondPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"max_unbond_per_epoch"`
+	// Minimum size (in native tokens) of a rebalancing redelegation. Smaller
+	// redelegations are skipped so that minor weight drift does not use up the
+	// host's redelegation entries. If 0, the minimum defaults to a fraction of
+	// the host zone's total delegations
+	MinRebalanceAmount cosmossdk_io_math.Int `protobuf:"bytes,53,opt,name=min_rebalance_amount,json=minRebalanceAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_rebalance_amount"`
 }
 
 func (m *HostZone) Reset()         { *m = HostZone{} }
 func (m *HostZone) String() string { return proto.CompactTextString(m) }
 func (*HostZone) ProtoMessage()    {}
 func (*HostZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f81bf5b42c61245a, []int{1}
+	return fileDescriptor_f81bf5b42c61245a, []int{8}
 }
 func (m *HostZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,79 +825,228 @@ func (m *HostZone) GetDeprecated() bool {
 	return false
 }
 
+func (m *HostZone) GetAutoValidatorWeighting() *AutoValidatorWeightingConfig {
+	if m != nil {
+		return m.AutoValidatorWeighting
+	}
+	return nil
+}
+
+func (m *HostZone) GetInstantRedemptionConfig() *InstantRedemptionConfig {
+	if m != nil {
+		return m.InstantRedemptionConfig
+	}
+	return nil
+}
+
+func (m *HostZone) GetInstantRedemptionBufferAddress() string {
+	if m != nil {
+		return m.InstantRedemptionBufferAddress
+	}
+	return ""
+}
+
+func (m *HostZone) GetFeeSchedule() *HostZoneFeeSchedule {
+	if m != nil {
+		return m.FeeSchedule
+	}
+	return nil
+}
+
+func (m *HostZone) GetValidatorBlacklistPolicy() *ValidatorBlacklistPolicy {
+	if m != nil {
+		return m.ValidatorBlacklistPolicy
+	}
+	return nil
+}
+
+func (m *HostZone) GetBlacklistedValidators() []string {
+	if m != nil {
+		return m.BlacklistedValidators
+	}
+	return nil
+}
+
+func (m *HostZone) GetRedemptionRateGuardConfig() *RedemptionRateGuardConfig {
+	if m != nil {
+		return m.RedemptionRateGuardConfig
+	}
+	return nil
+}
+
+func (m *HostZone) GetRedemptionRateGuard() *RedemptionRateGuardState {
+	if m != nil {
+		return m.RedemptionRateGuard
+	}
+	return nil
+}
+
+func (m *HostZone) GetMaxIcaTxGas() uint64 {
+	if m != nil {
+		return m.MaxIcaTxGas
+	}
+	return 0
+}
+
+func (m *HostZone) GetInsuranceFundConfig() *InsuranceFundConfig {
+	if m != nil {
+		return m.InsuranceFundConfig
+	}
+	return nil
+}
+
+func (m *HostZone) GetInsuranceFundAddress() string {
+	if m != nil {
+		return m.InsuranceFundAddress
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("stride.stakeibc.RedemptionRateGuardStatus", RedemptionRateGuardStatus_name, RedemptionRateGuardStatus_value)
 	proto.RegisterType((*CommunityPoolRebate)(nil), "stride.stakeibc.CommunityPoolRebate")
+	proto.RegisterType((*AutoValidatorWeightingConfig)(nil), "stride.stakeibc.AutoValidatorWeightingConfig")
+	proto.RegisterType((*InstantRedemptionConfig)(nil), "stride.stakeibc.InstantRedemptionConfig")
+	proto.RegisterType((*InsuranceFundConfig)(nil), "stride.stakeibc.InsuranceFundConfig")
+	proto.RegisterType((*HostZoneFeeSchedule)(nil), "stride.stakeibc.HostZoneFeeSchedule")
+	proto.RegisterType((*ValidatorBlacklistPolicy)(nil), "stride.stakeibc.ValidatorBlacklistPolicy")
+	proto.RegisterType((*RedemptionRateGuardConfig)(nil), "stride.stakeibc.RedemptionRateGuardConfig")
+	proto.RegisterType((*RedemptionRateGuardState)(nil), "stride.stakeibc.RedemptionRateGuardState")
 	proto.RegisterType((*HostZone)(nil), "stride.stakeibc.HostZone")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/host_zone.proto", fileDescriptor_f81bf5b42c61245a) }
 
 var fileDescriptor_f81bf5b42c61245a = []byte{
-	// 1017 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xc7, 0x71, 0xe2, 0xc0, 0x32, 0x04, 0xbc, 0x5e, 0x03, 0x59, 0x20, 0x18, 0xf3, 0x23, 0x15,
-	0x3d, 0x60, 0xab, 0x50, 0xa9, 0x52, 0x4f, 0x25, 0xa1, 0x52, 0x6c, 0xd1, 0x08, 0x2d, 0xa8, 0xaa,
-	0xb8, 0xac, 0x66, 0x67, 0x1e, 0xf6, 0x94, 0xdd, 0x19, 0x77, 0x66, 0x9c, 0x98, 0xfe, 0x15, 0xfd,
-	0x63, 0x72, 0xec, 0xa9, 0xa7, 0x1c, 0xa3, 0x9c, 0xaa, 0x1e, 0xa2, 0x0a, 0xfe, 0x91, 0x6a, 0x67,
-	0xbd, 0xf6, 0xda, 0x4b, 0xe5, 0x88, 0x13, 0x9e, 0xf7, 0xe3, 0xf3, 0x7d, 0xc3, 0xcc, 0xbc, 0x7d,
-	0x68, 0x4b, 0x69, 0xc9, 0x28, 0x34, 0x94, 0xc6, 0xd7, 0xc0, 0x02, 0xd2, 0xe8, 0x08, 0xa5, 0xfd,
-	0xdf, 0x05, 0x87, 0x7a, 0x57, 0x0a, 0x2d, 0x9c, 0x52, 0x12, 0x50, 0x4f, 0x03, 0xd6, 0xd7, 0x88,
-	0x50, 0x91, 0x50, 0xbe, 0x71, 0x37, 0x92, 0x45, 0x12, 0xbb, 0xbe, 0xdc, 0x16, 0x6d, 0x91, 0xd8,
-	0xe3, 0x5f, 0x03, 0x6b, 0x4e, 0xe2, 0x2d, 0x0e, 0x19, 0xc5, 0x5a, 0xc8, 0x24, 0x60, 0xe7, 0xcf,
-	0x02, 0xaa, 0xbc, 0x12, 0x51, 0xd4, 0xe3, 0x4c, 0xdf, 0x9c, 0x09, 0x11, 0x7a, 0x10, 0x60, 0x0d,
-	0xce, 0x09, 0x5a, 0x90, 0xe6, 0x97, 0x2f, 0xb1, 0x06, 0xb7, 0x50, 0x2b, 0xec, 0xcf, 0xbf, 0xdc,
-	0xfd, 0xf0, 0x79, 0x6b, 0xe6, 0x9f, 0xcf, 0x5b, 0x1b, 0x89, 0xb2, 0xa2, 0xd7, 0x75, 0x26, 0x1a,
-	0x11, 0xd6, 0x9d, 0xfa, 0x29, 0xb4, 0x31, 0xb9, 0x39, 0x01, 0xe2, 0xa1, 0x24, 0xcf, 0x8b, 0x29,
-	0x3e, 0xda, 0x0c, 0xd9, 0x6f, 0x3d, 0x46, 0x7d, 0x53, 0x40, 0xfc, 0xc7, 0xd7, 0xe2, 0x1a, 0xb8,
-	0x8f, 0x23, 0xd1, 0xe3, 0xda, 0x7d, 0x64, 0xb8, 0x9b, 0x03, 0xee, 0x4a, 0x9e, 0xdb, 0xe4, 0xda,
-	0x5b, 0x4b, 0x18, 0xe7, 0x06, 0x71, 0xae, 0x2f, 0x62, 0xc0, 0xb1, 0xc9, 0xdf, 0xf9, 0xab, 0x8c,
-	0xac, 0xd7, 0x42, 0xe9, 0x4b, 0xc1, 0xc1, 0x59, 0x43, 0x16, 0xe9, 0x60, 0xc6, 0x7d, 0x46, 0x93,
-	0x82, 0xbd, 0x39, 0xb3, 0x6e, 0x52, 0x67, 0x07, 0x3d, 0x0d, 0x80, 0x74, 0x8e, 0x0e, 0xbb, 0x12,
-	0xae, 0x58, 0xdf, 0x2d, 0x1b, 0xf7, 0x98, 0xcd, 0xd9, 0x45, 0x8b, 0x44, 0x70, 0x0e, 0x44, 0x33,
-	0x61, 0x18, 0x8f, 0x92, 0xa0, 0x91, 0xb1, 0x49, 0x9d, 0x3a, 0xaa, 0x68, 0x89, 0xb9, 0xba, 0x02,
-	0xe9, 0x93, 0x0e, 0xe6, 0x1c, 0xc2, 0x38, 0xf4, 0xa9, 0x09, 0x2d, 0xa7, 0xae, 0x57, 0x89, 0xa7,
-	0x49, 0x9d, 0x0d, 0x34, 0xcf, 0x02, 0xe2, 0x53, 0xe0, 0x22, 0x72, 0x2d, 0x13, 0x65, 0xb1, 0x80,
-	0x9c, 0xc4, 0x6b, 0x67, 0x13, 0x21, 0x73, 0xe4, 0x89, 0x77, 0xde, 0x78, 0xe7, 0x63, 0x4b, 0xe2,
-	0xfe, 0x1a, 0xd9, 0x3d, 0x1e, 0x08, 0x4e, 0x19, 0x6f, 0xfb, 0x5d, 0x90, 0x4c, 0x50, 0x77, 0xbd,
-	0x56, 0xd8, 0x2f, 0x7a, 0xa5, 0xa1, 0xfd, 0xcc, 0x98, 0x9d, 0xef, 0x11, 0x1a, 0x9e, 0xac, 0x72,
-	0x1f, 0xd7, 0x1e, 0xef, 0x2f, 0x1c, 0xae, 0xd7, 0x27, 0xae, 0x4f, 0xfd, 0xe7, 0x34, 0xc4, 0xcb,
-	0x44, 0x3b, 0xc7, 0xa8, 0x44, 0xa1, 0x2b, 0x14, 0xd3, 0x3e, 0xa6, 0x54, 0x82, 0x52, 0xae, 0x63,
-	0x8e, 0xc5, 0xfd, 0xf4, 0xfe, 0x60, 0x79, 0x70, 0xc9, 0x8e, 0x13, 0xcf, 0xb9, 0x96, 0x8c, 0xb7,
-	0xbd, 0xa5, 0x41, 0xc2, 0xc0, 0xea, 0xbc, 0x41, 0xab, 0xef, 0x98, 0xee, 0x50, 0x89, 0xdf, 0xe1,
-	0xd0, 0x67, 0x04, 0x0f, 0x49, 0xab, 0x53, 0x48, 0xcb, 0xa3, 0xbc, 0x26, 0xc1, 0x29, 0xef, 0x07,
-	0x54, 0xba, 0x02, 0x18, 0x03, 0x3d, 0x9b, 0x02, 0x5a, 0xbc, 0x02, 0xc8, 0x10, 0xde, 0xa0, 0x55,
-	0x0a, 0x21, 0xb4, 0x71, 0x72, 0x98, 0x19, 0x90, 0x3b, 0xad, 0xa2, 0x51, 0xde, 0x38, 0x4f, 0x02,
-	0x85, 0xa8, 0x9b, 0xe3, 0xad, 0x4d, 0xe3, 0x8d, 0xf2, 0x32, 0x3c, 0x8a, 0x76, 0x48, 0xfa, 0xec,
-	0xfc, 0xae, 0x10, 0xa1, 0x9f, 0x9e, 0x41, 0x96, 0x5d, 0x9d, 0xc2, 0xae, 0x92, 0xec, 0xd3, 0x3d,
-	0x49, 0x08, 0x19, 0x95, 0x00, 0x6d, 0x4f, 0xa8, 0x48, 0xd0, 0x3d, 0x39, 0xbe, 0x81, 0xad, 0x29,
-	0x22, 0x9b, 0x64, 0xbc, 0x3f, 0xc4, 0x80, 0x8c, 0x46, 0x07, 0xed, 0x4d, 0x68, 0x98, 0xfb, 0xe6,
-	0x77, 0x44, 0x68, 0x2e, 0x6e, 0x2a, 0x53, 0x9b, 0x22, 0x53, 0x1b, 0x93, 0x31, 0x8f, 0xfd, 0x75,
-	0x82, 0x48, 0x95, 0x7e, 0x45, 0x2f, 0x72, 0xbb, 0xa1, 0x00, 0x51, 0x4e, 0x6a, 0x7b, 0x8a, 0xd4,
-	0xf6, 0xc4, 0x8e, 0x62, 0xc8, 0x84, 0x96, 0x8f, 0xb6, 0x26, 0xb4, 0xb4, 0x04, 0xac, 0x7a, 0xf2,
-	0x66, 0xa8, 0xb2, 0x3b, 0x45, 0xe5, 0xf9, 0x98, 0xca, 0xc5, 0x20, 0x3d, 0x15, 0x68, 0xa1, 0xb2,
-	0x16, 0x1a, 0xc7, 0xe7, 0x9e, 0x5e, 0x37, 0xe5, 0x2e, 0x7e, 0x49, 0x3b, 0xb4, 0x4d, 0xde, 0xc9,
-	0x28, 0xcd, 0x21, 0x68, 0x39, 0xc4, 0x4a, 0xfb, 0x99, 0x1b, 0x6a, 0xba, 0x36, 0x32, 0xb8, 0x6f,
-	0xbe, 0xa0, 0x6b, 0x7f, 0x7a, 0x7f, 0x80, 0x06, 0x9b, 0x88, 0x7b, 0xb8, 0x13, 0xe3, 0xbc, 0x21,
-	0xcd, 0xf4, 0xf2, 0x4b, 0x54, 0x9a, 0xe4, 0x2f, 0x3c, 0x94, 0xbf, 0x24, 0xc7, 0xd9, 0x18, 0x55,
-	0x22, 0xc6, 0x73, 0xf5, 0x2f, 0x3f, 0x94, 0x5f, 0x8e, 0x18, 0xf7, 0xf2, 0x12, 0xb8, 0x9f, 0x93,
-	0x58, 0x79, 0xb8, 0x04, 0xee, 0x4f, 0x48, 0x84, 0x68, 0x2d, 0xde, 0x05, 0xe3, 0x1c, 0x64, 0x4e,
-	0xe8, 0xf9, 0x43, 0x85, 0x56, 0x23, 0xc6, 0x9b, 0x31, 0xf2, 0x1e, 0x35, 0xdc, 0xff, 0x1f, 0xb5,
-	0xcd, 0x87, 0xab, 0xe1, 0xfe, 0x7d, 0x6a, 0xdf, 0xa2, 0x67, 0xb1, 0x5a, 0x04, 0x4a, 0xe1, 0x36,
-	0xa8, 0xf8, 0x73, 0x64, 0x9a, 0x88, 0xee, 0xbb, 0x7b, 0xe6, 0x93, 0x14, 0xff, 0x77, 0x7f, 0x1a,
-	0x78, 0xcf, 0x40, 0x36, 0x09, 0xbe, 0xe8, 0x3b, 0x0d, 0x54, 0x19, 0x55, 0xa6, 0x7c, 0xe0, 0x38,
-	0x08, 0x81, 0xba, 0x2f, 0x6a, 0x85, 0x7d, 0xcb, 0x73, 0x32, 0xae, 0x1f, 0x13, 0x8f, 0xf3, 0x0b,
-	0x5a, 0xc9, 0x3d, 0xf1, 0x78, 0x9a, 0x70, 0x77, 0x6a, 0x85, 0xfd, 0x85, 0xc3, 0xbd, 0xdc, 0x27,
-	0xed, 0x9e, 0xd9, 0xc5, 0xab, 0x90, 0x7b, 0x06, 0x9a, 0xef, 0x90, 0x1b, 0xaa, 0xc8, 0xcf, 0x8e,
-	0x23, 0xc3, 0x7a, 0x36, 0x4c, 0x3d, 0x2b, 0xa1, 0x8a, 0x4e, 0x47, 0x93, 0x46, 0x5a, 0xd2, 0x2a,
-	0x9a, 0xed, 0xe0, 0x50, 0x03, 0x75, 0x2b, 0x26, 0x6c, 0xb0, 0x72, 0xaa, 0x08, 0x51, 0xe8, 0x4a,
-	0x20, 0x38, 0xf6, 0x7d, 0x65, 0x7c, 0x19, 0x4b, 0xab, 0x68, 0x15, 0xed, 0x27, 0xad, 0xa2, 0xf5,
-	0xc4, 0x9e, 0x6d, 0x15, 0xad, 0x59, 0x7b, 0xae, 0x55, 0xb4, 0xe6, 0x6c, 0xab, 0x55, 0xb4, 0x96,
-	0xec, 0x52, 0xab, 0x68, 0x95, 0x6c, 0xbb, 0x55, 0xb4, 0x6c, 0xbb, 0xfc, 0xf2, 0xf4, 0xc3, 0x6d,
-	0xb5, 0xf0, 0xf1, 0xb6, 0x5a, 0xf8, 0xf7, 0xb6, 0x5a, 0xf8, 0xe3, 0xae, 0x3a, 0xf3, 0xf1, 0xae,
-	0x3a, 0xf3, 0xf7, 0x5d, 0x75, 0xe6, 0xf2, 0xb0, 0xcd, 0x74, 0xa7, 0x17, 0xd4, 0x89, 0x88, 0x1a,
-	0xe7, 0x66, 0xe7, 0x07, 0xa7, 0x38, 0x50, 0x8d, 0xc1, 0x54, 0xf7, 0xf6, 0xe8, 0xa8, 0xd1, 0x1f,
-	0xcd, 0x76, 0xfa, 0xa6, 0x0b, 0x2a, 0x98, 0x35, 0x83, 0xdd, 0xd1, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x85, 0xb4, 0x25, 0x4f, 0x5e, 0x0a, 0x00, 0x00,
+	// 2045 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4f, 0x73, 0x1b, 0xb7,
+	0x15, 0x17, 0x25, 0x5a, 0xa6, 0x9f, 0xfe, 0x90, 0x02, 0x29, 0x7a, 0x25, 0xdb, 0x94, 0x4c, 0xdb,
+	0xad, 0xec, 0xc6, 0x52, 0x22, 0x3b, 0xd3, 0x99, 0x9e, 0x2a, 0x99, 0xb2, 0x2d, 0x55, 0x71, 0x35,
+	0x2b, 0x25, 0x6d, 0x3d, 0x93, 0xd9, 0x82, 0xbb, 0xd0, 0x12, 0xf1, 0x2e, 0xc0, 0x2e, 0xb0, 0x36,
+	0xd5, 0x4b, 0xaf, 0x3d, 0xf6, 0x1b, 0x74, 0x3a, 0xfd, 0x0a, 0x39, 0xf6, 0xde, 0x1c, 0x33, 0x99,
+	0x1e, 0x3a, 0x3d, 0x64, 0x3a, 0xf6, 0x77, 0xe8, 0xf4, 0xd6, 0x0e, 0x80, 0x5d, 0x72, 0xf9, 0x2f,
+	0x54, 0xe8, 0x9c, 0xa4, 0xc5, 0xc3, 0xfb, 0xfd, 0x80, 0xf7, 0x1e, 0x1e, 0xde, 0x03, 0x61, 0x43,
+	0xc8, 0x88, 0x7a, 0x64, 0x47, 0x48, 0xfc, 0x8a, 0xd0, 0xa6, 0xbb, 0xd3, 0xe2, 0x42, 0x3a, 0xbf,
+	0xe7, 0x8c, 0x6c, 0xb7, 0x23, 0x2e, 0x39, 0x2a, 0x9a, 0x09, 0xdb, 0xe9, 0x84, 0xf5, 0x35, 0x97,
+	0x8b, 0x90, 0x0b, 0x47, 0x8b, 0x77, 0xcc, 0x87, 0x99, 0xbb, 0x5e, 0xf1, 0xb9, 0xcf, 0xcd, 0xb8,
+	0xfa, 0x2f, 0x19, 0x1d, 0xa2, 0x78, 0x8d, 0x03, 0xea, 0x61, 0xc9, 0x23, 0x33, 0xa1, 0xfe, 0xb7,
+	0x1c, 0x94, 0x9f, 0xf0, 0x30, 0x8c, 0x19, 0x95, 0x17, 0x27, 0x9c, 0x07, 0x36, 0x69, 0x62, 0x49,
+	0x50, 0x03, 0x16, 0x22, 0xfd, 0x9f, 0x13, 0x61, 0x49, 0xac, 0xdc, 0x66, 0x6e, 0xeb, 0xda, 0xfe,
+	0x9d, 0xaf, 0xbe, 0xdd, 0x98, 0xf9, 0xd7, 0xb7, 0x1b, 0x37, 0x0c, 0xb3, 0xf0, 0x5e, 0x6d, 0x53,
+	0xbe, 0x13, 0x62, 0xd9, 0xda, 0x3e, 0x26, 0x3e, 0x76, 0x2f, 0x1a, 0xc4, 0xb5, 0xc1, 0xe8, 0xd9,
+	0x0a, 0xc5, 0x81, 0x5b, 0x01, 0xfd, 0x5d, 0x4c, 0x3d, 0x47, 0x2f, 0x40, 0xfd, 0x71, 0x24, 0x7f,
+	0x45, 0x98, 0x83, 0x43, 0x1e, 0x33, 0x69, 0xcd, 0x6a, 0xdc, 0x5b, 0x09, 0xee, 0xea, 0x30, 0xee,
+	0x21, 0x93, 0xf6, 0x9a, 0xc1, 0x38, 0xd5, 0x10, 0xa7, 0xf2, 0x4c, 0x01, 0xec, 0x69, 0xfd, 0xfa,
+	0x3f, 0xe6, 0xe0, 0xe6, 0x5e, 0x2c, 0xf9, 0x67, 0xe9, 0xb6, 0x7e, 0x45, 0xa8, 0xdf, 0x92, 0x94,
+	0xf9, 0x4f, 0x38, 0x3b, 0xa7, 0x3e, 0xda, 0x80, 0x85, 0x26, 0x16, 0xc4, 0x79, 0xa3, 0xc7, 0xf5,
+	0x3e, 0xf2, 0x36, 0xa8, 0x21, 0x33, 0x13, 0x61, 0x28, 0x87, 0xb8, 0xe3, 0xb8, 0x3c, 0x0c, 0xa9,
+	0x10, 0x94, 0x33, 0xb3, 0x61, 0xb3, 0xb0, 0x8f, 0x2e, 0xb1, 0xe1, 0x6f, 0xbe, 0x7c, 0x08, 0x89,
+	0x27, 0xd4, 0xf6, 0x57, 0x42, 0xdc, 0x79, 0xd2, 0x05, 0xd3, 0x56, 0xf8, 0x2d, 0xa0, 0x0c, 0x7c,
+	0x9b, 0x30, 0x1c, 0xc8, 0x0b, 0x6b, 0x6e, 0x6a, 0x86, 0x1e, 0xd8, 0x89, 0xc1, 0x42, 0x9f, 0xc1,
+	0x92, 0x08, 0xb0, 0x68, 0x75, 0xc1, 0xf3, 0xd3, 0x82, 0x2f, 0x6a, 0x9c, 0x14, 0xf7, 0x35, 0x6c,
+	0x28, 0xe3, 0x88, 0x16, 0x8e, 0x88, 0x70, 0x24, 0x37, 0xce, 0x13, 0xda, 0x44, 0x8e, 0x17, 0xd1,
+	0x73, 0x69, 0x5d, 0x99, 0x96, 0x69, 0x3d, 0xc4, 0x9d, 0x53, 0x0d, 0x7c, 0xc6, 0xb5, 0x4b, 0x85,
+	0x32, 0x56, 0x43, 0x81, 0xd6, 0xff, 0x37, 0x0b, 0xd7, 0x0f, 0x99, 0x90, 0x98, 0x49, 0x9b, 0x78,
+	0x24, 0x6c, 0x4b, 0xca, 0x59, 0xe2, 0x51, 0x0a, 0xd7, 0x3d, 0xd2, 0xe6, 0x82, 0x4a, 0x07, 0x07,
+	0x01, 0x77, 0xb1, 0xec, 0x3a, 0x2d, 0x37, 0xed, 0x5a, 0x56, 0x13, 0xc4, 0xbd, 0x2e, 0xa0, 0x76,
+	0xdc, 0x2f, 0xa1, 0x22, 0x71, 0xe4, 0x13, 0xe9, 0x34, 0xe3, 0xf3, 0x73, 0x12, 0x7d, 0xaf, 0xa8,
+	0x45, 0x46, 0x75, 0x5f, 0x6b, 0x9a, 0x70, 0x45, 0xa7, 0xb0, 0x18, 0x52, 0xe6, 0x9c, 0x93, 0xe4,
+	0x58, 0x4d, 0x1d, 0x03, 0x10, 0x52, 0xf6, 0x94, 0x98, 0x43, 0xa6, 0x40, 0x71, 0xa7, 0x07, 0x9a,
+	0x9f, 0x1e, 0x14, 0x77, 0x12, 0xd0, 0xfa, 0x9f, 0x67, 0xa1, 0x7c, 0xc8, 0x44, 0x1c, 0x61, 0xe6,
+	0x92, 0xa7, 0x31, 0xf3, 0x12, 0xeb, 0xfb, 0x50, 0x8d, 0xc8, 0x1b, 0x1c, 0x79, 0x3f, 0x9c, 0xf1,
+	0x2b, 0x06, 0x70, 0xc0, 0xf6, 0xbf, 0x80, 0xc4, 0x80, 0xce, 0x79, 0xcc, 0xbc, 0xef, 0x65, 0xf9,
+	0x92, 0x51, 0x54, 0xab, 0x4e, 0xec, 0x6e, 0x43, 0xd5, 0x1c, 0xf2, 0xd7, 0x24, 0xc2, 0x3e, 0x71,
+	0xda, 0x24, 0x72, 0x74, 0xa0, 0x5b, 0x73, 0x97, 0x01, 0x2c, 0xeb, 0x33, 0x6d, 0x74, 0x4f, 0x48,
+	0x74, 0xaa, 0x34, 0xeb, 0x7f, 0x9f, 0x85, 0xf2, 0x73, 0x2e, 0xe4, 0x4b, 0xce, 0xc8, 0x53, 0x42,
+	0x4e, 0xdd, 0x16, 0xf1, 0xe2, 0x80, 0x64, 0x2c, 0x34, 0x98, 0x53, 0xde, 0xd7, 0x42, 0x03, 0x69,
+	0xc5, 0x83, 0xd5, 0x6c, 0x72, 0xed, 0x05, 0xc0, 0xd4, 0xb9, 0x0b, 0x65, 0x12, 0x6d, 0x1a, 0x5d,
+	0x18, 0xca, 0x51, 0xf7, 0x08, 0xfe, 0x00, 0x91, 0xbb, 0xd2, 0x43, 0x4b, 0x63, 0xed, 0x2f, 0x39,
+	0xb0, 0xba, 0x09, 0x7c, 0x3f, 0xc0, 0xee, 0xab, 0x80, 0x0a, 0x79, 0xc2, 0x03, 0xea, 0x5e, 0xa0,
+	0x97, 0x50, 0x34, 0xa9, 0x4d, 0xb6, 0x22, 0x22, 0x5a, 0x3c, 0xf0, 0xa6, 0xb7, 0xe3, 0xb2, 0x46,
+	0x3a, 0x4b, 0x81, 0xd0, 0x7d, 0x28, 0x35, 0x53, 0x3a, 0xe7, 0x0b, 0x4c, 0x03, 0xe2, 0x69, 0xe3,
+	0x15, 0xec, 0x62, 0x77, 0xfc, 0x48, 0x0f, 0xd7, 0xff, 0x00, 0x6b, 0xbd, 0x4c, 0xa4, 0x56, 0xfd,
+	0x2c, 0xc6, 0x51, 0x7a, 0x28, 0x1e, 0x2b, 0x97, 0x8b, 0x38, 0x24, 0x8e, 0xcb, 0x79, 0xe0, 0xf1,
+	0x37, 0xcc, 0x21, 0x6d, 0xee, 0xb6, 0x44, 0x72, 0xdf, 0x54, 0x8c, 0xf4, 0x49, 0x22, 0x3c, 0xd0,
+	0x32, 0xf4, 0x01, 0x20, 0x1c, 0x4b, 0xee, 0x24, 0xaa, 0x89, 0xc6, 0xac, 0xd6, 0x28, 0x29, 0x89,
+	0xad, 0x05, 0x66, 0x76, 0xfd, 0xbf, 0x73, 0x60, 0x8d, 0x58, 0xc1, 0xa9, 0x54, 0x4e, 0xda, 0x87,
+	0x79, 0x21, 0xb1, 0x8c, 0x0d, 0xe1, 0xf2, 0xee, 0x83, 0xed, 0x81, 0xca, 0x61, 0x7b, 0x8c, 0x6a,
+	0x2c, 0xec, 0x44, 0x13, 0x55, 0x61, 0x3e, 0x22, 0x58, 0x70, 0x66, 0xe2, 0xc7, 0x4e, 0xbe, 0x54,
+	0xbe, 0x95, 0x11, 0xf5, 0x7d, 0x12, 0x39, 0x99, 0x40, 0x78, 0xbf, 0x20, 0x58, 0x4d, 0x10, 0xfb,
+	0x57, 0x85, 0x3e, 0x87, 0x95, 0x94, 0x4a, 0xa5, 0xc9, 0x26, 0x8f, 0x99, 0x37, 0x7d, 0x3a, 0x2b,
+	0x26, 0x58, 0x9f, 0x50, 0xb6, 0xaf, 0x90, 0xfa, 0xe0, 0x71, 0x27, 0x81, 0xbf, 0xf2, 0xde, 0xf0,
+	0xb8, 0x63, 0xe0, 0x3f, 0x84, 0x8a, 0x8c, 0x68, 0xbb, 0x4d, 0x3c, 0xe3, 0x4b, 0x87, 0xc5, 0x61,
+	0x93, 0x44, 0xd6, 0xbc, 0xf6, 0x28, 0x4a, 0x64, 0xda, 0x9d, 0x2f, 0xb4, 0x04, 0xdd, 0x83, 0xe5,
+	0x16, 0xc1, 0x81, 0x6c, 0x5d, 0xa4, 0xde, 0xbf, 0xaa, 0xe7, 0x2e, 0x25, 0xa3, 0x89, 0xeb, 0xff,
+	0x73, 0x03, 0x0a, 0x69, 0xa6, 0x41, 0x6b, 0x50, 0x70, 0x5b, 0x98, 0x32, 0x87, 0x26, 0x07, 0xc1,
+	0xbe, 0xaa, 0xbf, 0x0f, 0x3d, 0x54, 0x87, 0xc5, 0x26, 0x71, 0x5b, 0x8f, 0x76, 0xdb, 0x11, 0x39,
+	0xa7, 0x1d, 0x6b, 0x45, 0x8b, 0xfb, 0xc6, 0xd0, 0x1d, 0x58, 0x72, 0x39, 0x63, 0xc4, 0xd5, 0x5e,
+	0xa4, 0x5e, 0xe2, 0xec, 0xc5, 0xde, 0xe0, 0xa1, 0x87, 0xb6, 0xa1, 0x2c, 0x23, 0xcc, 0x84, 0xba,
+	0xf2, 0xdc, 0x16, 0x66, 0x8c, 0x04, 0x6a, 0xea, 0xa2, 0x9e, 0xba, 0x92, 0x8a, 0x9e, 0x18, 0xc9,
+	0xa1, 0x87, 0x6e, 0xc0, 0x35, 0xda, 0x74, 0x1d, 0x8f, 0x30, 0x1e, 0x5a, 0x05, 0x3d, 0xab, 0x40,
+	0x9b, 0x6e, 0x43, 0x7d, 0xa3, 0x5b, 0x00, 0xba, 0xae, 0x35, 0xd2, 0x6b, 0x5a, 0x7a, 0x4d, 0x8d,
+	0x18, 0xf1, 0x7d, 0x28, 0xc5, 0xac, 0xc9, 0x99, 0x47, 0x99, 0xaf, 0xf2, 0x32, 0xe5, 0x9e, 0xb5,
+	0xae, 0xad, 0x50, 0xec, 0x8e, 0x9f, 0xe8, 0x61, 0xf4, 0x33, 0x80, 0x6e, 0xf9, 0x2a, 0xac, 0xb9,
+	0xcd, 0xb9, 0xad, 0x85, 0xdd, 0xf5, 0xa1, 0x48, 0xef, 0x66, 0x12, 0x3b, 0x33, 0x1b, 0xed, 0x41,
+	0xb1, 0x5b, 0x35, 0x78, 0x5e, 0x44, 0x84, 0xb0, 0x90, 0xf6, 0xbc, 0xf5, 0xcd, 0x97, 0x0f, 0x2b,
+	0x89, 0x5b, 0xf7, 0x8c, 0xe4, 0x54, 0x46, 0x94, 0xf9, 0xf6, 0x72, 0x5a, 0x14, 0x98, 0x51, 0xf4,
+	0x02, 0xaa, 0x6f, 0xa8, 0x6c, 0x79, 0x11, 0x7e, 0x83, 0x03, 0x87, 0xba, 0xb8, 0x8b, 0x54, 0x9d,
+	0x80, 0x54, 0xe9, 0xe9, 0x1d, 0xba, 0x38, 0xc5, 0xfb, 0x39, 0x14, 0x55, 0x3a, 0xcd, 0x02, 0x5d,
+	0x9f, 0x00, 0xb4, 0x74, 0x4e, 0x48, 0x06, 0xe1, 0x05, 0x54, 0x3d, 0x12, 0x10, 0xdf, 0xdc, 0xc2,
+	0x59, 0x20, 0x6b, 0xd2, 0x8a, 0x7a, 0x7a, 0xfd, 0x78, 0x99, 0x23, 0x9e, 0xc5, 0x5b, 0x9b, 0x84,
+	0xd7, 0xd3, 0xcb, 0xe0, 0x79, 0x50, 0x77, 0xd3, 0xde, 0xc2, 0x69, 0x73, 0x1e, 0x38, 0xa9, 0x0f,
+	0xb2, 0xd8, 0xb5, 0x09, 0xd8, 0x35, 0x37, 0xdb, 0x9f, 0x34, 0x0c, 0x42, 0x86, 0xa5, 0x09, 0xb7,
+	0x07, 0x58, 0x22, 0x22, 0xe3, 0xa8, 0x7f, 0x03, 0x1b, 0x13, 0x48, 0x6e, 0xb9, 0xfd, 0x4d, 0x90,
+	0x02, 0xc8, 0x70, 0xb4, 0xe0, 0xee, 0x00, 0x87, 0xb9, 0x73, 0xd5, 0x35, 0xa2, 0x02, 0x37, 0xa5,
+	0xd9, 0x9c, 0x40, 0xb3, 0xd9, 0x47, 0xa3, 0x2f, 0xda, 0xe7, 0x06, 0x22, 0x65, 0xfa, 0x02, 0xee,
+	0x0d, 0xed, 0xc6, 0x23, 0x24, 0x1c, 0xa2, 0xba, 0x3d, 0x81, 0xea, 0xf6, 0xc0, 0x8e, 0x14, 0xc8,
+	0x00, 0x97, 0x03, 0x1b, 0x03, 0x5c, 0x52, 0x25, 0xfd, 0x38, 0xba, 0xe8, 0xb2, 0xdc, 0x99, 0xc0,
+	0x72, 0xb3, 0x8f, 0xe5, 0x2c, 0x51, 0x4f, 0x09, 0x8e, 0x60, 0x45, 0x72, 0x89, 0x03, 0xa7, 0x17,
+	0x6e, 0xc2, 0x5a, 0xba, 0x5c, 0x0d, 0xa7, 0xf4, 0x1a, 0x3d, 0x35, 0xe4, 0x42, 0x25, 0xc0, 0x42,
+	0x0e, 0x5d, 0x42, 0x30, 0x7d, 0xb5, 0x83, 0x85, 0x1c, 0xb8, 0x81, 0x5e, 0x42, 0x71, 0x10, 0x7f,
+	0x61, 0xea, 0x6a, 0x23, 0xea, 0xc7, 0x56, 0x9d, 0x26, 0x65, 0x43, 0xeb, 0xaf, 0x4c, 0xdf, 0x69,
+	0x52, 0x66, 0x0f, 0x53, 0xe0, 0xce, 0x10, 0xc5, 0xea, 0xfb, 0x34, 0xb3, 0x03, 0x14, 0x01, 0xac,
+	0xa9, 0x5d, 0x50, 0xc6, 0x46, 0x14, 0x04, 0x37, 0xa7, 0x25, 0xaa, 0x86, 0x94, 0x1d, 0x2a, 0xc8,
+	0x11, 0x6c, 0xb8, 0x33, 0x86, 0xed, 0xd6, 0xf4, 0x6c, 0xb8, 0x33, 0x8a, 0xed, 0x31, 0x5c, 0x57,
+	0x6c, 0x21, 0x11, 0x02, 0xfb, 0x44, 0xe8, 0x36, 0x41, 0x25, 0x11, 0xd9, 0xb1, 0xee, 0xea, 0x2b,
+	0x49, 0x59, 0xf7, 0x93, 0x44, 0x7a, 0x42, 0xa2, 0x43, 0x17, 0x9f, 0x75, 0xd0, 0x4e, 0xb6, 0x42,
+	0x16, 0x0e, 0x61, 0xb8, 0xa9, 0x0a, 0xc9, 0x7b, 0xba, 0x90, 0x44, 0x19, 0xd1, 0x81, 0x91, 0xa0,
+	0x5f, 0xc3, 0xea, 0xd0, 0x11, 0x57, 0x4f, 0x26, 0x56, 0x7d, 0x33, 0xb7, 0xb5, 0xb0, 0x7b, 0x77,
+	0xe8, 0x4a, 0x1b, 0xf1, 0x40, 0x63, 0x97, 0xdd, 0xe1, 0x41, 0xf4, 0x53, 0xb0, 0x02, 0x11, 0x3a,
+	0x7d, 0x6d, 0x41, 0xba, 0x9e, 0x1b, 0x7a, 0x3d, 0xab, 0x81, 0x08, 0x8f, 0x7b, 0x55, 0x7e, 0xba,
+	0xa4, 0x2a, 0xcc, 0xb7, 0x70, 0x20, 0x89, 0x67, 0x95, 0xf5, 0xb4, 0xe4, 0x0b, 0xd5, 0x00, 0x3c,
+	0xd2, 0x8e, 0x88, 0x8b, 0x95, 0xec, 0x47, 0x5a, 0x96, 0x19, 0x41, 0x3e, 0x58, 0xba, 0x86, 0xed,
+	0xde, 0xb4, 0xc9, 0x43, 0x0b, 0x65, 0xbe, 0xf5, 0x63, 0xbd, 0x9b, 0x87, 0x43, 0xbb, 0xf9, 0xae,
+	0xf7, 0x1a, 0xbb, 0x8a, 0x47, 0x4a, 0x91, 0x07, 0x6b, 0xd4, 0x3c, 0x08, 0x64, 0xc3, 0xc0, 0xd5,
+	0x4a, 0xd6, 0x96, 0x66, 0xda, 0x1a, 0x62, 0x1a, 0xf3, 0x84, 0x60, 0x5f, 0xa7, 0xa3, 0x05, 0xc8,
+	0x85, 0xdb, 0x23, 0x58, 0xd2, 0xe6, 0x3f, 0x49, 0x89, 0xf7, 0x27, 0xdd, 0x57, 0x43, 0xe8, 0xc9,
+	0x1b, 0x40, 0xf7, 0x2e, 0xf9, 0x0e, 0x92, 0x26, 0x0e, 0x54, 0xc7, 0x6d, 0x3d, 0xb8, 0x4c, 0x92,
+	0x1c, 0xc7, 0xb4, 0x6f, 0x40, 0xd0, 0x33, 0x58, 0x54, 0x15, 0x86, 0x48, 0x5a, 0x53, 0xeb, 0x27,
+	0x63, 0xe2, 0x6b, 0x44, 0x1b, 0x6b, 0x2f, 0x9c, 0xf7, 0xf5, 0xb4, 0xeb, 0x3d, 0x0f, 0xf7, 0x5a,
+	0xa6, 0xb6, 0x6e, 0xd1, 0xac, 0x0f, 0x34, 0xec, 0xfd, 0xf1, 0x95, 0xd8, 0x40, 0x4f, 0x67, 0x5b,
+	0xaf, 0xc7, 0x48, 0xd0, 0xc7, 0x50, 0xed, 0xc2, 0x13, 0xcf, 0xc9, 0x94, 0x7b, 0x0f, 0x37, 0xe7,
+	0xb6, 0xae, 0xd9, 0xab, 0x19, 0x69, 0x17, 0x5e, 0xa0, 0x57, 0x70, 0x73, 0x20, 0x39, 0x38, 0x7e,
+	0x6c, 0x5a, 0x70, 0x1d, 0x20, 0xdb, 0x7a, 0x85, 0x97, 0xea, 0x8a, 0x92, 0x10, 0x59, 0x8b, 0xc6,
+	0x89, 0xd0, 0xe7, 0xb0, 0x3a, 0x92, 0xcc, 0xda, 0x19, 0x63, 0x87, 0x71, 0x6d, 0x9b, 0x5d, 0x1e,
+	0x41, 0x82, 0xee, 0xc0, 0xb2, 0x4e, 0x79, 0x3a, 0xef, 0x38, 0x3e, 0x16, 0xd6, 0x87, 0x3a, 0xf7,
+	0x2c, 0xa8, 0xa4, 0xa5, 0x12, 0xce, 0x33, 0x2c, 0x54, 0x0a, 0xa1, 0xe9, 0xeb, 0x8c, 0x79, 0x20,
+	0x49, 0x76, 0xfa, 0xd1, 0x18, 0x17, 0x8f, 0x78, 0xcb, 0xb1, 0xcb, 0x74, 0x78, 0x50, 0xd5, 0x80,
+	0x03, 0xc8, 0x69, 0xdc, 0xef, 0x4e, 0xaa, 0x01, 0xfb, 0xe0, 0xd2, 0x68, 0xe7, 0x70, 0x37, 0x22,
+	0x2e, 0x67, 0x2e, 0x0d, 0xa8, 0xa9, 0x53, 0x3d, 0x2a, 0xdc, 0x88, 0xb4, 0x31, 0x73, 0x2f, 0x32,
+	0x4d, 0xfd, 0xa3, 0xcb, 0x04, 0x7c, 0xbd, 0x1f, 0xaa, 0xd1, 0x43, 0xea, 0x35, 0xf5, 0x2f, 0xa0,
+	0xa2, 0xec, 0x67, 0x9a, 0x07, 0x9d, 0xc2, 0x75, 0x6f, 0x65, 0x3d, 0xbe, 0x0c, 0x81, 0xba, 0xf0,
+	0x3e, 0xd5, 0x9a, 0x27, 0x24, 0xd2, 0xed, 0x97, 0x7a, 0x04, 0x34, 0xd7, 0x76, 0x72, 0x34, 0xd3,
+	0xa7, 0xa8, 0x8f, 0x2f, 0x83, 0x87, 0xf4, 0x1d, 0x9d, 0x68, 0x9a, 0xc7, 0xa8, 0xa3, 0x7c, 0x21,
+	0x5f, 0xba, 0x72, 0x94, 0x2f, 0x5c, 0x29, 0xcd, 0x1f, 0xe5, 0x0b, 0xf3, 0xa5, 0xab, 0x47, 0xf9,
+	0xc2, 0xd5, 0x52, 0xe1, 0x28, 0x5f, 0x58, 0x2e, 0x15, 0x8f, 0xf2, 0x85, 0x62, 0xa9, 0x74, 0x94,
+	0x2f, 0x94, 0x4a, 0x2b, 0x0f, 0x5e, 0xc2, 0xda, 0x98, 0xd8, 0x89, 0x05, 0x5a, 0x81, 0xa5, 0x67,
+	0x9f, 0xee, 0xd9, 0x0d, 0xe7, 0xf9, 0xc1, 0xde, 0xf1, 0xd9, 0xf3, 0xdf, 0x94, 0x66, 0x10, 0x82,
+	0x65, 0x33, 0xd4, 0x38, 0x78, 0x66, 0xef, 0x35, 0x0e, 0x1a, 0xa5, 0x1c, 0x2a, 0xc1, 0x62, 0x32,
+	0x6d, 0xef, 0xf8, 0xec, 0xa0, 0x51, 0x9a, 0x5d, 0xcf, 0xff, 0xf1, 0xaf, 0xb5, 0x99, 0xfd, 0xe3,
+	0xaf, 0xde, 0xd6, 0x72, 0x5f, 0xbf, 0xad, 0xe5, 0xfe, 0xfd, 0xb6, 0x96, 0xfb, 0xd3, 0xbb, 0xda,
+	0xcc, 0xd7, 0xef, 0x6a, 0x33, 0xff, 0x7c, 0x57, 0x9b, 0x79, 0xb9, 0xeb, 0x53, 0xd9, 0x8a, 0x9b,
+	0xdb, 0x2e, 0x0f, 0x77, 0x4e, 0x75, 0x18, 0x3d, 0x3c, 0xc6, 0x4d, 0xb1, 0x93, 0xfc, 0x94, 0xf0,
+	0xfa, 0xd1, 0xa3, 0x9d, 0x4e, 0xef, 0x07, 0x05, 0x79, 0xd1, 0x26, 0xa2, 0x39, 0xaf, 0x7f, 0x4d,
+	0x78, 0xf4, 0xff, 0x01, 0x00, 0x7c, 0xf8, 0x5c, 0xd0, 0xd3, 0x18, 0x00, 0x00,
 }
 
 func (m *CommunityPoolRebate) Marshal() (dAtA []byte, err error) {
@@ -471,7 +1092,7 @@ func (m *CommunityPoolRebate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HostZone) Marshal() (dAtA []byte, err error) {
+func (m *AutoValidatorWeightingConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -481,498 +1102,2871 @@ func (m *HostZone) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HostZone) MarshalTo(dAtA []byte) (int, error) {
+func (m *AutoValidatorWeightingConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AutoValidatorWeightingConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Deprecated {
-		i--
-		if m.Deprecated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xb0
-	}
-	if m.RedemptionsEnabled {
-		i--
-		if m.RedemptionsEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xa8
-	}
-	if m.MaxMessagesPerIcaTx != 0 {
-		i = encodeVarintHostZone(dAtA, i, uint64(m.MaxMessagesPerIcaTx))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xa0
-	}
-	if len(m.CommunityPoolTreasuryAddress) > 0 {
-		i -= len(m.CommunityPoolTreasuryAddress)
-		copy(dAtA[i:], m.CommunityPoolTreasuryAddress)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.CommunityPoolTreasuryAddress)))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.CommunityPoolRebate != nil {
-		{
-			size, err := m.CommunityPoolRebate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintHostZone(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.CommunityPoolRedeemHoldingAddress) > 0 {
-		i -= len(m.CommunityPoolRedeemHoldingAddress)
-		copy(dAtA[i:], m.CommunityPoolRedeemHoldingAddress)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.CommunityPoolRedeemHoldingAddress)))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x8a
-	}
-	if len(m.CommunityPoolStakeHoldingAddress) > 0 {
-		i -= len(m.CommunityPoolStakeHoldingAddress)
-		copy(dAtA[i:], m.CommunityPoolStakeHoldingAddress)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.CommunityPoolStakeHoldingAddress)))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x82
-	}
-	if len(m.CommunityPoolReturnIcaAddress) > 0 {
-		i -= len(m.CommunityPoolReturnIcaAddress)
-		copy(dAtA[i:], m.CommunityPoolReturnIcaAddress)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.CommunityPoolReturnIcaAddress)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xfa
-	}
-	if len(m.CommunityPoolDepositIcaAddress) > 0 {
-		i -= len(m.CommunityPoolDepositIcaAddress)
-		copy(dAtA[i:], m.CommunityPoolDepositIcaAddress)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.CommunityPoolDepositIcaAddress)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf2
-	}
 	{
-		size := m.MaxInnerRedemptionRate.Size()
+		size := m.MaxSharesToTokensRateDrift.Size()
 		i -= size
-		if _, err := m.MaxInnerRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxSharesToTokensRateDrift.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xea
+	dAtA[i] = 0x2a
 	{
-		size := m.MinInnerRedemptionRate.Size()
+		size := m.SlashPenalty.Size()
 		i -= size
-		if _, err := m.MinInnerRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SlashPenalty.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xe2
-	if m.LsmLiquidStakeEnabled {
-		i--
-		if m.LsmLiquidStakeEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd8
-	}
-	if m.UnbondingPeriod != 0 {
-		i = encodeVarintHostZone(dAtA, i, uint64(m.UnbondingPeriod))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd0
-	}
-	if len(m.RedemptionIcaAddress) > 0 {
-		i -= len(m.RedemptionIcaAddress)
-		copy(dAtA[i:], m.RedemptionIcaAddress)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.RedemptionIcaAddress)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
-	}
-	if len(m.DelegationIcaAddress) > 0 {
-		i -= len(m.DelegationIcaAddress)
-		copy(dAtA[i:], m.DelegationIcaAddress)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.DelegationIcaAddress)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
-	}
-	if len(m.FeeIcaAddress) > 0 {
-		i -= len(m.FeeIcaAddress)
-		copy(dAtA[i:], m.FeeIcaAddress)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.FeeIcaAddress)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
-	}
-	if len(m.WithdrawalIcaAddress) > 0 {
-		i -= len(m.WithdrawalIcaAddress)
-		copy(dAtA[i:], m.WithdrawalIcaAddress)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.WithdrawalIcaAddress)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
-	}
+	dAtA[i] = 0x22
 	{
-		size := m.MaxRedemptionRate.Size()
+		size := m.CommissionPenalty.Size()
 		i -= size
-		if _, err := m.MaxRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CommissionPenalty.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
+	dAtA[i] = 0x1a
 	{
-		size := m.MinRedemptionRate.Size()
+		size := m.MaxCommissionRate.Size()
 		i -= size
-		if _, err := m.MinRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xa2
-	if m.Halted {
-		i--
-		if m.Halted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
-	}
-	if len(m.DepositAddress) > 0 {
-		i -= len(m.DepositAddress)
-		copy(dAtA[i:], m.DepositAddress)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.DepositAddress)))
-		i--
-		dAtA[i] = 0x1
+	dAtA[i] = 0x12
+	if m.BaseWeight != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.BaseWeight))
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x8
 	}
-	if len(m.Bech32Prefix) > 0 {
-		i -= len(m.Bech32Prefix)
-		copy(dAtA[i:], m.Bech32Prefix)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.Bech32Prefix)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return len(dAtA) - i, nil
+}
+
+func (m *InstantRedemptionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InstantRedemptionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstantRedemptionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.TotalDelegations.Size()
+		size := m.MaxFeeRate.Size()
 		i -= size
-		if _, err := m.TotalDelegations.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxFeeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x6a
-	if len(m.TransferChannelId) > 0 {
-		i -= len(m.TransferChannelId)
-		copy(dAtA[i:], m.TransferChannelId)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.TransferChannelId)))
-		i--
-		dAtA[i] = 0x62
+	dAtA[i] = 0x22
+	{
+		size := m.MinFeeRate.Size()
+		i -= size
+		if _, err := m.MinFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	{
-		size := m.RedemptionRate.Size()
+		size := m.TargetBufferAmount.Size()
 		i -= size
-		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TargetBufferAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x5a
+	dAtA[i] = 0x12
 	{
-		size := m.LastRedemptionRate.Size()
+		size := m.DepositAllocationRate.Size()
 		i -= size
-		if _, err := m.LastRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DepositAllocationRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x52
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x4a
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.IbcDenom) > 0 {
-		i -= len(m.IbcDenom)
-		copy(dAtA[i:], m.IbcDenom)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.IbcDenom)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintHostZone(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxCoveragePerSlash.Size()
+		i -= size
+		if _, err := m.MaxCoveragePerSlash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TargetFundAmount.Size()
+		i -= size
+		if _, err := m.TargetFundAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintHostZone(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RewardAllocationRate.Size()
+		i -= size
+		if _, err := m.RewardAllocationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintHostZone(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHostZone(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HostZoneFeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CommunityPoolRebate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *HostZoneFeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZoneFeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.RebateRate.Size()
-	n += 1 + l + sovHostZone(uint64(l))
-	l = m.LiquidStakedStTokenAmount.Size()
-	n += 1 + l + sovHostZone(uint64(l))
-	return n
+	{
+		size := m.RedemptionFeeRate.Size()
+		i -= size
+		if _, err := m.RedemptionFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidStakeFeeRate.Size()
+		i -= size
+		if _, err := m.LiquidStakeFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RewardCommissionRate.Size()
+		i -= size
+		if _, err := m.RewardCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *HostZone) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorBlacklistPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBlacklistPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBlacklistPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovHostZone(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovHostZone(uint64(l))
+	if m.BlacklistJailed {
+		i--
+		if m.BlacklistJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovHostZone(uint64(l))
+	{
+		size := m.SlashThreshold.Size()
+		i -= size
+		if _, err := m.SlashThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovHostZone(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedemptionRateGuardConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovHostZone(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRateGuardConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRateGuardConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoResumeEpochs != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.AutoResumeEpochs))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.LastRedemptionRate.Size()
-	n += 1 + l + sovHostZone(uint64(l))
-	l = m.RedemptionRate.Size()
-	n += 1 + l + sovHostZone(uint64(l))
-	l = len(m.TransferChannelId)
-	if l > 0 {
-		n += 1 + l + sovHostZone(uint64(l))
+	if m.ResumeCooldownEpochs != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.ResumeCooldownEpochs))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.TotalDelegations.Size()
-	n += 1 + l + sovHostZone(uint64(l))
-	l = len(m.Bech32Prefix)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *RedemptionRateGuardState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.DepositAddress)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRateGuardState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRateGuardState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HealthyEpochs != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.HealthyEpochs))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Halted {
-		n += 3
+	if m.TrippedEpochNumber != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.TrippedEpochNumber))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.MinRedemptionRate.Size()
-	n += 2 + l + sovHostZone(uint64(l))
-	l = m.MaxRedemptionRate.Size()
-	n += 2 + l + sovHostZone(uint64(l))
-	l = len(m.WithdrawalIcaAddress)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	{
+		size := m.TriggerMaxBound.Size()
+		i -= size
+		if _, err := m.TriggerMaxBound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
-	l = len(m.FeeIcaAddress)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TriggerMinBound.Size()
+		i -= size
+		if _, err := m.TriggerMinBound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
-	l = len(m.DelegationIcaAddress)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TriggerRedemptionRate.Size()
+		i -= size
+		if _, err := m.TriggerRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
-	l = len(m.RedemptionIcaAddress)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UnbondingPeriod != 0 {
-		n += 2 + sovHostZone(uint64(m.UnbondingPeriod))
+	if m.Status != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.LsmLiquidStakeEnabled {
-		n += 3
+	return len(dAtA) - i, nil
+}
+
+func (m *HostZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.MinInnerRedemptionRate.Size()
-	n += 2 + l + sovHostZone(uint64(l))
-	l = m.MaxInnerRedemptionRate.Size()
-	n += 2 + l + sovHostZone(uint64(l))
-	l = len(m.CommunityPoolDepositIcaAddress)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *HostZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinRebalanceAmount.Size()
+		i -= size
+		if _, err := m.MinRebalanceAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
-	l = len(m.CommunityPoolReturnIcaAddress)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MaxUnbondPerEpoch.Size()
+		i -= size
+		if _, err := m.MaxUnbondPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
-	l = len(m.CommunityPoolStakeHoldingAddress)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.ReconciliationDiscrepancyThreshold.Size()
+		i -= size
+		if _, err := m.ReconciliationDiscrepancyThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
-	l = len(m.CommunityPoolRedeemHoldingAddress)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x9a
+	if len(m.InsuranceFundAddress) > 0 {
+		i -= len(m.InsuranceFundAddress)
+		copy(dAtA[i:], m.InsuranceFundAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.InsuranceFundAddress)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.CommunityPoolRebate != nil {
-		l = m.CommunityPoolRebate.Size()
-		n += 2 + l + sovHostZone(uint64(l))
+	if m.InsuranceFundConfig != nil {
+		{
+			size, err := m.InsuranceFundConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
 	}
-	l = len(m.CommunityPoolTreasuryAddress)
-	if l > 0 {
-		n += 2 + l + sovHostZone(uint64(l))
+	if m.MaxIcaTxGas != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.MaxIcaTxGas))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.MaxMessagesPerIcaTx != 0 {
-		n += 2 + sovHostZone(uint64(m.MaxMessagesPerIcaTx))
+	if m.RedemptionRateGuard != nil {
+		{
+			size, err := m.RedemptionRateGuard.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
 	}
-	if m.RedemptionsEnabled {
-		n += 3
+	if m.RedemptionRateGuardConfig != nil {
+		{
+			size, err := m.RedemptionRateGuardConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
 	}
-	if m.Deprecated {
-		n += 3
+	if len(m.BlacklistedValidators) > 0 {
+		for iNdEx := len(m.BlacklistedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlacklistedValidators[iNdEx])
+			copy(dAtA[i:], m.BlacklistedValidators[iNdEx])
+			i = encodeVarintHostZone(dAtA, i, uint64(len(m.BlacklistedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
 	}
-	return n
-}
-
-func sovHostZone(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozHostZone(x uint64) (n int) {
-	return sovHostZone(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *CommunityPoolRebate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHostZone
+	if m.ValidatorBlacklistPolicy != nil {
+		{
+			size, err := m.ValidatorBlacklistPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.FeeSchedule != nil {
+		{
+			size, err := m.FeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
+	{
+		size := m.InstantRedemptionBufferBalance.Size()
+		i -= size
+		if _, err := m.InstantRedemptionBufferBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
+	if len(m.InstantRedemptionBufferAddress) > 0 {
+		i -= len(m.InstantRedemptionBufferAddress)
+		copy(dAtA[i:], m.InstantRedemptionBufferAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.InstantRedemptionBufferAddress)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.InstantRedemptionConfig != nil {
+		{
+			size, err := m.InstantRedemptionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolRebate: wiretype end group for non-group")
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.AutoValidatorWeighting != nil {
+		{
+			size, err := m.AutoValidatorWeighting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolRebate: illegal tag %d (wire type %d)", fieldNum, wire)
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		switch fieldNum {
-		case 1:
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.RedemptionsEnabled {
+		i--
+		if m.RedemptionsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MaxMessagesPerIcaTx != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.MaxMessagesPerIcaTx))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.CommunityPoolTreasuryAddress) > 0 {
+		i -= len(m.CommunityPoolTreasuryAddress)
+		copy(dAtA[i:], m.CommunityPoolTreasuryAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.CommunityPoolTreasuryAddress)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.CommunityPoolRebate != nil {
+		{
+			size, err := m.CommunityPoolRebate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.CommunityPoolRedeemHoldingAddress) > 0 {
+		i -= len(m.CommunityPoolRedeemHoldingAddress)
+		copy(dAtA[i:], m.CommunityPoolRedeemHoldingAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.CommunityPoolRedeemHoldingAddress)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.CommunityPoolStakeHoldingAddress) > 0 {
+		i -= len(m.CommunityPoolStakeHoldingAddress)
+		copy(dAtA[i:], m.CommunityPoolStakeHoldingAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.CommunityPoolStakeHoldingAddress)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.CommunityPoolReturnIcaAddress) > 0 {
+		i -= len(m.CommunityPoolReturnIcaAddress)
+		copy(dAtA[i:], m.CommunityPoolReturnIcaAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.CommunityPoolReturnIcaAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.CommunityPoolDepositIcaAddress) > 0 {
+		i -= len(m.CommunityPoolDepositIcaAddress)
+		copy(dAtA[i:], m.CommunityPoolDepositIcaAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.CommunityPoolDepositIcaAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	{
+		size := m.MaxInnerRedemptionRate.Size()
+		i -= size
+		if _, err := m.MaxInnerRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	{
+		size := m.MinInnerRedemptionRate.Size()
+		i -= size
+		if _, err := m.MinInnerRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	if m.LsmLiquidStakeEnabled {
+		i--
+		if m.LsmLiquidStakeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.RedemptionIcaAddress) > 0 {
+		i -= len(m.RedemptionIcaAddress)
+		copy(dAtA[i:], m.RedemptionIcaAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.RedemptionIcaAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.DelegationIcaAddress) > 0 {
+		i -= len(m.DelegationIcaAddress)
+		copy(dAtA[i:], m.DelegationIcaAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.DelegationIcaAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.FeeIcaAddress) > 0 {
+		i -= len(m.FeeIcaAddress)
+		copy(dAtA[i:], m.FeeIcaAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.FeeIcaAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.WithdrawalIcaAddress) > 0 {
+		i -= len(m.WithdrawalIcaAddress)
+		copy(dAtA[i:], m.WithdrawalIcaAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.WithdrawalIcaAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	{
+		size := m.MaxRedemptionRate.Size()
+		i -= size
+		if _, err := m.MaxRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MinRedemptionRate.Size()
+		i -= size
+		if _, err := m.MinRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.DepositAddress) > 0 {
+		i -= len(m.DepositAddress)
+		copy(dAtA[i:], m.DepositAddress)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.DepositAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Bech32Prefix) > 0 {
+		i -= len(m.Bech32Prefix)
+		copy(dAtA[i:], m.Bech32Prefix)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.Bech32Prefix)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	{
+		size := m.TotalDelegations.Size()
+		i -= size
+		if _, err := m.TotalDelegations.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.TransferChannelId) > 0 {
+		i -= len(m.TransferChannelId)
+		copy(dAtA[i:], m.TransferChannelId)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.TransferChannelId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.LastRedemptionRate.Size()
+		i -= size
+		if _, err := m.LastRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHostZone(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHostZone(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHostZone(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommunityPoolRebate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RebateRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.LiquidStakedStTokenAmount.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	return n
+}
+
+func (m *AutoValidatorWeightingConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseWeight != 0 {
+		n += 1 + sovHostZone(uint64(m.BaseWeight))
+	}
+	l = m.MaxCommissionRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.CommissionPenalty.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.SlashPenalty.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.MaxSharesToTokensRateDrift.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	return n
+}
+
+func (m *InstantRedemptionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositAllocationRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.TargetBufferAmount.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.MinFeeRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.MaxFeeRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	return n
+}
+
+func (m *InsuranceFundConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardAllocationRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.TargetFundAmount.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.MaxCoveragePerSlash.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	return n
+}
+
+func (m *HostZoneFeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardCommissionRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.LiquidStakeFeeRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.RedemptionFeeRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	return n
+}
+
+func (m *ValidatorBlacklistPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashThreshold.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	if m.BlacklistJailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *RedemptionRateGuardConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResumeCooldownEpochs != 0 {
+		n += 1 + sovHostZone(uint64(m.ResumeCooldownEpochs))
+	}
+	if m.AutoResumeEpochs != 0 {
+		n += 1 + sovHostZone(uint64(m.AutoResumeEpochs))
+	}
+	return n
+}
+
+func (m *RedemptionRateGuardState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovHostZone(uint64(m.Status))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	l = m.TriggerRedemptionRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.TriggerMinBound.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.TriggerMaxBound.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	if m.TrippedEpochNumber != 0 {
+		n += 1 + sovHostZone(uint64(m.TrippedEpochNumber))
+	}
+	if m.HealthyEpochs != 0 {
+		n += 1 + sovHostZone(uint64(m.HealthyEpochs))
+	}
+	return n
+}
+
+func (m *HostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovHostZone(uint64(l))
+		}
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	l = m.LastRedemptionRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = len(m.TransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	l = m.TotalDelegations.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = len(m.Bech32Prefix)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.DepositAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.Halted {
+		n += 3
+	}
+	l = m.MinRedemptionRate.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	l = m.MaxRedemptionRate.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	l = len(m.WithdrawalIcaAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.FeeIcaAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.DelegationIcaAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.RedemptionIcaAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.UnbondingPeriod != 0 {
+		n += 2 + sovHostZone(uint64(m.UnbondingPeriod))
+	}
+	if m.LsmLiquidStakeEnabled {
+		n += 3
+	}
+	l = m.MinInnerRedemptionRate.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	l = m.MaxInnerRedemptionRate.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	l = len(m.CommunityPoolDepositIcaAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.CommunityPoolReturnIcaAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.CommunityPoolStakeHoldingAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.CommunityPoolRedeemHoldingAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.CommunityPoolRebate != nil {
+		l = m.CommunityPoolRebate.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.CommunityPoolTreasuryAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.MaxMessagesPerIcaTx != 0 {
+		n += 2 + sovHostZone(uint64(m.MaxMessagesPerIcaTx))
+	}
+	if m.RedemptionsEnabled {
+		n += 3
+	}
+	if m.Deprecated {
+		n += 3
+	}
+	if m.AutoValidatorWeighting != nil {
+		l = m.AutoValidatorWeighting.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.InstantRedemptionConfig != nil {
+		l = m.InstantRedemptionConfig.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.InstantRedemptionBufferAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = m.InstantRedemptionBufferBalance.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	if m.FeeSchedule != nil {
+		l = m.FeeSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.ValidatorBlacklistPolicy != nil {
+		l = m.ValidatorBlacklistPolicy.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if len(m.BlacklistedValidators) > 0 {
+		for _, s := range m.BlacklistedValidators {
+			l = len(s)
+			n += 2 + l + sovHostZone(uint64(l))
+		}
+	}
+	if m.RedemptionRateGuardConfig != nil {
+		l = m.RedemptionRateGuardConfig.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.RedemptionRateGuard != nil {
+		l = m.RedemptionRateGuard.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.MaxIcaTxGas != 0 {
+		n += 2 + sovHostZone(uint64(m.MaxIcaTxGas))
+	}
+	if m.InsuranceFundConfig != nil {
+		l = m.InsuranceFundConfig.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.InsuranceFundAddress)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = m.ReconciliationDiscrepancyThreshold.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	l = m.MaxUnbondPerEpoch.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	l = m.MinRebalanceAmount.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	return n
+}
+
+func sovHostZone(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHostZone(x uint64) (n int) {
+	return sovHostZone(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CommunityPoolRebate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolRebate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolRebate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebateRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebateRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakedStTokenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidStakedStTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoValidatorWeightingConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoValidatorWeightingConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoValidatorWeightingConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseWeight", wireType)
+			}
+			m.BaseWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSharesToTokensRateDrift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSharesToTokensRateDrift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InstantRedemptionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstantRedemptionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstantRedemptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAllocationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositAllocationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBufferAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBufferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFundConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAllocationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAllocationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFundAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetFundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCoveragePerSlash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCoveragePerSlash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostZoneFeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZoneFeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZoneFeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidStakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBlacklistPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBlacklistPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBlacklistPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlacklistJailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionRateGuardConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRateGuardConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRateGuardConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeCooldownEpochs", wireType)
+			}
+			m.ResumeCooldownEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumeCooldownEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoResumeEpochs", wireType)
+			}
+			m.AutoResumeEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoResumeEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionRateGuardState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRateGuardState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRateGuardState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RedemptionRateGuardStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerMinBound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerMinBound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerMaxBound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerMaxBound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedEpochNumber", wireType)
+			}
+			m.TrippedEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthyEpochs", wireType)
+			}
+			m.HealthyEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HealthyEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebateRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1000,13 +3994,13 @@ func (m *CommunityPoolRebate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RebateRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakedStTokenAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1034,63 +4028,13 @@ func (m *CommunityPoolRebate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidStakedStTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHostZone(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthHostZone
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HostZone) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHostZone
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HostZone: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostZone: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalIcaAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1118,11 +4062,11 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.WithdrawalIcaAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeIcaAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1150,13 +4094,13 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.FeeIcaAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationIcaAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1166,29 +4110,27 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegationIcaAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionIcaAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1216,11 +4158,50 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			m.RedemptionIcaAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmLiquidStakeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LsmLiquidStakeEnabled = bool(v != 0)
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInnerRedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1248,11 +4229,13 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MinInnerRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInnerRedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1280,13 +4263,13 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxInnerRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolDepositIcaAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1314,13 +4297,11 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CommunityPoolDepositIcaAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolReturnIcaAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1348,11 +4329,11 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
+			m.CommunityPoolReturnIcaAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolStakeHoldingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1380,13 +4361,11 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CommunityPoolStakeHoldingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRedeemHoldingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1414,11 +4393,47 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			m.CommunityPoolRedeemHoldingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRebate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommunityPoolRebate == nil {
+				m.CommunityPoolRebate = &CommunityPoolRebate{}
+			}
+			if err := m.CommunityPoolRebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolTreasuryAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1446,11 +4461,50 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 19:
+			m.CommunityPoolTreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessagesPerIcaTx", wireType)
+			}
+			m.MaxMessagesPerIcaTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessagesPerIcaTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedemptionsEnabled = bool(v != 0)
+		case 38:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1467,12 +4521,12 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Halted = bool(v != 0)
-		case 20:
+			m.Deprecated = bool(v != 0)
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoValidatorWeighting", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1482,31 +4536,33 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AutoValidatorWeighting == nil {
+				m.AutoValidatorWeighting = &AutoValidatorWeightingConfig{}
+			}
+			if err := m.AutoValidatorWeighting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1516,29 +4572,31 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InstantRedemptionConfig == nil {
+				m.InstantRedemptionConfig = &InstantRedemptionConfig{}
+			}
+			if err := m.InstantRedemptionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalIcaAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionBufferAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1566,11 +4624,11 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawalIcaAddress = string(dAtA[iNdEx:postIndex])
+			m.InstantRedemptionBufferAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeIcaAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionBufferBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1598,13 +4656,15 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeIcaAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.InstantRedemptionBufferBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 24:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationIcaAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1614,29 +4674,33 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegationIcaAddress = string(dAtA[iNdEx:postIndex])
+			if m.FeeSchedule == nil {
+				m.FeeSchedule = &HostZoneFeeSchedule{}
+			}
+			if err := m.FeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 25:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionIcaAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBlacklistPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1646,66 +4710,31 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedemptionIcaAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 26:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
-			}
-			m.UnbondingPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHostZone
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingPeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 27:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LsmLiquidStakeEnabled", wireType)
+			if m.ValidatorBlacklistPolicy == nil {
+				m.ValidatorBlacklistPolicy = &ValidatorBlacklistPolicy{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHostZone
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ValidatorBlacklistPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.LsmLiquidStakeEnabled = bool(v != 0)
-		case 28:
+			iNdEx = postIndex
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInnerRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedValidators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1733,15 +4762,13 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinInnerRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlacklistedValidators = append(m.BlacklistedValidators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 29:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxInnerRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateGuardConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1751,31 +4778,33 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxInnerRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RedemptionRateGuardConfig == nil {
+				m.RedemptionRateGuardConfig = &RedemptionRateGuardConfig{}
+			}
+			if err := m.RedemptionRateGuardConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolDepositIcaAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateGuard", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1785,29 +4814,33 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPoolDepositIcaAddress = string(dAtA[iNdEx:postIndex])
+			if m.RedemptionRateGuard == nil {
+				m.RedemptionRateGuard = &RedemptionRateGuardState{}
+			}
+			if err := m.RedemptionRateGuard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 31:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolReturnIcaAddress", wireType)
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIcaTxGas", wireType)
 			}
-			var stringLen uint64
+			m.MaxIcaTxGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1817,29 +4850,16 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxIcaTxGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHostZone
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthHostZone
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CommunityPoolReturnIcaAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 32:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolStakeHoldingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1849,27 +4869,31 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPoolStakeHoldingAddress = string(dAtA[iNdEx:postIndex])
+			if m.InsuranceFundConfig == nil {
+				m.InsuranceFundConfig = &InsuranceFundConfig{}
+			}
+			if err := m.InsuranceFundConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 33:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRedeemHoldingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1897,13 +4921,13 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPoolRedeemHoldingAddress = string(dAtA[iNdEx:postIndex])
+			m.InsuranceFundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 34:
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRebate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconciliationDiscrepancyThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1913,31 +4937,29 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommunityPoolRebate == nil {
-				m.CommunityPoolRebate = &CommunityPoolRebate{}
-			}
-			if err := m.CommunityPoolRebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReconciliationDiscrepancyThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolTreasuryAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnbondPerEpoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1965,13 +4987,15 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPoolTreasuryAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxUnbondPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 36:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessagesPerIcaTx", wireType)
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRebalanceAmount", wireType)
 			}
-			m.MaxMessagesPerIcaTx = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1981,51 +5005,26 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMessagesPerIcaTx |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 37:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionsEnabled", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHostZone
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
 			}
-			m.RedemptionsEnabled = bool(v != 0)
-		case 38:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHostZone
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.MinRebalanceAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Deprecated = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHostZone(dAtA[iNdEx:])
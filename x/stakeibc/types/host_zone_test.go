@@ -118,3 +118,40 @@ func TestSafelyGetCommunityPoolRebate(t *testing.T) {
 		})
 	}
 }
+
+func TestSafelyGetMaxUnbondPerEpoch(t *testing.T) {
+	testCases := []struct {
+		name           string
+		hostZone       types.HostZone
+		expectedCapped bool
+	}{
+		{
+			name:           "cap not set",
+			hostZone:       types.HostZone{},
+			expectedCapped: false,
+		},
+		{
+			name:           "zero cap",
+			hostZone:       types.HostZone{MaxUnbondPerEpoch: sdkmath.ZeroInt()},
+			expectedCapped: false,
+		},
+		{
+			name:           "positive cap",
+			hostZone:       types.HostZone{MaxUnbondPerEpoch: sdkmath.NewInt(1000)},
+			expectedCapped: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actualCap, capped := tc.hostZone.SafelyGetMaxUnbondPerEpoch()
+			require.Equal(t, tc.expectedCapped, capped, "capped bool")
+
+			if tc.expectedCapped {
+				require.Equal(t, tc.hostZone.MaxUnbondPerEpoch.Int64(), actualCap.Int64(), "cap")
+			} else {
+				require.Zero(t, actualCap.Int64(), "cap")
+			}
+		})
+	}
+}
@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/ica_gas.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The estimated gas consumed on a host zone by a single ICA message of a
+// given type. Estimates are learned from the acks of past delegation and
+// undelegation ICAs and are used to size ICA batches
+type IcaGasEstimate struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Type URL of the message (e.g. /cosmos.staking.v1beta1.MsgDelegate)
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	GasPerMsg  uint64 `protobuf:"varint,3,opt,name=gas_per_msg,json=gasPerMsg,proto3" json:"gas_per_msg,omitempty"`
+	// Lower bound on the gas per message implied by the most recent out of gas
+	// ack. The estimate is never decayed below this value
+	MinGasPerMsg uint64 `protobuf:"varint,4,opt,name=min_gas_per_msg,json=minGasPerMsg,proto3" json:"min_gas_per_msg,omitempty"`
+}
+
+func (m *IcaGasEstimate) Reset()         { *m = IcaGasEstimate{} }
+func (m *IcaGasEstimate) String() string { return proto.CompactTextString(m) }
+func (*IcaGasEstimate) ProtoMessage()    {}
+func (*IcaGasEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f54d5e73e90cb5, []int{0}
+}
+func (m *IcaGasEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaGasEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaGasEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaGasEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaGasEstimate.Merge(m, src)
+}
+func (m *IcaGasEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaGasEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaGasEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaGasEstimate proto.InternalMessageInfo
+
+func (m *IcaGasEstimate) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *IcaGasEstimate) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *IcaGasEstimate) GetGasPerMsg() uint64 {
+	if m != nil {
+		return m.GasPerMsg
+	}
+	return 0
+}
+
+func (m *IcaGasEstimate) GetMinGasPerMsg() uint64 {
+	if m != nil {
+		return m.MinGasPerMsg
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*IcaGasEstimate)(nil), "stride.stakeibc.IcaGasEstimate")
+}
+
+func init() { proto.RegisterFile("stride/stakeibc/ica_gas.proto", fileDescriptor_39f54d5e73e90cb5) }
+
+var fileDescriptor_39f54d5e73e90cb5 = []byte{
+	// 251 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0xc1, 0x4a, 0x2b, 0x31,
+	0x18, 0x46, 0x27, 0xf7, 0x16, 0xb5, 0xb1, 0x58, 0xc8, 0x6a, 0x5c, 0x18, 0x06, 0x41, 0xe8, 0xc6,
+	0x09, 0x38, 0x6f, 0x20, 0x48, 0x29, 0x54, 0x90, 0xaa, 0x1b, 0x37, 0xe1, 0x9f, 0x4c, 0x48, 0x83,
+	0xcd, 0xcc, 0x90, 0x3f, 0x15, 0xfb, 0x16, 0x2e, 0x7c, 0x28, 0x97, 0x5d, 0xba, 0x94, 0x99, 0x17,
+	0x11, 0xa3, 0x45, 0x97, 0x1f, 0xe7, 0x2c, 0xbe, 0x43, 0x4f, 0x30, 0x78, 0x5b, 0x69, 0x81, 0x01,
+	0x1e, 0xb5, 0x2d, 0x95, 0xb0, 0x0a, 0xa4, 0x01, 0xcc, 0x5b, 0xdf, 0x84, 0x86, 0x8d, 0xbf, 0x71,
+	0xbe, 0xc3, 0xa7, 0xaf, 0x84, 0x1e, 0xcd, 0x14, 0x4c, 0x01, 0xaf, 0x30, 0x58, 0x07, 0x41, 0xb3,
+	0x63, 0x7a, 0xa0, 0x96, 0x60, 0x6b, 0x69, 0xab, 0x94, 0x64, 0x64, 0x32, 0x5c, 0xec, 0xc7, 0x3d,
+	0xab, 0x58, 0x46, 0x47, 0x0e, 0x8d, 0x0c, 0x9b, 0x56, 0xcb, 0xb5, 0x5f, 0xa5, 0xff, 0x22, 0xa6,
+	0x0e, 0xcd, 0xdd, 0xa6, 0xd5, 0xf7, 0x7e, 0xc5, 0x38, 0x3d, 0x34, 0x80, 0xb2, 0xd5, 0x5e, 0x3a,
+	0x34, 0xe9, 0xff, 0x8c, 0x4c, 0x06, 0x8b, 0xa1, 0x01, 0xbc, 0xd1, 0xfe, 0x1a, 0x0d, 0x3b, 0xa3,
+	0x63, 0x67, 0x6b, 0xf9, 0xd7, 0x19, 0x44, 0x67, 0xe4, 0x6c, 0x3d, 0xdd, 0x69, 0x97, 0xf3, 0xb7,
+	0x8e, 0x93, 0x6d, 0xc7, 0xc9, 0x47, 0xc7, 0xc9, 0x4b, 0xcf, 0x93, 0x6d, 0xcf, 0x93, 0xf7, 0x9e,
+	0x27, 0x0f, 0x17, 0xc6, 0x86, 0xe5, 0xba, 0xcc, 0x55, 0xe3, 0xc4, 0x6d, 0x8c, 0x39, 0x9f, 0x43,
+	0x89, 0xe2, 0xa7, 0xfb, 0xa9, 0x28, 0xc4, 0xf3, 0x6f, 0xfd, 0xd7, 0x53, 0x2c, 0xf7, 0x62, 0x7c,
+	0xf1, 0x39, 0x00, 0xb2, 0x03, 0x72, 0x40, 0x1d, 0x01, 0x00, 0x00,
+}
+
+func (m *IcaGasEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaGasEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaGasEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinGasPerMsg != 0 {
+		i = encodeVarintIcaGas(dAtA, i, uint64(m.MinGasPerMsg))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasPerMsg != 0 {
+		i = encodeVarintIcaGas(dAtA, i, uint64(m.GasPerMsg))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintIcaGas(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintIcaGas(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcaGas(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcaGas(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IcaGasEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovIcaGas(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovIcaGas(uint64(l))
+	}
+	if m.GasPerMsg != 0 {
+		n += 1 + sovIcaGas(uint64(m.GasPerMsg))
+	}
+	if m.MinGasPerMsg != 0 {
+		n += 1 + sovIcaGas(uint64(m.MinGasPerMsg))
+	}
+	return n
+}
+
+func sovIcaGas(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIcaGas(x uint64) (n int) {
+	return sovIcaGas(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IcaGasEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaGas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcaGasEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcaGasEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaGas
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaGas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaGas
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaGas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerMsg", wireType)
+			}
+			m.GasPerMsg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerMsg |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPerMsg", wireType)
+			}
+			m.MinGasPerMsg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGasPerMsg |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaGas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaGas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIcaGas(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIcaGas
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIcaGas
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIcaGas
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIcaGas
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIcaGas        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIcaGas          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIcaGas = fmt.Errorf("proto: unexpected end of group")
+)
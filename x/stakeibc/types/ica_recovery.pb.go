@@ -0,0 +1,562 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/ica_recovery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Tracks the automatic recovery of a host zone ICA whose channel was closed
+// The state is removed once the account's channel is open again
+type IcaRecoveryState struct {
+	ChainId string         `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	IcaType ICAAccountType `protobuf:"varint,2,opt,name=ica_type,json=icaType,proto3,enum=stride.stakeibc.ICAAccountType" json:"ica_type,omitempty"`
+	// Number of times the account has been re-registered since the closure
+	Attempts        uint64    `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastAttemptTime time.Time `protobuf:"bytes,4,opt,name=last_attempt_time,json=lastAttemptTime,proto3,stdtime" json:"last_attempt_time"`
+	// Earliest time at which the account can be re-registered again
+	NextAttemptTime time.Time `protobuf:"bytes,5,opt,name=next_attempt_time,json=nextAttemptTime,proto3,stdtime" json:"next_attempt_time"`
+	// Error from the last registration attempt, if it failed
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *IcaRecoveryState) Reset()         { *m = IcaRecoveryState{} }
+func (m *IcaRecoveryState) String() string { return proto.CompactTextString(m) }
+func (*IcaRecoveryState) ProtoMessage()    {}
+func (*IcaRecoveryState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae3eb695f4a61e71, []int{0}
+}
+func (m *IcaRecoveryState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaRecoveryState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaRecoveryState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaRecoveryState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaRecoveryState.Merge(m, src)
+}
+func (m *IcaRecoveryState) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaRecoveryState) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaRecoveryState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaRecoveryState proto.InternalMessageInfo
+
+func (m *IcaRecoveryState) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *IcaRecoveryState) GetIcaType() ICAAccountType {
+	if m != nil {
+		return m.IcaType
+	}
+	return ICAAccountType_DELEGATION
+}
+
+func (m *IcaRecoveryState) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *IcaRecoveryState) GetLastAttemptTime() time.Time {
+	if m != nil {
+		return m.LastAttemptTime
+	}
+	return time.Time{}
+}
+
+func (m *IcaRecoveryState) GetNextAttemptTime() time.Time {
+	if m != nil {
+		return m.NextAttemptTime
+	}
+	return time.Time{}
+}
+
+func (m *IcaRecoveryState) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*IcaRecoveryState)(nil), "stride.stakeibc.IcaRecoveryState")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/ica_recovery.proto", fileDescriptor_ae3eb695f4a61e71)
+}
+
+var fileDescriptor_ae3eb695f4a61e71 = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0xbf, 0x4f, 0xc2, 0x40,
+	0x14, 0xee, 0x21, 0x42, 0x39, 0x13, 0xd1, 0xc6, 0xa1, 0x36, 0xb1, 0xad, 0x4c, 0x5d, 0xbc, 0x4b,
+	0x60, 0x73, 0x03, 0xe3, 0x40, 0xc2, 0x60, 0x0a, 0x93, 0x4b, 0x73, 0x3d, 0xce, 0x72, 0x91, 0x72,
+	0x4d, 0x7b, 0x10, 0xf8, 0x2f, 0xf8, 0xb3, 0x18, 0x19, 0x9d, 0xd4, 0xc0, 0x3f, 0x62, 0xae, 0x2d,
+	0x6a, 0x88, 0x8b, 0xdb, 0xfb, 0xf1, 0xbd, 0xef, 0x7d, 0xdf, 0x7b, 0xb0, 0x95, 0xc9, 0x94, 0x8f,
+	0x19, 0xce, 0x24, 0x79, 0x65, 0x3c, 0xa4, 0x98, 0x53, 0x12, 0xa4, 0x8c, 0x8a, 0x05, 0x4b, 0x57,
+	0x28, 0x49, 0x85, 0x14, 0x46, 0xb3, 0xc0, 0xa0, 0x03, 0xc6, 0xba, 0x8a, 0x44, 0x24, 0xf2, 0x1e,
+	0x56, 0x51, 0x01, 0xb3, 0x9c, 0x48, 0x88, 0x68, 0xca, 0x70, 0x9e, 0x85, 0xf3, 0x17, 0x2c, 0x79,
+	0xcc, 0x32, 0x49, 0xe2, 0xa4, 0x04, 0xdc, 0xfe, 0xb5, 0x8b, 0x50, 0x2a, 0xe6, 0x33, 0x59, 0x40,
+	0x5a, 0x9b, 0x0a, 0xbc, 0xe8, 0x53, 0xe2, 0x97, 0x02, 0x86, 0x92, 0x48, 0x66, 0x5c, 0x43, 0x9d,
+	0x4e, 0x08, 0x9f, 0x05, 0x7c, 0x6c, 0x02, 0x17, 0x78, 0x0d, 0xbf, 0x9e, 0xe7, 0xfd, 0xb1, 0x71,
+	0x0f, 0x75, 0x45, 0x22, 0x57, 0x09, 0x33, 0x2b, 0x2e, 0xf0, 0xce, 0xdb, 0x0e, 0x3a, 0x52, 0x8b,
+	0xfa, 0x0f, 0xdd, 0x6e, 0xb1, 0x64, 0xb4, 0x4a, 0x98, 0x5f, 0xe7, 0x94, 0xa8, 0xc0, 0xb0, 0xa0,
+	0x4e, 0xa4, 0x64, 0x71, 0x22, 0x33, 0xf3, 0xc4, 0x05, 0x5e, 0xd5, 0xff, 0xce, 0x8d, 0x27, 0x78,
+	0x39, 0x25, 0x99, 0x0c, 0xca, 0x42, 0xa0, 0xac, 0x98, 0x55, 0x17, 0x78, 0x67, 0x6d, 0x0b, 0x15,
+	0x3e, 0xd1, 0xc1, 0x27, 0x1a, 0x1d, 0x7c, 0xf6, 0xf4, 0xcd, 0xbb, 0xa3, 0xad, 0x3f, 0x1c, 0xe0,
+	0x37, 0xd5, 0x78, 0xb7, 0x98, 0x56, 0x7d, 0xc5, 0x38, 0x63, 0xcb, 0x23, 0xc6, 0xd3, 0xff, 0x30,
+	0xaa, 0xf1, 0xdf, 0x8c, 0x37, 0x10, 0xe6, 0x1a, 0x59, 0x9a, 0x8a, 0xd4, 0xac, 0xe5, 0x87, 0x69,
+	0xa8, 0xca, 0xa3, 0x2a, 0xf4, 0x06, 0x9b, 0x9d, 0x0d, 0xb6, 0x3b, 0x1b, 0x7c, 0xee, 0x6c, 0xb0,
+	0xde, 0xdb, 0xda, 0x76, 0x6f, 0x6b, 0x6f, 0x7b, 0x5b, 0x7b, 0x6e, 0x47, 0x5c, 0x4e, 0xe6, 0x21,
+	0xa2, 0x22, 0xc6, 0xc3, 0xfc, 0x58, 0x77, 0x03, 0x12, 0x66, 0xb8, 0x7c, 0xcf, 0xa2, 0xd3, 0xc1,
+	0xcb, 0x9f, 0x27, 0xa9, 0xdb, 0x66, 0x61, 0x2d, 0xd7, 0xd6, 0xf9, 0x1a, 0x00, 0x52, 0x05, 0xa5,
+	0xca, 0x30, 0x02, 0x00, 0x00,
+}
+
+func (m *IcaRecoveryState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaRecoveryState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaRecoveryState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextAttemptTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextAttemptTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIcaRecovery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastAttemptTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastAttemptTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIcaRecovery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Attempts != 0 {
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IcaType != 0 {
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(m.IcaType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcaRecovery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcaRecovery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IcaRecoveryState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	if m.IcaType != 0 {
+		n += 1 + sovIcaRecovery(uint64(m.IcaType))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovIcaRecovery(uint64(m.Attempts))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastAttemptTime)
+	n += 1 + l + sovIcaRecovery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextAttemptTime)
+	n += 1 + l + sovIcaRecovery(uint64(l))
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	return n
+}
+
+func sovIcaRecovery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIcaRecovery(x uint64) (n int) {
+	return sovIcaRecovery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IcaRecoveryState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcaRecoveryState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcaRecoveryState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaType", wireType)
+			}
+			m.IcaType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IcaType |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastAttemptTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextAttemptTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIcaRecovery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIcaRecovery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIcaRecovery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIcaRecovery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIcaRecovery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIcaRecovery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIcaRecovery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIcaRecovery = fmt.Errorf("proto: unexpected end of group")
+)
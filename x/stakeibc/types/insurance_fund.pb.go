@@ -0,0 +1,709 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/insurance_fund.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A record of the insurance fund covering a validator slash on a host zone
+type InsuranceCoverageRecord struct {
+	ChainId     string    `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Id          uint64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	EpochNumber uint64    `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Time        time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// Validator that was slashed
+	Validator string `protobuf:"bytes,5,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Native tokens lost from the slash
+	SlashAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=slash_amount,json=slashAmount,proto3,customtype=cosmossdk.io/math.Int" json:"slash_amount"`
+	// Native tokens paid out from the fund to cover the slash
+	CoveredAmount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=covered_amount,json=coveredAmount,proto3,customtype=cosmossdk.io/math.Int" json:"covered_amount"`
+	// Redemption rate before the slash
+	RedemptionRateBefore cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=redemption_rate_before,json=redemptionRateBefore,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"redemption_rate_before"`
+	// Redemption rate after the slash was covered
+	RedemptionRateAfter cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=redemption_rate_after,json=redemptionRateAfter,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"redemption_rate_after"`
+}
+
+func (m *InsuranceCoverageRecord) Reset()         { *m = InsuranceCoverageRecord{} }
+func (m *InsuranceCoverageRecord) String() string { return proto.CompactTextString(m) }
+func (*InsuranceCoverageRecord) ProtoMessage()    {}
+func (*InsuranceCoverageRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2d7fcc597c411f4, []int{0}
+}
+func (m *InsuranceCoverageRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceCoverageRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceCoverageRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceCoverageRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceCoverageRecord.Merge(m, src)
+}
+func (m *InsuranceCoverageRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceCoverageRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceCoverageRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceCoverageRecord proto.InternalMessageInfo
+
+func (m *InsuranceCoverageRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *InsuranceCoverageRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *InsuranceCoverageRecord) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *InsuranceCoverageRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *InsuranceCoverageRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*InsuranceCoverageRecord)(nil), "stride.stakeibc.InsuranceCoverageRecord")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/insurance_fund.proto", fileDescriptor_b2d7fcc597c411f4)
+}
+
+var fileDescriptor_b2d7fcc597c411f4 = []byte{
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x10, 0xda, 0x64, 0x53, 0x8a, 0x64, 0x5a, 0x70, 0x03, 0x72, 0x02, 0xe2, 0x10,
+	0x09, 0xd5, 0x2b, 0x9a, 0x0b, 0xd7, 0x86, 0x5e, 0x22, 0x45, 0x3d, 0x18, 0x4e, 0x5c, 0xac, 0xf5,
+	0xee, 0xc4, 0x5e, 0x35, 0xde, 0xb5, 0x76, 0xd7, 0x11, 0x7d, 0x8b, 0xbe, 0x0b, 0x3c, 0x44, 0x8f,
+	0x15, 0x27, 0xc4, 0x21, 0xa0, 0xe4, 0x45, 0x90, 0xd7, 0xb6, 0xc2, 0x9f, 0x13, 0xdc, 0x76, 0xbe,
+	0xfd, 0xe6, 0xf7, 0x8d, 0x46, 0x83, 0x5e, 0x6a, 0xa3, 0x38, 0x03, 0xac, 0x0d, 0xb9, 0x02, 0x1e,
+	0x53, 0xcc, 0x85, 0x2e, 0x14, 0x11, 0x14, 0xa2, 0x45, 0x21, 0x58, 0x90, 0x2b, 0x69, 0xa4, 0xfb,
+	0xb0, 0x72, 0x05, 0x8d, 0x6b, 0x70, 0x42, 0xa5, 0xce, 0xa4, 0x8e, 0xec, 0x37, 0xae, 0x8a, 0xca,
+	0x3b, 0x38, 0x4a, 0x64, 0x22, 0x2b, 0xbd, 0x7c, 0xd5, 0xea, 0x30, 0x91, 0x32, 0x59, 0x02, 0xb6,
+	0x55, 0x5c, 0x2c, 0xb0, 0xe1, 0x19, 0x68, 0x43, 0xb2, 0xbc, 0x32, 0xbc, 0xf8, 0xd4, 0x41, 0x4f,
+	0x66, 0x4d, 0xf6, 0x5b, 0xb9, 0x02, 0x45, 0x12, 0x08, 0x81, 0x4a, 0xc5, 0xdc, 0x13, 0xd4, 0xa5,
+	0x29, 0xe1, 0x22, 0xe2, 0xcc, 0x73, 0x46, 0xce, 0xb8, 0x17, 0xee, 0xdb, 0x7a, 0xc6, 0xdc, 0x43,
+	0xd4, 0xe6, 0xcc, 0x6b, 0x8f, 0x9c, 0x71, 0x27, 0x6c, 0x73, 0xe6, 0x3e, 0x47, 0x07, 0x90, 0x4b,
+	0x9a, 0x46, 0xa2, 0xc8, 0x62, 0x50, 0xde, 0x3d, 0xfb, 0xd3, 0xb7, 0xda, 0xa5, 0x95, 0xdc, 0x37,
+	0xa8, 0x53, 0x86, 0x7b, 0x9d, 0x91, 0x33, 0xee, 0x9f, 0x0d, 0x82, 0x6a, 0xb2, 0xa0, 0x99, 0x2c,
+	0x78, 0xdf, 0x4c, 0x36, 0xed, 0xde, 0xae, 0x87, 0xad, 0x9b, 0xef, 0x43, 0x27, 0xb4, 0x1d, 0xee,
+	0x33, 0xd4, 0x5b, 0x91, 0x25, 0x67, 0xc4, 0x48, 0xe5, 0xdd, 0xb7, 0x83, 0xec, 0x04, 0xf7, 0x12,
+	0x1d, 0xe8, 0x25, 0xd1, 0x69, 0x44, 0x32, 0x59, 0x08, 0xe3, 0xed, 0x95, 0x86, 0xe9, 0xab, 0x92,
+	0xf1, 0x6d, 0x3d, 0x3c, 0xae, 0x96, 0xa4, 0xd9, 0x55, 0xc0, 0x25, 0xce, 0x88, 0x49, 0x83, 0x99,
+	0x30, 0x5f, 0x3e, 0x9f, 0xa2, 0x7a, 0x7b, 0x33, 0x61, 0xc2, 0xbe, 0x05, 0x9c, 0xdb, 0x7e, 0x37,
+	0x44, 0x87, 0xb4, 0xdc, 0x03, 0xb0, 0x86, 0xb8, 0xff, 0xef, 0xc4, 0x07, 0x35, 0xa2, 0x66, 0x26,
+	0xe8, 0xb1, 0x02, 0x06, 0x59, 0x6e, 0xb8, 0x14, 0x91, 0x22, 0x06, 0xa2, 0x18, 0x16, 0x52, 0x81,
+	0xd7, 0xb5, 0xec, 0xd7, 0x35, 0xfb, 0xe9, 0xdf, 0xec, 0x39, 0x24, 0x84, 0x5e, 0x5f, 0x00, 0xfd,
+	0x25, 0xe1, 0x02, 0x68, 0x78, 0xb4, 0x03, 0x86, 0xc4, 0xc0, 0xd4, 0xe2, 0x5c, 0x40, 0xc7, 0x7f,
+	0x06, 0x91, 0x85, 0x01, 0xe5, 0xf5, 0xfe, 0x37, 0xe7, 0xd1, 0xef, 0x39, 0xe7, 0x25, 0x6d, 0x3a,
+	0xbf, 0xdd, 0xf8, 0xce, 0xdd, 0xc6, 0x77, 0x7e, 0x6c, 0x7c, 0xe7, 0x66, 0xeb, 0xb7, 0xee, 0xb6,
+	0x7e, 0xeb, 0xeb, 0xd6, 0x6f, 0x7d, 0x38, 0x4b, 0xb8, 0x49, 0x8b, 0x38, 0xa0, 0x32, 0xc3, 0xef,
+	0xec, 0xf5, 0x9e, 0xce, 0x49, 0xac, 0x71, 0x7d, 0xef, 0xab, 0xc9, 0x04, 0x7f, 0xdc, 0x5d, 0xbd,
+	0xb9, 0xce, 0x41, 0xc7, 0x7b, 0xf6, 0x06, 0x26, 0x3f, 0x07, 0x00, 0x49, 0xa7, 0xe0, 0x0d, 0x15,
+	0x03, 0x00, 0x00,
+}
+
+func (m *InsuranceCoverageRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceCoverageRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceCoverageRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RedemptionRateAfter.Size()
+		i -= size
+		if _, err := m.RedemptionRateAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.RedemptionRateBefore.Size()
+		i -= size
+		if _, err := m.RedemptionRateBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CoveredAmount.Size()
+		i -= size
+		if _, err := m.CoveredAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashAmount.Size()
+		i -= size
+		if _, err := m.SlashAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintInsuranceFund(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.EpochNumber != 0 {
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInsuranceFund(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInsuranceFund(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InsuranceCoverageRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInsuranceFund(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovInsuranceFund(uint64(m.Id))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovInsuranceFund(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovInsuranceFund(uint64(l))
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovInsuranceFund(uint64(l))
+	}
+	l = m.SlashAmount.Size()
+	n += 1 + l + sovInsuranceFund(uint64(l))
+	l = m.CoveredAmount.Size()
+	n += 1 + l + sovInsuranceFund(uint64(l))
+	l = m.RedemptionRateBefore.Size()
+	n += 1 + l + sovInsuranceFund(uint64(l))
+	l = m.RedemptionRateAfter.Size()
+	n += 1 + l + sovInsuranceFund(uint64(l))
+	return n
+}
+
+func sovInsuranceFund(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInsuranceFund(x uint64) (n int) {
+	return sovInsuranceFund(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InsuranceCoverageRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsuranceFund
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceCoverageRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceCoverageRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoveredAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRateBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRateAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsuranceFund(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInsuranceFund(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInsuranceFund
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInsuranceFund
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInsuranceFund
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInsuranceFund
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInsuranceFund        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInsuranceFund          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInsuranceFund = fmt.Errorf("proto: unexpected end of group")
+)
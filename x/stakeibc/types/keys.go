@@ -1,5 +1,9 @@
 package types
 
+import (
+	"encoding/binary"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "stakeibc"
@@ -26,6 +30,15 @@ const (
 	FeeAccount = "stride1czvrk3jkvtj8m27kqsqu2yrkhw3h3ykwj3rxh6"
 
 	RewardCollectorName = "reward_collector"
+
+	// Number of stride epochs of redemption rate history retained for each host zone
+	// (120 days with 6 hour stride epochs)
+	RedemptionRateHistoryRetentionEpochs = 480
+
+	// Maximum number of in-flight redelegation entries allowed by the host for each
+	// (delegator, source validator, destination validator) triple
+	// This mirrors the default MaxEntries staking param on the host
+	MaxRedelegationEntries = 7
 )
 
 // PortKey defines the key to store the port ID in store
@@ -52,6 +65,72 @@ func TradeRouteKeyFromDenoms(rewardDenom, hostDenom string) (key []byte) {
 	return []byte(rewardDenom + "-" + hostDenom)
 }
 
+// Prefix for all redemption rate snapshots of a host zone
+func RedemptionRateHistoryChainPrefix(chainId string) []byte {
+	return []byte(chainId + "/")
+}
+
+// Key for a redemption rate snapshot, ordered by epoch number within each host zone
+func RedemptionRateHistoryKey(chainId string, epochNumber uint64) []byte {
+	epochBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBz, epochNumber)
+	return append(RedemptionRateHistoryChainPrefix(chainId), epochBz...)
+}
+
+// Prefix for all redemption rate guard records of a host zone
+func RedemptionRateGuardHistoryChainPrefix(chainId string) []byte {
+	return []byte(chainId + "/")
+}
+
+// Key for a redemption rate guard record, ordered by record ID within each host zone
+func RedemptionRateGuardHistoryKey(chainId string, id uint64) []byte {
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, id)
+	return append(RedemptionRateGuardHistoryChainPrefix(chainId), idBz...)
+}
+
+// Prefix for all in-flight redelegations of a host zone
+func InFlightRedelegationChainPrefix(chainId string) []byte {
+	return []byte(chainId + "/")
+}
+
+// Key for the in-flight redelegations between a pair of validators on a host zone
+func InFlightRedelegationKey(chainId, srcValidator, dstValidator string) []byte {
+	return append(InFlightRedelegationChainPrefix(chainId), []byte(srcValidator+"/"+dstValidator)...)
+}
+
+// Key for the circuit breaker flag of an action on a host zone in one of the liquid staking modules
+func CircuitBreakerFlagKey(module, chainId string, action CircuitBreakerAction) []byte {
+	return []byte(module + "/" + chainId + "/" + action.String())
+}
+
+// Prefix for the ICA gas estimates of a host zone
+func IcaGasEstimateChainPrefix(chainId string) []byte {
+	return []byte(chainId + "/")
+}
+
+// Key for the ICA gas estimate of a message type on a host zone
+func IcaGasEstimateKey(chainId, msgTypeUrl string) []byte {
+	return append(IcaGasEstimateChainPrefix(chainId), []byte(msgTypeUrl)...)
+}
+
+// Prefix for all insurance coverage records of a host zone
+func InsuranceCoverageHistoryChainPrefix(chainId string) []byte {
+	return []byte(chainId + "/")
+}
+
+// Key for an insurance coverage record, ordered by record ID within each host zone
+func InsuranceCoverageHistoryKey(chainId string, id uint64) []byte {
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, id)
+	return append(InsuranceCoverageHistoryChainPrefix(chainId), idBz...)
+}
+
+// Key for the recovery state of a host zone ICA
+func IcaRecoveryStateKey(chainId string, icaType ICAAccountType) []byte {
+	return []byte(chainId + "/" + icaType.String())
+}
+
 const (
 	// Host zone keys prefix the HostZone structs
 	HostZoneKey = "HostZone-value-"
@@ -61,4 +140,37 @@ const (
 
 	// TradeRoute keys prefix to retrieve all TradeZones
 	TradeRouteKeyPrefix = "TradeRoute-value-"
+
+	// Basket keys prefix to retrieve all baskets
+	BasketKeyPrefix = "Basket-value-"
+
+	// Redemption rate history keys prefix the snapshots of each host zone
+	RedemptionRateHistoryKeyPrefix = "RedemptionRateHistory-value-"
+
+	// Redemption rate guard history keys prefix the guard status changes of each host zone
+	RedemptionRateGuardHistoryKeyPrefix = "RedemptionRateGuardHistory-value-"
+
+	// In-flight redelegation keys prefix the outstanding redelegations of each validator pair
+	InFlightRedelegationKeyPrefix = "InFlightRedelegation-value-"
+
+	// Circuit breaker guardian key stores the account that can pause actions without governance
+	CircuitBreakerGuardianKey = "CircuitBreakerGuardian-value"
+
+	// Circuit breaker flag keys prefix the paused actions of each module and host zone
+	CircuitBreakerFlagKeyPrefix = "CircuitBreakerFlag-value-"
+
+	// ICA gas estimate keys prefix the learned gas cost of each ICA message type on a host zone
+	IcaGasEstimateKeyPrefix = "IcaGasEstimate-value-"
+
+	// Insurance coverage history keys prefix the slashes covered by each host zone's insurance fund
+	InsuranceCoverageHistoryKeyPrefix = "InsuranceCoverageHistory-value-"
+
+	// ICA reconciliation report keys prefix the latest balance reconciliation of each host zone
+	IcaReconciliationReportKeyPrefix = "IcaReconciliationReport-value-"
+
+	// ICA recovery state keys prefix the host zone ICAs that are being re-registered after a channel closure
+	IcaRecoveryStateKeyPrefix = "IcaRecoveryState-value-"
+
+	// Host zone onboarding keys prefix the configuration from each host zone's onboarding proposal
+	HostZoneOnboardingKeyPrefix = "HostZoneOnboarding-value-"
 )
@@ -47,12 +47,16 @@ func (msg *MsgAddValidators) ValidateBasic() error {
 	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
 		return err
 	}
+	return validateNewValidators(msg.Validators)
+}
 
-	if len(msg.Validators) == 0 {
+// Confirms at least one validator was provided, and each has a name and address
+func validateNewValidators(validators []*Validator) error {
+	if len(validators) == 0 {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at least one validator must be provided")
 	}
 
-	for i, validator := range msg.Validators {
+	for i, validator := range validators {
 		if len(strings.TrimSpace(validator.Name)) == 0 {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator name is required (index %d)", i)
 		}
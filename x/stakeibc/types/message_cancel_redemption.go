@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelRedemption = "cancel_redemption"
+
+var _ sdk.Msg = &MsgCancelRedemption{}
+
+func NewMsgCancelRedemption(creator, hostZone string, epoch uint64, receiver string, stTokenAmount sdkmath.Int) *MsgCancelRedemption {
+	return &MsgCancelRedemption{
+		Creator:       creator,
+		HostZoneId:    hostZone,
+		Epoch:         epoch,
+		Receiver:      receiver,
+		StTokenAmount: stTokenAmount,
+	}
+}
+
+func (msg *MsgCancelRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelRedemption) Type() string {
+	return TypeMsgCancelRedemption
+}
+
+func (msg *MsgCancelRedemption) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelRedemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.HostZoneId == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "host zone id cannot be empty")
+	}
+	if msg.Receiver == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "receiver cannot be empty")
+	}
+	// A nil or zero amount indicates the full redemption should be cancelled
+	if !msg.StTokenAmount.IsNil() && msg.StTokenAmount.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sttoken amount cannot be negative (%v)", msg.StTokenAmount)
+	}
+	return nil
+}
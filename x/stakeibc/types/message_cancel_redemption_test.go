@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgCancelRedemption_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgCancelRedemption
+		err  error
+	}{
+		{
+			name: "success, partial cancel",
+			msg: types.MsgCancelRedemption{
+				Creator:       apptesting.SampleStrideAddress(),
+				HostZoneId:    "GAIA",
+				Epoch:         1,
+				Receiver:      apptesting.SampleHostAddress(),
+				StTokenAmount: sdkmath.NewInt(1),
+			},
+		},
+		{
+			name: "success, full cancel",
+			msg: types.MsgCancelRedemption{
+				Creator:    apptesting.SampleStrideAddress(),
+				HostZoneId: "GAIA",
+				Epoch:      1,
+				Receiver:   apptesting.SampleHostAddress(),
+			},
+		},
+		{
+			name: "invalid creator",
+			msg: types.MsgCancelRedemption{
+				Creator:    "invalid_address",
+				HostZoneId: "GAIA",
+				Epoch:      1,
+				Receiver:   apptesting.SampleHostAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no host zone",
+			msg: types.MsgCancelRedemption{
+				Creator:  apptesting.SampleStrideAddress(),
+				Epoch:    1,
+				Receiver: apptesting.SampleHostAddress(),
+			},
+			err: types.ErrRequiredFieldEmpty,
+		},
+		{
+			name: "no receiver",
+			msg: types.MsgCancelRedemption{
+				Creator:    apptesting.SampleStrideAddress(),
+				HostZoneId: "GAIA",
+				Epoch:      1,
+			},
+			err: types.ErrRequiredFieldEmpty,
+		},
+		{
+			name: "negative amount",
+			msg: types.MsgCancelRedemption{
+				Creator:       apptesting.SampleStrideAddress(),
+				HostZoneId:    "GAIA",
+				Epoch:         1,
+				Receiver:      apptesting.SampleHostAddress(),
+				StTokenAmount: sdkmath.NewInt(-1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgCreateBasket = "create_basket"
+
+var _ sdk.Msg = &MsgCreateBasket{}
+
+func NewMsgCreateBasket(authority, basketId string, components []BasketComponent) *MsgCreateBasket {
+	return &MsgCreateBasket{
+		Authority:  authority,
+		BasketId:   basketId,
+		Components: components,
+	}
+}
+
+func (msg *MsgCreateBasket) Type() string {
+	return TypeMsgCreateBasket
+}
+
+func (msg *MsgCreateBasket) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateBasket) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgCreateBasket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := ValidateBasketId(msg.BasketId); err != nil {
+		return err
+	}
+	return ValidateBasketComponents(msg.Components)
+}
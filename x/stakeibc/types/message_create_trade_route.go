@@ -47,19 +47,9 @@ func (msg *MsgCreateTradeRoute) ValidateBasic() error {
 	if err := ValidateConnectionId(msg.StrideToRewardConnectionId); err != nil {
 		return errorsmod.Wrap(err, "invalid stride to reward connection ID")
 	}
-	if err := ValidateConnectionId(msg.StrideToTradeConnectionId); err != nil {
-		return errorsmod.Wrap(err, "invalid stride to trade connection ID")
-	}
-
 	if err := ValidateChannelId(msg.HostToRewardTransferChannelId); err != nil {
 		return errorsmod.Wrap(err, "invalid host to reward channel ID")
 	}
-	if err := ValidateChannelId(msg.RewardToTradeTransferChannelId); err != nil {
-		return errorsmod.Wrap(err, "invalid reward to trade channel ID")
-	}
-	if err := ValidateChannelId(msg.TradeToHostTransferChannelId); err != nil {
-		return errorsmod.Wrap(err, "invalid trade to host channel ID")
-	}
 
 	if err := ValidateDenom(msg.RewardDenomOnHost, true); err != nil {
 		return errorsmod.Wrap(err, "invalid reward denom on host")
@@ -67,16 +57,40 @@ func (msg *MsgCreateTradeRoute) ValidateBasic() error {
 	if err := ValidateDenom(msg.RewardDenomOnReward, false); err != nil {
 		return errorsmod.Wrap(err, "invalid reward denom on reward")
 	}
-	if err := ValidateDenom(msg.RewardDenomOnTrade, true); err != nil {
-		return errorsmod.Wrap(err, "invalid reward denom on trade")
-	}
-	if err := ValidateDenom(msg.HostDenomOnTrade, true); err != nil {
-		return errorsmod.Wrap(err, "invalid host denom on trade")
-	}
 	if err := ValidateDenom(msg.HostDenomOnHost, false); err != nil {
 		return errorsmod.Wrap(err, "invalid host denom on host")
 	}
 
+	switch msg.TradeVenue {
+	case TradeVenue_OSMOSIS_AUTHZ:
+		if err := ValidateConnectionId(msg.StrideToTradeConnectionId); err != nil {
+			return errorsmod.Wrap(err, "invalid stride to trade connection ID")
+		}
+		if err := ValidateChannelId(msg.RewardToTradeTransferChannelId); err != nil {
+			return errorsmod.Wrap(err, "invalid reward to trade channel ID")
+		}
+		if err := ValidateChannelId(msg.TradeToHostTransferChannelId); err != nil {
+			return errorsmod.Wrap(err, "invalid trade to host channel ID")
+		}
+		if err := ValidateDenom(msg.RewardDenomOnTrade, true); err != nil {
+			return errorsmod.Wrap(err, "invalid reward denom on trade")
+		}
+		if err := ValidateDenom(msg.HostDenomOnTrade, true); err != nil {
+			return errorsmod.Wrap(err, "invalid host denom on trade")
+		}
+
+	case TradeVenue_STRIDE_AUCTION:
+		if err := ValidateChannelId(msg.RewardToStrideTransferChannelId); err != nil {
+			return errorsmod.Wrap(err, "invalid reward to stride channel ID")
+		}
+		if err := ValidateDenom(msg.RewardDenomOnStride, true); err != nil {
+			return errorsmod.Wrap(err, "invalid reward denom on stride")
+		}
+
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported trade venue %d", msg.TradeVenue)
+	}
+
 	if msg.MinTransferAmount.IsNil() || msg.MinTransferAmount.LT(sdkmath.ZeroInt()) {
 		return errors.New("min transfer amount must be greater than or equal to zero")
 	}
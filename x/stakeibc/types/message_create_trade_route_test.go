@@ -121,6 +121,32 @@ func TestMsgCreateTradeRoute(t *testing.T) {
 	invalidMessage = validMessage
 	invalidMessage.MinTransferAmount = sdkmath.OneInt().Neg()
 	require.ErrorContains(t, invalidMessage.ValidateBasic(), "min transfer amount must be greater than or equal to zero")
+
+	// Set an unsupported trade venue - confirm invalid
+	invalidMessage = validMessage
+	invalidMessage.TradeVenue = types.TradeVenue(99)
+	require.ErrorContains(t, invalidMessage.ValidateBasic(), "unsupported trade venue")
+
+	// Switch to the auction venue - the trade zone fields are no longer required
+	validAuctionMessage := validMessage
+	validAuctionMessage.TradeVenue = types.TradeVenue_STRIDE_AUCTION
+	validAuctionMessage.StrideToTradeConnectionId = ""
+	validAuctionMessage.RewardToTradeTransferChannelId = ""
+	validAuctionMessage.TradeToHostTransferChannelId = ""
+	validAuctionMessage.RewardDenomOnTrade = ""
+	validAuctionMessage.HostDenomOnTrade = ""
+	validAuctionMessage.RewardToStrideTransferChannelId = validTransferChannelId2
+	validAuctionMessage.RewardDenomOnStride = validIBCDenom
+	require.NoError(t, validAuctionMessage.ValidateBasic(), "valid auction message")
+
+	// Set invalid auction fields - confirm invalid
+	invalidMessage = validAuctionMessage
+	invalidMessage.RewardToStrideTransferChannelId = ""
+	require.ErrorContains(t, invalidMessage.ValidateBasic(), "invalid reward to stride channel ID")
+
+	invalidMessage = validAuctionMessage
+	invalidMessage.RewardDenomOnStride = "not-ibc-denom"
+	require.ErrorContains(t, invalidMessage.ValidateBasic(), "invalid reward denom on stride")
 }
 
 func TestValidateConnectionId(t *testing.T) {
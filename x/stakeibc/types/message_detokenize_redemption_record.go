@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDetokenizeRedemptionRecord = "detokenize_redemption_record"
+
+var _ sdk.Msg = &MsgDetokenizeRedemptionRecord{}
+
+func NewMsgDetokenizeRedemptionRecord(creator, hostZone string, epoch uint64, receiver string) *MsgDetokenizeRedemptionRecord {
+	return &MsgDetokenizeRedemptionRecord{
+		Creator:    creator,
+		HostZoneId: hostZone,
+		Epoch:      epoch,
+		Receiver:   receiver,
+	}
+}
+
+func (msg *MsgDetokenizeRedemptionRecord) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDetokenizeRedemptionRecord) Type() string {
+	return TypeMsgDetokenizeRedemptionRecord
+}
+
+func (msg *MsgDetokenizeRedemptionRecord) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDetokenizeRedemptionRecord) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.HostZoneId == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "host zone id cannot be empty")
+	}
+	if msg.Receiver == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "receiver cannot be empty")
+	}
+	return nil
+}
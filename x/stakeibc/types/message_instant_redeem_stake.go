@@ -0,0 +1,54 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgInstantRedeemStake = "instant_redeem_stake"
+
+var _ sdk.Msg = &MsgInstantRedeemStake{}
+
+func NewMsgInstantRedeemStake(creator string, amount sdkmath.Int, hostZone string, minNativeAmount sdkmath.Int) *MsgInstantRedeemStake {
+	return &MsgInstantRedeemStake{
+		Creator:         creator,
+		Amount:          amount,
+		HostZone:        hostZone,
+		MinNativeAmount: minNativeAmount,
+	}
+}
+
+func (msg *MsgInstantRedeemStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgInstantRedeemStake) Type() string {
+	return TypeMsgInstantRedeemStake
+}
+
+func (msg *MsgInstantRedeemStake) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgInstantRedeemStake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Amount.IsNil() || msg.Amount.LTE(sdkmath.ZeroInt()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount (%v)", msg.Amount)
+	}
+	if msg.HostZone == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "host zone cannot be empty")
+	}
+	if !msg.MinNativeAmount.IsNil() && msg.MinNativeAmount.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min native amount cannot be negative (%v)", msg.MinNativeAmount)
+	}
+	return nil
+}
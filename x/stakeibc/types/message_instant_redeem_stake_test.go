@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgInstantRedeemStake_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgInstantRedeemStake
+		err  error
+	}{
+		{
+			name: "success",
+			msg: types.MsgInstantRedeemStake{
+				Creator:         apptesting.SampleStrideAddress(),
+				HostZone:        "GAIA",
+				Amount:          sdkmath.NewInt(1),
+				MinNativeAmount: sdkmath.NewInt(1),
+			},
+		},
+		{
+			name: "success without min native amount",
+			msg: types.MsgInstantRedeemStake{
+				Creator:  apptesting.SampleStrideAddress(),
+				HostZone: "GAIA",
+				Amount:   sdkmath.NewInt(1),
+			},
+		},
+		{
+			name: "invalid creator",
+			msg: types.MsgInstantRedeemStake{
+				Creator:  "invalid_address",
+				HostZone: "GAIA",
+				Amount:   sdkmath.NewInt(1),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero amount",
+			msg: types.MsgInstantRedeemStake{
+				Creator:  apptesting.SampleStrideAddress(),
+				HostZone: "GAIA",
+				Amount:   sdkmath.ZeroInt(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "no host zone",
+			msg: types.MsgInstantRedeemStake{
+				Creator: apptesting.SampleStrideAddress(),
+				Amount:  sdkmath.NewInt(1),
+			},
+			err: types.ErrRequiredFieldEmpty,
+		},
+		{
+			name: "negative min native amount",
+			msg: types.MsgInstantRedeemStake{
+				Creator:         apptesting.SampleStrideAddress(),
+				HostZone:        "GAIA",
+				Amount:          sdkmath.NewInt(1),
+				MinNativeAmount: sdkmath.NewInt(-1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
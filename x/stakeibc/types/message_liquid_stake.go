@@ -53,5 +53,9 @@ func (msg *MsgLiquidStake) ValidateBasic() error {
 	if err := sdk.ValidateDenom(msg.HostDenom); err != nil {
 		return err
 	}
+	// validator preferences are optional, but must be valid if provided
+	if err := ValidateValidatorPreferences(msg.ValidatorPreferences); err != nil {
+		return err
+	}
 	return nil
 }
@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgLiquidStakeBasket = "liquid_stake_basket"
+
+var _ sdk.Msg = &MsgLiquidStakeBasket{}
+
+func NewMsgLiquidStakeBasket(creator, basketId string, amount sdkmath.Int) *MsgLiquidStakeBasket {
+	return &MsgLiquidStakeBasket{
+		Creator:  creator,
+		BasketId: basketId,
+		Amount:   amount,
+	}
+}
+
+func (msg *MsgLiquidStakeBasket) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLiquidStakeBasket) Type() string {
+	return TypeMsgLiquidStakeBasket
+}
+
+func (msg *MsgLiquidStakeBasket) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgLiquidStakeBasket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateBasketId(msg.BasketId); err != nil {
+		return errorsmod.Wrap(ErrInvalidBasket, err.Error())
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be positive (%v)", msg.Amount)
+	}
+	return nil
+}
@@ -63,6 +63,60 @@ func TestMsgLiquidStake_ValidateBasic(t *testing.T) {
 			},
 			err: types.ErrRequiredFieldEmpty,
 		},
+		{
+			name: "valid validator preferences",
+			msg: types.MsgLiquidStake{
+				Creator:   apptesting.SampleStrideAddress(),
+				Amount:    sdkmath.NewInt(1),
+				HostDenom: "uatom",
+				ValidatorPreferences: []types.ValidatorPreference{
+					{Validator: "val1", Weight: 1},
+					{Validator: "val2", Weight: 2},
+				},
+			},
+		},
+		{
+			name: "duplicate validator preference",
+			msg: types.MsgLiquidStake{
+				Creator:   apptesting.SampleStrideAddress(),
+				Amount:    sdkmath.NewInt(1),
+				HostDenom: "uatom",
+				ValidatorPreferences: []types.ValidatorPreference{
+					{Validator: "val1", Weight: 1},
+					{Validator: "val1", Weight: 2},
+				},
+			},
+			err: types.ErrInvalidValidatorPreference,
+		},
+		{
+			name: "zero weight validator preference",
+			msg: types.MsgLiquidStake{
+				Creator:   apptesting.SampleStrideAddress(),
+				Amount:    sdkmath.NewInt(1),
+				HostDenom: "uatom",
+				ValidatorPreferences: []types.ValidatorPreference{
+					{Validator: "val1", Weight: 0},
+				},
+			},
+			err: types.ErrInvalidValidatorPreference,
+		},
+		{
+			name: "too many validator preferences",
+			msg: types.MsgLiquidStake{
+				Creator:   apptesting.SampleStrideAddress(),
+				Amount:    sdkmath.NewInt(1),
+				HostDenom: "uatom",
+				ValidatorPreferences: []types.ValidatorPreference{
+					{Validator: "val1", Weight: 1},
+					{Validator: "val2", Weight: 1},
+					{Validator: "val3", Weight: 1},
+					{Validator: "val4", Weight: 1},
+					{Validator: "val5", Weight: 1},
+					{Validator: "val6", Weight: 1},
+				},
+			},
+			err: types.ErrInvalidValidatorPreference,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgOnboardHostZone = "onboard_host_zone"
+
+var _ sdk.Msg = &MsgOnboardHostZone{}
+
+func NewMsgOnboardHostZone(authority string, hostZone MsgRegisterHostZone, validators []*Validator) *MsgOnboardHostZone {
+	return &MsgOnboardHostZone{
+		Authority:  authority,
+		HostZone:   hostZone,
+		Validators: validators,
+	}
+}
+
+func (msg *MsgOnboardHostZone) Type() string {
+	return TypeMsgOnboardHostZone
+}
+
+func (msg *MsgOnboardHostZone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgOnboardHostZone) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgOnboardHostZone) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := msg.HostZone.validateHostZoneConfig(); err != nil {
+		return errorsmod.Wrap(err, "invalid host zone")
+	}
+	if err := validateNewValidators(msg.Validators); err != nil {
+		return errorsmod.Wrap(err, "invalid validators")
+	}
+
+	// The trade route's signer is ignored in favor of the onboarding authority
+	if msg.TradeRoute != nil {
+		tradeRoute := *msg.TradeRoute
+		tradeRoute.Authority = msg.Authority
+		if err := tradeRoute.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "invalid trade route")
+		}
+		if tradeRoute.HostDenomOnHost != msg.HostZone.HostDenom {
+			return fmt.Errorf("trade route host denom (%s) does not match host zone denom (%s)",
+				tradeRoute.HostDenomOnHost, msg.HostZone.HostDenom)
+		}
+	}
+
+	if rebate := msg.CommunityPoolRebate; rebate != nil {
+		if rebate.RebateRate.IsNil() || rebate.RebateRate.LT(sdkmath.LegacyZeroDec()) || rebate.RebateRate.GT(sdkmath.LegacyOneDec()) {
+			return errors.New("invalid rebate rate, must be a decimal between 0 and 1 (inclusive)")
+		}
+		if rebate.LiquidStakedStTokenAmount.IsNil() || rebate.LiquidStakedStTokenAmount.LT(sdkmath.ZeroInt()) {
+			return errors.New("invalid liquid stake amount, must be greater than or equal to zero")
+		}
+	}
+
+	if msg.OracleConnectionId != "" {
+		if err := ValidateConnectionId(msg.OracleConnectionId); err != nil {
+			return errorsmod.Wrap(err, "invalid oracle connection ID")
+		}
+	}
+
+	for i, entry := range msg.RateLimitWhitelist {
+		if entry.Sender == "" || entry.Receiver == "" {
+			return fmt.Errorf("rate limit whitelist sender and receiver must be specified (index %d)", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgOnboardHostZone(t *testing.T) {
+	apptesting.SetupConfig()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	validHostZone := types.MsgRegisterHostZone{
+		ConnectionId:      "connection-0",
+		Bech32Prefix:      "cosmos",
+		HostDenom:         "uatom",
+		IbcDenom:          "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		TransferChannelId: "channel-0",
+		UnbondingPeriod:   21,
+	}
+	validValidators := []*types.Validator{
+		{Name: "val1", Address: "cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrdt795p", Weight: 1},
+	}
+	validTradeRoute := types.MsgCreateTradeRoute{
+		HostChainId:                     "cosmoshub-4",
+		StrideToRewardConnectionId:      "connection-1",
+		HostToRewardTransferChannelId:   "channel-1",
+		RewardToStrideTransferChannelId: "channel-2",
+		RewardDenomOnHost:               "ibc/usdc-on-host",
+		RewardDenomOnReward:             "uusdc",
+		RewardDenomOnStride:             "ibc/usdc-on-stride",
+		HostDenomOnHost:                 "uatom",
+		MinTransferAmount:               sdkmath.ZeroInt(),
+		TradeVenue:                      types.TradeVenue_STRIDE_AUCTION,
+	}
+	validRebate := types.CommunityPoolRebate{
+		RebateRate:                sdkmath.LegacyMustNewDecFromStr("0.2"),
+		LiquidStakedStTokenAmount: sdkmath.NewInt(1_000),
+	}
+
+	// Helper to build a message with every optional step included
+	fullMsg := func() types.MsgOnboardHostZone {
+		tradeRoute := validTradeRoute
+		rebate := validRebate
+		return types.MsgOnboardHostZone{
+			Authority:           authority,
+			HostZone:            validHostZone,
+			Validators:          validValidators,
+			TradeRoute:          &tradeRoute,
+			CommunityPoolRebate: &rebate,
+			OracleConnectionId:  "connection-2",
+			RateLimitWhitelist: []types.RateLimitWhitelistEntry{
+				{Sender: "cosmos1sender", Receiver: "stride1receiver"},
+			},
+		}
+	}
+
+	invalidAuthority := fullMsg()
+	invalidAuthority.Authority = ""
+
+	invalidHostZone := fullMsg()
+	invalidHostZone.HostZone.HostDenom = ""
+
+	noValidators := fullMsg()
+	noValidators.Validators = []*types.Validator{}
+
+	invalidTradeRoute := fullMsg()
+	invalidTradeRoute.TradeRoute.StrideToRewardConnectionId = "invalid"
+
+	mismatchedTradeRouteDenom := fullMsg()
+	mismatchedTradeRouteDenom.TradeRoute.HostDenomOnHost = "uosmo"
+
+	invalidRebateRate := fullMsg()
+	invalidRebateRate.CommunityPoolRebate.RebateRate = sdkmath.LegacyMustNewDecFromStr("1.1")
+
+	invalidRebateAmount := fullMsg()
+	invalidRebateAmount.CommunityPoolRebate.LiquidStakedStTokenAmount = sdkmath.NewInt(-1)
+
+	invalidOracleConnection := fullMsg()
+	invalidOracleConnection.OracleConnectionId = "invalid"
+
+	invalidWhitelist := fullMsg()
+	invalidWhitelist.RateLimitWhitelist = append(invalidWhitelist.RateLimitWhitelist, types.RateLimitWhitelistEntry{Sender: "cosmos1sender"})
+
+	tests := []struct {
+		name string
+		msg  types.MsgOnboardHostZone
+		err  string
+	}{
+		{
+			name: "successful message, all steps",
+			msg:  fullMsg(),
+		},
+		{
+			name: "successful message, only required steps",
+			msg: types.MsgOnboardHostZone{
+				Authority:  authority,
+				HostZone:   validHostZone,
+				Validators: validValidators,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg:  invalidAuthority,
+			err:  "invalid authority address",
+		},
+		{
+			name: "invalid host zone",
+			msg:  invalidHostZone,
+			err:  "invalid host zone",
+		},
+		{
+			name: "no validators",
+			msg:  noValidators,
+			err:  "at least one validator must be provided",
+		},
+		{
+			name: "invalid trade route",
+			msg:  invalidTradeRoute,
+			err:  "invalid trade route",
+		},
+		{
+			name: "trade route host denom mismatch",
+			msg:  mismatchedTradeRouteDenom,
+			err:  "does not match host zone denom",
+		},
+		{
+			name: "invalid rebate rate",
+			msg:  invalidRebateRate,
+			err:  "invalid rebate rate",
+		},
+		{
+			name: "invalid rebate amount",
+			msg:  invalidRebateAmount,
+			err:  "invalid liquid stake amount",
+		},
+		{
+			name: "invalid oracle connection",
+			msg:  invalidOracleConnection,
+			err:  "invalid oracle connection ID",
+		},
+		{
+			name: "invalid rate limit whitelist",
+			msg:  invalidWhitelist,
+			err:  "rate limit whitelist sender and receiver must be specified (index 1)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "onboard_host_zone")
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), authority)
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
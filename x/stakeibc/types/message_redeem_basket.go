@@ -0,0 +1,63 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRedeemBasket = "redeem_basket"
+
+var _ sdk.Msg = &MsgRedeemBasket{}
+
+func NewMsgRedeemBasket(creator, basketId string, amount sdkmath.Int, receivers []BasketRedemptionReceiver) *MsgRedeemBasket {
+	return &MsgRedeemBasket{
+		Creator:   creator,
+		BasketId:  basketId,
+		Amount:    amount,
+		Receivers: receivers,
+	}
+}
+
+func (msg *MsgRedeemBasket) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRedeemBasket) Type() string {
+	return TypeMsgRedeemBasket
+}
+
+func (msg *MsgRedeemBasket) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRedeemBasket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateBasketId(msg.BasketId); err != nil {
+		return errorsmod.Wrap(ErrInvalidBasket, err.Error())
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be positive (%v)", msg.Amount)
+	}
+
+	// Receiver addresses are validated against each host zone's bech32 prefix during the redemption
+	hostZones := map[string]bool{}
+	for _, receiver := range msg.Receivers {
+		if receiver.HostZoneId == "" || receiver.Receiver == "" {
+			return errorsmod.Wrapf(ErrRequiredFieldEmpty, "receiver host zone and address must be specified")
+		}
+		if hostZones[receiver.HostZoneId] {
+			return errorsmod.Wrapf(ErrInvalidBasket, "duplicate receiver for host zone %s", receiver.HostZoneId)
+		}
+		hostZones[receiver.HostZoneId] = true
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgRedeemBasket_ValidateBasic(t *testing.T) {
+	receivers := []types.BasketRedemptionReceiver{
+		{HostZoneId: "GAIA", Receiver: apptesting.SampleHostAddress()},
+		{HostZoneId: "OSMO", Receiver: "osmo1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrqyeqwq"},
+	}
+
+	tests := []struct {
+		name string
+		msg  types.MsgRedeemBasket
+		err  error
+	}{
+		{
+			name: "success",
+			msg: types.MsgRedeemBasket{
+				Creator:   apptesting.SampleStrideAddress(),
+				BasketId:  "index",
+				Amount:    sdkmath.NewInt(1),
+				Receivers: receivers,
+			},
+		},
+		{
+			name: "invalid creator",
+			msg: types.MsgRedeemBasket{
+				Creator:   "invalid_address",
+				BasketId:  "index",
+				Amount:    sdkmath.NewInt(1),
+				Receivers: receivers,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid basket id",
+			msg: types.MsgRedeemBasket{
+				Creator:   apptesting.SampleStrideAddress(),
+				BasketId:  "x",
+				Amount:    sdkmath.NewInt(1),
+				Receivers: receivers,
+			},
+			err: types.ErrInvalidBasket,
+		},
+		{
+			name: "zero amount",
+			msg: types.MsgRedeemBasket{
+				Creator:   apptesting.SampleStrideAddress(),
+				BasketId:  "index",
+				Amount:    sdkmath.ZeroInt(),
+				Receivers: receivers,
+			},
+			err: types.ErrInvalidAmount,
+		},
+		{
+			name: "missing receiver address",
+			msg: types.MsgRedeemBasket{
+				Creator:   apptesting.SampleStrideAddress(),
+				BasketId:  "index",
+				Amount:    sdkmath.NewInt(1),
+				Receivers: []types.BasketRedemptionReceiver{{HostZoneId: "GAIA"}},
+			},
+			err: types.ErrRequiredFieldEmpty,
+		},
+		{
+			name: "duplicate receiver",
+			msg: types.MsgRedeemBasket{
+				Creator:   apptesting.SampleStrideAddress(),
+				BasketId:  "index",
+				Amount:    sdkmath.NewInt(1),
+				Receivers: []types.BasketRedemptionReceiver{receivers[0], receivers[0]},
+			},
+			err: types.ErrInvalidBasket,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -72,6 +72,11 @@ func (msg *MsgRegisterHostZone) ValidateBasic() error {
 	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
 		return err
 	}
+	return msg.validateHostZoneConfig()
+}
+
+// Validates the host zone configuration, independent of the signer
+func (msg *MsgRegisterHostZone) validateHostZoneConfig() error {
 	// VALIDATE DENOMS
 	// host denom cannot be empty
 	if msg.HostDenom == "" {
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgRemoveBlacklistedValidator = "remove_blacklisted_validator"
+
+var _ sdk.Msg = &MsgRemoveBlacklistedValidator{}
+
+func NewMsgRemoveBlacklistedValidator(authority, chainId, validatorAddress string) *MsgRemoveBlacklistedValidator {
+	return &MsgRemoveBlacklistedValidator{
+		Authority:        authority,
+		ChainId:          chainId,
+		ValidatorAddress: validatorAddress,
+	}
+}
+
+func (msg *MsgRemoveBlacklistedValidator) Type() string {
+	return TypeMsgRemoveBlacklistedValidator
+}
+
+func (msg *MsgRemoveBlacklistedValidator) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveBlacklistedValidator) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgRemoveBlacklistedValidator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if msg.ChainId == "" {
+		return errors.New("chain ID must be specified")
+	}
+	if msg.ValidatorAddress == "" {
+		return errors.New("validator address must be specified")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgRemoveBlacklistedValidator(t *testing.T) {
+	apptesting.SetupConfig()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validChainId := "chain-0"
+	validValidator := "cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrdt795p"
+
+	tests := []struct {
+		name string
+		msg  types.MsgRemoveBlacklistedValidator
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgRemoveBlacklistedValidator{
+				Authority:        authority,
+				ChainId:          validChainId,
+				ValidatorAddress: validValidator,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgRemoveBlacklistedValidator{
+				Authority:        "",
+				ChainId:          validChainId,
+				ValidatorAddress: validValidator,
+			},
+			err: "invalid authority address",
+		},
+		{
+			name: "missing chain ID",
+			msg: types.MsgRemoveBlacklistedValidator{
+				Authority:        authority,
+				ChainId:          "",
+				ValidatorAddress: validValidator,
+			},
+			err: "chain ID must be specified",
+		},
+		{
+			name: "missing validator address",
+			msg: types.MsgRemoveBlacklistedValidator{
+				Authority:        authority,
+				ChainId:          validChainId,
+				ValidatorAddress: "",
+			},
+			err: "validator address must be specified",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "remove_blacklisted_validator")
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), authority)
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
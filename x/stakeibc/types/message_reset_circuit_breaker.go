@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgResetCircuitBreaker = "reset_circuit_breaker"
+
+var _ sdk.Msg = &MsgResetCircuitBreaker{}
+
+func NewMsgResetCircuitBreaker(signer, module, chainId string, actions []CircuitBreakerAction) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Signer:  signer,
+		Module:  module,
+		ChainId: chainId,
+		Actions: actions,
+	}
+}
+
+func (msg *MsgResetCircuitBreaker) Type() string {
+	return TypeMsgResetCircuitBreaker
+}
+
+func (msg *MsgResetCircuitBreaker) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	return ValidateCircuitBreakerScope(msg.Module, msg.ChainId, msg.Actions)
+}
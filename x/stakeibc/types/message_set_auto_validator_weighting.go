@@ -0,0 +1,49 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetAutoValidatorWeighting = "set_auto_validator_weighting"
+
+var _ sdk.Msg = &MsgSetAutoValidatorWeighting{}
+
+func NewMsgSetAutoValidatorWeighting(authority, chainId string, config *AutoValidatorWeightingConfig) *MsgSetAutoValidatorWeighting {
+	return &MsgSetAutoValidatorWeighting{
+		Authority: authority,
+		ChainId:   chainId,
+		Config:    config,
+	}
+}
+
+func (msg *MsgSetAutoValidatorWeighting) Type() string {
+	return TypeMsgSetAutoValidatorWeighting
+}
+
+func (msg *MsgSetAutoValidatorWeighting) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAutoValidatorWeighting) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetAutoValidatorWeighting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if msg.ChainId == "" {
+		return errors.New("chain ID must be specified")
+	}
+	if msg.Config != nil {
+		if err := msg.Config.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgSetAutoValidatorWeighting(t *testing.T) {
+	apptesting.SetupConfig()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validChainId := "chain-0"
+
+	validConfig := func() *types.AutoValidatorWeightingConfig {
+		return &types.AutoValidatorWeightingConfig{
+			BaseWeight:                 100,
+			MaxCommissionRate:          sdkmath.LegacyMustNewDecFromStr("0.10"),
+			CommissionPenalty:          sdkmath.LegacyMustNewDecFromStr("1.0"),
+			SlashPenalty:               sdkmath.LegacyMustNewDecFromStr("0.25"),
+			MaxSharesToTokensRateDrift: sdkmath.LegacyMustNewDecFromStr("0.05"),
+		}
+	}
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetAutoValidatorWeighting
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSetAutoValidatorWeighting{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config:    validConfig(),
+			},
+		},
+		{
+			name: "successful message, disable weighting",
+			msg: types.MsgSetAutoValidatorWeighting{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config:    nil,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSetAutoValidatorWeighting{
+				Authority: "",
+				ChainId:   validChainId,
+				Config:    validConfig(),
+			},
+			err: "invalid authority address",
+		},
+		{
+			name: "missing chain ID",
+			msg: types.MsgSetAutoValidatorWeighting{
+				Authority: authority,
+				ChainId:   "",
+				Config:    validConfig(),
+			},
+			err: "chain ID must be specified",
+		},
+		{
+			name: "zero base weight",
+			msg: types.MsgSetAutoValidatorWeighting{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.AutoValidatorWeightingConfig {
+					config := validConfig()
+					config.BaseWeight = 0
+					return config
+				}(),
+			},
+			err: "base weight must be greater than zero",
+		},
+		{
+			name: "max commission rate greater than one",
+			msg: types.MsgSetAutoValidatorWeighting{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.AutoValidatorWeightingConfig {
+					config := validConfig()
+					config.MaxCommissionRate = sdkmath.LegacyMustNewDecFromStr("1.01")
+					return config
+				}(),
+			},
+			err: "max commission rate must be between 0 and 1",
+		},
+		{
+			name: "max commission rate not set",
+			msg: types.MsgSetAutoValidatorWeighting{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.AutoValidatorWeightingConfig {
+					config := validConfig()
+					config.MaxCommissionRate = sdkmath.LegacyDec{}
+					return config
+				}(),
+			},
+			err: "max commission rate must be between 0 and 1",
+		},
+		{
+			name: "negative commission penalty",
+			msg: types.MsgSetAutoValidatorWeighting{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.AutoValidatorWeightingConfig {
+					config := validConfig()
+					config.CommissionPenalty = sdkmath.LegacyMustNewDecFromStr("-1")
+					return config
+				}(),
+			},
+			err: "commission penalty must be non-negative",
+		},
+		{
+			name: "negative slash penalty",
+			msg: types.MsgSetAutoValidatorWeighting{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.AutoValidatorWeightingConfig {
+					config := validConfig()
+					config.SlashPenalty = sdkmath.LegacyMustNewDecFromStr("-0.1")
+					return config
+				}(),
+			},
+			err: "slash penalty must be non-negative",
+		},
+		{
+			name: "negative shares to tokens rate drift",
+			msg: types.MsgSetAutoValidatorWeighting{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.AutoValidatorWeightingConfig {
+					config := validConfig()
+					config.MaxSharesToTokensRateDrift = sdkmath.LegacyMustNewDecFromStr("-0.1")
+					return config
+				}(),
+			},
+			err: "max shares to tokens rate drift must be non-negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "set_auto_validator_weighting")
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), authority)
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
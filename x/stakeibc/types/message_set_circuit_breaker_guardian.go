@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetCircuitBreakerGuardian = "set_circuit_breaker_guardian"
+
+var _ sdk.Msg = &MsgSetCircuitBreakerGuardian{}
+
+func NewMsgSetCircuitBreakerGuardian(authority, guardian string, expiration time.Time) *MsgSetCircuitBreakerGuardian {
+	return &MsgSetCircuitBreakerGuardian{
+		Authority:  authority,
+		Guardian:   guardian,
+		Expiration: expiration,
+	}
+}
+
+func (msg *MsgSetCircuitBreakerGuardian) Type() string {
+	return TypeMsgSetCircuitBreakerGuardian
+}
+
+func (msg *MsgSetCircuitBreakerGuardian) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetCircuitBreakerGuardian) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetCircuitBreakerGuardian) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	// An empty guardian removes the guardian
+	if msg.Guardian == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Guardian); err != nil {
+		return errorsmod.Wrap(err, "invalid guardian address")
+	}
+	if msg.Expiration.IsZero() {
+		return errors.New("guardian expiration must be specified")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetInstantRedemptionConfig = "set_instant_redemption_config"
+
+var _ sdk.Msg = &MsgSetInstantRedemptionConfig{}
+
+func NewMsgSetInstantRedemptionConfig(authority, chainId string, config *InstantRedemptionConfig) *MsgSetInstantRedemptionConfig {
+	return &MsgSetInstantRedemptionConfig{
+		Authority: authority,
+		ChainId:   chainId,
+		Config:    config,
+	}
+}
+
+func (msg *MsgSetInstantRedemptionConfig) Type() string {
+	return TypeMsgSetInstantRedemptionConfig
+}
+
+func (msg *MsgSetInstantRedemptionConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetInstantRedemptionConfig) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetInstantRedemptionConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if msg.ChainId == "" {
+		return errors.New("chain ID must be specified")
+	}
+	if msg.Config != nil {
+		if err := msg.Config.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgSetInstantRedemptionConfig(t *testing.T) {
+	apptesting.SetupConfig()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validChainId := "chain-0"
+
+	validConfig := func() *types.InstantRedemptionConfig {
+		return &types.InstantRedemptionConfig{
+			DepositAllocationRate: sdkmath.LegacyMustNewDecFromStr("0.10"),
+			TargetBufferAmount:    sdkmath.NewInt(1_000_000),
+			MinFeeRate:            sdkmath.LegacyMustNewDecFromStr("0.01"),
+			MaxFeeRate:            sdkmath.LegacyMustNewDecFromStr("0.05"),
+		}
+	}
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetInstantRedemptionConfig
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSetInstantRedemptionConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config:    validConfig(),
+			},
+		},
+		{
+			name: "successful message, disable instant redemptions",
+			msg: types.MsgSetInstantRedemptionConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config:    nil,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSetInstantRedemptionConfig{
+				Authority: "",
+				ChainId:   validChainId,
+				Config:    validConfig(),
+			},
+			err: "invalid authority address",
+		},
+		{
+			name: "missing chain ID",
+			msg: types.MsgSetInstantRedemptionConfig{
+				Authority: authority,
+				ChainId:   "",
+				Config:    validConfig(),
+			},
+			err: "chain ID must be specified",
+		},
+		{
+			name: "deposit allocation rate greater than one",
+			msg: types.MsgSetInstantRedemptionConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.InstantRedemptionConfig {
+					config := validConfig()
+					config.DepositAllocationRate = sdkmath.LegacyMustNewDecFromStr("1.01")
+					return config
+				}(),
+			},
+			err: "deposit allocation rate must be between 0 and 1",
+		},
+		{
+			name: "negative target buffer amount",
+			msg: types.MsgSetInstantRedemptionConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.InstantRedemptionConfig {
+					config := validConfig()
+					config.TargetBufferAmount = sdkmath.NewInt(-1)
+					return config
+				}(),
+			},
+			err: "target buffer amount must be non-negative",
+		},
+		{
+			name: "min fee rate not set",
+			msg: types.MsgSetInstantRedemptionConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.InstantRedemptionConfig {
+					config := validConfig()
+					config.MinFeeRate = sdkmath.LegacyDec{}
+					return config
+				}(),
+			},
+			err: "min fee rate must be between 0 and 1",
+		},
+		{
+			name: "max fee rate greater than one",
+			msg: types.MsgSetInstantRedemptionConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.InstantRedemptionConfig {
+					config := validConfig()
+					config.MaxFeeRate = sdkmath.LegacyMustNewDecFromStr("1.01")
+					return config
+				}(),
+			},
+			err: "max fee rate must be between 0 and 1",
+		},
+		{
+			name: "max fee rate less than min fee rate",
+			msg: types.MsgSetInstantRedemptionConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.InstantRedemptionConfig {
+					config := validConfig()
+					config.MaxFeeRate = sdkmath.LegacyMustNewDecFromStr("0.005")
+					return config
+				}(),
+			},
+			err: "max fee rate must be greater than or equal to the min fee rate",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "set_instant_redemption_config")
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), authority)
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetInsuranceFundConfig = "set_insurance_fund_config"
+
+var _ sdk.Msg = &MsgSetInsuranceFundConfig{}
+
+func NewMsgSetInsuranceFundConfig(authority, chainId string, config *InsuranceFundConfig) *MsgSetInsuranceFundConfig {
+	return &MsgSetInsuranceFundConfig{
+		Authority: authority,
+		ChainId:   chainId,
+		Config:    config,
+	}
+}
+
+func (msg *MsgSetInsuranceFundConfig) Type() string {
+	return TypeMsgSetInsuranceFundConfig
+}
+
+func (msg *MsgSetInsuranceFundConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetInsuranceFundConfig) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetInsuranceFundConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if msg.ChainId == "" {
+		return errors.New("chain ID must be specified")
+	}
+	if msg.Config != nil {
+		if err := msg.Config.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
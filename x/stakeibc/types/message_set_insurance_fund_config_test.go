@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgSetInsuranceFundConfig(t *testing.T) {
+	apptesting.SetupConfig()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validChainId := "chain-0"
+
+	validConfig := func() *types.InsuranceFundConfig {
+		return &types.InsuranceFundConfig{
+			RewardAllocationRate: sdkmath.LegacyMustNewDecFromStr("0.02"),
+			TargetFundAmount:     sdkmath.NewInt(1_000_000),
+			MaxCoveragePerSlash:  sdkmath.NewInt(100_000),
+		}
+	}
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetInsuranceFundConfig
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSetInsuranceFundConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config:    validConfig(),
+			},
+		},
+		{
+			name: "successful message, disable insurance fund",
+			msg: types.MsgSetInsuranceFundConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config:    nil,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSetInsuranceFundConfig{
+				Authority: "",
+				ChainId:   validChainId,
+				Config:    validConfig(),
+			},
+			err: "invalid authority address",
+		},
+		{
+			name: "missing chain ID",
+			msg: types.MsgSetInsuranceFundConfig{
+				Authority: authority,
+				ChainId:   "",
+				Config:    validConfig(),
+			},
+			err: "chain ID must be specified",
+		},
+		{
+			name: "reward allocation rate of one",
+			msg: types.MsgSetInsuranceFundConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.InsuranceFundConfig {
+					config := validConfig()
+					config.RewardAllocationRate = sdkmath.LegacyOneDec()
+					return config
+				}(),
+			},
+			err: "reward allocation rate must be at least 0 and less than 1",
+		},
+		{
+			name: "negative target fund amount",
+			msg: types.MsgSetInsuranceFundConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.InsuranceFundConfig {
+					config := validConfig()
+					config.TargetFundAmount = sdkmath.NewInt(-1)
+					return config
+				}(),
+			},
+			err: "target fund amount must be non-negative",
+		},
+		{
+			name: "max coverage per slash not set",
+			msg: types.MsgSetInsuranceFundConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: func() *types.InsuranceFundConfig {
+					config := validConfig()
+					config.MaxCoveragePerSlash = sdkmath.Int{}
+					return config
+				}(),
+			},
+			err: "max coverage per slash must be non-negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "set_insurance_fund_config")
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), authority)
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
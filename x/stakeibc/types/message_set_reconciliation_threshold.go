@@ -0,0 +1,48 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetReconciliationThreshold = "set_reconciliation_threshold"
+
+var _ sdk.Msg = &MsgSetReconciliationThreshold{}
+
+func NewMsgSetReconciliationThreshold(authority, chainId string, threshold sdkmath.Int) *MsgSetReconciliationThreshold {
+	return &MsgSetReconciliationThreshold{
+		Authority: authority,
+		ChainId:   chainId,
+		Threshold: threshold,
+	}
+}
+
+func (msg *MsgSetReconciliationThreshold) Type() string {
+	return TypeMsgSetReconciliationThreshold
+}
+
+func (msg *MsgSetReconciliationThreshold) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetReconciliationThreshold) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetReconciliationThreshold) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if msg.ChainId == "" {
+		return errors.New("chain ID must be specified")
+	}
+	if msg.Threshold.IsNil() || msg.Threshold.IsNegative() {
+		return errors.New("threshold must be non-negative")
+	}
+	return nil
+}
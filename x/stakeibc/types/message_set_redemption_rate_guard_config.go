@@ -0,0 +1,44 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetRedemptionRateGuardConfig = "set_redemption_rate_guard_config"
+
+var _ sdk.Msg = &MsgSetRedemptionRateGuardConfig{}
+
+func NewMsgSetRedemptionRateGuardConfig(authority, chainId string, config *RedemptionRateGuardConfig) *MsgSetRedemptionRateGuardConfig {
+	return &MsgSetRedemptionRateGuardConfig{
+		Authority: authority,
+		ChainId:   chainId,
+		Config:    config,
+	}
+}
+
+func (msg *MsgSetRedemptionRateGuardConfig) Type() string {
+	return TypeMsgSetRedemptionRateGuardConfig
+}
+
+func (msg *MsgSetRedemptionRateGuardConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRedemptionRateGuardConfig) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetRedemptionRateGuardConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if msg.ChainId == "" {
+		return errors.New("chain ID must be specified")
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgSetRedemptionRateGuardConfig(t *testing.T) {
+	apptesting.SetupConfig()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validChainId := "chain-0"
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetRedemptionRateGuardConfig
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSetRedemptionRateGuardConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config: &types.RedemptionRateGuardConfig{
+					ResumeCooldownEpochs: 4,
+					AutoResumeEpochs:     8,
+				},
+			},
+		},
+		{
+			name: "successful message, disable guard",
+			msg: types.MsgSetRedemptionRateGuardConfig{
+				Authority: authority,
+				ChainId:   validChainId,
+				Config:    nil,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSetRedemptionRateGuardConfig{
+				Authority: "",
+				ChainId:   validChainId,
+			},
+			err: "invalid authority address",
+		},
+		{
+			name: "missing chain ID",
+			msg: types.MsgSetRedemptionRateGuardConfig{
+				Authority: authority,
+				ChainId:   "",
+			},
+			err: "chain ID must be specified",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "set_redemption_rate_guard_config")
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), authority)
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetUnbondCap = "set_unbond_cap"
+
+var _ sdk.Msg = &MsgSetUnbondCap{}
+
+func NewMsgSetUnbondCap(authority, chainId string, maxUnbondPerEpoch sdkmath.Int) *MsgSetUnbondCap {
+	return &MsgSetUnbondCap{
+		Authority:         authority,
+		ChainId:           chainId,
+		MaxUnbondPerEpoch: maxUnbondPerEpoch,
+	}
+}
+
+func (msg *MsgSetUnbondCap) Type() string {
+	return TypeMsgSetUnbondCap
+}
+
+func (msg *MsgSetUnbondCap) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetUnbondCap) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetUnbondCap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if msg.ChainId == "" {
+		return errors.New("chain ID must be specified")
+	}
+	if msg.MaxUnbondPerEpoch.IsNil() || msg.MaxUnbondPerEpoch.IsNegative() {
+		return errors.New("max unbond per epoch must be non-negative")
+	}
+	return nil
+}
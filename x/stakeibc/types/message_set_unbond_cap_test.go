@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgSetUnbondCap(t *testing.T) {
+	apptesting.SetupConfig()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validChainId := "chain-0"
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetUnbondCap
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSetUnbondCap{
+				Authority:         authority,
+				ChainId:           validChainId,
+				MaxUnbondPerEpoch: sdkmath.NewInt(1_000),
+			},
+		},
+		{
+			name: "successful message, zero cap",
+			msg: types.MsgSetUnbondCap{
+				Authority:         authority,
+				ChainId:           validChainId,
+				MaxUnbondPerEpoch: sdkmath.ZeroInt(),
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSetUnbondCap{
+				Authority:         "",
+				ChainId:           validChainId,
+				MaxUnbondPerEpoch: sdkmath.NewInt(1_000),
+			},
+			err: "invalid authority address",
+		},
+		{
+			name: "missing chain ID",
+			msg: types.MsgSetUnbondCap{
+				Authority:         authority,
+				ChainId:           "",
+				MaxUnbondPerEpoch: sdkmath.NewInt(1_000),
+			},
+			err: "chain ID must be specified",
+		},
+		{
+			name: "cap not set",
+			msg: types.MsgSetUnbondCap{
+				Authority:         authority,
+				ChainId:           validChainId,
+				MaxUnbondPerEpoch: sdkmath.Int{},
+			},
+			err: "max unbond per epoch must be non-negative",
+		},
+		{
+			name: "negative cap",
+			msg: types.MsgSetUnbondCap{
+				Authority:         authority,
+				ChainId:           validChainId,
+				MaxUnbondPerEpoch: sdkmath.NewInt(-1),
+			},
+			err: "max unbond per epoch must be non-negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "set_unbond_cap")
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), authority)
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
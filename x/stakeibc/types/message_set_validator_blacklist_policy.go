@@ -0,0 +1,49 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetValidatorBlacklistPolicy = "set_validator_blacklist_policy"
+
+var _ sdk.Msg = &MsgSetValidatorBlacklistPolicy{}
+
+func NewMsgSetValidatorBlacklistPolicy(authority, chainId string, policy *ValidatorBlacklistPolicy) *MsgSetValidatorBlacklistPolicy {
+	return &MsgSetValidatorBlacklistPolicy{
+		Authority: authority,
+		ChainId:   chainId,
+		Policy:    policy,
+	}
+}
+
+func (msg *MsgSetValidatorBlacklistPolicy) Type() string {
+	return TypeMsgSetValidatorBlacklistPolicy
+}
+
+func (msg *MsgSetValidatorBlacklistPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetValidatorBlacklistPolicy) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetValidatorBlacklistPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if msg.ChainId == "" {
+		return errors.New("chain ID must be specified")
+	}
+	if msg.Policy != nil {
+		if err := msg.Policy.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgSetValidatorBlacklistPolicy(t *testing.T) {
+	apptesting.SetupConfig()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validChainId := "chain-0"
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetValidatorBlacklistPolicy
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSetValidatorBlacklistPolicy{
+				Authority: authority,
+				ChainId:   validChainId,
+				Policy: &types.ValidatorBlacklistPolicy{
+					SlashThreshold:  sdkmath.LegacyMustNewDecFromStr("0.05"),
+					BlacklistJailed: true,
+				},
+			},
+		},
+		{
+			name: "successful message, slash threshold disabled",
+			msg: types.MsgSetValidatorBlacklistPolicy{
+				Authority: authority,
+				ChainId:   validChainId,
+				Policy: &types.ValidatorBlacklistPolicy{
+					SlashThreshold:  sdkmath.LegacyZeroDec(),
+					BlacklistJailed: true,
+				},
+			},
+		},
+		{
+			name: "successful message, remove policy",
+			msg: types.MsgSetValidatorBlacklistPolicy{
+				Authority: authority,
+				ChainId:   validChainId,
+				Policy:    nil,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSetValidatorBlacklistPolicy{
+				Authority: "",
+				ChainId:   validChainId,
+			},
+			err: "invalid authority address",
+		},
+		{
+			name: "missing chain ID",
+			msg: types.MsgSetValidatorBlacklistPolicy{
+				Authority: authority,
+				ChainId:   "",
+			},
+			err: "chain ID must be specified",
+		},
+		{
+			name: "nil slash threshold",
+			msg: types.MsgSetValidatorBlacklistPolicy{
+				Authority: authority,
+				ChainId:   validChainId,
+				Policy:    &types.ValidatorBlacklistPolicy{BlacklistJailed: true},
+			},
+			err: "slash threshold must be between 0 and 1",
+		},
+		{
+			name: "negative slash threshold",
+			msg: types.MsgSetValidatorBlacklistPolicy{
+				Authority: authority,
+				ChainId:   validChainId,
+				Policy:    &types.ValidatorBlacklistPolicy{SlashThreshold: sdkmath.LegacyMustNewDecFromStr("-0.01")},
+			},
+			err: "slash threshold must be between 0 and 1",
+		},
+		{
+			name: "slash threshold greater than one",
+			msg: types.MsgSetValidatorBlacklistPolicy{
+				Authority: authority,
+				ChainId:   validChainId,
+				Policy:    &types.ValidatorBlacklistPolicy{SlashThreshold: sdkmath.LegacyMustNewDecFromStr("1.01")},
+			},
+			err: "slash threshold must be between 0 and 1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "set_validator_blacklist_policy")
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), authority)
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTokenizeRedemptionRecord = "tokenize_redemption_record"
+
+var _ sdk.Msg = &MsgTokenizeRedemptionRecord{}
+
+func NewMsgTokenizeRedemptionRecord(creator, hostZone string, epoch uint64, receiver string) *MsgTokenizeRedemptionRecord {
+	return &MsgTokenizeRedemptionRecord{
+		Creator:    creator,
+		HostZoneId: hostZone,
+		Epoch:      epoch,
+		Receiver:   receiver,
+	}
+}
+
+func (msg *MsgTokenizeRedemptionRecord) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTokenizeRedemptionRecord) Type() string {
+	return TypeMsgTokenizeRedemptionRecord
+}
+
+func (msg *MsgTokenizeRedemptionRecord) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTokenizeRedemptionRecord) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.HostZoneId == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "host zone id cannot be empty")
+	}
+	if msg.Receiver == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "receiver cannot be empty")
+	}
+	return nil
+}
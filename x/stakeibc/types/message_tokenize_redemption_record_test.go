@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgTokenizeRedemptionRecord_ValidateBasic(t *testing.T) {
+	validCreator := apptesting.SampleStrideAddress()
+	validReceiver := apptesting.SampleHostAddress()
+
+	tests := []struct {
+		name string
+		msg  sdk.Msg
+		err  error
+	}{
+		{
+			name: "successful tokenize",
+			msg:  types.NewMsgTokenizeRedemptionRecord(validCreator, "GAIA", 1, validReceiver),
+		},
+		{
+			name: "successful detokenize",
+			msg:  types.NewMsgDetokenizeRedemptionRecord(validCreator, "GAIA", 1, validReceiver),
+		},
+		{
+			name: "invalid creator",
+			msg:  types.NewMsgTokenizeRedemptionRecord("invalid_address", "GAIA", 1, validReceiver),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no host zone",
+			msg:  types.NewMsgDetokenizeRedemptionRecord(validCreator, "", 1, validReceiver),
+			err:  types.ErrRequiredFieldEmpty,
+		},
+		{
+			name: "no receiver",
+			msg:  types.NewMsgTokenizeRedemptionRecord(validCreator, "GAIA", 1, ""),
+			err:  types.ErrRequiredFieldEmpty,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, ok := tt.msg.(interface{ ValidateBasic() error })
+			require.True(t, ok, "msg should implement ValidateBasic")
+
+			err := msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferRedemptionRecord = "transfer_redemption_record"
+
+var _ sdk.Msg = &MsgTransferRedemptionRecord{}
+
+func NewMsgTransferRedemptionRecord(creator, hostZone string, epoch uint64, receiver, newOwner, newReceiver string) *MsgTransferRedemptionRecord {
+	return &MsgTransferRedemptionRecord{
+		Creator:     creator,
+		HostZoneId:  hostZone,
+		Epoch:       epoch,
+		Receiver:    receiver,
+		NewOwner:    newOwner,
+		NewReceiver: newReceiver,
+	}
+}
+
+func (msg *MsgTransferRedemptionRecord) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferRedemptionRecord) Type() string {
+	return TypeMsgTransferRedemptionRecord
+}
+
+func (msg *MsgTransferRedemptionRecord) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferRedemptionRecord) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address (%s)", err)
+	}
+	if msg.HostZoneId == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "host zone id cannot be empty")
+	}
+	if msg.Receiver == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "receiver cannot be empty")
+	}
+	// The new receiver is validated against the host zone's bech32 prefix in the msg server
+	if msg.NewReceiver == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "new receiver cannot be empty")
+	}
+	if msg.Receiver == msg.NewReceiver && msg.Creator == msg.NewOwner {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "new owner and new receiver cannot both match the current record")
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgTransferRedemptionRecord_ValidateBasic(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validOwner := apptesting.SampleStrideAddress()
+	validNewOwner := apptesting.CreateRandomAccounts(1)[0].String()
+	validReceiver := "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf"
+	validNewReceiver := apptesting.SampleHostAddress()
+
+	tests := []struct {
+		name string
+		msg  types.MsgTransferRedemptionRecord
+		err  error
+	}{
+		{
+			name: "success",
+			msg: types.MsgTransferRedemptionRecord{
+				Creator:     validOwner,
+				HostZoneId:  "GAIA",
+				Epoch:       1,
+				Receiver:    validReceiver,
+				NewOwner:    validNewOwner,
+				NewReceiver: validNewReceiver,
+			},
+		},
+		{
+			name: "success, only owner changes",
+			msg: types.MsgTransferRedemptionRecord{
+				Creator:     validOwner,
+				HostZoneId:  "GAIA",
+				Epoch:       1,
+				Receiver:    validReceiver,
+				NewOwner:    validNewOwner,
+				NewReceiver: validReceiver,
+			},
+		},
+		{
+			name: "invalid creator",
+			msg: types.MsgTransferRedemptionRecord{
+				Creator:     "invalid_address",
+				HostZoneId:  "GAIA",
+				Epoch:       1,
+				Receiver:    validReceiver,
+				NewOwner:    validNewOwner,
+				NewReceiver: validNewReceiver,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid new owner",
+			msg: types.MsgTransferRedemptionRecord{
+				Creator:     validOwner,
+				HostZoneId:  "GAIA",
+				Epoch:       1,
+				Receiver:    validReceiver,
+				NewOwner:    "invalid_address",
+				NewReceiver: validNewReceiver,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no host zone",
+			msg: types.MsgTransferRedemptionRecord{
+				Creator:     validOwner,
+				Epoch:       1,
+				Receiver:    validReceiver,
+				NewOwner:    validNewOwner,
+				NewReceiver: validNewReceiver,
+			},
+			err: types.ErrRequiredFieldEmpty,
+		},
+		{
+			name: "no receiver",
+			msg: types.MsgTransferRedemptionRecord{
+				Creator:     validOwner,
+				HostZoneId:  "GAIA",
+				Epoch:       1,
+				NewOwner:    validNewOwner,
+				NewReceiver: validNewReceiver,
+			},
+			err: types.ErrRequiredFieldEmpty,
+		},
+		{
+			name: "no new receiver",
+			msg: types.MsgTransferRedemptionRecord{
+				Creator:    validOwner,
+				HostZoneId: "GAIA",
+				Epoch:      1,
+				Receiver:   validReceiver,
+				NewOwner:   validNewOwner,
+			},
+			err: types.ErrRequiredFieldEmpty,
+		},
+		{
+			name: "nothing changes",
+			msg: types.MsgTransferRedemptionRecord{
+				Creator:     validOwner,
+				HostZoneId:  "GAIA",
+				Epoch:       1,
+				Receiver:    validReceiver,
+				NewOwner:    validOwner,
+				NewReceiver: validReceiver,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
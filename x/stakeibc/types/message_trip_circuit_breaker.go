@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgTripCircuitBreaker = "trip_circuit_breaker"
+
+var _ sdk.Msg = &MsgTripCircuitBreaker{}
+
+func NewMsgTripCircuitBreaker(signer, module, chainId string, actions []CircuitBreakerAction) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Signer:  signer,
+		Module:  module,
+		ChainId: chainId,
+		Actions: actions,
+	}
+}
+
+func (msg *MsgTripCircuitBreaker) Type() string {
+	return TypeMsgTripCircuitBreaker
+}
+
+func (msg *MsgTripCircuitBreaker) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	return ValidateCircuitBreakerScope(msg.Module, msg.ChainId, msg.Actions)
+}
@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v33/app/apptesting"
+	"github.com/Stride-Labs/stride/v33/x/stakeibc/types"
+)
+
+func TestMsgTripCircuitBreaker(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validSigner := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validChainId := "chain-0"
+	validActions := []types.CircuitBreakerAction{types.PAUSE_LIQUID_STAKE, types.PAUSE_REDEEM}
+
+	tests := []struct {
+		name string
+		msg  types.MsgTripCircuitBreaker
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgTripCircuitBreaker{
+				Signer:  validSigner,
+				Module:  types.ModuleName,
+				ChainId: validChainId,
+				Actions: validActions,
+			},
+		},
+		{
+			name: "successful message, multisig module",
+			msg: types.MsgTripCircuitBreaker{
+				Signer:  validSigner,
+				Module:  "stakedym",
+				ChainId: validChainId,
+				Actions: validActions,
+			},
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgTripCircuitBreaker{
+				Signer:  "",
+				Module:  types.ModuleName,
+				ChainId: validChainId,
+				Actions: validActions,
+			},
+			err: "invalid signer address",
+		},
+		{
+			name: "invalid module",
+			msg: types.MsgTripCircuitBreaker{
+				Signer:  validSigner,
+				Module:  "bank",
+				ChainId: validChainId,
+				Actions: validActions,
+			},
+			err: "invalid circuit breaker module",
+		},
+		{
+			name: "missing chain ID",
+			msg: types.MsgTripCircuitBreaker{
+				Signer:  validSigner,
+				Module:  types.ModuleName,
+				ChainId: "",
+				Actions: validActions,
+			},
+			err: "chain ID must be specified",
+		},
+		{
+			name: "missing actions",
+			msg: types.MsgTripCircuitBreaker{
+				Signer:  validSigner,
+				Module:  types.ModuleName,
+				ChainId: validChainId,
+				Actions: []types.CircuitBreakerAction{},
+			},
+			err: "at least one action must be specified",
+		},
+		{
+			name: "unspecified action",
+			msg: types.MsgTripCircuitBreaker{
+				Signer:  validSigner,
+				Module:  types.ModuleName,
+				ChainId: validChainId,
+				Actions: []types.CircuitBreakerAction{types.CIRCUIT_BREAKER_UNSPECIFIED},
+			},
+			err: "invalid circuit breaker action",
+		},
+		{
+			name: "duplicate action",
+			msg: types.MsgTripCircuitBreaker{
+				Signer:  validSigner,
+				Module:  types.ModuleName,
+				ChainId: validChainId,
+				Actions: []types.CircuitBreakerAction{types.PAUSE_REDEEM, types.PAUSE_REDEEM},
+			},
+			err: "duplicate circuit breaker action",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "trip_circuit_breaker")
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), validSigner)
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
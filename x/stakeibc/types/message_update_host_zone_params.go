@@ -32,5 +32,22 @@ func (msg *MsgUpdateHostZoneParams) ValidateBasic() error {
 	if msg.ChainId == "" {
 		return errors.New("chain ID must be specified")
 	}
+	if msg.FeeSchedule != nil && msg.RemoveFeeSchedule {
+		return errors.New("fee schedule cannot be both set and removed")
+	}
+	if msg.FeeSchedule != nil {
+		if err := msg.FeeSchedule.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid fee schedule")
+		}
+	}
+	if msg.MaxIcaTxGas != 0 && msg.RemoveMaxIcaTxGas {
+		return errors.New("max ica tx gas cannot be both set and removed")
+	}
+	if !msg.MinRebalanceAmount.IsNil() && msg.MinRebalanceAmount.IsNegative() {
+		return errors.New("min rebalance amount cannot be negative")
+	}
+	if !msg.MinRebalanceAmount.IsNil() && msg.MinRebalanceAmount.IsPositive() && msg.RemoveMinRebalanceAmount {
+		return errors.New("min rebalance amount cannot be both set and removed")
+	}
 	return nil
 }
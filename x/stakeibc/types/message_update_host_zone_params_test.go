@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -31,6 +33,94 @@ func TestMsgUpdateHostZoneParams(t *testing.T) {
 				MaxMessagesPerIcaTx: 30,
 			},
 		},
+		{
+			name: "successful message with fee schedule",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:           authority,
+				ChainId:             validChainId,
+				MaxMessagesPerIcaTx: 30,
+				FeeSchedule: &types.HostZoneFeeSchedule{
+					RewardCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.1"),
+					LiquidStakeFeeRate:   sdkmath.LegacyMustNewDecFromStr("0.001"),
+					RedemptionFeeRate:    sdkmath.LegacyZeroDec(),
+				},
+			},
+		},
+		{
+			name: "successful message removing fee schedule",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:         authority,
+				ChainId:           validChainId,
+				RemoveFeeSchedule: true,
+			},
+		},
+		{
+			name: "fee schedule set and removed",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority: authority,
+				ChainId:   validChainId,
+				FeeSchedule: &types.HostZoneFeeSchedule{
+					RewardCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.1"),
+					LiquidStakeFeeRate:   sdkmath.LegacyZeroDec(),
+					RedemptionFeeRate:    sdkmath.LegacyZeroDec(),
+				},
+				RemoveFeeSchedule: true,
+			},
+			err: "fee schedule cannot be both set and removed",
+		},
+		{
+			name: "successful message removing max ica tx gas",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:         authority,
+				ChainId:           validChainId,
+				RemoveMaxIcaTxGas: true,
+			},
+		},
+		{
+			name: "max ica tx gas set and removed",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:         authority,
+				ChainId:           validChainId,
+				MaxIcaTxGas:       4_000_000,
+				RemoveMaxIcaTxGas: true,
+			},
+			err: "max ica tx gas cannot be both set and removed",
+		},
+		{
+			name: "successful message with min rebalance amount",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:          authority,
+				ChainId:            validChainId,
+				MinRebalanceAmount: sdkmath.NewInt(1000),
+			},
+		},
+		{
+			name: "successful message removing min rebalance amount",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:                authority,
+				ChainId:                  validChainId,
+				RemoveMinRebalanceAmount: true,
+			},
+		},
+		{
+			name: "min rebalance amount set and removed",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:                authority,
+				ChainId:                  validChainId,
+				MinRebalanceAmount:       sdkmath.NewInt(1000),
+				RemoveMinRebalanceAmount: true,
+			},
+			err: "min rebalance amount cannot be both set and removed",
+		},
+		{
+			name: "negative min rebalance amount",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority:          authority,
+				ChainId:            validChainId,
+				MinRebalanceAmount: sdkmath.NewInt(-1),
+			},
+			err: "min rebalance amount cannot be negative",
+		},
 		{
 			name: "invalid authority",
 			msg: types.MsgUpdateHostZoneParams{
@@ -49,6 +139,57 @@ func TestMsgUpdateHostZoneParams(t *testing.T) {
 			},
 			err: "chain ID must be specified",
 		},
+		{
+			name: "reward commission above one",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority: authority,
+				ChainId:   validChainId,
+				FeeSchedule: &types.HostZoneFeeSchedule{
+					RewardCommissionRate: sdkmath.LegacyMustNewDecFromStr("1.01"),
+					LiquidStakeFeeRate:   sdkmath.LegacyZeroDec(),
+					RedemptionFeeRate:    sdkmath.LegacyZeroDec(),
+				},
+			},
+			err: "reward commission rate must be between 0 and 1",
+		},
+		{
+			name: "liquid stake fee of one",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority: authority,
+				ChainId:   validChainId,
+				FeeSchedule: &types.HostZoneFeeSchedule{
+					RewardCommissionRate: sdkmath.LegacyZeroDec(),
+					LiquidStakeFeeRate:   sdkmath.LegacyOneDec(),
+					RedemptionFeeRate:    sdkmath.LegacyZeroDec(),
+				},
+			},
+			err: "liquid stake fee rate must be at least 0 and less than 1",
+		},
+		{
+			name: "negative redemption fee",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority: authority,
+				ChainId:   validChainId,
+				FeeSchedule: &types.HostZoneFeeSchedule{
+					RewardCommissionRate: sdkmath.LegacyZeroDec(),
+					LiquidStakeFeeRate:   sdkmath.LegacyZeroDec(),
+					RedemptionFeeRate:    sdkmath.LegacyMustNewDecFromStr("-0.01"),
+				},
+			},
+			err: "redemption fee rate must be at least 0 and less than 1",
+		},
+		{
+			name: "missing fee",
+			msg: types.MsgUpdateHostZoneParams{
+				Authority: authority,
+				ChainId:   validChainId,
+				FeeSchedule: &types.HostZoneFeeSchedule{
+					RewardCommissionRate: sdkmath.LegacyZeroDec(),
+					LiquidStakeFeeRate:   sdkmath.LegacyZeroDec(),
+				},
+			},
+			err: "redemption fee rate must be at least 0 and less than 1",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,1305 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/onboarding.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Configuration from a host zone's onboarding proposal that is needed to
+// follow up on the steps that complete after registration
+type HostZoneOnboarding struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Trade route requested during onboarding, which is created once the
+	// withdrawal ICA is registered
+	TradeRoute *MsgCreateTradeRoute `protobuf:"bytes,2,opt,name=trade_route,json=tradeRoute,proto3" json:"trade_route,omitempty"`
+	// Chain ID of the oracle added during onboarding
+	OracleChainId string `protobuf:"bytes,3,opt,name=oracle_chain_id,json=oracleChainId,proto3" json:"oracle_chain_id,omitempty"`
+}
+
+func (m *HostZoneOnboarding) Reset()         { *m = HostZoneOnboarding{} }
+func (m *HostZoneOnboarding) String() string { return proto.CompactTextString(m) }
+func (*HostZoneOnboarding) ProtoMessage()    {}
+func (*HostZoneOnboarding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a4c0b4e84b1f60f, []int{0}
+}
+func (m *HostZoneOnboarding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostZoneOnboarding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostZoneOnboarding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostZoneOnboarding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostZoneOnboarding.Merge(m, src)
+}
+func (m *HostZoneOnboarding) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostZoneOnboarding) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostZoneOnboarding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostZoneOnboarding proto.InternalMessageInfo
+
+func (m *HostZoneOnboarding) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *HostZoneOnboarding) GetTradeRoute() *MsgCreateTradeRoute {
+	if m != nil {
+		return m.TradeRoute
+	}
+	return nil
+}
+
+func (m *HostZoneOnboarding) GetOracleChainId() string {
+	if m != nil {
+		return m.OracleChainId
+	}
+	return ""
+}
+
+// Registration status of a host zone ICA
+type OnboardingIcaStatus struct {
+	IcaType ICAAccountType `protobuf:"varint,1,opt,name=ica_type,json=icaType,proto3,enum=stride.stakeibc.ICAAccountType" json:"ica_type,omitempty"`
+	// ICA address, set once the channel handshake completes
+	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ChannelOpen bool   `protobuf:"varint,3,opt,name=channel_open,json=channelOpen,proto3" json:"channel_open,omitempty"`
+}
+
+func (m *OnboardingIcaStatus) Reset()         { *m = OnboardingIcaStatus{} }
+func (m *OnboardingIcaStatus) String() string { return proto.CompactTextString(m) }
+func (*OnboardingIcaStatus) ProtoMessage()    {}
+func (*OnboardingIcaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a4c0b4e84b1f60f, []int{1}
+}
+func (m *OnboardingIcaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnboardingIcaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OnboardingIcaStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OnboardingIcaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnboardingIcaStatus.Merge(m, src)
+}
+func (m *OnboardingIcaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnboardingIcaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnboardingIcaStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnboardingIcaStatus proto.InternalMessageInfo
+
+func (m *OnboardingIcaStatus) GetIcaType() ICAAccountType {
+	if m != nil {
+		return m.IcaType
+	}
+	return ICAAccountType_DELEGATION
+}
+
+func (m *OnboardingIcaStatus) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *OnboardingIcaStatus) GetChannelOpen() bool {
+	if m != nil {
+		return m.ChannelOpen
+	}
+	return false
+}
+
+// Post-registration checklist for a host zone
+type HostZoneOnboardingChecklist struct {
+	ChainId     string                `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	IcaChannels []OnboardingIcaStatus `protobuf:"bytes,2,rep,name=ica_channels,json=icaChannels,proto3" json:"ica_channels"`
+	// Number of validators on the host zone
+	NumValidators uint64 `protobuf:"varint,3,opt,name=num_validators,json=numValidators,proto3" json:"num_validators,omitempty"`
+	// Number of validators whose sharesToTokens rate has been returned by an ICQ
+	NumValidatorsQueried uint64 `protobuf:"varint,4,opt,name=num_validators_queried,json=numValidatorsQueried,proto3" json:"num_validators_queried,omitempty"`
+	// Number of ICQs that have been submitted for the host zone and are still
+	// awaiting a response
+	NumPendingIcqs      uint64 `protobuf:"varint,5,opt,name=num_pending_icqs,json=numPendingIcqs,proto3" json:"num_pending_icqs,omitempty"`
+	TradeRouteRequested bool   `protobuf:"varint,6,opt,name=trade_route_requested,json=tradeRouteRequested,proto3" json:"trade_route_requested,omitempty"`
+	TradeRouteCreated   bool   `protobuf:"varint,7,opt,name=trade_route_created,json=tradeRouteCreated,proto3" json:"trade_route_created,omitempty"`
+	// Chain ID of the oracle added during onboarding, if applicable
+	OracleChainId      string `protobuf:"bytes,8,opt,name=oracle_chain_id,json=oracleChainId,proto3" json:"oracle_chain_id,omitempty"`
+	OracleChannelOpen  bool   `protobuf:"varint,9,opt,name=oracle_channel_open,json=oracleChannelOpen,proto3" json:"oracle_channel_open,omitempty"`
+	OracleInstantiated bool   `protobuf:"varint,10,opt,name=oracle_instantiated,json=oracleInstantiated,proto3" json:"oracle_instantiated,omitempty"`
+	// True once every applicable step has completed
+	Complete bool `protobuf:"varint,11,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (m *HostZoneOnboardingChecklist) Reset()         { *m = HostZoneOnboardingChecklist{} }
+func (m *HostZoneOnboardingChecklist) String() string { return proto.CompactTextString(m) }
+func (*HostZoneOnboardingChecklist) ProtoMessage()    {}
+func (*HostZoneOnboardingChecklist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a4c0b4e84b1f60f, []int{2}
+}
+func (m *HostZoneOnboardingChecklist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostZoneOnboardingChecklist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostZoneOnboardingChecklist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostZoneOnboardingChecklist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostZoneOnboardingChecklist.Merge(m, src)
+}
+func (m *HostZoneOnboardingChecklist) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostZoneOnboardingChecklist) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostZoneOnboardingChecklist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostZoneOnboardingChecklist proto.InternalMessageInfo
+
+func (m *HostZoneOnboardingChecklist) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *HostZoneOnboardingChecklist) GetIcaChannels() []OnboardingIcaStatus {
+	if m != nil {
+		return m.IcaChannels
+	}
+	return nil
+}
+
+func (m *HostZoneOnboardingChecklist) GetNumValidators() uint64 {
+	if m != nil {
+		return m.NumValidators
+	}
+	return 0
+}
+
+func (m *HostZoneOnboardingChecklist) GetNumValidatorsQueried() uint64 {
+	if m != nil {
+		return m.NumValidatorsQueried
+	}
+	return 0
+}
+
+func (m *HostZoneOnboardingChecklist) GetNumPendingIcqs() uint64 {
+	if m != nil {
+		return m.NumPendingIcqs
+	}
+	return 0
+}
+
+func (m *HostZoneOnboardingChecklist) GetTradeRouteRequested() bool {
+	if m != nil {
+		return m.TradeRouteRequested
+	}
+	return false
+}
+
+func (m *HostZoneOnboardingChecklist) GetTradeRouteCreated() bool {
+	if m != nil {
+		return m.TradeRouteCreated
+	}
+	return false
+}
+
+func (m *HostZoneOnboardingChecklist) GetOracleChainId() string {
+	if m != nil {
+		return m.OracleChainId
+	}
+	return ""
+}
+
+func (m *HostZoneOnboardingChecklist) GetOracleChannelOpen() bool {
+	if m != nil {
+		return m.OracleChannelOpen
+	}
+	return false
+}
+
+func (m *HostZoneOnboardingChecklist) GetOracleInstantiated() bool {
+	if m != nil {
+		return m.OracleInstantiated
+	}
+	return false
+}
+
+func (m *HostZoneOnboardingChecklist) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*HostZoneOnboarding)(nil), "stride.stakeibc.HostZoneOnboarding")
+	proto.RegisterType((*OnboardingIcaStatus)(nil), "stride.stakeibc.OnboardingIcaStatus")
+	proto.RegisterType((*HostZoneOnboardingChecklist)(nil), "stride.stakeibc.HostZoneOnboardingChecklist")
+}
+
+func init() { proto.RegisterFile("stride/stakeibc/onboarding.proto", fileDescriptor_0a4c0b4e84b1f60f) }
+
+var fileDescriptor_0a4c0b4e84b1f60f = []byte{
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xc7, 0xb3, 0x34, 0x34, 0xa9, 0xd3, 0x0f, 0x70, 0x0b, 0x5a, 0x82, 0xb4, 0x4d, 0x23, 0x40,
+	0xb9, 0xb0, 0x2b, 0x25, 0x9c, 0xb8, 0xb5, 0x11, 0x12, 0x91, 0x5a, 0x15, 0xb6, 0x15, 0x87, 0x5e,
+	0x56, 0x8e, 0x3d, 0x4a, 0xac, 0x26, 0xf6, 0x66, 0xed, 0xad, 0x9a, 0x97, 0x40, 0xdc, 0xb8, 0xf1,
+	0x3c, 0x3d, 0xf6, 0xc8, 0x09, 0xa1, 0xe4, 0x45, 0xd0, 0xda, 0xc9, 0x26, 0x4d, 0x23, 0x6e, 0xf6,
+	0xcc, 0x6f, 0x66, 0xfe, 0x9e, 0x19, 0xa3, 0x9a, 0xd2, 0x09, 0x67, 0x10, 0x28, 0x4d, 0xae, 0x81,
+	0x77, 0x69, 0x20, 0x45, 0x57, 0x92, 0x84, 0x71, 0xd1, 0xf3, 0xe3, 0x44, 0x6a, 0x89, 0xf7, 0x2c,
+	0xe1, 0xcf, 0x89, 0xea, 0x41, 0x4f, 0xf6, 0xa4, 0xf1, 0x05, 0xd9, 0xc9, 0x62, 0xd5, 0xa3, 0xd5,
+	0x44, 0x9c, 0x92, 0x88, 0x50, 0x2a, 0x53, 0xa1, 0x67, 0x88, 0xbb, 0x8a, 0xe8, 0x5b, 0xeb, 0xa9,
+	0xff, 0x72, 0x10, 0xfe, 0x2c, 0x95, 0xbe, 0x92, 0x02, 0xce, 0x73, 0x01, 0xf8, 0x15, 0x2a, 0xd3,
+	0x3e, 0xe1, 0x22, 0xe2, 0xcc, 0x75, 0x6a, 0x4e, 0x63, 0x2b, 0x2c, 0x99, 0x7b, 0x87, 0xe1, 0x4f,
+	0xa8, 0xa2, 0x13, 0xc2, 0x20, 0x4a, 0x64, 0xaa, 0xc1, 0x7d, 0x52, 0x73, 0x1a, 0x95, 0xe6, 0x1b,
+	0x7f, 0x45, 0xab, 0x7f, 0xa6, 0x7a, 0xed, 0x04, 0x88, 0x86, 0xcb, 0x0c, 0x0e, 0x33, 0x36, 0x44,
+	0x3a, 0x3f, 0xe3, 0x77, 0x68, 0x4f, 0x26, 0x84, 0x0e, 0x20, 0xca, 0x0b, 0x6d, 0x98, 0x42, 0x3b,
+	0xd6, 0xdc, 0xb6, 0xe5, 0xea, 0xdf, 0x1d, 0xb4, 0xbf, 0x10, 0xd6, 0xa1, 0xe4, 0x42, 0x13, 0x9d,
+	0x2a, 0xfc, 0x11, 0x95, 0xb3, 0x77, 0xea, 0x71, 0x0c, 0x46, 0xe1, 0x6e, 0xf3, 0xf0, 0x91, 0x86,
+	0x4e, 0xfb, 0xf8, 0xd8, 0xf6, 0xe1, 0x72, 0x1c, 0x43, 0x58, 0xe2, 0x94, 0x64, 0x07, 0xec, 0xa2,
+	0x12, 0x61, 0x2c, 0x01, 0xa5, 0x8c, 0xfc, 0xad, 0x70, 0x7e, 0xc5, 0x47, 0x68, 0x9b, 0xf6, 0x89,
+	0x10, 0x30, 0x88, 0x64, 0x0c, 0xc2, 0x48, 0x2a, 0x87, 0x95, 0x99, 0xed, 0x3c, 0x06, 0x51, 0xff,
+	0x59, 0x44, 0xaf, 0x1f, 0x77, 0xac, 0xdd, 0x07, 0x7a, 0x3d, 0xe0, 0x4a, 0xff, 0xaf, 0x75, 0x67,
+	0x68, 0x3b, 0xd3, 0x3c, 0xcb, 0x96, 0x15, 0xdf, 0x58, 0xdb, 0xbb, 0x35, 0xef, 0x3d, 0x29, 0xde,
+	0xfd, 0x39, 0x2c, 0x84, 0x15, 0x4e, 0x49, 0x7b, 0x16, 0x8e, 0xdf, 0xa2, 0x5d, 0x91, 0x0e, 0xa3,
+	0x1b, 0x32, 0xe0, 0x8c, 0x68, 0x99, 0x28, 0x23, 0xb7, 0x18, 0xee, 0x88, 0x74, 0xf8, 0x2d, 0x37,
+	0xe2, 0x0f, 0xe8, 0xe5, 0x43, 0x2c, 0x1a, 0xa5, 0x90, 0x70, 0x60, 0x6e, 0xd1, 0xe0, 0x07, 0x0f,
+	0xf0, 0xaf, 0xd6, 0x87, 0x1b, 0xe8, 0x59, 0x16, 0x15, 0x83, 0xc8, 0x74, 0x44, 0x9c, 0x8e, 0x94,
+	0xfb, 0xd4, 0xf0, 0x59, 0xd1, 0x2f, 0xd6, 0xdc, 0xa1, 0x23, 0x85, 0x9b, 0xe8, 0xc5, 0xd2, 0x42,
+	0x44, 0x09, 0x8c, 0x52, 0x50, 0x1a, 0x98, 0xbb, 0x69, 0x9a, 0xb7, 0xbf, 0x18, 0x7a, 0x38, 0x77,
+	0x61, 0x1f, 0xed, 0x2f, 0xc7, 0x50, 0xb3, 0x29, 0xcc, 0x2d, 0x99, 0x88, 0xe7, 0x8b, 0x08, 0xbb,
+	0x42, 0x6c, 0xdd, 0xb6, 0x94, 0xd7, 0x6c, 0x4b, 0x96, 0x77, 0xc1, 0x2d, 0xc6, 0xb8, 0x65, 0xf3,
+	0xe6, 0xec, 0x7c, 0x98, 0x38, 0xc8, 0x79, 0x2e, 0x94, 0x26, 0x42, 0x73, 0xa3, 0x03, 0x19, 0x1e,
+	0x5b, 0x57, 0x67, 0xc9, 0x83, 0xab, 0xa8, 0x4c, 0xe5, 0x30, 0x1e, 0x80, 0x06, 0xb7, 0x62, 0xa8,
+	0xfc, 0x7e, 0x72, 0x7a, 0x37, 0xf1, 0x9c, 0xfb, 0x89, 0xe7, 0xfc, 0x9d, 0x78, 0xce, 0x8f, 0xa9,
+	0x57, 0xb8, 0x9f, 0x7a, 0x85, 0xdf, 0x53, 0xaf, 0x70, 0xd5, 0xec, 0x71, 0xdd, 0x4f, 0xbb, 0x3e,
+	0x95, 0xc3, 0xe0, 0xc2, 0x0c, 0xfb, 0xfd, 0x29, 0xe9, 0xaa, 0x60, 0xf6, 0x2d, 0x6f, 0x5a, 0xad,
+	0xe0, 0x76, 0xe9, 0x73, 0x8e, 0x63, 0x50, 0xdd, 0x4d, 0xf3, 0x41, 0x5b, 0xff, 0x06, 0x00, 0x3c,
+	0xef, 0x36, 0xcd, 0x28, 0x04, 0x00, 0x00,
+}
+
+func (m *HostZoneOnboarding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostZoneOnboarding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZoneOnboarding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleChainId) > 0 {
+		i -= len(m.OracleChainId)
+		copy(dAtA[i:], m.OracleChainId)
+		i = encodeVarintOnboarding(dAtA, i, uint64(len(m.OracleChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TradeRoute != nil {
+		{
+			size, err := m.TradeRoute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOnboarding(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintOnboarding(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OnboardingIcaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnboardingIcaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnboardingIcaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChannelOpen {
+		i--
+		if m.ChannelOpen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOnboarding(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IcaType != 0 {
+		i = encodeVarintOnboarding(dAtA, i, uint64(m.IcaType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostZoneOnboardingChecklist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostZoneOnboardingChecklist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZoneOnboardingChecklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.OracleInstantiated {
+		i--
+		if m.OracleInstantiated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.OracleChannelOpen {
+		i--
+		if m.OracleChannelOpen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.OracleChainId) > 0 {
+		i -= len(m.OracleChainId)
+		copy(dAtA[i:], m.OracleChainId)
+		i = encodeVarintOnboarding(dAtA, i, uint64(len(m.OracleChainId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TradeRouteCreated {
+		i--
+		if m.TradeRouteCreated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TradeRouteRequested {
+		i--
+		if m.TradeRouteRequested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumPendingIcqs != 0 {
+		i = encodeVarintOnboarding(dAtA, i, uint64(m.NumPendingIcqs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumValidatorsQueried != 0 {
+		i = encodeVarintOnboarding(dAtA, i, uint64(m.NumValidatorsQueried))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumValidators != 0 {
+		i = encodeVarintOnboarding(dAtA, i, uint64(m.NumValidators))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.IcaChannels) > 0 {
+		for iNdEx := len(m.IcaChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOnboarding(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintOnboarding(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOnboarding(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOnboarding(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HostZoneOnboarding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovOnboarding(uint64(l))
+	}
+	if m.TradeRoute != nil {
+		l = m.TradeRoute.Size()
+		n += 1 + l + sovOnboarding(uint64(l))
+	}
+	l = len(m.OracleChainId)
+	if l > 0 {
+		n += 1 + l + sovOnboarding(uint64(l))
+	}
+	return n
+}
+
+func (m *OnboardingIcaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IcaType != 0 {
+		n += 1 + sovOnboarding(uint64(m.IcaType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOnboarding(uint64(l))
+	}
+	if m.ChannelOpen {
+		n += 2
+	}
+	return n
+}
+
+func (m *HostZoneOnboardingChecklist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovOnboarding(uint64(l))
+	}
+	if len(m.IcaChannels) > 0 {
+		for _, e := range m.IcaChannels {
+			l = e.Size()
+			n += 1 + l + sovOnboarding(uint64(l))
+		}
+	}
+	if m.NumValidators != 0 {
+		n += 1 + sovOnboarding(uint64(m.NumValidators))
+	}
+	if m.NumValidatorsQueried != 0 {
+		n += 1 + sovOnboarding(uint64(m.NumValidatorsQueried))
+	}
+	if m.NumPendingIcqs != 0 {
+		n += 1 + sovOnboarding(uint64(m.NumPendingIcqs))
+	}
+	if m.TradeRouteRequested {
+		n += 2
+	}
+	if m.TradeRouteCreated {
+		n += 2
+	}
+	l = len(m.OracleChainId)
+	if l > 0 {
+		n += 1 + l + sovOnboarding(uint64(l))
+	}
+	if m.OracleChannelOpen {
+		n += 2
+	}
+	if m.OracleInstantiated {
+		n += 2
+	}
+	if m.Complete {
+		n += 2
+	}
+	return n
+}
+
+func sovOnboarding(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOnboarding(x uint64) (n int) {
+	return sovOnboarding(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HostZoneOnboarding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnboarding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZoneOnboarding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZoneOnboarding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRoute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradeRoute == nil {
+				m.TradeRoute = &MsgCreateTradeRoute{}
+			}
+			if err := m.TradeRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnboarding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OnboardingIcaStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnboarding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnboardingIcaStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnboardingIcaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaType", wireType)
+			}
+			m.IcaType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IcaType |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOpen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChannelOpen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnboarding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostZoneOnboardingChecklist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnboarding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZoneOnboardingChecklist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZoneOnboardingChecklist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaChannels = append(m.IcaChannels, OnboardingIcaStatus{})
+			if err := m.IcaChannels[len(m.IcaChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidators", wireType)
+			}
+			m.NumValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidatorsQueried", wireType)
+			}
+			m.NumValidatorsQueried = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumValidatorsQueried |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPendingIcqs", wireType)
+			}
+			m.NumPendingIcqs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPendingIcqs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRouteRequested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TradeRouteRequested = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRouteCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TradeRouteCreated = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleChannelOpen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OracleChannelOpen = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleInstantiated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OracleInstantiated = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnboarding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnboarding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOnboarding(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOnboarding
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOnboarding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOnboarding
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOnboarding
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOnboarding
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOnboarding        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOnboarding          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOnboarding = fmt.Errorf("proto: unexpected end of group")
+)
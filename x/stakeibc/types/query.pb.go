@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -1011,1598 +1014,9777 @@ func (m *QueryAllTradeRoutesResponse) GetTradeRoutes() []TradeRoute {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryInterchainAccountFromAddressRequest)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressRequest")
-	proto.RegisterType((*QueryInterchainAccountFromAddressResponse)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "stride.stakeibc.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "stride.stakeibc.QueryParamsResponse")
-	proto.RegisterType((*QueryGetValidatorsRequest)(nil), "stride.stakeibc.QueryGetValidatorsRequest")
-	proto.RegisterType((*QueryGetValidatorsResponse)(nil), "stride.stakeibc.QueryGetValidatorsResponse")
-	proto.RegisterType((*QueryGetHostZoneRequest)(nil), "stride.stakeibc.QueryGetHostZoneRequest")
-	proto.RegisterType((*QueryGetHostZoneResponse)(nil), "stride.stakeibc.QueryGetHostZoneResponse")
-	proto.RegisterType((*QueryAllHostZoneRequest)(nil), "stride.stakeibc.QueryAllHostZoneRequest")
-	proto.RegisterType((*QueryAllHostZoneResponse)(nil), "stride.stakeibc.QueryAllHostZoneResponse")
-	proto.RegisterType((*QueryModuleAddressRequest)(nil), "stride.stakeibc.QueryModuleAddressRequest")
-	proto.RegisterType((*QueryModuleAddressResponse)(nil), "stride.stakeibc.QueryModuleAddressResponse")
-	proto.RegisterType((*QueryGetEpochTrackerRequest)(nil), "stride.stakeibc.QueryGetEpochTrackerRequest")
-	proto.RegisterType((*QueryGetEpochTrackerResponse)(nil), "stride.stakeibc.QueryGetEpochTrackerResponse")
-	proto.RegisterType((*QueryAllEpochTrackerRequest)(nil), "stride.stakeibc.QueryAllEpochTrackerRequest")
-	proto.RegisterType((*QueryAllEpochTrackerResponse)(nil), "stride.stakeibc.QueryAllEpochTrackerResponse")
-	proto.RegisterType((*QueryGetNextPacketSequenceRequest)(nil), "stride.stakeibc.QueryGetNextPacketSequenceRequest")
-	proto.RegisterType((*QueryGetNextPacketSequenceResponse)(nil), "stride.stakeibc.QueryGetNextPacketSequenceResponse")
-	proto.RegisterType((*QueryAddressUnbondings)(nil), "stride.stakeibc.QueryAddressUnbondings")
-	proto.RegisterType((*QueryAddressUnbondingsResponse)(nil), "stride.stakeibc.QueryAddressUnbondingsResponse")
-	proto.RegisterType((*QueryAllTradeRoutes)(nil), "stride.stakeibc.QueryAllTradeRoutes")
-	proto.RegisterType((*QueryAllTradeRoutesResponse)(nil), "stride.stakeibc.QueryAllTradeRoutesResponse")
+type QueryBasketRequest struct {
+	BasketId string `protobuf:"bytes,1,opt,name=basket_id,json=basketId,proto3" json:"basket_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("stride/stakeibc/query.proto", fileDescriptor_494b786fe66f2b80) }
-
-var fileDescriptor_494b786fe66f2b80 = []byte{
-	// 1239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x97, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xc7, 0xb3, 0x6d, 0x9a, 0xa6, 0x27, 0x49, 0xf3, 0x74, 0x9e, 0x40, 0x9c, 0x4d, 0xe2, 0x90,
-	0x69, 0x68, 0x5e, 0x9a, 0x7a, 0x89, 0x53, 0x90, 0x1a, 0x51, 0x81, 0x23, 0xda, 0xc6, 0x28, 0xa0,
-	0xe0, 0x96, 0x0a, 0x95, 0x0b, 0x6b, 0xbc, 0x3b, 0xd8, 0xab, 0xae, 0x77, 0xdc, 0xdd, 0x71, 0x48,
-	0x88, 0xac, 0x4a, 0x7c, 0x00, 0x54, 0x81, 0x10, 0x12, 0x77, 0x45, 0x5c, 0x70, 0xcd, 0xa7, 0xe8,
-	0x1d, 0x95, 0xb8, 0xe1, 0x2a, 0x42, 0x09, 0x9f, 0xa0, 0x9f, 0x00, 0xed, 0xec, 0xec, 0x7a, 0xbd,
-	0x2f, 0xc6, 0xe9, 0xdd, 0xee, 0xcc, 0x79, 0xf9, 0xed, 0x39, 0x33, 0xe7, 0x6f, 0xc3, 0xac, 0xcb,
-	0x1d, 0xd3, 0xa0, 0x9a, 0xcb, 0xc9, 0x63, 0x6a, 0xd6, 0x74, 0xed, 0x49, 0x9b, 0x3a, 0x87, 0x85,
-	0x96, 0xc3, 0x38, 0x43, 0x93, 0xfe, 0x66, 0x21, 0xd8, 0x54, 0xd7, 0x74, 0xe6, 0x36, 0x99, 0xab,
-	0xd5, 0x88, 0x4b, 0x7d, 0x4b, 0x6d, 0x7f, 0xa3, 0x46, 0x39, 0xd9, 0xd0, 0x5a, 0xa4, 0x6e, 0xda,
-	0x84, 0x9b, 0xcc, 0xf6, 0x9d, 0xd5, 0xa9, 0x3a, 0xab, 0x33, 0xf1, 0xa8, 0x79, 0x4f, 0x72, 0x75,
-	0xae, 0xce, 0x58, 0xdd, 0xa2, 0x1a, 0x69, 0x99, 0x1a, 0xb1, 0x6d, 0xc6, 0x85, 0x8b, 0x2b, 0x77,
-	0x97, 0xe3, 0x34, 0xc4, 0x30, 0x1c, 0xea, 0xba, 0xd5, 0xb6, 0x5d, 0x63, 0xb6, 0x61, 0xda, 0x75,
-	0x69, 0x78, 0x35, 0x6e, 0x48, 0x5b, 0x4c, 0x6f, 0x54, 0xb9, 0x43, 0xf4, 0xc7, 0xd4, 0x91, 0x46,
-	0x0b, 0x71, 0xa3, 0x06, 0x73, 0x79, 0xf5, 0x1b, 0x66, 0xd3, 0x00, 0x26, 0x6e, 0xd0, 0x22, 0x0e,
-	0x69, 0x06, 0x30, 0x8b, 0xf1, 0x5d, 0xee, 0x10, 0x83, 0x56, 0x1d, 0xd6, 0xe6, 0x34, 0x2b, 0xc3,
-	0x3e, 0xb1, 0x4c, 0x83, 0x70, 0x26, 0x11, 0xf0, 0x53, 0x58, 0xf9, 0xcc, 0x2b, 0x53, 0xd9, 0xe6,
-	0xd4, 0xd1, 0x1b, 0xc4, 0xb4, 0x4b, 0xba, 0xce, 0xda, 0x36, 0xbf, 0xeb, 0xb0, 0x66, 0xc9, 0xff,
-	0xb8, 0x0a, 0x7d, 0xd2, 0xa6, 0x2e, 0x47, 0x53, 0x70, 0x81, 0x7d, 0x6d, 0x53, 0x27, 0xa7, 0xbc,
-	0xa5, 0xac, 0x5c, 0xaa, 0xf8, 0x2f, 0xe8, 0x36, 0x4c, 0xe8, 0xcc, 0xb6, 0xa9, 0xee, 0xd5, 0xa9,
-	0x6a, 0x1a, 0xb9, 0x73, 0xde, 0xee, 0x76, 0xee, 0xd5, 0xf1, 0xc2, 0xd4, 0x21, 0x69, 0x5a, 0x5b,
-	0xb8, 0x67, 0x1b, 0x57, 0xc6, 0xbb, 0xef, 0x65, 0x03, 0x3f, 0x53, 0x60, 0x75, 0x00, 0x02, 0xb7,
-	0xc5, 0x6c, 0x97, 0x22, 0x1d, 0x54, 0x33, 0xb4, 0xab, 0x12, 0xdf, 0xb0, 0x2a, 0x9b, 0xe0, 0x73,
-	0x6d, 0xbf, 0xfd, 0xea, 0x78, 0x61, 0xd1, 0xcf, 0x9c, 0x6d, 0x8b, 0x2b, 0x39, 0x33, 0x9e, 0x50,
-	0x26, 0xc3, 0x53, 0x80, 0x04, 0xd1, 0x9e, 0x28, 0xb6, 0xfc, 0x7a, 0xbc, 0x0b, 0xff, 0xef, 0x59,
-	0x95, 0x44, 0xef, 0xc2, 0x88, 0xdf, 0x14, 0x91, 0x7d, 0xac, 0x38, 0x5d, 0x88, 0x9d, 0xc9, 0x82,
-	0xef, 0xb0, 0x3d, 0xfc, 0xe2, 0x78, 0x61, 0xa8, 0x22, 0x8d, 0xf1, 0x7b, 0x30, 0x23, 0xa2, 0xdd,
-	0xa3, 0xfc, 0x61, 0xd0, 0x92, 0xb0, 0xd0, 0x33, 0x30, 0xea, 0x43, 0x9b, 0x86, 0xac, 0xf5, 0x45,
-	0xf1, 0x5e, 0x36, 0xf0, 0x17, 0xa0, 0xa6, 0xf9, 0x49, 0x98, 0x2d, 0x80, 0xb0, 0xc1, 0x1e, 0xd0,
-	0xf9, 0x95, 0xb1, 0xa2, 0x9a, 0x00, 0x0a, 0x1d, 0x2b, 0x11, 0x6b, 0x7c, 0x13, 0xa6, 0x83, 0xc8,
-	0x3b, 0xcc, 0xe5, 0x8f, 0x98, 0x4d, 0x07, 0xe2, 0xc9, 0x25, 0xbd, 0x24, 0xcd, 0xfb, 0x70, 0x29,
-	0x3c, 0xd0, 0xb2, 0x3a, 0x33, 0x09, 0x98, 0xc0, 0x4b, 0xd6, 0x67, 0xb4, 0x21, 0xdf, 0x31, 0x91,
-	0x3c, 0x25, 0xcb, 0x8a, 0xf3, 0xdc, 0x05, 0xe8, 0xde, 0x66, 0x19, 0xf9, 0x5a, 0xc1, 0xbf, 0xfa,
-	0x05, 0xef, 0xea, 0x17, 0xfc, 0x21, 0x21, 0xaf, 0x7e, 0x61, 0x8f, 0xd4, 0x03, 0xdf, 0x4a, 0xc4,
-	0x13, 0x3f, 0x57, 0x24, 0x7d, 0x4f, 0x8e, 0x74, 0xfa, 0xf3, 0x67, 0xa2, 0x47, 0xf7, 0x7a, 0x10,
-	0xcf, 0x09, 0xc4, 0xe5, 0xff, 0x44, 0xf4, 0x53, 0xf7, 0x30, 0x6a, 0xf2, 0xa0, 0x7c, 0xc2, 0x8c,
-	0xb6, 0x45, 0x63, 0x37, 0x12, 0xc1, 0xb0, 0x4d, 0x9a, 0x54, 0x36, 0x45, 0x3c, 0xe3, 0x77, 0xe4,
-	0x09, 0x89, 0x39, 0xc8, 0xaf, 0x42, 0x30, 0xec, 0xdd, 0x80, 0xc0, 0xc3, 0x7b, 0xc6, 0x3b, 0x30,
-	0x1b, 0xf4, 0xf0, 0x8e, 0x37, 0xa5, 0x1e, 0xf8, 0x43, 0x2a, 0x48, 0xb2, 0x0a, 0xff, 0xf3, 0x87,
-	0x97, 0x69, 0x50, 0x9b, 0x9b, 0x5f, 0x99, 0xe1, 0x04, 0x98, 0x14, 0xeb, 0xe5, 0x70, 0x19, 0x37,
-	0x60, 0x2e, 0x3d, 0x92, 0xcc, 0xbe, 0x03, 0x13, 0x3d, 0x73, 0x50, 0xf6, 0x6e, 0x3e, 0x51, 0xd7,
-	0xa8, 0xb7, 0xac, 0xed, 0x38, 0x8d, 0xac, 0xe1, 0x79, 0xc9, 0x5c, 0xb2, 0xac, 0x14, 0xe6, 0x10,
-	0x24, 0xb1, 0x9d, 0x0d, 0x72, 0xfe, 0xf5, 0x40, 0xbe, 0x84, 0xc5, 0xe0, 0x93, 0x3f, 0xa5, 0x07,
-	0x7c, 0xcf, 0x5b, 0xe5, 0xf7, 0x3d, 0x0c, 0x5b, 0x0f, 0x0f, 0xec, 0x3c, 0x80, 0xde, 0x20, 0xb6,
-	0x4d, 0xad, 0xee, 0x15, 0xba, 0x24, 0x57, 0xca, 0x06, 0x9a, 0x86, 0x8b, 0x2d, 0xe6, 0xf0, 0x70,
-	0x78, 0x56, 0x46, 0xbc, 0xd7, 0xb2, 0x81, 0x3f, 0x04, 0xdc, 0x2f, 0xb8, 0xfc, 0x18, 0x15, 0x46,
-	0x5d, 0xb9, 0x26, 0x62, 0x0f, 0x57, 0xc2, 0x77, 0x5c, 0x84, 0x37, 0xfd, 0x42, 0xf8, 0xe7, 0xe0,
-	0xf3, 0x40, 0xa6, 0x5c, 0x94, 0x83, 0x8b, 0x3d, 0x73, 0xb3, 0x12, 0xbc, 0xe2, 0x03, 0xc8, 0xa7,
-	0xfb, 0x84, 0x19, 0x1f, 0x02, 0x4a, 0x08, 0x5f, 0x30, 0x6f, 0x16, 0x13, 0x35, 0x8c, 0xc7, 0x91,
-	0x75, 0xbc, 0x42, 0xe2, 0xf1, 0xf1, 0x1b, 0x72, 0xc6, 0x96, 0x2c, 0xeb, 0x81, 0xa7, 0x65, 0x15,
-	0x4f, 0xca, 0x5c, 0xac, 0x77, 0x9b, 0x1d, 0x59, 0x0e, 0x69, 0x3e, 0x82, 0xf1, 0x88, 0xf2, 0x05,
-	0x1c, 0xb3, 0x09, 0x8e, 0xae, 0xaf, 0x24, 0x18, 0xe3, 0xdd, 0x68, 0xc5, 0xef, 0x2e, 0xc3, 0x05,
-	0x91, 0x05, 0x3d, 0x85, 0x11, 0x7f, 0x66, 0xa3, 0xab, 0x89, 0x18, 0x49, 0x61, 0x50, 0x97, 0xfa,
-	0x1b, 0xf9, 0x90, 0x78, 0xed, 0xdb, 0x3f, 0xff, 0xf9, 0xe1, 0xdc, 0x12, 0xc2, 0xda, 0x7d, 0x61,
-	0x6d, 0x91, 0x9a, 0xab, 0xa5, 0xcb, 0x3b, 0x7a, 0xae, 0x00, 0x74, 0xa7, 0x3b, 0x5a, 0x4b, 0x4f,
-	0x90, 0x26, 0x1d, 0xea, 0xf5, 0x81, 0x6c, 0x25, 0xd3, 0x96, 0x60, 0xba, 0x89, 0x8a, 0x92, 0xe9,
-	0xc6, 0x6e, 0x1a, 0x54, 0x57, 0x23, 0xb4, 0xa3, 0x40, 0x06, 0x3a, 0xe8, 0x67, 0x05, 0x46, 0x83,
-	0xe9, 0x87, 0x56, 0x32, 0xb3, 0xc6, 0x46, 0xb7, 0xba, 0x3a, 0x80, 0xa5, 0xa4, 0xbb, 0x25, 0xe8,
-	0x36, 0xd1, 0x46, 0x5f, 0xba, 0x70, 0x46, 0x47, 0xe1, 0xbe, 0x57, 0x60, 0x2c, 0x88, 0x57, 0xb2,
-	0xac, 0x2c, 0xbe, 0xa4, 0xb4, 0x64, 0xf1, 0xa5, 0x08, 0x04, 0x2e, 0x08, 0xbe, 0x15, 0x74, 0x6d,
-	0x30, 0x3e, 0xf4, 0xab, 0x02, 0x13, 0x3d, 0x43, 0x39, 0xab, 0xb1, 0x69, 0xa3, 0x3e, 0xab, 0xb1,
-	0xa9, 0x53, 0x7e, 0xc0, 0xc6, 0x36, 0x85, 0x6f, 0xf0, 0x8b, 0x48, 0x3b, 0xf2, 0xe4, 0xa3, 0x83,
-	0x7e, 0x54, 0x60, 0xae, 0xdf, 0x6f, 0x31, 0x74, 0x2b, 0x9d, 0x64, 0x80, 0x5f, 0x90, 0xea, 0xd6,
-	0xeb, 0xb8, 0xca, 0x5b, 0xfe, 0xbb, 0x02, 0xe3, 0xd1, 0x69, 0x8c, 0xd6, 0x33, 0x8f, 0x52, 0x8a,
-	0x22, 0xa8, 0x37, 0x06, 0xb4, 0x96, 0x15, 0xbc, 0x23, 0x2a, 0xf8, 0x01, 0xba, 0xdd, 0xb7, 0x82,
-	0x3d, 0x1a, 0xa2, 0x1d, 0xc5, 0x65, 0xb2, 0x83, 0x7e, 0x51, 0x60, 0x32, 0x1a, 0xdf, 0x3b, 0x8c,
-	0xeb, 0x99, 0x47, 0xec, 0x0c, 0xdc, 0x19, 0xc2, 0x86, 0x8b, 0x82, 0x7b, 0x1d, 0xad, 0x0d, 0xce,
-	0x8d, 0xfe, 0x50, 0x00, 0x25, 0xe5, 0x05, 0x15, 0x33, 0x2b, 0x96, 0x29, 0x74, 0xea, 0xe6, 0x99,
-	0x7c, 0x24, 0xf3, 0x9e, 0x60, 0xfe, 0x18, 0xed, 0xf4, 0x65, 0xb6, 0xe9, 0x01, 0xaf, 0xb6, 0x44,
-	0x84, 0x6a, 0x20, 0x6f, 0xe2, 0xce, 0x4b, 0x59, 0xed, 0x68, 0x47, 0x52, 0x44, 0x3b, 0xe8, 0x37,
-	0x05, 0xae, 0x24, 0x15, 0x6f, 0x39, 0xa3, 0x94, 0x71, 0x43, 0x55, 0x1b, 0xd0, 0xf0, 0x8c, 0xa3,
-	0xaa, 0x2b, 0x95, 0xda, 0x91, 0xbc, 0x74, 0x1d, 0xf4, 0x93, 0x02, 0x97, 0x7b, 0x75, 0x0d, 0x2d,
-	0x65, 0xb6, 0x3c, 0x62, 0xa5, 0xae, 0x0f, 0x62, 0x15, 0x12, 0x6e, 0x08, 0xc2, 0xeb, 0x68, 0xb5,
-	0x2f, 0x61, 0x54, 0x46, 0xb7, 0x77, 0x5f, 0x9c, 0xe4, 0x95, 0x97, 0x27, 0x79, 0xe5, 0xef, 0x93,
-	0xbc, 0xf2, 0xec, 0x34, 0x3f, 0xf4, 0xf2, 0x34, 0x3f, 0xf4, 0xd7, 0x69, 0x7e, 0xe8, 0x51, 0xb1,
-	0x6e, 0xf2, 0x46, 0xbb, 0x56, 0xd0, 0x59, 0x33, 0x2d, 0xdc, 0xfe, 0xe6, 0xa6, 0x76, 0x10, 0x09,
-	0x7a, 0xd8, 0xa2, 0x6e, 0x6d, 0x44, 0xfc, 0xdf, 0xdc, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x1d,
-	0x96, 0x22, 0x8a, 0xd0, 0x0f, 0x00, 0x00,
+func (m *QueryBasketRequest) Reset()         { *m = QueryBasketRequest{} }
+func (m *QueryBasketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBasketRequest) ProtoMessage()    {}
+func (*QueryBasketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{22}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Validator by host zone.
-	Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error)
-	// Queries a HostZone by id.
-	HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error)
-	// Queries a list of HostZone items.
-	HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error)
-	// Queries a list of ModuleAddress items.
-	ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error)
-	// QueryInterchainAccountFromAddress returns the interchain account for given
-	// owner address on a given connection pair
-	InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error)
-	// Queries a EpochTracker by index.
-	EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error)
-	// Queries a list of EpochTracker items.
-	EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error)
-	// Queries the next packet sequence for one for a given channel
-	NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error)
-	// Queries an address's unbondings
-	AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error)
-	// Queries all trade routes
-	AllTradeRoutes(ctx context.Context, in *QueryAllTradeRoutes, opts ...grpc.CallOption) (*QueryAllTradeRoutesResponse, error)
+func (m *QueryBasketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryBasketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBasketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBasketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBasketRequest.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBasketRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error) {
-	out := new(QueryGetValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Validators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBasketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBasketRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error) {
-	out := new(QueryGetHostZoneResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZone", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryBasketRequest proto.InternalMessageInfo
 
-func (c *queryClient) HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error) {
-	out := new(QueryAllHostZoneResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZoneAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBasketRequest) GetBasketId() string {
+	if m != nil {
+		return m.BasketId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error) {
-	out := new(QueryModuleAddressResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/ModuleAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// The number of native tokens each basket token can be redeemed for, from a
+// single component of the basket
+type BasketComponentRedemptionRate struct {
+	HostZoneId     string                      `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	HostDenom      string                      `protobuf:"bytes,2,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+	RedemptionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=redemption_rate,json=redemptionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"redemption_rate"`
 }
 
-func (c *queryClient) InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error) {
-	out := new(QueryInterchainAccountFromAddressResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/InterchainAccountFromAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BasketComponentRedemptionRate) Reset()         { *m = BasketComponentRedemptionRate{} }
+func (m *BasketComponentRedemptionRate) String() string { return proto.CompactTextString(m) }
+func (*BasketComponentRedemptionRate) ProtoMessage()    {}
+func (*BasketComponentRedemptionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{23}
+}
+func (m *BasketComponentRedemptionRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasketComponentRedemptionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasketComponentRedemptionRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *BasketComponentRedemptionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasketComponentRedemptionRate.Merge(m, src)
+}
+func (m *BasketComponentRedemptionRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasketComponentRedemptionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasketComponentRedemptionRate.DiscardUnknown(m)
 }
 
-func (c *queryClient) EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error) {
-	out := new(QueryGetEpochTrackerResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTracker", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BasketComponentRedemptionRate proto.InternalMessageInfo
+
+func (m *BasketComponentRedemptionRate) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error) {
-	out := new(QueryAllEpochTrackerResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTrackerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BasketComponentRedemptionRate) GetHostDenom() string {
+	if m != nil {
+		return m.HostDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error) {
-	out := new(QueryGetNextPacketSequenceResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/NextPacketSequence", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryBasketResponse struct {
+	Basket          Basket                          `protobuf:"bytes,1,opt,name=basket,proto3" json:"basket"`
+	RedemptionRates []BasketComponentRedemptionRate `protobuf:"bytes,2,rep,name=redemption_rates,json=redemptionRates,proto3" json:"redemption_rates"`
 }
 
-func (c *queryClient) AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error) {
-	out := new(QueryAddressUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/AddressUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBasketResponse) Reset()         { *m = QueryBasketResponse{} }
+func (m *QueryBasketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBasketResponse) ProtoMessage()    {}
+func (*QueryBasketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{24}
+}
+func (m *QueryBasketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBasketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBasketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBasketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBasketResponse.Merge(m, src)
+}
+func (m *QueryBasketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBasketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBasketResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllTradeRoutes(ctx context.Context, in *QueryAllTradeRoutes, opts ...grpc.CallOption) (*QueryAllTradeRoutesResponse, error) {
-	out := new(QueryAllTradeRoutesResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/AllTradeRoutes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBasketResponse proto.InternalMessageInfo
+
+func (m *QueryBasketResponse) GetBasket() Basket {
+	if m != nil {
+		return m.Basket
 	}
-	return out, nil
+	return Basket{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Validator by host zone.
-	Validators(context.Context, *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error)
-	// Queries a HostZone by id.
-	HostZone(context.Context, *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error)
-	// Queries a list of HostZone items.
-	HostZoneAll(context.Context, *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error)
-	// Queries a list of ModuleAddress items.
-	ModuleAddress(context.Context, *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error)
-	// QueryInterchainAccountFromAddress returns the interchain account for given
-	// owner address on a given connection pair
-	InterchainAccountFromAddress(context.Context, *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error)
-	// Queries a EpochTracker by index.
-	EpochTracker(context.Context, *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error)
-	// Queries a list of EpochTracker items.
-	EpochTrackerAll(context.Context, *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error)
-	// Queries the next packet sequence for one for a given channel
-	NextPacketSequence(context.Context, *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error)
-	// Queries an address's unbondings
-	AddressUnbondings(context.Context, *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error)
-	// Queries all trade routes
-	AllTradeRoutes(context.Context, *QueryAllTradeRoutes) (*QueryAllTradeRoutesResponse, error)
+func (m *QueryBasketResponse) GetRedemptionRates() []BasketComponentRedemptionRate {
+	if m != nil {
+		return m.RedemptionRates
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryAllBasketsRequest struct {
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllBasketsRequest) Reset()         { *m = QueryAllBasketsRequest{} }
+func (m *QueryAllBasketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBasketsRequest) ProtoMessage()    {}
+func (*QueryAllBasketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{25}
 }
-func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+func (m *QueryAllBasketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HostZone(ctx context.Context, req *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostZone not implemented")
+func (m *QueryAllBasketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBasketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) HostZoneAll(ctx context.Context, req *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostZoneAll not implemented")
+func (m *QueryAllBasketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBasketsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ModuleAddress(ctx context.Context, req *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleAddress not implemented")
+func (m *QueryAllBasketsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) InterchainAccountFromAddress(ctx context.Context, req *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountFromAddress not implemented")
+func (m *QueryAllBasketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBasketsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) EpochTracker(ctx context.Context, req *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochTracker not implemented")
+
+var xxx_messageInfo_QueryAllBasketsRequest proto.InternalMessageInfo
+
+type QueryAllBasketsResponse struct {
+	Baskets []Basket `protobuf:"bytes,1,rep,name=baskets,proto3" json:"baskets"`
 }
-func (*UnimplementedQueryServer) EpochTrackerAll(ctx context.Context, req *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochTrackerAll not implemented")
+
+func (m *QueryAllBasketsResponse) Reset()         { *m = QueryAllBasketsResponse{} }
+func (m *QueryAllBasketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBasketsResponse) ProtoMessage()    {}
+func (*QueryAllBasketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{26}
 }
-func (*UnimplementedQueryServer) NextPacketSequence(ctx context.Context, req *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NextPacketSequence not implemented")
+func (m *QueryAllBasketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AddressUnbondings(ctx context.Context, req *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressUnbondings not implemented")
+func (m *QueryAllBasketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBasketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllTradeRoutes(ctx context.Context, req *QueryAllTradeRoutes) (*QueryAllTradeRoutesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllTradeRoutes not implemented")
+func (m *QueryAllBasketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBasketsResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAllBasketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBasketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBasketsResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryAllBasketsResponse proto.InternalMessageInfo
+
+func (m *QueryAllBasketsResponse) GetBaskets() []Basket {
+	if m != nil {
+		return m.Baskets
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/Validators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validators(ctx, req.(*QueryGetValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRedemptionRateHistoryRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_HostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetHostZoneRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostZone(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/HostZone",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostZone(ctx, req.(*QueryGetHostZoneRequest))
+func (m *QueryRedemptionRateHistoryRequest) Reset()         { *m = QueryRedemptionRateHistoryRequest{} }
+func (m *QueryRedemptionRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateHistoryRequest) ProtoMessage()    {}
+func (*QueryRedemptionRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{27}
+}
+func (m *QueryRedemptionRateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRedemptionRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateHistoryRequest.Merge(m, src)
+}
+func (m *QueryRedemptionRateHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_HostZoneAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllHostZoneRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostZoneAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/HostZoneAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostZoneAll(ctx, req.(*QueryAllHostZoneRequest))
+var xxx_messageInfo_QueryRedemptionRateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateHistoryRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ModuleAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModuleAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/ModuleAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModuleAddress(ctx, req.(*QueryModuleAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRedemptionRateHistoryResponse struct {
+	Snapshots []RedemptionRateSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
 }
 
-func _Query_InterchainAccountFromAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInterchainAccountFromAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InterchainAccountFromAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/InterchainAccountFromAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InterchainAccountFromAddress(ctx, req.(*QueryInterchainAccountFromAddressRequest))
+func (m *QueryRedemptionRateHistoryResponse) Reset()         { *m = QueryRedemptionRateHistoryResponse{} }
+func (m *QueryRedemptionRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateHistoryResponse) ProtoMessage()    {}
+func (*QueryRedemptionRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{28}
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateHistoryResponse.Merge(m, src)
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_EpochTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetEpochTrackerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochTracker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/EpochTracker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochTracker(ctx, req.(*QueryGetEpochTrackerRequest))
+var xxx_messageInfo_QueryRedemptionRateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateHistoryResponse) GetSnapshots() []RedemptionRateSnapshot {
+	if m != nil {
+		return m.Snapshots
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_EpochTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllEpochTrackerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochTrackerAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/EpochTrackerAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochTrackerAll(ctx, req.(*QueryAllEpochTrackerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRedemptionRateAprRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_NextPacketSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetNextPacketSequenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NextPacketSequence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/NextPacketSequence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NextPacketSequence(ctx, req.(*QueryGetNextPacketSequenceRequest))
+func (m *QueryRedemptionRateAprRequest) Reset()         { *m = QueryRedemptionRateAprRequest{} }
+func (m *QueryRedemptionRateAprRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateAprRequest) ProtoMessage()    {}
+func (*QueryRedemptionRateAprRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{29}
+}
+func (m *QueryRedemptionRateAprRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateAprRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateAprRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRedemptionRateAprRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateAprRequest.Merge(m, src)
+}
+func (m *QueryRedemptionRateAprRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateAprRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateAprRequest.DiscardUnknown(m)
 }
 
-func _Query_AddressUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddressUnbondings)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AddressUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/AddressUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AddressUnbondings(ctx, req.(*QueryAddressUnbondings))
+var xxx_messageInfo_QueryRedemptionRateAprRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateAprRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllTradeRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllTradeRoutes)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllTradeRoutes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/AllTradeRoutes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllTradeRoutes(ctx, req.(*QueryAllTradeRoutes))
-	}
-	return interceptor(ctx, in, info, handler)
+// APRs are zero if there is not yet enough history to compute them
+type QueryRedemptionRateAprResponse struct {
+	Apr_7D  cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apr_7d,json=apr7d,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr_7d"`
+	Apr_30D cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=apr_30d,json=apr30d,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr_30d"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stride.stakeibc.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Validators",
-			Handler:    _Query_Validators_Handler,
-		},
-		{
-			MethodName: "HostZone",
-			Handler:    _Query_HostZone_Handler,
-		},
-		{
-			MethodName: "HostZoneAll",
-			Handler:    _Query_HostZoneAll_Handler,
-		},
-		{
-			MethodName: "ModuleAddress",
-			Handler:    _Query_ModuleAddress_Handler,
-		},
-		{
-			MethodName: "InterchainAccountFromAddress",
-			Handler:    _Query_InterchainAccountFromAddress_Handler,
-		},
-		{
-			MethodName: "EpochTracker",
-			Handler:    _Query_EpochTracker_Handler,
-		},
-		{
-			MethodName: "EpochTrackerAll",
-			Handler:    _Query_EpochTrackerAll_Handler,
-		},
-		{
-			MethodName: "NextPacketSequence",
-			Handler:    _Query_NextPacketSequence_Handler,
-		},
-		{
-			MethodName: "AddressUnbondings",
-			Handler:    _Query_AddressUnbondings_Handler,
-		},
-		{
-			MethodName: "AllTradeRoutes",
-			Handler:    _Query_AllTradeRoutes_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stride/stakeibc/query.proto",
+func (m *QueryRedemptionRateAprResponse) Reset()         { *m = QueryRedemptionRateAprResponse{} }
+func (m *QueryRedemptionRateAprResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateAprResponse) ProtoMessage()    {}
+func (*QueryRedemptionRateAprResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{30}
 }
-
-func (m *QueryInterchainAccountFromAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionRateAprResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateAprResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateAprResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRedemptionRateAprResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateAprResponse.Merge(m, src)
+}
+func (m *QueryRedemptionRateAprResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateAprResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateAprResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInterchainAccountFromAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRedemptionRateAprResponse proto.InternalMessageInfo
+
+type QueryRedemptionRateDrawdownRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryInterchainAccountFromAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRedemptionRateDrawdownRequest) Reset()         { *m = QueryRedemptionRateDrawdownRequest{} }
+func (m *QueryRedemptionRateDrawdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateDrawdownRequest) ProtoMessage()    {}
+func (*QueryRedemptionRateDrawdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{31}
+}
+func (m *QueryRedemptionRateDrawdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateDrawdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateDrawdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRedemptionRateDrawdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateDrawdownRequest.Merge(m, src)
+}
+func (m *QueryRedemptionRateDrawdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateDrawdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateDrawdownRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionRateDrawdownRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateDrawdownRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The max drawdown is the decline from the peak redemption rate, as a fraction
+// of the peak
+// The peak and trough epochs are zero if the redemption rate never declined
+type QueryRedemptionRateDrawdownResponse struct {
+	MaxDrawdown       cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=max_drawdown,json=maxDrawdown,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_drawdown"`
+	PeakEpochNumber   uint64                      `protobuf:"varint,2,opt,name=peak_epoch_number,json=peakEpochNumber,proto3" json:"peak_epoch_number,omitempty"`
+	TroughEpochNumber uint64                      `protobuf:"varint,3,opt,name=trough_epoch_number,json=troughEpochNumber,proto3" json:"trough_epoch_number,omitempty"`
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InterchainAccountAddress) > 0 {
-		i -= len(m.InterchainAccountAddress)
-		copy(dAtA[i:], m.InterchainAccountAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterchainAccountAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRedemptionRateDrawdownResponse) Reset()         { *m = QueryRedemptionRateDrawdownResponse{} }
+func (m *QueryRedemptionRateDrawdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateDrawdownResponse) ProtoMessage()    {}
+func (*QueryRedemptionRateDrawdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{32}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionRateDrawdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateDrawdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateDrawdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRedemptionRateDrawdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateDrawdownResponse.Merge(m, src)
+}
+func (m *QueryRedemptionRateDrawdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateDrawdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateDrawdownResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryRedemptionRateDrawdownResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateDrawdownResponse) GetPeakEpochNumber() uint64 {
+	if m != nil {
+		return m.PeakEpochNumber
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionRateDrawdownResponse) GetTroughEpochNumber() uint64 {
+	if m != nil {
+		return m.TroughEpochNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryHaltHistoryRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryHaltHistoryRequest) Reset()         { *m = QueryHaltHistoryRequest{} }
+func (m *QueryHaltHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHaltHistoryRequest) ProtoMessage()    {}
+func (*QueryHaltHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{33}
+}
+func (m *QueryHaltHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHaltHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHaltHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryHaltHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHaltHistoryRequest.Merge(m, src)
+}
+func (m *QueryHaltHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHaltHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHaltHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHaltHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryHaltHistoryRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryHaltHistoryResponse struct {
+	Records []RedemptionRateGuardRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
 }
 
-func (m *QueryGetValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryHaltHistoryResponse) Reset()         { *m = QueryHaltHistoryResponse{} }
+func (m *QueryHaltHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHaltHistoryResponse) ProtoMessage()    {}
+func (*QueryHaltHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{34}
+}
+func (m *QueryHaltHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHaltHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHaltHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHaltHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHaltHistoryResponse.Merge(m, src)
+}
+func (m *QueryHaltHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHaltHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHaltHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHaltHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryHaltHistoryResponse) GetRecords() []RedemptionRateGuardRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRebalancePlanRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryGetValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRebalancePlanRequest) Reset()         { *m = QueryRebalancePlanRequest{} }
+func (m *QueryRebalancePlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancePlanRequest) ProtoMessage()    {}
+func (*QueryRebalancePlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{35}
+}
+func (m *QueryRebalancePlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancePlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancePlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetHostZoneRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRebalancePlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancePlanRequest.Merge(m, src)
 }
-
-func (m *QueryGetHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRebalancePlanRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRebalancePlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancePlanRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetHostZoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRebalancePlanRequest proto.InternalMessageInfo
+
+func (m *QueryRebalancePlanRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRebalancePlanResponse struct {
+	// Redelegations that would be submitted, in order
+	Rebalancings []Rebalancing `protobuf:"bytes,1,rep,name=rebalancings,proto3" json:"rebalancings"`
+	// Validators that cannot be redelegated away from because they are the
+	// destination of an in-flight redelegation
+	LockedValidators []string `protobuf:"bytes,2,rep,name=locked_validators,json=lockedValidators,proto3" json:"locked_validators,omitempty"`
+	// Outstanding redelegations that constrained the plan
+	InFlightRedelegations []InFlightRedelegation `protobuf:"bytes,3,rep,name=in_flight_redelegations,json=inFlightRedelegations,proto3" json:"in_flight_redelegations"`
+	// ICA transactions that would be submitted
+	Txs []PlannedIcaTx `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs"`
 }
 
-func (m *QueryGetHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostZone.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRebalancePlanResponse) Reset()         { *m = QueryRebalancePlanResponse{} }
+func (m *QueryRebalancePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancePlanResponse) ProtoMessage()    {}
+func (*QueryRebalancePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{36}
+}
+func (m *QueryRebalancePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRebalancePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancePlanResponse.Merge(m, src)
+}
+func (m *QueryRebalancePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebalancePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancePlanResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllHostZoneRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRebalancePlanResponse proto.InternalMessageInfo
+
+func (m *QueryRebalancePlanResponse) GetRebalancings() []Rebalancing {
+	if m != nil {
+		return m.Rebalancings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRebalancePlanResponse) GetLockedValidators() []string {
+	if m != nil {
+		return m.LockedValidators
+	}
+	return nil
 }
 
-func (m *QueryAllHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRebalancePlanResponse) GetInFlightRedelegations() []InFlightRedelegation {
+	if m != nil {
+		return m.InFlightRedelegations
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllHostZoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlanResponse) GetTxs() []PlannedIcaTx {
+	if m != nil {
+		return m.Txs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// A message that would be included in a planned ICA transaction
+type PlannedIcaMsg struct {
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// JSON encoding of the message
+	Json string `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
 }
 
-func (m *QueryAllHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *PlannedIcaMsg) Reset()         { *m = PlannedIcaMsg{} }
+func (m *PlannedIcaMsg) String() string { return proto.CompactTextString(m) }
+func (*PlannedIcaMsg) ProtoMessage()    {}
+func (*PlannedIcaMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{37}
+}
+func (m *PlannedIcaMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlannedIcaMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlannedIcaMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.HostZone) > 0 {
-		for iNdEx := len(m.HostZone) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostZone[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *PlannedIcaMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlannedIcaMsg.Merge(m, src)
+}
+func (m *PlannedIcaMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlannedIcaMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlannedIcaMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlannedIcaMsg proto.InternalMessageInfo
+
+func (m *PlannedIcaMsg) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryModuleAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PlannedIcaMsg) GetJson() string {
+	if m != nil {
+		return m.Json
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryModuleAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// An ICA transaction that would be submitted by an epochly batch
+type PlannedIcaTx struct {
+	CallbackId string          `protobuf:"bytes,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Msgs       []PlannedIcaMsg `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs"`
 }
 
-func (m *QueryModuleAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *PlannedIcaTx) Reset()         { *m = PlannedIcaTx{} }
+func (m *PlannedIcaTx) String() string { return proto.CompactTextString(m) }
+func (*PlannedIcaTx) ProtoMessage()    {}
+func (*PlannedIcaTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{38}
+}
+func (m *PlannedIcaTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlannedIcaTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlannedIcaTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PlannedIcaTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlannedIcaTx.Merge(m, src)
+}
+func (m *PlannedIcaTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlannedIcaTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlannedIcaTx.DiscardUnknown(m)
 }
 
-func (m *QueryModuleAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PlannedIcaTx proto.InternalMessageInfo
+
+func (m *PlannedIcaTx) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryModuleAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PlannedIcaTx) GetMsgs() []PlannedIcaMsg {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
 }
 
-func (m *QueryModuleAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryDelegationPlanRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryGetEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDelegationPlanRequest) Reset()         { *m = QueryDelegationPlanRequest{} }
+func (m *QueryDelegationPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationPlanRequest) ProtoMessage()    {}
+func (*QueryDelegationPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{39}
 }
-
-func (m *QueryGetEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegationPlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryGetEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EpochIdentifier) > 0 {
-		i -= len(m.EpochIdentifier)
-		copy(dAtA[i:], m.EpochIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDelegationPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationPlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationPlanRequest.Merge(m, src)
+}
+func (m *QueryDelegationPlanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationPlanRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationPlanRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationPlanRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The planned delegation of a single deposit record
+type PlannedDepositDelegation struct {
+	DepositRecordId  uint64                `protobuf:"varint,1,opt,name=deposit_record_id,json=depositRecordId,proto3" json:"deposit_record_id,omitempty"`
+	Amount           cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	SplitDelegations []SplitDelegation     `protobuf:"bytes,3,rep,name=split_delegations,json=splitDelegations,proto3" json:"split_delegations"`
+	Txs              []PlannedIcaTx        `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs"`
 }
 
-func (m *QueryGetEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EpochTracker.MarshalToSizedBuffer(dAtA[:i])
+func (m *PlannedDepositDelegation) Reset()         { *m = PlannedDepositDelegation{} }
+func (m *PlannedDepositDelegation) String() string { return proto.CompactTextString(m) }
+func (*PlannedDepositDelegation) ProtoMessage()    {}
+func (*PlannedDepositDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{40}
+}
+func (m *PlannedDepositDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlannedDepositDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlannedDepositDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PlannedDepositDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlannedDepositDelegation.Merge(m, src)
+}
+func (m *PlannedDepositDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlannedDepositDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlannedDepositDelegation.DiscardUnknown(m)
 }
 
-func (m *QueryAllEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PlannedDepositDelegation proto.InternalMessageInfo
+
+func (m *PlannedDepositDelegation) GetDepositRecordId() uint64 {
+	if m != nil {
+		return m.DepositRecordId
+	}
+	return 0
 }
 
-func (m *QueryAllEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *PlannedDepositDelegation) GetSplitDelegations() []SplitDelegation {
+	if m != nil {
+		return m.SplitDelegations
+	}
+	return nil
 }
 
-func (m *QueryAllEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PlannedDepositDelegation) GetTxs() []PlannedIcaTx {
+	if m != nil {
+		return m.Txs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDelegationPlanResponse struct {
+	Deposits []PlannedDepositDelegation `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits"`
 }
 
-func (m *QueryAllEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EpochTracker) > 0 {
-		for iNdEx := len(m.EpochTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EpochTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDelegationPlanResponse) Reset()         { *m = QueryDelegationPlanResponse{} }
+func (m *QueryDelegationPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationPlanResponse) ProtoMessage()    {}
+func (*QueryDelegationPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{41}
+}
+func (m *QueryDelegationPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationPlanResponse.Merge(m, src)
+}
+func (m *QueryDelegationPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationPlanResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetNextPacketSequenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationPlanResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationPlanResponse) GetDeposits() []PlannedDepositDelegation {
+	if m != nil {
+		return m.Deposits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetNextPacketSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryUnbondingPlanRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryGetNextPacketSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryUnbondingPlanRequest) Reset()         { *m = QueryUnbondingPlanRequest{} }
+func (m *QueryUnbondingPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingPlanRequest) ProtoMessage()    {}
+func (*QueryUnbondingPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{42}
+}
+func (m *QueryUnbondingPlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingPlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryUnbondingPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingPlanRequest.Merge(m, src)
+}
+func (m *QueryUnbondingPlanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingPlanRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetNextPacketSequenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnbondingPlanRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingPlanRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetNextPacketSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryUnbondingPlanResponse struct {
+	// Day epoch on which the host zone will next unbond
+	NextUnbondingDayEpoch   uint64                `protobuf:"varint,1,opt,name=next_unbonding_day_epoch,json=nextUnbondingDayEpoch,proto3" json:"next_unbonding_day_epoch,omitempty"`
+	TotalUnbondAmount       cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_unbond_amount,json=totalUnbondAmount,proto3,customtype=cosmossdk.io/math.Int" json:"total_unbond_amount"`
+	EpochUnbondingRecordIds []uint64              `protobuf:"varint,3,rep,packed,name=epoch_unbonding_record_ids,json=epochUnbondingRecordIds,proto3" json:"epoch_unbonding_record_ids,omitempty"`
+	SplitUndelegations      []SplitUndelegation   `protobuf:"bytes,4,rep,name=split_undelegations,json=splitUndelegations,proto3" json:"split_undelegations"`
+	Txs                     []PlannedIcaTx        `protobuf:"bytes,5,rep,name=txs,proto3" json:"txs"`
 }
 
-func (m *QueryGetNextPacketSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryUnbondingPlanResponse) Reset()         { *m = QueryUnbondingPlanResponse{} }
+func (m *QueryUnbondingPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingPlanResponse) ProtoMessage()    {}
+func (*QueryUnbondingPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{43}
+}
+func (m *QueryUnbondingPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryUnbondingPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingPlanResponse.Merge(m, src)
+}
+func (m *QueryUnbondingPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingPlanResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAddressUnbondings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnbondingPlanResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingPlanResponse) GetNextUnbondingDayEpoch() uint64 {
+	if m != nil {
+		return m.NextUnbondingDayEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAddressUnbondings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingPlanResponse) GetEpochUnbondingRecordIds() []uint64 {
+	if m != nil {
+		return m.EpochUnbondingRecordIds
+	}
+	return nil
 }
 
-func (m *QueryAddressUnbondings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryUnbondingPlanResponse) GetSplitUndelegations() []SplitUndelegation {
+	if m != nil {
+		return m.SplitUndelegations
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAddressUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnbondingPlanResponse) GetTxs() []PlannedIcaTx {
+	if m != nil {
+		return m.Txs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAddressUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRedemptionSweepPlanRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryAddressUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddressUnbondings) > 0 {
-		for iNdEx := len(m.AddressUnbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AddressUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRedemptionSweepPlanRequest) Reset()         { *m = QueryRedemptionSweepPlanRequest{} }
+func (m *QueryRedemptionSweepPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionSweepPlanRequest) ProtoMessage()    {}
+func (*QueryRedemptionSweepPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{44}
+}
+func (m *QueryRedemptionSweepPlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionSweepPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionSweepPlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllTradeRoutes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRedemptionSweepPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionSweepPlanRequest.Merge(m, src)
 }
-
-func (m *QueryAllTradeRoutes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRedemptionSweepPlanRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAllTradeRoutes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRedemptionSweepPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionSweepPlanRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllTradeRoutesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionSweepPlanRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionSweepPlanRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllTradeRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRedemptionSweepPlanResponse struct {
+	TotalSweepAmount        cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_sweep_amount,json=totalSweepAmount,proto3,customtype=cosmossdk.io/math.Int" json:"total_sweep_amount"`
+	EpochUnbondingRecordIds []uint64              `protobuf:"varint,2,rep,packed,name=epoch_unbonding_record_ids,json=epochUnbondingRecordIds,proto3" json:"epoch_unbonding_record_ids,omitempty"`
+	Txs                     []PlannedIcaTx        `protobuf:"bytes,3,rep,name=txs,proto3" json:"txs"`
 }
 
-func (m *QueryAllTradeRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TradeRoutes) > 0 {
-		for iNdEx := len(m.TradeRoutes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TradeRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRedemptionSweepPlanResponse) Reset()         { *m = QueryRedemptionSweepPlanResponse{} }
+func (m *QueryRedemptionSweepPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionSweepPlanResponse) ProtoMessage()    {}
+func (*QueryRedemptionSweepPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{45}
+}
+func (m *QueryRedemptionSweepPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionSweepPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionSweepPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryRedemptionSweepPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionSweepPlanResponse.Merge(m, src)
 }
-func (m *QueryInterchainAccountFromAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRedemptionSweepPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionSweepPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionSweepPlanResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InterchainAccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryRedemptionSweepPlanResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionSweepPlanResponse) GetEpochUnbondingRecordIds() []uint64 {
+	if m != nil {
+		return m.EpochUnbondingRecordIds
 	}
-	return n
+	return nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRedemptionSweepPlanResponse) GetTxs() []PlannedIcaTx {
+	if m != nil {
+		return m.Txs
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryCircuitBreakersRequest struct {
 }
 
-func (m *QueryGetValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCircuitBreakersRequest) Reset()         { *m = QueryCircuitBreakersRequest{} }
+func (m *QueryCircuitBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{46}
+}
+func (m *QueryCircuitBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCircuitBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryCircuitBreakersRequest proto.InternalMessageInfo
+
+type QueryCircuitBreakersResponse struct {
+	Guardian *CircuitBreakerGuardian `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	Flags    []CircuitBreakerFlag    `protobuf:"bytes,2,rep,name=flags,proto3" json:"flags"`
+}
+
+func (m *QueryCircuitBreakersResponse) Reset()         { *m = QueryCircuitBreakersResponse{} }
+func (m *QueryCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{47}
+}
+func (m *QueryCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetHostZoneRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryCircuitBreakersResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakersResponse) GetGuardian() *CircuitBreakerGuardian {
+	if m != nil {
+		return m.Guardian
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetHostZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCircuitBreakersResponse) GetFlags() []CircuitBreakerFlag {
+	if m != nil {
+		return m.Flags
 	}
-	var l int
-	_ = l
-	l = m.HostZone.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllHostZoneRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryIcaGasEstimatesRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryAllHostZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.HostZone) > 0 {
-		for _, e := range m.HostZone {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIcaGasEstimatesRequest) Reset()         { *m = QueryIcaGasEstimatesRequest{} }
+func (m *QueryIcaGasEstimatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaGasEstimatesRequest) ProtoMessage()    {}
+func (*QueryIcaGasEstimatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{48}
+}
+func (m *QueryIcaGasEstimatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIcaGasEstimatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIcaGasEstimatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryIcaGasEstimatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaGasEstimatesRequest.Merge(m, src)
+}
+func (m *QueryIcaGasEstimatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIcaGasEstimatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaGasEstimatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryModuleAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryIcaGasEstimatesRequest proto.InternalMessageInfo
+
+func (m *QueryIcaGasEstimatesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryModuleAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryIcaGasEstimatesResponse struct {
+	MaxIcaTxGas uint64           `protobuf:"varint,1,opt,name=max_ica_tx_gas,json=maxIcaTxGas,proto3" json:"max_ica_tx_gas,omitempty"`
+	Estimates   []IcaGasEstimate `protobuf:"bytes,2,rep,name=estimates,proto3" json:"estimates"`
 }
 
-func (m *QueryGetEpochTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EpochIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIcaGasEstimatesResponse) Reset()         { *m = QueryIcaGasEstimatesResponse{} }
+func (m *QueryIcaGasEstimatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaGasEstimatesResponse) ProtoMessage()    {}
+func (*QueryIcaGasEstimatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{49}
+}
+func (m *QueryIcaGasEstimatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIcaGasEstimatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIcaGasEstimatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryIcaGasEstimatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaGasEstimatesResponse.Merge(m, src)
+}
+func (m *QueryIcaGasEstimatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIcaGasEstimatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaGasEstimatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetEpochTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryIcaGasEstimatesResponse proto.InternalMessageInfo
+
+func (m *QueryIcaGasEstimatesResponse) GetMaxIcaTxGas() uint64 {
+	if m != nil {
+		return m.MaxIcaTxGas
 	}
-	var l int
-	_ = l
-	l = m.EpochTracker.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryAllEpochTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIcaGasEstimatesResponse) GetEstimates() []IcaGasEstimate {
+	if m != nil {
+		return m.Estimates
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryAllEpochTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.EpochTracker) > 0 {
-		for _, e := range m.EpochTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryInsuranceFundRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryInsuranceFundRequest) Reset()         { *m = QueryInsuranceFundRequest{} }
+func (m *QueryInsuranceFundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundRequest) ProtoMessage()    {}
+func (*QueryInsuranceFundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{50}
+}
+func (m *QueryInsuranceFundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryInsuranceFundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundRequest.Merge(m, src)
+}
+func (m *QueryInsuranceFundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetNextPacketSequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryInsuranceFundRequest proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetNextPacketSequenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryInsuranceFundResponse struct {
+	Config          *InsuranceFundConfig      `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Address         string                    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Balance         types.Coin                `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance"`
+	CoverageHistory []InsuranceCoverageRecord `protobuf:"bytes,4,rep,name=coverage_history,json=coverageHistory,proto3" json:"coverage_history"`
+}
+
+func (m *QueryInsuranceFundResponse) Reset()         { *m = QueryInsuranceFundResponse{} }
+func (m *QueryInsuranceFundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundResponse) ProtoMessage()    {}
+func (*QueryInsuranceFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{51}
+}
+func (m *QueryInsuranceFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+}
+func (m *QueryInsuranceFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundResponse.Merge(m, src)
+}
+func (m *QueryInsuranceFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundResponse proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundResponse) GetConfig() *InsuranceFundConfig {
+	if m != nil {
+		return m.Config
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAddressUnbondings) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInsuranceFundResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryInsuranceFundResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *QueryAddressUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInsuranceFundResponse) GetCoverageHistory() []InsuranceCoverageRecord {
+	if m != nil {
+		return m.CoverageHistory
 	}
-	var l int
-	_ = l
-	if len(m.AddressUnbondings) > 0 {
-		for _, e := range m.AddressUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryIcaReconciliationReportRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryIcaReconciliationReportRequest) Reset()         { *m = QueryIcaReconciliationReportRequest{} }
+func (m *QueryIcaReconciliationReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaReconciliationReportRequest) ProtoMessage()    {}
+func (*QueryIcaReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{52}
+}
+func (m *QueryIcaReconciliationReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIcaReconciliationReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIcaReconciliationReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryIcaReconciliationReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaReconciliationReportRequest.Merge(m, src)
+}
+func (m *QueryIcaReconciliationReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIcaReconciliationReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaReconciliationReportRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllTradeRoutes) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryIcaReconciliationReportRequest proto.InternalMessageInfo
+
+func (m *QueryIcaReconciliationReportRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryAllTradeRoutesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryIcaReconciliationReportResponse struct {
+	Report IcaReconciliationReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report"`
+	// Discrepancy threshold of the host zone
+	Threshold cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Int" json:"threshold"`
+}
+
+func (m *QueryIcaReconciliationReportResponse) Reset()         { *m = QueryIcaReconciliationReportResponse{} }
+func (m *QueryIcaReconciliationReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaReconciliationReportResponse) ProtoMessage()    {}
+func (*QueryIcaReconciliationReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{53}
+}
+func (m *QueryIcaReconciliationReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIcaReconciliationReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIcaReconciliationReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
+}
+func (m *QueryIcaReconciliationReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaReconciliationReportResponse.Merge(m, src)
+}
+func (m *QueryIcaReconciliationReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIcaReconciliationReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaReconciliationReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIcaReconciliationReportResponse proto.InternalMessageInfo
+
+func (m *QueryIcaReconciliationReportResponse) GetReport() IcaReconciliationReport {
+	if m != nil {
+		return m.Report
+	}
+	return IcaReconciliationReport{}
+}
+
+type QueryIcaRecoveryStatesRequest struct {
+}
+
+func (m *QueryIcaRecoveryStatesRequest) Reset()         { *m = QueryIcaRecoveryStatesRequest{} }
+func (m *QueryIcaRecoveryStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaRecoveryStatesRequest) ProtoMessage()    {}
+func (*QueryIcaRecoveryStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{54}
+}
+func (m *QueryIcaRecoveryStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIcaRecoveryStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIcaRecoveryStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIcaRecoveryStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaRecoveryStatesRequest.Merge(m, src)
+}
+func (m *QueryIcaRecoveryStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIcaRecoveryStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaRecoveryStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIcaRecoveryStatesRequest proto.InternalMessageInfo
+
+type QueryIcaRecoveryStatesResponse struct {
+	RecoveryStates []IcaRecoveryState `protobuf:"bytes,1,rep,name=recovery_states,json=recoveryStates,proto3" json:"recovery_states"`
+}
+
+func (m *QueryIcaRecoveryStatesResponse) Reset()         { *m = QueryIcaRecoveryStatesResponse{} }
+func (m *QueryIcaRecoveryStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaRecoveryStatesResponse) ProtoMessage()    {}
+func (*QueryIcaRecoveryStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{55}
+}
+func (m *QueryIcaRecoveryStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIcaRecoveryStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIcaRecoveryStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIcaRecoveryStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaRecoveryStatesResponse.Merge(m, src)
+}
+func (m *QueryIcaRecoveryStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIcaRecoveryStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaRecoveryStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIcaRecoveryStatesResponse proto.InternalMessageInfo
+
+func (m *QueryIcaRecoveryStatesResponse) GetRecoveryStates() []IcaRecoveryState {
+	if m != nil {
+		return m.RecoveryStates
+	}
+	return nil
+}
+
+type QueryOnboardingChecklistRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryOnboardingChecklistRequest) Reset()         { *m = QueryOnboardingChecklistRequest{} }
+func (m *QueryOnboardingChecklistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOnboardingChecklistRequest) ProtoMessage()    {}
+func (*QueryOnboardingChecklistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{56}
+}
+func (m *QueryOnboardingChecklistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOnboardingChecklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOnboardingChecklistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOnboardingChecklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOnboardingChecklistRequest.Merge(m, src)
+}
+func (m *QueryOnboardingChecklistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOnboardingChecklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOnboardingChecklistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOnboardingChecklistRequest proto.InternalMessageInfo
+
+func (m *QueryOnboardingChecklistRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryOnboardingChecklistResponse struct {
+	Checklist HostZoneOnboardingChecklist `protobuf:"bytes,1,opt,name=checklist,proto3" json:"checklist"`
+}
+
+func (m *QueryOnboardingChecklistResponse) Reset()         { *m = QueryOnboardingChecklistResponse{} }
+func (m *QueryOnboardingChecklistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOnboardingChecklistResponse) ProtoMessage()    {}
+func (*QueryOnboardingChecklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{57}
+}
+func (m *QueryOnboardingChecklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOnboardingChecklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOnboardingChecklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOnboardingChecklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOnboardingChecklistResponse.Merge(m, src)
+}
+func (m *QueryOnboardingChecklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOnboardingChecklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOnboardingChecklistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOnboardingChecklistResponse proto.InternalMessageInfo
+
+func (m *QueryOnboardingChecklistResponse) GetChecklist() HostZoneOnboardingChecklist {
+	if m != nil {
+		return m.Checklist
+	}
+	return HostZoneOnboardingChecklist{}
+}
+
+type QueryRedemptionCompletionEstimateRequest struct {
+	UserRedemptionRecordId string `protobuf:"bytes,1,opt,name=user_redemption_record_id,json=userRedemptionRecordId,proto3" json:"user_redemption_record_id,omitempty"`
+}
+
+func (m *QueryRedemptionCompletionEstimateRequest) Reset() {
+	*m = QueryRedemptionCompletionEstimateRequest{}
+}
+func (m *QueryRedemptionCompletionEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionCompletionEstimateRequest) ProtoMessage()    {}
+func (*QueryRedemptionCompletionEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{58}
+}
+func (m *QueryRedemptionCompletionEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionCompletionEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionCompletionEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionCompletionEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionCompletionEstimateRequest.Merge(m, src)
+}
+func (m *QueryRedemptionCompletionEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionCompletionEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionCompletionEstimateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionCompletionEstimateRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionCompletionEstimateRequest) GetUserRedemptionRecordId() string {
+	if m != nil {
+		return m.UserRedemptionRecordId
+	}
+	return ""
+}
+
+type QueryRedemptionCompletionEstimateResponse struct {
+	// Day epoch on which the record's unbonding is expected to be initiated
+	// (0 if the unbonding has already been initiated)
+	UnbondingDayEpoch uint64 `protobuf:"varint,1,opt,name=unbonding_day_epoch,json=unbondingDayEpoch,proto3" json:"unbonding_day_epoch,omitempty"`
+	// Native tokens queued for unbonding ahead of and including the record's
+	// epoch that must be unbonded before the record is fully unbonded
+	NativeTokensQueuedAhead cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=native_tokens_queued_ahead,json=nativeTokensQueuedAhead,proto3,customtype=cosmossdk.io/math.Int" json:"native_tokens_queued_ahead"`
+	// Estimated time (unix nanoseconds) at which the record will be claimable
+	EstimatedCompletionTime uint64 `protobuf:"varint,3,opt,name=estimated_completion_time,json=estimatedCompletionTime,proto3" json:"estimated_completion_time,omitempty"`
+}
+
+func (m *QueryRedemptionCompletionEstimateResponse) Reset() {
+	*m = QueryRedemptionCompletionEstimateResponse{}
+}
+func (m *QueryRedemptionCompletionEstimateResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryRedemptionCompletionEstimateResponse) ProtoMessage() {}
+func (*QueryRedemptionCompletionEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{59}
+}
+func (m *QueryRedemptionCompletionEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionCompletionEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionCompletionEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionCompletionEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionCompletionEstimateResponse.Merge(m, src)
+}
+func (m *QueryRedemptionCompletionEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionCompletionEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionCompletionEstimateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionCompletionEstimateResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionCompletionEstimateResponse) GetUnbondingDayEpoch() uint64 {
+	if m != nil {
+		return m.UnbondingDayEpoch
+	}
+	return 0
+}
+
+func (m *QueryRedemptionCompletionEstimateResponse) GetEstimatedCompletionTime() uint64 {
+	if m != nil {
+		return m.EstimatedCompletionTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryInterchainAccountFromAddressRequest)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressRequest")
+	proto.RegisterType((*QueryInterchainAccountFromAddressResponse)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "stride.stakeibc.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "stride.stakeibc.QueryParamsResponse")
+	proto.RegisterType((*QueryGetValidatorsRequest)(nil), "stride.stakeibc.QueryGetValidatorsRequest")
+	proto.RegisterType((*QueryGetValidatorsResponse)(nil), "stride.stakeibc.QueryGetValidatorsResponse")
+	proto.RegisterType((*QueryGetHostZoneRequest)(nil), "stride.stakeibc.QueryGetHostZoneRequest")
+	proto.RegisterType((*QueryGetHostZoneResponse)(nil), "stride.stakeibc.QueryGetHostZoneResponse")
+	proto.RegisterType((*QueryAllHostZoneRequest)(nil), "stride.stakeibc.QueryAllHostZoneRequest")
+	proto.RegisterType((*QueryAllHostZoneResponse)(nil), "stride.stakeibc.QueryAllHostZoneResponse")
+	proto.RegisterType((*QueryModuleAddressRequest)(nil), "stride.stakeibc.QueryModuleAddressRequest")
+	proto.RegisterType((*QueryModuleAddressResponse)(nil), "stride.stakeibc.QueryModuleAddressResponse")
+	proto.RegisterType((*QueryGetEpochTrackerRequest)(nil), "stride.stakeibc.QueryGetEpochTrackerRequest")
+	proto.RegisterType((*QueryGetEpochTrackerResponse)(nil), "stride.stakeibc.QueryGetEpochTrackerResponse")
+	proto.RegisterType((*QueryAllEpochTrackerRequest)(nil), "stride.stakeibc.QueryAllEpochTrackerRequest")
+	proto.RegisterType((*QueryAllEpochTrackerResponse)(nil), "stride.stakeibc.QueryAllEpochTrackerResponse")
+	proto.RegisterType((*QueryGetNextPacketSequenceRequest)(nil), "stride.stakeibc.QueryGetNextPacketSequenceRequest")
+	proto.RegisterType((*QueryGetNextPacketSequenceResponse)(nil), "stride.stakeibc.QueryGetNextPacketSequenceResponse")
+	proto.RegisterType((*QueryAddressUnbondings)(nil), "stride.stakeibc.QueryAddressUnbondings")
+	proto.RegisterType((*QueryAddressUnbondingsResponse)(nil), "stride.stakeibc.QueryAddressUnbondingsResponse")
+	proto.RegisterType((*QueryAllTradeRoutes)(nil), "stride.stakeibc.QueryAllTradeRoutes")
+	proto.RegisterType((*QueryAllTradeRoutesResponse)(nil), "stride.stakeibc.QueryAllTradeRoutesResponse")
+	proto.RegisterType((*QueryBasketRequest)(nil), "stride.stakeibc.QueryBasketRequest")
+	proto.RegisterType((*BasketComponentRedemptionRate)(nil), "stride.stakeibc.BasketComponentRedemptionRate")
+	proto.RegisterType((*QueryBasketResponse)(nil), "stride.stakeibc.QueryBasketResponse")
+	proto.RegisterType((*QueryAllBasketsRequest)(nil), "stride.stakeibc.QueryAllBasketsRequest")
+	proto.RegisterType((*QueryAllBasketsResponse)(nil), "stride.stakeibc.QueryAllBasketsResponse")
+	proto.RegisterType((*QueryRedemptionRateHistoryRequest)(nil), "stride.stakeibc.QueryRedemptionRateHistoryRequest")
+	proto.RegisterType((*QueryRedemptionRateHistoryResponse)(nil), "stride.stakeibc.QueryRedemptionRateHistoryResponse")
+	proto.RegisterType((*QueryRedemptionRateAprRequest)(nil), "stride.stakeibc.QueryRedemptionRateAprRequest")
+	proto.RegisterType((*QueryRedemptionRateAprResponse)(nil), "stride.stakeibc.QueryRedemptionRateAprResponse")
+	proto.RegisterType((*QueryRedemptionRateDrawdownRequest)(nil), "stride.stakeibc.QueryRedemptionRateDrawdownRequest")
+	proto.RegisterType((*QueryRedemptionRateDrawdownResponse)(nil), "stride.stakeibc.QueryRedemptionRateDrawdownResponse")
+	proto.RegisterType((*QueryHaltHistoryRequest)(nil), "stride.stakeibc.QueryHaltHistoryRequest")
+	proto.RegisterType((*QueryHaltHistoryResponse)(nil), "stride.stakeibc.QueryHaltHistoryResponse")
+	proto.RegisterType((*QueryRebalancePlanRequest)(nil), "stride.stakeibc.QueryRebalancePlanRequest")
+	proto.RegisterType((*QueryRebalancePlanResponse)(nil), "stride.stakeibc.QueryRebalancePlanResponse")
+	proto.RegisterType((*PlannedIcaMsg)(nil), "stride.stakeibc.PlannedIcaMsg")
+	proto.RegisterType((*PlannedIcaTx)(nil), "stride.stakeibc.PlannedIcaTx")
+	proto.RegisterType((*QueryDelegationPlanRequest)(nil), "stride.stakeibc.QueryDelegationPlanRequest")
+	proto.RegisterType((*PlannedDepositDelegation)(nil), "stride.stakeibc.PlannedDepositDelegation")
+	proto.RegisterType((*QueryDelegationPlanResponse)(nil), "stride.stakeibc.QueryDelegationPlanResponse")
+	proto.RegisterType((*QueryUnbondingPlanRequest)(nil), "stride.stakeibc.QueryUnbondingPlanRequest")
+	proto.RegisterType((*QueryUnbondingPlanResponse)(nil), "stride.stakeibc.QueryUnbondingPlanResponse")
+	proto.RegisterType((*QueryRedemptionSweepPlanRequest)(nil), "stride.stakeibc.QueryRedemptionSweepPlanRequest")
+	proto.RegisterType((*QueryRedemptionSweepPlanResponse)(nil), "stride.stakeibc.QueryRedemptionSweepPlanResponse")
+	proto.RegisterType((*QueryCircuitBreakersRequest)(nil), "stride.stakeibc.QueryCircuitBreakersRequest")
+	proto.RegisterType((*QueryCircuitBreakersResponse)(nil), "stride.stakeibc.QueryCircuitBreakersResponse")
+	proto.RegisterType((*QueryIcaGasEstimatesRequest)(nil), "stride.stakeibc.QueryIcaGasEstimatesRequest")
+	proto.RegisterType((*QueryIcaGasEstimatesResponse)(nil), "stride.stakeibc.QueryIcaGasEstimatesResponse")
+	proto.RegisterType((*QueryInsuranceFundRequest)(nil), "stride.stakeibc.QueryInsuranceFundRequest")
+	proto.RegisterType((*QueryInsuranceFundResponse)(nil), "stride.stakeibc.QueryInsuranceFundResponse")
+	proto.RegisterType((*QueryIcaReconciliationReportRequest)(nil), "stride.stakeibc.QueryIcaReconciliationReportRequest")
+	proto.RegisterType((*QueryIcaReconciliationReportResponse)(nil), "stride.stakeibc.QueryIcaReconciliationReportResponse")
+	proto.RegisterType((*QueryIcaRecoveryStatesRequest)(nil), "stride.stakeibc.QueryIcaRecoveryStatesRequest")
+	proto.RegisterType((*QueryIcaRecoveryStatesResponse)(nil), "stride.stakeibc.QueryIcaRecoveryStatesResponse")
+	proto.RegisterType((*QueryOnboardingChecklistRequest)(nil), "stride.stakeibc.QueryOnboardingChecklistRequest")
+	proto.RegisterType((*QueryOnboardingChecklistResponse)(nil), "stride.stakeibc.QueryOnboardingChecklistResponse")
+	proto.RegisterType((*QueryRedemptionCompletionEstimateRequest)(nil), "stride.stakeibc.QueryRedemptionCompletionEstimateRequest")
+	proto.RegisterType((*QueryRedemptionCompletionEstimateResponse)(nil), "stride.stakeibc.QueryRedemptionCompletionEstimateResponse")
+}
+
+func init() { proto.RegisterFile("stride/stakeibc/query.proto", fileDescriptor_494b786fe66f2b80) }
+
+var fileDescriptor_494b786fe66f2b80 = []byte{
+	// 3229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x5a, 0xdf, 0x6f, 0x14, 0xd7,
+	0xf5, 0x67, 0x6c, 0x63, 0xf0, 0xc1, 0x60, 0x7c, 0x81, 0x78, 0x19, 0xc0, 0x86, 0xc1, 0x01, 0x83,
+	0xed, 0x5d, 0x6c, 0x43, 0x00, 0x43, 0x20, 0xfe, 0x11, 0xec, 0xcd, 0x17, 0xf2, 0x25, 0x63, 0x92,
+	0x36, 0xf4, 0x61, 0x74, 0x3d, 0x73, 0xd9, 0x9d, 0x78, 0x76, 0x66, 0x99, 0x99, 0x05, 0x53, 0xcb,
+	0x8a, 0xd4, 0xa7, 0xb6, 0x6a, 0xa5, 0xa8, 0x55, 0x55, 0xa9, 0x4f, 0x4d, 0x95, 0x4a, 0x91, 0xda,
+	0x54, 0x6a, 0x55, 0x55, 0x8a, 0xd4, 0x87, 0xf6, 0x2d, 0x7d, 0xa8, 0x9a, 0xb6, 0x0f, 0xad, 0xfa,
+	0x80, 0xaa, 0xd0, 0xbf, 0x20, 0x91, 0xfa, 0x5c, 0xcd, 0xfd, 0x31, 0x3b, 0x3f, 0xd7, 0xb3, 0x7e,
+	0xdb, 0xb9, 0xf7, 0x9c, 0x73, 0x3f, 0xe7, 0xdc, 0x73, 0xcf, 0x3d, 0xf7, 0x9c, 0x85, 0x13, 0x9e,
+	0xef, 0x9a, 0x06, 0xa9, 0x78, 0x3e, 0xde, 0x20, 0xe6, 0xba, 0x5e, 0x79, 0xdc, 0x22, 0xee, 0xb3,
+	0x72, 0xd3, 0x75, 0x7c, 0x07, 0x0d, 0xb1, 0xc9, 0xb2, 0x98, 0x94, 0x2f, 0xea, 0x8e, 0xd7, 0x70,
+	0xbc, 0xca, 0x3a, 0xf6, 0x08, 0xa3, 0xac, 0x3c, 0x99, 0x59, 0x27, 0x3e, 0x9e, 0xa9, 0x34, 0x71,
+	0xcd, 0xb4, 0xb1, 0x6f, 0x3a, 0x36, 0x63, 0x96, 0x47, 0xa3, 0xb4, 0x82, 0x4a, 0x77, 0x4c, 0x31,
+	0x7f, 0x9c, 0xcd, 0x6b, 0xf4, 0xab, 0xc2, 0x3e, 0xf8, 0xd4, 0xd1, 0x9a, 0x53, 0x73, 0xd8, 0x78,
+	0xf0, 0x8b, 0x8f, 0x9e, 0xac, 0x39, 0x4e, 0xcd, 0x22, 0x15, 0xdc, 0x34, 0x2b, 0xd8, 0xb6, 0x1d,
+	0x9f, 0xae, 0x26, 0x78, 0xce, 0x27, 0x15, 0xc1, 0x86, 0xe1, 0x12, 0xcf, 0xd3, 0x5a, 0xf6, 0xba,
+	0x63, 0x1b, 0xa6, 0x5d, 0x13, 0x62, 0x92, 0x84, 0xeb, 0xd8, 0xdb, 0x20, 0x3e, 0x9f, 0x1d, 0x4b,
+	0xce, 0xea, 0xd8, 0xb2, 0xd6, 0xb1, 0xbe, 0x21, 0xd6, 0x79, 0x39, 0x45, 0x60, 0xba, 0x7a, 0xcb,
+	0xf4, 0xb5, 0x75, 0x97, 0xe0, 0x0d, 0xe2, 0x72, 0xb2, 0xb3, 0x49, 0x32, 0xd2, 0x74, 0xf4, 0xba,
+	0xe6, 0xbb, 0x58, 0x6f, 0x13, 0xa5, 0x16, 0xab, 0x3b, 0x9e, 0xaf, 0x7d, 0xd3, 0xb1, 0x09, 0x27,
+	0x38, 0x95, 0x24, 0x30, 0x75, 0xac, 0xd5, 0xb0, 0xc0, 0xa2, 0x64, 0x4d, 0xbb, 0x44, 0x77, 0x9e,
+	0x84, 0x7b, 0x28, 0x8f, 0xa7, 0x68, 0x6c, 0xaf, 0xe5, 0x62, 0x5b, 0x27, 0xda, 0xa3, 0x96, 0x6d,
+	0x70, 0xaa, 0xd3, 0x49, 0x2a, 0xc7, 0x5e, 0x77, 0xb0, 0xdb, 0xc9, 0x6c, 0x4d, 0xec, 0xe2, 0x86,
+	0x97, 0xb7, 0x4a, 0x80, 0xc2, 0xd6, 0x4d, 0xcb, 0x8c, 0xba, 0x84, 0x92, 0xa6, 0x32, 0x88, 0x45,
+	0x6a, 0x51, 0x9a, 0xe9, 0x2c, 0x9a, 0x46, 0x33, 0xa0, 0xd0, 0x5c, 0xec, 0x13, 0xad, 0x6e, 0x7a,
+	0xbe, 0x13, 0xaa, 0x77, 0x26, 0x49, 0xee, 0xbb, 0xd8, 0x20, 0x9a, 0xeb, 0xb4, 0x7c, 0x92, 0x67,
+	0xe5, 0x27, 0xd8, 0x32, 0x0d, 0xec, 0x3b, 0x7c, 0x1b, 0x94, 0xf7, 0x61, 0xe2, 0xad, 0xc0, 0x97,
+	0xab, 0xb6, 0x4f, 0x5c, 0xbd, 0x8e, 0x4d, 0x7b, 0x41, 0xd7, 0x9d, 0x96, 0xed, 0xdf, 0x71, 0x9d,
+	0xc6, 0x02, 0x73, 0x23, 0x95, 0x3c, 0x6e, 0x11, 0xcf, 0x47, 0x47, 0x61, 0xaf, 0xf3, 0xd4, 0x26,
+	0x6e, 0x49, 0x3a, 0x2d, 0x4d, 0x0c, 0xa8, 0xec, 0x03, 0xbd, 0x0a, 0x07, 0x75, 0xc7, 0xb6, 0x89,
+	0x4e, 0x61, 0x9a, 0x46, 0xa9, 0x27, 0x98, 0x5d, 0x2c, 0x7d, 0xf9, 0x7c, 0xec, 0xe8, 0x33, 0xdc,
+	0xb0, 0xe6, 0x95, 0xd8, 0xb4, 0xa2, 0x0e, 0xb6, 0xbf, 0xab, 0x86, 0xf2, 0x81, 0x04, 0x17, 0x0a,
+	0x20, 0xf0, 0x9a, 0x8e, 0xed, 0x11, 0xa4, 0x83, 0x6c, 0x86, 0x74, 0x1a, 0x66, 0x84, 0x1a, 0x77,
+	0x77, 0x86, 0x6b, 0xf1, 0xe5, 0x2f, 0x9f, 0x8f, 0x9d, 0x61, 0x2b, 0xe7, 0xd3, 0x2a, 0x6a, 0xc9,
+	0x4c, 0x2e, 0xc8, 0x17, 0x53, 0x8e, 0x02, 0xa2, 0x88, 0xee, 0xd3, 0x5d, 0xe6, 0xda, 0x2b, 0x77,
+	0xe1, 0x48, 0x6c, 0x94, 0x23, 0xba, 0x02, 0xfd, 0xcc, 0x1b, 0xe8, 0xea, 0x07, 0x66, 0x47, 0xca,
+	0x89, 0xc0, 0x51, 0x66, 0x0c, 0x8b, 0x7d, 0x9f, 0x3d, 0x1f, 0xdb, 0xa3, 0x72, 0x62, 0xe5, 0x15,
+	0x38, 0x4e, 0xa5, 0xad, 0x10, 0xff, 0x1d, 0xb1, 0x25, 0xa1, 0xa1, 0x8f, 0xc3, 0x7e, 0x06, 0xda,
+	0x34, 0xb8, 0xad, 0xf7, 0xd1, 0xef, 0xaa, 0xa1, 0x7c, 0x1d, 0xe4, 0x2c, 0x3e, 0x0e, 0x66, 0x1e,
+	0x20, 0xdc, 0xe0, 0x00, 0x50, 0xef, 0xc4, 0x81, 0x59, 0x39, 0x05, 0x28, 0x64, 0x54, 0x23, 0xd4,
+	0xca, 0x65, 0x18, 0x11, 0x92, 0x57, 0x1d, 0xcf, 0x7f, 0xe8, 0xd8, 0xa4, 0x10, 0x9e, 0x52, 0x9a,
+	0x8b, 0xa3, 0xb9, 0x09, 0x03, 0xe1, 0xa1, 0xe6, 0xd6, 0x39, 0x9e, 0x02, 0x23, 0xb8, 0xb8, 0x7d,
+	0xf6, 0xd7, 0xf9, 0xb7, 0x82, 0x39, 0x9e, 0x05, 0xcb, 0x4a, 0xe2, 0xb9, 0x03, 0xd0, 0x0e, 0xb9,
+	0x5c, 0xf2, 0xb9, 0x32, 0x0f, 0xa3, 0x41, 0xcc, 0x2d, 0xb3, 0x48, 0xce, 0x23, 0x6f, 0xf9, 0x3e,
+	0xae, 0x09, 0x5e, 0x35, 0xc2, 0xa9, 0x7c, 0x28, 0x41, 0x29, 0xbd, 0x46, 0x36, 0xfa, 0xde, 0xae,
+	0xd0, 0xa3, 0x95, 0x18, 0xc4, 0x1e, 0x0a, 0xf1, 0xfc, 0x8e, 0x10, 0xd9, 0xd2, 0x31, 0x8c, 0x15,
+	0xee, 0x28, 0xf7, 0x1c, 0xa3, 0x65, 0x91, 0xc4, 0x89, 0x44, 0xd0, 0x67, 0xe3, 0x06, 0xe1, 0x9b,
+	0x42, 0x7f, 0x2b, 0x97, 0x40, 0xce, 0x62, 0xe0, 0x5a, 0x21, 0xe8, 0x0b, 0x4e, 0x80, 0xe0, 0x08,
+	0x7e, 0x2b, 0xab, 0x70, 0x42, 0xec, 0xe1, 0xeb, 0x41, 0xa4, 0x7e, 0xc0, 0x02, 0xb5, 0x58, 0xe4,
+	0x02, 0x1c, 0x66, 0x01, 0xdc, 0x34, 0x88, 0xed, 0x9b, 0x8f, 0xcc, 0x30, 0x02, 0x0c, 0xd1, 0xf1,
+	0x6a, 0x38, 0xac, 0xd4, 0xe1, 0x64, 0xb6, 0x24, 0xbe, 0xfa, 0x2a, 0x1c, 0x8c, 0xdd, 0x05, 0x7c,
+	0xef, 0x4e, 0xa5, 0xec, 0x1a, 0xe5, 0xe6, 0xb6, 0x1d, 0x24, 0x91, 0x31, 0xe5, 0x14, 0xc7, 0xbc,
+	0x60, 0x59, 0x19, 0x98, 0x43, 0x20, 0xa9, 0xe9, 0x7c, 0x20, 0xbd, 0xbb, 0x03, 0xf2, 0x0d, 0x38,
+	0x23, 0x54, 0x7e, 0x93, 0x6c, 0xfa, 0xf7, 0x83, 0x51, 0x7f, 0x2d, 0x80, 0x61, 0xeb, 0xa1, 0xc3,
+	0x9e, 0x02, 0xd0, 0xeb, 0xd8, 0xb6, 0x89, 0xd5, 0x3e, 0x42, 0x03, 0x7c, 0xa4, 0x6a, 0xa0, 0x11,
+	0xd8, 0xd7, 0x74, 0x5c, 0x3f, 0x0c, 0x9e, 0x6a, 0x7f, 0xf0, 0x59, 0x35, 0x94, 0xd7, 0x40, 0xe9,
+	0x24, 0x9c, 0x2b, 0x23, 0xc3, 0x7e, 0x8f, 0x8f, 0x51, 0xd9, 0x7d, 0x6a, 0xf8, 0xad, 0xcc, 0xc2,
+	0x4b, 0xcc, 0x10, 0xcc, 0x0f, 0xde, 0x16, 0x09, 0x81, 0x87, 0x4a, 0xb0, 0x2f, 0x16, 0x37, 0x55,
+	0xf1, 0xa9, 0x6c, 0xc2, 0x68, 0x36, 0x4f, 0xb8, 0xe2, 0x3b, 0x80, 0x52, 0x29, 0x86, 0x88, 0x37,
+	0x67, 0x52, 0x36, 0x4c, 0xca, 0xe1, 0x76, 0x1c, 0xc6, 0x49, 0xf9, 0xca, 0x31, 0x1e, 0x63, 0x17,
+	0x2c, 0xeb, 0x81, 0x8b, 0x0d, 0xa2, 0x06, 0x57, 0x99, 0xa7, 0xe8, 0x70, 0x22, 0x63, 0x38, 0x44,
+	0xb3, 0x0c, 0x83, 0x91, 0x9b, 0x4f, 0xe0, 0x38, 0x91, 0xc2, 0xd1, 0xe6, 0xe5, 0x08, 0x0e, 0xf8,
+	0x91, 0x45, 0x66, 0x78, 0xd4, 0x5f, 0xa4, 0x29, 0x91, 0xd8, 0xb9, 0x13, 0x30, 0xc0, 0x72, 0xa4,
+	0xf6, 0xc6, 0xed, 0x67, 0x03, 0x55, 0x43, 0xf9, 0xbd, 0x04, 0xa7, 0x18, 0xf9, 0x92, 0xd3, 0x68,
+	0x3a, 0x36, 0xb1, 0x7d, 0x35, 0xbc, 0xb1, 0x55, 0xec, 0x13, 0x74, 0x1a, 0x06, 0xc3, 0x20, 0xd2,
+	0x96, 0x00, 0x22, 0x4c, 0x54, 0x8d, 0xc0, 0x35, 0x28, 0x85, 0x41, 0x6c, 0xa7, 0xc1, 0xb7, 0x9f,
+	0x06, 0x9e, 0xe5, 0x60, 0x00, 0x3d, 0x84, 0xa1, 0x44, 0x12, 0x50, 0xea, 0xa5, 0xb7, 0xdc, 0x4c,
+	0xa0, 0xc1, 0xbf, 0x9e, 0x8f, 0x9d, 0x60, 0x31, 0xc5, 0x33, 0x36, 0xca, 0xa6, 0x53, 0x69, 0x60,
+	0xbf, 0x5e, 0xbe, 0x4b, 0x6a, 0x58, 0x7f, 0xb6, 0x4c, 0xf4, 0xbf, 0xfd, 0x76, 0x1a, 0xd8, 0x74,
+	0x79, 0x99, 0xe8, 0xea, 0x21, 0x37, 0x06, 0x4e, 0xf9, 0x44, 0xe2, 0xe6, 0x16, 0x2a, 0xb7, 0xaf,
+	0x34, 0xa6, 0x62, 0xee, 0x95, 0xc6, 0x18, 0xc4, 0x95, 0xc6, 0x88, 0x91, 0x06, 0x87, 0x13, 0x50,
+	0xbd, 0x52, 0x0f, 0xdd, 0x8a, 0x72, 0x8e, 0x80, 0x1c, 0xab, 0x71, 0xb9, 0x43, 0x71, 0xb8, 0x9e,
+	0x52, 0x12, 0xbe, 0x6c, 0x59, 0x8c, 0x3f, 0xbc, 0x9b, 0x55, 0x18, 0x49, 0xcd, 0x70, 0x65, 0xae,
+	0xc2, 0x3e, 0x86, 0x4f, 0xf8, 0xc5, 0x0e, 0xda, 0x08, 0x6a, 0xe5, 0x16, 0x3f, 0xd8, 0x71, 0x6c,
+	0xab, 0x2c, 0x03, 0x2b, 0x70, 0x33, 0x3e, 0x06, 0xa5, 0x13, 0x3f, 0x87, 0xf7, 0x7f, 0x30, 0xe0,
+	0xd9, 0xb8, 0xe9, 0xd5, 0x9d, 0x10, 0xe0, 0xf9, 0x14, 0xc0, 0xb8, 0x88, 0x35, 0x4e, 0xcf, 0x01,
+	0xb7, 0xf9, 0x95, 0x79, 0x38, 0x95, 0xb1, 0xe4, 0x42, 0xd3, 0x2d, 0x00, 0xf7, 0x77, 0x12, 0x8c,
+	0xe6, 0x31, 0x87, 0x41, 0xb3, 0x1f, 0x37, 0x5d, 0xed, 0x2a, 0xe7, 0xdd, 0x8d, 0x0b, 0xee, 0xc5,
+	0x4d, 0xf7, 0xaa, 0x81, 0xde, 0x80, 0x7d, 0x81, 0xa4, 0xb9, 0x4b, 0x22, 0x5b, 0xdc, 0x85, 0xa8,
+	0x00, 0xcb, 0xdc, 0x25, 0x43, 0xb9, 0x9d, 0x69, 0xe7, 0x65, 0x17, 0x3f, 0x35, 0x9c, 0xa7, 0x76,
+	0x01, 0xcd, 0xff, 0x21, 0xc1, 0xd9, 0x8e, 0x12, 0xb8, 0xfa, 0x0f, 0x60, 0xb0, 0x81, 0x37, 0x35,
+	0x83, 0x8f, 0xef, 0xde, 0x08, 0x07, 0x1a, 0x78, 0x53, 0x48, 0x47, 0x17, 0x61, 0xb8, 0x49, 0xf0,
+	0x86, 0xc6, 0xae, 0x23, 0xbb, 0xd5, 0x58, 0x27, 0x2e, 0x35, 0x4a, 0x9f, 0x3a, 0x14, 0x4c, 0xd0,
+	0x0b, 0xe8, 0x4d, 0x3a, 0x8c, 0xca, 0x70, 0xc4, 0x77, 0x9d, 0x56, 0xad, 0x1e, 0xa7, 0xee, 0xa5,
+	0xd4, 0xc3, 0x6c, 0x2a, 0x42, 0x1f, 0xa6, 0x74, 0xab, 0xd8, 0xf2, 0x8b, 0x3b, 0xee, 0x23, 0x28,
+	0xa5, 0xb9, 0xb8, 0x0d, 0xde, 0x80, 0x7d, 0x2e, 0xd1, 0x1d, 0xd7, 0x10, 0xce, 0x7a, 0x71, 0x07,
+	0x67, 0x5d, 0x69, 0x61, 0xd7, 0x50, 0x29, 0x8b, 0x38, 0x60, 0x5c, 0x40, 0x98, 0x02, 0xab, 0x64,
+	0x1d, 0x5b, 0xd8, 0xd6, 0xc9, 0x7d, 0x0b, 0x17, 0xd9, 0xaf, 0x4f, 0x7a, 0x40, 0xce, 0x62, 0xe4,
+	0x10, 0xef, 0xc0, 0xa0, 0xcb, 0x27, 0x22, 0xb7, 0xd2, 0xc9, 0x0c, 0x9c, 0x21, 0x91, 0xb8, 0xd8,
+	0xa3, 0x7c, 0x68, 0x12, 0x86, 0x2d, 0x47, 0xdf, 0x20, 0x86, 0x16, 0x49, 0xa9, 0x83, 0x78, 0x36,
+	0xa0, 0x1e, 0x66, 0x13, 0xed, 0x04, 0x1c, 0xe9, 0x30, 0x62, 0xda, 0xda, 0x23, 0xcb, 0xac, 0xd5,
+	0x7d, 0x2d, 0xfa, 0xb2, 0xf3, 0x4a, 0xbd, 0x74, 0xfd, 0x97, 0x53, 0xeb, 0x57, 0xed, 0x3b, 0x94,
+	0x5c, 0x8d, 0x50, 0x73, 0x20, 0xc7, 0xcc, 0x8c, 0x39, 0x0f, 0x5d, 0x81, 0x5e, 0x7f, 0xd3, 0x2b,
+	0xf5, 0xe5, 0xa4, 0x2a, 0x81, 0x15, 0x6c, 0x62, 0x54, 0x75, 0xfc, 0x60, 0x93, 0x0b, 0x0a, 0xe8,
+	0x95, 0x5b, 0x70, 0xb0, 0x3d, 0x75, 0xcf, 0xab, 0x05, 0xb6, 0xf5, 0x9f, 0x35, 0x89, 0xd6, 0x72,
+	0x2d, 0x61, 0xdb, 0xe0, 0xfb, 0x6d, 0xd7, 0x0a, 0xd2, 0xc3, 0xf7, 0x3c, 0x9e, 0xb0, 0x0e, 0xa8,
+	0xf4, 0xb7, 0x62, 0xc2, 0x60, 0x54, 0x34, 0x1a, 0x83, 0x03, 0xa2, 0x30, 0x10, 0xb9, 0xd2, 0xc4,
+	0x50, 0xd5, 0x40, 0xd7, 0xa0, 0xaf, 0xe1, 0xd5, 0x44, 0xf0, 0x1f, 0xed, 0x00, 0xf4, 0x9e, 0x27,
+	0x6c, 0x4f, 0x39, 0x94, 0xab, 0x7c, 0x67, 0x97, 0x43, 0xad, 0x0b, 0xfa, 0xc4, 0x77, 0x7a, 0xa0,
+	0xc4, 0xc5, 0x2e, 0x93, 0xa6, 0xe3, 0x99, 0x7e, 0x5b, 0x44, 0x70, 0xc4, 0x0c, 0x36, 0xa8, 0x31,
+	0xdf, 0x13, 0x02, 0xfa, 0xd4, 0x21, 0x3e, 0xc1, 0x3c, 0xb4, 0x6a, 0x04, 0x77, 0x1f, 0x6e, 0x04,
+	0x8f, 0x41, 0x1e, 0x98, 0x4e, 0xf1, 0xe3, 0x7d, 0x2c, 0x7d, 0xbc, 0xab, 0xb6, 0xaf, 0x72, 0x62,
+	0xb4, 0x06, 0xc3, 0x5e, 0xd3, 0x32, 0x83, 0x6b, 0x3c, 0xb9, 0xf3, 0xa7, 0x53, 0xfa, 0xaf, 0x35,
+	0xad, 0x28, 0x3e, 0x6e, 0x81, 0xc3, 0x5e, 0x7c, 0x78, 0xd7, 0xfb, 0xfd, 0x1e, 0xcf, 0x96, 0x92,
+	0x46, 0x0c, 0x6f, 0x9c, 0xfd, 0x5c, 0x69, 0x71, 0x36, 0x2e, 0xe4, 0x89, 0x4e, 0x99, 0x52, 0x3c,
+	0x73, 0x84, 0x80, 0xf0, 0x0c, 0x87, 0x39, 0x5c, 0xc1, 0xfd, 0xfa, 0x4a, 0x9c, 0xe1, 0x04, 0x63,
+	0x78, 0x69, 0x97, 0x6c, 0xb2, 0xe9, 0xb7, 0x93, 0x4b, 0xcd, 0xc0, 0xcf, 0x58, 0xd0, 0xe3, 0x1b,
+	0x77, 0x2c, 0x98, 0x0f, 0x99, 0x97, 0xf1, 0x33, 0x1a, 0xf7, 0xd0, 0x3d, 0x38, 0xe2, 0x3b, 0x3e,
+	0xb6, 0x38, 0xa7, 0xd6, 0xcd, 0x5e, 0x0e, 0x53, 0x4e, 0x26, 0x73, 0x81, 0x6d, 0xeb, 0x0d, 0x90,
+	0x59, 0xa4, 0x6d, 0x03, 0x09, 0x3d, 0x88, 0xed, 0x6f, 0x9f, 0x3a, 0x42, 0x29, 0x42, 0x28, 0xc2,
+	0x93, 0x3c, 0xf4, 0x2e, 0x1c, 0x61, 0x3e, 0xd1, 0xb2, 0xa3, 0x5e, 0xc1, 0xb6, 0x53, 0xc9, 0xf6,
+	0x8a, 0xb7, 0xed, 0x54, 0x30, 0x40, 0x5e, 0x72, 0x22, 0xf4, 0x8c, 0xbd, 0x5d, 0x7a, 0xc6, 0x4d,
+	0x18, 0x4b, 0x5c, 0x74, 0x6b, 0x4f, 0x09, 0x69, 0x16, 0xdc, 0xb3, 0x17, 0x12, 0x9c, 0xce, 0x67,
+	0x0f, 0xbd, 0x0b, 0xb1, 0x0d, 0xf0, 0x82, 0x29, 0x61, 0x7f, 0xa9, 0x88, 0xfd, 0x0f, 0x53, 0x46,
+	0x2a, 0xb2, 0x90, 0xf9, 0x7b, 0x3a, 0x9b, 0x9f, 0xdb, 0xa8, 0xb7, 0x4b, 0x1b, 0x89, 0x87, 0xe5,
+	0x12, 0x2b, 0x6d, 0x2e, 0xb2, 0xca, 0x66, 0x98, 0x69, 0x7e, 0x24, 0xc1, 0xc9, 0xec, 0x79, 0x6e,
+	0x80, 0x25, 0xd8, 0x5f, 0x0b, 0xee, 0x3c, 0x13, 0x8b, 0xca, 0x44, 0x3a, 0x9f, 0x8b, 0xf3, 0xae,
+	0x70, 0x72, 0x35, 0x64, 0x44, 0xb7, 0x61, 0xef, 0x23, 0x0b, 0x87, 0x21, 0xf4, 0xec, 0x0e, 0x12,
+	0xee, 0x58, 0x58, 0xc4, 0x51, 0xc6, 0xa7, 0x5c, 0xe3, 0x5a, 0x54, 0x75, 0xbc, 0x82, 0xbd, 0xd7,
+	0x3d, 0xdf, 0x6c, 0x60, 0x9f, 0x08, 0x2d, 0x3a, 0xed, 0xf2, 0xb7, 0x85, 0x82, 0x29, 0x56, 0xae,
+	0xe0, 0x59, 0x38, 0x14, 0xa4, 0x41, 0x41, 0xb9, 0xd5, 0xdf, 0x0c, 0x0a, 0xb2, 0xfc, 0x44, 0x06,
+	0x59, 0x0d, 0xb5, 0xe6, 0x0a, 0xf6, 0xd0, 0x12, 0x0c, 0x10, 0xc1, 0xc9, 0x95, 0x18, 0x4b, 0xdf,
+	0x80, 0xb1, 0x15, 0x44, 0x3a, 0x1b, 0xf2, 0x85, 0xc1, 0xa5, 0x2a, 0xaa, 0xb6, 0x77, 0x5a, 0xb6,
+	0x51, 0x40, 0x85, 0xef, 0x8a, 0xe0, 0x92, 0x60, 0x0c, 0x0b, 0x3b, 0xfd, 0xba, 0x63, 0x3f, 0x32,
+	0x6b, 0x7c, 0x7f, 0xc6, 0x33, 0xae, 0xe6, 0x08, 0xdf, 0x12, 0xa5, 0x55, 0x39, 0x4f, 0xf4, 0xd9,
+	0xdc, 0x13, 0x7b, 0x36, 0xa3, 0xeb, 0xb0, 0x8f, 0xa5, 0x0f, 0xec, 0x89, 0x16, 0x94, 0x8b, 0xa2,
+	0xf5, 0x1e, 0x51, 0xe9, 0x59, 0x72, 0x4c, 0xbb, 0xfd, 0xd6, 0xa0, 0xf4, 0xe8, 0x5d, 0x38, 0x4c,
+	0x0b, 0xd7, 0xb8, 0x16, 0xd6, 0x78, 0x79, 0x9c, 0x98, 0xc8, 0x07, 0xb7, 0xc4, 0x39, 0x62, 0xd9,
+	0xd5, 0x90, 0x90, 0xc3, 0x33, 0x37, 0xe5, 0x35, 0x9e, 0xdc, 0x56, 0x75, 0xac, 0xc6, 0xea, 0xd2,
+	0x2a, 0x09, 0x8a, 0x0c, 0x05, 0xcc, 0xf9, 0x0b, 0x09, 0xc6, 0x3b, 0x8b, 0x08, 0x33, 0xaf, 0x7e,
+	0x97, 0x8e, 0x70, 0xc3, 0x4e, 0x64, 0xed, 0x78, 0x96, 0x04, 0xf1, 0x90, 0x64, 0xdc, 0xe8, 0x06,
+	0x0c, 0xf8, 0x75, 0x97, 0x78, 0x75, 0xc7, 0x32, 0x8a, 0x85, 0xee, 0x36, 0xbd, 0x32, 0xc6, 0xdf,
+	0x40, 0x7c, 0xa9, 0xa0, 0x1b, 0xb0, 0xe6, 0x47, 0x7c, 0x5f, 0x71, 0x61, 0x34, 0x8f, 0x80, 0xeb,
+	0x71, 0x3f, 0x78, 0x73, 0xb3, 0x19, 0xcd, 0xa3, 0x53, 0xb9, 0xa5, 0x8d, 0xa4, 0x10, 0xae, 0xc9,
+	0x21, 0x37, 0x26, 0x39, 0x0c, 0xbc, 0xff, 0x1f, 0x76, 0x16, 0x96, 0xea, 0x44, 0xdf, 0xb0, 0x4c,
+	0xaf, 0xc8, 0x06, 0xf8, 0x70, 0x3a, 0x9f, 0x3b, 0xc4, 0x3c, 0xa0, 0x8b, 0x41, 0x6e, 0xfe, 0xa9,
+	0xdc, 0x6a, 0x65, 0x86, 0x20, 0x71, 0xfa, 0x42, 0x21, 0x0a, 0x81, 0x89, 0x44, 0xb4, 0x0f, 0x9e,
+	0xeb, 0x16, 0x09, 0x7e, 0x89, 0x33, 0x2b, 0xc0, 0x5f, 0x87, 0xe3, 0x2d, 0x8f, 0xb8, 0x5a, 0xf4,
+	0xfd, 0x1f, 0xcb, 0xb4, 0x06, 0xd4, 0x97, 0x02, 0x82, 0xb6, 0x2c, 0x11, 0xa7, 0x95, 0xaf, 0x44,
+	0xfd, 0xbf, 0xf3, 0x3a, 0x5c, 0xcd, 0x32, 0x1c, 0xc9, 0xcf, 0x09, 0x86, 0x5b, 0xa9, 0x7c, 0xe0,
+	0x21, 0xc8, 0x36, 0xf6, 0xcd, 0x27, 0x44, 0xf3, 0x9d, 0x0d, 0x62, 0x7b, 0xda, 0xe3, 0x16, 0x69,
+	0x11, 0x43, 0xc3, 0x75, 0x82, 0x0b, 0xfa, 0xd6, 0x08, 0x13, 0xf0, 0x80, 0xf2, 0xbf, 0x45, 0xd9,
+	0x17, 0x02, 0x6e, 0x34, 0x0f, 0xc7, 0x45, 0xac, 0x32, 0x34, 0x3d, 0xc4, 0xac, 0xf9, 0x66, 0x83,
+	0xf0, 0x37, 0xd9, 0x48, 0x48, 0xd0, 0xd6, 0xe9, 0x81, 0xd9, 0x20, 0xb3, 0x9f, 0x9e, 0x83, 0xbd,
+	0x54, 0x6b, 0xf4, 0x3e, 0xf4, 0xb3, 0x06, 0x01, 0x4a, 0x47, 0xf9, 0x74, 0x17, 0x42, 0x1e, 0xef,
+	0x4c, 0xc4, 0xcc, 0xa4, 0x5c, 0xfc, 0xd6, 0xdf, 0xff, 0xf3, 0xc3, 0x9e, 0x71, 0xa4, 0x54, 0xd6,
+	0x28, 0xb5, 0x85, 0xd7, 0xbd, 0x4a, 0x76, 0x13, 0x0b, 0x7d, 0x28, 0x01, 0x44, 0x5e, 0x32, 0x17,
+	0xb3, 0x17, 0xc8, 0xea, 0x53, 0xc8, 0x93, 0x85, 0x68, 0x39, 0xa6, 0x79, 0x8a, 0xe9, 0x32, 0x9a,
+	0xe5, 0x98, 0xa6, 0xef, 0x66, 0x81, 0x6a, 0xbf, 0xb5, 0x2a, 0x5b, 0xe2, 0x3c, 0x6c, 0xa3, 0x9f,
+	0x48, 0xb0, 0x5f, 0x38, 0x2f, 0x9a, 0xc8, 0x5d, 0x35, 0xd1, 0x27, 0x90, 0x2f, 0x14, 0xa0, 0xe4,
+	0xe8, 0xae, 0x53, 0x74, 0x73, 0x68, 0xa6, 0x23, 0xba, 0xb0, 0x96, 0x17, 0x05, 0xf7, 0x03, 0x09,
+	0x0e, 0x08, 0x79, 0x0b, 0x96, 0x95, 0x87, 0x2f, 0xdd, 0xc7, 0x90, 0x2f, 0x14, 0xa0, 0xe4, 0xf8,
+	0xca, 0x14, 0xdf, 0x04, 0x3a, 0x57, 0x0c, 0x1f, 0xfa, 0x48, 0x82, 0x83, 0xb1, 0x0e, 0x40, 0xde,
+	0xc6, 0x66, 0xf5, 0x15, 0xe4, 0xc9, 0x42, 0xb4, 0x5d, 0x6d, 0x6c, 0x83, 0xf2, 0x8a, 0xf6, 0x5b,
+	0x65, 0x2b, 0xe8, 0x55, 0x6c, 0xa3, 0x1f, 0x49, 0x70, 0xb2, 0x53, 0xe3, 0x0f, 0x5d, 0xcf, 0x46,
+	0x52, 0xa0, 0x5d, 0x29, 0xcf, 0xef, 0x86, 0x95, 0xc7, 0x99, 0xdf, 0x48, 0x30, 0x18, 0x2d, 0xfd,
+	0xa3, 0xa9, 0x5c, 0x57, 0xca, 0x68, 0x3f, 0xc8, 0xd3, 0x05, 0xa9, 0xb9, 0x05, 0x5f, 0xa7, 0x16,
+	0xbc, 0x8d, 0x5e, 0xed, 0x68, 0xc1, 0x58, 0xc3, 0xa2, 0xb2, 0x95, 0xec, 0xc9, 0x6c, 0xa3, 0x9f,
+	0x49, 0x30, 0x14, 0x95, 0x1f, 0x38, 0xe3, 0x54, 0xae, 0x8b, 0x75, 0x81, 0x3b, 0xa7, 0x8b, 0xa2,
+	0xcc, 0x52, 0xdc, 0x53, 0xe8, 0x62, 0x71, 0xdc, 0xe8, 0x2f, 0x12, 0xa0, 0x74, 0x2f, 0x03, 0xcd,
+	0xe6, 0x5a, 0x2c, 0xb7, 0xab, 0x22, 0xcf, 0x75, 0xc5, 0xc3, 0x31, 0xdf, 0xa7, 0x98, 0xdf, 0x40,
+	0xab, 0x1d, 0x31, 0xd3, 0xd7, 0x67, 0x93, 0x4a, 0xd0, 0x44, 0x2f, 0xa5, 0xb2, 0xc5, 0x3b, 0x36,
+	0xc1, 0xa9, 0xaf, 0x6c, 0xf1, 0x8e, 0xcd, 0x36, 0xfa, 0x58, 0x82, 0xe1, 0x74, 0x7b, 0xe5, 0x7c,
+	0x8e, 0x29, 0x93, 0x84, 0x72, 0xa5, 0x20, 0x61, 0x97, 0xa1, 0xaa, 0xdd, 0x97, 0xa9, 0x6c, 0xf1,
+	0x43, 0xb7, 0x8d, 0x7e, 0x2c, 0xc1, 0xa1, 0x78, 0x13, 0x05, 0x8d, 0xe7, 0x6e, 0x79, 0x84, 0x4a,
+	0x9e, 0x2a, 0x42, 0x15, 0x22, 0x9c, 0xa1, 0x08, 0x27, 0xd1, 0x85, 0x8e, 0x08, 0xa3, 0x3d, 0x1b,
+	0xf4, 0x3d, 0x09, 0xfa, 0x59, 0x1d, 0x3e, 0xef, 0x1e, 0x8c, 0xf5, 0x65, 0xe4, 0xf1, 0xce, 0x44,
+	0x1c, 0xc8, 0x55, 0x0a, 0x64, 0x06, 0x55, 0x3a, 0x02, 0x61, 0x15, 0xff, 0xca, 0x56, 0xd8, 0xe8,
+	0xd9, 0x46, 0xdf, 0x97, 0x00, 0xda, 0xcd, 0x84, 0xdc, 0xcd, 0x4c, 0x36, 0x22, 0xe4, 0x89, 0x9d,
+	0x09, 0x39, 0xb4, 0x29, 0x0a, 0xed, 0x1c, 0x1a, 0x2f, 0x00, 0xcd, 0x43, 0x7f, 0x92, 0xe0, 0x58,
+	0x66, 0x23, 0x21, 0xef, 0xe0, 0x74, 0xea, 0x5a, 0xc8, 0x73, 0x5d, 0xf1, 0x70, 0xc0, 0x2b, 0x14,
+	0xf0, 0x02, 0xba, 0xdd, 0x11, 0x70, 0xce, 0x3f, 0x56, 0xa2, 0xf7, 0xe5, 0xa7, 0x12, 0x0c, 0xa7,
+	0x9a, 0x0c, 0xa8, 0x5c, 0x04, 0x53, 0xbb, 0x95, 0x21, 0x57, 0x0a, 0xd3, 0x73, 0xfc, 0x4b, 0x14,
+	0xff, 0xab, 0xe8, 0x46, 0x57, 0xf8, 0x71, 0xd3, 0x8d, 0x62, 0xff, 0xb3, 0x04, 0x2f, 0x65, 0xb7,
+	0x09, 0x50, 0x21, 0xa3, 0x26, 0xda, 0x12, 0xf2, 0xe5, 0xee, 0x98, 0xb8, 0x2a, 0xab, 0x54, 0x95,
+	0x45, 0xf4, 0x5a, 0x57, 0xaa, 0x88, 0xc6, 0x45, 0x54, 0x9f, 0x9f, 0x06, 0xb9, 0x4b, 0xbb, 0xce,
+	0x9f, 0x97, 0xbb, 0xa4, 0x1b, 0x08, 0xf2, 0x85, 0x02, 0x94, 0x1c, 0xee, 0x4d, 0x0a, 0xf7, 0x15,
+	0x74, 0xb9, 0x73, 0xee, 0x82, 0x2d, 0x3f, 0xcb, 0x5d, 0x3e, 0x96, 0xe0, 0x60, 0xac, 0xd2, 0x9f,
+	0x97, 0xc9, 0x64, 0xf5, 0x11, 0xe4, 0xc9, 0x42, 0xb4, 0x1c, 0xe8, 0x2d, 0x0a, 0xf4, 0x1a, 0x7a,
+	0x65, 0x07, 0xbb, 0x72, 0x5e, 0xad, 0x69, 0xe1, 0x98, 0x35, 0x7f, 0x29, 0xc1, 0xa1, 0x78, 0xd5,
+	0x15, 0xe5, 0xac, 0x9f, 0x59, 0xe0, 0x96, 0xa7, 0x8a, 0x11, 0x73, 0xb4, 0xb7, 0x29, 0xda, 0xeb,
+	0xe8, 0x6a, 0x47, 0xb4, 0xed, 0xb2, 0x61, 0x0a, 0x6e, 0x60, 0xd9, 0x58, 0xfd, 0x35, 0xcf, 0xb2,
+	0x59, 0xd5, 0x5d, 0x79, 0xb2, 0x10, 0x6d, 0x57, 0x96, 0x6d, 0x3f, 0xed, 0x92, 0x50, 0xff, 0x28,
+	0xc1, 0x91, 0x8c, 0xb2, 0x23, 0xba, 0xb4, 0xd3, 0xf9, 0x49, 0x16, 0x38, 0xe5, 0x99, 0x2e, 0x38,
+	0xba, 0x4a, 0xcf, 0x22, 0xc7, 0x8d, 0xd5, 0x3e, 0x93, 0x3a, 0xfc, 0x5c, 0x82, 0xa1, 0x44, 0xd5,
+	0x30, 0x2f, 0x3d, 0xcb, 0x2e, 0x3e, 0xca, 0xd3, 0x05, 0xa9, 0x39, 0xee, 0x2b, 0x14, 0x77, 0x05,
+	0x4d, 0x77, 0xc4, 0x9d, 0xf8, 0x0f, 0xa7, 0x87, 0x7e, 0x2d, 0xc1, 0x50, 0xa2, 0xf8, 0x97, 0x87,
+	0x33, 0xbb, 0xbc, 0x28, 0x4f, 0x17, 0xa4, 0xe6, 0x38, 0x17, 0x28, 0xce, 0x1b, 0xe8, 0x7a, 0x47,
+	0x9c, 0xfc, 0xef, 0x9f, 0x5a, 0x58, 0x1f, 0x4c, 0xba, 0x72, 0xac, 0x6a, 0x97, 0xe7, 0xca, 0x59,
+	0xb5, 0x44, 0x79, 0xb2, 0x10, 0x6d, 0x57, 0xae, 0x1c, 0xff, 0xa7, 0x69, 0x14, 0xea, 0x5f, 0x25,
+	0x18, 0xc9, 0xa9, 0x83, 0xa1, 0xcb, 0xb9, 0x86, 0xeb, 0x50, 0xbb, 0x93, 0xaf, 0x74, 0xc9, 0xc5,
+	0x15, 0xa9, 0x52, 0x45, 0x96, 0xd0, 0xc2, 0x8e, 0x66, 0x8f, 0xff, 0xa1, 0x55, 0x63, 0x65, 0xba,
+	0xa8, 0x4e, 0xbf, 0x92, 0x60, 0x38, 0x55, 0x4f, 0xcb, 0xbb, 0xd2, 0xf3, 0x2a, 0x73, 0x72, 0xa5,
+	0x30, 0x3d, 0xd7, 0xe0, 0x1a, 0xd5, 0x60, 0x16, 0x5d, 0x2a, 0xa4, 0x41, 0xa4, 0x9e, 0x87, 0xfe,
+	0x20, 0xc1, 0x91, 0x8c, 0x2a, 0x58, 0x5e, 0x3c, 0xc9, 0xaf, 0xdb, 0xc9, 0x33, 0x5d, 0x70, 0x70,
+	0xd8, 0xcb, 0x14, 0xf6, 0x2d, 0x74, 0xb3, 0x23, 0xec, 0xf6, 0xbf, 0x90, 0xb5, 0xb0, 0x28, 0x17,
+	0xb5, 0xf9, 0x7f, 0x25, 0x38, 0xd9, 0xa9, 0x66, 0x96, 0xf7, 0x74, 0x2e, 0x50, 0xcf, 0x93, 0xe7,
+	0x77, 0xc3, 0xca, 0xb5, 0xd3, 0xa8, 0x76, 0xef, 0xa2, 0xaf, 0x15, 0x8d, 0x96, 0x91, 0xd2, 0x99,
+	0x38, 0xdb, 0x95, 0xad, 0xdc, 0x72, 0xe2, 0xf6, 0xe2, 0xdd, 0xcf, 0xbe, 0x18, 0x95, 0x3e, 0xff,
+	0x62, 0x54, 0xfa, 0xf7, 0x17, 0xa3, 0xd2, 0x07, 0x2f, 0x46, 0xf7, 0x7c, 0xfe, 0x62, 0x74, 0xcf,
+	0x3f, 0x5f, 0x8c, 0xee, 0x79, 0x38, 0x5b, 0x33, 0xfd, 0x7a, 0x6b, 0xbd, 0xac, 0x3b, 0x8d, 0xac,
+	0xc5, 0x9f, 0xcc, 0xcd, 0x55, 0x36, 0xdb, 0x10, 0x82, 0x9e, 0xb7, 0xb7, 0xde, 0x4f, 0xff, 0x07,
+	0x3d, 0xf7, 0xbf, 0x01, 0x00, 0xf5, 0x25, 0x27, 0x85, 0x0d, 0x30, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Validator by host zone.
+	Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error)
+	// Queries a HostZone by id.
+	HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error)
+	// Queries a list of HostZone items.
+	HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error)
+	// Queries a list of ModuleAddress items.
+	ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error)
+	// QueryInterchainAccountFromAddress returns the interchain account for given
+	// owner address on a given connection pair
+	InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error)
+	// Queries a EpochTracker by index.
+	EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error)
+	// Queries a list of EpochTracker items.
+	EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error)
+	// Queries the next packet sequence for one for a given channel
+	NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error)
+	// Queries an address's unbondings
+	AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error)
+	// Queries all trade routes
+	AllTradeRoutes(ctx context.Context, in *QueryAllTradeRoutes, opts ...grpc.CallOption) (*QueryAllTradeRoutesResponse, error)
+	// Queries a basket and the redemption rate of each of its components
+	Basket(ctx context.Context, in *QueryBasketRequest, opts ...grpc.CallOption) (*QueryBasketResponse, error)
+	// Queries all baskets
+	AllBaskets(ctx context.Context, in *QueryAllBasketsRequest, opts ...grpc.CallOption) (*QueryAllBasketsResponse, error)
+	// Queries the recorded redemption rate history of a host zone, ordered from
+	// oldest to newest
+	RedemptionRateHistory(ctx context.Context, in *QueryRedemptionRateHistoryRequest, opts ...grpc.CallOption) (*QueryRedemptionRateHistoryResponse, error)
+	// Queries the trailing 7 and 30 day APR of a host zone, derived from the
+	// growth in the redemption rate
+	RedemptionRateApr(ctx context.Context, in *QueryRedemptionRateAprRequest, opts ...grpc.CallOption) (*QueryRedemptionRateAprResponse, error)
+	// Queries the largest peak-to-trough decline in a host zone's redemption
+	// rate across the recorded history
+	RedemptionRateDrawdown(ctx context.Context, in *QueryRedemptionRateDrawdownRequest, opts ...grpc.CallOption) (*QueryRedemptionRateDrawdownResponse, error)
+	// Queries the history of redemption rate guard status changes for a host
+	// zone, ordered from oldest to newest
+	HaltHistory(ctx context.Context, in *QueryHaltHistoryRequest, opts ...grpc.CallOption) (*QueryHaltHistoryResponse, error)
+	// Dry-runs the rebalance planner for a host zone, returning the
+	// redelegations that would be submitted if the rebalance ran now
+	RebalancePlan(ctx context.Context, in *QueryRebalancePlanRequest, opts ...grpc.CallOption) (*QueryRebalancePlanResponse, error)
+	// Dry-runs the delegation of each queued deposit record on a host zone,
+	// returning the delegation ICAs that would be submitted next epoch
+	DelegationPlan(ctx context.Context, in *QueryDelegationPlanRequest, opts ...grpc.CallOption) (*QueryDelegationPlanResponse, error)
+	// Dry-runs the unbonding of queued redemptions on a host zone, returning
+	// the undelegation ICAs that would be submitted on the next unbonding day
+	UnbondingPlan(ctx context.Context, in *QueryUnbondingPlanRequest, opts ...grpc.CallOption) (*QueryUnbondingPlanResponse, error)
+	// Dry-runs the sweep of unbonded tokens on a host zone, returning the
+	// ICA that would transfer them to the redemption account
+	RedemptionSweepPlan(ctx context.Context, in *QueryRedemptionSweepPlanRequest, opts ...grpc.CallOption) (*QueryRedemptionSweepPlanResponse, error)
+	// Queries the circuit breaker guardian and all paused actions
+	CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error)
+	// Queries the learned gas estimate of each ICA message type on a host zone
+	IcaGasEstimates(ctx context.Context, in *QueryIcaGasEstimatesRequest, opts ...grpc.CallOption) (*QueryIcaGasEstimatesResponse, error)
+	// Queries the slashing insurance fund of a host zone and its coverage history
+	InsuranceFund(ctx context.Context, in *QueryInsuranceFundRequest, opts ...grpc.CallOption) (*QueryInsuranceFundResponse, error)
+	// Queries the latest balance reconciliation report of a host zone
+	IcaReconciliationReport(ctx context.Context, in *QueryIcaReconciliationReportRequest, opts ...grpc.CallOption) (*QueryIcaReconciliationReportResponse, error)
+	// Queries the ICAs that are being automatically recovered after a channel
+	// closure
+	IcaRecoveryStates(ctx context.Context, in *QueryIcaRecoveryStatesRequest, opts ...grpc.CallOption) (*QueryIcaRecoveryStatesResponse, error)
+	// Queries the post-registration onboarding checklist for a host zone
+	OnboardingChecklist(ctx context.Context, in *QueryOnboardingChecklistRequest, opts ...grpc.CallOption) (*QueryOnboardingChecklistResponse, error)
+	// Estimates when a user redemption record will be claimable, accounting for
+	// the redemptions queued ahead of it and the host zone's unbond cap
+	RedemptionCompletionEstimate(ctx context.Context, in *QueryRedemptionCompletionEstimateRequest, opts ...grpc.CallOption) (*QueryRedemptionCompletionEstimateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error) {
+	out := new(QueryGetValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Validators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error) {
+	out := new(QueryGetHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error) {
+	out := new(QueryAllHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZoneAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error) {
+	out := new(QueryModuleAddressResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/ModuleAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error) {
+	out := new(QueryInterchainAccountFromAddressResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/InterchainAccountFromAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error) {
+	out := new(QueryGetEpochTrackerResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTracker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error) {
+	out := new(QueryAllEpochTrackerResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTrackerAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error) {
+	out := new(QueryGetNextPacketSequenceResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/NextPacketSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error) {
+	out := new(QueryAddressUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/AddressUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllTradeRoutes(ctx context.Context, in *QueryAllTradeRoutes, opts ...grpc.CallOption) (*QueryAllTradeRoutesResponse, error) {
+	out := new(QueryAllTradeRoutesResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/AllTradeRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Basket(ctx context.Context, in *QueryBasketRequest, opts ...grpc.CallOption) (*QueryBasketResponse, error) {
+	out := new(QueryBasketResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Basket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllBaskets(ctx context.Context, in *QueryAllBasketsRequest, opts ...grpc.CallOption) (*QueryAllBasketsResponse, error) {
+	out := new(QueryAllBasketsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/AllBaskets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionRateHistory(ctx context.Context, in *QueryRedemptionRateHistoryRequest, opts ...grpc.CallOption) (*QueryRedemptionRateHistoryResponse, error) {
+	out := new(QueryRedemptionRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/RedemptionRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionRateApr(ctx context.Context, in *QueryRedemptionRateAprRequest, opts ...grpc.CallOption) (*QueryRedemptionRateAprResponse, error) {
+	out := new(QueryRedemptionRateAprResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/RedemptionRateApr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionRateDrawdown(ctx context.Context, in *QueryRedemptionRateDrawdownRequest, opts ...grpc.CallOption) (*QueryRedemptionRateDrawdownResponse, error) {
+	out := new(QueryRedemptionRateDrawdownResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/RedemptionRateDrawdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HaltHistory(ctx context.Context, in *QueryHaltHistoryRequest, opts ...grpc.CallOption) (*QueryHaltHistoryResponse, error) {
+	out := new(QueryHaltHistoryResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HaltHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RebalancePlan(ctx context.Context, in *QueryRebalancePlanRequest, opts ...grpc.CallOption) (*QueryRebalancePlanResponse, error) {
+	out := new(QueryRebalancePlanResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/RebalancePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationPlan(ctx context.Context, in *QueryDelegationPlanRequest, opts ...grpc.CallOption) (*QueryDelegationPlanResponse, error) {
+	out := new(QueryDelegationPlanResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/DelegationPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingPlan(ctx context.Context, in *QueryUnbondingPlanRequest, opts ...grpc.CallOption) (*QueryUnbondingPlanResponse, error) {
+	out := new(QueryUnbondingPlanResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/UnbondingPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionSweepPlan(ctx context.Context, in *QueryRedemptionSweepPlanRequest, opts ...grpc.CallOption) (*QueryRedemptionSweepPlanResponse, error) {
+	out := new(QueryRedemptionSweepPlanResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/RedemptionSweepPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error) {
+	out := new(QueryCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/CircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaGasEstimates(ctx context.Context, in *QueryIcaGasEstimatesRequest, opts ...grpc.CallOption) (*QueryIcaGasEstimatesResponse, error) {
+	out := new(QueryIcaGasEstimatesResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/IcaGasEstimates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InsuranceFund(ctx context.Context, in *QueryInsuranceFundRequest, opts ...grpc.CallOption) (*QueryInsuranceFundResponse, error) {
+	out := new(QueryInsuranceFundResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/InsuranceFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaReconciliationReport(ctx context.Context, in *QueryIcaReconciliationReportRequest, opts ...grpc.CallOption) (*QueryIcaReconciliationReportResponse, error) {
+	out := new(QueryIcaReconciliationReportResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/IcaReconciliationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaRecoveryStates(ctx context.Context, in *QueryIcaRecoveryStatesRequest, opts ...grpc.CallOption) (*QueryIcaRecoveryStatesResponse, error) {
+	out := new(QueryIcaRecoveryStatesResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/IcaRecoveryStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OnboardingChecklist(ctx context.Context, in *QueryOnboardingChecklistRequest, opts ...grpc.CallOption) (*QueryOnboardingChecklistResponse, error) {
+	out := new(QueryOnboardingChecklistResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/OnboardingChecklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionCompletionEstimate(ctx context.Context, in *QueryRedemptionCompletionEstimateRequest, opts ...grpc.CallOption) (*QueryRedemptionCompletionEstimateResponse, error) {
+	out := new(QueryRedemptionCompletionEstimateResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/RedemptionCompletionEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Validator by host zone.
+	Validators(context.Context, *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error)
+	// Queries a HostZone by id.
+	HostZone(context.Context, *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error)
+	// Queries a list of HostZone items.
+	HostZoneAll(context.Context, *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error)
+	// Queries a list of ModuleAddress items.
+	ModuleAddress(context.Context, *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error)
+	// QueryInterchainAccountFromAddress returns the interchain account for given
+	// owner address on a given connection pair
+	InterchainAccountFromAddress(context.Context, *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error)
+	// Queries a EpochTracker by index.
+	EpochTracker(context.Context, *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error)
+	// Queries a list of EpochTracker items.
+	EpochTrackerAll(context.Context, *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error)
+	// Queries the next packet sequence for one for a given channel
+	NextPacketSequence(context.Context, *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error)
+	// Queries an address's unbondings
+	AddressUnbondings(context.Context, *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error)
+	// Queries all trade routes
+	AllTradeRoutes(context.Context, *QueryAllTradeRoutes) (*QueryAllTradeRoutesResponse, error)
+	// Queries a basket and the redemption rate of each of its components
+	Basket(context.Context, *QueryBasketRequest) (*QueryBasketResponse, error)
+	// Queries all baskets
+	AllBaskets(context.Context, *QueryAllBasketsRequest) (*QueryAllBasketsResponse, error)
+	// Queries the recorded redemption rate history of a host zone, ordered from
+	// oldest to newest
+	RedemptionRateHistory(context.Context, *QueryRedemptionRateHistoryRequest) (*QueryRedemptionRateHistoryResponse, error)
+	// Queries the trailing 7 and 30 day APR of a host zone, derived from the
+	// growth in the redemption rate
+	RedemptionRateApr(context.Context, *QueryRedemptionRateAprRequest) (*QueryRedemptionRateAprResponse, error)
+	// Queries the largest peak-to-trough decline in a host zone's redemption
+	// rate across the recorded history
+	RedemptionRateDrawdown(context.Context, *QueryRedemptionRateDrawdownRequest) (*QueryRedemptionRateDrawdownResponse, error)
+	// Queries the history of redemption rate guard status changes for a host
+	// zone, ordered from oldest to newest
+	HaltHistory(context.Context, *QueryHaltHistoryRequest) (*QueryHaltHistoryResponse, error)
+	// Dry-runs the rebalance planner for a host zone, returning the
+	// redelegations that would be submitted if the rebalance ran now
+	RebalancePlan(context.Context, *QueryRebalancePlanRequest) (*QueryRebalancePlanResponse, error)
+	// Dry-runs the delegation of each queued deposit record on a host zone,
+	// returning the delegation ICAs that would be submitted next epoch
+	DelegationPlan(context.Context, *QueryDelegationPlanRequest) (*QueryDelegationPlanResponse, error)
+	// Dry-runs the unbonding of queued redemptions on a host zone, returning
+	// the undelegation ICAs that would be submitted on the next unbonding day
+	UnbondingPlan(context.Context, *QueryUnbondingPlanRequest) (*QueryUnbondingPlanResponse, error)
+	// Dry-runs the sweep of unbonded tokens on a host zone, returning the
+	// ICA that would transfer them to the redemption account
+	RedemptionSweepPlan(context.Context, *QueryRedemptionSweepPlanRequest) (*QueryRedemptionSweepPlanResponse, error)
+	// Queries the circuit breaker guardian and all paused actions
+	CircuitBreakers(context.Context, *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error)
+	// Queries the learned gas estimate of each ICA message type on a host zone
+	IcaGasEstimates(context.Context, *QueryIcaGasEstimatesRequest) (*QueryIcaGasEstimatesResponse, error)
+	// Queries the slashing insurance fund of a host zone and its coverage history
+	InsuranceFund(context.Context, *QueryInsuranceFundRequest) (*QueryInsuranceFundResponse, error)
+	// Queries the latest balance reconciliation report of a host zone
+	IcaReconciliationReport(context.Context, *QueryIcaReconciliationReportRequest) (*QueryIcaReconciliationReportResponse, error)
+	// Queries the ICAs that are being automatically recovered after a channel
+	// closure
+	IcaRecoveryStates(context.Context, *QueryIcaRecoveryStatesRequest) (*QueryIcaRecoveryStatesResponse, error)
+	// Queries the post-registration onboarding checklist for a host zone
+	OnboardingChecklist(context.Context, *QueryOnboardingChecklistRequest) (*QueryOnboardingChecklistResponse, error)
+	// Estimates when a user redemption record will be claimable, accounting for
+	// the redemptions queued ahead of it and the host zone's unbond cap
+	RedemptionCompletionEstimate(context.Context, *QueryRedemptionCompletionEstimateRequest) (*QueryRedemptionCompletionEstimateResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+}
+func (*UnimplementedQueryServer) HostZone(ctx context.Context, req *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZone not implemented")
+}
+func (*UnimplementedQueryServer) HostZoneAll(ctx context.Context, req *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZoneAll not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAddress(ctx context.Context, req *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAddress not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccountFromAddress(ctx context.Context, req *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountFromAddress not implemented")
+}
+func (*UnimplementedQueryServer) EpochTracker(ctx context.Context, req *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochTracker not implemented")
+}
+func (*UnimplementedQueryServer) EpochTrackerAll(ctx context.Context, req *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochTrackerAll not implemented")
+}
+func (*UnimplementedQueryServer) NextPacketSequence(ctx context.Context, req *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextPacketSequence not implemented")
+}
+func (*UnimplementedQueryServer) AddressUnbondings(ctx context.Context, req *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) AllTradeRoutes(ctx context.Context, req *QueryAllTradeRoutes) (*QueryAllTradeRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTradeRoutes not implemented")
+}
+func (*UnimplementedQueryServer) Basket(ctx context.Context, req *QueryBasketRequest) (*QueryBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Basket not implemented")
+}
+func (*UnimplementedQueryServer) AllBaskets(ctx context.Context, req *QueryAllBasketsRequest) (*QueryAllBasketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBaskets not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionRateHistory(ctx context.Context, req *QueryRedemptionRateHistoryRequest) (*QueryRedemptionRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionRateHistory not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionRateApr(ctx context.Context, req *QueryRedemptionRateAprRequest) (*QueryRedemptionRateAprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionRateApr not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionRateDrawdown(ctx context.Context, req *QueryRedemptionRateDrawdownRequest) (*QueryRedemptionRateDrawdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionRateDrawdown not implemented")
+}
+func (*UnimplementedQueryServer) HaltHistory(ctx context.Context, req *QueryHaltHistoryRequest) (*QueryHaltHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HaltHistory not implemented")
+}
+func (*UnimplementedQueryServer) RebalancePlan(ctx context.Context, req *QueryRebalancePlanRequest) (*QueryRebalancePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalancePlan not implemented")
+}
+func (*UnimplementedQueryServer) DelegationPlan(ctx context.Context, req *QueryDelegationPlanRequest) (*QueryDelegationPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationPlan not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingPlan(ctx context.Context, req *QueryUnbondingPlanRequest) (*QueryUnbondingPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingPlan not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionSweepPlan(ctx context.Context, req *QueryRedemptionSweepPlanRequest) (*QueryRedemptionSweepPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionSweepPlan not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakers(ctx context.Context, req *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakers not implemented")
+}
+func (*UnimplementedQueryServer) IcaGasEstimates(ctx context.Context, req *QueryIcaGasEstimatesRequest) (*QueryIcaGasEstimatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaGasEstimates not implemented")
+}
+func (*UnimplementedQueryServer) InsuranceFund(ctx context.Context, req *QueryInsuranceFundRequest) (*QueryInsuranceFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFund not implemented")
+}
+func (*UnimplementedQueryServer) IcaReconciliationReport(ctx context.Context, req *QueryIcaReconciliationReportRequest) (*QueryIcaReconciliationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaReconciliationReport not implemented")
+}
+func (*UnimplementedQueryServer) IcaRecoveryStates(ctx context.Context, req *QueryIcaRecoveryStatesRequest) (*QueryIcaRecoveryStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaRecoveryStates not implemented")
+}
+func (*UnimplementedQueryServer) OnboardingChecklist(ctx context.Context, req *QueryOnboardingChecklistRequest) (*QueryOnboardingChecklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnboardingChecklist not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionCompletionEstimate(ctx context.Context, req *QueryRedemptionCompletionEstimateRequest) (*QueryRedemptionCompletionEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionCompletionEstimate not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/Validators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validators(ctx, req.(*QueryGetValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetHostZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/HostZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZone(ctx, req.(*QueryGetHostZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostZoneAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllHostZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostZoneAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/HostZoneAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZoneAll(ctx, req.(*QueryAllHostZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/ModuleAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAddress(ctx, req.(*QueryModuleAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccountFromAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountFromAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountFromAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/InterchainAccountFromAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountFromAddress(ctx, req.(*QueryInterchainAccountFromAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEpochTrackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/EpochTracker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochTracker(ctx, req.(*QueryGetEpochTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEpochTrackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochTrackerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/EpochTrackerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochTrackerAll(ctx, req.(*QueryAllEpochTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextPacketSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNextPacketSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextPacketSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/NextPacketSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextPacketSequence(ctx, req.(*QueryGetNextPacketSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressUnbondings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/AddressUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressUnbondings(ctx, req.(*QueryAddressUnbondings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllTradeRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTradeRoutes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllTradeRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/AllTradeRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTradeRoutes(ctx, req.(*QueryAllTradeRoutes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Basket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Basket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/Basket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Basket(ctx, req.(*QueryBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllBaskets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBasketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllBaskets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/AllBaskets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBaskets(ctx, req.(*QueryAllBasketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/RedemptionRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionRateHistory(ctx, req.(*QueryRedemptionRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionRateApr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRateAprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionRateApr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/RedemptionRateApr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionRateApr(ctx, req.(*QueryRedemptionRateAprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionRateDrawdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRateDrawdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionRateDrawdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/RedemptionRateDrawdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionRateDrawdown(ctx, req.(*QueryRedemptionRateDrawdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HaltHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHaltHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HaltHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/HaltHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HaltHistory(ctx, req.(*QueryHaltHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RebalancePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRebalancePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RebalancePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/RebalancePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RebalancePlan(ctx, req.(*QueryRebalancePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/DelegationPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationPlan(ctx, req.(*QueryDelegationPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/UnbondingPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingPlan(ctx, req.(*QueryUnbondingPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionSweepPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionSweepPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionSweepPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/RedemptionSweepPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionSweepPlan(ctx, req.(*QueryRedemptionSweepPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/CircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakers(ctx, req.(*QueryCircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaGasEstimates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaGasEstimatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaGasEstimates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/IcaGasEstimates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaGasEstimates(ctx, req.(*QueryIcaGasEstimatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InsuranceFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InsuranceFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/InsuranceFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InsuranceFund(ctx, req.(*QueryInsuranceFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaReconciliationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaReconciliationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/IcaReconciliationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaReconciliationReport(ctx, req.(*QueryIcaReconciliationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaRecoveryStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaRecoveryStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaRecoveryStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/IcaRecoveryStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaRecoveryStates(ctx, req.(*QueryIcaRecoveryStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OnboardingChecklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOnboardingChecklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OnboardingChecklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/OnboardingChecklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OnboardingChecklist(ctx, req.(*QueryOnboardingChecklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionCompletionEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionCompletionEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionCompletionEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/RedemptionCompletionEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionCompletionEstimate(ctx, req.(*QueryRedemptionCompletionEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stride.stakeibc.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Validators",
+			Handler:    _Query_Validators_Handler,
+		},
+		{
+			MethodName: "HostZone",
+			Handler:    _Query_HostZone_Handler,
+		},
+		{
+			MethodName: "HostZoneAll",
+			Handler:    _Query_HostZoneAll_Handler,
+		},
+		{
+			MethodName: "ModuleAddress",
+			Handler:    _Query_ModuleAddress_Handler,
+		},
+		{
+			MethodName: "InterchainAccountFromAddress",
+			Handler:    _Query_InterchainAccountFromAddress_Handler,
+		},
+		{
+			MethodName: "EpochTracker",
+			Handler:    _Query_EpochTracker_Handler,
+		},
+		{
+			MethodName: "EpochTrackerAll",
+			Handler:    _Query_EpochTrackerAll_Handler,
+		},
+		{
+			MethodName: "NextPacketSequence",
+			Handler:    _Query_NextPacketSequence_Handler,
+		},
+		{
+			MethodName: "AddressUnbondings",
+			Handler:    _Query_AddressUnbondings_Handler,
+		},
+		{
+			MethodName: "AllTradeRoutes",
+			Handler:    _Query_AllTradeRoutes_Handler,
+		},
+		{
+			MethodName: "Basket",
+			Handler:    _Query_Basket_Handler,
+		},
+		{
+			MethodName: "AllBaskets",
+			Handler:    _Query_AllBaskets_Handler,
+		},
+		{
+			MethodName: "RedemptionRateHistory",
+			Handler:    _Query_RedemptionRateHistory_Handler,
+		},
+		{
+			MethodName: "RedemptionRateApr",
+			Handler:    _Query_RedemptionRateApr_Handler,
+		},
+		{
+			MethodName: "RedemptionRateDrawdown",
+			Handler:    _Query_RedemptionRateDrawdown_Handler,
+		},
+		{
+			MethodName: "HaltHistory",
+			Handler:    _Query_HaltHistory_Handler,
+		},
+		{
+			MethodName: "RebalancePlan",
+			Handler:    _Query_RebalancePlan_Handler,
+		},
+		{
+			MethodName: "DelegationPlan",
+			Handler:    _Query_DelegationPlan_Handler,
+		},
+		{
+			MethodName: "UnbondingPlan",
+			Handler:    _Query_UnbondingPlan_Handler,
+		},
+		{
+			MethodName: "RedemptionSweepPlan",
+			Handler:    _Query_RedemptionSweepPlan_Handler,
+		},
+		{
+			MethodName: "CircuitBreakers",
+			Handler:    _Query_CircuitBreakers_Handler,
+		},
+		{
+			MethodName: "IcaGasEstimates",
+			Handler:    _Query_IcaGasEstimates_Handler,
+		},
+		{
+			MethodName: "InsuranceFund",
+			Handler:    _Query_InsuranceFund_Handler,
+		},
+		{
+			MethodName: "IcaReconciliationReport",
+			Handler:    _Query_IcaReconciliationReport_Handler,
+		},
+		{
+			MethodName: "IcaRecoveryStates",
+			Handler:    _Query_IcaRecoveryStates_Handler,
+		},
+		{
+			MethodName: "OnboardingChecklist",
+			Handler:    _Query_OnboardingChecklist_Handler,
+		},
+		{
+			MethodName: "RedemptionCompletionEstimate",
+			Handler:    _Query_RedemptionCompletionEstimate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stride/stakeibc/query.proto",
+}
+
+func (m *QueryInterchainAccountFromAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountFromAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountFromAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountFromAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountFromAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountFromAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InterchainAccountAddress) > 0 {
+		i -= len(m.InterchainAccountAddress)
+		copy(dAtA[i:], m.InterchainAccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterchainAccountAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHostZoneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHostZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostZone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostZoneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostZone) > 0 {
+		for iNdEx := len(m.HostZone) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostZone[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochTracker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochTracker) > 0 {
+		for iNdEx := len(m.EpochTracker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNextPacketSequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNextPacketSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNextPacketSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNextPacketSequenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNextPacketSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNextPacketSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressUnbondings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressUnbondings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressUnbondings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressUnbondings) > 0 {
+		for iNdEx := len(m.AddressUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTradeRoutes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTradeRoutes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTradeRoutes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTradeRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTradeRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTradeRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TradeRoutes) > 0 {
+		for iNdEx := len(m.TradeRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBasketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBasketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBasketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BasketId) > 0 {
+		i -= len(m.BasketId)
+		copy(dAtA[i:], m.BasketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BasketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BasketComponentRedemptionRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasketComponentRedemptionRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasketComponentRedemptionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBasketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBasketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBasketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedemptionRates) > 0 {
+		for iNdEx := len(m.RedemptionRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Basket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBasketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBasketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBasketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBasketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBasketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBasketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Baskets) > 0 {
+		for iNdEx := len(m.Baskets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Baskets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateAprRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateAprRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateAprRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateAprResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateAprResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateAprResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr_30D.Size()
+		i -= size
+		if _, err := m.Apr_30D.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Apr_7D.Size()
+		i -= size
+		if _, err := m.Apr_7D.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateDrawdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateDrawdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateDrawdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateDrawdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateDrawdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateDrawdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TroughEpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TroughEpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PeakEpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PeakEpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxDrawdown.Size()
+		i -= size
+		if _, err := m.MaxDrawdown.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHaltHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHaltHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHaltHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHaltHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHaltHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHaltHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalancePlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancePlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancePlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalancePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InFlightRedelegations) > 0 {
+		for iNdEx := len(m.InFlightRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LockedValidators) > 0 {
+		for iNdEx := len(m.LockedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LockedValidators[iNdEx])
+			copy(dAtA[i:], m.LockedValidators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.LockedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rebalancings) > 0 {
+		for iNdEx := len(m.Rebalancings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rebalancings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlannedIcaMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlannedIcaMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlannedIcaMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Json) > 0 {
+		i -= len(m.Json)
+		copy(dAtA[i:], m.Json)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Json)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlannedIcaTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlannedIcaTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlannedIcaTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlannedDepositDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlannedDepositDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlannedDepositDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SplitDelegations) > 0 {
+		for iNdEx := len(m.SplitDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SplitDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DepositRecordId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DepositRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SplitUndelegations) > 0 {
+		for iNdEx := len(m.SplitUndelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SplitUndelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EpochUnbondingRecordIds) > 0 {
+		dAtA8 := make([]byte, len(m.EpochUnbondingRecordIds)*10)
+		var j7 int
+		for _, num := range m.EpochUnbondingRecordIds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.TotalUnbondAmount.Size()
+		i -= size
+		if _, err := m.TotalUnbondAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.NextUnbondingDayEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextUnbondingDayEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionSweepPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionSweepPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionSweepPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionSweepPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionSweepPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionSweepPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EpochUnbondingRecordIds) > 0 {
+		dAtA10 := make([]byte, len(m.EpochUnbondingRecordIds)*10)
+		var j9 int
+		for _, num := range m.EpochUnbondingRecordIds {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.TotalSweepAmount.Size()
+		i -= size
+		if _, err := m.TotalSweepAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flags) > 0 {
+		for iNdEx := len(m.Flags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Guardian != nil {
+		{
+			size, err := m.Guardian.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaGasEstimatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaGasEstimatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaGasEstimatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaGasEstimatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaGasEstimatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaGasEstimatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Estimates) > 0 {
+		for iNdEx := len(m.Estimates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Estimates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxIcaTxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxIcaTxGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoverageHistory) > 0 {
+		for iNdEx := len(m.CoverageHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoverageHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaReconciliationReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaReconciliationReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaReconciliationReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaReconciliationReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaReconciliationReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaReconciliationReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaRecoveryStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaRecoveryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaRecoveryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaRecoveryStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaRecoveryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaRecoveryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveryStates) > 0 {
+		for iNdEx := len(m.RecoveryStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecoveryStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOnboardingChecklistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOnboardingChecklistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOnboardingChecklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOnboardingChecklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOnboardingChecklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOnboardingChecklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checklist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionCompletionEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionCompletionEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionCompletionEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserRedemptionRecordId) > 0 {
+		i -= len(m.UserRedemptionRecordId)
+		copy(dAtA[i:], m.UserRedemptionRecordId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserRedemptionRecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionCompletionEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionCompletionEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionCompletionEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedCompletionTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedCompletionTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.NativeTokensQueuedAhead.Size()
+		i -= size
+		if _, err := m.NativeTokensQueuedAhead.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.UnbondingDayEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingDayEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInterchainAccountFromAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountFromAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InterchainAccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetHostZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostZone.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllHostZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostZone) > 0 {
+		for _, e := range m.HostZone {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEpochTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEpochTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochTracker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllEpochTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllEpochTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochTracker) > 0 {
+		for _, e := range m.EpochTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetNextPacketSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNextPacketSequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryAddressUnbondings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AddressUnbondings) > 0 {
+		for _, e := range m.AddressUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllTradeRoutes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllTradeRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.TradeRoutes) > 0 {
 		for _, e := range m.TradeRoutes {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryBasketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BasketComponentRedemptionRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBasketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Basket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RedemptionRates) > 0 {
+		for _, e := range m.RedemptionRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllBasketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllBasketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Baskets) > 0 {
+		for _, e := range m.Baskets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateAprRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateAprResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr_7D.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Apr_30D.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedemptionRateDrawdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateDrawdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxDrawdown.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PeakEpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.PeakEpochNumber))
+	}
+	if m.TroughEpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.TroughEpochNumber))
+	}
+	return n
+}
+
+func (m *QueryHaltHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHaltHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRebalancePlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRebalancePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rebalancings) > 0 {
+		for _, e := range m.Rebalancings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LockedValidators) > 0 {
+		for _, s := range m.LockedValidators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.InFlightRedelegations) > 0 {
+		for _, e := range m.InFlightRedelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlannedIcaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Json)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PlannedIcaTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegationPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PlannedDepositDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositRecordId != 0 {
+		n += 1 + sovQuery(uint64(m.DepositRecordId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SplitDelegations) > 0 {
+		for _, e := range m.SplitDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegationPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnbondingPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextUnbondingDayEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.NextUnbondingDayEpoch))
+	}
+	l = m.TotalUnbondAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.EpochUnbondingRecordIds) > 0 {
+		l = 0
+		for _, e := range m.EpochUnbondingRecordIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.SplitUndelegations) > 0 {
+		for _, e := range m.SplitUndelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRedemptionSweepPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionSweepPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalSweepAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.EpochUnbondingRecordIds) > 0 {
+		l = 0
+		for _, e := range m.EpochUnbondingRecordIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Guardian != nil {
+		l = m.Guardian.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Flags) > 0 {
+		for _, e := range m.Flags {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIcaGasEstimatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIcaGasEstimatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxIcaTxGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxIcaTxGas))
+	}
+	if len(m.Estimates) > 0 {
+		for _, e := range m.Estimates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.CoverageHistory) > 0 {
+		for _, e := range m.CoverageHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIcaReconciliationReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIcaReconciliationReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Report.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIcaRecoveryStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIcaRecoveryStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecoveryStates) > 0 {
+		for _, e := range m.RecoveryStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOnboardingChecklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOnboardingChecklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checklist.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedemptionCompletionEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserRedemptionRecordId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionCompletionEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnbondingDayEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingDayEpoch))
+	}
+	l = m.NativeTokensQueuedAhead.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EstimatedCompletionTime != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedCompletionTime))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostZoneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostZoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostZone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostZoneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostZoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = append(m.HostZone, HostZone{})
+			if err := m.HostZone[len(m.HostZone)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochTracker = append(m.EpochTracker, EpochTracker{})
+			if err := m.EpochTracker[len(m.EpochTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressUnbondings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressUnbondings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressUnbondings = append(m.AddressUnbondings, AddressUnbonding{})
+			if err := m.AddressUnbondings[len(m.AddressUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTradeRoutes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTradeRoutes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTradeRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTradeRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTradeRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTradeRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeRoutes = append(m.TradeRoutes, TradeRoute{})
+			if err := m.TradeRoutes[len(m.TradeRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBasketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasketComponentRedemptionRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasketComponentRedemptionRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasketComponentRedemptionRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBasketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Basket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRates = append(m.RedemptionRates, BasketComponentRedemptionRate{})
+			if err := m.RedemptionRates[len(m.RedemptionRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBasketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBasketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBasketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBasketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBasketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBasketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Baskets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Baskets = append(m.Baskets, Basket{})
+			if err := m.Baskets[len(m.Baskets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRateHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRateHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, RedemptionRateSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRateAprRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRateAprRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRateAprRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRateAprResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRateAprResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRateAprResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr_7D", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr_7D.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr_30D", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr_30D.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRateDrawdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRateDrawdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRateDrawdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRateDrawdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRateDrawdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRateDrawdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDrawdown", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDrawdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakEpochNumber", wireType)
+			}
+			m.PeakEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeakEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TroughEpochNumber", wireType)
+			}
+			m.TroughEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TroughEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHaltHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHaltHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHaltHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHaltHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHaltHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHaltHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, RedemptionRateGuardRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRebalancePlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRebalancePlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRebalancePlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRebalancePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRebalancePlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRebalancePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebalancings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rebalancings = append(m.Rebalancings, Rebalancing{})
+			if err := m.Rebalancings[len(m.Rebalancings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedValidators = append(m.LockedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightRedelegations = append(m.InFlightRedelegations, InFlightRedelegation{})
+			if err := m.InFlightRedelegations[len(m.InFlightRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, PlannedIcaTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlannedIcaMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlannedIcaMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlannedIcaMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Json = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *PlannedIcaTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2625,15 +10807,15 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlannedIcaTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlannedIcaTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2661,13 +10843,13 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2677,23 +10859,25 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.Msgs = append(m.Msgs, PlannedIcaMsg{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2716,7 +10900,7 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationPlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2739,15 +10923,15 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationPlanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2775,7 +10959,7 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterchainAccountAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2798,7 +10982,7 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *PlannedDepositDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2821,12 +11005,133 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlannedDepositDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlannedDepositDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecordId", wireType)
+			}
+			m.DepositRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitDelegations = append(m.SplitDelegations, SplitDelegation{})
+			if err := m.SplitDelegations[len(m.SplitDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, PlannedIcaTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2848,7 +11153,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationPlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2871,15 +11176,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationPlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2906,7 +11211,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposits = append(m.Deposits, PlannedDepositDelegation{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2931,7 +11237,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingPlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2954,10 +11260,10 @@ func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingPlanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3013,7 +11319,7 @@ func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingPlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3036,15 +11342,178 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingPlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnbondingDayEpoch", wireType)
+			}
+			m.NextUnbondingDayEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUnbondingDayEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalUnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EpochUnbondingRecordIds = append(m.EpochUnbondingRecordIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EpochUnbondingRecordIds) == 0 {
+					m.EpochUnbondingRecordIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EpochUnbondingRecordIds = append(m.EpochUnbondingRecordIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochUnbondingRecordIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitUndelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitUndelegations = append(m.SplitUndelegations, SplitUndelegation{})
+			if err := m.SplitUndelegations[len(m.SplitUndelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3071,8 +11540,8 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Txs = append(m.Txs, PlannedIcaTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3097,7 +11566,7 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionSweepPlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3120,10 +11589,10 @@ func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostZoneRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionSweepPlanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionSweepPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3179,7 +11648,7 @@ func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionSweepPlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3202,15 +11671,125 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionSweepPlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionSweepPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSweepAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSweepAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EpochUnbondingRecordIds = append(m.EpochUnbondingRecordIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EpochUnbondingRecordIds) == 0 {
+					m.EpochUnbondingRecordIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EpochUnbondingRecordIds = append(m.EpochUnbondingRecordIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochUnbondingRecordIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3237,7 +11816,8 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostZone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Txs = append(m.Txs, PlannedIcaTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3262,7 +11842,7 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3285,48 +11865,12 @@ func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostZoneRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3348,7 +11892,7 @@ func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3371,15 +11915,15 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3406,14 +11950,16 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = append(m.HostZone, HostZone{})
-			if err := m.HostZone[len(m.HostZone)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Guardian == nil {
+				m.Guardian = &CircuitBreakerGuardian{}
+			}
+			if err := m.Guardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3440,10 +11986,8 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Flags = append(m.Flags, CircuitBreakerFlag{})
+			if err := m.Flags[len(m.Flags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3468,7 +12012,7 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIcaGasEstimatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3491,15 +12035,15 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIcaGasEstimatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIcaGasEstimatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3527,7 +12071,7 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3550,7 +12094,7 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIcaGasEstimatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3573,17 +12117,36 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIcaGasEstimatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIcaGasEstimatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIcaTxGas", wireType)
+			}
+			m.MaxIcaTxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIcaTxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3593,23 +12156,25 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Estimates = append(m.Estimates, IcaGasEstimate{})
+			if err := m.Estimates[len(m.Estimates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3632,7 +12197,7 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInsuranceFundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3655,15 +12220,15 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInsuranceFundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInsuranceFundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3691,7 +12256,7 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3714,7 +12279,7 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInsuranceFundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3737,15 +12302,116 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInsuranceFundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &InsuranceFundConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverageHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3772,7 +12438,8 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CoverageHistory = append(m.CoverageHistory, InsuranceCoverageRecord{})
+			if err := m.CoverageHistory[len(m.CoverageHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3797,57 +12464,7 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIcaReconciliationReportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3870,17 +12487,17 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIcaReconciliationReportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIcaReconciliationReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3890,25 +12507,23 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochTracker = append(m.EpochTracker, EpochTracker{})
-			if err := m.EpochTracker[len(m.EpochTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3931,7 +12546,7 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIcaReconciliationReportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3954,17 +12569,17 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIcaReconciliationReportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIcaReconciliationReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3974,27 +12589,28 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4022,7 +12638,9 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4045,7 +12663,7 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIcaRecoveryStatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4068,17 +12686,67 @@ func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIcaRecoveryStatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIcaRecoveryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIcaRecoveryStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIcaRecoveryStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIcaRecoveryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryStates", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4088,11 +12756,26 @@ func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryStates = append(m.RecoveryStates, IcaRecoveryState{})
+			if err := m.RecoveryStates[len(m.RecoveryStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4114,7 +12797,7 @@ func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
+func (m *QueryOnboardingChecklistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4137,15 +12820,15 @@ func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressUnbondings: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOnboardingChecklistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressUnbondings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOnboardingChecklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4173,7 +12856,7 @@ func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4196,7 +12879,7 @@ func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOnboardingChecklistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4219,15 +12902,15 @@ func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOnboardingChecklistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOnboardingChecklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checklist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4254,8 +12937,7 @@ func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressUnbondings = append(m.AddressUnbondings, AddressUnbonding{})
-			if err := m.AddressUnbondings[len(m.AddressUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Checklist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4280,7 +12962,7 @@ func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTradeRoutes) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionCompletionEstimateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4303,12 +12985,44 @@ func (m *QueryAllTradeRoutes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTradeRoutes: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionCompletionEstimateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTradeRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionCompletionEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserRedemptionRecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserRedemptionRecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4330,7 +13044,7 @@ func (m *QueryAllTradeRoutes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTradeRoutesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionCompletionEstimateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4353,17 +13067,36 @@ func (m *QueryAllTradeRoutesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTradeRoutesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionCompletionEstimateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTradeRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionCompletionEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDayEpoch", wireType)
+			}
+			m.UnbondingDayEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingDayEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeTokensQueuedAhead", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4373,26 +13106,45 @@ func (m *QueryAllTradeRoutesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradeRoutes = append(m.TradeRoutes, TradeRoute{})
-			if err := m.TradeRoutes[len(m.TradeRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NativeTokensQueuedAhead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCompletionTime", wireType)
+			}
+			m.EstimatedCompletionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedCompletionTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
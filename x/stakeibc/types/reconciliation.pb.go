@@ -0,0 +1,903 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/reconciliation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The reconciled balance of one of a host zone's accounts
+type IcaReconciliationEntry struct {
+	// Name of the account - the ICA account type, or DEPOSIT for the
+	// stride-side deposit account
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Balance implied by the host zone's records
+	ExpectedBalance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=expected_balance,json=expectedBalance,proto3,customtype=cosmossdk.io/math.Int" json:"expected_balance"`
+	// Balance returned from the query
+	ActualBalance cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=actual_balance,json=actualBalance,proto3,customtype=cosmossdk.io/math.Int" json:"actual_balance"`
+	// Actual balance minus the expected balance
+	Discrepancy cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=discrepancy,proto3,customtype=cosmossdk.io/math.Int" json:"discrepancy"`
+	// Whether the balance query has returned
+	Reconciled bool `protobuf:"varint,6,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
+}
+
+func (m *IcaReconciliationEntry) Reset()         { *m = IcaReconciliationEntry{} }
+func (m *IcaReconciliationEntry) String() string { return proto.CompactTextString(m) }
+func (*IcaReconciliationEntry) ProtoMessage()    {}
+func (*IcaReconciliationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0820735cdccac756, []int{0}
+}
+func (m *IcaReconciliationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaReconciliationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaReconciliationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaReconciliationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaReconciliationEntry.Merge(m, src)
+}
+func (m *IcaReconciliationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaReconciliationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaReconciliationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaReconciliationEntry proto.InternalMessageInfo
+
+func (m *IcaReconciliationEntry) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *IcaReconciliationEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *IcaReconciliationEntry) GetReconciled() bool {
+	if m != nil {
+		return m.Reconciled
+	}
+	return false
+}
+
+// The latest reconciliation of a host zone's account balances against its
+// records
+type IcaReconciliationReport struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Day epoch in which the reconciliation was started
+	EpochNumber uint64                   `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Time        time.Time                `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	Entries     []IcaReconciliationEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *IcaReconciliationReport) Reset()         { *m = IcaReconciliationReport{} }
+func (m *IcaReconciliationReport) String() string { return proto.CompactTextString(m) }
+func (*IcaReconciliationReport) ProtoMessage()    {}
+func (*IcaReconciliationReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0820735cdccac756, []int{1}
+}
+func (m *IcaReconciliationReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaReconciliationReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaReconciliationReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaReconciliationReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaReconciliationReport.Merge(m, src)
+}
+func (m *IcaReconciliationReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaReconciliationReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaReconciliationReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaReconciliationReport proto.InternalMessageInfo
+
+func (m *IcaReconciliationReport) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *IcaReconciliationReport) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *IcaReconciliationReport) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *IcaReconciliationReport) GetEntries() []IcaReconciliationEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*IcaReconciliationEntry)(nil), "stride.stakeibc.IcaReconciliationEntry")
+	proto.RegisterType((*IcaReconciliationReport)(nil), "stride.stakeibc.IcaReconciliationReport")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/reconciliation.proto", fileDescriptor_0820735cdccac756)
+}
+
+var fileDescriptor_0820735cdccac756 = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x6e, 0xb6, 0xb0, 0x15, 0x17, 0x18, 0x8a, 0xf8, 0xc8, 0x7a, 0x48, 0xcb, 0x84, 0x44, 0x25,
+	0x34, 0x47, 0x6a, 0x2f, 0x9c, 0x23, 0x21, 0x54, 0x69, 0x70, 0x08, 0x88, 0x03, 0x97, 0xca, 0xb1,
+	0x5f, 0x52, 0x6b, 0x8d, 0x1d, 0xd9, 0x0e, 0x5a, 0xff, 0xc5, 0x7e, 0x0c, 0x3f, 0x62, 0xc7, 0x89,
+	0x13, 0xda, 0x61, 0xa0, 0xf6, 0xc0, 0xdf, 0x40, 0xb1, 0x6b, 0x18, 0x1f, 0x97, 0xde, 0xf2, 0x3c,
+	0xef, 0xf3, 0x3e, 0xb1, 0xdf, 0xc7, 0x2f, 0x7a, 0xaa, 0x8d, 0xe2, 0x0c, 0x52, 0x6d, 0xc8, 0x29,
+	0xf0, 0x82, 0xa6, 0x0a, 0xa8, 0x14, 0x94, 0x2f, 0x38, 0x31, 0x5c, 0x0a, 0x5c, 0x2b, 0x69, 0x64,
+	0x74, 0xe0, 0x54, 0xd8, 0xab, 0xfa, 0x87, 0x54, 0xea, 0x4a, 0xea, 0x99, 0x2d, 0xa7, 0x0e, 0x38,
+	0x6d, 0xff, 0x41, 0x29, 0x4b, 0xe9, 0xf8, 0xf6, 0x6b, 0xc3, 0x0e, 0x4a, 0x29, 0xcb, 0x05, 0xa4,
+	0x16, 0x15, 0xcd, 0xc7, 0xd4, 0xf0, 0x0a, 0xb4, 0x21, 0x55, 0xed, 0x04, 0x47, 0x3f, 0x76, 0xd0,
+	0xa3, 0x29, 0x25, 0xf9, 0x1f, 0xbf, 0x7f, 0x29, 0x8c, 0x5a, 0x46, 0x31, 0xda, 0x27, 0x94, 0xca,
+	0x46, 0x98, 0x38, 0x18, 0x06, 0xa3, 0xdb, 0xb9, 0x87, 0xb6, 0xc2, 0x98, 0x02, 0xad, 0xe3, 0x9d,
+	0x4d, 0xc5, 0xc1, 0xe8, 0x3d, 0xba, 0x0f, 0x67, 0x35, 0x50, 0x03, 0x6c, 0x56, 0x90, 0x05, 0x11,
+	0x14, 0xe2, 0xdd, 0x56, 0x92, 0x3d, 0xbf, 0xb8, 0x1e, 0x74, 0xae, 0xae, 0x07, 0x0f, 0xdd, 0xa9,
+	0x35, 0x3b, 0xc5, 0x5c, 0xa6, 0x15, 0x31, 0x73, 0x3c, 0x15, 0xe6, 0xcb, 0xe7, 0x63, 0xb4, 0xb9,
+	0xce, 0x54, 0x98, 0xfc, 0xc0, 0x9b, 0x64, 0xce, 0x23, 0xca, 0xd1, 0x3d, 0x42, 0x4d, 0x43, 0x16,
+	0xbf, 0x5c, 0xc3, 0xed, 0x5d, 0xef, 0x3a, 0x0b, 0xef, 0xf9, 0x1a, 0xf5, 0x18, 0xd7, 0x54, 0x41,
+	0x4d, 0x04, 0x5d, 0xc6, 0xb7, 0xb6, 0x37, 0xbc, 0xd9, 0x1f, 0x25, 0x08, 0xf9, 0x10, 0x81, 0xc5,
+	0x7b, 0xc3, 0x60, 0xd4, 0xcd, 0x6f, 0x30, 0x47, 0x57, 0x01, 0x7a, 0xfc, 0xcf, 0xa4, 0x73, 0xa8,
+	0xa5, 0x32, 0xd1, 0x21, 0xea, 0xd2, 0x39, 0xe1, 0x62, 0xc6, 0x99, 0x9f, 0xb5, 0xc5, 0x53, 0x16,
+	0x3d, 0x41, 0x77, 0xa0, 0x96, 0x74, 0x3e, 0x13, 0x4d, 0x55, 0x80, 0xb2, 0x03, 0x0f, 0xf3, 0x9e,
+	0xe5, 0xde, 0x58, 0x2a, 0x7a, 0x81, 0xc2, 0x36, 0x56, 0x3b, 0xe8, 0xde, 0xb8, 0x8f, 0x5d, 0xe6,
+	0xd8, 0x67, 0x8e, 0xdf, 0xf9, 0xcc, 0xb3, 0x6e, 0x7b, 0xbb, 0xf3, 0x6f, 0x83, 0x20, 0xb7, 0x1d,
+	0xd1, 0x2b, 0xb4, 0x0f, 0xc2, 0x28, 0x0e, 0x3a, 0x0e, 0x87, 0xbb, 0xa3, 0xde, 0xf8, 0x19, 0xfe,
+	0xeb, 0xc9, 0xe1, 0xff, 0x3f, 0x8e, 0x2c, 0x6c, 0x9d, 0x72, 0xdf, 0x9d, 0x9d, 0x5c, 0xac, 0x92,
+	0xe0, 0x72, 0x95, 0x04, 0xdf, 0x57, 0x49, 0x70, 0xbe, 0x4e, 0x3a, 0x97, 0xeb, 0xa4, 0xf3, 0x75,
+	0x9d, 0x74, 0x3e, 0x8c, 0x4b, 0x6e, 0xe6, 0x4d, 0x81, 0xa9, 0xac, 0xd2, 0xb7, 0xd6, 0xfb, 0xf8,
+	0x84, 0x14, 0x3a, 0xdd, 0x2c, 0xc0, 0xa7, 0xc9, 0x24, 0x3d, 0xfb, 0xbd, 0x06, 0x66, 0x59, 0x83,
+	0x2e, 0xf6, 0xec, 0xd1, 0x27, 0x3f, 0x07, 0x00, 0xd8, 0xd4, 0xf9, 0xbc, 0x26, 0x03, 0x00, 0x00,
+}
+
+func (m *IcaReconciliationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaReconciliationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaReconciliationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reconciled {
+		i--
+		if m.Reconciled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Discrepancy.Size()
+		i -= size
+		if _, err := m.Discrepancy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReconciliation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ActualBalance.Size()
+		i -= size
+		if _, err := m.ActualBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReconciliation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExpectedBalance.Size()
+		i -= size
+		if _, err := m.ExpectedBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReconciliation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintReconciliation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintReconciliation(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IcaReconciliationReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaReconciliationReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaReconciliationReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReconciliation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintReconciliation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintReconciliation(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintReconciliation(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReconciliation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReconciliation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IcaReconciliationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovReconciliation(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovReconciliation(uint64(l))
+	}
+	l = m.ExpectedBalance.Size()
+	n += 1 + l + sovReconciliation(uint64(l))
+	l = m.ActualBalance.Size()
+	n += 1 + l + sovReconciliation(uint64(l))
+	l = m.Discrepancy.Size()
+	n += 1 + l + sovReconciliation(uint64(l))
+	if m.Reconciled {
+		n += 2
+	}
+	return n
+}
+
+func (m *IcaReconciliationReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovReconciliation(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovReconciliation(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovReconciliation(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovReconciliation(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovReconciliation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReconciliation(x uint64) (n int) {
+	return sovReconciliation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IcaReconciliationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReconciliation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcaReconciliationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcaReconciliationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActualBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discrepancy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reconciled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReconciliation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IcaReconciliationReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReconciliation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcaReconciliationReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcaReconciliationReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, IcaReconciliationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReconciliation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReconciliation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReconciliation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReconciliation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReconciliation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReconciliation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReconciliation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReconciliation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReconciliation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReconciliation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReconciliation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,702 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/redelegation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A single redelegation that has been acknowledged by the host but has not
+// yet matured
+type InFlightRedelegationEntry struct {
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// Unix nanoseconds of the redelegation's completion time on the host
+	CompletionTime uint64 `protobuf:"varint,2,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+}
+
+func (m *InFlightRedelegationEntry) Reset()         { *m = InFlightRedelegationEntry{} }
+func (m *InFlightRedelegationEntry) String() string { return proto.CompactTextString(m) }
+func (*InFlightRedelegationEntry) ProtoMessage()    {}
+func (*InFlightRedelegationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fae44a3c542d481, []int{0}
+}
+func (m *InFlightRedelegationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightRedelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightRedelegationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightRedelegationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightRedelegationEntry.Merge(m, src)
+}
+func (m *InFlightRedelegationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightRedelegationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightRedelegationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightRedelegationEntry proto.InternalMessageInfo
+
+func (m *InFlightRedelegationEntry) GetCompletionTime() uint64 {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return 0
+}
+
+// Tracks the outstanding redelegations from the delegation ICA between a
+// given source and destination validator. The host only allows a bounded
+// number of entries per pair, and a validator cannot be redelegated away from
+// while it is the destination of an in-flight redelegation
+type InFlightRedelegation struct {
+	ChainId      string                      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	SrcValidator string                      `protobuf:"bytes,2,opt,name=src_validator,json=srcValidator,proto3" json:"src_validator,omitempty"`
+	DstValidator string                      `protobuf:"bytes,3,opt,name=dst_validator,json=dstValidator,proto3" json:"dst_validator,omitempty"`
+	Entries      []InFlightRedelegationEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *InFlightRedelegation) Reset()         { *m = InFlightRedelegation{} }
+func (m *InFlightRedelegation) String() string { return proto.CompactTextString(m) }
+func (*InFlightRedelegation) ProtoMessage()    {}
+func (*InFlightRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fae44a3c542d481, []int{1}
+}
+func (m *InFlightRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightRedelegation.Merge(m, src)
+}
+func (m *InFlightRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightRedelegation proto.InternalMessageInfo
+
+func (m *InFlightRedelegation) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *InFlightRedelegation) GetSrcValidator() string {
+	if m != nil {
+		return m.SrcValidator
+	}
+	return ""
+}
+
+func (m *InFlightRedelegation) GetDstValidator() string {
+	if m != nil {
+		return m.DstValidator
+	}
+	return ""
+}
+
+func (m *InFlightRedelegation) GetEntries() []InFlightRedelegationEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InFlightRedelegationEntry)(nil), "stride.stakeibc.InFlightRedelegationEntry")
+	proto.RegisterType((*InFlightRedelegation)(nil), "stride.stakeibc.InFlightRedelegation")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/redelegation.proto", fileDescriptor_5fae44a3c542d481)
+}
+
+var fileDescriptor_5fae44a3c542d481 = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x86, 0x93, 0xab, 0xe8, 0x75, 0xee, 0x6d, 0x85, 0x60, 0x41, 0x0b, 0x8d, 0x62, 0x17, 0x95,
+	0x42, 0x13, 0x50, 0xfa, 0x02, 0x42, 0x0b, 0x16, 0x57, 0x69, 0xe9, 0xa2, 0x1b, 0x99, 0xcc, 0x0c,
+	0xc9, 0x60, 0x66, 0x46, 0x66, 0x8e, 0x52, 0xe9, 0x4b, 0xf4, 0xad, 0xea, 0xd2, 0x65, 0xe9, 0x42,
+	0x8a, 0xbe, 0x48, 0x49, 0xa2, 0x28, 0xa5, 0xdd, 0xcd, 0x9c, 0xf3, 0xf1, 0x1d, 0xf8, 0x7f, 0xd4,
+	0x36, 0xa0, 0x39, 0x65, 0xbe, 0x01, 0x3c, 0x66, 0x3c, 0x24, 0xbe, 0x66, 0x94, 0x25, 0x2c, 0xc2,
+	0xc0, 0x95, 0xf4, 0x26, 0x5a, 0x81, 0x72, 0xaa, 0x39, 0xe3, 0xed, 0x98, 0xd3, 0x5a, 0xa4, 0x22,
+	0x95, 0xed, 0xfc, 0xf4, 0x95, 0x63, 0xed, 0x17, 0xd4, 0x18, 0xc8, 0xdb, 0x84, 0x47, 0x31, 0x04,
+	0x07, 0x92, 0x1b, 0x09, 0x7a, 0xee, 0x5c, 0xa3, 0x12, 0x16, 0x6a, 0x2a, 0xa1, 0x6e, 0xb7, 0xec,
+	0x4e, 0xa5, 0x7f, 0xb6, 0x58, 0x35, 0xad, 0x8f, 0x55, 0xf3, 0x84, 0x28, 0x23, 0x94, 0x31, 0x74,
+	0xec, 0x71, 0xe5, 0x0b, 0x0c, 0xb1, 0x37, 0x90, 0x10, 0x6c, 0x61, 0xe7, 0x02, 0x55, 0x89, 0x12,
+	0x93, 0x84, 0xa5, 0xa6, 0x11, 0x70, 0xc1, 0xea, 0x7f, 0x5a, 0x76, 0xa7, 0x18, 0x1c, 0xef, 0xc7,
+	0x0f, 0x5c, 0xb0, 0xf6, 0x9b, 0x8d, 0x6a, 0x3f, 0x5d, 0x77, 0x1a, 0xe8, 0x2f, 0x89, 0x31, 0x97,
+	0x23, 0x4e, 0xf3, 0xd3, 0x41, 0x39, 0xfb, 0x0f, 0xa8, 0x73, 0x8e, 0x8e, 0x8c, 0x26, 0xa3, 0x19,
+	0x4e, 0x38, 0xc5, 0xa0, 0x74, 0xa6, 0xae, 0x04, 0xff, 0x8d, 0x26, 0x8f, 0xbb, 0x59, 0x0a, 0x51,
+	0x03, 0x07, 0x50, 0x21, 0x87, 0xa8, 0x81, 0x3d, 0x74, 0x87, 0xca, 0x4c, 0x82, 0xe6, 0xcc, 0xd4,
+	0x8b, 0xad, 0x42, 0xe7, 0x5f, 0xf7, 0xd2, 0xfb, 0x96, 0x99, 0xf7, 0x6b, 0x34, 0xfd, 0x62, 0x1a,
+	0x45, 0xb0, 0x13, 0xf4, 0x87, 0x8b, 0xb5, 0x6b, 0x2f, 0xd7, 0xae, 0xfd, 0xb9, 0x76, 0xed, 0xd7,
+	0x8d, 0x6b, 0x2d, 0x37, 0xae, 0xf5, 0xbe, 0x71, 0xad, 0xa7, 0x6e, 0xc4, 0x21, 0x9e, 0x86, 0x1e,
+	0x51, 0xc2, 0xbf, 0xcf, 0xf4, 0x57, 0x43, 0x1c, 0x1a, 0x7f, 0x5b, 0xe1, 0xac, 0xd7, 0xf3, 0x9f,
+	0xf7, 0x45, 0xc2, 0x7c, 0xc2, 0x4c, 0x58, 0xca, 0xba, 0xe9, 0x7d, 0x0d, 0x00, 0x97, 0xab, 0xb6,
+	0xe6, 0xe8, 0x01, 0x00, 0x00,
+}
+
+func (m *InFlightRedelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightRedelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightRedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionTime != 0 {
+		i = encodeVarintRedelegation(dAtA, i, uint64(m.CompletionTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InFlightRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRedelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DstValidator) > 0 {
+		i -= len(m.DstValidator)
+		copy(dAtA[i:], m.DstValidator)
+		i = encodeVarintRedelegation(dAtA, i, uint64(len(m.DstValidator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcValidator) > 0 {
+		i -= len(m.SrcValidator)
+		copy(dAtA[i:], m.SrcValidator)
+		i = encodeVarintRedelegation(dAtA, i, uint64(len(m.SrcValidator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRedelegation(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRedelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRedelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightRedelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovRedelegation(uint64(l))
+	if m.CompletionTime != 0 {
+		n += 1 + sovRedelegation(uint64(m.CompletionTime))
+	}
+	return n
+}
+
+func (m *InFlightRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRedelegation(uint64(l))
+	}
+	l = len(m.SrcValidator)
+	if l > 0 {
+		n += 1 + l + sovRedelegation(uint64(l))
+	}
+	l = len(m.DstValidator)
+	if l > 0 {
+		n += 1 + l + sovRedelegation(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovRedelegation(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRedelegation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRedelegation(x uint64) (n int) {
+	return sovRedelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightRedelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightRedelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightRedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			m.CompletionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InFlightRedelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightRedelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, InFlightRedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRedelegation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRedelegation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRedelegation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRedelegation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRedelegation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRedelegation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRedelegation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRedelegation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1037 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/redemption_rate_history.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A host zone's redemption rate and its components, recorded each time the
+// redemption rate is updated
+// Only the latest snapshot from each stride epoch is kept
+type RedemptionRateSnapshot struct {
+	ChainId          string                      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	EpochNumber      uint64                      `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Time             time.Time                   `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	RedemptionRate   cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=redemption_rate,json=redemptionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"redemption_rate"`
+	TotalDelegations cosmossdk_io_math.Int       `protobuf:"bytes,5,opt,name=total_delegations,json=totalDelegations,proto3,customtype=cosmossdk.io/math.Int" json:"total_delegations"`
+	StTokenSupply    cosmossdk_io_math.Int       `protobuf:"bytes,6,opt,name=st_token_supply,json=stTokenSupply,proto3,customtype=cosmossdk.io/math.Int" json:"st_token_supply"`
+}
+
+func (m *RedemptionRateSnapshot) Reset()         { *m = RedemptionRateSnapshot{} }
+func (m *RedemptionRateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRateSnapshot) ProtoMessage()    {}
+func (*RedemptionRateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5eac712b7d3f1d5, []int{0}
+}
+func (m *RedemptionRateSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRateSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRateSnapshot.Merge(m, src)
+}
+func (m *RedemptionRateSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRateSnapshot proto.InternalMessageInfo
+
+func (m *RedemptionRateSnapshot) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *RedemptionRateSnapshot) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *RedemptionRateSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// An audit record of a host zone's redemption rate guard changing status
+type RedemptionRateGuardRecord struct {
+	ChainId        string                      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Id             uint64                      `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	EpochNumber    uint64                      `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Time           time.Time                   `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	PreviousStatus RedemptionRateGuardStatus   `protobuf:"varint,5,opt,name=previous_status,json=previousStatus,proto3,enum=stride.stakeibc.RedemptionRateGuardStatus" json:"previous_status,omitempty"`
+	NewStatus      RedemptionRateGuardStatus   `protobuf:"varint,6,opt,name=new_status,json=newStatus,proto3,enum=stride.stakeibc.RedemptionRateGuardStatus" json:"new_status,omitempty"`
+	Reason         string                      `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	RedemptionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=redemption_rate,json=redemptionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"redemption_rate"`
+}
+
+func (m *RedemptionRateGuardRecord) Reset()         { *m = RedemptionRateGuardRecord{} }
+func (m *RedemptionRateGuardRecord) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRateGuardRecord) ProtoMessage()    {}
+func (*RedemptionRateGuardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5eac712b7d3f1d5, []int{1}
+}
+func (m *RedemptionRateGuardRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRateGuardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRateGuardRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRateGuardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRateGuardRecord.Merge(m, src)
+}
+func (m *RedemptionRateGuardRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRateGuardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRateGuardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRateGuardRecord proto.InternalMessageInfo
+
+func (m *RedemptionRateGuardRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *RedemptionRateGuardRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RedemptionRateGuardRecord) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *RedemptionRateGuardRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *RedemptionRateGuardRecord) GetPreviousStatus() RedemptionRateGuardStatus {
+	if m != nil {
+		return m.PreviousStatus
+	}
+	return GUARD_HEALTHY
+}
+
+func (m *RedemptionRateGuardRecord) GetNewStatus() RedemptionRateGuardStatus {
+	if m != nil {
+		return m.NewStatus
+	}
+	return GUARD_HEALTHY
+}
+
+func (m *RedemptionRateGuardRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RedemptionRateSnapshot)(nil), "stride.stakeibc.RedemptionRateSnapshot")
+	proto.RegisterType((*RedemptionRateGuardRecord)(nil), "stride.stakeibc.RedemptionRateGuardRecord")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/redemption_rate_history.proto", fileDescriptor_b5eac712b7d3f1d5)
+}
+
+var fileDescriptor_b5eac712b7d3f1d5 = []byte{
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc6, 0xe3, 0x26, 0xff, 0xb4, 0xdd, 0xfe, 0x49, 0xc0, 0x82, 0xca, 0x0d, 0x92, 0x13, 0x7a,
+	0x8a, 0x40, 0xb1, 0x45, 0x72, 0xe1, 0x1c, 0x45, 0x42, 0x91, 0x22, 0x0e, 0x76, 0x0f, 0xa8, 0x17,
+	0x6b, 0x6d, 0x0f, 0xf6, 0x2a, 0xb1, 0xd7, 0xf2, 0x8e, 0x5b, 0xc2, 0x53, 0xf4, 0xce, 0x6b, 0x20,
+	0xf1, 0x0a, 0x3d, 0x56, 0x9c, 0x10, 0x87, 0x82, 0x92, 0x17, 0x41, 0x5e, 0xdb, 0x94, 0xa6, 0x05,
+	0x29, 0x12, 0xb7, 0xcc, 0xe4, 0xdb, 0x9f, 0xbf, 0x9d, 0x6f, 0x96, 0x0c, 0x04, 0xa6, 0xcc, 0x07,
+	0x53, 0x20, 0x9d, 0x03, 0x73, 0x3d, 0x33, 0x05, 0x1f, 0xa2, 0x04, 0x19, 0x8f, 0x9d, 0x94, 0x22,
+	0x38, 0x21, 0x13, 0xc8, 0xd3, 0xa5, 0x91, 0xa4, 0x1c, 0xb9, 0xda, 0x2e, 0xe4, 0x46, 0x25, 0xef,
+	0x1c, 0x79, 0x5c, 0x44, 0x5c, 0x38, 0xf2, 0x6f, 0xb3, 0x28, 0x0a, 0x6d, 0xe7, 0x71, 0xc0, 0x03,
+	0x5e, 0xf4, 0xf3, 0x5f, 0x65, 0xb7, 0x1b, 0x70, 0x1e, 0x2c, 0xc0, 0x94, 0x95, 0x9b, 0xbd, 0x33,
+	0x91, 0x45, 0x20, 0x90, 0x46, 0x49, 0x25, 0xd8, 0x74, 0x14, 0x72, 0x81, 0xce, 0x07, 0x1e, 0x43,
+	0x21, 0x38, 0xfe, 0x58, 0x27, 0x87, 0xd6, 0x2f, 0x97, 0x16, 0x45, 0xb0, 0x63, 0x9a, 0x88, 0x90,
+	0xa3, 0x7a, 0x44, 0xf6, 0xbc, 0x90, 0xb2, 0xd8, 0x61, 0xbe, 0xa6, 0xf4, 0x94, 0xfe, 0xbe, 0xb5,
+	0x2b, 0xeb, 0xa9, 0xaf, 0x3e, 0x23, 0xff, 0x43, 0xc2, 0xbd, 0xd0, 0x89, 0xb3, 0xc8, 0x85, 0x54,
+	0xdb, 0xe9, 0x29, 0xfd, 0x86, 0x75, 0x20, 0x7b, 0x6f, 0x64, 0x4b, 0x7d, 0x45, 0x1a, 0xb9, 0x19,
+	0xad, 0xde, 0x53, 0xfa, 0x07, 0xc3, 0x8e, 0x51, 0x38, 0x35, 0x2a, 0xa7, 0xc6, 0x49, 0xe5, 0x74,
+	0xbc, 0x77, 0x79, 0xdd, 0xad, 0x5d, 0x7c, 0xef, 0x2a, 0x96, 0x3c, 0xa1, 0x9e, 0x92, 0xf6, 0xc6,
+	0xdc, 0xb4, 0x46, 0xfe, 0xf9, 0xf1, 0xcb, 0x5c, 0xf8, 0xed, 0xba, 0xfb, 0xb4, 0x98, 0x8c, 0xf0,
+	0xe7, 0x06, 0xe3, 0x66, 0x44, 0x31, 0x34, 0x66, 0x10, 0x50, 0x6f, 0x39, 0x01, 0xef, 0xcb, 0xa7,
+	0x01, 0x29, 0x07, 0x37, 0x01, 0xcf, 0x6a, 0xa5, 0xb7, 0xee, 0xa6, 0xbe, 0x25, 0x8f, 0x90, 0x23,
+	0x5d, 0x38, 0x3e, 0x2c, 0x20, 0xa0, 0x79, 0x5f, 0x68, 0xff, 0x49, 0xfa, 0x8b, 0x92, 0xfe, 0xe4,
+	0x2e, 0x7d, 0x1a, 0xe3, 0x6f, 0xdc, 0x69, 0x8c, 0xd6, 0x43, 0x49, 0x99, 0xdc, 0x40, 0x54, 0x9b,
+	0xb4, 0x05, 0x3a, 0xc8, 0xe7, 0x10, 0x3b, 0x22, 0x4b, 0x92, 0xc5, 0x52, 0x6b, 0x6e, 0xcf, 0x7d,
+	0x20, 0xf0, 0x24, 0x47, 0xd8, 0x92, 0x70, 0xfc, 0xb9, 0x4e, 0x8e, 0x6e, 0xa7, 0xf3, 0x3a, 0xa3,
+	0xa9, 0x6f, 0x81, 0xc7, 0x53, 0xff, 0x6f, 0x01, 0xb5, 0xc8, 0x0e, 0xf3, 0xcb, 0x58, 0x76, 0xd8,
+	0xdd, 0xc0, 0xea, 0x7f, 0x0e, 0xac, 0xb1, 0x75, 0x60, 0x36, 0x69, 0x27, 0x29, 0x9c, 0x31, 0x9e,
+	0x09, 0x47, 0x20, 0xc5, 0xac, 0x18, 0x69, 0x6b, 0xf8, 0xdc, 0xd8, 0xd8, 0x70, 0xe3, 0x9e, 0xcb,
+	0xd8, 0xf2, 0x84, 0xd5, 0xaa, 0x10, 0x45, 0xad, 0x4e, 0x09, 0x89, 0xe1, 0xbc, 0xe2, 0x35, 0xb7,
+	0xe6, 0xed, 0xc7, 0x70, 0x5e, 0xa2, 0x0e, 0x49, 0x33, 0x05, 0x2a, 0x78, 0xac, 0xed, 0xca, 0x29,
+	0x95, 0xd5, 0x7d, 0x8b, 0xb6, 0xf7, 0x8f, 0x16, 0x6d, 0x3c, 0xbb, 0x5c, 0xe9, 0xca, 0xd5, 0x4a,
+	0x57, 0x7e, 0xac, 0x74, 0xe5, 0x62, 0xad, 0xd7, 0xae, 0xd6, 0x7a, 0xed, 0xeb, 0x5a, 0xaf, 0x9d,
+	0x0e, 0x03, 0x86, 0x61, 0xe6, 0x1a, 0x1e, 0x8f, 0x4c, 0x5b, 0x5e, 0x67, 0x30, 0xa3, 0xae, 0x30,
+	0xcb, 0x97, 0x7a, 0x36, 0x1a, 0x99, 0xef, 0x6f, 0xde, 0x2b, 0x2e, 0x13, 0x10, 0x6e, 0x53, 0xa6,
+	0x30, 0xfa, 0x39, 0x00, 0x6a, 0x43, 0x7a, 0x3b, 0x61, 0x04, 0x00, 0x00,
+}
+
+func (m *RedemptionRateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StTokenSupply.Size()
+		i -= size
+		if _, err := m.StTokenSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalDelegations.Size()
+		i -= size
+		if _, err := m.TotalDelegations.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedemptionRateGuardRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRateGuardRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRateGuardRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NewStatus != 0 {
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(m.NewStatus))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PreviousStatus != 0 {
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(m.PreviousStatus))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.EpochNumber != 0 {
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRedemptionRateHistory(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRedemptionRateHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRedemptionRateHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RedemptionRateSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRedemptionRateHistory(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovRedemptionRateHistory(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovRedemptionRateHistory(uint64(l))
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovRedemptionRateHistory(uint64(l))
+	l = m.TotalDelegations.Size()
+	n += 1 + l + sovRedemptionRateHistory(uint64(l))
+	l = m.StTokenSupply.Size()
+	n += 1 + l + sovRedemptionRateHistory(uint64(l))
+	return n
+}
+
+func (m *RedemptionRateGuardRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRedemptionRateHistory(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovRedemptionRateHistory(uint64(m.Id))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovRedemptionRateHistory(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovRedemptionRateHistory(uint64(l))
+	if m.PreviousStatus != 0 {
+		n += 1 + sovRedemptionRateHistory(uint64(m.PreviousStatus))
+	}
+	if m.NewStatus != 0 {
+		n += 1 + sovRedemptionRateHistory(uint64(m.NewStatus))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRedemptionRateHistory(uint64(l))
+	}
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovRedemptionRateHistory(uint64(l))
+	return n
+}
+
+func sovRedemptionRateHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRedemptionRateHistory(x uint64) (n int) {
+	return sovRedemptionRateHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RedemptionRateSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemptionRateHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRateSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StTokenSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemptionRateHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionRateGuardRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemptionRateHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRateGuardRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRateGuardRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+			}
+			m.PreviousStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStatus |= RedemptionRateGuardStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			m.NewStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStatus |= RedemptionRateGuardStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemptionRateHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemptionRateHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRedemptionRateHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRedemptionRateHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemptionRateHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRedemptionRateHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRedemptionRateHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRedemptionRateHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRedemptionRateHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRedemptionRateHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRedemptionRateHistory = fmt.Errorf("proto: unexpected end of group")
+)
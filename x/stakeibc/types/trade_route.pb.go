@@ -25,6 +25,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Venue where a trade route's reward tokens are converted to the host denom
+type TradeVenue int32
+
+const (
+	// Reward tokens are sent to a trade ICA on Osmosis and swapped off-chain by
+	// an address that's been granted swap permissions via authz
+	TradeVenue_OSMOSIS_AUTHZ TradeVenue = 0
+	// Reward tokens are sent to Stride and sold through x/auction, with the
+	// proceeds returned to the host zone
+	TradeVenue_STRIDE_AUCTION TradeVenue = 1
+)
+
+var TradeVenue_name = map[int32]string{
+	0: "OSMOSIS_AUTHZ",
+	1: "STRIDE_AUCTION",
+}
+
+var TradeVenue_value = map[string]int32{
+	"OSMOSIS_AUTHZ":  0,
+	"STRIDE_AUCTION": 1,
+}
+
+func (x TradeVenue) String() string {
+	return proto.EnumName(TradeVenue_name, int32(x))
+}
+
+func (TradeVenue) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c252b142ecf88017, []int{0}
+}
+
 // Deprecated, this configuration is no longer needed since swaps
 // are executed off-chain via authz
 //
@@ -103,6 +133,10 @@ func (m *TradeConfig) GetPriceUpdateTimestamp() uint64 {
 // the host so therefore the first two hops are to unwind the ibc denom enroute
 // to the trade chain and the last hop is the return so funds start/end in the
 // withdrawl ICA on hostZone
+// If the trade venue is STRIDE_AUCTION, the trade zone is Stride itself, so the
+// trade account and trade channels are not used, and the reward tokens are
+// instead forwarded from the reward zone to the auction proceeds account on
+// Stride, from which they're moved into the auction module
 // The structure is key'd on reward denom and host denom in their native forms
 // (i.e. reward_denom_on_reward_zone and host_denom_on_host_zone)
 type TradeRoute struct {
@@ -143,6 +177,20 @@ type TradeRoute struct {
 	// specifies the configuration needed to execute the swap
 	// such as pool_id, slippage, min trade amount, etc.
 	TradeConfig TradeConfig `protobuf:"bytes,12,opt,name=trade_config,json=tradeConfig,proto3" json:"trade_config"` // Deprecated: Do not use.
+	// Venue where the reward tokens are converted to the host denom
+	TradeVenue TradeVenue `protobuf:"varint,14,opt,name=trade_venue,json=tradeVenue,proto3,enum=stride.stakeibc.TradeVenue" json:"trade_venue,omitempty"`
+	// Channel responsible for the transfer of reward tokens from the reward
+	// zone to Stride, for the STRIDE_AUCTION venue. This is the channel ID on
+	// the reward zone side
+	RewardToStrideChannelId string `protobuf:"bytes,15,opt,name=reward_to_stride_channel_id,json=rewardToStrideChannelId,proto3" json:"reward_to_stride_channel_id,omitempty"`
+	// ibc denom of the reward on Stride, sold in the auction for the
+	// STRIDE_AUCTION venue
+	RewardDenomOnStride string `protobuf:"bytes,16,opt,name=reward_denom_on_stride,json=rewardDenomOnStride,proto3" json:"reward_denom_on_stride,omitempty"`
+	// Stride address that receives the auction proceeds for the STRIDE_AUCTION
+	// venue, and must be set as the auction's beneficiary. The reward tokens
+	// are also forwarded here, since the auction module account is blocked from
+	// receiving IBC transfers
+	AuctionProceedsAddress string `protobuf:"bytes,17,opt,name=auction_proceeds_address,json=auctionProceedsAddress,proto3" json:"auction_proceeds_address,omitempty"`
 }
 
 func (m *TradeRoute) Reset()         { *m = TradeRoute{} }
@@ -263,7 +311,36 @@ func (m *TradeRoute) GetTradeConfig() TradeConfig {
 	return TradeConfig{}
 }
 
+func (m *TradeRoute) GetTradeVenue() TradeVenue {
+	if m != nil {
+		return m.TradeVenue
+	}
+	return TradeVenue_OSMOSIS_AUTHZ
+}
+
+func (m *TradeRoute) GetRewardToStrideChannelId() string {
+	if m != nil {
+		return m.RewardToStrideChannelId
+	}
+	return ""
+}
+
+func (m *TradeRoute) GetRewardDenomOnStride() string {
+	if m != nil {
+		return m.RewardDenomOnStride
+	}
+	return ""
+}
+
+func (m *TradeRoute) GetAuctionProceedsAddress() string {
+	if m != nil {
+		return m.AuctionProceedsAddress
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("stride.stakeibc.TradeVenue", TradeVenue_name, TradeVenue_value)
 	proto.RegisterType((*TradeConfig)(nil), "stride.stakeibc.TradeConfig")
 	proto.RegisterType((*TradeRoute)(nil), "stride.stakeibc.TradeRoute")
 }
@@ -271,51 +348,59 @@ func init() {
 func init() { proto.RegisterFile("stride/stakeibc/trade_route.proto", fileDescriptor_c252b142ecf88017) }
 
 var fileDescriptor_c252b142ecf88017 = []byte{
-	// 699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x4e, 0xdb, 0x40,
-	0x10, 0xc6, 0x63, 0xa0, 0xa1, 0xd9, 0x24, 0x45, 0x35, 0xff, 0x4c, 0x68, 0x03, 0xe5, 0xc4, 0x85,
-	0x44, 0x05, 0x84, 0x10, 0xea, 0x25, 0x90, 0xb6, 0x44, 0x4a, 0x55, 0x64, 0xd2, 0x0b, 0x97, 0xd5,
-	0xc6, 0x5e, 0x12, 0x8b, 0xd8, 0x63, 0x79, 0x37, 0x4d, 0xe8, 0x53, 0xf4, 0x4d, 0x7a, 0xe9, 0x43,
-	0x70, 0xe8, 0x01, 0xf5, 0x54, 0xf5, 0x80, 0x2a, 0x78, 0x91, 0x6a, 0x67, 0x6d, 0x70, 0x48, 0x0f,
-	0x51, 0x6f, 0xf6, 0xce, 0xfc, 0xbe, 0x99, 0xdd, 0x6f, 0x77, 0xc8, 0x2b, 0x21, 0x23, 0xcf, 0xe5,
-	0x55, 0x21, 0xd9, 0x05, 0xf7, 0xda, 0x4e, 0x55, 0x46, 0xcc, 0xe5, 0x34, 0x82, 0xbe, 0xe4, 0x95,
-	0x30, 0x02, 0x09, 0xe6, 0x9c, 0x4e, 0xa9, 0x24, 0x29, 0xa5, 0x15, 0x07, 0x84, 0x0f, 0x82, 0x62,
-	0xb8, 0xaa, 0x7f, 0x74, 0x6e, 0x69, 0xa1, 0x03, 0x1d, 0xd0, 0xeb, 0xea, 0x2b, 0x5e, 0x1d, 0x2b,
-	0xe2, 0x39, 0x8c, 0x32, 0xc7, 0x81, 0x7e, 0x20, 0x75, 0xca, 0xc6, 0xb7, 0x69, 0x92, 0x6f, 0xa9,
-	0xd2, 0x47, 0x10, 0x9c, 0x7b, 0x1d, 0x73, 0x99, 0xcc, 0x86, 0x00, 0x3d, 0xea, 0xb9, 0x96, 0xb1,
-	0x6e, 0x6c, 0xce, 0xd8, 0x59, 0xf5, 0xdb, 0x70, 0xcd, 0x13, 0x42, 0xc4, 0x80, 0x85, 0x34, 0x8c,
-	0x3c, 0x87, 0x5b, 0x53, 0xeb, 0xc6, 0x66, 0xee, 0xf0, 0xf5, 0xd5, 0xcd, 0x5a, 0xe6, 0xf7, 0xcd,
-	0xda, 0xaa, 0xee, 0x45, 0xb8, 0x17, 0x15, 0x0f, 0xaa, 0x3e, 0x93, 0xdd, 0x4a, 0x93, 0x77, 0x98,
-	0x73, 0x59, 0xe7, 0xce, 0xcf, 0xef, 0x5b, 0x24, 0x6e, 0xb5, 0xce, 0x1d, 0x3b, 0xa7, 0x44, 0x4e,
-	0x94, 0x86, 0xb9, 0x4b, 0x96, 0x50, 0x8c, 0xf6, 0x43, 0x97, 0x49, 0x4e, 0xa5, 0xe7, 0x73, 0x21,
-	0x99, 0x1f, 0x5a, 0xd3, 0x58, 0x79, 0x01, 0xa3, 0x9f, 0x30, 0xd8, 0x4a, 0x62, 0xa6, 0x4f, 0x4a,
-	0x3e, 0x1b, 0x52, 0xd6, 0xeb, 0xc1, 0x80, 0xbb, 0x14, 0x7b, 0xea, 0x81, 0x10, 0x34, 0x62, 0x92,
-	0x5b, 0x33, 0xff, 0xdb, 0xd7, 0x92, 0xcf, 0x86, 0x35, 0xad, 0x79, 0x3a, 0x60, 0x61, 0x13, 0x84,
-	0xb0, 0x99, 0xe4, 0xe6, 0x5b, 0x32, 0xe7, 0x7b, 0x81, 0x2e, 0xc3, 0x7c, 0x75, 0x70, 0xd6, 0x13,
-	0xac, 0xf1, 0x32, 0xae, 0xb1, 0x38, 0x5e, 0xa3, 0x11, 0x48, 0xbb, 0xe8, 0x7b, 0x81, 0x12, 0xaa,
-	0x21, 0x83, 0x32, 0x6c, 0x38, 0x22, 0x93, 0x9d, 0x4c, 0x86, 0x0d, 0x1f, 0x64, 0x0e, 0xa6, 0x2c,
-	0x63, 0xe3, 0x47, 0x96, 0x10, 0x74, 0xcc, 0x56, 0x77, 0xc5, 0xdc, 0x27, 0x2b, 0x11, 0x1f, 0xb0,
-	0xc8, 0xa5, 0x2e, 0x0f, 0xc0, 0xa7, 0x10, 0xd0, 0x2e, 0x08, 0x49, 0xbf, 0x40, 0xc0, 0xd1, 0xc2,
-	0x9c, 0xbd, 0xa8, 0x13, 0xea, 0x2a, 0xfe, 0x31, 0x38, 0x06, 0x21, 0xcf, 0x20, 0xe0, 0xe6, 0x1b,
-	0xb2, 0xfa, 0x98, 0x8c, 0xff, 0x91, 0x45, 0x8b, 0xed, 0xe5, 0x11, 0xd6, 0xc6, 0x1f, 0xa4, 0x0f,
-	0x48, 0xe9, 0x31, 0xad, 0xaf, 0x30, 0xc2, 0xd3, 0x08, 0x2f, 0x8d, 0xc0, 0xd8, 0x34, 0xb2, 0x7b,
-	0xc4, 0xc2, 0x1e, 0xff, 0x45, 0xa2, 0x83, 0xf6, 0x82, 0x8a, 0x8f, 0x71, 0xbb, 0x64, 0x79, 0x94,
-	0x7b, 0xd8, 0x29, 0x9a, 0x62, 0xcf, 0xa7, 0xb0, 0xfb, 0x7d, 0xd6, 0x49, 0x01, 0xf3, 0xe2, 0x8b,
-	0x8f, 0x07, 0x9f, 0xdf, 0x5e, 0xad, 0x3c, 0x7a, 0x5e, 0x95, 0xc6, 0x51, 0xad, 0xa6, 0x53, 0x0e,
-	0x67, 0x94, 0x2b, 0x76, 0x5e, 0x61, 0xf1, 0x92, 0x79, 0x4c, 0x9e, 0xc5, 0xfb, 0x4d, 0x74, 0x66,
-	0x27, 0xd5, 0x29, 0x6a, 0x30, 0x51, 0x7a, 0x47, 0x8a, 0x7a, 0xbf, 0x89, 0xd0, 0xd3, 0x49, 0x85,
-	0x0a, 0xc8, 0x25, 0x3a, 0xfb, 0x64, 0x05, 0xf7, 0x25, 0x21, 0xf1, 0xcd, 0xe9, 0xb2, 0x20, 0xe0,
-	0xf8, 0x78, 0x73, 0xda, 0x79, 0x95, 0xd0, 0x02, 0x6d, 0xdb, 0x91, 0x8e, 0x36, 0xdc, 0x94, 0x77,
-	0x12, 0xe2, 0xb3, 0x4f, 0xa1, 0x24, 0xed, 0x5d, 0x0b, 0xf4, 0x74, 0xb8, 0x67, 0xf7, 0x88, 0xa5,
-	0x09, 0x09, 0xfa, 0xf8, 0x53, 0x64, 0x5e, 0x7b, 0x87, 0xf1, 0x16, 0x28, 0x03, 0x1e, 0xb8, 0x0f,
-	0x64, 0x5e, 0x3d, 0x24, 0x19, 0xb1, 0x40, 0x9c, 0xf3, 0x28, 0x79, 0x05, 0xc5, 0x49, 0x5e, 0xc1,
-	0x73, 0xdf, 0x53, 0xb7, 0x00, 0xc1, 0xf8, 0x41, 0xbd, 0x27, 0x85, 0xb8, 0x71, 0x9c, 0x5b, 0x56,
-	0x01, 0xcf, 0xf0, 0xc5, 0xd8, 0x19, 0xa6, 0x66, 0xdb, 0x61, 0x56, 0x55, 0xb1, 0x0c, 0x3b, 0x2f,
-	0x53, 0x8b, 0xcd, 0xab, 0xdb, 0xb2, 0x71, 0x7d, 0x5b, 0x36, 0xfe, 0xdc, 0x96, 0x8d, 0xaf, 0x77,
-	0xe5, 0xcc, 0xf5, 0x5d, 0x39, 0xf3, 0xeb, 0xae, 0x9c, 0x39, 0xdb, 0xee, 0x78, 0xb2, 0xdb, 0x6f,
-	0x57, 0x1c, 0xf0, 0xab, 0xa7, 0x28, 0xbb, 0xd5, 0x64, 0x6d, 0x51, 0x8d, 0x87, 0xea, 0xe7, 0x9d,
-	0x9d, 0xea, 0x30, 0x35, 0xbf, 0x2f, 0x43, 0x2e, 0xda, 0x59, 0x9c, 0xaa, 0x3b, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xc9, 0x69, 0x52, 0x38, 0xdf, 0x05, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0xc5, 0xd8, 0x55, 0xa2, 0xd5, 0x87, 0x6d, 0xc6, 0x91, 0x69, 0xa9, 0x55, 0xdc, 0x9c,
+	0x8c, 0x02, 0x91, 0x50, 0x2b, 0x08, 0x8c, 0x20, 0x17, 0x7d, 0xa4, 0xb5, 0x00, 0xa5, 0x32, 0x28,
+	0xba, 0x07, 0x5f, 0x16, 0x2b, 0x72, 0x23, 0x11, 0x11, 0x77, 0x09, 0xee, 0x2a, 0x52, 0xfa, 0x14,
+	0x7d, 0x93, 0x5e, 0x0a, 0xf4, 0x15, 0x72, 0x0c, 0x7a, 0x2a, 0x7a, 0x08, 0x0a, 0xfb, 0x45, 0x8a,
+	0x9d, 0x25, 0x2d, 0x4a, 0xf2, 0x41, 0xc8, 0x8d, 0xcb, 0x99, 0xdf, 0x7f, 0x66, 0x67, 0x76, 0x76,
+	0xd1, 0xf7, 0x42, 0x46, 0xbe, 0x47, 0x1b, 0x42, 0x92, 0xf7, 0xd4, 0x1f, 0xb9, 0x0d, 0x19, 0x11,
+	0x8f, 0xe2, 0x88, 0xcf, 0x24, 0xad, 0x87, 0x11, 0x97, 0xdc, 0xdc, 0xd3, 0x2e, 0xf5, 0xc4, 0xa5,
+	0x72, 0xec, 0x72, 0x11, 0x70, 0x81, 0xc1, 0xdc, 0xd0, 0x0b, 0xed, 0x5b, 0x39, 0x1c, 0xf3, 0x31,
+	0xd7, 0xff, 0xd5, 0x57, 0xfc, 0x77, 0x23, 0x88, 0xef, 0x12, 0x4c, 0x5c, 0x97, 0xcf, 0x98, 0xd4,
+	0x2e, 0xcf, 0xfe, 0xd8, 0x41, 0x79, 0x47, 0x85, 0xee, 0x70, 0xf6, 0xce, 0x1f, 0x9b, 0x47, 0xe8,
+	0x61, 0xc8, 0xf9, 0x14, 0xfb, 0x9e, 0x65, 0x9c, 0x18, 0xa7, 0xbb, 0x76, 0x56, 0x2d, 0x7b, 0x9e,
+	0x79, 0x89, 0x90, 0x98, 0x93, 0x10, 0x87, 0x91, 0xef, 0x52, 0xeb, 0xc1, 0x89, 0x71, 0x9a, 0x6b,
+	0xff, 0xf8, 0xe9, 0xcb, 0xd3, 0xcc, 0xbf, 0x5f, 0x9e, 0x56, 0x75, 0x2e, 0xc2, 0x7b, 0x5f, 0xf7,
+	0x79, 0x23, 0x20, 0x72, 0x52, 0xef, 0xd3, 0x31, 0x71, 0x3f, 0x76, 0xa9, 0xfb, 0xf7, 0x9f, 0xcf,
+	0x51, 0x9c, 0x6a, 0x97, 0xba, 0x76, 0x4e, 0x89, 0x5c, 0x2a, 0x0d, 0xf3, 0x05, 0x2a, 0x83, 0x18,
+	0x9e, 0x85, 0x1e, 0x91, 0x14, 0x4b, 0x3f, 0xa0, 0x42, 0x92, 0x20, 0xb4, 0x76, 0x20, 0xf2, 0x21,
+	0x58, 0xaf, 0xc0, 0xe8, 0x24, 0x36, 0x33, 0x40, 0x95, 0x80, 0x2c, 0x30, 0x99, 0x4e, 0xf9, 0x9c,
+	0x7a, 0x18, 0x72, 0x9a, 0x72, 0x21, 0x70, 0x44, 0x24, 0xb5, 0x76, 0xbf, 0x36, 0xaf, 0x72, 0x40,
+	0x16, 0x2d, 0xad, 0x39, 0x9c, 0x93, 0xb0, 0xcf, 0x85, 0xb0, 0x89, 0xa4, 0xe6, 0x1b, 0xb4, 0x17,
+	0xf8, 0x4c, 0x87, 0x21, 0x81, 0x2a, 0x9c, 0xf5, 0x0d, 0xc4, 0xf8, 0x2e, 0x8e, 0xf1, 0x64, 0x33,
+	0x46, 0x8f, 0x49, 0xbb, 0x18, 0xf8, 0x4c, 0x09, 0xb5, 0x80, 0x01, 0x19, 0xb2, 0x58, 0x91, 0xc9,
+	0x6e, 0x27, 0x43, 0x16, 0x4b, 0x99, 0x57, 0x0f, 0x2c, 0xe3, 0xd9, 0x5f, 0x8f, 0x10, 0x82, 0x8e,
+	0xd9, 0xea, 0xac, 0x98, 0xe7, 0xe8, 0x38, 0xa2, 0x73, 0x12, 0x79, 0xd8, 0xa3, 0x8c, 0x07, 0x98,
+	0x33, 0x3c, 0xe1, 0x42, 0xe2, 0xdf, 0x38, 0xa3, 0xd0, 0xc2, 0x9c, 0xfd, 0x44, 0x3b, 0x74, 0x95,
+	0x7d, 0xc0, 0x2e, 0xb8, 0x90, 0xd7, 0x9c, 0x51, 0xf3, 0x35, 0xaa, 0xae, 0x93, 0xf1, 0x1a, 0x58,
+	0x68, 0xb1, 0x7d, 0xb4, 0xc2, 0xda, 0xb0, 0x00, 0xfa, 0x15, 0xaa, 0xac, 0xd3, 0xfa, 0x08, 0x03,
+	0xbc, 0x03, 0x70, 0x79, 0x05, 0x86, 0xa4, 0x81, 0x7d, 0x89, 0x2c, 0xc8, 0xf1, 0x3e, 0x12, 0x3a,
+	0x68, 0x1f, 0x2a, 0xfb, 0x06, 0xf7, 0x02, 0x1d, 0xad, 0x72, 0xcb, 0x9d, 0x42, 0x53, 0xec, 0xc7,
+	0x29, 0xec, 0x6e, 0x9f, 0x5d, 0x54, 0x00, 0xbf, 0xf8, 0xe0, 0x43, 0xe1, 0xf3, 0x67, 0xd5, 0xfa,
+	0xda, 0x78, 0xd5, 0x7b, 0x9d, 0x56, 0x4b, 0xbb, 0xb4, 0x77, 0x55, 0x57, 0xec, 0xbc, 0xc2, 0xe2,
+	0x5f, 0xe6, 0x05, 0x2a, 0xc5, 0xfb, 0x4d, 0x74, 0x1e, 0x6e, 0xab, 0x53, 0xd4, 0x60, 0xa2, 0xf4,
+	0x13, 0x2a, 0xea, 0xfd, 0x26, 0x42, 0x8f, 0xb6, 0x15, 0x2a, 0x00, 0x97, 0xe8, 0x9c, 0xa3, 0x63,
+	0xd8, 0x97, 0xe4, 0x49, 0xdf, 0xdc, 0x09, 0x61, 0x8c, 0xc2, 0xf0, 0xe6, 0x74, 0xe7, 0x95, 0x83,
+	0xc3, 0x75, 0xdb, 0x3a, 0xda, 0xda, 0xf3, 0x52, 0xbd, 0x93, 0x3c, 0xae, 0x7d, 0x0a, 0x45, 0xe9,
+	0xde, 0x39, 0x5c, 0xdf, 0x0e, 0x77, 0xec, 0x4b, 0x64, 0x69, 0x42, 0x72, 0x5d, 0xfe, 0x14, 0x99,
+	0xd7, 0xbd, 0x03, 0xbb, 0xc3, 0x55, 0x03, 0x96, 0xdc, 0x5b, 0xf4, 0x58, 0x0d, 0x92, 0x8c, 0x08,
+	0x13, 0xef, 0x68, 0x94, 0x4c, 0x41, 0x71, 0x9b, 0x29, 0x38, 0x08, 0x7c, 0xe6, 0xc4, 0x60, 0x3c,
+	0x50, 0x3f, 0xa3, 0x42, 0x9c, 0x38, 0xdc, 0x5b, 0x56, 0x01, 0x6a, 0xf8, 0xed, 0x46, 0x0d, 0x53,
+	0x77, 0x5b, 0x3b, 0xab, 0xa2, 0x58, 0x86, 0x9d, 0x97, 0xcb, 0x9f, 0xe6, 0x6b, 0xa4, 0x97, 0xf8,
+	0x03, 0x65, 0x33, 0x6a, 0x95, 0x4e, 0x8c, 0xd3, 0xd2, 0x59, 0xf5, 0x7e, 0x9d, 0x5f, 0x95, 0x8b,
+	0x8d, 0xe4, 0xdd, 0x77, 0x6a, 0x86, 0x24, 0xc7, 0x9a, 0x49, 0x17, 0x64, 0x2f, 0x3d, 0x43, 0x0e,
+	0x1f, 0x82, 0xc3, 0xb2, 0x26, 0x4d, 0x54, 0x5e, 0x9f, 0x21, 0xad, 0x61, 0xed, 0xeb, 0xe3, 0xbc,
+	0x32, 0x3f, 0x9a, 0x36, 0xcf, 0x91, 0x45, 0x66, 0xae, 0xf4, 0x39, 0x53, 0x0f, 0x81, 0x4b, 0xa9,
+	0x27, 0x30, 0xf1, 0xbc, 0x88, 0x0a, 0x61, 0x1d, 0xe8, 0xd6, 0xc5, 0xf6, 0xcb, 0xd8, 0xdc, 0xd2,
+	0xd6, 0x1f, 0x9a, 0xf1, 0xc5, 0xa1, 0x53, 0x3f, 0x40, 0xc5, 0xc1, 0xf0, 0xed, 0x60, 0xd8, 0x1b,
+	0xe2, 0xd6, 0x95, 0x73, 0x71, 0xbd, 0x9f, 0x31, 0x4d, 0x54, 0x1a, 0x3a, 0x76, 0xaf, 0xfb, 0x06,
+	0xb7, 0xae, 0x3a, 0x4e, 0x6f, 0xf0, 0xcb, 0xbe, 0xd1, 0xee, 0x7f, 0xba, 0xa9, 0x19, 0x9f, 0x6f,
+	0x6a, 0xc6, 0x7f, 0x37, 0x35, 0xe3, 0xf7, 0xdb, 0x5a, 0xe6, 0xf3, 0x6d, 0x2d, 0xf3, 0xcf, 0x6d,
+	0x2d, 0x73, 0x7d, 0x36, 0xf6, 0xe5, 0x64, 0x36, 0xaa, 0xbb, 0x3c, 0x68, 0xe8, 0xdc, 0x9e, 0xf7,
+	0xc9, 0x48, 0x34, 0xe2, 0x47, 0xe7, 0x43, 0xb3, 0xd9, 0x58, 0xa4, 0xde, 0xb7, 0x8f, 0x21, 0x15,
+	0xa3, 0x2c, 0xbc, 0x3a, 0xcd, 0xff, 0x07, 0x00, 0x3e, 0x1d, 0x8b, 0xe2, 0xff, 0x06, 0x00, 0x00,
 }
 
 func (m *TradeConfig) Marshal() (dAtA []byte, err error) {
@@ -411,6 +496,36 @@ func (m *TradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AuctionProceedsAddress) > 0 {
+		i -= len(m.AuctionProceedsAddress)
+		copy(dAtA[i:], m.AuctionProceedsAddress)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.AuctionProceedsAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.RewardDenomOnStride) > 0 {
+		i -= len(m.RewardDenomOnStride)
+		copy(dAtA[i:], m.RewardDenomOnStride)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.RewardDenomOnStride)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.RewardToStrideChannelId) > 0 {
+		i -= len(m.RewardToStrideChannelId)
+		copy(dAtA[i:], m.RewardToStrideChannelId)
+		i = encodeVarintTradeRoute(dAtA, i, uint64(len(m.RewardToStrideChannelId)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.TradeVenue != 0 {
+		i = encodeVarintTradeRoute(dAtA, i, uint64(m.TradeVenue))
+		i--
+		dAtA[i] = 0x70
+	}
 	{
 		size := m.MinTransferAmount.Size()
 		i -= size
@@ -602,6 +717,21 @@ func (m *TradeRoute) Size() (n int) {
 	n += 1 + l + sovTradeRoute(uint64(l))
 	l = m.MinTransferAmount.Size()
 	n += 1 + l + sovTradeRoute(uint64(l))
+	if m.TradeVenue != 0 {
+		n += 1 + sovTradeRoute(uint64(m.TradeVenue))
+	}
+	l = len(m.RewardToStrideChannelId)
+	if l > 0 {
+		n += 1 + l + sovTradeRoute(uint64(l))
+	}
+	l = len(m.RewardDenomOnStride)
+	if l > 0 {
+		n += 2 + l + sovTradeRoute(uint64(l))
+	}
+	l = len(m.AuctionProceedsAddress)
+	if l > 0 {
+		n += 2 + l + sovTradeRoute(uint64(l))
+	}
 	return n
 }
 
@@ -1286,6 +1416,121 @@ func (m *TradeRoute) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeVenue", wireType)
+			}
+			m.TradeVenue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeVenue |= TradeVenue(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToStrideChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToStrideChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomOnStride", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenomOnStride = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionProceedsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionProceedsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTradeRoute(dAtA[iNdEx:])
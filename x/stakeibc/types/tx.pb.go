@@ -8,24 +8,29 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -158,6 +163,9 @@ type MsgLiquidStake struct {
 	Creator   string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Amount    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	HostDenom string                `protobuf:"bytes,3,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+	// Optional validators to direct the deposit to. If empty, the deposit is
+	// delegated according to the validator weights
+	ValidatorPreferences []ValidatorPreference `protobuf:"bytes,4,rep,name=validator_preferences,json=validatorPreferences,proto3" json:"validator_preferences"`
 }
 
 func (m *MsgLiquidStake) Reset()         { *m = MsgLiquidStake{} }
@@ -207,6 +215,13 @@ func (m *MsgLiquidStake) GetHostDenom() string {
 	return ""
 }
 
+func (m *MsgLiquidStake) GetValidatorPreferences() []ValidatorPreference {
+	if m != nil {
+		return m.ValidatorPreferences
+	}
+	return nil
+}
+
 type MsgLiquidStakeResponse struct {
 	StToken types.Coin `protobuf:"bytes,1,opt,name=st_token,json=stToken,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"st_token"`
 }
@@ -542,6 +557,118 @@ func (m *MsgRedeemStakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRedeemStakeResponse proto.InternalMessageInfo
 
+// Redeems stTokens for native tokens immediately, paid out from the host
+// zone's instant redemption buffer
+type MsgInstantRedeemStake struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Number of stTokens to redeem
+	Amount   cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	HostZone string                `protobuf:"bytes,3,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
+	// Minimum number of native tokens the user is willing to receive after fees
+	MinNativeAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_native_amount,json=minNativeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_native_amount"`
+}
+
+func (m *MsgInstantRedeemStake) Reset()         { *m = MsgInstantRedeemStake{} }
+func (m *MsgInstantRedeemStake) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantRedeemStake) ProtoMessage()    {}
+func (*MsgInstantRedeemStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{10}
+}
+func (m *MsgInstantRedeemStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantRedeemStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantRedeemStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantRedeemStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantRedeemStake.Merge(m, src)
+}
+func (m *MsgInstantRedeemStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantRedeemStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantRedeemStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantRedeemStake proto.InternalMessageInfo
+
+func (m *MsgInstantRedeemStake) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgInstantRedeemStake) GetHostZone() string {
+	if m != nil {
+		return m.HostZone
+	}
+	return ""
+}
+
+type MsgInstantRedeemStakeResponse struct {
+	// Native tokens (in the ibc denom) sent to the user
+	NativeToken types.Coin `protobuf:"bytes,1,opt,name=native_token,json=nativeToken,proto3" json:"native_token"`
+	// Fee charged on the redemption, which is retained by the buffer
+	Fee types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *MsgInstantRedeemStakeResponse) Reset()         { *m = MsgInstantRedeemStakeResponse{} }
+func (m *MsgInstantRedeemStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantRedeemStakeResponse) ProtoMessage()    {}
+func (*MsgInstantRedeemStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{11}
+}
+func (m *MsgInstantRedeemStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantRedeemStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantRedeemStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantRedeemStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantRedeemStakeResponse.Merge(m, src)
+}
+func (m *MsgInstantRedeemStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantRedeemStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantRedeemStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantRedeemStakeResponse proto.InternalMessageInfo
+
+func (m *MsgInstantRedeemStakeResponse) GetNativeToken() types.Coin {
+	if m != nil {
+		return m.NativeToken
+	}
+	return types.Coin{}
+}
+
+func (m *MsgInstantRedeemStakeResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
 // next: 15
 type MsgRegisterHostZone struct {
 	ConnectionId                 string                      `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
@@ -562,7 +689,7 @@ func (m *MsgRegisterHostZone) Reset()         { *m = MsgRegisterHostZone{} }
 func (m *MsgRegisterHostZone) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterHostZone) ProtoMessage()    {}
 func (*MsgRegisterHostZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{10}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{12}
 }
 func (m *MsgRegisterHostZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,7 +725,7 @@ func (m *MsgRegisterHostZoneResponse) Reset()         { *m = MsgRegisterHostZone
 func (m *MsgRegisterHostZoneResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterHostZoneResponse) ProtoMessage()    {}
 func (*MsgRegisterHostZoneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{11}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{13}
 }
 func (m *MsgRegisterHostZoneResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +766,7 @@ func (m *MsgClaimUndelegatedTokens) Reset()         { *m = MsgClaimUndelegatedTo
 func (m *MsgClaimUndelegatedTokens) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimUndelegatedTokens) ProtoMessage()    {}
 func (*MsgClaimUndelegatedTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{12}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{14}
 }
 func (m *MsgClaimUndelegatedTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -703,7 +830,7 @@ func (m *MsgClaimUndelegatedTokensResponse) Reset()         { *m = MsgClaimUndel
 func (m *MsgClaimUndelegatedTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimUndelegatedTokensResponse) ProtoMessage()    {}
 func (*MsgClaimUndelegatedTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{13}
+	return fileDescriptor_9b7e09c9ad51cd54, []int{15}
 }
 func (m *MsgClaimUndelegatedTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,24 +859,35 @@ func (m *MsgClaimUndelegatedTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimUndelegatedTokensResponse proto.InternalMessageInfo
 
-type MsgRebalanceValidators struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	HostZone     string `protobuf:"bytes,2,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
-	NumRebalance uint64 `protobuf:"varint,3,opt,name=num_rebalance,json=numRebalance,proto3" json:"num_rebalance,omitempty"`
-}
-
-func (m *MsgRebalanceValidators) Reset()         { *m = MsgRebalanceValidators{} }
-func (m *MsgRebalanceValidators) String() string { return proto.CompactTextString(m) }
-func (*MsgRebalanceValidators) ProtoMessage()    {}
-func (*MsgRebalanceValidators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{14}
+// Reassigns an unclaimed user redemption record to a new owner and receiver
+// Only records with an owner can be transferred, so records created before
+// ownership was tracked remain with their original receiver
+type MsgTransferRedemptionRecord struct {
+	// Current owner of the redemption record
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// UserRedemptionRecords are keyed on {chain_id}.{epoch}.{receiver}
+	HostZoneId string `protobuf:"bytes,2,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	Epoch      uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Receiver   string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Stride address that will own the record after the transfer
+	NewOwner string `protobuf:"bytes,5,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// Host address that will receive the native tokens once the record is
+	// claimed
+	NewReceiver string `protobuf:"bytes,6,opt,name=new_receiver,json=newReceiver,proto3" json:"new_receiver,omitempty"`
+}
+
+func (m *MsgTransferRedemptionRecord) Reset()         { *m = MsgTransferRedemptionRecord{} }
+func (m *MsgTransferRedemptionRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferRedemptionRecord) ProtoMessage()    {}
+func (*MsgTransferRedemptionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{16}
 }
-func (m *MsgRebalanceValidators) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferRedemptionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRebalanceValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferRedemptionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRebalanceValidators.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferRedemptionRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -759,54 +897,75 @@ func (m *MsgRebalanceValidators) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgRebalanceValidators) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRebalanceValidators.Merge(m, src)
+func (m *MsgTransferRedemptionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferRedemptionRecord.Merge(m, src)
 }
-func (m *MsgRebalanceValidators) XXX_Size() int {
+func (m *MsgTransferRedemptionRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRebalanceValidators) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRebalanceValidators.DiscardUnknown(m)
+func (m *MsgTransferRedemptionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferRedemptionRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRebalanceValidators proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferRedemptionRecord proto.InternalMessageInfo
 
-func (m *MsgRebalanceValidators) GetCreator() string {
+func (m *MsgTransferRedemptionRecord) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgRebalanceValidators) GetHostZone() string {
+func (m *MsgTransferRedemptionRecord) GetHostZoneId() string {
 	if m != nil {
-		return m.HostZone
+		return m.HostZoneId
 	}
 	return ""
 }
 
-func (m *MsgRebalanceValidators) GetNumRebalance() uint64 {
+func (m *MsgTransferRedemptionRecord) GetEpoch() uint64 {
 	if m != nil {
-		return m.NumRebalance
+		return m.Epoch
 	}
 	return 0
 }
 
-type MsgRebalanceValidatorsResponse struct {
+func (m *MsgTransferRedemptionRecord) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
 
-func (m *MsgRebalanceValidatorsResponse) Reset()         { *m = MsgRebalanceValidatorsResponse{} }
-func (m *MsgRebalanceValidatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRebalanceValidatorsResponse) ProtoMessage()    {}
-func (*MsgRebalanceValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{15}
+func (m *MsgTransferRedemptionRecord) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
 }
-func (m *MsgRebalanceValidatorsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgTransferRedemptionRecord) GetNewReceiver() string {
+	if m != nil {
+		return m.NewReceiver
+	}
+	return ""
+}
+
+type MsgTransferRedemptionRecordResponse struct {
+}
+
+func (m *MsgTransferRedemptionRecordResponse) Reset()         { *m = MsgTransferRedemptionRecordResponse{} }
+func (m *MsgTransferRedemptionRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferRedemptionRecordResponse) ProtoMessage()    {}
+func (*MsgTransferRedemptionRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{17}
+}
+func (m *MsgTransferRedemptionRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRebalanceValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferRedemptionRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRebalanceValidatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferRedemptionRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -816,36 +975,46 @@ func (m *MsgRebalanceValidatorsResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgRebalanceValidatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRebalanceValidatorsResponse.Merge(m, src)
+func (m *MsgTransferRedemptionRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferRedemptionRecordResponse.Merge(m, src)
 }
-func (m *MsgRebalanceValidatorsResponse) XXX_Size() int {
+func (m *MsgTransferRedemptionRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRebalanceValidatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRebalanceValidatorsResponse.DiscardUnknown(m)
+func (m *MsgTransferRedemptionRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferRedemptionRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRebalanceValidatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferRedemptionRecordResponse proto.InternalMessageInfo
 
-type MsgAddValidators struct {
-	Creator    string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	HostZone   string       `protobuf:"bytes,2,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
-	Validators []*Validator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+// Cancels all or part of a redemption that has not yet been unbonded,
+// returning the escrowed stTokens to the owner of the redemption record
+// Records created before ownership was tracked have no owner and can't be
+// cancelled
+type MsgCancelRedemption struct {
+	// Owner of the redemption record
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// UserRedemptionRecords are keyed on {chain_id}.{epoch}.{receiver}
+	HostZoneId string `protobuf:"bytes,2,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	Epoch      uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Receiver   string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Number of stTokens to cancel
+	// If not specified, the full redemption is cancelled
+	StTokenAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=st_token_amount,json=stTokenAmount,proto3,customtype=cosmossdk.io/math.Int" json:"st_token_amount"`
 }
 
-func (m *MsgAddValidators) Reset()         { *m = MsgAddValidators{} }
-func (m *MsgAddValidators) String() string { return proto.CompactTextString(m) }
-func (*MsgAddValidators) ProtoMessage()    {}
-func (*MsgAddValidators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{16}
+func (m *MsgCancelRedemption) Reset()         { *m = MsgCancelRedemption{} }
+func (m *MsgCancelRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemption) ProtoMessage()    {}
+func (*MsgCancelRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{18}
 }
-func (m *MsgAddValidators) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelRedemption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddValidators.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelRedemption.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -855,54 +1024,62 @@ func (m *MsgAddValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgAddValidators) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddValidators.Merge(m, src)
+func (m *MsgCancelRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemption.Merge(m, src)
 }
-func (m *MsgAddValidators) XXX_Size() int {
+func (m *MsgCancelRedemption) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddValidators) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddValidators.DiscardUnknown(m)
+func (m *MsgCancelRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemption.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddValidators proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelRedemption proto.InternalMessageInfo
 
-func (m *MsgAddValidators) GetCreator() string {
+func (m *MsgCancelRedemption) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgAddValidators) GetHostZone() string {
+func (m *MsgCancelRedemption) GetHostZoneId() string {
 	if m != nil {
-		return m.HostZone
+		return m.HostZoneId
 	}
 	return ""
 }
 
-func (m *MsgAddValidators) GetValidators() []*Validator {
+func (m *MsgCancelRedemption) GetEpoch() uint64 {
 	if m != nil {
-		return m.Validators
+		return m.Epoch
 	}
-	return nil
+	return 0
 }
 
-type MsgAddValidatorsResponse struct {
+func (m *MsgCancelRedemption) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
 
-func (m *MsgAddValidatorsResponse) Reset()         { *m = MsgAddValidatorsResponse{} }
-func (m *MsgAddValidatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddValidatorsResponse) ProtoMessage()    {}
-func (*MsgAddValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{17}
+type MsgCancelRedemptionResponse struct {
+	StToken types.Coin `protobuf:"bytes,1,opt,name=st_token,json=stToken,proto3" json:"st_token"`
 }
-func (m *MsgAddValidatorsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCancelRedemptionResponse) Reset()         { *m = MsgCancelRedemptionResponse{} }
+func (m *MsgCancelRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemptionResponse) ProtoMessage()    {}
+func (*MsgCancelRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{19}
+}
+func (m *MsgCancelRedemptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddValidatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelRedemptionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -912,35 +1089,50 @@ func (m *MsgAddValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgAddValidatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddValidatorsResponse.Merge(m, src)
+func (m *MsgCancelRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemptionResponse.Merge(m, src)
 }
-func (m *MsgAddValidatorsResponse) XXX_Size() int {
+func (m *MsgCancelRedemptionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddValidatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddValidatorsResponse.DiscardUnknown(m)
+func (m *MsgCancelRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemptionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddValidatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelRedemptionResponse proto.InternalMessageInfo
 
-type ValidatorWeight struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Weight  uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+func (m *MsgCancelRedemptionResponse) GetStToken() types.Coin {
+	if m != nil {
+		return m.StToken
+	}
+	return types.Coin{}
 }
 
-func (m *ValidatorWeight) Reset()         { *m = ValidatorWeight{} }
-func (m *ValidatorWeight) String() string { return proto.CompactTextString(m) }
-func (*ValidatorWeight) ProtoMessage()    {}
-func (*ValidatorWeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{18}
+// Mints an x/nft token that represents an unclaimed user redemption record
+// While the record is tokenized, it's owned by the holder of the NFT, so it
+// can be sold or used as collateral with the x/nft module, and it can't be
+// claimed until it's detokenized
+type MsgTokenizeRedemptionRecord struct {
+	// Owner of the redemption record
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// UserRedemptionRecords are keyed on {chain_id}.{epoch}.{receiver}
+	HostZoneId string `protobuf:"bytes,2,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	Epoch      uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Receiver   string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 }
-func (m *ValidatorWeight) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgTokenizeRedemptionRecord) Reset()         { *m = MsgTokenizeRedemptionRecord{} }
+func (m *MsgTokenizeRedemptionRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeRedemptionRecord) ProtoMessage()    {}
+func (*MsgTokenizeRedemptionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{20}
+}
+func (m *MsgTokenizeRedemptionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTokenizeRedemptionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorWeight.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTokenizeRedemptionRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -950,50 +1142,62 @@ func (m *ValidatorWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ValidatorWeight) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorWeight.Merge(m, src)
+func (m *MsgTokenizeRedemptionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeRedemptionRecord.Merge(m, src)
 }
-func (m *ValidatorWeight) XXX_Size() int {
+func (m *MsgTokenizeRedemptionRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorWeight) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorWeight.DiscardUnknown(m)
+func (m *MsgTokenizeRedemptionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeRedemptionRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorWeight proto.InternalMessageInfo
+var xxx_messageInfo_MsgTokenizeRedemptionRecord proto.InternalMessageInfo
 
-func (m *ValidatorWeight) GetAddress() string {
+func (m *MsgTokenizeRedemptionRecord) GetCreator() string {
 	if m != nil {
-		return m.Address
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *ValidatorWeight) GetWeight() uint64 {
+func (m *MsgTokenizeRedemptionRecord) GetHostZoneId() string {
 	if m != nil {
-		return m.Weight
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *MsgTokenizeRedemptionRecord) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
 	return 0
 }
 
-type MsgChangeValidatorWeights struct {
-	Creator          string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	HostZone         string             `protobuf:"bytes,2,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
-	ValidatorWeights []*ValidatorWeight `protobuf:"bytes,3,rep,name=validator_weights,json=validatorWeights,proto3" json:"validator_weights,omitempty"`
+func (m *MsgTokenizeRedemptionRecord) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
 
-func (m *MsgChangeValidatorWeights) Reset()         { *m = MsgChangeValidatorWeights{} }
-func (m *MsgChangeValidatorWeights) String() string { return proto.CompactTextString(m) }
-func (*MsgChangeValidatorWeights) ProtoMessage()    {}
-func (*MsgChangeValidatorWeights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{19}
+type MsgTokenizeRedemptionRecordResponse struct {
+	NftId string `protobuf:"bytes,1,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
-func (m *MsgChangeValidatorWeights) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgTokenizeRedemptionRecordResponse) Reset()         { *m = MsgTokenizeRedemptionRecordResponse{} }
+func (m *MsgTokenizeRedemptionRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeRedemptionRecordResponse) ProtoMessage()    {}
+func (*MsgTokenizeRedemptionRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{21}
+}
+func (m *MsgTokenizeRedemptionRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgChangeValidatorWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTokenizeRedemptionRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgChangeValidatorWeights.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTokenizeRedemptionRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1003,54 +1207,48 @@ func (m *MsgChangeValidatorWeights) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgChangeValidatorWeights) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgChangeValidatorWeights.Merge(m, src)
+func (m *MsgTokenizeRedemptionRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeRedemptionRecordResponse.Merge(m, src)
 }
-func (m *MsgChangeValidatorWeights) XXX_Size() int {
+func (m *MsgTokenizeRedemptionRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgChangeValidatorWeights) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgChangeValidatorWeights.DiscardUnknown(m)
+func (m *MsgTokenizeRedemptionRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeRedemptionRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgChangeValidatorWeights proto.InternalMessageInfo
-
-func (m *MsgChangeValidatorWeights) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
-}
+var xxx_messageInfo_MsgTokenizeRedemptionRecordResponse proto.InternalMessageInfo
 
-func (m *MsgChangeValidatorWeights) GetHostZone() string {
+func (m *MsgTokenizeRedemptionRecordResponse) GetNftId() string {
 	if m != nil {
-		return m.HostZone
+		return m.NftId
 	}
 	return ""
 }
 
-func (m *MsgChangeValidatorWeights) GetValidatorWeights() []*ValidatorWeight {
-	if m != nil {
-		return m.ValidatorWeights
-	}
-	return nil
-}
-
-type MsgChangeValidatorWeightsResponse struct {
+// Burns the x/nft token that represents a user redemption record, and makes
+// the holder of the NFT the owner of the record
+type MsgDetokenizeRedemptionRecord struct {
+	// Holder of the redemption record NFT
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// UserRedemptionRecords are keyed on {chain_id}.{epoch}.{receiver}
+	HostZoneId string `protobuf:"bytes,2,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	Epoch      uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Receiver   string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 }
 
-func (m *MsgChangeValidatorWeightsResponse) Reset()         { *m = MsgChangeValidatorWeightsResponse{} }
-func (m *MsgChangeValidatorWeightsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgChangeValidatorWeightsResponse) ProtoMessage()    {}
-func (*MsgChangeValidatorWeightsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{20}
+func (m *MsgDetokenizeRedemptionRecord) Reset()         { *m = MsgDetokenizeRedemptionRecord{} }
+func (m *MsgDetokenizeRedemptionRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgDetokenizeRedemptionRecord) ProtoMessage()    {}
+func (*MsgDetokenizeRedemptionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{22}
 }
-func (m *MsgChangeValidatorWeightsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDetokenizeRedemptionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgChangeValidatorWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDetokenizeRedemptionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgChangeValidatorWeightsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDetokenizeRedemptionRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1060,93 +1258,61 @@ func (m *MsgChangeValidatorWeightsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgChangeValidatorWeightsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgChangeValidatorWeightsResponse.Merge(m, src)
+func (m *MsgDetokenizeRedemptionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDetokenizeRedemptionRecord.Merge(m, src)
 }
-func (m *MsgChangeValidatorWeightsResponse) XXX_Size() int {
+func (m *MsgDetokenizeRedemptionRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgChangeValidatorWeightsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgChangeValidatorWeightsResponse.DiscardUnknown(m)
+func (m *MsgDetokenizeRedemptionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDetokenizeRedemptionRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgChangeValidatorWeightsResponse proto.InternalMessageInfo
-
-type MsgDeleteValidator struct {
-	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	HostZone string `protobuf:"bytes,2,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
-	ValAddr  string `protobuf:"bytes,3,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
-}
+var xxx_messageInfo_MsgDetokenizeRedemptionRecord proto.InternalMessageInfo
 
-func (m *MsgDeleteValidator) Reset()         { *m = MsgDeleteValidator{} }
-func (m *MsgDeleteValidator) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteValidator) ProtoMessage()    {}
-func (*MsgDeleteValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{21}
-}
-func (m *MsgDeleteValidator) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgDeleteValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgDeleteValidator.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *MsgDetokenizeRedemptionRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
+	return ""
 }
-func (m *MsgDeleteValidator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteValidator.Merge(m, src)
-}
-func (m *MsgDeleteValidator) XXX_Size() int {
-	return m.Size()
-}
-func (m *MsgDeleteValidator) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteValidator.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgDeleteValidator proto.InternalMessageInfo
 
-func (m *MsgDeleteValidator) GetCreator() string {
+func (m *MsgDetokenizeRedemptionRecord) GetHostZoneId() string {
 	if m != nil {
-		return m.Creator
+		return m.HostZoneId
 	}
 	return ""
 }
 
-func (m *MsgDeleteValidator) GetHostZone() string {
+func (m *MsgDetokenizeRedemptionRecord) GetEpoch() uint64 {
 	if m != nil {
-		return m.HostZone
+		return m.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgDeleteValidator) GetValAddr() string {
+func (m *MsgDetokenizeRedemptionRecord) GetReceiver() string {
 	if m != nil {
-		return m.ValAddr
+		return m.Receiver
 	}
 	return ""
 }
 
-type MsgDeleteValidatorResponse struct {
+type MsgDetokenizeRedemptionRecordResponse struct {
 }
 
-func (m *MsgDeleteValidatorResponse) Reset()         { *m = MsgDeleteValidatorResponse{} }
-func (m *MsgDeleteValidatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteValidatorResponse) ProtoMessage()    {}
-func (*MsgDeleteValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{22}
+func (m *MsgDetokenizeRedemptionRecordResponse) Reset()         { *m = MsgDetokenizeRedemptionRecordResponse{} }
+func (m *MsgDetokenizeRedemptionRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDetokenizeRedemptionRecordResponse) ProtoMessage()    {}
+func (*MsgDetokenizeRedemptionRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{23}
 }
-func (m *MsgDeleteValidatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDetokenizeRedemptionRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDetokenizeRedemptionRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteValidatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDetokenizeRedemptionRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1156,37 +1322,39 @@ func (m *MsgDeleteValidatorResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteValidatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteValidatorResponse.Merge(m, src)
+func (m *MsgDetokenizeRedemptionRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDetokenizeRedemptionRecordResponse.Merge(m, src)
 }
-func (m *MsgDeleteValidatorResponse) XXX_Size() int {
+func (m *MsgDetokenizeRedemptionRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteValidatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteValidatorResponse.DiscardUnknown(m)
+func (m *MsgDetokenizeRedemptionRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDetokenizeRedemptionRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteValidatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDetokenizeRedemptionRecordResponse proto.InternalMessageInfo
 
-type MsgRestoreInterchainAccount struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId      string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
-	AccountOwner string `protobuf:"bytes,4,opt,name=account_owner,json=accountOwner,proto3" json:"account_owner,omitempty"`
+// Liquid stakes across each host zone in a basket and mints the basket token
+type MsgLiquidStakeBasket struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	BasketId string `protobuf:"bytes,2,opt,name=basket_id,json=basketId,proto3" json:"basket_id,omitempty"`
+	// Number of basket tokens to mint
+	// The native tokens required for each component are pulled from the creator
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *MsgRestoreInterchainAccount) Reset()         { *m = MsgRestoreInterchainAccount{} }
-func (m *MsgRestoreInterchainAccount) String() string { return proto.CompactTextString(m) }
-func (*MsgRestoreInterchainAccount) ProtoMessage()    {}
-func (*MsgRestoreInterchainAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{23}
+func (m *MsgLiquidStakeBasket) Reset()         { *m = MsgLiquidStakeBasket{} }
+func (m *MsgLiquidStakeBasket) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidStakeBasket) ProtoMessage()    {}
+func (*MsgLiquidStakeBasket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{24}
 }
-func (m *MsgRestoreInterchainAccount) XXX_Unmarshal(b []byte) error {
+func (m *MsgLiquidStakeBasket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRestoreInterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgLiquidStakeBasket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRestoreInterchainAccount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgLiquidStakeBasket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1196,61 +1364,104 @@ func (m *MsgRestoreInterchainAccount) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgRestoreInterchainAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRestoreInterchainAccount.Merge(m, src)
+func (m *MsgLiquidStakeBasket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidStakeBasket.Merge(m, src)
 }
-func (m *MsgRestoreInterchainAccount) XXX_Size() int {
+func (m *MsgLiquidStakeBasket) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRestoreInterchainAccount) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRestoreInterchainAccount.DiscardUnknown(m)
+func (m *MsgLiquidStakeBasket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidStakeBasket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRestoreInterchainAccount proto.InternalMessageInfo
+var xxx_messageInfo_MsgLiquidStakeBasket proto.InternalMessageInfo
 
-func (m *MsgRestoreInterchainAccount) GetCreator() string {
+func (m *MsgLiquidStakeBasket) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgRestoreInterchainAccount) GetChainId() string {
+func (m *MsgLiquidStakeBasket) GetBasketId() string {
 	if m != nil {
-		return m.ChainId
+		return m.BasketId
 	}
 	return ""
 }
 
-func (m *MsgRestoreInterchainAccount) GetConnectionId() string {
+type MsgLiquidStakeBasketResponse struct {
+	BasketToken types.Coin `protobuf:"bytes,1,opt,name=basket_token,json=basketToken,proto3" json:"basket_token"`
+	// Native tokens that were liquid staked for each component
+	NativeTokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=native_tokens,json=nativeTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"native_tokens"`
+}
+
+func (m *MsgLiquidStakeBasketResponse) Reset()         { *m = MsgLiquidStakeBasketResponse{} }
+func (m *MsgLiquidStakeBasketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidStakeBasketResponse) ProtoMessage()    {}
+func (*MsgLiquidStakeBasketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{25}
+}
+func (m *MsgLiquidStakeBasketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidStakeBasketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidStakeBasketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiquidStakeBasketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidStakeBasketResponse.Merge(m, src)
+}
+func (m *MsgLiquidStakeBasketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidStakeBasketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidStakeBasketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiquidStakeBasketResponse proto.InternalMessageInfo
+
+func (m *MsgLiquidStakeBasketResponse) GetBasketToken() types.Coin {
 	if m != nil {
-		return m.ConnectionId
+		return m.BasketToken
 	}
-	return ""
+	return types.Coin{}
 }
 
-func (m *MsgRestoreInterchainAccount) GetAccountOwner() string {
+func (m *MsgLiquidStakeBasketResponse) GetNativeTokens() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.AccountOwner
+		return m.NativeTokens
 	}
-	return ""
+	return nil
 }
 
-type MsgRestoreInterchainAccountResponse struct {
+// The host address that should receive unbonded tokens from one component of a
+// basket redemption
+type BasketRedemptionReceiver struct {
+	HostZoneId string `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	Receiver   string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
 }
 
-func (m *MsgRestoreInterchainAccountResponse) Reset()         { *m = MsgRestoreInterchainAccountResponse{} }
-func (m *MsgRestoreInterchainAccountResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRestoreInterchainAccountResponse) ProtoMessage()    {}
-func (*MsgRestoreInterchainAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{24}
+func (m *BasketRedemptionReceiver) Reset()         { *m = BasketRedemptionReceiver{} }
+func (m *BasketRedemptionReceiver) String() string { return proto.CompactTextString(m) }
+func (*BasketRedemptionReceiver) ProtoMessage()    {}
+func (*BasketRedemptionReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{26}
 }
-func (m *MsgRestoreInterchainAccountResponse) XXX_Unmarshal(b []byte) error {
+func (m *BasketRedemptionReceiver) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRestoreInterchainAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BasketRedemptionReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRestoreInterchainAccountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BasketRedemptionReceiver.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1260,35 +1471,54 @@ func (m *MsgRestoreInterchainAccountResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgRestoreInterchainAccountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRestoreInterchainAccountResponse.Merge(m, src)
+func (m *BasketRedemptionReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasketRedemptionReceiver.Merge(m, src)
 }
-func (m *MsgRestoreInterchainAccountResponse) XXX_Size() int {
+func (m *BasketRedemptionReceiver) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRestoreInterchainAccountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRestoreInterchainAccountResponse.DiscardUnknown(m)
+func (m *BasketRedemptionReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasketRedemptionReceiver.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRestoreInterchainAccountResponse proto.InternalMessageInfo
+var xxx_messageInfo_BasketRedemptionReceiver proto.InternalMessageInfo
 
-type MsgCloseDelegationChannel struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+func (m *BasketRedemptionReceiver) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
 }
 
-func (m *MsgCloseDelegationChannel) Reset()         { *m = MsgCloseDelegationChannel{} }
-func (m *MsgCloseDelegationChannel) String() string { return proto.CompactTextString(m) }
-func (*MsgCloseDelegationChannel) ProtoMessage()    {}
-func (*MsgCloseDelegationChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{25}
+func (m *BasketRedemptionReceiver) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
-func (m *MsgCloseDelegationChannel) XXX_Unmarshal(b []byte) error {
+
+// Burns basket tokens and redeems the underlying stTokens from each host zone
+type MsgRedeemBasket struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	BasketId string `protobuf:"bytes,2,opt,name=basket_id,json=basketId,proto3" json:"basket_id,omitempty"`
+	// Number of basket tokens to redeem
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// Receiver for each host zone in the basket
+	Receivers []BasketRedemptionReceiver `protobuf:"bytes,4,rep,name=receivers,proto3" json:"receivers"`
+}
+
+func (m *MsgRedeemBasket) Reset()         { *m = MsgRedeemBasket{} }
+func (m *MsgRedeemBasket) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemBasket) ProtoMessage()    {}
+func (*MsgRedeemBasket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{27}
+}
+func (m *MsgRedeemBasket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCloseDelegationChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRedeemBasket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCloseDelegationChannel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRedeemBasket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1298,47 +1528,54 @@ func (m *MsgCloseDelegationChannel) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgCloseDelegationChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCloseDelegationChannel.Merge(m, src)
+func (m *MsgRedeemBasket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemBasket.Merge(m, src)
 }
-func (m *MsgCloseDelegationChannel) XXX_Size() int {
+func (m *MsgRedeemBasket) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCloseDelegationChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCloseDelegationChannel.DiscardUnknown(m)
+func (m *MsgRedeemBasket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemBasket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCloseDelegationChannel proto.InternalMessageInfo
+var xxx_messageInfo_MsgRedeemBasket proto.InternalMessageInfo
 
-func (m *MsgCloseDelegationChannel) GetCreator() string {
+func (m *MsgRedeemBasket) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCloseDelegationChannel) GetChainId() string {
+func (m *MsgRedeemBasket) GetBasketId() string {
 	if m != nil {
-		return m.ChainId
+		return m.BasketId
 	}
 	return ""
 }
 
-type MsgCloseDelegationChannelResponse struct {
+func (m *MsgRedeemBasket) GetReceivers() []BasketRedemptionReceiver {
+	if m != nil {
+		return m.Receivers
+	}
+	return nil
 }
 
-func (m *MsgCloseDelegationChannelResponse) Reset()         { *m = MsgCloseDelegationChannelResponse{} }
-func (m *MsgCloseDelegationChannelResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCloseDelegationChannelResponse) ProtoMessage()    {}
-func (*MsgCloseDelegationChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{26}
+type MsgRedeemBasketResponse struct {
 }
-func (m *MsgCloseDelegationChannelResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgRedeemBasketResponse) Reset()         { *m = MsgRedeemBasketResponse{} }
+func (m *MsgRedeemBasketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemBasketResponse) ProtoMessage()    {}
+func (*MsgRedeemBasketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{28}
+}
+func (m *MsgRedeemBasketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCloseDelegationChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRedeemBasketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCloseDelegationChannelResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRedeemBasketResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1348,36 +1585,37 @@ func (m *MsgCloseDelegationChannelResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgCloseDelegationChannelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCloseDelegationChannelResponse.Merge(m, src)
+func (m *MsgRedeemBasketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemBasketResponse.Merge(m, src)
 }
-func (m *MsgCloseDelegationChannelResponse) XXX_Size() int {
+func (m *MsgRedeemBasketResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCloseDelegationChannelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCloseDelegationChannelResponse.DiscardUnknown(m)
+func (m *MsgRedeemBasketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemBasketResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCloseDelegationChannelResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRedeemBasketResponse proto.InternalMessageInfo
 
-type MsgUpdateValidatorSharesExchRate struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Valoper string `protobuf:"bytes,3,opt,name=valoper,proto3" json:"valoper,omitempty"`
+// Creates a new basket of host zones
+type MsgCreateBasket struct {
+	Authority  string            `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	BasketId   string            `protobuf:"bytes,2,opt,name=basket_id,json=basketId,proto3" json:"basket_id,omitempty"`
+	Components []BasketComponent `protobuf:"bytes,3,rep,name=components,proto3" json:"components"`
 }
 
-func (m *MsgUpdateValidatorSharesExchRate) Reset()         { *m = MsgUpdateValidatorSharesExchRate{} }
-func (m *MsgUpdateValidatorSharesExchRate) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateValidatorSharesExchRate) ProtoMessage()    {}
-func (*MsgUpdateValidatorSharesExchRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{27}
+func (m *MsgCreateBasket) Reset()         { *m = MsgCreateBasket{} }
+func (m *MsgCreateBasket) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBasket) ProtoMessage()    {}
+func (*MsgCreateBasket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{29}
 }
-func (m *MsgUpdateValidatorSharesExchRate) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateBasket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateValidatorSharesExchRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateBasket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateValidatorSharesExchRate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateBasket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1387,56 +1625,54 @@ func (m *MsgUpdateValidatorSharesExchRate) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateValidatorSharesExchRate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateValidatorSharesExchRate.Merge(m, src)
+func (m *MsgCreateBasket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBasket.Merge(m, src)
 }
-func (m *MsgUpdateValidatorSharesExchRate) XXX_Size() int {
+func (m *MsgCreateBasket) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateValidatorSharesExchRate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateValidatorSharesExchRate.DiscardUnknown(m)
+func (m *MsgCreateBasket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBasket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateValidatorSharesExchRate proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateBasket proto.InternalMessageInfo
 
-func (m *MsgUpdateValidatorSharesExchRate) GetCreator() string {
+func (m *MsgCreateBasket) GetAuthority() string {
 	if m != nil {
-		return m.Creator
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateValidatorSharesExchRate) GetChainId() string {
+func (m *MsgCreateBasket) GetBasketId() string {
 	if m != nil {
-		return m.ChainId
+		return m.BasketId
 	}
 	return ""
 }
 
-func (m *MsgUpdateValidatorSharesExchRate) GetValoper() string {
+func (m *MsgCreateBasket) GetComponents() []BasketComponent {
 	if m != nil {
-		return m.Valoper
+		return m.Components
 	}
-	return ""
+	return nil
 }
 
-type MsgUpdateValidatorSharesExchRateResponse struct {
+type MsgCreateBasketResponse struct {
 }
 
-func (m *MsgUpdateValidatorSharesExchRateResponse) Reset() {
-	*m = MsgUpdateValidatorSharesExchRateResponse{}
-}
-func (m *MsgUpdateValidatorSharesExchRateResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateValidatorSharesExchRateResponse) ProtoMessage()    {}
-func (*MsgUpdateValidatorSharesExchRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{28}
+func (m *MsgCreateBasketResponse) Reset()         { *m = MsgCreateBasketResponse{} }
+func (m *MsgCreateBasketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBasketResponse) ProtoMessage()    {}
+func (*MsgCreateBasketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{30}
 }
-func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateBasketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateBasketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateBasketResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1446,36 +1682,36 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse.Merge(m, src)
+func (m *MsgCreateBasketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBasketResponse.Merge(m, src)
 }
-func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_Size() int {
+func (m *MsgCreateBasketResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse.DiscardUnknown(m)
+func (m *MsgCreateBasketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBasketResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateBasketResponse proto.InternalMessageInfo
 
-type MsgCalibrateDelegation struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Valoper string `protobuf:"bytes,3,opt,name=valoper,proto3" json:"valoper,omitempty"`
+type MsgRebalanceValidators struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	HostZone     string `protobuf:"bytes,2,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
+	NumRebalance uint64 `protobuf:"varint,3,opt,name=num_rebalance,json=numRebalance,proto3" json:"num_rebalance,omitempty"`
 }
 
-func (m *MsgCalibrateDelegation) Reset()         { *m = MsgCalibrateDelegation{} }
-func (m *MsgCalibrateDelegation) String() string { return proto.CompactTextString(m) }
-func (*MsgCalibrateDelegation) ProtoMessage()    {}
-func (*MsgCalibrateDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{29}
+func (m *MsgRebalanceValidators) Reset()         { *m = MsgRebalanceValidators{} }
+func (m *MsgRebalanceValidators) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceValidators) ProtoMessage()    {}
+func (*MsgRebalanceValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{31}
 }
-func (m *MsgCalibrateDelegation) XXX_Unmarshal(b []byte) error {
+func (m *MsgRebalanceValidators) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCalibrateDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRebalanceValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCalibrateDelegation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRebalanceValidators.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1485,54 +1721,54 @@ func (m *MsgCalibrateDelegation) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgCalibrateDelegation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCalibrateDelegation.Merge(m, src)
+func (m *MsgRebalanceValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceValidators.Merge(m, src)
 }
-func (m *MsgCalibrateDelegation) XXX_Size() int {
+func (m *MsgRebalanceValidators) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCalibrateDelegation) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCalibrateDelegation.DiscardUnknown(m)
+func (m *MsgRebalanceValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceValidators.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCalibrateDelegation proto.InternalMessageInfo
+var xxx_messageInfo_MsgRebalanceValidators proto.InternalMessageInfo
 
-func (m *MsgCalibrateDelegation) GetCreator() string {
+func (m *MsgRebalanceValidators) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCalibrateDelegation) GetChainId() string {
+func (m *MsgRebalanceValidators) GetHostZone() string {
 	if m != nil {
-		return m.ChainId
+		return m.HostZone
 	}
 	return ""
 }
 
-func (m *MsgCalibrateDelegation) GetValoper() string {
+func (m *MsgRebalanceValidators) GetNumRebalance() uint64 {
 	if m != nil {
-		return m.Valoper
+		return m.NumRebalance
 	}
-	return ""
+	return 0
 }
 
-type MsgCalibrateDelegationResponse struct {
+type MsgRebalanceValidatorsResponse struct {
 }
 
-func (m *MsgCalibrateDelegationResponse) Reset()         { *m = MsgCalibrateDelegationResponse{} }
-func (m *MsgCalibrateDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCalibrateDelegationResponse) ProtoMessage()    {}
-func (*MsgCalibrateDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{30}
+func (m *MsgRebalanceValidatorsResponse) Reset()         { *m = MsgRebalanceValidatorsResponse{} }
+func (m *MsgRebalanceValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceValidatorsResponse) ProtoMessage()    {}
+func (*MsgRebalanceValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{32}
 }
-func (m *MsgCalibrateDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRebalanceValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCalibrateDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRebalanceValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCalibrateDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRebalanceValidatorsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1542,35 +1778,36 @@ func (m *MsgCalibrateDelegationResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgCalibrateDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCalibrateDelegationResponse.Merge(m, src)
+func (m *MsgRebalanceValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceValidatorsResponse.Merge(m, src)
 }
-func (m *MsgCalibrateDelegationResponse) XXX_Size() int {
+func (m *MsgRebalanceValidatorsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCalibrateDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCalibrateDelegationResponse.DiscardUnknown(m)
+func (m *MsgRebalanceValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceValidatorsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCalibrateDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRebalanceValidatorsResponse proto.InternalMessageInfo
 
-type MsgResumeHostZone struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type MsgAddValidators struct {
+	Creator    string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	HostZone   string       `protobuf:"bytes,2,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
+	Validators []*Validator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (m *MsgResumeHostZone) Reset()         { *m = MsgResumeHostZone{} }
-func (m *MsgResumeHostZone) String() string { return proto.CompactTextString(m) }
-func (*MsgResumeHostZone) ProtoMessage()    {}
-func (*MsgResumeHostZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{31}
+func (m *MsgAddValidators) Reset()         { *m = MsgAddValidators{} }
+func (m *MsgAddValidators) String() string { return proto.CompactTextString(m) }
+func (*MsgAddValidators) ProtoMessage()    {}
+func (*MsgAddValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{33}
 }
-func (m *MsgResumeHostZone) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddValidators) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgResumeHostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgResumeHostZone.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddValidators.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1580,47 +1817,54 @@ func (m *MsgResumeHostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgResumeHostZone) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgResumeHostZone.Merge(m, src)
+func (m *MsgAddValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddValidators.Merge(m, src)
 }
-func (m *MsgResumeHostZone) XXX_Size() int {
+func (m *MsgAddValidators) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgResumeHostZone) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgResumeHostZone.DiscardUnknown(m)
+func (m *MsgAddValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddValidators.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgResumeHostZone proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddValidators proto.InternalMessageInfo
 
-func (m *MsgResumeHostZone) GetCreator() string {
+func (m *MsgAddValidators) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgResumeHostZone) GetChainId() string {
+func (m *MsgAddValidators) GetHostZone() string {
 	if m != nil {
-		return m.ChainId
+		return m.HostZone
 	}
 	return ""
 }
 
-type MsgResumeHostZoneResponse struct {
+func (m *MsgAddValidators) GetValidators() []*Validator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
 
-func (m *MsgResumeHostZoneResponse) Reset()         { *m = MsgResumeHostZoneResponse{} }
-func (m *MsgResumeHostZoneResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgResumeHostZoneResponse) ProtoMessage()    {}
-func (*MsgResumeHostZoneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{32}
+type MsgAddValidatorsResponse struct {
 }
-func (m *MsgResumeHostZoneResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgAddValidatorsResponse) Reset()         { *m = MsgAddValidatorsResponse{} }
+func (m *MsgAddValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddValidatorsResponse) ProtoMessage()    {}
+func (*MsgAddValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{34}
+}
+func (m *MsgAddValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgResumeHostZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgResumeHostZoneResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddValidatorsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1630,35 +1874,35 @@ func (m *MsgResumeHostZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgResumeHostZoneResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgResumeHostZoneResponse.Merge(m, src)
+func (m *MsgAddValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddValidatorsResponse.Merge(m, src)
 }
-func (m *MsgResumeHostZoneResponse) XXX_Size() int {
+func (m *MsgAddValidatorsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgResumeHostZoneResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgResumeHostZoneResponse.DiscardUnknown(m)
+func (m *MsgAddValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddValidatorsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgResumeHostZoneResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddValidatorsResponse proto.InternalMessageInfo
 
-type MsgDeprecateHostZone struct {
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	ChainId   string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type ValidatorWeight struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight  uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (m *MsgDeprecateHostZone) Reset()         { *m = MsgDeprecateHostZone{} }
-func (m *MsgDeprecateHostZone) String() string { return proto.CompactTextString(m) }
-func (*MsgDeprecateHostZone) ProtoMessage()    {}
-func (*MsgDeprecateHostZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{33}
+func (m *ValidatorWeight) Reset()         { *m = ValidatorWeight{} }
+func (m *ValidatorWeight) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWeight) ProtoMessage()    {}
+func (*ValidatorWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{35}
 }
-func (m *MsgDeprecateHostZone) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorWeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeprecateHostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeprecateHostZone.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorWeight.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1668,47 +1912,50 @@ func (m *MsgDeprecateHostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgDeprecateHostZone) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeprecateHostZone.Merge(m, src)
+func (m *ValidatorWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWeight.Merge(m, src)
 }
-func (m *MsgDeprecateHostZone) XXX_Size() int {
+func (m *ValidatorWeight) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeprecateHostZone) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeprecateHostZone.DiscardUnknown(m)
+func (m *ValidatorWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWeight.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeprecateHostZone proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorWeight proto.InternalMessageInfo
 
-func (m *MsgDeprecateHostZone) GetAuthority() string {
+func (m *ValidatorWeight) GetAddress() string {
 	if m != nil {
-		return m.Authority
+		return m.Address
 	}
 	return ""
 }
 
-func (m *MsgDeprecateHostZone) GetChainId() string {
+func (m *ValidatorWeight) GetWeight() uint64 {
 	if m != nil {
-		return m.ChainId
+		return m.Weight
 	}
-	return ""
+	return 0
 }
 
-type MsgDeprecateHostZoneResponse struct {
+type MsgChangeValidatorWeights struct {
+	Creator          string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	HostZone         string             `protobuf:"bytes,2,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
+	ValidatorWeights []*ValidatorWeight `protobuf:"bytes,3,rep,name=validator_weights,json=validatorWeights,proto3" json:"validator_weights,omitempty"`
 }
 
-func (m *MsgDeprecateHostZoneResponse) Reset()         { *m = MsgDeprecateHostZoneResponse{} }
-func (m *MsgDeprecateHostZoneResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeprecateHostZoneResponse) ProtoMessage()    {}
-func (*MsgDeprecateHostZoneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{34}
+func (m *MsgChangeValidatorWeights) Reset()         { *m = MsgChangeValidatorWeights{} }
+func (m *MsgChangeValidatorWeights) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeValidatorWeights) ProtoMessage()    {}
+func (*MsgChangeValidatorWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{36}
 }
-func (m *MsgDeprecateHostZoneResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgChangeValidatorWeights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeprecateHostZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgChangeValidatorWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeprecateHostZoneResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgChangeValidatorWeights.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1718,80 +1965,54 @@ func (m *MsgDeprecateHostZoneResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgDeprecateHostZoneResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeprecateHostZoneResponse.Merge(m, src)
+func (m *MsgChangeValidatorWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeValidatorWeights.Merge(m, src)
 }
-func (m *MsgDeprecateHostZoneResponse) XXX_Size() int {
+func (m *MsgChangeValidatorWeights) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeprecateHostZoneResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeprecateHostZoneResponse.DiscardUnknown(m)
+func (m *MsgChangeValidatorWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeValidatorWeights.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeprecateHostZoneResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgChangeValidatorWeights proto.InternalMessageInfo
 
-// Creates a new trade route
-type MsgCreateTradeRoute struct {
-	// authority is the address that controls the module (defaults to x/gov unless
-	// overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// The chain ID of the host zone
-	HostChainId string `protobuf:"bytes,2,opt,name=host_chain_id,json=hostChainId,proto3" json:"host_chain_id,omitempty"`
-	// Connection IDs between stride and the other zones
-	StrideToRewardConnectionId string `protobuf:"bytes,3,opt,name=stride_to_reward_connection_id,json=strideToRewardConnectionId,proto3" json:"stride_to_reward_connection_id,omitempty"`
-	StrideToTradeConnectionId  string `protobuf:"bytes,4,opt,name=stride_to_trade_connection_id,json=strideToTradeConnectionId,proto3" json:"stride_to_trade_connection_id,omitempty"`
-	// Transfer channels between the host, reward, and trade zones
-	HostToRewardTransferChannelId  string `protobuf:"bytes,5,opt,name=host_to_reward_transfer_channel_id,json=hostToRewardTransferChannelId,proto3" json:"host_to_reward_transfer_channel_id,omitempty"`
-	RewardToTradeTransferChannelId string `protobuf:"bytes,6,opt,name=reward_to_trade_transfer_channel_id,json=rewardToTradeTransferChannelId,proto3" json:"reward_to_trade_transfer_channel_id,omitempty"`
-	TradeToHostTransferChannelId   string `protobuf:"bytes,7,opt,name=trade_to_host_transfer_channel_id,json=tradeToHostTransferChannelId,proto3" json:"trade_to_host_transfer_channel_id,omitempty"`
-	// ibc denom for the reward token on the host zone (e.g. ibc/usdc on dYdX)
-	RewardDenomOnHost string `protobuf:"bytes,8,opt,name=reward_denom_on_host,json=rewardDenomOnHost,proto3" json:"reward_denom_on_host,omitempty"`
-	// native denom of reward token on the reward zone (e.g. usdc on Noble)
-	RewardDenomOnReward string `protobuf:"bytes,9,opt,name=reward_denom_on_reward,json=rewardDenomOnReward,proto3" json:"reward_denom_on_reward,omitempty"`
-	// ibc denom of the reward token on the trade zone (e.g. ibc/usdc on Osmosis)
-	RewardDenomOnTrade string `protobuf:"bytes,10,opt,name=reward_denom_on_trade,json=rewardDenomOnTrade,proto3" json:"reward_denom_on_trade,omitempty"`
-	// ibc denom of the host's token on the trade zone (e.g. ibc/dydx on Osmosis)
-	HostDenomOnTrade string `protobuf:"bytes,11,opt,name=host_denom_on_trade,json=hostDenomOnTrade,proto3" json:"host_denom_on_trade,omitempty"`
-	// the host zone's native denom (e.g. dydx on dYdX)
-	HostDenomOnHost string `protobuf:"bytes,12,opt,name=host_denom_on_host,json=hostDenomOnHost,proto3" json:"host_denom_on_host,omitempty"`
-	// Deprecated, the trades are now executed off-chain via authz
-	//
-	// The osmosis pool ID
-	PoolId uint64 `protobuf:"varint,13,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // Deprecated: Do not use.
-	// Deprecated, the trades are now executed off-chain via authz
-	//
-	// Threshold defining the percentage of tokens that could be lost in the trade
-	// This captures both the loss from slippage and from a stale price on stride
-	// "0.05" means the output from the trade can be no less than a 5% deviation
-	// from the current value
-	MaxAllowedSwapLossRate string `protobuf:"bytes,14,opt,name=max_allowed_swap_loss_rate,json=maxAllowedSwapLossRate,proto3" json:"max_allowed_swap_loss_rate,omitempty"` // Deprecated: Do not use.
-	// Deprecated, the trades are now executed off-chain via authz
-	//
-	// minimum amount of reward tokens to initate a swap
-	// if not provided, defaults to 0
-	MinSwapAmount cosmossdk_io_math.Int `protobuf:"bytes,15,opt,name=min_swap_amount,json=minSwapAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_swap_amount"`
-	// Deprecated, the trades are now executed off-chain via authz
-	//
-	// maximum amount of reward tokens in a single swap
-	// if not provided, defaults to 10e24
-	MaxSwapAmount cosmossdk_io_math.Int `protobuf:"bytes,16,opt,name=max_swap_amount,json=maxSwapAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_swap_amount"`
-	// Minimum amount of reward token that must be accumulated before
-	// the tokens are transferred to the trade ICA
-	MinTransferAmount cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=min_transfer_amount,json=minTransferAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_transfer_amount"`
+func (m *MsgChangeValidatorWeights) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgCreateTradeRoute) Reset()         { *m = MsgCreateTradeRoute{} }
-func (m *MsgCreateTradeRoute) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateTradeRoute) ProtoMessage()    {}
-func (*MsgCreateTradeRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{35}
+func (m *MsgChangeValidatorWeights) GetHostZone() string {
+	if m != nil {
+		return m.HostZone
+	}
+	return ""
 }
-func (m *MsgCreateTradeRoute) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgChangeValidatorWeights) GetValidatorWeights() []*ValidatorWeight {
+	if m != nil {
+		return m.ValidatorWeights
+	}
+	return nil
+}
+
+type MsgChangeValidatorWeightsResponse struct {
+}
+
+func (m *MsgChangeValidatorWeightsResponse) Reset()         { *m = MsgChangeValidatorWeightsResponse{} }
+func (m *MsgChangeValidatorWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeValidatorWeightsResponse) ProtoMessage()    {}
+func (*MsgChangeValidatorWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{37}
+}
+func (m *MsgChangeValidatorWeightsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateTradeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgChangeValidatorWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateTradeRoute.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgChangeValidatorWeightsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1801,133 +2022,197 @@ func (m *MsgCreateTradeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateTradeRoute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateTradeRoute.Merge(m, src)
+func (m *MsgChangeValidatorWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeValidatorWeightsResponse.Merge(m, src)
 }
-func (m *MsgCreateTradeRoute) XXX_Size() int {
+func (m *MsgChangeValidatorWeightsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateTradeRoute) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateTradeRoute.DiscardUnknown(m)
+func (m *MsgChangeValidatorWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeValidatorWeightsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateTradeRoute proto.InternalMessageInfo
+var xxx_messageInfo_MsgChangeValidatorWeightsResponse proto.InternalMessageInfo
 
-func (m *MsgCreateTradeRoute) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
+type MsgDeleteValidator struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	HostZone string `protobuf:"bytes,2,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
+	ValAddr  string `protobuf:"bytes,3,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
 }
 
-func (m *MsgCreateTradeRoute) GetHostChainId() string {
-	if m != nil {
-		return m.HostChainId
+func (m *MsgDeleteValidator) Reset()         { *m = MsgDeleteValidator{} }
+func (m *MsgDeleteValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteValidator) ProtoMessage()    {}
+func (*MsgDeleteValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{38}
+}
+func (m *MsgDeleteValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgDeleteValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteValidator.Merge(m, src)
+}
+func (m *MsgDeleteValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteValidator.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTradeRoute) GetStrideToRewardConnectionId() string {
+var xxx_messageInfo_MsgDeleteValidator proto.InternalMessageInfo
+
+func (m *MsgDeleteValidator) GetCreator() string {
 	if m != nil {
-		return m.StrideToRewardConnectionId
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateTradeRoute) GetStrideToTradeConnectionId() string {
+func (m *MsgDeleteValidator) GetHostZone() string {
 	if m != nil {
-		return m.StrideToTradeConnectionId
+		return m.HostZone
 	}
 	return ""
 }
 
-func (m *MsgCreateTradeRoute) GetHostToRewardTransferChannelId() string {
+func (m *MsgDeleteValidator) GetValAddr() string {
 	if m != nil {
-		return m.HostToRewardTransferChannelId
+		return m.ValAddr
 	}
 	return ""
 }
 
-func (m *MsgCreateTradeRoute) GetRewardToTradeTransferChannelId() string {
-	if m != nil {
-		return m.RewardToTradeTransferChannelId
-	}
-	return ""
+type MsgDeleteValidatorResponse struct {
 }
 
-func (m *MsgCreateTradeRoute) GetTradeToHostTransferChannelId() string {
-	if m != nil {
-		return m.TradeToHostTransferChannelId
+func (m *MsgDeleteValidatorResponse) Reset()         { *m = MsgDeleteValidatorResponse{} }
+func (m *MsgDeleteValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteValidatorResponse) ProtoMessage()    {}
+func (*MsgDeleteValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{39}
+}
+func (m *MsgDeleteValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgDeleteValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteValidatorResponse.Merge(m, src)
+}
+func (m *MsgDeleteValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteValidatorResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTradeRoute) GetRewardDenomOnHost() string {
-	if m != nil {
-		return m.RewardDenomOnHost
+var xxx_messageInfo_MsgDeleteValidatorResponse proto.InternalMessageInfo
+
+type MsgRestoreInterchainAccount struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId      string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	AccountOwner string `protobuf:"bytes,4,opt,name=account_owner,json=accountOwner,proto3" json:"account_owner,omitempty"`
+}
+
+func (m *MsgRestoreInterchainAccount) Reset()         { *m = MsgRestoreInterchainAccount{} }
+func (m *MsgRestoreInterchainAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgRestoreInterchainAccount) ProtoMessage()    {}
+func (*MsgRestoreInterchainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{40}
+}
+func (m *MsgRestoreInterchainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestoreInterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestoreInterchainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgRestoreInterchainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreInterchainAccount.Merge(m, src)
+}
+func (m *MsgRestoreInterchainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestoreInterchainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreInterchainAccount.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTradeRoute) GetRewardDenomOnReward() string {
+var xxx_messageInfo_MsgRestoreInterchainAccount proto.InternalMessageInfo
+
+func (m *MsgRestoreInterchainAccount) GetCreator() string {
 	if m != nil {
-		return m.RewardDenomOnReward
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateTradeRoute) GetRewardDenomOnTrade() string {
+func (m *MsgRestoreInterchainAccount) GetChainId() string {
 	if m != nil {
-		return m.RewardDenomOnTrade
+		return m.ChainId
 	}
 	return ""
 }
 
-func (m *MsgCreateTradeRoute) GetHostDenomOnTrade() string {
+func (m *MsgRestoreInterchainAccount) GetConnectionId() string {
 	if m != nil {
-		return m.HostDenomOnTrade
+		return m.ConnectionId
 	}
 	return ""
 }
 
-func (m *MsgCreateTradeRoute) GetHostDenomOnHost() string {
+func (m *MsgRestoreInterchainAccount) GetAccountOwner() string {
 	if m != nil {
-		return m.HostDenomOnHost
+		return m.AccountOwner
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-func (m *MsgCreateTradeRoute) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
+type MsgRestoreInterchainAccountResponse struct {
 }
 
-// Deprecated: Do not use.
-func (m *MsgCreateTradeRoute) GetMaxAllowedSwapLossRate() string {
-	if m != nil {
-		return m.MaxAllowedSwapLossRate
-	}
-	return ""
-}
-
-type MsgCreateTradeRouteResponse struct {
-}
-
-func (m *MsgCreateTradeRouteResponse) Reset()         { *m = MsgCreateTradeRouteResponse{} }
-func (m *MsgCreateTradeRouteResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateTradeRouteResponse) ProtoMessage()    {}
-func (*MsgCreateTradeRouteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{36}
+func (m *MsgRestoreInterchainAccountResponse) Reset()         { *m = MsgRestoreInterchainAccountResponse{} }
+func (m *MsgRestoreInterchainAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRestoreInterchainAccountResponse) ProtoMessage()    {}
+func (*MsgRestoreInterchainAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{41}
 }
-func (m *MsgCreateTradeRouteResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRestoreInterchainAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateTradeRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRestoreInterchainAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateTradeRouteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRestoreInterchainAccountResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1937,41 +2222,35 @@ func (m *MsgCreateTradeRouteResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateTradeRouteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateTradeRouteResponse.Merge(m, src)
+func (m *MsgRestoreInterchainAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreInterchainAccountResponse.Merge(m, src)
 }
-func (m *MsgCreateTradeRouteResponse) XXX_Size() int {
+func (m *MsgRestoreInterchainAccountResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateTradeRouteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateTradeRouteResponse.DiscardUnknown(m)
+func (m *MsgRestoreInterchainAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreInterchainAccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateTradeRouteResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRestoreInterchainAccountResponse proto.InternalMessageInfo
 
-// Deletes a trade route
-type MsgDeleteTradeRoute struct {
-	// authority is the address that controls the module (defaults to x/gov unless
-	// overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// The reward denom of the route in it's native form (e.g. usdc)
-	RewardDenom string `protobuf:"bytes,2,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
-	// The host zone's denom in it's native form (e.g. dydx)
-	HostDenom string `protobuf:"bytes,3,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+type MsgCloseDelegationChannel struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *MsgDeleteTradeRoute) Reset()         { *m = MsgDeleteTradeRoute{} }
-func (m *MsgDeleteTradeRoute) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteTradeRoute) ProtoMessage()    {}
-func (*MsgDeleteTradeRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{37}
+func (m *MsgCloseDelegationChannel) Reset()         { *m = MsgCloseDelegationChannel{} }
+func (m *MsgCloseDelegationChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseDelegationChannel) ProtoMessage()    {}
+func (*MsgCloseDelegationChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{42}
 }
-func (m *MsgDeleteTradeRoute) XXX_Unmarshal(b []byte) error {
+func (m *MsgCloseDelegationChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteTradeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCloseDelegationChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteTradeRoute.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCloseDelegationChannel.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1981,54 +2260,47 @@ func (m *MsgDeleteTradeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteTradeRoute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteTradeRoute.Merge(m, src)
+func (m *MsgCloseDelegationChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseDelegationChannel.Merge(m, src)
 }
-func (m *MsgDeleteTradeRoute) XXX_Size() int {
+func (m *MsgCloseDelegationChannel) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteTradeRoute) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteTradeRoute.DiscardUnknown(m)
+func (m *MsgCloseDelegationChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseDelegationChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteTradeRoute proto.InternalMessageInfo
-
-func (m *MsgDeleteTradeRoute) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
-}
+var xxx_messageInfo_MsgCloseDelegationChannel proto.InternalMessageInfo
 
-func (m *MsgDeleteTradeRoute) GetRewardDenom() string {
+func (m *MsgCloseDelegationChannel) GetCreator() string {
 	if m != nil {
-		return m.RewardDenom
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgDeleteTradeRoute) GetHostDenom() string {
+func (m *MsgCloseDelegationChannel) GetChainId() string {
 	if m != nil {
-		return m.HostDenom
+		return m.ChainId
 	}
 	return ""
 }
 
-type MsgDeleteTradeRouteResponse struct {
+type MsgCloseDelegationChannelResponse struct {
 }
 
-func (m *MsgDeleteTradeRouteResponse) Reset()         { *m = MsgDeleteTradeRouteResponse{} }
-func (m *MsgDeleteTradeRouteResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteTradeRouteResponse) ProtoMessage()    {}
-func (*MsgDeleteTradeRouteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{38}
+func (m *MsgCloseDelegationChannelResponse) Reset()         { *m = MsgCloseDelegationChannelResponse{} }
+func (m *MsgCloseDelegationChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseDelegationChannelResponse) ProtoMessage()    {}
+func (*MsgCloseDelegationChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{43}
 }
-func (m *MsgDeleteTradeRouteResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCloseDelegationChannelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteTradeRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCloseDelegationChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteTradeRouteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCloseDelegationChannelResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2038,65 +2310,36 @@ func (m *MsgDeleteTradeRouteResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteTradeRouteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteTradeRouteResponse.Merge(m, src)
+func (m *MsgCloseDelegationChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseDelegationChannelResponse.Merge(m, src)
 }
-func (m *MsgDeleteTradeRouteResponse) XXX_Size() int {
+func (m *MsgCloseDelegationChannelResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteTradeRouteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteTradeRouteResponse.DiscardUnknown(m)
+func (m *MsgCloseDelegationChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseDelegationChannelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteTradeRouteResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCloseDelegationChannelResponse proto.InternalMessageInfo
 
-// Updates the config of a trade route
-type MsgUpdateTradeRoute struct {
-	// authority is the address that controls the module (defaults to x/gov unless
-	// overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// The reward denom of the route in it's native form (e.g. usdc)
-	RewardDenom string `protobuf:"bytes,2,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
-	// The host zone's denom in it's native form (e.g. dydx)
-	HostDenom string `protobuf:"bytes,3,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
-	// Deprecated, the trades are now executed off-chain via authz
-	//
-	// The osmosis pool ID
-	PoolId uint64 `protobuf:"varint,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // Deprecated: Do not use.
-	// Deprecated, the trades are now executed off-chain via authz
-	//
-	// Threshold defining the percentage of tokens that could be lost in the trade
-	// This captures both the loss from slippage and from a stale price on stride
-	// "0.05" means the output from the trade can be no less than a 5% deviation
-	// from the current value
-	MaxAllowedSwapLossRate string `protobuf:"bytes,5,opt,name=max_allowed_swap_loss_rate,json=maxAllowedSwapLossRate,proto3" json:"max_allowed_swap_loss_rate,omitempty"` // Deprecated: Do not use.
-	// Deprecated, the trades are now executed off-chain via authz
-	//
-	// minimum amount of reward tokens to initate a swap
-	// if not provided, defaults to 0
-	MinSwapAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=min_swap_amount,json=minSwapAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_swap_amount"` // Deprecated: Do not use.
-	// Deprecated, the trades are now executed off-chain via authz
-	//
-	// maximum amount of reward tokens in a single swap
-	// if not provided, defaults to 10e24
-	MaxSwapAmount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_swap_amount,json=maxSwapAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_swap_amount"` // Deprecated: Do not use.
-	// Minimum amount of reward token that must be accumulated before
-	// the tokens are transferred to the trade ICA
-	MinTransferAmount cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=min_transfer_amount,json=minTransferAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_transfer_amount"`
+type MsgUpdateValidatorSharesExchRate struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Valoper string `protobuf:"bytes,3,opt,name=valoper,proto3" json:"valoper,omitempty"`
 }
 
-func (m *MsgUpdateTradeRoute) Reset()         { *m = MsgUpdateTradeRoute{} }
-func (m *MsgUpdateTradeRoute) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateTradeRoute) ProtoMessage()    {}
-func (*MsgUpdateTradeRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{39}
+func (m *MsgUpdateValidatorSharesExchRate) Reset()         { *m = MsgUpdateValidatorSharesExchRate{} }
+func (m *MsgUpdateValidatorSharesExchRate) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorSharesExchRate) ProtoMessage()    {}
+func (*MsgUpdateValidatorSharesExchRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{44}
 }
-func (m *MsgUpdateTradeRoute) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateValidatorSharesExchRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateTradeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateValidatorSharesExchRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateTradeRoute.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateValidatorSharesExchRate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2106,70 +2349,56 @@ func (m *MsgUpdateTradeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateTradeRoute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateTradeRoute.Merge(m, src)
+func (m *MsgUpdateValidatorSharesExchRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorSharesExchRate.Merge(m, src)
 }
-func (m *MsgUpdateTradeRoute) XXX_Size() int {
+func (m *MsgUpdateValidatorSharesExchRate) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateTradeRoute) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateTradeRoute.DiscardUnknown(m)
+func (m *MsgUpdateValidatorSharesExchRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorSharesExchRate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateTradeRoute proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateValidatorSharesExchRate proto.InternalMessageInfo
 
-func (m *MsgUpdateTradeRoute) GetAuthority() string {
+func (m *MsgUpdateValidatorSharesExchRate) GetCreator() string {
 	if m != nil {
-		return m.Authority
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateTradeRoute) GetRewardDenom() string {
+func (m *MsgUpdateValidatorSharesExchRate) GetChainId() string {
 	if m != nil {
-		return m.RewardDenom
+		return m.ChainId
 	}
 	return ""
 }
 
-func (m *MsgUpdateTradeRoute) GetHostDenom() string {
+func (m *MsgUpdateValidatorSharesExchRate) GetValoper() string {
 	if m != nil {
-		return m.HostDenom
+		return m.Valoper
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-func (m *MsgUpdateTradeRoute) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
-}
-
-// Deprecated: Do not use.
-func (m *MsgUpdateTradeRoute) GetMaxAllowedSwapLossRate() string {
-	if m != nil {
-		return m.MaxAllowedSwapLossRate
-	}
-	return ""
+type MsgUpdateValidatorSharesExchRateResponse struct {
 }
 
-type MsgUpdateTradeRouteResponse struct {
+func (m *MsgUpdateValidatorSharesExchRateResponse) Reset() {
+	*m = MsgUpdateValidatorSharesExchRateResponse{}
 }
-
-func (m *MsgUpdateTradeRouteResponse) Reset()         { *m = MsgUpdateTradeRouteResponse{} }
-func (m *MsgUpdateTradeRouteResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateTradeRouteResponse) ProtoMessage()    {}
-func (*MsgUpdateTradeRouteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{40}
+func (m *MsgUpdateValidatorSharesExchRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorSharesExchRateResponse) ProtoMessage()    {}
+func (*MsgUpdateValidatorSharesExchRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{45}
 }
-func (m *MsgUpdateTradeRouteResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateTradeRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateTradeRouteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2179,44 +2408,36 @@ func (m *MsgUpdateTradeRouteResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateTradeRouteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateTradeRouteResponse.Merge(m, src)
+func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse.Merge(m, src)
 }
-func (m *MsgUpdateTradeRouteResponse) XXX_Size() int {
+func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateTradeRouteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateTradeRouteResponse.DiscardUnknown(m)
+func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateTradeRouteResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse proto.InternalMessageInfo
 
-// Registers or updates a community pool rebate by specifying the amount liquid
-// staked
-type MsgSetCommunityPoolRebate struct {
-	// Message signer (admin only)
+type MsgCalibrateDelegation struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	// Chain id of the chain whose community pool has a liquid staking rebate
-	// arrangement with stride
 	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Rebate percentage represented as a decimal (e.g. 0.2 for 20%)
-	RebateRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rebate_rate,json=rebateRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rebate_rate"`
-	// Number of stTokens recieved by the community pool after liquid staking
-	LiquidStakedStTokenAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=liquid_staked_st_token_amount,json=liquidStakedStTokenAmount,proto3,customtype=cosmossdk.io/math.Int" json:"liquid_staked_st_token_amount"`
+	Valoper string `protobuf:"bytes,3,opt,name=valoper,proto3" json:"valoper,omitempty"`
 }
 
-func (m *MsgSetCommunityPoolRebate) Reset()         { *m = MsgSetCommunityPoolRebate{} }
-func (m *MsgSetCommunityPoolRebate) String() string { return proto.CompactTextString(m) }
-func (*MsgSetCommunityPoolRebate) ProtoMessage()    {}
-func (*MsgSetCommunityPoolRebate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{41}
+func (m *MsgCalibrateDelegation) Reset()         { *m = MsgCalibrateDelegation{} }
+func (m *MsgCalibrateDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgCalibrateDelegation) ProtoMessage()    {}
+func (*MsgCalibrateDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{46}
 }
-func (m *MsgSetCommunityPoolRebate) XXX_Unmarshal(b []byte) error {
+func (m *MsgCalibrateDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetCommunityPoolRebate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCalibrateDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetCommunityPoolRebate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCalibrateDelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2226,47 +2447,54 @@ func (m *MsgSetCommunityPoolRebate) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgSetCommunityPoolRebate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetCommunityPoolRebate.Merge(m, src)
+func (m *MsgCalibrateDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCalibrateDelegation.Merge(m, src)
 }
-func (m *MsgSetCommunityPoolRebate) XXX_Size() int {
+func (m *MsgCalibrateDelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetCommunityPoolRebate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetCommunityPoolRebate.DiscardUnknown(m)
+func (m *MsgCalibrateDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCalibrateDelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetCommunityPoolRebate proto.InternalMessageInfo
+var xxx_messageInfo_MsgCalibrateDelegation proto.InternalMessageInfo
 
-func (m *MsgSetCommunityPoolRebate) GetCreator() string {
+func (m *MsgCalibrateDelegation) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSetCommunityPoolRebate) GetChainId() string {
+func (m *MsgCalibrateDelegation) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type MsgSetCommunityPoolRebateResponse struct {
+func (m *MsgCalibrateDelegation) GetValoper() string {
+	if m != nil {
+		return m.Valoper
+	}
+	return ""
 }
 
-func (m *MsgSetCommunityPoolRebateResponse) Reset()         { *m = MsgSetCommunityPoolRebateResponse{} }
-func (m *MsgSetCommunityPoolRebateResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetCommunityPoolRebateResponse) ProtoMessage()    {}
-func (*MsgSetCommunityPoolRebateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{42}
+type MsgCalibrateDelegationResponse struct {
 }
-func (m *MsgSetCommunityPoolRebateResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCalibrateDelegationResponse) Reset()         { *m = MsgCalibrateDelegationResponse{} }
+func (m *MsgCalibrateDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCalibrateDelegationResponse) ProtoMessage()    {}
+func (*MsgCalibrateDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{47}
+}
+func (m *MsgCalibrateDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetCommunityPoolRebateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCalibrateDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetCommunityPoolRebateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCalibrateDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2276,44 +2504,35 @@ func (m *MsgSetCommunityPoolRebateResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgSetCommunityPoolRebateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetCommunityPoolRebateResponse.Merge(m, src)
+func (m *MsgCalibrateDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCalibrateDelegationResponse.Merge(m, src)
 }
-func (m *MsgSetCommunityPoolRebateResponse) XXX_Size() int {
+func (m *MsgCalibrateDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetCommunityPoolRebateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetCommunityPoolRebateResponse.DiscardUnknown(m)
+func (m *MsgCalibrateDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCalibrateDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetCommunityPoolRebateResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCalibrateDelegationResponse proto.InternalMessageInfo
 
-// Grants or revokes trade permissions to a given address via authz
-type MsgToggleTradeController struct {
-	// Message signer (admin only)
+type MsgResumeHostZone struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	// Chain ID of the trade account
 	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Permission change (either grant or revoke)
-	PermissionChange AuthzPermissionChange `protobuf:"varint,3,opt,name=permission_change,json=permissionChange,proto3,enum=stride.stakeibc.AuthzPermissionChange" json:"permission_change,omitempty"`
-	// Address of trade operator
-	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	// Option to grant/revoke the legacy osmosis swap message
-	Legacy bool `protobuf:"varint,5,opt,name=legacy,proto3" json:"legacy,omitempty"`
 }
 
-func (m *MsgToggleTradeController) Reset()         { *m = MsgToggleTradeController{} }
-func (m *MsgToggleTradeController) String() string { return proto.CompactTextString(m) }
-func (*MsgToggleTradeController) ProtoMessage()    {}
-func (*MsgToggleTradeController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{43}
+func (m *MsgResumeHostZone) Reset()         { *m = MsgResumeHostZone{} }
+func (m *MsgResumeHostZone) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeHostZone) ProtoMessage()    {}
+func (*MsgResumeHostZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{48}
 }
-func (m *MsgToggleTradeController) XXX_Unmarshal(b []byte) error {
+func (m *MsgResumeHostZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgToggleTradeController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgResumeHostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgToggleTradeController.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgResumeHostZone.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2323,68 +2542,47 @@ func (m *MsgToggleTradeController) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgToggleTradeController) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgToggleTradeController.Merge(m, src)
+func (m *MsgResumeHostZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeHostZone.Merge(m, src)
 }
-func (m *MsgToggleTradeController) XXX_Size() int {
+func (m *MsgResumeHostZone) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgToggleTradeController) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgToggleTradeController.DiscardUnknown(m)
+func (m *MsgResumeHostZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeHostZone.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgToggleTradeController proto.InternalMessageInfo
+var xxx_messageInfo_MsgResumeHostZone proto.InternalMessageInfo
 
-func (m *MsgToggleTradeController) GetCreator() string {
+func (m *MsgResumeHostZone) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgToggleTradeController) GetChainId() string {
+func (m *MsgResumeHostZone) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-func (m *MsgToggleTradeController) GetPermissionChange() AuthzPermissionChange {
-	if m != nil {
-		return m.PermissionChange
-	}
-	return AuthzPermissionChange_GRANT
-}
-
-func (m *MsgToggleTradeController) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
-
-func (m *MsgToggleTradeController) GetLegacy() bool {
-	if m != nil {
-		return m.Legacy
-	}
-	return false
-}
-
-type MsgToggleTradeControllerResponse struct {
+type MsgResumeHostZoneResponse struct {
 }
 
-func (m *MsgToggleTradeControllerResponse) Reset()         { *m = MsgToggleTradeControllerResponse{} }
-func (m *MsgToggleTradeControllerResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgToggleTradeControllerResponse) ProtoMessage()    {}
-func (*MsgToggleTradeControllerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{44}
+func (m *MsgResumeHostZoneResponse) Reset()         { *m = MsgResumeHostZoneResponse{} }
+func (m *MsgResumeHostZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeHostZoneResponse) ProtoMessage()    {}
+func (*MsgResumeHostZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{49}
 }
-func (m *MsgToggleTradeControllerResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgResumeHostZoneResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgToggleTradeControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgResumeHostZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgToggleTradeControllerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgResumeHostZoneResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2394,41 +2592,35 @@ func (m *MsgToggleTradeControllerResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgToggleTradeControllerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgToggleTradeControllerResponse.Merge(m, src)
+func (m *MsgResumeHostZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeHostZoneResponse.Merge(m, src)
 }
-func (m *MsgToggleTradeControllerResponse) XXX_Size() int {
+func (m *MsgResumeHostZoneResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgToggleTradeControllerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgToggleTradeControllerResponse.DiscardUnknown(m)
+func (m *MsgResumeHostZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeHostZoneResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgToggleTradeControllerResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgResumeHostZoneResponse proto.InternalMessageInfo
 
-// Updates host zone params
-type MsgUpdateHostZoneParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless
-	// overwritten).
+type MsgDeprecateHostZone struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Chain ID of the host zone
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Max messages that can be sent in a single ICA message
-	MaxMessagesPerIcaTx uint64 `protobuf:"varint,3,opt,name=max_messages_per_ica_tx,json=maxMessagesPerIcaTx,proto3" json:"max_messages_per_ica_tx,omitempty"`
+	ChainId   string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *MsgUpdateHostZoneParams) Reset()         { *m = MsgUpdateHostZoneParams{} }
-func (m *MsgUpdateHostZoneParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateHostZoneParams) ProtoMessage()    {}
-func (*MsgUpdateHostZoneParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{45}
+func (m *MsgDeprecateHostZone) Reset()         { *m = MsgDeprecateHostZone{} }
+func (m *MsgDeprecateHostZone) String() string { return proto.CompactTextString(m) }
+func (*MsgDeprecateHostZone) ProtoMessage()    {}
+func (*MsgDeprecateHostZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{50}
 }
-func (m *MsgUpdateHostZoneParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeprecateHostZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateHostZoneParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeprecateHostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateHostZoneParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeprecateHostZone.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2438,54 +2630,47 @@ func (m *MsgUpdateHostZoneParams) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateHostZoneParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateHostZoneParams.Merge(m, src)
+func (m *MsgDeprecateHostZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprecateHostZone.Merge(m, src)
 }
-func (m *MsgUpdateHostZoneParams) XXX_Size() int {
+func (m *MsgDeprecateHostZone) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateHostZoneParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateHostZoneParams.DiscardUnknown(m)
+func (m *MsgDeprecateHostZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprecateHostZone.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateHostZoneParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeprecateHostZone proto.InternalMessageInfo
 
-func (m *MsgUpdateHostZoneParams) GetAuthority() string {
+func (m *MsgDeprecateHostZone) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateHostZoneParams) GetChainId() string {
+func (m *MsgDeprecateHostZone) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-func (m *MsgUpdateHostZoneParams) GetMaxMessagesPerIcaTx() uint64 {
-	if m != nil {
-		return m.MaxMessagesPerIcaTx
-	}
-	return 0
-}
-
-type MsgUpdateHostZoneParamsResponse struct {
+type MsgDeprecateHostZoneResponse struct {
 }
 
-func (m *MsgUpdateHostZoneParamsResponse) Reset()         { *m = MsgUpdateHostZoneParamsResponse{} }
-func (m *MsgUpdateHostZoneParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateHostZoneParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateHostZoneParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7e09c9ad51cd54, []int{46}
+func (m *MsgDeprecateHostZoneResponse) Reset()         { *m = MsgDeprecateHostZoneResponse{} }
+func (m *MsgDeprecateHostZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeprecateHostZoneResponse) ProtoMessage()    {}
+func (*MsgDeprecateHostZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{51}
 }
-func (m *MsgUpdateHostZoneParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeprecateHostZoneResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateHostZoneParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeprecateHostZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateHostZoneParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeprecateHostZoneResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2495,3830 +2680,14628 @@ func (m *MsgUpdateHostZoneParamsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateHostZoneParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateHostZoneParamsResponse.Merge(m, src)
+func (m *MsgDeprecateHostZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprecateHostZoneResponse.Merge(m, src)
 }
-func (m *MsgUpdateHostZoneParamsResponse) XXX_Size() int {
+func (m *MsgDeprecateHostZoneResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateHostZoneParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateHostZoneParamsResponse.DiscardUnknown(m)
+func (m *MsgDeprecateHostZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprecateHostZoneResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateHostZoneParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeprecateHostZoneResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("stride.stakeibc.AuthzPermissionChange", AuthzPermissionChange_name, AuthzPermissionChange_value)
-	proto.RegisterType((*MsgUpdateInnerRedemptionRateBounds)(nil), "stride.stakeibc.MsgUpdateInnerRedemptionRateBounds")
-	proto.RegisterType((*MsgUpdateInnerRedemptionRateBoundsResponse)(nil), "stride.stakeibc.MsgUpdateInnerRedemptionRateBoundsResponse")
-	proto.RegisterType((*MsgLiquidStake)(nil), "stride.stakeibc.MsgLiquidStake")
-	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "stride.stakeibc.MsgLiquidStakeResponse")
-	proto.RegisterType((*MsgLSMLiquidStake)(nil), "stride.stakeibc.MsgLSMLiquidStake")
-	proto.RegisterType((*MsgLSMLiquidStakeResponse)(nil), "stride.stakeibc.MsgLSMLiquidStakeResponse")
-	proto.RegisterType((*MsgClearBalance)(nil), "stride.stakeibc.MsgClearBalance")
-	proto.RegisterType((*MsgClearBalanceResponse)(nil), "stride.stakeibc.MsgClearBalanceResponse")
-	proto.RegisterType((*MsgRedeemStake)(nil), "stride.stakeibc.MsgRedeemStake")
-	proto.RegisterType((*MsgRedeemStakeResponse)(nil), "stride.stakeibc.MsgRedeemStakeResponse")
-	proto.RegisterType((*MsgRegisterHostZone)(nil), "stride.stakeibc.MsgRegisterHostZone")
-	proto.RegisterType((*MsgRegisterHostZoneResponse)(nil), "stride.stakeibc.MsgRegisterHostZoneResponse")
-	proto.RegisterType((*MsgClaimUndelegatedTokens)(nil), "stride.stakeibc.MsgClaimUndelegatedTokens")
-	proto.RegisterType((*MsgClaimUndelegatedTokensResponse)(nil), "stride.stakeibc.MsgClaimUndelegatedTokensResponse")
-	proto.RegisterType((*MsgRebalanceValidators)(nil), "stride.stakeibc.MsgRebalanceValidators")
-	proto.RegisterType((*MsgRebalanceValidatorsResponse)(nil), "stride.stakeibc.MsgRebalanceValidatorsResponse")
-	proto.RegisterType((*MsgAddValidators)(nil), "stride.stakeibc.MsgAddValidators")
-	proto.RegisterType((*MsgAddValidatorsResponse)(nil), "stride.stakeibc.MsgAddValidatorsResponse")
-	proto.RegisterType((*ValidatorWeight)(nil), "stride.stakeibc.ValidatorWeight")
-	proto.RegisterType((*MsgChangeValidatorWeights)(nil), "stride.stakeibc.MsgChangeValidatorWeights")
-	proto.RegisterType((*MsgChangeValidatorWeightsResponse)(nil), "stride.stakeibc.MsgChangeValidatorWeightsResponse")
-	proto.RegisterType((*MsgDeleteValidator)(nil), "stride.stakeibc.MsgDeleteValidator")
-	proto.RegisterType((*MsgDeleteValidatorResponse)(nil), "stride.stakeibc.MsgDeleteValidatorResponse")
-	proto.RegisterType((*MsgRestoreInterchainAccount)(nil), "stride.stakeibc.MsgRestoreInterchainAccount")
-	proto.RegisterType((*MsgRestoreInterchainAccountResponse)(nil), "stride.stakeibc.MsgRestoreInterchainAccountResponse")
-	proto.RegisterType((*MsgCloseDelegationChannel)(nil), "stride.stakeibc.MsgCloseDelegationChannel")
-	proto.RegisterType((*MsgCloseDelegationChannelResponse)(nil), "stride.stakeibc.MsgCloseDelegationChannelResponse")
-	proto.RegisterType((*MsgUpdateValidatorSharesExchRate)(nil), "stride.stakeibc.MsgUpdateValidatorSharesExchRate")
-	proto.RegisterType((*MsgUpdateValidatorSharesExchRateResponse)(nil), "stride.stakeibc.MsgUpdateValidatorSharesExchRateResponse")
-	proto.RegisterType((*MsgCalibrateDelegation)(nil), "stride.stakeibc.MsgCalibrateDelegation")
-	proto.RegisterType((*MsgCalibrateDelegationResponse)(nil), "stride.stakeibc.MsgCalibrateDelegationResponse")
-	proto.RegisterType((*MsgResumeHostZone)(nil), "stride.stakeibc.MsgResumeHostZone")
-	proto.RegisterType((*MsgResumeHostZoneResponse)(nil), "stride.stakeibc.MsgResumeHostZoneResponse")
-	proto.RegisterType((*MsgDeprecateHostZone)(nil), "stride.stakeibc.MsgDeprecateHostZone")
-	proto.RegisterType((*MsgDeprecateHostZoneResponse)(nil), "stride.stakeibc.MsgDeprecateHostZoneResponse")
-	proto.RegisterType((*MsgCreateTradeRoute)(nil), "stride.stakeibc.MsgCreateTradeRoute")
-	proto.RegisterType((*MsgCreateTradeRouteResponse)(nil), "stride.stakeibc.MsgCreateTradeRouteResponse")
-	proto.RegisterType((*MsgDeleteTradeRoute)(nil), "stride.stakeibc.MsgDeleteTradeRoute")
-	proto.RegisterType((*MsgDeleteTradeRouteResponse)(nil), "stride.stakeibc.MsgDeleteTradeRouteResponse")
-	proto.RegisterType((*MsgUpdateTradeRoute)(nil), "stride.stakeibc.MsgUpdateTradeRoute")
-	proto.RegisterType((*MsgUpdateTradeRouteResponse)(nil), "stride.stakeibc.MsgUpdateTradeRouteResponse")
-	proto.RegisterType((*MsgSetCommunityPoolRebate)(nil), "stride.stakeibc.MsgSetCommunityPoolRebate")
-	proto.RegisterType((*MsgSetCommunityPoolRebateResponse)(nil), "stride.stakeibc.MsgSetCommunityPoolRebateResponse")
-	proto.RegisterType((*MsgToggleTradeController)(nil), "stride.stakeibc.MsgToggleTradeController")
-	proto.RegisterType((*MsgToggleTradeControllerResponse)(nil), "stride.stakeibc.MsgToggleTradeControllerResponse")
-	proto.RegisterType((*MsgUpdateHostZoneParams)(nil), "stride.stakeibc.MsgUpdateHostZoneParams")
-	proto.RegisterType((*MsgUpdateHostZoneParamsResponse)(nil), "stride.stakeibc.MsgUpdateHostZoneParamsResponse")
+// Creates a new trade route
+type MsgCreateTradeRoute struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The chain ID of the host zone
+	HostChainId string `protobuf:"bytes,2,opt,name=host_chain_id,json=hostChainId,proto3" json:"host_chain_id,omitempty"`
+	// Connection IDs between stride and the other zones
+	StrideToRewardConnectionId string `protobuf:"bytes,3,opt,name=stride_to_reward_connection_id,json=strideToRewardConnectionId,proto3" json:"stride_to_reward_connection_id,omitempty"`
+	StrideToTradeConnectionId  string `protobuf:"bytes,4,opt,name=stride_to_trade_connection_id,json=strideToTradeConnectionId,proto3" json:"stride_to_trade_connection_id,omitempty"`
+	// Transfer channels between the host, reward, and trade zones
+	HostToRewardTransferChannelId  string `protobuf:"bytes,5,opt,name=host_to_reward_transfer_channel_id,json=hostToRewardTransferChannelId,proto3" json:"host_to_reward_transfer_channel_id,omitempty"`
+	RewardToTradeTransferChannelId string `protobuf:"bytes,6,opt,name=reward_to_trade_transfer_channel_id,json=rewardToTradeTransferChannelId,proto3" json:"reward_to_trade_transfer_channel_id,omitempty"`
+	TradeToHostTransferChannelId   string `protobuf:"bytes,7,opt,name=trade_to_host_transfer_channel_id,json=tradeToHostTransferChannelId,proto3" json:"trade_to_host_transfer_channel_id,omitempty"`
+	// ibc denom for the reward token on the host zone (e.g. ibc/usdc on dYdX)
+	RewardDenomOnHost string `protobuf:"bytes,8,opt,name=reward_denom_on_host,json=rewardDenomOnHost,proto3" json:"reward_denom_on_host,omitempty"`
+	// native denom of reward token on the reward zone (e.g. usdc on Noble)
+	RewardDenomOnReward string `protobuf:"bytes,9,opt,name=reward_denom_on_reward,json=rewardDenomOnReward,proto3" json:"reward_denom_on_reward,omitempty"`
+	// ibc denom of the reward token on the trade zone (e.g. ibc/usdc on Osmosis)
+	RewardDenomOnTrade string `protobuf:"bytes,10,opt,name=reward_denom_on_trade,json=rewardDenomOnTrade,proto3" json:"reward_denom_on_trade,omitempty"`
+	// ibc denom of the host's token on the trade zone (e.g. ibc/dydx on Osmosis)
+	HostDenomOnTrade string `protobuf:"bytes,11,opt,name=host_denom_on_trade,json=hostDenomOnTrade,proto3" json:"host_denom_on_trade,omitempty"`
+	// the host zone's native denom (e.g. dydx on dYdX)
+	HostDenomOnHost string `protobuf:"bytes,12,opt,name=host_denom_on_host,json=hostDenomOnHost,proto3" json:"host_denom_on_host,omitempty"`
+	// Deprecated, the trades are now executed off-chain via authz
+	//
+	// The osmosis pool ID
+	PoolId uint64 `protobuf:"varint,13,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // Deprecated: Do not use.
+	// Deprecated, the trades are now executed off-chain via authz
+	//
+	// Threshold defining the percentage of tokens that could be lost in the trade
+	// This captures both the loss from slippage and from a stale price on stride
+	// "0.05" means the output from the trade can be no less than a 5% deviation
+	// from the current value
+	MaxAllowedSwapLossRate string `protobuf:"bytes,14,opt,name=max_allowed_swap_loss_rate,json=maxAllowedSwapLossRate,proto3" json:"max_allowed_swap_loss_rate,omitempty"` // Deprecated: Do not use.
+	// Deprecated, the trades are now executed off-chain via authz
+	//
+	// minimum amount of reward tokens to initate a swap
+	// if not provided, defaults to 0
+	MinSwapAmount cosmossdk_io_math.Int `protobuf:"bytes,15,opt,name=min_swap_amount,json=minSwapAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_swap_amount"`
+	// Deprecated, the trades are now executed off-chain via authz
+	//
+	// maximum amount of reward tokens in a single swap
+	// if not provided, defaults to 10e24
+	MaxSwapAmount cosmossdk_io_math.Int `protobuf:"bytes,16,opt,name=max_swap_amount,json=maxSwapAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_swap_amount"`
+	// Minimum amount of reward token that must be accumulated before
+	// the tokens are transferred to the trade ICA
+	MinTransferAmount cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=min_transfer_amount,json=minTransferAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_transfer_amount"`
+	// Venue where the reward tokens are converted to the host denom
+	// The trade connection, trade channels and trade denoms are only required
+	// for the OSMOSIS_AUTHZ venue
+	TradeVenue TradeVenue `protobuf:"varint,18,opt,name=trade_venue,json=tradeVenue,proto3,enum=stride.stakeibc.TradeVenue" json:"trade_venue,omitempty"`
+	// Transfer channel from the reward zone to Stride (STRIDE_AUCTION only)
+	RewardToStrideTransferChannelId string `protobuf:"bytes,19,opt,name=reward_to_stride_transfer_channel_id,json=rewardToStrideTransferChannelId,proto3" json:"reward_to_stride_transfer_channel_id,omitempty"`
+	// ibc denom of the reward token on Stride (STRIDE_AUCTION only)
+	RewardDenomOnStride string `protobuf:"bytes,20,opt,name=reward_denom_on_stride,json=rewardDenomOnStride,proto3" json:"reward_denom_on_stride,omitempty"`
 }
 
-func init() { proto.RegisterFile("stride/stakeibc/tx.proto", fileDescriptor_9b7e09c9ad51cd54) }
-
-var fileDescriptor_9b7e09c9ad51cd54 = []byte{
-	// 2735 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x24, 0x47,
-	0x15, 0xf6, 0xac, 0x7f, 0xf7, 0xd9, 0x5e, 0xdb, 0x6d, 0x7b, 0x77, 0xdc, 0x5e, 0x7b, 0xec, 0x76,
-	0x12, 0x1c, 0x27, 0xf6, 0xc4, 0xf6, 0x86, 0x08, 0x07, 0x10, 0xb6, 0xd7, 0x2c, 0x26, 0xeb, 0x64,
-	0xd5, 0x76, 0x12, 0x29, 0x12, 0x6a, 0x6a, 0xba, 0x6b, 0xc7, 0xad, 0xed, 0x9f, 0xa1, 0xab, 0xc7,
-	0x1e, 0xe7, 0x80, 0x22, 0x2e, 0x44, 0x48, 0x08, 0x24, 0x8e, 0x48, 0x28, 0x07, 0x4e, 0x9c, 0x72,
-	0xc8, 0x81, 0x23, 0x07, 0x84, 0x22, 0x71, 0x09, 0x39, 0x00, 0x8a, 0x90, 0x89, 0x12, 0xa4, 0x45,
-	0x9c, 0xd0, 0x9e, 0x38, 0x21, 0x54, 0x55, 0xdd, 0x35, 0xdd, 0x3d, 0xd5, 0x33, 0xb3, 0x96, 0x97,
-	0xe4, 0xb2, 0xde, 0xae, 0x7a, 0xf5, 0x7e, 0xbe, 0x7a, 0xef, 0x55, 0xbd, 0x57, 0x03, 0x45, 0x12,
-	0x06, 0xb6, 0x85, 0xcb, 0x24, 0x44, 0x0f, 0xb0, 0x5d, 0x31, 0xcb, 0x61, 0x63, 0xad, 0x16, 0xf8,
-	0xa1, 0xaf, 0x8c, 0xf1, 0x99, 0xb5, 0x78, 0x46, 0x9d, 0x40, 0xae, 0xed, 0xf9, 0x65, 0xf6, 0x2f,
-	0xa7, 0x51, 0x67, 0x4c, 0x9f, 0xb8, 0x3e, 0x31, 0xd8, 0x57, 0x99, 0x7f, 0x44, 0x53, 0xf3, 0xfc,
-	0xab, 0x5c, 0x41, 0x04, 0x97, 0x4f, 0xd6, 0x2b, 0x38, 0x44, 0xeb, 0x65, 0xd3, 0xb7, 0xbd, 0x68,
-	0xfe, 0x46, 0x34, 0xef, 0x92, 0x6a, 0xf9, 0x64, 0x9d, 0xfe, 0x89, 0x26, 0xa6, 0xaa, 0x7e, 0xd5,
-	0xe7, 0x0c, 0xe9, 0xff, 0xa2, 0xd1, 0x52, 0x56, 0xcf, 0x13, 0xe4, 0xd8, 0x16, 0x0a, 0xfd, 0x80,
-	0x13, 0x68, 0xef, 0xf6, 0x82, 0x76, 0x40, 0xaa, 0xaf, 0xd7, 0x2c, 0x14, 0xe2, 0x7d, 0xcf, 0xc3,
-	0x81, 0x8e, 0x2d, 0xec, 0xd6, 0x42, 0xdb, 0xf7, 0x74, 0x14, 0xe2, 0x1d, 0xbf, 0xee, 0x59, 0x44,
-	0xd9, 0x80, 0x41, 0x33, 0xc0, 0x74, 0x5d, 0xb1, 0xb0, 0x50, 0x58, 0xbe, 0xba, 0x53, 0xfc, 0xf8,
-	0x83, 0xd5, 0xa9, 0x48, 0xf3, 0x6d, 0xcb, 0x0a, 0x30, 0x21, 0x87, 0x61, 0x60, 0x7b, 0x55, 0x3d,
-	0x26, 0x54, 0x66, 0x60, 0xc8, 0x3c, 0x46, 0xb6, 0x67, 0xd8, 0x56, 0xf1, 0x0a, 0x5d, 0xa4, 0x0f,
-	0xb2, 0xef, 0x7d, 0x4b, 0x71, 0x60, 0xc6, 0xa5, 0x13, 0x54, 0x9e, 0x11, 0x08, 0x81, 0x46, 0x80,
-	0x42, 0x5c, 0xec, 0x65, 0x02, 0xd6, 0x3f, 0x3c, 0x2f, 0xf5, 0x7c, 0x72, 0x5e, 0x9a, 0xe5, 0x42,
-	0x88, 0xf5, 0x60, 0xcd, 0xf6, 0xcb, 0x2e, 0x0a, 0x8f, 0xd7, 0xee, 0xe2, 0x2a, 0x32, 0xcf, 0x6e,
-	0x63, 0xf3, 0xe3, 0x0f, 0x56, 0x21, 0xd2, 0xe1, 0x36, 0x36, 0xf5, 0xeb, 0xae, 0xed, 0x49, 0x4c,
-	0x60, 0xd2, 0x50, 0x23, 0x47, 0x5a, 0xdf, 0xc5, 0xa5, 0xa1, 0x86, 0x44, 0xda, 0xd6, 0x4b, 0x3f,
-	0x7a, 0xf8, 0xfe, 0x4a, 0x0c, 0xc2, 0x4f, 0x1e, 0xbe, 0xbf, 0xf2, 0x8c, 0x00, 0x5f, 0x00, 0x2d,
-	0xc3, 0x58, 0x7b, 0x1e, 0x56, 0x3a, 0xef, 0x84, 0x8e, 0x49, 0xcd, 0xf7, 0x08, 0xd6, 0x7e, 0x5f,
-	0x80, 0x6b, 0x07, 0xa4, 0x7a, 0xd7, 0xfe, 0x41, 0xdd, 0xb6, 0x0e, 0xa9, 0x84, 0x0b, 0x6d, 0xd2,
-	0x8b, 0x30, 0x80, 0x5c, 0xbf, 0xee, 0x85, 0x7c, 0x8b, 0x76, 0xe6, 0x22, 0x20, 0xa6, 0x5b, 0x81,
-	0xd8, 0xf7, 0x42, 0x3d, 0x22, 0x56, 0xe6, 0x00, 0x8e, 0x7d, 0x12, 0x1a, 0x16, 0xf6, 0x7c, 0x97,
-	0xef, 0x98, 0x7e, 0x95, 0x8e, 0xdc, 0xa6, 0x03, 0x5b, 0xcb, 0x59, 0x0c, 0x6e, 0x24, 0x31, 0x48,
-	0xe8, 0xac, 0xbd, 0x53, 0x80, 0xeb, 0xe9, 0xa1, 0xd8, 0x42, 0xe5, 0x3e, 0x0c, 0x91, 0xd0, 0x08,
-	0xfd, 0x07, 0xd8, 0x63, 0xf6, 0x0c, 0x6f, 0xcc, 0xac, 0x45, 0xc6, 0xd0, 0xe8, 0x58, 0x8b, 0xa2,
-	0x63, 0x6d, 0xd7, 0xb7, 0xbd, 0x9d, 0x17, 0xa8, 0xde, 0xbf, 0xf9, 0x7b, 0x69, 0xb9, 0x6a, 0x87,
-	0xc7, 0xf5, 0xca, 0x9a, 0xe9, 0xbb, 0x51, 0x60, 0x45, 0x7f, 0x56, 0x89, 0xf5, 0xa0, 0x1c, 0x9e,
-	0xd5, 0x30, 0x61, 0x0b, 0x88, 0x3e, 0x48, 0xc2, 0x23, 0xca, 0x5b, 0xfb, 0xa4, 0x00, 0x13, 0x54,
-	0x85, 0xc3, 0x83, 0x2f, 0x08, 0xcc, 0x55, 0x98, 0x74, 0x88, 0xcb, 0x2d, 0x35, 0xec, 0x8a, 0x99,
-	0x42, 0x75, 0xdc, 0x21, 0x2e, 0xd3, 0x73, 0xbf, 0x62, 0x72, 0x70, 0x9f, 0xcb, 0x82, 0xab, 0xa6,
-	0xc0, 0x4d, 0x99, 0xa1, 0xbd, 0x0a, 0x33, 0x2d, 0x83, 0x02, 0xe1, 0x75, 0x98, 0x0a, 0x03, 0xe4,
-	0x11, 0x64, 0xb2, 0x78, 0x30, 0x7d, 0xb7, 0xe6, 0xe0, 0x10, 0x33, 0x83, 0x87, 0xf4, 0xc9, 0xc4,
-	0xdc, 0x6e, 0x34, 0xa5, 0xfd, 0xad, 0x00, 0x63, 0x07, 0xa4, 0xba, 0xeb, 0x60, 0x14, 0xec, 0x20,
-	0x07, 0x79, 0x26, 0xbe, 0xec, 0xe4, 0xd0, 0x44, 0xb1, 0xf7, 0x71, 0x50, 0x2c, 0x02, 0xe5, 0xe0,
-	0x79, 0xd8, 0xe1, 0x31, 0xad, 0xc7, 0x9f, 0x5b, 0xcf, 0x66, 0x01, 0x2b, 0x26, 0x01, 0x4b, 0x9a,
-	0xa2, 0xcd, 0xc0, 0x8d, 0xcc, 0x90, 0x08, 0xb8, 0xff, 0xf0, 0x80, 0xa3, 0x41, 0x89, 0xdd, 0xff,
-	0xbb, 0x8f, 0xcc, 0x02, 0x0b, 0x2f, 0xe3, 0x6d, 0xdf, 0x8b, 0x32, 0xa4, 0x3e, 0x44, 0x07, 0xde,
-	0xf2, 0x3d, 0xac, 0xdc, 0x82, 0xa1, 0x00, 0x9b, 0xd8, 0x3e, 0xc1, 0x41, 0x94, 0xcf, 0xf2, 0x15,
-	0x11, 0x94, 0x1d, 0x82, 0x34, 0x61, 0xa7, 0x56, 0x64, 0x31, 0x9a, 0x18, 0x11, 0xa0, 0x7c, 0x3a,
-	0x00, 0x93, 0x6c, 0xaa, 0x6a, 0x93, 0x10, 0x07, 0xdf, 0x89, 0x35, 0xfa, 0x06, 0x8c, 0x9a, 0xbe,
-	0xe7, 0x61, 0xee, 0x58, 0xf1, 0x1e, 0xef, 0x14, 0x1f, 0x9d, 0x97, 0xa6, 0xce, 0x90, 0xeb, 0x6c,
-	0x69, 0xa9, 0x69, 0x4d, 0x1f, 0x69, 0x7e, 0xef, 0x5b, 0x8a, 0x06, 0x23, 0x15, 0x6c, 0x1e, 0x6f,
-	0x6e, 0xd4, 0x02, 0x7c, 0xdf, 0x6e, 0x14, 0x47, 0x98, 0xc1, 0xa9, 0x31, 0xe5, 0x56, 0x2a, 0x05,
-	0x71, 0xb3, 0xa7, 0x1f, 0x9d, 0x97, 0x26, 0x38, 0xff, 0xe6, 0x9c, 0x96, 0xc8, 0x4c, 0xca, 0x3a,
-	0x5c, 0x6d, 0x46, 0x58, 0x3f, 0x5b, 0x34, 0xf5, 0xe8, 0xbc, 0x34, 0xce, 0x17, 0x89, 0x29, 0x4d,
-	0x1f, 0xb2, 0xa3, 0x78, 0x4b, 0xee, 0xf2, 0x40, 0xb7, 0xbb, 0xfc, 0x2a, 0xf0, 0xe8, 0xb9, 0x8f,
-	0x03, 0x23, 0x72, 0x43, 0x8a, 0x02, 0xb0, 0xf5, 0xf3, 0x8f, 0xce, 0x4b, 0x2a, 0x17, 0x28, 0x21,
-	0xd2, 0xf4, 0x89, 0x78, 0x74, 0x97, 0x0f, 0xee, 0x5b, 0xca, 0xb7, 0x61, 0xbc, 0xee, 0x55, 0x7c,
-	0xcf, 0xb2, 0xbd, 0xaa, 0x51, 0xc3, 0x81, 0xed, 0x5b, 0xc5, 0xe1, 0x85, 0xc2, 0x72, 0xdf, 0xce,
-	0xec, 0xa3, 0xf3, 0xd2, 0x0d, 0xce, 0x2c, 0x4b, 0xa1, 0xe9, 0x63, 0x62, 0xe8, 0x1e, 0x1b, 0x51,
-	0x10, 0x4c, 0xd2, 0x83, 0x37, 0x7b, 0x08, 0x8e, 0x5e, 0xf4, 0x10, 0x9c, 0x70, 0x6d, 0x2f, 0x73,
-	0xda, 0x52, 0x11, 0xa8, 0xd1, 0x22, 0xe2, 0xda, 0xc5, 0x45, 0xa0, 0x46, 0x46, 0xc4, 0x4b, 0x50,
-	0xa4, 0x09, 0xd3, 0x61, 0x29, 0xcd, 0x60, 0x5e, 0x6b, 0x60, 0x0f, 0x55, 0x1c, 0x6c, 0x15, 0xc7,
-	0x58, 0xee, 0x9a, 0x76, 0x88, 0x9b, 0xc8, 0x78, 0x7b, 0x7c, 0x52, 0xd9, 0x83, 0x92, 0xe9, 0xbb,
-	0x6e, 0xdd, 0xb3, 0xc3, 0x33, 0xa3, 0xe6, 0xfb, 0x8e, 0x11, 0x06, 0x18, 0x91, 0x7a, 0x70, 0x66,
-	0x20, 0xbe, 0x91, 0xc5, 0x71, 0xe6, 0x6a, 0x37, 0x05, 0xd9, 0x3d, 0xdf, 0x77, 0x8e, 0x22, 0xa2,
-	0x68, 0xb3, 0x95, 0x5b, 0x70, 0x83, 0x9a, 0xe8, 0x62, 0x42, 0x50, 0x15, 0x13, 0x0a, 0xb7, 0x61,
-	0x9b, 0xc8, 0x08, 0x1b, 0xc5, 0x09, 0xba, 0x29, 0x3a, 0x45, 0xe0, 0x20, 0x9a, 0xbd, 0x87, 0x83,
-	0x7d, 0x13, 0x1d, 0x35, 0xb6, 0x5e, 0x7c, 0xf7, 0xbd, 0x52, 0xcf, 0x3f, 0xdf, 0x2b, 0xf5, 0x64,
-	0xe3, 0xee, 0x66, 0x3a, 0xee, 0xd2, 0xa1, 0xa4, 0xcd, 0xc1, 0xac, 0x64, 0x58, 0x44, 0xe0, 0x79,
-	0x81, 0x65, 0xf8, 0x5d, 0x07, 0xd9, 0xee, 0xeb, 0x9e, 0x85, 0x1d, 0x5c, 0x45, 0x21, 0xb6, 0xd8,
-	0x91, 0x71, 0xb1, 0x7b, 0xdb, 0x02, 0x8c, 0x88, 0x54, 0xd3, 0x4c, 0xcf, 0x10, 0x67, 0x9b, 0x7d,
-	0x4b, 0x99, 0x82, 0x7e, 0x5c, 0xf3, 0xcd, 0x63, 0x96, 0x88, 0xfa, 0x74, 0xfe, 0xa1, 0xa8, 0x89,
-	0x2c, 0xd4, 0xcf, 0x33, 0x94, 0xc8, 0x35, 0x9b, 0x59, 0x9b, 0xb5, 0x74, 0x0a, 0x96, 0x29, 0xff,
-	0xdd, 0xbe, 0xa1, 0xbe, 0xf1, 0x7e, 0x6d, 0x09, 0x16, 0x73, 0x49, 0x04, 0x0a, 0xbf, 0x2b, 0x44,
-	0x29, 0xaa, 0xc2, 0xb3, 0xf6, 0x1b, 0xf1, 0x35, 0xf7, 0x62, 0x10, 0xa4, 0xb2, 0xed, 0x95, 0x4c,
-	0xb6, 0x5d, 0x82, 0x51, 0xaf, 0xee, 0x1a, 0x41, 0x2c, 0x2b, 0x42, 0x61, 0xc4, 0xab, 0xbb, 0x42,
-	0xfe, 0xd6, 0x0b, 0x59, 0x83, 0x4b, 0xe9, 0x4d, 0x6e, 0xd1, 0x53, 0x5b, 0x80, 0x79, 0xf9, 0x8c,
-	0x30, 0xf2, 0x8f, 0x05, 0x18, 0x3f, 0x20, 0xd5, 0x6d, 0xcb, 0x7a, 0x92, 0xe6, 0x6d, 0x01, 0x88,
-	0x22, 0x81, 0x14, 0x7b, 0x17, 0x7a, 0x97, 0x87, 0x37, 0xd4, 0xb5, 0x4c, 0x55, 0xb3, 0x26, 0x34,
-	0xd0, 0x13, 0xd4, 0x5b, 0x2b, 0x59, 0xab, 0x67, 0x92, 0x56, 0xa7, 0x14, 0xd7, 0x54, 0x28, 0x66,
-	0xc7, 0x84, 0xa5, 0xf7, 0x61, 0x4c, 0x8c, 0xbe, 0x89, 0xed, 0xea, 0x71, 0xa8, 0xbc, 0x0c, 0x83,
-	0x71, 0x88, 0x72, 0x3b, 0x17, 0x3f, 0xfe, 0x60, 0x75, 0x2e, 0xb2, 0x53, 0x10, 0x67, 0x0c, 0x8e,
-	0x56, 0x28, 0xd7, 0x61, 0xe0, 0x94, 0xb1, 0x61, 0xd6, 0xf6, 0xe9, 0xd1, 0x97, 0xf6, 0xef, 0x28,
-	0x78, 0x8e, 0x91, 0x57, 0xc5, 0x19, 0x89, 0x4f, 0x00, 0xda, 0x03, 0x98, 0x10, 0x60, 0x19, 0x5c,
-	0x85, 0x18, 0xe1, 0x85, 0x7c, 0x84, 0xb9, 0x3a, 0xfa, 0xf8, 0x49, 0x46, 0xbf, 0x4e, 0x41, 0x25,
-	0x35, 0x2a, 0x0e, 0x27, 0xe9, 0xa4, 0xc0, 0xff, 0x4f, 0x05, 0x50, 0x0e, 0x48, 0xf5, 0x36, 0xa6,
-	0x77, 0x3e, 0x41, 0x75, 0xf9, 0x80, 0x7c, 0x1d, 0x86, 0x4e, 0x90, 0xc3, 0x72, 0x6f, 0x74, 0xd9,
-	0xeb, 0x66, 0x57, 0x4f, 0x90, 0x43, 0x47, 0xb6, 0x9e, 0xcf, 0xda, 0x3f, 0x9b, 0xb4, 0x3f, 0xa3,
-	0xbc, 0x76, 0x13, 0xd4, 0xd6, 0x51, 0x61, 0xf1, 0xbf, 0x0a, 0x51, 0x9a, 0x25, 0xa1, 0x1f, 0xe0,
-	0x7d, 0x2f, 0xc4, 0x01, 0xbb, 0x8f, 0x6e, 0x9b, 0x26, 0xbb, 0x7f, 0x5d, 0xf2, 0x1d, 0x77, 0x29,
-	0x7b, 0x3f, 0xe2, 0x57, 0xba, 0xf4, 0x2d, 0x68, 0x09, 0x46, 0x11, 0x17, 0x6f, 0xf8, 0xa7, 0x5e,
-	0x7c, 0xb7, 0xd3, 0x47, 0xa2, 0xc1, 0xd7, 0xe8, 0xd8, 0xd6, 0x46, 0x16, 0x84, 0xc5, 0x74, 0xa2,
-	0x91, 0xd8, 0xa3, 0x3d, 0x0d, 0x4b, 0x6d, 0x6c, 0x15, 0x98, 0xfc, 0x2a, 0x3e, 0x5a, 0x7c, 0x82,
-	0x6f, 0xf3, 0xc4, 0x4b, 0x4b, 0x01, 0x7e, 0x29, 0xb9, 0x64, 0x44, 0x3a, 0xd8, 0x21, 0xd5, 0x41,
-	0x1c, 0x0d, 0x32, 0xfd, 0x84, 0x15, 0xff, 0x28, 0xc0, 0x82, 0xa8, 0xab, 0xc5, 0xc6, 0x1f, 0x1e,
-	0xa3, 0x00, 0x93, 0xbd, 0x86, 0x79, 0xcc, 0x6e, 0x14, 0x97, 0xbc, 0xbd, 0x2f, 0x03, 0x75, 0x52,
-	0xbf, 0x86, 0x1f, 0xd3, 0xad, 0xe9, 0x8a, 0xad, 0x5b, 0x59, 0x24, 0x96, 0x5a, 0x1b, 0x08, 0x6f,
-	0x20, 0x27, 0x6d, 0x81, 0xb6, 0x02, 0xcb, 0x9d, 0xac, 0x14, 0x90, 0xfc, 0x99, 0x9f, 0x96, 0xbb,
-	0xc8, 0xb1, 0x2b, 0xf4, 0x72, 0xd6, 0x04, 0xef, 0x4b, 0x05, 0x44, 0xfb, 0x33, 0x54, 0xa2, 0x7d,
-	0x74, 0x86, 0x4a, 0x66, 0x84, 0xe9, 0x3f, 0xe3, 0xc5, 0xbe, 0x8e, 0x49, 0xdd, 0xc5, 0xa2, 0x5c,
-	0xb9, 0x64, 0x5f, 0x6e, 0x5f, 0xa1, 0xa7, 0x65, 0x6b, 0xb3, 0x2c, 0xc8, 0xd2, 0x83, 0x42, 0xdd,
-	0x5f, 0x16, 0x60, 0x8a, 0x65, 0xad, 0x5a, 0x80, 0x4d, 0x14, 0x36, 0x35, 0xfe, 0x2a, 0x5c, 0x45,
-	0xf5, 0xf0, 0xd8, 0x0f, 0xec, 0xf0, 0xac, 0xa3, 0xce, 0x4d, 0xd2, 0x76, 0x5a, 0x33, 0xb8, 0x9b,
-	0xa4, 0x54, 0xef, 0xb9, 0x74, 0x42, 0xcd, 0x28, 0xa1, 0xcd, 0xc3, 0x4d, 0xd9, 0xb8, 0xd0, 0xfe,
-	0xb7, 0x43, 0xac, 0x3a, 0xdc, 0xa5, 0x40, 0xe0, 0xa3, 0x00, 0x59, 0x58, 0xf7, 0xeb, 0xe1, 0xc5,
-	0x95, 0xd7, 0x60, 0x94, 0x9d, 0x25, 0x19, 0x0b, 0x86, 0xe9, 0xe0, 0x6e, 0xe4, 0x71, 0x3b, 0x30,
-	0xcf, 0x4f, 0x52, 0x23, 0xf4, 0x8d, 0x00, 0x9f, 0xa2, 0xc0, 0x32, 0x64, 0xa9, 0x56, 0xe5, 0x54,
-	0x47, 0xbe, 0xce, 0x68, 0x76, 0x93, 0x89, 0xf7, 0x5b, 0x30, 0xd7, 0xe4, 0x11, 0x52, 0xbd, 0x33,
-	0x2c, 0x78, 0x22, 0x9e, 0x89, 0x59, 0x30, 0xd3, 0x52, 0x1c, 0xf6, 0x81, 0x17, 0xa0, 0x4d, 0x1d,
-	0x64, 0xe5, 0x20, 0xbf, 0x25, 0xcf, 0x51, 0xca, 0x58, 0x8f, 0xa3, 0x96, 0xd2, 0xef, 0x15, 0x58,
-	0x8a, 0x59, 0xc4, 0xca, 0xc8, 0x78, 0xb1, 0xd2, 0x54, 0x9f, 0xe7, 0xa4, 0x91, 0x4a, 0xad, 0xcc,
-	0xee, 0xc0, 0x62, 0xc4, 0xc2, 0x37, 0xb8, 0x82, 0x12, 0x56, 0x83, 0xbc, 0x04, 0x62, 0x84, 0x47,
-	0x3e, 0xdd, 0xd5, 0x56, 0x46, 0x65, 0x98, 0x8a, 0xb4, 0x62, 0xf5, 0xb2, 0xe1, 0x7b, 0x8c, 0x5f,
-	0x71, 0x88, 0xad, 0x9d, 0xe0, 0x73, 0xac, 0x7e, 0x7e, 0xcd, 0xa3, 0x1c, 0x94, 0x4d, 0xb8, 0x9e,
-	0x5d, 0xc0, 0xbf, 0x8b, 0x57, 0xd9, 0x92, 0xc9, 0xd4, 0x12, 0x0e, 0x86, 0xb2, 0x0e, 0xd3, 0xd9,
-	0x45, 0x4c, 0x2b, 0x5e, 0x48, 0xeb, 0x4a, 0x6a, 0x0d, 0x33, 0x59, 0x59, 0x85, 0xc9, 0x66, 0xe9,
-	0xdf, 0x5c, 0x30, 0xcc, 0x9b, 0x69, 0xa2, 0x11, 0x10, 0x93, 0x3f, 0x07, 0x4a, 0x9a, 0x9c, 0x59,
-	0xc1, 0xfb, 0x0d, 0x63, 0x09, 0x6a, 0x66, 0xc3, 0x2c, 0x0c, 0xb2, 0xa2, 0xd1, 0xb6, 0x58, 0xc5,
-	0xdc, 0xb7, 0x73, 0xa5, 0x58, 0xd0, 0x07, 0xe8, 0xd0, 0xbe, 0xa5, 0x7c, 0x13, 0x54, 0x5a, 0x14,
-	0x22, 0xc7, 0xf1, 0x4f, 0xb1, 0x65, 0x90, 0x53, 0x54, 0x33, 0x1c, 0x9f, 0x90, 0x64, 0xf9, 0x4b,
-	0xe9, 0xaf, 0xbb, 0xa8, 0xb1, 0xcd, 0x89, 0x0e, 0x4f, 0x51, 0xed, 0xae, 0x4f, 0x08, 0x3b, 0x82,
-	0xf6, 0x60, 0x8c, 0x96, 0xe6, 0x6c, 0x5d, 0xd4, 0x21, 0x1a, 0xeb, 0xa6, 0x43, 0x34, 0xea, 0xda,
-	0x1e, 0x65, 0xb4, 0xcd, 0x1b, 0x45, 0x94, 0x0d, 0x6a, 0xa4, 0xd8, 0x8c, 0x77, 0xc7, 0x06, 0x35,
-	0x12, 0x6c, 0x0e, 0x78, 0xa3, 0x40, 0xb8, 0x47, 0xc4, 0x6a, 0xa2, 0x1b, 0x56, 0x13, 0xae, 0xed,
-	0xc5, 0x1e, 0xc3, 0xd9, 0x6d, 0x95, 0x5b, 0x73, 0x4b, 0xaa, 0xea, 0xcd, 0xa6, 0x88, 0xa8, 0xea,
-	0xcd, 0x0e, 0x27, 0xeb, 0xbd, 0x49, 0x71, 0x9b, 0xbb, 0x84, 0xcc, 0xb2, 0x08, 0x23, 0x49, 0x47,
-	0x8b, 0x13, 0x4b, 0xc2, 0xbf, 0x3a, 0xb5, 0xbc, 0x3b, 0x59, 0x98, 0x55, 0x35, 0xb2, 0x30, 0x3b,
-	0x2c, 0x2c, 0xfc, 0x6f, 0x2f, 0xb3, 0x90, 0x1f, 0xe8, 0x5f, 0x06, 0x0b, 0x93, 0xde, 0xdf, 0xf7,
-	0x98, 0xde, 0xdf, 0xdf, 0xd1, 0xfb, 0xef, 0xb4, 0x7a, 0x3f, 0x6f, 0xb6, 0x95, 0xda, 0xfa, 0x5a,
-	0xb1, 0x90, 0xf5, 0xff, 0x3b, 0xad, 0xfe, 0x3f, 0xd8, 0x2d, 0xa3, 0x2f, 0x32, 0x02, 0xb2, 0x1b,
-	0x1d, 0xf9, 0x47, 0x76, 0x58, 0xf8, 0xc7, 0x1f, 0xae, 0xb0, 0x7b, 0xc3, 0x21, 0x0e, 0x77, 0x93,
-	0xad, 0x2a, 0x1d, 0x57, 0x9e, 0xc0, 0x7d, 0xf6, 0x36, 0x0c, 0x07, 0x8c, 0x71, 0xf2, 0x85, 0x6e,
-	0xa9, 0x8b, 0x5e, 0x9e, 0x0e, 0x7c, 0x1d, 0xdb, 0x63, 0x03, 0xe6, 0x92, 0x2d, 0x3b, 0xfa, 0x27,
-	0x7a, 0xf4, 0x88, 0xb0, 0xed, 0xeb, 0x06, 0xdb, 0x19, 0xa7, 0xd9, 0xd7, 0xb3, 0x0e, 0xf9, 0x1b,
-	0x4e, 0x84, 0x71, 0xfb, 0x82, 0x58, 0x0e, 0x55, 0x54, 0x44, 0xc8, 0x27, 0x05, 0xda, 0xbf, 0xbe,
-	0xc2, 0xba, 0x15, 0x47, 0x7e, 0xb5, 0xea, 0xe0, 0xf8, 0xb8, 0x0f, 0x03, 0xdf, 0x71, 0x70, 0x70,
-	0xd9, 0x60, 0x1f, 0xc2, 0x44, 0x0d, 0x07, 0xae, 0x4d, 0x08, 0x7b, 0x94, 0x61, 0x95, 0x3a, 0x83,
-	0xfc, 0xda, 0xc6, 0x33, 0x2d, 0x5d, 0x82, 0xed, 0x7a, 0x78, 0xfc, 0xf6, 0x3d, 0x41, 0xce, 0xeb,
-	0x7a, 0x7d, 0xbc, 0x96, 0x19, 0x51, 0x8a, 0xcd, 0xf6, 0x49, 0xf4, 0x3a, 0x92, 0xe8, 0x8d, 0x38,
-	0x6c, 0xbb, 0x58, 0x94, 0x0e, 0xe9, 0xd1, 0x57, 0x87, 0x82, 0x4c, 0x8a, 0x84, 0xa6, 0xb1, 0x52,
-	0x4b, 0x3a, 0x27, 0xa0, 0xfc, 0x4b, 0x81, 0xbd, 0xb1, 0x70, 0xc7, 0x8e, 0xaf, 0x8c, 0xf7, 0x50,
-	0x80, 0x5c, 0xf2, 0x04, 0x6e, 0xb5, 0xed, 0x7a, 0xb5, 0xbd, 0xf9, 0xbd, 0xda, 0xcd, 0xd6, 0x68,
-	0x5d, 0x68, 0x8d, 0xd6, 0xb4, 0xf6, 0xda, 0x22, 0x94, 0x72, 0xa6, 0x62, 0xe3, 0x57, 0xd6, 0x60,
-	0x5a, 0xba, 0x63, 0xca, 0x55, 0xe8, 0xbf, 0xa3, 0x6f, 0xbf, 0x7a, 0x34, 0xde, 0xa3, 0x00, 0x0c,
-	0xe8, 0x7b, 0x6f, 0xbc, 0xf6, 0xca, 0xde, 0x78, 0x61, 0xe3, 0xc7, 0x53, 0xd0, 0x7b, 0x40, 0xaa,
-	0xca, 0x9b, 0x30, 0x9c, 0x7c, 0x9c, 0x2c, 0xb5, 0xf8, 0x41, 0xfa, 0x0d, 0x55, 0xfd, 0x4a, 0x07,
-	0x02, 0xf1, 0x04, 0xf8, 0x7d, 0xb8, 0x96, 0x79, 0xf8, 0xd4, 0xa4, 0x4b, 0x53, 0x34, 0xea, 0x4a,
-	0x67, 0x1a, 0x21, 0xe1, 0x4d, 0x18, 0x4e, 0xbe, 0x99, 0x49, 0x55, 0x4f, 0x10, 0xc8, 0x55, 0x97,
-	0xbc, 0x3d, 0x29, 0xf7, 0x61, 0xbc, 0xe5, 0xdd, 0xe9, 0x29, 0xf9, 0xe2, 0x34, 0x95, 0xfa, 0x7c,
-	0x37, 0x54, 0x42, 0x4e, 0x03, 0xae, 0xe7, 0x74, 0xd7, 0xa5, 0x30, 0xc8, 0x69, 0xd5, 0x8d, 0xee,
-	0x69, 0x85, 0x64, 0x1f, 0x26, 0x65, 0x1d, 0xed, 0x1c, 0x84, 0x5a, 0x08, 0xd5, 0x72, 0x97, 0x84,
-	0x42, 0xe0, 0xf7, 0x60, 0x34, 0xdd, 0x5d, 0x5e, 0x94, 0x71, 0x48, 0x91, 0xa8, 0xcf, 0x76, 0x24,
-	0x11, 0xec, 0x4f, 0x61, 0x5a, 0xda, 0x78, 0xcc, 0x01, 0x52, 0xda, 0xa3, 0xcc, 0x01, 0xb2, 0x6d,
-	0x3f, 0x53, 0x31, 0x61, 0x2c, 0xdb, 0xcb, 0x5c, 0x92, 0xb1, 0xc9, 0x10, 0xa9, 0xcf, 0x75, 0x41,
-	0x24, 0x84, 0xfc, 0x10, 0x8a, 0xb9, 0xed, 0xc3, 0x1c, 0x8f, 0x93, 0x53, 0xab, 0xb7, 0x1e, 0x87,
-	0x3a, 0xed, 0xa7, 0xd2, 0x56, 0x5d, 0x8e, 0x9f, 0xca, 0x68, 0xf3, 0xfc, 0xb4, 0x5d, 0x8b, 0x4d,
-	0xf9, 0x69, 0x01, 0xe6, 0xda, 0xf7, 0xd7, 0xd6, 0x65, 0x5c, 0xdb, 0x2e, 0x51, 0xbf, 0xf6, 0xd8,
-	0x4b, 0x92, 0x71, 0x23, 0xeb, 0x6d, 0x49, 0xe3, 0x46, 0x42, 0x28, 0x8f, 0x9b, 0x36, 0x5d, 0x25,
-	0xe5, 0x2d, 0x18, 0x49, 0xfd, 0x22, 0x62, 0x41, 0x0e, 0x62, 0x93, 0x42, 0x5d, 0xee, 0x44, 0x21,
-	0x78, 0xff, 0xa2, 0x00, 0xa5, 0x4e, 0x3f, 0xcf, 0xda, 0xcc, 0xc7, 0x2a, 0x77, 0x91, 0xfa, 0xf2,
-	0x05, 0x16, 0x25, 0xcf, 0x8d, 0x4c, 0x0f, 0x4d, 0xcb, 0x71, 0xda, 0x04, 0x8d, 0xfc, 0xdc, 0x90,
-	0xb7, 0xbe, 0x68, 0x7a, 0x6f, 0x69, 0x1c, 0x49, 0xd3, 0x7b, 0x96, 0x4a, 0x9e, 0xde, 0xf3, 0x4a,
-	0x49, 0x2a, 0xa7, 0xa5, 0x8c, 0x7c, 0x2a, 0x3f, 0xee, 0x3b, 0xc9, 0xc9, 0x2b, 0xe8, 0xa8, 0x9c,
-	0x96, 0x62, 0xee, 0xa9, 0xfc, 0x2d, 0xe8, 0x24, 0x27, 0xaf, 0x30, 0xa0, 0x69, 0x20, 0xa7, 0x28,
-	0x90, 0xa2, 0x2f, 0xa7, 0x95, 0xa7, 0x81, 0xf6, 0x97, 0x64, 0xa5, 0x0e, 0xd3, 0xf2, 0x0b, 0xb2,
-	0xf4, 0x88, 0x90, 0x92, 0xaa, 0xeb, 0x5d, 0x93, 0x0a, 0xb1, 0x01, 0x4c, 0x49, 0x2f, 0x93, 0xcb,
-	0xf9, 0xb0, 0xa5, 0x29, 0xd5, 0x17, 0xba, 0xa5, 0x14, 0x32, 0x6d, 0x98, 0x68, 0xed, 0xc9, 0x3e,
-	0x2d, 0xf7, 0x87, 0x0c, 0x99, 0xba, 0xda, 0x15, 0x59, 0x2c, 0x4a, 0xed, 0x7f, 0xe7, 0xe1, 0xfb,
-	0x2b, 0x85, 0x9d, 0xbb, 0x1f, 0x7e, 0x36, 0x5f, 0xf8, 0xe8, 0xb3, 0xf9, 0xc2, 0xa7, 0x9f, 0xcd,
-	0x17, 0x7e, 0xfe, 0xf9, 0x7c, 0xcf, 0x47, 0x9f, 0xcf, 0xf7, 0xfc, 0xf5, 0xf3, 0xf9, 0x9e, 0xb7,
-	0x36, 0x12, 0x3f, 0x79, 0x3b, 0x64, 0x9c, 0x57, 0xef, 0xa2, 0x0a, 0x29, 0x47, 0x3f, 0xfd, 0x3c,
-	0xd9, 0xdc, 0x2c, 0x37, 0x12, 0x3f, 0x54, 0x3d, 0xab, 0x61, 0x52, 0x19, 0x60, 0xbf, 0xfe, 0xdc,
-	0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x2b, 0x79, 0x74, 0xc8, 0x2a, 0x00, 0x00,
+func (m *MsgCreateTradeRoute) Reset()         { *m = MsgCreateTradeRoute{} }
+func (m *MsgCreateTradeRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTradeRoute) ProtoMessage()    {}
+func (*MsgCreateTradeRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{52}
+}
+func (m *MsgCreateTradeRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTradeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTradeRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateTradeRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTradeRoute.Merge(m, src)
+}
+func (m *MsgCreateTradeRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTradeRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTradeRoute.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgCreateTradeRoute proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
-	LSMLiquidStake(ctx context.Context, in *MsgLSMLiquidStake, opts ...grpc.CallOption) (*MsgLSMLiquidStakeResponse, error)
-	RedeemStake(ctx context.Context, in *MsgRedeemStake, opts ...grpc.CallOption) (*MsgRedeemStakeResponse, error)
-	RegisterHostZone(ctx context.Context, in *MsgRegisterHostZone, opts ...grpc.CallOption) (*MsgRegisterHostZoneResponse, error)
-	ClaimUndelegatedTokens(ctx context.Context, in *MsgClaimUndelegatedTokens, opts ...grpc.CallOption) (*MsgClaimUndelegatedTokensResponse, error)
-	RebalanceValidators(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*MsgRebalanceValidatorsResponse, error)
-	AddValidators(ctx context.Context, in *MsgAddValidators, opts ...grpc.CallOption) (*MsgAddValidatorsResponse, error)
-	ChangeValidatorWeight(ctx context.Context, in *MsgChangeValidatorWeights, opts ...grpc.CallOption) (*MsgChangeValidatorWeightsResponse, error)
-	DeleteValidator(ctx context.Context, in *MsgDeleteValidator, opts ...grpc.CallOption) (*MsgDeleteValidatorResponse, error)
-	RestoreInterchainAccount(ctx context.Context, in *MsgRestoreInterchainAccount, opts ...grpc.CallOption) (*MsgRestoreInterchainAccountResponse, error)
-	CloseDelegationChannel(ctx context.Context, in *MsgCloseDelegationChannel, opts ...grpc.CallOption) (*MsgCloseDelegationChannelResponse, error)
-	UpdateValidatorSharesExchRate(ctx context.Context, in *MsgUpdateValidatorSharesExchRate, opts ...grpc.CallOption) (*MsgUpdateValidatorSharesExchRateResponse, error)
-	CalibrateDelegation(ctx context.Context, in *MsgCalibrateDelegation, opts ...grpc.CallOption) (*MsgCalibrateDelegationResponse, error)
-	ClearBalance(ctx context.Context, in *MsgClearBalance, opts ...grpc.CallOption) (*MsgClearBalanceResponse, error)
-	UpdateInnerRedemptionRateBounds(ctx context.Context, in *MsgUpdateInnerRedemptionRateBounds, opts ...grpc.CallOption) (*MsgUpdateInnerRedemptionRateBoundsResponse, error)
-	ResumeHostZone(ctx context.Context, in *MsgResumeHostZone, opts ...grpc.CallOption) (*MsgResumeHostZoneResponse, error)
-	CreateTradeRoute(ctx context.Context, in *MsgCreateTradeRoute, opts ...grpc.CallOption) (*MsgCreateTradeRouteResponse, error)
-	DeleteTradeRoute(ctx context.Context, in *MsgDeleteTradeRoute, opts ...grpc.CallOption) (*MsgDeleteTradeRouteResponse, error)
-	UpdateTradeRoute(ctx context.Context, in *MsgUpdateTradeRoute, opts ...grpc.CallOption) (*MsgUpdateTradeRouteResponse, error)
-	SetCommunityPoolRebate(ctx context.Context, in *MsgSetCommunityPoolRebate, opts ...grpc.CallOption) (*MsgSetCommunityPoolRebateResponse, error)
-	ToggleTradeController(ctx context.Context, in *MsgToggleTradeController, opts ...grpc.CallOption) (*MsgToggleTradeControllerResponse, error)
-	UpdateHostZoneParams(ctx context.Context, in *MsgUpdateHostZoneParams, opts ...grpc.CallOption) (*MsgUpdateHostZoneParamsResponse, error)
-	DeprecateHostZone(ctx context.Context, in *MsgDeprecateHostZone, opts ...grpc.CallOption) (*MsgDeprecateHostZoneResponse, error)
+func (m *MsgCreateTradeRoute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateTradeRoute) GetHostChainId() string {
+	if m != nil {
+		return m.HostChainId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCreateTradeRoute) GetStrideToRewardConnectionId() string {
+	if m != nil {
+		return m.StrideToRewardConnectionId
+	}
+	return ""
 }
 
-func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
-	out := new(MsgLiquidStakeResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/LiquidStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetStrideToTradeConnectionId() string {
+	if m != nil {
+		return m.StrideToTradeConnectionId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) LSMLiquidStake(ctx context.Context, in *MsgLSMLiquidStake, opts ...grpc.CallOption) (*MsgLSMLiquidStakeResponse, error) {
-	out := new(MsgLSMLiquidStakeResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/LSMLiquidStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetHostToRewardTransferChannelId() string {
+	if m != nil {
+		return m.HostToRewardTransferChannelId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RedeemStake(ctx context.Context, in *MsgRedeemStake, opts ...grpc.CallOption) (*MsgRedeemStakeResponse, error) {
-	out := new(MsgRedeemStakeResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RedeemStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetRewardToTradeTransferChannelId() string {
+	if m != nil {
+		return m.RewardToTradeTransferChannelId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterHostZone(ctx context.Context, in *MsgRegisterHostZone, opts ...grpc.CallOption) (*MsgRegisterHostZoneResponse, error) {
-	out := new(MsgRegisterHostZoneResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RegisterHostZone", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetTradeToHostTransferChannelId() string {
+	if m != nil {
+		return m.TradeToHostTransferChannelId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClaimUndelegatedTokens(ctx context.Context, in *MsgClaimUndelegatedTokens, opts ...grpc.CallOption) (*MsgClaimUndelegatedTokensResponse, error) {
-	out := new(MsgClaimUndelegatedTokensResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ClaimUndelegatedTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetRewardDenomOnHost() string {
+	if m != nil {
+		return m.RewardDenomOnHost
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RebalanceValidators(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*MsgRebalanceValidatorsResponse, error) {
-	out := new(MsgRebalanceValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RebalanceValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetRewardDenomOnReward() string {
+	if m != nil {
+		return m.RewardDenomOnReward
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddValidators(ctx context.Context, in *MsgAddValidators, opts ...grpc.CallOption) (*MsgAddValidatorsResponse, error) {
-	out := new(MsgAddValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/AddValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetRewardDenomOnTrade() string {
+	if m != nil {
+		return m.RewardDenomOnTrade
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ChangeValidatorWeight(ctx context.Context, in *MsgChangeValidatorWeights, opts ...grpc.CallOption) (*MsgChangeValidatorWeightsResponse, error) {
-	out := new(MsgChangeValidatorWeightsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ChangeValidatorWeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetHostDenomOnTrade() string {
+	if m != nil {
+		return m.HostDenomOnTrade
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteValidator(ctx context.Context, in *MsgDeleteValidator, opts ...grpc.CallOption) (*MsgDeleteValidatorResponse, error) {
-	out := new(MsgDeleteValidatorResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/DeleteValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetHostDenomOnHost() string {
+	if m != nil {
+		return m.HostDenomOnHost
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RestoreInterchainAccount(ctx context.Context, in *MsgRestoreInterchainAccount, opts ...grpc.CallOption) (*MsgRestoreInterchainAccountResponse, error) {
-	out := new(MsgRestoreInterchainAccountResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RestoreInterchainAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+// Deprecated: Do not use.
+func (m *MsgCreateTradeRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CloseDelegationChannel(ctx context.Context, in *MsgCloseDelegationChannel, opts ...grpc.CallOption) (*MsgCloseDelegationChannelResponse, error) {
-	out := new(MsgCloseDelegationChannelResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/CloseDelegationChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+// Deprecated: Do not use.
+func (m *MsgCreateTradeRoute) GetMaxAllowedSwapLossRate() string {
+	if m != nil {
+		return m.MaxAllowedSwapLossRate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateValidatorSharesExchRate(ctx context.Context, in *MsgUpdateValidatorSharesExchRate, opts ...grpc.CallOption) (*MsgUpdateValidatorSharesExchRateResponse, error) {
-	out := new(MsgUpdateValidatorSharesExchRateResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/UpdateValidatorSharesExchRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetTradeVenue() TradeVenue {
+	if m != nil {
+		return m.TradeVenue
 	}
-	return out, nil
+	return TradeVenue_OSMOSIS_AUTHZ
 }
 
-func (c *msgClient) CalibrateDelegation(ctx context.Context, in *MsgCalibrateDelegation, opts ...grpc.CallOption) (*MsgCalibrateDelegationResponse, error) {
-	out := new(MsgCalibrateDelegationResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/CalibrateDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetRewardToStrideTransferChannelId() string {
+	if m != nil {
+		return m.RewardToStrideTransferChannelId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClearBalance(ctx context.Context, in *MsgClearBalance, opts ...grpc.CallOption) (*MsgClearBalanceResponse, error) {
-	out := new(MsgClearBalanceResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ClearBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRoute) GetRewardDenomOnStride() string {
+	if m != nil {
+		return m.RewardDenomOnStride
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateInnerRedemptionRateBounds(ctx context.Context, in *MsgUpdateInnerRedemptionRateBounds, opts ...grpc.CallOption) (*MsgUpdateInnerRedemptionRateBoundsResponse, error) {
-	out := new(MsgUpdateInnerRedemptionRateBoundsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/UpdateInnerRedemptionRateBounds", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgCreateTradeRouteResponse struct {
 }
 
-func (c *msgClient) ResumeHostZone(ctx context.Context, in *MsgResumeHostZone, opts ...grpc.CallOption) (*MsgResumeHostZoneResponse, error) {
-	out := new(MsgResumeHostZoneResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ResumeHostZone", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCreateTradeRouteResponse) Reset()         { *m = MsgCreateTradeRouteResponse{} }
+func (m *MsgCreateTradeRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTradeRouteResponse) ProtoMessage()    {}
+func (*MsgCreateTradeRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{53}
 }
-
-func (c *msgClient) CreateTradeRoute(ctx context.Context, in *MsgCreateTradeRoute, opts ...grpc.CallOption) (*MsgCreateTradeRouteResponse, error) {
-	out := new(MsgCreateTradeRouteResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/CreateTradeRoute", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCreateTradeRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) DeleteTradeRoute(ctx context.Context, in *MsgDeleteTradeRoute, opts ...grpc.CallOption) (*MsgDeleteTradeRouteResponse, error) {
-	out := new(MsgDeleteTradeRouteResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/DeleteTradeRoute", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTradeRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) UpdateTradeRoute(ctx context.Context, in *MsgUpdateTradeRoute, opts ...grpc.CallOption) (*MsgUpdateTradeRouteResponse, error) {
-	out := new(MsgUpdateTradeRouteResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/UpdateTradeRoute", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCreateTradeRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTradeRouteResponse.Merge(m, src)
+}
+func (m *MsgCreateTradeRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTradeRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTradeRouteResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetCommunityPoolRebate(ctx context.Context, in *MsgSetCommunityPoolRebate, opts ...grpc.CallOption) (*MsgSetCommunityPoolRebateResponse, error) {
-	out := new(MsgSetCommunityPoolRebateResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/SetCommunityPoolRebate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgCreateTradeRouteResponse proto.InternalMessageInfo
+
+// Deletes a trade route
+type MsgDeleteTradeRoute struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The reward denom of the route in it's native form (e.g. usdc)
+	RewardDenom string `protobuf:"bytes,2,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
+	// The host zone's denom in it's native form (e.g. dydx)
+	HostDenom string `protobuf:"bytes,3,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
 }
 
-func (c *msgClient) ToggleTradeController(ctx context.Context, in *MsgToggleTradeController, opts ...grpc.CallOption) (*MsgToggleTradeControllerResponse, error) {
-	out := new(MsgToggleTradeControllerResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ToggleTradeController", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteTradeRoute) Reset()         { *m = MsgDeleteTradeRoute{} }
+func (m *MsgDeleteTradeRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteTradeRoute) ProtoMessage()    {}
+func (*MsgDeleteTradeRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{54}
+}
+func (m *MsgDeleteTradeRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteTradeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteTradeRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeleteTradeRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteTradeRoute.Merge(m, src)
+}
+func (m *MsgDeleteTradeRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteTradeRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteTradeRoute.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateHostZoneParams(ctx context.Context, in *MsgUpdateHostZoneParams, opts ...grpc.CallOption) (*MsgUpdateHostZoneParamsResponse, error) {
-	out := new(MsgUpdateHostZoneParamsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/UpdateHostZoneParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeleteTradeRoute proto.InternalMessageInfo
+
+func (m *MsgDeleteTradeRoute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeprecateHostZone(ctx context.Context, in *MsgDeprecateHostZone, opts ...grpc.CallOption) (*MsgDeprecateHostZoneResponse, error) {
-	out := new(MsgDeprecateHostZoneResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/DeprecateHostZone", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteTradeRoute) GetRewardDenom() string {
+	if m != nil {
+		return m.RewardDenom
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
-	LSMLiquidStake(context.Context, *MsgLSMLiquidStake) (*MsgLSMLiquidStakeResponse, error)
-	RedeemStake(context.Context, *MsgRedeemStake) (*MsgRedeemStakeResponse, error)
-	RegisterHostZone(context.Context, *MsgRegisterHostZone) (*MsgRegisterHostZoneResponse, error)
-	ClaimUndelegatedTokens(context.Context, *MsgClaimUndelegatedTokens) (*MsgClaimUndelegatedTokensResponse, error)
-	RebalanceValidators(context.Context, *MsgRebalanceValidators) (*MsgRebalanceValidatorsResponse, error)
-	AddValidators(context.Context, *MsgAddValidators) (*MsgAddValidatorsResponse, error)
-	ChangeValidatorWeight(context.Context, *MsgChangeValidatorWeights) (*MsgChangeValidatorWeightsResponse, error)
-	DeleteValidator(context.Context, *MsgDeleteValidator) (*MsgDeleteValidatorResponse, error)
-	RestoreInterchainAccount(context.Context, *MsgRestoreInterchainAccount) (*MsgRestoreInterchainAccountResponse, error)
-	CloseDelegationChannel(context.Context, *MsgCloseDelegationChannel) (*MsgCloseDelegationChannelResponse, error)
-	UpdateValidatorSharesExchRate(context.Context, *MsgUpdateValidatorSharesExchRate) (*MsgUpdateValidatorSharesExchRateResponse, error)
-	CalibrateDelegation(context.Context, *MsgCalibrateDelegation) (*MsgCalibrateDelegationResponse, error)
-	ClearBalance(context.Context, *MsgClearBalance) (*MsgClearBalanceResponse, error)
-	UpdateInnerRedemptionRateBounds(context.Context, *MsgUpdateInnerRedemptionRateBounds) (*MsgUpdateInnerRedemptionRateBoundsResponse, error)
-	ResumeHostZone(context.Context, *MsgResumeHostZone) (*MsgResumeHostZoneResponse, error)
-	CreateTradeRoute(context.Context, *MsgCreateTradeRoute) (*MsgCreateTradeRouteResponse, error)
-	DeleteTradeRoute(context.Context, *MsgDeleteTradeRoute) (*MsgDeleteTradeRouteResponse, error)
-	UpdateTradeRoute(context.Context, *MsgUpdateTradeRoute) (*MsgUpdateTradeRouteResponse, error)
-	SetCommunityPoolRebate(context.Context, *MsgSetCommunityPoolRebate) (*MsgSetCommunityPoolRebateResponse, error)
-	ToggleTradeController(context.Context, *MsgToggleTradeController) (*MsgToggleTradeControllerResponse, error)
-	UpdateHostZoneParams(context.Context, *MsgUpdateHostZoneParams) (*MsgUpdateHostZoneParamsResponse, error)
-	DeprecateHostZone(context.Context, *MsgDeprecateHostZone) (*MsgDeprecateHostZoneResponse, error)
+func (m *MsgDeleteTradeRoute) GetHostDenom() string {
+	if m != nil {
+		return m.HostDenom
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgDeleteTradeRouteResponse struct {
 }
 
-func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
-}
-func (*UnimplementedMsgServer) LSMLiquidStake(ctx context.Context, req *MsgLSMLiquidStake) (*MsgLSMLiquidStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LSMLiquidStake not implemented")
-}
-func (*UnimplementedMsgServer) RedeemStake(ctx context.Context, req *MsgRedeemStake) (*MsgRedeemStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RedeemStake not implemented")
+func (m *MsgDeleteTradeRouteResponse) Reset()         { *m = MsgDeleteTradeRouteResponse{} }
+func (m *MsgDeleteTradeRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteTradeRouteResponse) ProtoMessage()    {}
+func (*MsgDeleteTradeRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{55}
 }
-func (*UnimplementedMsgServer) RegisterHostZone(ctx context.Context, req *MsgRegisterHostZone) (*MsgRegisterHostZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterHostZone not implemented")
+func (m *MsgDeleteTradeRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ClaimUndelegatedTokens(ctx context.Context, req *MsgClaimUndelegatedTokens) (*MsgClaimUndelegatedTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimUndelegatedTokens not implemented")
+func (m *MsgDeleteTradeRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteTradeRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RebalanceValidators(ctx context.Context, req *MsgRebalanceValidators) (*MsgRebalanceValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RebalanceValidators not implemented")
+func (m *MsgDeleteTradeRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteTradeRouteResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddValidators(ctx context.Context, req *MsgAddValidators) (*MsgAddValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddValidators not implemented")
+func (m *MsgDeleteTradeRouteResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ChangeValidatorWeight(ctx context.Context, req *MsgChangeValidatorWeights) (*MsgChangeValidatorWeightsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeValidatorWeight not implemented")
+func (m *MsgDeleteTradeRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteTradeRouteResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DeleteValidator(ctx context.Context, req *MsgDeleteValidator) (*MsgDeleteValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteValidator not implemented")
-}
-func (*UnimplementedMsgServer) RestoreInterchainAccount(ctx context.Context, req *MsgRestoreInterchainAccount) (*MsgRestoreInterchainAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreInterchainAccount not implemented")
-}
-func (*UnimplementedMsgServer) CloseDelegationChannel(ctx context.Context, req *MsgCloseDelegationChannel) (*MsgCloseDelegationChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseDelegationChannel not implemented")
-}
-func (*UnimplementedMsgServer) UpdateValidatorSharesExchRate(ctx context.Context, req *MsgUpdateValidatorSharesExchRate) (*MsgUpdateValidatorSharesExchRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorSharesExchRate not implemented")
-}
-func (*UnimplementedMsgServer) CalibrateDelegation(ctx context.Context, req *MsgCalibrateDelegation) (*MsgCalibrateDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalibrateDelegation not implemented")
-}
-func (*UnimplementedMsgServer) ClearBalance(ctx context.Context, req *MsgClearBalance) (*MsgClearBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearBalance not implemented")
-}
-func (*UnimplementedMsgServer) UpdateInnerRedemptionRateBounds(ctx context.Context, req *MsgUpdateInnerRedemptionRateBounds) (*MsgUpdateInnerRedemptionRateBoundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateInnerRedemptionRateBounds not implemented")
-}
-func (*UnimplementedMsgServer) ResumeHostZone(ctx context.Context, req *MsgResumeHostZone) (*MsgResumeHostZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResumeHostZone not implemented")
-}
-func (*UnimplementedMsgServer) CreateTradeRoute(ctx context.Context, req *MsgCreateTradeRoute) (*MsgCreateTradeRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTradeRoute not implemented")
+
+var xxx_messageInfo_MsgDeleteTradeRouteResponse proto.InternalMessageInfo
+
+// Updates the config of a trade route
+type MsgUpdateTradeRoute struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The reward denom of the route in it's native form (e.g. usdc)
+	RewardDenom string `protobuf:"bytes,2,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
+	// The host zone's denom in it's native form (e.g. dydx)
+	HostDenom string `protobuf:"bytes,3,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+	// Deprecated, the trades are now executed off-chain via authz
+	//
+	// The osmosis pool ID
+	PoolId uint64 `protobuf:"varint,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // Deprecated: Do not use.
+	// Deprecated, the trades are now executed off-chain via authz
+	//
+	// Threshold defining the percentage of tokens that could be lost in the trade
+	// This captures both the loss from slippage and from a stale price on stride
+	// "0.05" means the output from the trade can be no less than a 5% deviation
+	// from the current value
+	MaxAllowedSwapLossRate string `protobuf:"bytes,5,opt,name=max_allowed_swap_loss_rate,json=maxAllowedSwapLossRate,proto3" json:"max_allowed_swap_loss_rate,omitempty"` // Deprecated: Do not use.
+	// Deprecated, the trades are now executed off-chain via authz
+	//
+	// minimum amount of reward tokens to initate a swap
+	// if not provided, defaults to 0
+	MinSwapAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=min_swap_amount,json=minSwapAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_swap_amount"` // Deprecated: Do not use.
+	// Deprecated, the trades are now executed off-chain via authz
+	//
+	// maximum amount of reward tokens in a single swap
+	// if not provided, defaults to 10e24
+	MaxSwapAmount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_swap_amount,json=maxSwapAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_swap_amount"` // Deprecated: Do not use.
+	// Minimum amount of reward token that must be accumulated before
+	// the tokens are transferred to the trade ICA
+	MinTransferAmount cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=min_transfer_amount,json=minTransferAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_transfer_amount"`
 }
-func (*UnimplementedMsgServer) DeleteTradeRoute(ctx context.Context, req *MsgDeleteTradeRoute) (*MsgDeleteTradeRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTradeRoute not implemented")
+
+func (m *MsgUpdateTradeRoute) Reset()         { *m = MsgUpdateTradeRoute{} }
+func (m *MsgUpdateTradeRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTradeRoute) ProtoMessage()    {}
+func (*MsgUpdateTradeRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{56}
 }
-func (*UnimplementedMsgServer) UpdateTradeRoute(ctx context.Context, req *MsgUpdateTradeRoute) (*MsgUpdateTradeRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTradeRoute not implemented")
+func (m *MsgUpdateTradeRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetCommunityPoolRebate(ctx context.Context, req *MsgSetCommunityPoolRebate) (*MsgSetCommunityPoolRebateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetCommunityPoolRebate not implemented")
+func (m *MsgUpdateTradeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTradeRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ToggleTradeController(ctx context.Context, req *MsgToggleTradeController) (*MsgToggleTradeControllerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ToggleTradeController not implemented")
+func (m *MsgUpdateTradeRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTradeRoute.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateHostZoneParams(ctx context.Context, req *MsgUpdateHostZoneParams) (*MsgUpdateHostZoneParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostZoneParams not implemented")
+func (m *MsgUpdateTradeRoute) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DeprecateHostZone(ctx context.Context, req *MsgDeprecateHostZone) (*MsgDeprecateHostZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeprecateHostZone not implemented")
+func (m *MsgUpdateTradeRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTradeRoute.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgUpdateTradeRoute proto.InternalMessageInfo
 
-func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/LiquidStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
+func (m *MsgUpdateTradeRoute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_LSMLiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLSMLiquidStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LSMLiquidStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/LSMLiquidStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LSMLiquidStake(ctx, req.(*MsgLSMLiquidStake))
+func (m *MsgUpdateTradeRoute) GetRewardDenom() string {
+	if m != nil {
+		return m.RewardDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RedeemStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRedeemStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RedeemStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/RedeemStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RedeemStake(ctx, req.(*MsgRedeemStake))
+func (m *MsgUpdateTradeRoute) GetHostDenom() string {
+	if m != nil {
+		return m.HostDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RegisterHostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterHostZone)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterHostZone(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/RegisterHostZone",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterHostZone(ctx, req.(*MsgRegisterHostZone))
+// Deprecated: Do not use.
+func (m *MsgUpdateTradeRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ClaimUndelegatedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimUndelegatedTokens)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimUndelegatedTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/ClaimUndelegatedTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimUndelegatedTokens(ctx, req.(*MsgClaimUndelegatedTokens))
+// Deprecated: Do not use.
+func (m *MsgUpdateTradeRoute) GetMaxAllowedSwapLossRate() string {
+	if m != nil {
+		return m.MaxAllowedSwapLossRate
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RebalanceValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRebalanceValidators)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RebalanceValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/RebalanceValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RebalanceValidators(ctx, req.(*MsgRebalanceValidators))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgUpdateTradeRouteResponse struct {
 }
 
-func _Msg_AddValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddValidators)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/AddValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddValidators(ctx, req.(*MsgAddValidators))
+func (m *MsgUpdateTradeRouteResponse) Reset()         { *m = MsgUpdateTradeRouteResponse{} }
+func (m *MsgUpdateTradeRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTradeRouteResponse) ProtoMessage()    {}
+func (*MsgUpdateTradeRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{57}
+}
+func (m *MsgUpdateTradeRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTradeRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTradeRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateTradeRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTradeRouteResponse.Merge(m, src)
+}
+func (m *MsgUpdateTradeRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTradeRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTradeRouteResponse.DiscardUnknown(m)
 }
 
-func _Msg_ChangeValidatorWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeValidatorWeights)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeValidatorWeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/ChangeValidatorWeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeValidatorWeight(ctx, req.(*MsgChangeValidatorWeights))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdateTradeRouteResponse proto.InternalMessageInfo
+
+// Registers or updates a community pool rebate by specifying the amount liquid
+// staked
+type MsgSetCommunityPoolRebate struct {
+	// Message signer (admin only)
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Chain id of the chain whose community pool has a liquid staking rebate
+	// arrangement with stride
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Rebate percentage represented as a decimal (e.g. 0.2 for 20%)
+	RebateRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rebate_rate,json=rebateRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rebate_rate"`
+	// Number of stTokens recieved by the community pool after liquid staking
+	LiquidStakedStTokenAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=liquid_staked_st_token_amount,json=liquidStakedStTokenAmount,proto3,customtype=cosmossdk.io/math.Int" json:"liquid_staked_st_token_amount"`
 }
 
-func _Msg_DeleteValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteValidator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/DeleteValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteValidator(ctx, req.(*MsgDeleteValidator))
+func (m *MsgSetCommunityPoolRebate) Reset()         { *m = MsgSetCommunityPoolRebate{} }
+func (m *MsgSetCommunityPoolRebate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommunityPoolRebate) ProtoMessage()    {}
+func (*MsgSetCommunityPoolRebate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{58}
+}
+func (m *MsgSetCommunityPoolRebate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommunityPoolRebate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommunityPoolRebate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetCommunityPoolRebate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommunityPoolRebate.Merge(m, src)
+}
+func (m *MsgSetCommunityPoolRebate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommunityPoolRebate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommunityPoolRebate.DiscardUnknown(m)
 }
 
-func _Msg_RestoreInterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRestoreInterchainAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RestoreInterchainAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/RestoreInterchainAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RestoreInterchainAccount(ctx, req.(*MsgRestoreInterchainAccount))
+var xxx_messageInfo_MsgSetCommunityPoolRebate proto.InternalMessageInfo
+
+func (m *MsgSetCommunityPoolRebate) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CloseDelegationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseDelegationChannel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CloseDelegationChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/CloseDelegationChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CloseDelegationChannel(ctx, req.(*MsgCloseDelegationChannel))
+func (m *MsgSetCommunityPoolRebate) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateValidatorSharesExchRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateValidatorSharesExchRate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateValidatorSharesExchRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/UpdateValidatorSharesExchRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateValidatorSharesExchRate(ctx, req.(*MsgUpdateValidatorSharesExchRate))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSetCommunityPoolRebateResponse struct {
 }
 
-func _Msg_CalibrateDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCalibrateDelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CalibrateDelegation(ctx, in)
+func (m *MsgSetCommunityPoolRebateResponse) Reset()         { *m = MsgSetCommunityPoolRebateResponse{} }
+func (m *MsgSetCommunityPoolRebateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommunityPoolRebateResponse) ProtoMessage()    {}
+func (*MsgSetCommunityPoolRebateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{59}
+}
+func (m *MsgSetCommunityPoolRebateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommunityPoolRebateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommunityPoolRebateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/CalibrateDelegation",
+}
+func (m *MsgSetCommunityPoolRebateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommunityPoolRebateResponse.Merge(m, src)
+}
+func (m *MsgSetCommunityPoolRebateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommunityPoolRebateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommunityPoolRebateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommunityPoolRebateResponse proto.InternalMessageInfo
+
+// Grants or revokes trade permissions to a given address via authz
+type MsgToggleTradeController struct {
+	// Message signer (admin only)
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Chain ID of the trade account
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Permission change (either grant or revoke)
+	PermissionChange AuthzPermissionChange `protobuf:"varint,3,opt,name=permission_change,json=permissionChange,proto3,enum=stride.stakeibc.AuthzPermissionChange" json:"permission_change,omitempty"`
+	// Address of trade operator
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// Option to grant/revoke the legacy osmosis swap message
+	Legacy bool `protobuf:"varint,5,opt,name=legacy,proto3" json:"legacy,omitempty"`
+}
+
+func (m *MsgToggleTradeController) Reset()         { *m = MsgToggleTradeController{} }
+func (m *MsgToggleTradeController) String() string { return proto.CompactTextString(m) }
+func (*MsgToggleTradeController) ProtoMessage()    {}
+func (*MsgToggleTradeController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{60}
+}
+func (m *MsgToggleTradeController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgToggleTradeController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgToggleTradeController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CalibrateDelegation(ctx, req.(*MsgCalibrateDelegation))
+}
+func (m *MsgToggleTradeController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgToggleTradeController.Merge(m, src)
+}
+func (m *MsgToggleTradeController) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgToggleTradeController) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgToggleTradeController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgToggleTradeController proto.InternalMessageInfo
+
+func (m *MsgToggleTradeController) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ClearBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClearBalance)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgToggleTradeController) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClearBalance(ctx, in)
+	return ""
+}
+
+func (m *MsgToggleTradeController) GetPermissionChange() AuthzPermissionChange {
+	if m != nil {
+		return m.PermissionChange
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/ClearBalance",
+	return AuthzPermissionChange_GRANT
+}
+
+func (m *MsgToggleTradeController) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClearBalance(ctx, req.(*MsgClearBalance))
+	return ""
+}
+
+func (m *MsgToggleTradeController) GetLegacy() bool {
+	if m != nil {
+		return m.Legacy
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_UpdateInnerRedemptionRateBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateInnerRedemptionRateBounds)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateInnerRedemptionRateBounds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/UpdateInnerRedemptionRateBounds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateInnerRedemptionRateBounds(ctx, req.(*MsgUpdateInnerRedemptionRateBounds))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgToggleTradeControllerResponse struct {
 }
 
-func _Msg_ResumeHostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgResumeHostZone)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ResumeHostZone(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/ResumeHostZone",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ResumeHostZone(ctx, req.(*MsgResumeHostZone))
+func (m *MsgToggleTradeControllerResponse) Reset()         { *m = MsgToggleTradeControllerResponse{} }
+func (m *MsgToggleTradeControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgToggleTradeControllerResponse) ProtoMessage()    {}
+func (*MsgToggleTradeControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{61}
+}
+func (m *MsgToggleTradeControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgToggleTradeControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgToggleTradeControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgToggleTradeControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgToggleTradeControllerResponse.Merge(m, src)
+}
+func (m *MsgToggleTradeControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgToggleTradeControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgToggleTradeControllerResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreateTradeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTradeRoute)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTradeRoute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/CreateTradeRoute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTradeRoute(ctx, req.(*MsgCreateTradeRoute))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgToggleTradeControllerResponse proto.InternalMessageInfo
+
+// Updates host zone params
+type MsgUpdateHostZoneParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Chain ID of the host zone
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Max messages that can be sent in a single ICA message
+	MaxMessagesPerIcaTx uint64 `protobuf:"varint,3,opt,name=max_messages_per_ica_tx,json=maxMessagesPerIcaTx,proto3" json:"max_messages_per_ica_tx,omitempty"`
+	// Fee schedule for the host zone - if nil, the existing fee schedule is left
+	// unchanged
+	FeeSchedule *HostZoneFeeSchedule `protobuf:"bytes,4,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule,omitempty"`
+	// Estimated gas limit of a delegation or undelegation ICA tx - if 0, the
+	// existing gas limit is left unchanged
+	MaxIcaTxGas uint64 `protobuf:"varint,5,opt,name=max_ica_tx_gas,json=maxIcaTxGas,proto3" json:"max_ica_tx_gas,omitempty"`
+	// If true, the host zone's fee schedule is removed, so the global stride
+	// commission is used and no liquid stake or redemption fees are charged
+	// Cannot be set alongside a fee schedule
+	RemoveFeeSchedule bool `protobuf:"varint,6,opt,name=remove_fee_schedule,json=removeFeeSchedule,proto3" json:"remove_fee_schedule,omitempty"`
+	// Minimum size of a rebalancing redelegation - if 0, the existing minimum is
+	// left unchanged
+	MinRebalanceAmount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=min_rebalance_amount,json=minRebalanceAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_rebalance_amount"`
+	// If true, the host zone's max ICA tx gas is removed, so ICA txs are only
+	// batched by the max messages per tx
+	// Cannot be set alongside a max ICA tx gas
+	RemoveMaxIcaTxGas bool `protobuf:"varint,8,opt,name=remove_max_ica_tx_gas,json=removeMaxIcaTxGas,proto3" json:"remove_max_ica_tx_gas,omitempty"`
+	// If true, the host zone's min rebalance amount is removed, so the default
+	// minimum (a percentage of total delegations) is used
+	// Cannot be set alongside a min rebalance amount
+	RemoveMinRebalanceAmount bool `protobuf:"varint,9,opt,name=remove_min_rebalance_amount,json=removeMinRebalanceAmount,proto3" json:"remove_min_rebalance_amount,omitempty"`
 }
 
-func _Msg_DeleteTradeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteTradeRoute)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteTradeRoute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/DeleteTradeRoute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteTradeRoute(ctx, req.(*MsgDeleteTradeRoute))
+func (m *MsgUpdateHostZoneParams) Reset()         { *m = MsgUpdateHostZoneParams{} }
+func (m *MsgUpdateHostZoneParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateHostZoneParams) ProtoMessage()    {}
+func (*MsgUpdateHostZoneParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{62}
+}
+func (m *MsgUpdateHostZoneParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateHostZoneParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateHostZoneParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateHostZoneParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateHostZoneParams.Merge(m, src)
+}
+func (m *MsgUpdateHostZoneParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateHostZoneParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateHostZoneParams.DiscardUnknown(m)
 }
 
-func _Msg_UpdateTradeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateTradeRoute)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateTradeRoute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/UpdateTradeRoute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTradeRoute(ctx, req.(*MsgUpdateTradeRoute))
+var xxx_messageInfo_MsgUpdateHostZoneParams proto.InternalMessageInfo
+
+func (m *MsgUpdateHostZoneParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetCommunityPoolRebate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetCommunityPoolRebate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetCommunityPoolRebate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/SetCommunityPoolRebate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetCommunityPoolRebate(ctx, req.(*MsgSetCommunityPoolRebate))
+func (m *MsgUpdateHostZoneParams) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ToggleTradeController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgToggleTradeController)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ToggleTradeController(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/ToggleTradeController",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ToggleTradeController(ctx, req.(*MsgToggleTradeController))
+func (m *MsgUpdateHostZoneParams) GetMaxMessagesPerIcaTx() uint64 {
+	if m != nil {
+		return m.MaxMessagesPerIcaTx
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateHostZoneParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateHostZoneParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateHostZoneParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/UpdateHostZoneParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateHostZoneParams(ctx, req.(*MsgUpdateHostZoneParams))
+func (m *MsgUpdateHostZoneParams) GetFeeSchedule() *HostZoneFeeSchedule {
+	if m != nil {
+		return m.FeeSchedule
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DeprecateHostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeprecateHostZone)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateHostZoneParams) GetMaxIcaTxGas() uint64 {
+	if m != nil {
+		return m.MaxIcaTxGas
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeprecateHostZone(ctx, in)
+	return 0
+}
+
+func (m *MsgUpdateHostZoneParams) GetRemoveFeeSchedule() bool {
+	if m != nil {
+		return m.RemoveFeeSchedule
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Msg/DeprecateHostZone",
+	return false
+}
+
+func (m *MsgUpdateHostZoneParams) GetRemoveMaxIcaTxGas() bool {
+	if m != nil {
+		return m.RemoveMaxIcaTxGas
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeprecateHostZone(ctx, req.(*MsgDeprecateHostZone))
+	return false
+}
+
+func (m *MsgUpdateHostZoneParams) GetRemoveMinRebalanceAmount() bool {
+	if m != nil {
+		return m.RemoveMinRebalanceAmount
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stride.stakeibc.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LiquidStake",
-			Handler:    _Msg_LiquidStake_Handler,
-		},
-		{
-			MethodName: "LSMLiquidStake",
-			Handler:    _Msg_LSMLiquidStake_Handler,
-		},
-		{
-			MethodName: "RedeemStake",
-			Handler:    _Msg_RedeemStake_Handler,
-		},
-		{
-			MethodName: "RegisterHostZone",
-			Handler:    _Msg_RegisterHostZone_Handler,
-		},
-		{
-			MethodName: "ClaimUndelegatedTokens",
-			Handler:    _Msg_ClaimUndelegatedTokens_Handler,
-		},
-		{
-			MethodName: "RebalanceValidators",
-			Handler:    _Msg_RebalanceValidators_Handler,
-		},
-		{
-			MethodName: "AddValidators",
-			Handler:    _Msg_AddValidators_Handler,
-		},
-		{
-			MethodName: "ChangeValidatorWeight",
-			Handler:    _Msg_ChangeValidatorWeight_Handler,
-		},
-		{
-			MethodName: "DeleteValidator",
-			Handler:    _Msg_DeleteValidator_Handler,
-		},
-		{
-			MethodName: "RestoreInterchainAccount",
-			Handler:    _Msg_RestoreInterchainAccount_Handler,
-		},
-		{
-			MethodName: "CloseDelegationChannel",
-			Handler:    _Msg_CloseDelegationChannel_Handler,
-		},
-		{
-			MethodName: "UpdateValidatorSharesExchRate",
-			Handler:    _Msg_UpdateValidatorSharesExchRate_Handler,
-		},
-		{
-			MethodName: "CalibrateDelegation",
-			Handler:    _Msg_CalibrateDelegation_Handler,
-		},
-		{
-			MethodName: "ClearBalance",
-			Handler:    _Msg_ClearBalance_Handler,
-		},
-		{
-			MethodName: "UpdateInnerRedemptionRateBounds",
-			Handler:    _Msg_UpdateInnerRedemptionRateBounds_Handler,
-		},
-		{
-			MethodName: "ResumeHostZone",
-			Handler:    _Msg_ResumeHostZone_Handler,
-		},
-		{
-			MethodName: "CreateTradeRoute",
-			Handler:    _Msg_CreateTradeRoute_Handler,
-		},
-		{
-			MethodName: "DeleteTradeRoute",
-			Handler:    _Msg_DeleteTradeRoute_Handler,
-		},
-		{
-			MethodName: "UpdateTradeRoute",
-			Handler:    _Msg_UpdateTradeRoute_Handler,
-		},
-		{
-			MethodName: "SetCommunityPoolRebate",
-			Handler:    _Msg_SetCommunityPoolRebate_Handler,
-		},
-		{
-			MethodName: "ToggleTradeController",
-			Handler:    _Msg_ToggleTradeController_Handler,
-		},
-		{
-			MethodName: "UpdateHostZoneParams",
-			Handler:    _Msg_UpdateHostZoneParams_Handler,
-		},
-		{
-			MethodName: "DeprecateHostZone",
-			Handler:    _Msg_DeprecateHostZone_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stride/stakeibc/tx.proto",
+type MsgUpdateHostZoneParamsResponse struct {
 }
 
-func (m *MsgUpdateInnerRedemptionRateBounds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateHostZoneParamsResponse) Reset()         { *m = MsgUpdateHostZoneParamsResponse{} }
+func (m *MsgUpdateHostZoneParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateHostZoneParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateHostZoneParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{63}
+}
+func (m *MsgUpdateHostZoneParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateHostZoneParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateHostZoneParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateHostZoneParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateHostZoneParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateHostZoneParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateHostZoneParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateHostZoneParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateInnerRedemptionRateBounds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateHostZoneParamsResponse proto.InternalMessageInfo
+
+// Enables, updates, or disables automatic validator weighting on a host zone
+type MsgSetAutoValidatorWeighting struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Chain ID of the host zone
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Weighting config - if nil, automatic weighting is disabled
+	Config *AutoValidatorWeightingConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (m *MsgUpdateInnerRedemptionRateBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxInnerRedemptionRate.Size()
-		i -= size
-		if _, err := m.MaxInnerRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.MinInnerRedemptionRate.Size()
-		i -= size
-		if _, err := m.MinInnerRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetAutoValidatorWeighting) Reset()         { *m = MsgSetAutoValidatorWeighting{} }
+func (m *MsgSetAutoValidatorWeighting) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoValidatorWeighting) ProtoMessage()    {}
+func (*MsgSetAutoValidatorWeighting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{64}
+}
+func (m *MsgSetAutoValidatorWeighting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoValidatorWeighting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoValidatorWeighting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetAutoValidatorWeighting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoValidatorWeighting.Merge(m, src)
+}
+func (m *MsgSetAutoValidatorWeighting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoValidatorWeighting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoValidatorWeighting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoValidatorWeighting proto.InternalMessageInfo
+
+func (m *MsgSetAutoValidatorWeighting) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateInnerRedemptionRateBoundsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoValidatorWeighting) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateInnerRedemptionRateBoundsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAutoValidatorWeighting) GetConfig() *AutoValidatorWeightingConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
 }
 
-func (m *MsgUpdateInnerRedemptionRateBoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetAutoValidatorWeightingResponse struct {
 }
 
-func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoValidatorWeightingResponse) Reset()         { *m = MsgSetAutoValidatorWeightingResponse{} }
+func (m *MsgSetAutoValidatorWeightingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoValidatorWeightingResponse) ProtoMessage()    {}
+func (*MsgSetAutoValidatorWeightingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{65}
+}
+func (m *MsgSetAutoValidatorWeightingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoValidatorWeightingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoValidatorWeightingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetAutoValidatorWeightingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoValidatorWeightingResponse.Merge(m, src)
+}
+func (m *MsgSetAutoValidatorWeightingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoValidatorWeightingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoValidatorWeightingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAutoValidatorWeightingResponse proto.InternalMessageInfo
+
+// Enables, updates, or disables instant redemptions on a host zone
+type MsgSetInstantRedemptionConfig struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Chain ID of the host zone
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Instant redemption config - if nil, instant redemptions are disabled
+	Config *InstantRedemptionConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetInstantRedemptionConfig) Reset()         { *m = MsgSetInstantRedemptionConfig{} }
+func (m *MsgSetInstantRedemptionConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInstantRedemptionConfig) ProtoMessage()    {}
+func (*MsgSetInstantRedemptionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{66}
+}
+func (m *MsgSetInstantRedemptionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInstantRedemptionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInstantRedemptionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetInstantRedemptionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInstantRedemptionConfig.Merge(m, src)
+}
+func (m *MsgSetInstantRedemptionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInstantRedemptionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInstantRedemptionConfig.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetInstantRedemptionConfig proto.InternalMessageInfo
+
+func (m *MsgSetInstantRedemptionConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StToken.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgSetInstantRedemptionConfig) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgLSMLiquidStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetInstantRedemptionConfig) GetConfig() *InstantRedemptionConfig {
+	if m != nil {
+		return m.Config
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgLSMLiquidStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetInstantRedemptionConfigResponse struct {
 }
 
-func (m *MsgLSMLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LsmTokenIbcDenom) > 0 {
-		i -= len(m.LsmTokenIbcDenom)
-		copy(dAtA[i:], m.LsmTokenIbcDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LsmTokenIbcDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetInstantRedemptionConfigResponse) Reset()         { *m = MsgSetInstantRedemptionConfigResponse{} }
+func (m *MsgSetInstantRedemptionConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInstantRedemptionConfigResponse) ProtoMessage()    {}
+func (*MsgSetInstantRedemptionConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{67}
+}
+func (m *MsgSetInstantRedemptionConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInstantRedemptionConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInstantRedemptionConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgLSMLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetInstantRedemptionConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInstantRedemptionConfigResponse.Merge(m, src)
+}
+func (m *MsgSetInstantRedemptionConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInstantRedemptionConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInstantRedemptionConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLSMLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetInstantRedemptionConfigResponse proto.InternalMessageInfo
+
+// Enables, updates, or disables automatic validator blacklisting on a host zone
+type MsgSetValidatorBlacklistPolicy struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Chain ID of the host zone
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Blacklist policy - if nil, automatic blacklisting is disabled
+	Policy *ValidatorBlacklistPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
-func (m *MsgLSMLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TransactionComplete {
-		i--
-		if m.TransactionComplete {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgSetValidatorBlacklistPolicy) Reset()         { *m = MsgSetValidatorBlacklistPolicy{} }
+func (m *MsgSetValidatorBlacklistPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorBlacklistPolicy) ProtoMessage()    {}
+func (*MsgSetValidatorBlacklistPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{68}
+}
+func (m *MsgSetValidatorBlacklistPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorBlacklistPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorBlacklistPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgClearBalance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetValidatorBlacklistPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorBlacklistPolicy.Merge(m, src)
 }
-
-func (m *MsgClearBalance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetValidatorBlacklistPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorBlacklistPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorBlacklistPolicy.DiscardUnknown(m)
 }
 
-func (m *MsgClearBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSetValidatorBlacklistPolicy proto.InternalMessageInfo
+
+func (m *MsgSetValidatorBlacklistPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgClearBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetValidatorBlacklistPolicy) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgClearBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetValidatorBlacklistPolicy) GetPolicy() *ValidatorBlacklistPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
 }
 
-func (m *MsgClearBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetValidatorBlacklistPolicyResponse struct {
 }
 
-func (m *MsgRedeemStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetValidatorBlacklistPolicyResponse) Reset() {
+	*m = MsgSetValidatorBlacklistPolicyResponse{}
+}
+func (m *MsgSetValidatorBlacklistPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorBlacklistPolicyResponse) ProtoMessage()    {}
+func (*MsgSetValidatorBlacklistPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{69}
+}
+func (m *MsgSetValidatorBlacklistPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorBlacklistPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorBlacklistPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetValidatorBlacklistPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorBlacklistPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetValidatorBlacklistPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorBlacklistPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorBlacklistPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRedeemStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetValidatorBlacklistPolicyResponse proto.InternalMessageInfo
+
+// Removes a validator from a host zone's blacklist so that it can be
+// re-weighted or added back to the validator set
+type MsgRemoveBlacklistedValidator struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Chain ID of the host zone
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Validator operator address
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *MsgRedeemStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.HostZone) > 0 {
-		i -= len(m.HostZone)
-		copy(dAtA[i:], m.HostZone)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRemoveBlacklistedValidator) Reset()         { *m = MsgRemoveBlacklistedValidator{} }
+func (m *MsgRemoveBlacklistedValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBlacklistedValidator) ProtoMessage()    {}
+func (*MsgRemoveBlacklistedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{70}
+}
+func (m *MsgRemoveBlacklistedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBlacklistedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBlacklistedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRemoveBlacklistedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBlacklistedValidator.Merge(m, src)
+}
+func (m *MsgRemoveBlacklistedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBlacklistedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBlacklistedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveBlacklistedValidator proto.InternalMessageInfo
+
+func (m *MsgRemoveBlacklistedValidator) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRedeemStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveBlacklistedValidator) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRedeemStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveBlacklistedValidator) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-func (m *MsgRedeemStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRemoveBlacklistedValidatorResponse struct {
 }
 
-func (m *MsgRegisterHostZone) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveBlacklistedValidatorResponse) Reset()         { *m = MsgRemoveBlacklistedValidatorResponse{} }
+func (m *MsgRemoveBlacklistedValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBlacklistedValidatorResponse) ProtoMessage()    {}
+func (*MsgRemoveBlacklistedValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{71}
+}
+func (m *MsgRemoveBlacklistedValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBlacklistedValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBlacklistedValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveBlacklistedValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBlacklistedValidatorResponse.Merge(m, src)
+}
+func (m *MsgRemoveBlacklistedValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBlacklistedValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBlacklistedValidatorResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterHostZone) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveBlacklistedValidatorResponse proto.InternalMessageInfo
+
+// Enables, updates, or disables the redemption rate guard on a host zone
+type MsgSetRedemptionRateGuardConfig struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Chain ID of the host zone
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Guard config - if nil, the guard is disabled
+	Config *RedemptionRateGuardConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (m *MsgRegisterHostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxMessagesPerIcaTx != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxMessagesPerIcaTx))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if len(m.CommunityPoolTreasuryAddress) > 0 {
-		i -= len(m.CommunityPoolTreasuryAddress)
-		copy(dAtA[i:], m.CommunityPoolTreasuryAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CommunityPoolTreasuryAddress)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.LsmLiquidStakeEnabled {
-		i--
-		if m.LsmLiquidStakeEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
-	}
-	{
-		size := m.MaxRedemptionRate.Size()
-		i -= size
-		if _, err := m.MaxRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.MinRedemptionRate.Size()
-		i -= size
-		if _, err := m.MinRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetRedemptionRateGuardConfig) Reset()         { *m = MsgSetRedemptionRateGuardConfig{} }
+func (m *MsgSetRedemptionRateGuardConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRedemptionRateGuardConfig) ProtoMessage()    {}
+func (*MsgSetRedemptionRateGuardConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{72}
+}
+func (m *MsgSetRedemptionRateGuardConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRedemptionRateGuardConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRedemptionRateGuardConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	if len(m.Bech32Prefix) > 0 {
-		i -= len(m.Bech32Prefix)
-		copy(dAtA[i:], m.Bech32Prefix)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bech32Prefix)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.UnbondingPeriod != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingPeriod))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.TransferChannelId) > 0 {
-		i -= len(m.TransferChannelId)
-		copy(dAtA[i:], m.TransferChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferChannelId)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.IbcDenom) > 0 {
-		i -= len(m.IbcDenom)
-		copy(dAtA[i:], m.IbcDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRegisterHostZoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetRedemptionRateGuardConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRedemptionRateGuardConfig.Merge(m, src)
+}
+func (m *MsgSetRedemptionRateGuardConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRedemptionRateGuardConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRedemptionRateGuardConfig.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetRedemptionRateGuardConfig proto.InternalMessageInfo
+
+func (m *MsgSetRedemptionRateGuardConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgRegisterHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetRedemptionRateGuardConfig) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *MsgClaimUndelegatedTokens) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetRedemptionRateGuardConfig) GetConfig() *RedemptionRateGuardConfig {
+	if m != nil {
+		return m.Config
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgClaimUndelegatedTokens) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetRedemptionRateGuardConfigResponse struct {
 }
 
-func (m *MsgClaimUndelegatedTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.HostZoneId) > 0 {
-		i -= len(m.HostZoneId)
-		copy(dAtA[i:], m.HostZoneId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZoneId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetRedemptionRateGuardConfigResponse) Reset() {
+	*m = MsgSetRedemptionRateGuardConfigResponse{}
 }
-
-func (m *MsgClaimUndelegatedTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetRedemptionRateGuardConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRedemptionRateGuardConfigResponse) ProtoMessage()    {}
+func (*MsgSetRedemptionRateGuardConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{73}
+}
+func (m *MsgSetRedemptionRateGuardConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRedemptionRateGuardConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRedemptionRateGuardConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgClaimUndelegatedTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetRedemptionRateGuardConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRedemptionRateGuardConfigResponse.Merge(m, src)
+}
+func (m *MsgSetRedemptionRateGuardConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRedemptionRateGuardConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRedemptionRateGuardConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimUndelegatedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetRedemptionRateGuardConfigResponse proto.InternalMessageInfo
+
+// Sets or removes the guardian that can trip and reset circuit breakers
+type MsgSetCircuitBreakerGuardian struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Guardian address - if empty, the guardian is removed
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// Time after which the guardian can no longer trip or reset circuit breakers
+	Expiration time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration"`
 }
 
-func (m *MsgRebalanceValidators) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetCircuitBreakerGuardian) Reset()         { *m = MsgSetCircuitBreakerGuardian{} }
+func (m *MsgSetCircuitBreakerGuardian) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCircuitBreakerGuardian) ProtoMessage()    {}
+func (*MsgSetCircuitBreakerGuardian) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{74}
+}
+func (m *MsgSetCircuitBreakerGuardian) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCircuitBreakerGuardian) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCircuitBreakerGuardian.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRebalanceValidators) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetCircuitBreakerGuardian) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCircuitBreakerGuardian.Merge(m, src)
+}
+func (m *MsgSetCircuitBreakerGuardian) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCircuitBreakerGuardian) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCircuitBreakerGuardian.DiscardUnknown(m)
 }
 
-func (m *MsgRebalanceValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumRebalance != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NumRebalance))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.HostZone) > 0 {
-		i -= len(m.HostZone)
-		copy(dAtA[i:], m.HostZone)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSetCircuitBreakerGuardian proto.InternalMessageInfo
+
+func (m *MsgSetCircuitBreakerGuardian) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRebalanceValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetCircuitBreakerGuardian) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRebalanceValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetCircuitBreakerGuardian) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
 }
 
-func (m *MsgRebalanceValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetCircuitBreakerGuardianResponse struct {
 }
 
-func (m *MsgAddValidators) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetCircuitBreakerGuardianResponse) Reset()         { *m = MsgSetCircuitBreakerGuardianResponse{} }
+func (m *MsgSetCircuitBreakerGuardianResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCircuitBreakerGuardianResponse) ProtoMessage()    {}
+func (*MsgSetCircuitBreakerGuardianResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{75}
+}
+func (m *MsgSetCircuitBreakerGuardianResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCircuitBreakerGuardianResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCircuitBreakerGuardianResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetCircuitBreakerGuardianResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCircuitBreakerGuardianResponse.Merge(m, src)
+}
+func (m *MsgSetCircuitBreakerGuardianResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCircuitBreakerGuardianResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCircuitBreakerGuardianResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddValidators) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetCircuitBreakerGuardianResponse proto.InternalMessageInfo
+
+// Pauses actions for a host zone in one of the liquid staking modules
+// Can be signed by either the guardian or governance
+type MsgTripCircuitBreaker struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Module to pause (stakeibc, staketia, or stakedym)
+	Module  string                 `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	ChainId string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Actions []CircuitBreakerAction `protobuf:"varint,4,rep,packed,name=actions,proto3,enum=stride.stakeibc.CircuitBreakerAction" json:"actions,omitempty"`
 }
 
-func (m *MsgAddValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgTripCircuitBreaker) Reset()         { *m = MsgTripCircuitBreaker{} }
+func (m *MsgTripCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgTripCircuitBreaker) ProtoMessage()    {}
+func (*MsgTripCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{76}
+}
+func (m *MsgTripCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTripCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTripCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.HostZone) > 0 {
-		i -= len(m.HostZone)
-		copy(dAtA[i:], m.HostZone)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTripCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTripCircuitBreaker.Merge(m, src)
+}
+func (m *MsgTripCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTripCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTripCircuitBreaker.DiscardUnknown(m)
 }
 
-func (m *MsgAddValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTripCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgTripCircuitBreaker) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTripCircuitBreaker) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
 }
 
-func (m *MsgAddValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTripCircuitBreaker) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *ValidatorWeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTripCircuitBreaker) GetActions() []CircuitBreakerAction {
+	if m != nil {
+		return m.Actions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgTripCircuitBreakerResponse struct {
 }
 
-func (m *ValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Weight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Weight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgTripCircuitBreakerResponse) Reset()         { *m = MsgTripCircuitBreakerResponse{} }
+func (m *MsgTripCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTripCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgTripCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{77}
 }
-
-func (m *MsgChangeValidatorWeights) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTripCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTripCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTripCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTripCircuitBreakerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgChangeValidatorWeights) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTripCircuitBreakerResponse proto.InternalMessageInfo
+
+// Unpauses actions for a host zone in one of the liquid staking modules
+// Can be signed by either the guardian or governance, however only governance
+// can reset a flag that was tripped by governance
+type MsgResetCircuitBreaker struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Module to unpause (stakeibc, staketia, or stakedym)
+	Module  string                 `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	ChainId string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Actions []CircuitBreakerAction `protobuf:"varint,4,rep,packed,name=actions,proto3,enum=stride.stakeibc.CircuitBreakerAction" json:"actions,omitempty"`
 }
 
-func (m *MsgChangeValidatorWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorWeights) > 0 {
-		for iNdEx := len(m.ValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgResetCircuitBreaker) Reset()         { *m = MsgResetCircuitBreaker{} }
+func (m *MsgResetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreaker) ProtoMessage()    {}
+func (*MsgResetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{78}
+}
+func (m *MsgResetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.HostZone) > 0 {
-		i -= len(m.HostZone)
-		copy(dAtA[i:], m.HostZone)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgResetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgResetCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgResetCircuitBreaker) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgResetCircuitBreaker) GetModule() string {
+	if m != nil {
+		return m.Module
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgChangeValidatorWeightsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResetCircuitBreaker) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgChangeValidatorWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResetCircuitBreaker) GetActions() []CircuitBreakerAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
 }
 
-func (m *MsgChangeValidatorWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgResetCircuitBreakerResponse struct {
 }
 
-func (m *MsgDeleteValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResetCircuitBreakerResponse) Reset()         { *m = MsgResetCircuitBreakerResponse{} }
+func (m *MsgResetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgResetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{79}
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgResetCircuitBreakerResponse proto.InternalMessageInfo
+
+// Enables, updates, or disables the slashing insurance fund on a host zone
+type MsgSetInsuranceFundConfig struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Chain ID of the host zone
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Insurance fund config - if nil, the insurance fund is disabled
+	Config *InsuranceFundConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (m *MsgDeleteValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
-		copy(dAtA[i:], m.ValAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.HostZone) > 0 {
-		i -= len(m.HostZone)
-		copy(dAtA[i:], m.HostZone)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSetInsuranceFundConfig) Reset()         { *m = MsgSetInsuranceFundConfig{} }
+func (m *MsgSetInsuranceFundConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInsuranceFundConfig) ProtoMessage()    {}
+func (*MsgSetInsuranceFundConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{80}
+}
+func (m *MsgSetInsuranceFundConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInsuranceFundConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInsuranceFundConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetInsuranceFundConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInsuranceFundConfig.Merge(m, src)
+}
+func (m *MsgSetInsuranceFundConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInsuranceFundConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInsuranceFundConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInsuranceFundConfig proto.InternalMessageInfo
+
+func (m *MsgSetInsuranceFundConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeleteValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetInsuranceFundConfig) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetInsuranceFundConfig) GetConfig() *InsuranceFundConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
 }
 
-func (m *MsgDeleteValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetInsuranceFundConfigResponse struct {
 }
 
-func (m *MsgRestoreInterchainAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetInsuranceFundConfigResponse) Reset()         { *m = MsgSetInsuranceFundConfigResponse{} }
+func (m *MsgSetInsuranceFundConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInsuranceFundConfigResponse) ProtoMessage()    {}
+func (*MsgSetInsuranceFundConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{81}
+}
+func (m *MsgSetInsuranceFundConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInsuranceFundConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInsuranceFundConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetInsuranceFundConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInsuranceFundConfigResponse.Merge(m, src)
+}
+func (m *MsgSetInsuranceFundConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInsuranceFundConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInsuranceFundConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRestoreInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetInsuranceFundConfigResponse proto.InternalMessageInfo
+
+// Sets the discrepancy threshold for a host zone's balance reconciliation
+type MsgSetReconciliationThreshold struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Chain ID of the host zone
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Minimum discrepancy (in native tokens) that emits a discrepancy event
+	Threshold cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Int" json:"threshold"`
 }
 
-func (m *MsgRestoreInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AccountOwner) > 0 {
-		i -= len(m.AccountOwner)
-		copy(dAtA[i:], m.AccountOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountOwner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetReconciliationThreshold) Reset()         { *m = MsgSetReconciliationThreshold{} }
+func (m *MsgSetReconciliationThreshold) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReconciliationThreshold) ProtoMessage()    {}
+func (*MsgSetReconciliationThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{82}
+}
+func (m *MsgSetReconciliationThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReconciliationThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReconciliationThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetReconciliationThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReconciliationThreshold.Merge(m, src)
+}
+func (m *MsgSetReconciliationThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReconciliationThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReconciliationThreshold.DiscardUnknown(m)
 }
 
-func (m *MsgRestoreInterchainAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetReconciliationThreshold proto.InternalMessageInfo
+
+func (m *MsgSetReconciliationThreshold) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRestoreInterchainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetReconciliationThreshold) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *MsgRestoreInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetReconciliationThresholdResponse struct {
 }
 
-func (m *MsgCloseDelegationChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetReconciliationThresholdResponse) Reset()         { *m = MsgSetReconciliationThresholdResponse{} }
+func (m *MsgSetReconciliationThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReconciliationThresholdResponse) ProtoMessage()    {}
+func (*MsgSetReconciliationThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{83}
+}
+func (m *MsgSetReconciliationThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReconciliationThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReconciliationThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetReconciliationThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReconciliationThresholdResponse.Merge(m, src)
+}
+func (m *MsgSetReconciliationThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReconciliationThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReconciliationThresholdResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCloseDelegationChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetReconciliationThresholdResponse proto.InternalMessageInfo
+
+// Sender and receiver of transfers that should bypass the rate limit
+type RateLimitWhitelistEntry struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
 }
 
-func (m *MsgCloseDelegationChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *RateLimitWhitelistEntry) Reset()         { *m = RateLimitWhitelistEntry{} }
+func (m *RateLimitWhitelistEntry) String() string { return proto.CompactTextString(m) }
+func (*RateLimitWhitelistEntry) ProtoMessage()    {}
+func (*RateLimitWhitelistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{84}
+}
+func (m *RateLimitWhitelistEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitWhitelistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitWhitelistEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RateLimitWhitelistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitWhitelistEntry.Merge(m, src)
+}
+func (m *RateLimitWhitelistEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitWhitelistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitWhitelistEntry.DiscardUnknown(m)
 }
 
-func (m *MsgCloseDelegationChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateLimitWhitelistEntry proto.InternalMessageInfo
+
+func (m *RateLimitWhitelistEntry) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCloseDelegationChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RateLimitWhitelistEntry) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
 
-func (m *MsgCloseDelegationChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Registers a new host zone and applies its initial configuration in a single
+// proposal. Either every step succeeds, or nothing is applied
+type MsgOnboardHostZone struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Host zone registration (the creator field is ignored)
+	HostZone MsgRegisterHostZone `protobuf:"bytes,2,opt,name=host_zone,json=hostZone,proto3" json:"host_zone"`
+	// Initial validator set
+	Validators []*Validator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	// Optional trade route for the host zone's rewards (the authority field is
+	// ignored). Since the route requires the withdrawal ICA, it is created once
+	// the withdrawal ICA channel opens
+	TradeRoute *MsgCreateTradeRoute `protobuf:"bytes,4,opt,name=trade_route,json=tradeRoute,proto3" json:"trade_route,omitempty"`
+	// Optional community pool rebate
+	CommunityPoolRebate *CommunityPoolRebate `protobuf:"bytes,5,opt,name=community_pool_rebate,json=communityPoolRebate,proto3" json:"community_pool_rebate,omitempty"`
+	// Optional connection to a chain where an ICA oracle should be added
+	OracleConnectionId string `protobuf:"bytes,6,opt,name=oracle_connection_id,json=oracleConnectionId,proto3" json:"oracle_connection_id,omitempty"`
+	// Optional address pairs to whitelist from the rate limit
+	RateLimitWhitelist []RateLimitWhitelistEntry `protobuf:"bytes,7,rep,name=rate_limit_whitelist,json=rateLimitWhitelist,proto3" json:"rate_limit_whitelist"`
+}
+
+func (m *MsgOnboardHostZone) Reset()         { *m = MsgOnboardHostZone{} }
+func (m *MsgOnboardHostZone) String() string { return proto.CompactTextString(m) }
+func (*MsgOnboardHostZone) ProtoMessage()    {}
+func (*MsgOnboardHostZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{85}
+}
+func (m *MsgOnboardHostZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOnboardHostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOnboardHostZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOnboardHostZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOnboardHostZone.Merge(m, src)
+}
+func (m *MsgOnboardHostZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOnboardHostZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOnboardHostZone.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateValidatorSharesExchRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgOnboardHostZone proto.InternalMessageInfo
+
+func (m *MsgOnboardHostZone) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateValidatorSharesExchRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOnboardHostZone) GetHostZone() MsgRegisterHostZone {
+	if m != nil {
+		return m.HostZone
+	}
+	return MsgRegisterHostZone{}
 }
 
-func (m *MsgUpdateValidatorSharesExchRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Valoper) > 0 {
-		i -= len(m.Valoper)
-		copy(dAtA[i:], m.Valoper)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Valoper)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgOnboardHostZone) GetValidators() []*Validator {
+	if m != nil {
+		return m.Validators
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *MsgOnboardHostZone) GetTradeRoute() *MsgCreateTradeRoute {
+	if m != nil {
+		return m.TradeRoute
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgOnboardHostZone) GetCommunityPoolRebate() *CommunityPoolRebate {
+	if m != nil {
+		return m.CommunityPoolRebate
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgUpdateValidatorSharesExchRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOnboardHostZone) GetOracleConnectionId() string {
+	if m != nil {
+		return m.OracleConnectionId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateValidatorSharesExchRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOnboardHostZone) GetRateLimitWhitelist() []RateLimitWhitelistEntry {
+	if m != nil {
+		return m.RateLimitWhitelist
+	}
+	return nil
 }
 
-func (m *MsgUpdateValidatorSharesExchRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgOnboardHostZoneResponse struct {
 }
 
-func (m *MsgCalibrateDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOnboardHostZoneResponse) Reset()         { *m = MsgOnboardHostZoneResponse{} }
+func (m *MsgOnboardHostZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOnboardHostZoneResponse) ProtoMessage()    {}
+func (*MsgOnboardHostZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{86}
+}
+func (m *MsgOnboardHostZoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOnboardHostZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOnboardHostZoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCalibrateDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOnboardHostZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOnboardHostZoneResponse.Merge(m, src)
+}
+func (m *MsgOnboardHostZoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOnboardHostZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOnboardHostZoneResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCalibrateDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Valoper) > 0 {
-		i -= len(m.Valoper)
-		copy(dAtA[i:], m.Valoper)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Valoper)))
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_MsgOnboardHostZoneResponse proto.InternalMessageInfo
+
+// Sets the maximum native tokens that can be unbonded from a host zone in a
+// single unbonding epoch
+type MsgSetUnbondCap struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Chain ID of the host zone
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Max native tokens unbonded per unbonding epoch - if 0, the cap is removed
+	MaxUnbondPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_unbond_per_epoch,json=maxUnbondPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"max_unbond_per_epoch"`
+}
+
+func (m *MsgSetUnbondCap) Reset()         { *m = MsgSetUnbondCap{} }
+func (m *MsgSetUnbondCap) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnbondCap) ProtoMessage()    {}
+func (*MsgSetUnbondCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{87}
+}
+func (m *MsgSetUnbondCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnbondCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnbondCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgSetUnbondCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnbondCap.Merge(m, src)
+}
+func (m *MsgSetUnbondCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnbondCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnbondCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUnbondCap proto.InternalMessageInfo
+
+func (m *MsgSetUnbondCap) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSetUnbondCap) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCalibrateDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgSetUnbondCapResponse struct {
+}
+
+func (m *MsgSetUnbondCapResponse) Reset()         { *m = MsgSetUnbondCapResponse{} }
+func (m *MsgSetUnbondCapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnbondCapResponse) ProtoMessage()    {}
+func (*MsgSetUnbondCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7e09c9ad51cd54, []int{88}
+}
+func (m *MsgSetUnbondCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnbondCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnbondCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetUnbondCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnbondCapResponse.Merge(m, src)
+}
+func (m *MsgSetUnbondCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnbondCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnbondCapResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCalibrateDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetUnbondCapResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("stride.stakeibc.AuthzPermissionChange", AuthzPermissionChange_name, AuthzPermissionChange_value)
+	proto.RegisterType((*MsgUpdateInnerRedemptionRateBounds)(nil), "stride.stakeibc.MsgUpdateInnerRedemptionRateBounds")
+	proto.RegisterType((*MsgUpdateInnerRedemptionRateBoundsResponse)(nil), "stride.stakeibc.MsgUpdateInnerRedemptionRateBoundsResponse")
+	proto.RegisterType((*MsgLiquidStake)(nil), "stride.stakeibc.MsgLiquidStake")
+	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "stride.stakeibc.MsgLiquidStakeResponse")
+	proto.RegisterType((*MsgLSMLiquidStake)(nil), "stride.stakeibc.MsgLSMLiquidStake")
+	proto.RegisterType((*MsgLSMLiquidStakeResponse)(nil), "stride.stakeibc.MsgLSMLiquidStakeResponse")
+	proto.RegisterType((*MsgClearBalance)(nil), "stride.stakeibc.MsgClearBalance")
+	proto.RegisterType((*MsgClearBalanceResponse)(nil), "stride.stakeibc.MsgClearBalanceResponse")
+	proto.RegisterType((*MsgRedeemStake)(nil), "stride.stakeibc.MsgRedeemStake")
+	proto.RegisterType((*MsgRedeemStakeResponse)(nil), "stride.stakeibc.MsgRedeemStakeResponse")
+	proto.RegisterType((*MsgInstantRedeemStake)(nil), "stride.stakeibc.MsgInstantRedeemStake")
+	proto.RegisterType((*MsgInstantRedeemStakeResponse)(nil), "stride.stakeibc.MsgInstantRedeemStakeResponse")
+	proto.RegisterType((*MsgRegisterHostZone)(nil), "stride.stakeibc.MsgRegisterHostZone")
+	proto.RegisterType((*MsgRegisterHostZoneResponse)(nil), "stride.stakeibc.MsgRegisterHostZoneResponse")
+	proto.RegisterType((*MsgClaimUndelegatedTokens)(nil), "stride.stakeibc.MsgClaimUndelegatedTokens")
+	proto.RegisterType((*MsgClaimUndelegatedTokensResponse)(nil), "stride.stakeibc.MsgClaimUndelegatedTokensResponse")
+	proto.RegisterType((*MsgTransferRedemptionRecord)(nil), "stride.stakeibc.MsgTransferRedemptionRecord")
+	proto.RegisterType((*MsgTransferRedemptionRecordResponse)(nil), "stride.stakeibc.MsgTransferRedemptionRecordResponse")
+	proto.RegisterType((*MsgCancelRedemption)(nil), "stride.stakeibc.MsgCancelRedemption")
+	proto.RegisterType((*MsgCancelRedemptionResponse)(nil), "stride.stakeibc.MsgCancelRedemptionResponse")
+	proto.RegisterType((*MsgTokenizeRedemptionRecord)(nil), "stride.stakeibc.MsgTokenizeRedemptionRecord")
+	proto.RegisterType((*MsgTokenizeRedemptionRecordResponse)(nil), "stride.stakeibc.MsgTokenizeRedemptionRecordResponse")
+	proto.RegisterType((*MsgDetokenizeRedemptionRecord)(nil), "stride.stakeibc.MsgDetokenizeRedemptionRecord")
+	proto.RegisterType((*MsgDetokenizeRedemptionRecordResponse)(nil), "stride.stakeibc.MsgDetokenizeRedemptionRecordResponse")
+	proto.RegisterType((*MsgLiquidStakeBasket)(nil), "stride.stakeibc.MsgLiquidStakeBasket")
+	proto.RegisterType((*MsgLiquidStakeBasketResponse)(nil), "stride.stakeibc.MsgLiquidStakeBasketResponse")
+	proto.RegisterType((*BasketRedemptionReceiver)(nil), "stride.stakeibc.BasketRedemptionReceiver")
+	proto.RegisterType((*MsgRedeemBasket)(nil), "stride.stakeibc.MsgRedeemBasket")
+	proto.RegisterType((*MsgRedeemBasketResponse)(nil), "stride.stakeibc.MsgRedeemBasketResponse")
+	proto.RegisterType((*MsgCreateBasket)(nil), "stride.stakeibc.MsgCreateBasket")
+	proto.RegisterType((*MsgCreateBasketResponse)(nil), "stride.stakeibc.MsgCreateBasketResponse")
+	proto.RegisterType((*MsgRebalanceValidators)(nil), "stride.stakeibc.MsgRebalanceValidators")
+	proto.RegisterType((*MsgRebalanceValidatorsResponse)(nil), "stride.stakeibc.MsgRebalanceValidatorsResponse")
+	proto.RegisterType((*MsgAddValidators)(nil), "stride.stakeibc.MsgAddValidators")
+	proto.RegisterType((*MsgAddValidatorsResponse)(nil), "stride.stakeibc.MsgAddValidatorsResponse")
+	proto.RegisterType((*ValidatorWeight)(nil), "stride.stakeibc.ValidatorWeight")
+	proto.RegisterType((*MsgChangeValidatorWeights)(nil), "stride.stakeibc.MsgChangeValidatorWeights")
+	proto.RegisterType((*MsgChangeValidatorWeightsResponse)(nil), "stride.stakeibc.MsgChangeValidatorWeightsResponse")
+	proto.RegisterType((*MsgDeleteValidator)(nil), "stride.stakeibc.MsgDeleteValidator")
+	proto.RegisterType((*MsgDeleteValidatorResponse)(nil), "stride.stakeibc.MsgDeleteValidatorResponse")
+	proto.RegisterType((*MsgRestoreInterchainAccount)(nil), "stride.stakeibc.MsgRestoreInterchainAccount")
+	proto.RegisterType((*MsgRestoreInterchainAccountResponse)(nil), "stride.stakeibc.MsgRestoreInterchainAccountResponse")
+	proto.RegisterType((*MsgCloseDelegationChannel)(nil), "stride.stakeibc.MsgCloseDelegationChannel")
+	proto.RegisterType((*MsgCloseDelegationChannelResponse)(nil), "stride.stakeibc.MsgCloseDelegationChannelResponse")
+	proto.RegisterType((*MsgUpdateValidatorSharesExchRate)(nil), "stride.stakeibc.MsgUpdateValidatorSharesExchRate")
+	proto.RegisterType((*MsgUpdateValidatorSharesExchRateResponse)(nil), "stride.stakeibc.MsgUpdateValidatorSharesExchRateResponse")
+	proto.RegisterType((*MsgCalibrateDelegation)(nil), "stride.stakeibc.MsgCalibrateDelegation")
+	proto.RegisterType((*MsgCalibrateDelegationResponse)(nil), "stride.stakeibc.MsgCalibrateDelegationResponse")
+	proto.RegisterType((*MsgResumeHostZone)(nil), "stride.stakeibc.MsgResumeHostZone")
+	proto.RegisterType((*MsgResumeHostZoneResponse)(nil), "stride.stakeibc.MsgResumeHostZoneResponse")
+	proto.RegisterType((*MsgDeprecateHostZone)(nil), "stride.stakeibc.MsgDeprecateHostZone")
+	proto.RegisterType((*MsgDeprecateHostZoneResponse)(nil), "stride.stakeibc.MsgDeprecateHostZoneResponse")
+	proto.RegisterType((*MsgCreateTradeRoute)(nil), "stride.stakeibc.MsgCreateTradeRoute")
+	proto.RegisterType((*MsgCreateTradeRouteResponse)(nil), "stride.stakeibc.MsgCreateTradeRouteResponse")
+	proto.RegisterType((*MsgDeleteTradeRoute)(nil), "stride.stakeibc.MsgDeleteTradeRoute")
+	proto.RegisterType((*MsgDeleteTradeRouteResponse)(nil), "stride.stakeibc.MsgDeleteTradeRouteResponse")
+	proto.RegisterType((*MsgUpdateTradeRoute)(nil), "stride.stakeibc.MsgUpdateTradeRoute")
+	proto.RegisterType((*MsgUpdateTradeRouteResponse)(nil), "stride.stakeibc.MsgUpdateTradeRouteResponse")
+	proto.RegisterType((*MsgSetCommunityPoolRebate)(nil), "stride.stakeibc.MsgSetCommunityPoolRebate")
+	proto.RegisterType((*MsgSetCommunityPoolRebateResponse)(nil), "stride.stakeibc.MsgSetCommunityPoolRebateResponse")
+	proto.RegisterType((*MsgToggleTradeController)(nil), "stride.stakeibc.MsgToggleTradeController")
+	proto.RegisterType((*MsgToggleTradeControllerResponse)(nil), "stride.stakeibc.MsgToggleTradeControllerResponse")
+	proto.RegisterType((*MsgUpdateHostZoneParams)(nil), "stride.stakeibc.MsgUpdateHostZoneParams")
+	proto.RegisterType((*MsgUpdateHostZoneParamsResponse)(nil), "stride.stakeibc.MsgUpdateHostZoneParamsResponse")
+	proto.RegisterType((*MsgSetAutoValidatorWeighting)(nil), "stride.stakeibc.MsgSetAutoValidatorWeighting")
+	proto.RegisterType((*MsgSetAutoValidatorWeightingResponse)(nil), "stride.stakeibc.MsgSetAutoValidatorWeightingResponse")
+	proto.RegisterType((*MsgSetInstantRedemptionConfig)(nil), "stride.stakeibc.MsgSetInstantRedemptionConfig")
+	proto.RegisterType((*MsgSetInstantRedemptionConfigResponse)(nil), "stride.stakeibc.MsgSetInstantRedemptionConfigResponse")
+	proto.RegisterType((*MsgSetValidatorBlacklistPolicy)(nil), "stride.stakeibc.MsgSetValidatorBlacklistPolicy")
+	proto.RegisterType((*MsgSetValidatorBlacklistPolicyResponse)(nil), "stride.stakeibc.MsgSetValidatorBlacklistPolicyResponse")
+	proto.RegisterType((*MsgRemoveBlacklistedValidator)(nil), "stride.stakeibc.MsgRemoveBlacklistedValidator")
+	proto.RegisterType((*MsgRemoveBlacklistedValidatorResponse)(nil), "stride.stakeibc.MsgRemoveBlacklistedValidatorResponse")
+	proto.RegisterType((*MsgSetRedemptionRateGuardConfig)(nil), "stride.stakeibc.MsgSetRedemptionRateGuardConfig")
+	proto.RegisterType((*MsgSetRedemptionRateGuardConfigResponse)(nil), "stride.stakeibc.MsgSetRedemptionRateGuardConfigResponse")
+	proto.RegisterType((*MsgSetCircuitBreakerGuardian)(nil), "stride.stakeibc.MsgSetCircuitBreakerGuardian")
+	proto.RegisterType((*MsgSetCircuitBreakerGuardianResponse)(nil), "stride.stakeibc.MsgSetCircuitBreakerGuardianResponse")
+	proto.RegisterType((*MsgTripCircuitBreaker)(nil), "stride.stakeibc.MsgTripCircuitBreaker")
+	proto.RegisterType((*MsgTripCircuitBreakerResponse)(nil), "stride.stakeibc.MsgTripCircuitBreakerResponse")
+	proto.RegisterType((*MsgResetCircuitBreaker)(nil), "stride.stakeibc.MsgResetCircuitBreaker")
+	proto.RegisterType((*MsgResetCircuitBreakerResponse)(nil), "stride.stakeibc.MsgResetCircuitBreakerResponse")
+	proto.RegisterType((*MsgSetInsuranceFundConfig)(nil), "stride.stakeibc.MsgSetInsuranceFundConfig")
+	proto.RegisterType((*MsgSetInsuranceFundConfigResponse)(nil), "stride.stakeibc.MsgSetInsuranceFundConfigResponse")
+	proto.RegisterType((*MsgSetReconciliationThreshold)(nil), "stride.stakeibc.MsgSetReconciliationThreshold")
+	proto.RegisterType((*MsgSetReconciliationThresholdResponse)(nil), "stride.stakeibc.MsgSetReconciliationThresholdResponse")
+	proto.RegisterType((*RateLimitWhitelistEntry)(nil), "stride.stakeibc.RateLimitWhitelistEntry")
+	proto.RegisterType((*MsgOnboardHostZone)(nil), "stride.stakeibc.MsgOnboardHostZone")
+	proto.RegisterType((*MsgOnboardHostZoneResponse)(nil), "stride.stakeibc.MsgOnboardHostZoneResponse")
+	proto.RegisterType((*MsgSetUnbondCap)(nil), "stride.stakeibc.MsgSetUnbondCap")
+	proto.RegisterType((*MsgSetUnbondCapResponse)(nil), "stride.stakeibc.MsgSetUnbondCapResponse")
 }
 
-func (m *MsgCalibrateDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("stride/stakeibc/tx.proto", fileDescriptor_9b7e09c9ad51cd54) }
+
+var fileDescriptor_9b7e09c9ad51cd54 = []byte{
+	// 4494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0x5d, 0x6c, 0x1c, 0x59,
+	0x56, 0x4e, 0xfb, 0x2f, 0xf6, 0xb1, 0x13, 0xdb, 0x65, 0x3b, 0x69, 0x97, 0x63, 0xb7, 0x53, 0xce,
+	0x8f, 0xe3, 0x89, 0xbb, 0x63, 0xe7, 0x67, 0x76, 0x9d, 0x59, 0x58, 0xdb, 0xf1, 0x04, 0xb3, 0x71,
+	0x12, 0x95, 0x3d, 0x33, 0xcb, 0x48, 0xa8, 0xb7, 0x5c, 0x75, 0xdd, 0x2e, 0xa5, 0xba, 0xaa, 0xa9,
+	0xaa, 0xb6, 0x9d, 0x79, 0x40, 0x0b, 0x42, 0x62, 0xb5, 0x12, 0x62, 0xa5, 0x95, 0x78, 0x41, 0x42,
+	0xfb, 0x00, 0x2f, 0x3c, 0xcd, 0xc3, 0x68, 0x79, 0xe5, 0x09, 0xad, 0x84, 0x84, 0x96, 0x11, 0x20,
+	0x34, 0xac, 0xb2, 0xc3, 0x0c, 0xd2, 0x20, 0x24, 0x04, 0x8a, 0x84, 0x84, 0x78, 0x40, 0xe8, 0xfe,
+	0xd4, 0xed, 0xaa, 0x5b, 0xb7, 0xba, 0xcb, 0xc6, 0x66, 0xc3, 0x4b, 0x92, 0xbe, 0xf7, 0xbb, 0xe7,
+	0x9e, 0x73, 0xee, 0xb9, 0xe7, 0xde, 0x7b, 0xce, 0xa9, 0x40, 0x31, 0x08, 0x7d, 0xdb, 0x42, 0x95,
+	0x20, 0x34, 0x5e, 0x20, 0x7b, 0xd7, 0xac, 0x84, 0x47, 0xe5, 0x86, 0xef, 0x85, 0x9e, 0x32, 0x4c,
+	0x7b, 0xca, 0x51, 0x8f, 0x3a, 0x6a, 0xd4, 0x6d, 0xd7, 0xab, 0x90, 0x3f, 0x29, 0x46, 0x9d, 0x34,
+	0xbd, 0xa0, 0xee, 0x05, 0x55, 0xf2, 0xab, 0x42, 0x7f, 0xb0, 0xae, 0x19, 0xfa, 0xab, 0xb2, 0x6b,
+	0x04, 0xa8, 0x72, 0xb0, 0xb4, 0x8b, 0x42, 0x63, 0xa9, 0x62, 0x7a, 0xb6, 0xcb, 0xfa, 0x2f, 0xb3,
+	0xfe, 0x7a, 0x50, 0xab, 0x1c, 0x2c, 0xe1, 0xbf, 0x58, 0xc7, 0x78, 0xcd, 0xab, 0x79, 0x94, 0x20,
+	0xfe, 0x17, 0x6b, 0x2d, 0xd5, 0x3c, 0xaf, 0xe6, 0xa0, 0x0a, 0xf9, 0xb5, 0xdb, 0xdc, 0xab, 0x84,
+	0x76, 0x1d, 0x05, 0xa1, 0x51, 0x6f, 0x30, 0xc0, 0x15, 0x51, 0x90, 0x5d, 0x23, 0x78, 0x81, 0x42,
+	0xd6, 0x7b, 0x5d, 0xec, 0x35, 0x6d, 0xdf, 0x6c, 0xda, 0x61, 0x75, 0xd7, 0x47, 0xc6, 0x0b, 0xe4,
+	0x47, 0xb3, 0x88, 0xb0, 0x7d, 0x2f, 0x08, 0xab, 0x1f, 0x79, 0x2e, 0x62, 0x80, 0xab, 0x29, 0x75,
+	0xf9, 0x86, 0x85, 0xaa, 0xbe, 0xd7, 0x0c, 0x51, 0x16, 0x8d, 0x03, 0xc3, 0xb1, 0x2d, 0x23, 0xf4,
+	0xd8, 0x24, 0xda, 0xf7, 0xba, 0x41, 0xdb, 0x0a, 0x6a, 0xef, 0x35, 0x2c, 0x23, 0x44, 0x9b, 0xae,
+	0x8b, 0x7c, 0x1d, 0x59, 0xa8, 0xde, 0x08, 0x6d, 0xcf, 0xd5, 0x8d, 0x10, 0xad, 0x79, 0x4d, 0xd7,
+	0x0a, 0x94, 0x65, 0x38, 0x6f, 0xfa, 0x08, 0x8f, 0x2b, 0x16, 0x66, 0x0b, 0xf3, 0x03, 0x6b, 0xc5,
+	0x4f, 0x3f, 0x59, 0x1c, 0x67, 0x3a, 0x5e, 0xb5, 0x2c, 0x1f, 0x05, 0xc1, 0x76, 0xe8, 0xdb, 0x6e,
+	0x4d, 0x8f, 0x80, 0xca, 0x24, 0xf4, 0x9b, 0xfb, 0x86, 0xed, 0x56, 0x6d, 0xab, 0xd8, 0x85, 0x07,
+	0xe9, 0xe7, 0xc9, 0xef, 0x4d, 0x4b, 0x71, 0x60, 0xb2, 0x8e, 0x3b, 0xf0, 0x7c, 0x55, 0x9f, 0x4f,
+	0x58, 0xf5, 0x8d, 0x10, 0x15, 0xbb, 0xc9, 0x04, 0x4b, 0x3f, 0x79, 0x55, 0x3a, 0xf7, 0xd9, 0xab,
+	0xd2, 0x14, 0x9d, 0x24, 0xb0, 0x5e, 0x94, 0x6d, 0xaf, 0x52, 0x37, 0xc2, 0xfd, 0xf2, 0x13, 0x54,
+	0x33, 0xcc, 0x97, 0x8f, 0x90, 0xf9, 0xe9, 0x27, 0x8b, 0xc0, 0x78, 0x78, 0x84, 0x4c, 0xfd, 0x52,
+	0xdd, 0x76, 0x25, 0x22, 0x90, 0xd9, 0x8c, 0xa3, 0x8c, 0xd9, 0x7a, 0x4e, 0x3e, 0x9b, 0x71, 0x24,
+	0x99, 0x6d, 0xe5, 0xed, 0xdf, 0xfe, 0xea, 0xe3, 0x85, 0x48, 0x09, 0xdf, 0xff, 0xea, 0xe3, 0x85,
+	0x1b, 0x5c, 0xf9, 0x5c, 0xd1, 0x32, 0x1d, 0x6b, 0xb7, 0x61, 0xa1, 0xf3, 0x4a, 0xe8, 0x28, 0x68,
+	0x78, 0x6e, 0x80, 0xb4, 0x3f, 0xe9, 0x82, 0x8b, 0x5b, 0x41, 0xed, 0x89, 0xfd, 0x1b, 0x4d, 0xdb,
+	0xda, 0xc6, 0x33, 0x9c, 0x68, 0x91, 0xee, 0x43, 0x9f, 0x51, 0xf7, 0x9a, 0x6e, 0x48, 0x97, 0x68,
+	0x6d, 0x9a, 0x29, 0x62, 0x22, 0xad, 0x88, 0x4d, 0x37, 0xd4, 0x19, 0x58, 0x99, 0x06, 0x20, 0xd6,
+	0x68, 0x21, 0xd7, 0xab, 0xd3, 0x15, 0xd3, 0x07, 0x70, 0xcb, 0x23, 0xdc, 0xa0, 0x54, 0x61, 0x82,
+	0x1b, 0x5a, 0xb5, 0xe1, 0xa3, 0x3d, 0xe4, 0x23, 0xd7, 0x44, 0x41, 0xb1, 0x67, 0xb6, 0x7b, 0x7e,
+	0x70, 0xf9, 0x5a, 0x59, 0xd8, 0xce, 0xe5, 0xf7, 0x23, 0xf4, 0x73, 0x0e, 0x5e, 0xeb, 0xc1, 0xac,
+	0xe8, 0xe3, 0x07, 0xe9, 0xae, 0x60, 0x65, 0x5e, 0x54, 0xf2, 0xe5, 0xb8, 0x92, 0x63, 0x4a, 0xd1,
+	0xbe, 0x5b, 0x80, 0x4b, 0xc9, 0xa6, 0x48, 0x85, 0xca, 0x1e, 0xf4, 0x07, 0x61, 0x35, 0xf4, 0x5e,
+	0x20, 0x97, 0x28, 0x6c, 0x70, 0x79, 0xb2, 0xcc, 0xb4, 0x85, 0x1d, 0x45, 0x99, 0x39, 0x8a, 0xf2,
+	0xba, 0x67, 0xbb, 0x6b, 0x77, 0x30, 0x37, 0x7f, 0xfa, 0xf3, 0xd2, 0x7c, 0xcd, 0x0e, 0xf7, 0x9b,
+	0xbb, 0x65, 0xd3, 0xab, 0x33, 0x1f, 0xc3, 0xfe, 0x5a, 0x0c, 0xac, 0x17, 0x95, 0xf0, 0x65, 0x03,
+	0x05, 0x64, 0x40, 0xa0, 0x9f, 0x0f, 0xc2, 0x1d, 0x4c, 0x5b, 0xfb, 0xac, 0x00, 0xa3, 0x98, 0x85,
+	0xed, 0xad, 0x5f, 0xd0, 0x6a, 0x2d, 0xc2, 0x98, 0x13, 0xd4, 0xa9, 0xa4, 0x55, 0x7b, 0xd7, 0x4c,
+	0x2c, 0xdb, 0x88, 0x13, 0xd4, 0x09, 0x9f, 0x9b, 0xbb, 0x26, 0x59, 0xbd, 0x95, 0xb7, 0x44, 0xe5,
+	0xaa, 0x09, 0xe5, 0x26, 0xc4, 0xd0, 0x9e, 0xc2, 0x64, 0xaa, 0x91, 0x6b, 0x78, 0x09, 0xc6, 0x43,
+	0xdf, 0x70, 0x03, 0xc3, 0x24, 0x1b, 0xce, 0xf4, 0xea, 0x0d, 0x07, 0x85, 0x88, 0x08, 0xdc, 0xaf,
+	0x8f, 0xc5, 0xfa, 0xd6, 0x59, 0x97, 0xf6, 0xb3, 0x02, 0x0c, 0x6f, 0x05, 0xb5, 0x75, 0x07, 0x19,
+	0xfe, 0x9a, 0xe1, 0x18, 0xae, 0x89, 0x4e, 0xdb, 0xfb, 0xb4, 0xb4, 0xd8, 0x7d, 0x1c, 0x2d, 0x16,
+	0x01, 0x53, 0x70, 0x5d, 0xe4, 0x14, 0x7b, 0x38, 0x41, 0xfc, 0x73, 0xe5, 0x96, 0xa8, 0xb0, 0x62,
+	0x5c, 0x61, 0x71, 0x51, 0xb4, 0x49, 0xb8, 0x2c, 0x34, 0xf1, 0x1d, 0xfd, 0x9f, 0x05, 0xb2, 0xa3,
+	0xf1, 0xae, 0x47, 0xf5, 0xff, 0x73, 0x1b, 0x99, 0x82, 0x01, 0x7e, 0xbe, 0x30, 0xcb, 0xe8, 0xc7,
+	0x0d, 0x1f, 0x7a, 0x2e, 0x52, 0xee, 0x41, 0xbf, 0x8f, 0x4c, 0x64, 0x1f, 0x20, 0xbf, 0xd8, 0xd3,
+	0x81, 0x11, 0x8e, 0xec, 0xb0, 0x49, 0x63, 0x72, 0x6a, 0x45, 0xb8, 0x94, 0x6c, 0xe1, 0x4a, 0xf9,
+	0xc3, 0x2e, 0x98, 0xd8, 0x0a, 0x6a, 0x9b, 0x6e, 0x10, 0x1a, 0x6e, 0xf8, 0x26, 0xea, 0x66, 0x13,
+	0x46, 0xf1, 0x59, 0xe6, 0x1a, 0xa1, 0x7d, 0x80, 0xaa, 0x8c, 0x7c, 0x4f, 0x1e, 0xf2, 0xc3, 0x75,
+	0xdb, 0x7d, 0x4a, 0x86, 0xad, 0x92, 0x51, 0x2b, 0x15, 0x51, 0x61, 0x33, 0x71, 0x85, 0xa5, 0x75,
+	0xa0, 0xfd, 0x41, 0x01, 0xa6, 0xa5, 0x3d, 0x7c, 0x07, 0xae, 0xc1, 0x10, 0xe3, 0x2c, 0xa7, 0x9f,
+	0xa3, 0x5e, 0x77, 0x90, 0x0e, 0x22, 0x7e, 0x41, 0x59, 0x82, 0xee, 0x3d, 0x84, 0x8a, 0x5d, 0xf9,
+	0x86, 0x62, 0xac, 0xf6, 0x79, 0x1f, 0x8c, 0x91, 0x15, 0xad, 0xd9, 0x41, 0x88, 0xfc, 0x5f, 0x89,
+	0x94, 0xf5, 0x0d, 0xb8, 0x60, 0x7a, 0xae, 0x8b, 0xa8, 0x3f, 0x88, 0xb6, 0xe6, 0x5a, 0xf1, 0xf5,
+	0xab, 0xd2, 0xf8, 0x4b, 0xa3, 0xee, 0xac, 0x68, 0x89, 0x6e, 0x4d, 0x1f, 0x6a, 0xfd, 0xde, 0xb4,
+	0x14, 0x0d, 0x86, 0x76, 0x91, 0xb9, 0x7f, 0x77, 0x19, 0x9f, 0x29, 0xf6, 0x51, 0x71, 0x88, 0xac,
+	0x45, 0xa2, 0x4d, 0xb9, 0x97, 0x38, 0x9a, 0xe8, 0x42, 0x4c, 0xbc, 0x7e, 0x55, 0x1a, 0xa5, 0xf4,
+	0x5b, 0x7d, 0x5a, 0xfc, 0xc4, 0x5a, 0x82, 0x81, 0x96, 0x63, 0xec, 0x25, 0x83, 0xc6, 0x5f, 0xbf,
+	0x2a, 0x8d, 0xd0, 0x41, 0xbc, 0x4b, 0xd3, 0xfb, 0x6d, 0xe6, 0x26, 0xe3, 0x06, 0xd8, 0x97, 0xd7,
+	0x00, 0x9f, 0x02, 0x75, 0x7a, 0x7b, 0xc8, 0xaf, 0x32, 0xef, 0x81, 0xb5, 0x00, 0x64, 0xfc, 0xcc,
+	0xeb, 0x57, 0x25, 0x95, 0x4e, 0x28, 0x01, 0x69, 0xfa, 0x68, 0xd4, 0xba, 0x4e, 0x1b, 0x37, 0x2d,
+	0xe5, 0x5d, 0x18, 0x69, 0xba, 0xbb, 0x9e, 0x6b, 0xd9, 0x6e, 0xad, 0xda, 0x40, 0xbe, 0xed, 0x59,
+	0xc5, 0xc1, 0xd9, 0xc2, 0x7c, 0xcf, 0xda, 0xd4, 0xeb, 0x57, 0xa5, 0xcb, 0x94, 0x98, 0x88, 0xd0,
+	0xf4, 0x61, 0xde, 0xf4, 0x9c, 0xb4, 0x28, 0x06, 0x8c, 0x61, 0x23, 0x16, 0x2f, 0x47, 0x17, 0x4e,
+	0x7a, 0x39, 0xc2, 0x5b, 0x42, 0xb8, 0x85, 0xe1, 0x29, 0x8c, 0xa3, 0xd4, 0x14, 0x17, 0x4f, 0x3e,
+	0x85, 0x71, 0x24, 0x4c, 0xf1, 0x36, 0x14, 0xf1, 0x39, 0xe7, 0x90, 0x93, 0xa8, 0x4a, 0xf6, 0x4e,
+	0x15, 0xb9, 0xc6, 0xae, 0x83, 0xac, 0xe2, 0x30, 0x39, 0x72, 0x26, 0x9c, 0xa0, 0x1e, 0x3b, 0xa8,
+	0x36, 0x68, 0xa7, 0xb2, 0x01, 0x25, 0xd3, 0xab, 0xd7, 0x9b, 0xae, 0x1d, 0xbe, 0xac, 0x36, 0x3c,
+	0xcf, 0xa9, 0x86, 0x3e, 0x32, 0x82, 0xa6, 0xff, 0xb2, 0x6a, 0xd0, 0x85, 0x2c, 0x8e, 0x10, 0x53,
+	0xbb, 0xc2, 0x61, 0xcf, 0x3d, 0xcf, 0xd9, 0x61, 0x20, 0xb6, 0xd8, 0xca, 0x3d, 0xb8, 0x8c, 0x45,
+	0xac, 0xa3, 0x20, 0x30, 0x6a, 0x28, 0xc0, 0xea, 0xae, 0xda, 0xa6, 0x51, 0x0d, 0x8f, 0x8a, 0xa3,
+	0x78, 0x51, 0x74, 0xac, 0x81, 0x2d, 0xd6, 0xfb, 0x1c, 0xf9, 0x9b, 0xa6, 0xb1, 0x73, 0xb4, 0x72,
+	0xff, 0x7b, 0x3f, 0x2a, 0x9d, 0xfb, 0xe7, 0x1f, 0x95, 0xce, 0x89, 0xbb, 0xff, 0x4a, 0xd2, 0x5d,
+	0x26, 0xb7, 0x92, 0x36, 0x0d, 0x53, 0x92, 0x66, 0xee, 0x38, 0x5f, 0x15, 0xc8, 0xc1, 0xbc, 0xee,
+	0x18, 0x76, 0xfd, 0x3d, 0xd7, 0x42, 0x0e, 0xaa, 0x19, 0x21, 0xb2, 0xc8, 0x8e, 0x3e, 0xd9, 0x7d,
+	0x7e, 0x16, 0x86, 0xb8, 0x17, 0x6c, 0x9d, 0xaa, 0x10, 0x39, 0xc2, 0x4d, 0x4b, 0x19, 0x87, 0x5e,
+	0xd4, 0xf0, 0xcc, 0x7d, 0xe2, 0x23, 0x7b, 0x74, 0xfa, 0x43, 0x51, 0x63, 0x87, 0x47, 0x2f, 0x75,
+	0x9e, 0xfc, 0x88, 0xb8, 0x2b, 0xca, 0xac, 0x25, 0x4f, 0x4e, 0x19, 0xf3, 0xbf, 0xda, 0xd3, 0xdf,
+	0x33, 0xd2, 0xab, 0xcd, 0xc1, 0xd5, 0x4c, 0x08, 0xd7, 0xc2, 0x8f, 0xbb, 0x88, 0x96, 0x76, 0xd8,
+	0xc6, 0x89, 0xd9, 0x0b, 0x32, 0x3d, 0xdf, 0xfa, 0x85, 0xe9, 0xa1, 0x27, 0xa9, 0x07, 0xe5, 0x3e,
+	0x0c, 0xb8, 0xe8, 0xb0, 0xea, 0x1d, 0xba, 0x91, 0x92, 0xda, 0x9d, 0xb0, 0x2e, 0x3a, 0x7c, 0x86,
+	0x91, 0xca, 0x55, 0x18, 0xc2, 0xc3, 0x38, 0x59, 0xe2, 0x87, 0xf4, 0x41, 0x17, 0x1d, 0xea, 0x91,
+	0x86, 0xef, 0x8b, 0x1a, 0xbe, 0x16, 0xd7, 0x70, 0x96, 0x62, 0xb4, 0xeb, 0x30, 0xd7, 0xa6, 0x9b,
+	0xeb, 0xf7, 0x87, 0x5d, 0xc4, 0xcf, 0xaf, 0xe3, 0x8b, 0x8c, 0xd3, 0x42, 0xbd, 0x31, 0x7a, 0xdd,
+	0x80, 0xe1, 0xe8, 0x8a, 0x1f, 0x1d, 0xcd, 0xbd, 0x79, 0x8e, 0xe6, 0x0b, 0xec, 0xee, 0xce, 0x0e,
+	0xe6, 0xc5, 0xb6, 0x5b, 0x53, 0x94, 0x5e, 0xfb, 0x35, 0x98, 0x92, 0x34, 0xf3, 0x33, 0x79, 0xe5,
+	0x38, 0xef, 0x0e, 0x7a, 0xa8, 0xf2, 0xb7, 0xc4, 0xcf, 0x0a, 0xd4, 0xa0, 0xf1, 0x0f, 0xfb, 0x23,
+	0xf4, 0xa6, 0x1a, 0x74, 0x27, 0xb3, 0xcb, 0x60, 0x5f, 0x7b, 0x07, 0xe6, 0xda, 0x74, 0x73, 0x0d,
+	0x4e, 0x40, 0x9f, 0xbb, 0x17, 0x62, 0x5e, 0x89, 0x90, 0x7a, 0xaf, 0xbb, 0x17, 0x6e, 0x5a, 0xda,
+	0xe7, 0xf4, 0x3a, 0xf4, 0x08, 0x85, 0x6f, 0xba, 0x7a, 0xda, 0x07, 0x09, 0xb2, 0x05, 0xd0, 0x6e,
+	0xc2, 0xf5, 0xb6, 0x00, 0xbe, 0x33, 0xff, 0xaa, 0x00, 0xe3, 0xc9, 0x77, 0xef, 0x1a, 0x89, 0x41,
+	0x9d, 0x48, 0x05, 0x53, 0x30, 0x40, 0x23, 0x58, 0x2d, 0xf9, 0xfb, 0x69, 0xc3, 0x89, 0x9f, 0x53,
+	0x2b, 0x65, 0x51, 0x05, 0xd3, 0x19, 0x4f, 0x78, 0xca, 0xb7, 0xf6, 0x37, 0x05, 0xb8, 0x22, 0xeb,
+	0x88, 0x5f, 0x75, 0x19, 0x93, 0xc7, 0xbb, 0xea, 0xd2, 0x41, 0xf4, 0xaa, 0xdb, 0x80, 0x0b, 0xf1,
+	0xeb, 0x72, 0x50, 0xec, 0x9a, 0xed, 0x6e, 0x4f, 0xe4, 0xf8, 0x71, 0x81, 0xa1, 0xd8, 0xdd, 0x3a,
+	0xd0, 0xbe, 0x0d, 0xc5, 0x48, 0x8e, 0xd8, 0x4a, 0x52, 0xef, 0x25, 0x5a, 0x5e, 0x21, 0x65, 0x79,
+	0x71, 0x1b, 0xeb, 0x4a, 0xda, 0x18, 0xf6, 0xcd, 0xc3, 0xfc, 0x55, 0xf5, 0x66, 0x2d, 0xbe, 0xb2,
+	0x05, 0x03, 0x11, 0x9f, 0x51, 0x50, 0xe8, 0x56, 0x2a, 0x28, 0x94, 0xa5, 0x17, 0xb6, 0x70, 0x2d,
+	0x0a, 0x1d, 0x1e, 0xe0, 0x71, 0x0d, 0xb0, 0x07, 0x78, 0xbc, 0x89, 0x6f, 0x99, 0x7f, 0x60, 0xa1,
+	0x07, 0x4c, 0x26, 0xda, 0x2d, 0x0f, 0x60, 0xc0, 0x68, 0x86, 0xfb, 0x9e, 0x6f, 0x87, 0x2f, 0x3b,
+	0xaa, 0xac, 0x05, 0x6d, 0xaf, 0xb4, 0x77, 0x01, 0x70, 0x28, 0xc4, 0x73, 0x91, 0x1b, 0x06, 0xc5,
+	0x6e, 0x22, 0xfe, 0x6c, 0x86, 0xf8, 0xeb, 0x11, 0x90, 0x49, 0x1d, 0x1b, 0x49, 0x03, 0x35, 0xad,
+	0x49, 0xd3, 0x91, 0x87, 0x98, 0x24, 0x51, 0xe4, 0x21, 0xd6, 0xc4, 0x05, 0xff, 0xf3, 0x02, 0x7b,
+	0x7f, 0xef, 0xd2, 0x90, 0x04, 0x8f, 0xc6, 0x05, 0x27, 0x35, 0x98, 0xd6, 0x73, 0xb9, 0x4b, 0x78,
+	0x2e, 0xcf, 0xc1, 0x05, 0xb7, 0x59, 0xaf, 0xfa, 0xd1, 0x5c, 0xcc, 0x67, 0x0e, 0xb9, 0xcd, 0x3a,
+	0x9f, 0x7f, 0xe5, 0x8e, 0xb8, 0x9e, 0xa5, 0xe4, 0x7a, 0xa6, 0xf8, 0xd4, 0x66, 0x61, 0x46, 0xde,
+	0xc3, 0x85, 0xfc, 0xcb, 0x02, 0x8c, 0x6c, 0x05, 0xb5, 0x55, 0xcb, 0x3a, 0x4b, 0xf1, 0x56, 0x00,
+	0x78, 0xc0, 0x32, 0x5a, 0x5a, 0x35, 0x3b, 0xdc, 0xa9, 0xc7, 0xd0, 0x2b, 0x0b, 0xa2, 0xd4, 0x93,
+	0x71, 0xa9, 0x13, 0x8c, 0x6b, 0x2a, 0x14, 0xc5, 0x36, 0x2e, 0xe9, 0x1e, 0x0c, 0xf3, 0xd6, 0x0f,
+	0x90, 0x5d, 0xdb, 0x0f, 0x95, 0x87, 0x70, 0x3e, 0x7a, 0xc8, 0x50, 0x39, 0xaf, 0x7e, 0xfa, 0xc9,
+	0xe2, 0x34, 0x93, 0x93, 0x83, 0x05, 0x81, 0xd9, 0x08, 0xe5, 0x12, 0xf4, 0x1d, 0x12, 0x32, 0x44,
+	0xda, 0x1e, 0x9d, 0xfd, 0xd2, 0xfe, 0x9d, 0x3d, 0x31, 0xf6, 0x0d, 0xb7, 0x86, 0x84, 0x19, 0xcf,
+	0x40, 0xb5, 0x5b, 0x30, 0xda, 0x0a, 0x2a, 0x53, 0x16, 0xb2, 0x37, 0x8f, 0xc0, 0x8e, 0x3e, 0x72,
+	0x20, 0xf0, 0xd7, 0xe9, 0xe9, 0x21, 0x15, 0x2a, 0x7a, 0x74, 0x48, 0x3b, 0xb9, 0xfe, 0xff, 0xba,
+	0x00, 0x0a, 0x39, 0xa4, 0x1d, 0x14, 0xb6, 0x50, 0xa7, 0xaf, 0x90, 0x77, 0xa0, 0xff, 0xc0, 0x70,
+	0xc8, 0x0b, 0xb5, 0xd8, 0x9d, 0x7b, 0x55, 0x0f, 0x0c, 0x07, 0xb7, 0xac, 0xdc, 0x16, 0xe5, 0x9f,
+	0x4a, 0x5e, 0x41, 0x12, 0xcc, 0x6b, 0x57, 0x40, 0x4d, 0xb7, 0x72, 0x89, 0xff, 0xa5, 0xc0, 0x1e,
+	0xa3, 0x41, 0xe8, 0xf9, 0x68, 0xd3, 0x0d, 0x91, 0x4f, 0x82, 0xad, 0xab, 0xa6, 0x49, 0xdc, 0xfd,
+	0x29, 0x07, 0x70, 0xe7, 0xc4, 0x28, 0x52, 0x37, 0x8d, 0x03, 0x25, 0x62, 0x45, 0x73, 0x70, 0xc1,
+	0xa0, 0xd3, 0xb3, 0x67, 0x15, 0xbd, 0x83, 0x0d, 0xb1, 0x46, 0xf2, 0x80, 0x5a, 0x59, 0x16, 0x95,
+	0x70, 0x35, 0xe9, 0x68, 0x24, 0xf2, 0xb0, 0xa7, 0x51, 0x96, 0xac, 0x5c, 0x27, 0x7f, 0x14, 0x3d,
+	0xc0, 0xbd, 0x00, 0x3d, 0xa2, 0xcf, 0x53, 0x1c, 0xe7, 0xa6, 0xa1, 0x9b, 0x53, 0xd6, 0x48, 0x07,
+	0x39, 0xa4, 0x3c, 0xf0, 0x07, 0xb4, 0x8c, 0x3f, 0x2e, 0xc5, 0x3f, 0x15, 0x60, 0x96, 0x67, 0xa5,
+	0xf8, 0xc2, 0x6f, 0xef, 0x1b, 0x3e, 0x0a, 0x36, 0x8e, 0xcc, 0x7d, 0x12, 0x77, 0x39, 0xe5, 0xe5,
+	0x7d, 0x08, 0xd8, 0x48, 0xbd, 0x06, 0x3a, 0xa6, 0x59, 0xe3, 0x11, 0x2b, 0xf7, 0x44, 0x4d, 0xcc,
+	0xa5, 0xd3, 0x6f, 0xef, 0x1b, 0x4e, 0x52, 0x02, 0x6d, 0x01, 0xe6, 0x3b, 0x49, 0xc9, 0x55, 0xf2,
+	0x77, 0xf4, 0xb4, 0x5c, 0x37, 0x1c, 0x7b, 0xd7, 0x37, 0xc2, 0x98, 0xf2, 0xde, 0x28, 0x45, 0xb4,
+	0x3f, 0x43, 0x25, 0xdc, 0xb3, 0x33, 0x54, 0xd2, 0xc3, 0x45, 0xff, 0x7d, 0x9a, 0xc9, 0xd2, 0x51,
+	0xd0, 0xac, 0x23, 0x1e, 0xd4, 0x3d, 0x65, 0x5b, 0x6e, 0x9f, 0x7e, 0x4a, 0xce, 0xad, 0x4d, 0xc1,
+	0x64, 0xaa, 0xb1, 0x95, 0x3c, 0xa0, 0x6f, 0xa0, 0x47, 0xa8, 0xe1, 0x23, 0xd3, 0x08, 0x5b, 0x1c,
+	0x9f, 0xf4, 0x56, 0xd7, 0x86, 0xeb, 0x3b, 0xe9, 0xbb, 0xd8, 0x74, 0xd2, 0xa1, 0x0a, 0x4c, 0x68,
+	0x33, 0x70, 0x45, 0xd6, 0xce, 0xb9, 0xff, 0xaf, 0x01, 0x1a, 0x5b, 0x21, 0x37, 0xb6, 0x1d, 0xdf,
+	0xb0, 0x90, 0xee, 0x35, 0xc3, 0x93, 0x33, 0xaf, 0xc1, 0x05, 0x72, 0x96, 0x08, 0x12, 0x0c, 0xe2,
+	0xc6, 0x75, 0x66, 0x71, 0x6b, 0x30, 0x43, 0x4f, 0xd2, 0x6a, 0xe8, 0x55, 0x7d, 0x74, 0x68, 0xf8,
+	0x56, 0x55, 0xe6, 0x6a, 0x55, 0x8a, 0xda, 0xf1, 0x74, 0x82, 0x59, 0x8f, 0x3b, 0xde, 0x6f, 0xc2,
+	0x74, 0x8b, 0x06, 0x2d, 0x49, 0x48, 0x92, 0xa0, 0x8e, 0x78, 0x32, 0x22, 0x41, 0x44, 0x4b, 0x50,
+	0xd8, 0x04, 0x1a, 0xa6, 0x6f, 0xf1, 0x20, 0x0b, 0x9a, 0xd3, 0x58, 0xe2, 0x34, 0x46, 0x46, 0x7c,
+	0xec, 0xa4, 0x02, 0xe4, 0xdf, 0x82, 0xb9, 0x88, 0x44, 0xc4, 0x8c, 0x8c, 0x16, 0x0d, 0x9c, 0xcd,
+	0x50, 0x28, 0x63, 0x29, 0x4d, 0xec, 0x31, 0x5c, 0x65, 0x24, 0xbc, 0x2a, 0x65, 0x50, 0x42, 0xea,
+	0x3c, 0x0d, 0x14, 0x13, 0xe0, 0x8e, 0x87, 0x57, 0x35, 0x4d, 0xa8, 0x02, 0xe3, 0x8c, 0x2b, 0x92,
+	0x55, 0xa8, 0x7a, 0x2e, 0xa1, 0x57, 0xec, 0x27, 0x63, 0x47, 0x69, 0x1f, 0xc9, 0x32, 0x3c, 0x73,
+	0x31, 0x05, 0xe5, 0x2e, 0x5c, 0x12, 0x07, 0xd0, 0xdf, 0xc5, 0x01, 0x32, 0x64, 0x2c, 0x31, 0x84,
+	0x2a, 0x43, 0x59, 0x82, 0x09, 0x71, 0x10, 0xe1, 0x8a, 0xa6, 0x1b, 0x74, 0x25, 0x31, 0x86, 0x88,
+	0x8c, 0x33, 0xc5, 0xad, 0x04, 0x49, 0x6b, 0xc0, 0x20, 0xcd, 0x14, 0xf3, 0x74, 0x49, 0x04, 0x7f,
+	0x0b, 0x94, 0x24, 0x9c, 0x48, 0x41, 0xb3, 0x32, 0xc3, 0x31, 0x34, 0x91, 0x61, 0x0a, 0xce, 0x93,
+	0xd0, 0xba, 0x6d, 0x91, 0xbc, 0x42, 0xcf, 0x5a, 0x57, 0xb1, 0xa0, 0xf7, 0xe1, 0xa6, 0x4d, 0x4b,
+	0xf9, 0x25, 0x50, 0x71, 0xe8, 0xdc, 0x70, 0x1c, 0xef, 0x10, 0x59, 0xd5, 0xe0, 0xd0, 0x68, 0x54,
+	0x1d, 0x2f, 0x08, 0xe2, 0x49, 0x02, 0x8c, 0xc7, 0x55, 0x17, 0xab, 0x14, 0xb4, 0x7d, 0x68, 0x34,
+	0x9e, 0x78, 0x41, 0x40, 0x8e, 0xa0, 0x0d, 0xc0, 0xd9, 0x34, 0x3a, 0x8e, 0x3d, 0x48, 0x87, 0x73,
+	0x05, 0xfa, 0xea, 0xb6, 0x8b, 0x09, 0xd1, 0x40, 0x1f, 0x21, 0x63, 0x1c, 0x25, 0xc8, 0x8c, 0xe4,
+	0x23, 0x63, 0x1c, 0xc5, 0xc8, 0x6c, 0xd1, 0x74, 0x0a, 0x37, 0x0f, 0x46, 0x6a, 0x34, 0x0f, 0x29,
+	0x9c, 0x3a, 0x89, 0x2c, 0x86, 0x91, 0x7b, 0x07, 0x06, 0xa9, 0xdd, 0x1d, 0x20, 0xb7, 0x89, 0x8a,
+	0xca, 0x6c, 0x61, 0xfe, 0xe2, 0xf2, 0x54, 0xea, 0xce, 0x4b, 0xd6, 0xe4, 0x7d, 0x0c, 0xd1, 0x21,
+	0xe4, 0xff, 0x56, 0xb6, 0xe0, 0x5a, 0x6b, 0x0b, 0x44, 0x3b, 0x53, 0x62, 0xb8, 0x63, 0x64, 0xd9,
+	0x4a, 0xd1, 0x1e, 0xd8, 0xa6, 0xdb, 0x33, 0x65, 0xbb, 0x12, 0x53, 0xa4, 0x44, 0x8b, 0xe3, 0x12,
+	0x53, 0xa4, 0x54, 0x68, 0x66, 0x33, 0xe9, 0x1d, 0xaf, 0xa4, 0x5f, 0xaa, 0x2d, 0x27, 0xc7, 0xb2,
+	0x1b, 0x62, 0x73, 0xfc, 0xc5, 0x3a, 0xc6, 0xef, 0xa3, 0xa7, 0xe0, 0x1b, 0xaf, 0xc2, 0x50, 0x5c,
+	0xa8, 0xc8, 0x35, 0xc6, 0x44, 0xe9, 0x50, 0xf2, 0xd2, 0x51, 0x42, 0x91, 0x55, 0x26, 0xa1, 0xd8,
+	0xcc, 0x25, 0xfc, 0xef, 0x6e, 0x18, 0xe3, 0x57, 0x92, 0x37, 0x41, 0xc2, 0xf8, 0xfe, 0xed, 0x39,
+	0xe6, 0xfe, 0xed, 0xed, 0xb8, 0x7f, 0x1f, 0xa7, 0xf7, 0x2f, 0x4d, 0xaa, 0x96, 0xda, 0xee, 0x96,
+	0x62, 0x41, 0xdc, 0xc1, 0x8f, 0xd3, 0x3b, 0xf8, 0x7c, 0x5e, 0x42, 0x67, 0xb8, 0x87, 0x3b, 0xda,
+	0x87, 0xb8, 0xd0, 0xcc, 0x3e, 0xc4, 0x66, 0x6e, 0x1f, 0x7f, 0xd1, 0x45, 0x6e, 0x3e, 0xdb, 0x24,
+	0x42, 0xd4, 0x4a, 0x49, 0xe2, 0x08, 0xc8, 0xe9, 0xdf, 0xc8, 0x1f, 0xc1, 0xa0, 0x4f, 0x08, 0xc7,
+	0x2b, 0xf4, 0xe6, 0x72, 0xe4, 0x6c, 0x75, 0xa0, 0xe3, 0xc8, 0x1a, 0x57, 0x61, 0x3a, 0x9e, 0x9a,
+	0xc5, 0x7f, 0x25, 0x53, 0x33, 0xb9, 0xaa, 0x26, 0x26, 0x9d, 0x56, 0x04, 0xd8, 0xda, 0x4e, 0xa4,
+	0x69, 0xda, 0x3f, 0xe9, 0xe5, 0xaa, 0x62, 0xcf, 0x20, 0x79, 0x27, 0xd7, 0xf6, 0x1f, 0x77, 0x91,
+	0x78, 0xcb, 0x8e, 0x57, 0xab, 0x39, 0x28, 0xba, 0xb0, 0x84, 0xbe, 0xe7, 0x38, 0xc8, 0x3f, 0x6d,
+	0x65, 0x6f, 0xc3, 0x68, 0x03, 0xf9, 0x75, 0x3b, 0x08, 0x48, 0xcd, 0x14, 0x89, 0x35, 0x10, 0x95,
+	0x5f, 0x5c, 0xbe, 0x91, 0xf2, 0xf9, 0xab, 0xcd, 0x70, 0xff, 0xa3, 0xe7, 0x1c, 0x4e, 0x23, 0x13,
+	0xfa, 0x48, 0x43, 0x68, 0xc1, 0xc5, 0x4b, 0x51, 0x00, 0x88, 0x15, 0x2f, 0xc5, 0xa2, 0x3b, 0x0e,
+	0x59, 0x2e, 0xb2, 0x4b, 0xfb, 0x75, 0xf6, 0xab, 0xc3, 0x93, 0x52, 0xaa, 0x09, 0x4d, 0x83, 0xd9,
+	0xac, 0x3e, 0xae, 0xca, 0x3f, 0xeb, 0x81, 0xcb, 0xdc, 0xb0, 0xa3, 0x4b, 0xef, 0x73, 0xc3, 0x37,
+	0xea, 0xc1, 0x19, 0xdc, 0xcb, 0xdb, 0xe5, 0xe4, 0xbb, 0x33, 0x73, 0xf2, 0xca, 0x63, 0x18, 0xda,
+	0x43, 0xa8, 0x1a, 0x98, 0xfb, 0xc8, 0x6a, 0x3a, 0xb4, 0x4a, 0x54, 0x56, 0xb7, 0x18, 0xf1, 0xff,
+	0x2e, 0x42, 0xdb, 0x0c, 0xab, 0x0f, 0xee, 0xb5, 0x7e, 0x28, 0x73, 0x70, 0x11, 0x4f, 0x4f, 0x67,
+	0xac, 0xd6, 0x8c, 0x80, 0x68, 0xb9, 0x47, 0x1f, 0xc4, 0xd5, 0xa3, 0x78, 0xaa, 0xc7, 0x46, 0xa0,
+	0x94, 0x61, 0xcc, 0x47, 0x75, 0xef, 0x00, 0x55, 0x13, 0x93, 0xf6, 0x91, 0xf5, 0x18, 0xa5, 0x5d,
+	0xb1, 0x19, 0x94, 0x67, 0x30, 0x4e, 0xab, 0x35, 0x58, 0xb4, 0x33, 0xe9, 0xe8, 0x3a, 0xec, 0x1f,
+	0x85, 0x94, 0x66, 0xb0, 0x91, 0xcc, 0xd7, 0xdd, 0x81, 0x09, 0x3a, 0x4b, 0x55, 0x60, 0xb6, 0x3f,
+	0xce, 0xc2, 0x56, 0x8c, 0xe5, 0x6f, 0xc0, 0x54, 0x34, 0x42, 0xc6, 0xc9, 0x00, 0x19, 0x57, 0x64,
+	0xe3, 0x52, 0x13, 0xd2, 0x9d, 0x9a, 0xf4, 0x86, 0xb3, 0x69, 0x6f, 0x98, 0xb4, 0x0e, 0xed, 0x2a,
+	0x94, 0x32, 0xba, 0xb8, 0x71, 0xbd, 0xa6, 0x49, 0xa2, 0x6d, 0x14, 0xae, 0x36, 0x43, 0x4f, 0x88,
+	0xd0, 0xd9, 0x6e, 0xed, 0x2c, 0x2c, 0x6c, 0x03, 0xfa, 0x4c, 0xcf, 0xdd, 0xb3, 0x6b, 0xc4, 0xa0,
+	0x06, 0x97, 0x17, 0x65, 0x9b, 0x54, 0xc2, 0xcb, 0x3a, 0x19, 0xa4, 0xb3, 0xc1, 0x2b, 0x5f, 0x4b,
+	0xab, 0xe4, 0xba, 0xe0, 0xbe, 0xe4, 0x74, 0xb4, 0x1b, 0x70, 0xad, 0x5d, 0x3f, 0x57, 0xce, 0xbf,
+	0xd1, 0xf4, 0xe8, 0x36, 0x0a, 0x63, 0x05, 0x63, 0x34, 0xb5, 0x42, 0x79, 0x39, 0x0b, 0xed, 0x7c,
+	0x53, 0xd0, 0xce, 0x7c, 0x4a, 0x3b, 0x19, 0xcc, 0x70, 0xc5, 0x7c, 0x3d, 0xad, 0x98, 0x1b, 0x82,
+	0x62, 0x32, 0x48, 0xb0, 0x6c, 0x69, 0x36, 0x20, 0x6e, 0x37, 0x33, 0x14, 0xc9, 0xf5, 0xb7, 0xe6,
+	0x18, 0xe6, 0x0b, 0xc7, 0x0e, 0xc2, 0xe7, 0x9e, 0x63, 0x9b, 0x2f, 0xcf, 0x42, 0x37, 0xab, 0xd0,
+	0xd7, 0x20, 0xc4, 0x99, 0x6e, 0x6e, 0x65, 0x87, 0xb1, 0x05, 0x6e, 0x74, 0x36, 0x70, 0x65, 0x25,
+	0xad, 0x9c, 0x9b, 0x82, 0x72, 0xb2, 0x68, 0x68, 0xf3, 0x70, 0xa3, 0x3d, 0x82, 0xab, 0xe7, 0x33,
+	0x6a, 0x39, 0x3a, 0xd9, 0xce, 0x1c, 0x84, 0x5a, 0xc9, 0x87, 0xb3, 0xd0, 0xce, 0x5b, 0xf1, 0x78,
+	0x7f, 0x74, 0x78, 0xb1, 0x9a, 0xe5, 0x03, 0x21, 0xf8, 0xd5, 0xd1, 0x48, 0xb2, 0x59, 0x67, 0x46,
+	0x92, 0x0d, 0xe0, 0x5a, 0xf8, 0x8f, 0x02, 0x71, 0x40, 0xdb, 0x89, 0x9c, 0xa4, 0x11, 0xa2, 0xc7,
+	0x4d, 0x1a, 0x02, 0x39, 0xa3, 0x1d, 0xb4, 0x26, 0xec, 0xa0, 0x85, 0x94, 0x95, 0x64, 0xb2, 0xc3,
+	0xf7, 0xd0, 0xc3, 0xb4, 0x7a, 0xe6, 0x05, 0x33, 0xc9, 0x24, 0xa2, 0xdd, 0x82, 0x9b, 0x1d, 0x20,
+	0x12, 0xff, 0xbb, 0x4e, 0xbf, 0x69, 0x59, 0xa3, 0x9f, 0xb4, 0x10, 0xac, 0x6d, 0xb8, 0x27, 0xd6,
+	0x8f, 0x0a, 0xfd, 0x35, 0x46, 0x23, 0xca, 0x83, 0x44, 0xbf, 0x95, 0x47, 0x00, 0xe8, 0xa8, 0x61,
+	0xfb, 0x24, 0x56, 0xc9, 0x94, 0xa4, 0x96, 0xe9, 0x37, 0x3a, 0xe5, 0xe8, 0x1b, 0x9d, 0xf2, 0x4e,
+	0xf4, 0x8d, 0xce, 0x5a, 0x3f, 0x3e, 0x20, 0x7f, 0xf0, 0xf3, 0x52, 0x41, 0x8f, 0x8d, 0xcb, 0xe3,
+	0x7f, 0xe5, 0x32, 0xb5, 0xfc, 0xaf, 0xbc, 0x9f, 0x2b, 0xe7, 0x5f, 0x0b, 0xa4, 0x96, 0x79, 0xc7,
+	0xb7, 0x1b, 0x49, 0xa4, 0x72, 0x07, 0xfa, 0x02, 0xbb, 0x86, 0x93, 0x14, 0x9d, 0x54, 0xc2, 0x70,
+	0xf8, 0xd6, 0x56, 0xf7, 0xc8, 0x2d, 0x81, 0x6a, 0x83, 0xfd, 0x4a, 0xd8, 0x51, 0x77, 0xd2, 0x8e,
+	0x7e, 0x19, 0xce, 0xd3, 0x5a, 0x7b, 0x9a, 0x71, 0xbf, 0xb8, 0x7c, 0x3d, 0x65, 0x48, 0x49, 0xb6,
+	0x56, 0x09, 0x5a, 0x8f, 0x46, 0xd1, 0x8a, 0x0d, 0xc6, 0x40, 0xaa, 0x3a, 0x39, 0x2d, 0x95, 0x56,
+	0x82, 0x69, 0x69, 0x47, 0xfc, 0x40, 0xa2, 0x79, 0xe7, 0x00, 0x85, 0xff, 0x2f, 0x35, 0x52, 0x11,
+	0x34, 0x22, 0xa4, 0xa9, 0x53, 0x62, 0xf1, 0x34, 0x75, 0xaa, 0x87, 0xeb, 0xe4, 0xcb, 0x42, 0xf4,
+	0xae, 0xdb, 0x74, 0x83, 0xa6, 0x8f, 0xef, 0x4c, 0xef, 0x36, 0xdd, 0x33, 0x74, 0x2f, 0xef, 0x08,
+	0xee, 0xe5, 0x9a, 0xec, 0x80, 0x16, 0x19, 0xe1, 0x8e, 0xe5, 0x7e, 0x7a, 0xd7, 0x68, 0xe9, 0xc3,
+	0x59, 0x1c, 0xde, 0x7a, 0x74, 0xc9, 0x68, 0xc7, 0xb2, 0x8a, 0xd3, 0x91, 0xe3, 0x31, 0x3d, 0xd7,
+	0xb4, 0x1d, 0x9b, 0x6c, 0xd5, 0x9d, 0x7d, 0x1f, 0x05, 0xfb, 0x9e, 0x63, 0x9d, 0x85, 0x3a, 0x1e,
+	0xc2, 0x40, 0x18, 0xd1, 0xcf, 0x57, 0xd3, 0xd2, 0xc2, 0xe7, 0xb9, 0xaa, 0x64, 0x88, 0xd2, 0xba,
+	0xaa, 0x64, 0x00, 0xb8, 0x56, 0xb6, 0xe0, 0x32, 0xf6, 0xbe, 0x4f, 0xec, 0xba, 0x1d, 0x7e, 0xb0,
+	0x6f, 0x87, 0x08, 0x1f, 0x57, 0x1b, 0x6e, 0xe8, 0xbf, 0xc4, 0x5b, 0x20, 0x40, 0xae, 0x15, 0x6d,
+	0x1a, 0x9d, 0xfd, 0x6a, 0x5b, 0x25, 0xf4, 0xe3, 0x1e, 0x92, 0xac, 0x7e, 0xe6, 0xee, 0x7a, 0x86,
+	0x6f, 0xfd, 0xaf, 0x33, 0x24, 0x8f, 0xc5, 0x84, 0xb5, 0xcc, 0xa0, 0x24, 0x75, 0xcb, 0xac, 0xba,
+	0xe5, 0x54, 0x0a, 0x29, 0x94, 0x0d, 0x18, 0x8c, 0x7d, 0x0a, 0x99, 0xf9, 0x78, 0x93, 0x05, 0x18,
+	0x21, 0xe4, 0xff, 0x56, 0xbe, 0x0d, 0x13, 0x42, 0x55, 0x38, 0x0d, 0x66, 0x14, 0x7b, 0x33, 0x08,
+	0xca, 0x22, 0x08, 0x63, 0x66, 0xba, 0x51, 0xb9, 0x03, 0xe3, 0x9e, 0x6f, 0x98, 0x8e, 0x98, 0x19,
+	0xa1, 0x69, 0x08, 0x85, 0xf6, 0x25, 0x52, 0x22, 0xdf, 0x81, 0x71, 0x1f, 0xc7, 0x5f, 0x1c, 0xbc,
+	0xec, 0xd5, 0xc3, 0x68, 0xdd, 0x8b, 0xe7, 0x67, 0xbb, 0xa5, 0x97, 0xea, 0x0c, 0x13, 0x61, 0x6a,
+	0x56, 0xfc, 0x54, 0x37, 0xf5, 0xee, 0x49, 0xdb, 0x4d, 0x54, 0x04, 0x08, 0x16, 0xc2, 0x2a, 0x02,
+	0x84, 0x56, 0x6e, 0xa5, 0xff, 0x48, 0x6b, 0xa9, 0xb6, 0x51, 0xf8, 0x1e, 0xf9, 0xd4, 0x60, 0xdd,
+	0x68, 0x9c, 0xc5, 0x6e, 0x7d, 0x0a, 0xe3, 0xf8, 0xc5, 0x4a, 0x3f, 0x67, 0x20, 0x6f, 0xfb, 0x56,
+	0x21, 0x66, 0x8e, 0x28, 0x9d, 0x71, 0x44, 0xb9, 0x7b, 0x8e, 0xfc, 0x0d, 0x3c, 0xae, 0x63, 0x45,
+	0x55, 0x5c, 0x1e, 0x56, 0x51, 0x15, 0x6f, 0x8a, 0xc4, 0x5f, 0x28, 0xc3, 0x84, 0x34, 0x32, 0xa3,
+	0x0c, 0x40, 0xef, 0x63, 0x7d, 0xf5, 0xe9, 0xce, 0xc8, 0x39, 0x05, 0xa0, 0x4f, 0xdf, 0x78, 0xff,
+	0xd9, 0xb7, 0x36, 0x46, 0x0a, 0xcb, 0x7f, 0x7b, 0x03, 0xba, 0xb7, 0x82, 0x9a, 0xf2, 0x01, 0x0c,
+	0xc6, 0xbf, 0x11, 0x2c, 0xc9, 0x6c, 0x36, 0x06, 0x50, 0x6f, 0x76, 0x00, 0x44, 0x0c, 0x29, 0xdf,
+	0x81, 0x8b, 0xc2, 0xf7, 0x87, 0x9a, 0x74, 0x68, 0x02, 0xa3, 0x2e, 0x74, 0xc6, 0xf0, 0x19, 0x3e,
+	0x80, 0xc1, 0xf8, 0xe7, 0x59, 0x25, 0xf9, 0xae, 0xe7, 0x00, 0xf5, 0x66, 0x07, 0x40, 0xec, 0x33,
+	0xcd, 0x91, 0xd4, 0x77, 0x44, 0xb9, 0x7c, 0x8a, 0x7a, 0x3b, 0x0f, 0x8a, 0xcf, 0x73, 0x04, 0x97,
+	0x32, 0xbe, 0x96, 0x90, 0xaa, 0x41, 0x8e, 0x55, 0x97, 0xf3, 0x63, 0xf9, 0xcc, 0xbf, 0x09, 0xc5,
+	0xcc, 0x2f, 0x14, 0xa4, 0x32, 0x64, 0xa1, 0xd5, 0x7b, 0xc7, 0x41, 0xc7, 0x35, 0x9c, 0xaa, 0xe0,
+	0x97, 0xbb, 0x4b, 0x01, 0xa5, 0xde, 0xce, 0x83, 0x4a, 0xc8, 0x99, 0x55, 0x99, 0x2d, 0x97, 0x33,
+	0x03, 0xad, 0xde, 0x3b, 0x0e, 0x9a, 0xcf, 0xff, 0x3b, 0x05, 0x50, 0xdb, 0x14, 0x87, 0x97, 0x65,
+	0x44, 0xb3, 0xf1, 0xea, 0x83, 0xe3, 0xe1, 0x39, 0x1b, 0x36, 0x8c, 0xa6, 0xcb, 0xb2, 0xaf, 0x77,
+	0xd8, 0xc9, 0x14, 0xa6, 0x2e, 0xe6, 0x82, 0xf1, 0xa9, 0x3e, 0x84, 0xa1, 0x44, 0xfd, 0xef, 0x6c,
+	0xf6, 0xa6, 0x63, 0x13, 0xcc, 0x77, 0x42, 0xc4, 0x69, 0x27, 0x4a, 0x65, 0x67, 0xb3, 0x0f, 0xd8,
+	0x76, 0xb4, 0x65, 0x15, 0xa9, 0x8a, 0x07, 0x63, 0xb2, 0x6a, 0xd4, 0x0c, 0x9f, 0x91, 0x02, 0xaa,
+	0x95, 0x9c, 0x40, 0x3e, 0xe1, 0xaf, 0xc3, 0x85, 0x64, 0x65, 0xe8, 0x55, 0x19, 0x85, 0x04, 0x44,
+	0xbd, 0xd5, 0x11, 0xc2, 0xc9, 0x1f, 0xc2, 0x84, 0xb4, 0x68, 0x30, 0xc3, 0xb5, 0xc8, 0xa0, 0x59,
+	0xae, 0xa5, 0x6d, 0x2d, 0xa2, 0x62, 0xc2, 0xb0, 0x58, 0x87, 0x38, 0x27, 0x37, 0xdb, 0x04, 0x48,
+	0x7d, 0x2b, 0x07, 0x28, 0xbe, 0xaf, 0x33, 0x4b, 0xff, 0x32, 0x7c, 0xb0, 0x1c, 0xad, 0xde, 0x3b,
+	0x0e, 0x3a, 0xe9, 0xb9, 0xa5, 0x65, 0x76, 0x19, 0x9e, 0x5b, 0x86, 0x55, 0x97, 0xf3, 0x63, 0xf9,
+	0xcc, 0xbf, 0x57, 0x80, 0xe9, 0xf6, 0xb5, 0x71, 0x4b, 0x32, 0xaa, 0x6d, 0x87, 0xa8, 0x5f, 0x3f,
+	0xf6, 0x90, 0xf8, 0xbe, 0x91, 0xd5, 0xa5, 0xdd, 0x94, 0xbb, 0xe9, 0x14, 0x50, 0xad, 0xe4, 0x04,
+	0x26, 0x9c, 0x40, 0xfc, 0x53, 0x7d, 0xb9, 0x13, 0x88, 0x21, 0xd4, 0xf9, 0x4e, 0x08, 0x4e, 0xfb,
+	0x87, 0x05, 0x28, 0x75, 0xfa, 0x8f, 0x49, 0xee, 0x66, 0xeb, 0x2a, 0x73, 0x90, 0xfa, 0xf0, 0x04,
+	0x83, 0xe2, 0x37, 0x29, 0xa1, 0xfe, 0x4d, 0xcb, 0x30, 0xda, 0x18, 0x46, 0x5d, 0xe8, 0x8c, 0x49,
+	0x1c, 0xc7, 0x62, 0xd1, 0x57, 0xae, 0xd7, 0x8b, 0x7a, 0x3b, 0x0f, 0x2a, 0x3e, 0x4f, 0xaa, 0x80,
+	0xe2, 0x5a, 0xf6, 0xbe, 0xef, 0x34, 0x4f, 0x56, 0x29, 0x03, 0x9e, 0x27, 0x55, 0xc6, 0x70, 0x2d,
+	0x7b, 0x09, 0x3a, 0xcd, 0x93, 0x95, 0x12, 0xc7, 0x6e, 0x20, 0x23, 0x1d, 0x2e, 0xd5, 0xbe, 0x1c,
+	0xab, 0x2e, 0xe7, 0xc7, 0xf2, 0x99, 0x9b, 0x30, 0x21, 0x4f, 0x0d, 0xdf, 0x92, 0xdf, 0x53, 0x24,
+	0x50, 0x75, 0x29, 0x37, 0x94, 0x4f, 0xeb, 0xc3, 0xb8, 0x34, 0x8d, 0x3a, 0x9f, 0xad, 0xb6, 0x24,
+	0x52, 0xbd, 0x93, 0x17, 0x19, 0xbf, 0xbc, 0xa4, 0xeb, 0x29, 0xaf, 0xcb, 0xed, 0x41, 0x80, 0xa9,
+	0x8b, 0xb9, 0x60, 0x7c, 0xaa, 0xdf, 0x2a, 0xc0, 0x64, 0x76, 0x26, 0x6f, 0x31, 0x63, 0x9d, 0xe4,
+	0x70, 0xf5, 0xfe, 0xb1, 0xe0, 0x9c, 0x07, 0x07, 0x14, 0xc9, 0xff, 0x3d, 0x71, 0x43, 0x46, 0x2c,
+	0x8d, 0x53, 0xcb, 0xf9, 0x70, 0x89, 0x0b, 0x6a, 0x9b, 0xf4, 0x5c, 0x39, 0x43, 0x86, 0x0c, 0xbc,
+	0xfa, 0xe0, 0x78, 0x78, 0xce, 0xc6, 0xef, 0x16, 0x60, 0xaa, 0x5d, 0x2a, 0xac, 0x92, 0x41, 0x37,
+	0x6b, 0x80, 0xfa, 0xf6, 0x31, 0x07, 0x24, 0x14, 0xd2, 0x26, 0xeb, 0x54, 0x96, 0x7b, 0xd5, 0x2c,
+	0xbc, 0xfa, 0xe0, 0x78, 0x78, 0xce, 0xc6, 0xf7, 0x0b, 0x70, 0xa5, 0x6d, 0xda, 0xe7, 0x4e, 0x86,
+	0x80, 0x99, 0x23, 0xd4, 0xaf, 0x1d, 0x77, 0x84, 0xb8, 0x2d, 0x32, 0x12, 0x2c, 0x59, 0xdb, 0x42,
+	0x0e, 0x57, 0xef, 0x1f, 0x0b, 0x1e, 0xdf, 0x16, 0x92, 0x34, 0xc6, 0x0d, 0xf9, 0xeb, 0x53, 0xc4,
+	0xa9, 0xe5, 0x7c, 0xb8, 0xe4, 0x6b, 0x20, 0x9d, 0x23, 0xc8, 0x78, 0x0d, 0xa4, 0x80, 0x6a, 0x25,
+	0x27, 0x50, 0x38, 0x49, 0x64, 0x01, 0xf8, 0x85, 0xec, 0x2d, 0x25, 0x62, 0xd5, 0xe5, 0xfc, 0x58,
+	0xd1, 0x03, 0x64, 0x05, 0xbc, 0xcb, 0x99, 0x56, 0x23, 0xc5, 0xab, 0x0f, 0x8e, 0x87, 0x8f, 0x3f,
+	0x1b, 0xc4, 0x88, 0xb0, 0xf4, 0xd9, 0x20, 0x80, 0xd4, 0xb7, 0x72, 0x80, 0xe2, 0x77, 0xc7, 0x44,
+	0x7c, 0x70, 0x36, 0x83, 0x59, 0x8e, 0x50, 0xe7, 0x3b, 0x21, 0x22, 0xda, 0x6a, 0xef, 0x77, 0xbf,
+	0xfa, 0x78, 0xa1, 0xb0, 0xf6, 0xe4, 0x27, 0x5f, 0xcc, 0x14, 0x7e, 0xfa, 0xc5, 0x4c, 0xe1, 0xf3,
+	0x2f, 0x66, 0x0a, 0x3f, 0xf8, 0x72, 0xe6, 0xdc, 0x4f, 0xbf, 0x9c, 0x39, 0xf7, 0xf7, 0x5f, 0xce,
+	0x9c, 0xfb, 0x70, 0x39, 0xf6, 0xbd, 0x2e, 0x2d, 0x52, 0x5d, 0x7c, 0x62, 0xec, 0x06, 0x15, 0x3a,
+	0x41, 0xe5, 0xe0, 0xee, 0xdd, 0xca, 0x51, 0xec, 0x7f, 0xd6, 0xc3, 0xdf, 0xef, 0xee, 0xf6, 0x91,
+	0x64, 0xe2, 0xdd, 0xff, 0x19, 0x00, 0x54, 0x69, 0x37, 0xe7, 0xa8, 0x50, 0x00, 0x00,
 }
 
-func (m *MsgResumeHostZone) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
+	LSMLiquidStake(ctx context.Context, in *MsgLSMLiquidStake, opts ...grpc.CallOption) (*MsgLSMLiquidStakeResponse, error)
+	RedeemStake(ctx context.Context, in *MsgRedeemStake, opts ...grpc.CallOption) (*MsgRedeemStakeResponse, error)
+	RegisterHostZone(ctx context.Context, in *MsgRegisterHostZone, opts ...grpc.CallOption) (*MsgRegisterHostZoneResponse, error)
+	ClaimUndelegatedTokens(ctx context.Context, in *MsgClaimUndelegatedTokens, opts ...grpc.CallOption) (*MsgClaimUndelegatedTokensResponse, error)
+	TransferRedemptionRecord(ctx context.Context, in *MsgTransferRedemptionRecord, opts ...grpc.CallOption) (*MsgTransferRedemptionRecordResponse, error)
+	CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error)
+	TokenizeRedemptionRecord(ctx context.Context, in *MsgTokenizeRedemptionRecord, opts ...grpc.CallOption) (*MsgTokenizeRedemptionRecordResponse, error)
+	DetokenizeRedemptionRecord(ctx context.Context, in *MsgDetokenizeRedemptionRecord, opts ...grpc.CallOption) (*MsgDetokenizeRedemptionRecordResponse, error)
+	LiquidStakeBasket(ctx context.Context, in *MsgLiquidStakeBasket, opts ...grpc.CallOption) (*MsgLiquidStakeBasketResponse, error)
+	RedeemBasket(ctx context.Context, in *MsgRedeemBasket, opts ...grpc.CallOption) (*MsgRedeemBasketResponse, error)
+	CreateBasket(ctx context.Context, in *MsgCreateBasket, opts ...grpc.CallOption) (*MsgCreateBasketResponse, error)
+	RebalanceValidators(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*MsgRebalanceValidatorsResponse, error)
+	AddValidators(ctx context.Context, in *MsgAddValidators, opts ...grpc.CallOption) (*MsgAddValidatorsResponse, error)
+	ChangeValidatorWeight(ctx context.Context, in *MsgChangeValidatorWeights, opts ...grpc.CallOption) (*MsgChangeValidatorWeightsResponse, error)
+	DeleteValidator(ctx context.Context, in *MsgDeleteValidator, opts ...grpc.CallOption) (*MsgDeleteValidatorResponse, error)
+	RestoreInterchainAccount(ctx context.Context, in *MsgRestoreInterchainAccount, opts ...grpc.CallOption) (*MsgRestoreInterchainAccountResponse, error)
+	CloseDelegationChannel(ctx context.Context, in *MsgCloseDelegationChannel, opts ...grpc.CallOption) (*MsgCloseDelegationChannelResponse, error)
+	UpdateValidatorSharesExchRate(ctx context.Context, in *MsgUpdateValidatorSharesExchRate, opts ...grpc.CallOption) (*MsgUpdateValidatorSharesExchRateResponse, error)
+	CalibrateDelegation(ctx context.Context, in *MsgCalibrateDelegation, opts ...grpc.CallOption) (*MsgCalibrateDelegationResponse, error)
+	ClearBalance(ctx context.Context, in *MsgClearBalance, opts ...grpc.CallOption) (*MsgClearBalanceResponse, error)
+	UpdateInnerRedemptionRateBounds(ctx context.Context, in *MsgUpdateInnerRedemptionRateBounds, opts ...grpc.CallOption) (*MsgUpdateInnerRedemptionRateBoundsResponse, error)
+	ResumeHostZone(ctx context.Context, in *MsgResumeHostZone, opts ...grpc.CallOption) (*MsgResumeHostZoneResponse, error)
+	CreateTradeRoute(ctx context.Context, in *MsgCreateTradeRoute, opts ...grpc.CallOption) (*MsgCreateTradeRouteResponse, error)
+	DeleteTradeRoute(ctx context.Context, in *MsgDeleteTradeRoute, opts ...grpc.CallOption) (*MsgDeleteTradeRouteResponse, error)
+	UpdateTradeRoute(ctx context.Context, in *MsgUpdateTradeRoute, opts ...grpc.CallOption) (*MsgUpdateTradeRouteResponse, error)
+	SetCommunityPoolRebate(ctx context.Context, in *MsgSetCommunityPoolRebate, opts ...grpc.CallOption) (*MsgSetCommunityPoolRebateResponse, error)
+	ToggleTradeController(ctx context.Context, in *MsgToggleTradeController, opts ...grpc.CallOption) (*MsgToggleTradeControllerResponse, error)
+	UpdateHostZoneParams(ctx context.Context, in *MsgUpdateHostZoneParams, opts ...grpc.CallOption) (*MsgUpdateHostZoneParamsResponse, error)
+	DeprecateHostZone(ctx context.Context, in *MsgDeprecateHostZone, opts ...grpc.CallOption) (*MsgDeprecateHostZoneResponse, error)
+	SetAutoValidatorWeighting(ctx context.Context, in *MsgSetAutoValidatorWeighting, opts ...grpc.CallOption) (*MsgSetAutoValidatorWeightingResponse, error)
+	InstantRedeemStake(ctx context.Context, in *MsgInstantRedeemStake, opts ...grpc.CallOption) (*MsgInstantRedeemStakeResponse, error)
+	SetInstantRedemptionConfig(ctx context.Context, in *MsgSetInstantRedemptionConfig, opts ...grpc.CallOption) (*MsgSetInstantRedemptionConfigResponse, error)
+	SetValidatorBlacklistPolicy(ctx context.Context, in *MsgSetValidatorBlacklistPolicy, opts ...grpc.CallOption) (*MsgSetValidatorBlacklistPolicyResponse, error)
+	RemoveBlacklistedValidator(ctx context.Context, in *MsgRemoveBlacklistedValidator, opts ...grpc.CallOption) (*MsgRemoveBlacklistedValidatorResponse, error)
+	SetRedemptionRateGuardConfig(ctx context.Context, in *MsgSetRedemptionRateGuardConfig, opts ...grpc.CallOption) (*MsgSetRedemptionRateGuardConfigResponse, error)
+	SetCircuitBreakerGuardian(ctx context.Context, in *MsgSetCircuitBreakerGuardian, opts ...grpc.CallOption) (*MsgSetCircuitBreakerGuardianResponse, error)
+	TripCircuitBreaker(ctx context.Context, in *MsgTripCircuitBreaker, opts ...grpc.CallOption) (*MsgTripCircuitBreakerResponse, error)
+	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	SetInsuranceFundConfig(ctx context.Context, in *MsgSetInsuranceFundConfig, opts ...grpc.CallOption) (*MsgSetInsuranceFundConfigResponse, error)
+	SetReconciliationThreshold(ctx context.Context, in *MsgSetReconciliationThreshold, opts ...grpc.CallOption) (*MsgSetReconciliationThresholdResponse, error)
+	OnboardHostZone(ctx context.Context, in *MsgOnboardHostZone, opts ...grpc.CallOption) (*MsgOnboardHostZoneResponse, error)
+	SetUnbondCap(ctx context.Context, in *MsgSetUnbondCap, opts ...grpc.CallOption) (*MsgSetUnbondCapResponse, error)
 }
 
-func (m *MsgResumeHostZone) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgResumeHostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgResumeHostZoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
+	out := new(MsgLiquidStakeResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/LiquidStake", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgResumeHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LSMLiquidStake(ctx context.Context, in *MsgLSMLiquidStake, opts ...grpc.CallOption) (*MsgLSMLiquidStakeResponse, error) {
+	out := new(MsgLSMLiquidStakeResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/LSMLiquidStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgResumeHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RedeemStake(ctx context.Context, in *MsgRedeemStake, opts ...grpc.CallOption) (*MsgRedeemStakeResponse, error) {
+	out := new(MsgRedeemStakeResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RedeemStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeprecateHostZone) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterHostZone(ctx context.Context, in *MsgRegisterHostZone, opts ...grpc.CallOption) (*MsgRegisterHostZoneResponse, error) {
+	out := new(MsgRegisterHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RegisterHostZone", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeprecateHostZone) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimUndelegatedTokens(ctx context.Context, in *MsgClaimUndelegatedTokens, opts ...grpc.CallOption) (*MsgClaimUndelegatedTokensResponse, error) {
+	out := new(MsgClaimUndelegatedTokensResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ClaimUndelegatedTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeprecateHostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) TransferRedemptionRecord(ctx context.Context, in *MsgTransferRedemptionRecord, opts ...grpc.CallOption) (*MsgTransferRedemptionRecordResponse, error) {
+	out := new(MsgTransferRedemptionRecordResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/TransferRedemptionRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeprecateHostZoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error) {
+	out := new(MsgCancelRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/CancelRedemption", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeprecateHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TokenizeRedemptionRecord(ctx context.Context, in *MsgTokenizeRedemptionRecord, opts ...grpc.CallOption) (*MsgTokenizeRedemptionRecordResponse, error) {
+	out := new(MsgTokenizeRedemptionRecordResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/TokenizeRedemptionRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeprecateHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DetokenizeRedemptionRecord(ctx context.Context, in *MsgDetokenizeRedemptionRecord, opts ...grpc.CallOption) (*MsgDetokenizeRedemptionRecordResponse, error) {
+	out := new(MsgDetokenizeRedemptionRecordResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/DetokenizeRedemptionRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateTradeRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LiquidStakeBasket(ctx context.Context, in *MsgLiquidStakeBasket, opts ...grpc.CallOption) (*MsgLiquidStakeBasketResponse, error) {
+	out := new(MsgLiquidStakeBasketResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/LiquidStakeBasket", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateTradeRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RedeemBasket(ctx context.Context, in *MsgRedeemBasket, opts ...grpc.CallOption) (*MsgRedeemBasketResponse, error) {
+	out := new(MsgRedeemBasketResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RedeemBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateTradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinTransferAmount.Size()
-		i -= size
-		if _, err := m.MinTransferAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	{
-		size := m.MaxSwapAmount.Size()
-		i -= size
-		if _, err := m.MaxSwapAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.MinSwapAmount.Size()
-		i -= size
-		if _, err := m.MinSwapAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	if len(m.MaxAllowedSwapLossRate) > 0 {
-		i -= len(m.MaxAllowedSwapLossRate)
-		copy(dAtA[i:], m.MaxAllowedSwapLossRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxAllowedSwapLossRate)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.HostDenomOnHost) > 0 {
-		i -= len(m.HostDenomOnHost)
-		copy(dAtA[i:], m.HostDenomOnHost)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenomOnHost)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.HostDenomOnTrade) > 0 {
-		i -= len(m.HostDenomOnTrade)
-		copy(dAtA[i:], m.HostDenomOnTrade)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenomOnTrade)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.RewardDenomOnTrade) > 0 {
-		i -= len(m.RewardDenomOnTrade)
-		copy(dAtA[i:], m.RewardDenomOnTrade)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenomOnTrade)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.RewardDenomOnReward) > 0 {
-		i -= len(m.RewardDenomOnReward)
-		copy(dAtA[i:], m.RewardDenomOnReward)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenomOnReward)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.RewardDenomOnHost) > 0 {
-		i -= len(m.RewardDenomOnHost)
-		copy(dAtA[i:], m.RewardDenomOnHost)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenomOnHost)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.TradeToHostTransferChannelId) > 0 {
-		i -= len(m.TradeToHostTransferChannelId)
-		copy(dAtA[i:], m.TradeToHostTransferChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TradeToHostTransferChannelId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.RewardToTradeTransferChannelId) > 0 {
-		i -= len(m.RewardToTradeTransferChannelId)
-		copy(dAtA[i:], m.RewardToTradeTransferChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardToTradeTransferChannelId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.HostToRewardTransferChannelId) > 0 {
-		i -= len(m.HostToRewardTransferChannelId)
-		copy(dAtA[i:], m.HostToRewardTransferChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostToRewardTransferChannelId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.StrideToTradeConnectionId) > 0 {
-		i -= len(m.StrideToTradeConnectionId)
-		copy(dAtA[i:], m.StrideToTradeConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StrideToTradeConnectionId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.StrideToRewardConnectionId) > 0 {
-		i -= len(m.StrideToRewardConnectionId)
-		copy(dAtA[i:], m.StrideToRewardConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StrideToRewardConnectionId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.HostChainId) > 0 {
-		i -= len(m.HostChainId)
-		copy(dAtA[i:], m.HostChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreateBasket(ctx context.Context, in *MsgCreateBasket, opts ...grpc.CallOption) (*MsgCreateBasketResponse, error) {
+	out := new(MsgCreateBasketResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/CreateBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateTradeRouteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RebalanceValidators(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*MsgRebalanceValidatorsResponse, error) {
+	out := new(MsgRebalanceValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RebalanceValidators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateTradeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddValidators(ctx context.Context, in *MsgAddValidators, opts ...grpc.CallOption) (*MsgAddValidatorsResponse, error) {
+	out := new(MsgAddValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/AddValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateTradeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ChangeValidatorWeight(ctx context.Context, in *MsgChangeValidatorWeights, opts ...grpc.CallOption) (*MsgChangeValidatorWeightsResponse, error) {
+	out := new(MsgChangeValidatorWeightsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ChangeValidatorWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteTradeRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteValidator(ctx context.Context, in *MsgDeleteValidator, opts ...grpc.CallOption) (*MsgDeleteValidatorResponse, error) {
+	out := new(MsgDeleteValidatorResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/DeleteValidator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteTradeRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RestoreInterchainAccount(ctx context.Context, in *MsgRestoreInterchainAccount, opts ...grpc.CallOption) (*MsgRestoreInterchainAccountResponse, error) {
+	out := new(MsgRestoreInterchainAccountResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RestoreInterchainAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteTradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) CloseDelegationChannel(ctx context.Context, in *MsgCloseDelegationChannel, opts ...grpc.CallOption) (*MsgCloseDelegationChannelResponse, error) {
+	out := new(MsgCloseDelegationChannelResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/CloseDelegationChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RewardDenom) > 0 {
-		i -= len(m.RewardDenom)
-		copy(dAtA[i:], m.RewardDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenom)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) UpdateValidatorSharesExchRate(ctx context.Context, in *MsgUpdateValidatorSharesExchRate, opts ...grpc.CallOption) (*MsgUpdateValidatorSharesExchRateResponse, error) {
+	out := new(MsgUpdateValidatorSharesExchRateResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/UpdateValidatorSharesExchRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) CalibrateDelegation(ctx context.Context, in *MsgCalibrateDelegation, opts ...grpc.CallOption) (*MsgCalibrateDelegationResponse, error) {
+	out := new(MsgCalibrateDelegationResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/CalibrateDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteTradeRouteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClearBalance(ctx context.Context, in *MsgClearBalance, opts ...grpc.CallOption) (*MsgClearBalanceResponse, error) {
+	out := new(MsgClearBalanceResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ClearBalance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteTradeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateInnerRedemptionRateBounds(ctx context.Context, in *MsgUpdateInnerRedemptionRateBounds, opts ...grpc.CallOption) (*MsgUpdateInnerRedemptionRateBoundsResponse, error) {
+	out := new(MsgUpdateInnerRedemptionRateBoundsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/UpdateInnerRedemptionRateBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteTradeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ResumeHostZone(ctx context.Context, in *MsgResumeHostZone, opts ...grpc.CallOption) (*MsgResumeHostZoneResponse, error) {
+	out := new(MsgResumeHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ResumeHostZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateTradeRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateTradeRoute(ctx context.Context, in *MsgCreateTradeRoute, opts ...grpc.CallOption) (*MsgCreateTradeRouteResponse, error) {
+	out := new(MsgCreateTradeRouteResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/CreateTradeRoute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateTradeRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteTradeRoute(ctx context.Context, in *MsgDeleteTradeRoute, opts ...grpc.CallOption) (*MsgDeleteTradeRouteResponse, error) {
+	out := new(MsgDeleteTradeRouteResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/DeleteTradeRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateTradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinTransferAmount.Size()
-		i -= size
-		if _, err := m.MinTransferAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) UpdateTradeRoute(ctx context.Context, in *MsgUpdateTradeRoute, opts ...grpc.CallOption) (*MsgUpdateTradeRouteResponse, error) {
+	out := new(MsgUpdateTradeRouteResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/UpdateTradeRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	{
-		size := m.MaxSwapAmount.Size()
-		i -= size
-		if _, err := m.MaxSwapAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) SetCommunityPoolRebate(ctx context.Context, in *MsgSetCommunityPoolRebate, opts ...grpc.CallOption) (*MsgSetCommunityPoolRebateResponse, error) {
+	out := new(MsgSetCommunityPoolRebateResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/SetCommunityPoolRebate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.MinSwapAmount.Size()
-		i -= size
-		if _, err := m.MinSwapAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) ToggleTradeController(ctx context.Context, in *MsgToggleTradeController, opts ...grpc.CallOption) (*MsgToggleTradeControllerResponse, error) {
+	out := new(MsgToggleTradeControllerResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ToggleTradeController", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.MaxAllowedSwapLossRate) > 0 {
-		i -= len(m.MaxAllowedSwapLossRate)
-		copy(dAtA[i:], m.MaxAllowedSwapLossRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxAllowedSwapLossRate)))
-		i--
-		dAtA[i] = 0x2a
+	return out, nil
+}
+
+func (c *msgClient) UpdateHostZoneParams(ctx context.Context, in *MsgUpdateHostZoneParams, opts ...grpc.CallOption) (*MsgUpdateHostZoneParamsResponse, error) {
+	out := new(MsgUpdateHostZoneParamsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/UpdateHostZoneParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x20
+	return out, nil
+}
+
+func (c *msgClient) DeprecateHostZone(ctx context.Context, in *MsgDeprecateHostZone, opts ...grpc.CallOption) (*MsgDeprecateHostZoneResponse, error) {
+	out := new(MsgDeprecateHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/DeprecateHostZone", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *msgClient) SetAutoValidatorWeighting(ctx context.Context, in *MsgSetAutoValidatorWeighting, opts ...grpc.CallOption) (*MsgSetAutoValidatorWeightingResponse, error) {
+	out := new(MsgSetAutoValidatorWeightingResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/SetAutoValidatorWeighting", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RewardDenom) > 0 {
-		i -= len(m.RewardDenom)
-		copy(dAtA[i:], m.RewardDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenom)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) InstantRedeemStake(ctx context.Context, in *MsgInstantRedeemStake, opts ...grpc.CallOption) (*MsgInstantRedeemStakeResponse, error) {
+	out := new(MsgInstantRedeemStakeResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/InstantRedeemStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) SetInstantRedemptionConfig(ctx context.Context, in *MsgSetInstantRedemptionConfig, opts ...grpc.CallOption) (*MsgSetInstantRedemptionConfigResponse, error) {
+	out := new(MsgSetInstantRedemptionConfigResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/SetInstantRedemptionConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateTradeRouteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetValidatorBlacklistPolicy(ctx context.Context, in *MsgSetValidatorBlacklistPolicy, opts ...grpc.CallOption) (*MsgSetValidatorBlacklistPolicyResponse, error) {
+	out := new(MsgSetValidatorBlacklistPolicyResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/SetValidatorBlacklistPolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateTradeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveBlacklistedValidator(ctx context.Context, in *MsgRemoveBlacklistedValidator, opts ...grpc.CallOption) (*MsgRemoveBlacklistedValidatorResponse, error) {
+	out := new(MsgRemoveBlacklistedValidatorResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/RemoveBlacklistedValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateTradeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetRedemptionRateGuardConfig(ctx context.Context, in *MsgSetRedemptionRateGuardConfig, opts ...grpc.CallOption) (*MsgSetRedemptionRateGuardConfigResponse, error) {
+	out := new(MsgSetRedemptionRateGuardConfigResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/SetRedemptionRateGuardConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetCommunityPoolRebate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetCircuitBreakerGuardian(ctx context.Context, in *MsgSetCircuitBreakerGuardian, opts ...grpc.CallOption) (*MsgSetCircuitBreakerGuardianResponse, error) {
+	out := new(MsgSetCircuitBreakerGuardianResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/SetCircuitBreakerGuardian", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetCommunityPoolRebate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TripCircuitBreaker(ctx context.Context, in *MsgTripCircuitBreaker, opts ...grpc.CallOption) (*MsgTripCircuitBreakerResponse, error) {
+	out := new(MsgTripCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/TripCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetCommunityPoolRebate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LiquidStakedStTokenAmount.Size()
-		i -= size
-		if _, err := m.LiquidStakedStTokenAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.RebateRate.Size()
-		i -= size
-		if _, err := m.RebateRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSetCommunityPoolRebateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error) {
+	out := new(MsgResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/ResetCircuitBreaker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetCommunityPoolRebateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetCommunityPoolRebateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgToggleTradeController) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetInsuranceFundConfig(ctx context.Context, in *MsgSetInsuranceFundConfig, opts ...grpc.CallOption) (*MsgSetInsuranceFundConfigResponse, error) {
+	out := new(MsgSetInsuranceFundConfigResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/SetInsuranceFundConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgToggleTradeController) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgToggleTradeController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Legacy {
-		i--
-		if m.Legacy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PermissionChange != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PermissionChange))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetReconciliationThreshold(ctx context.Context, in *MsgSetReconciliationThreshold, opts ...grpc.CallOption) (*MsgSetReconciliationThresholdResponse, error) {
+	out := new(MsgSetReconciliationThresholdResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/SetReconciliationThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgToggleTradeControllerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) OnboardHostZone(ctx context.Context, in *MsgOnboardHostZone, opts ...grpc.CallOption) (*MsgOnboardHostZoneResponse, error) {
+	out := new(MsgOnboardHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/OnboardHostZone", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgToggleTradeControllerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgToggleTradeControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateHostZoneParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetUnbondCap(ctx context.Context, in *MsgSetUnbondCap, opts ...grpc.CallOption) (*MsgSetUnbondCapResponse, error) {
+	out := new(MsgSetUnbondCapResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Msg/SetUnbondCap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateHostZoneParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
+	LSMLiquidStake(context.Context, *MsgLSMLiquidStake) (*MsgLSMLiquidStakeResponse, error)
+	RedeemStake(context.Context, *MsgRedeemStake) (*MsgRedeemStakeResponse, error)
+	RegisterHostZone(context.Context, *MsgRegisterHostZone) (*MsgRegisterHostZoneResponse, error)
+	ClaimUndelegatedTokens(context.Context, *MsgClaimUndelegatedTokens) (*MsgClaimUndelegatedTokensResponse, error)
+	TransferRedemptionRecord(context.Context, *MsgTransferRedemptionRecord) (*MsgTransferRedemptionRecordResponse, error)
+	CancelRedemption(context.Context, *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error)
+	TokenizeRedemptionRecord(context.Context, *MsgTokenizeRedemptionRecord) (*MsgTokenizeRedemptionRecordResponse, error)
+	DetokenizeRedemptionRecord(context.Context, *MsgDetokenizeRedemptionRecord) (*MsgDetokenizeRedemptionRecordResponse, error)
+	LiquidStakeBasket(context.Context, *MsgLiquidStakeBasket) (*MsgLiquidStakeBasketResponse, error)
+	RedeemBasket(context.Context, *MsgRedeemBasket) (*MsgRedeemBasketResponse, error)
+	CreateBasket(context.Context, *MsgCreateBasket) (*MsgCreateBasketResponse, error)
+	RebalanceValidators(context.Context, *MsgRebalanceValidators) (*MsgRebalanceValidatorsResponse, error)
+	AddValidators(context.Context, *MsgAddValidators) (*MsgAddValidatorsResponse, error)
+	ChangeValidatorWeight(context.Context, *MsgChangeValidatorWeights) (*MsgChangeValidatorWeightsResponse, error)
+	DeleteValidator(context.Context, *MsgDeleteValidator) (*MsgDeleteValidatorResponse, error)
+	RestoreInterchainAccount(context.Context, *MsgRestoreInterchainAccount) (*MsgRestoreInterchainAccountResponse, error)
+	CloseDelegationChannel(context.Context, *MsgCloseDelegationChannel) (*MsgCloseDelegationChannelResponse, error)
+	UpdateValidatorSharesExchRate(context.Context, *MsgUpdateValidatorSharesExchRate) (*MsgUpdateValidatorSharesExchRateResponse, error)
+	CalibrateDelegation(context.Context, *MsgCalibrateDelegation) (*MsgCalibrateDelegationResponse, error)
+	ClearBalance(context.Context, *MsgClearBalance) (*MsgClearBalanceResponse, error)
+	UpdateInnerRedemptionRateBounds(context.Context, *MsgUpdateInnerRedemptionRateBounds) (*MsgUpdateInnerRedemptionRateBoundsResponse, error)
+	ResumeHostZone(context.Context, *MsgResumeHostZone) (*MsgResumeHostZoneResponse, error)
+	CreateTradeRoute(context.Context, *MsgCreateTradeRoute) (*MsgCreateTradeRouteResponse, error)
+	DeleteTradeRoute(context.Context, *MsgDeleteTradeRoute) (*MsgDeleteTradeRouteResponse, error)
+	UpdateTradeRoute(context.Context, *MsgUpdateTradeRoute) (*MsgUpdateTradeRouteResponse, error)
+	SetCommunityPoolRebate(context.Context, *MsgSetCommunityPoolRebate) (*MsgSetCommunityPoolRebateResponse, error)
+	ToggleTradeController(context.Context, *MsgToggleTradeController) (*MsgToggleTradeControllerResponse, error)
+	UpdateHostZoneParams(context.Context, *MsgUpdateHostZoneParams) (*MsgUpdateHostZoneParamsResponse, error)
+	DeprecateHostZone(context.Context, *MsgDeprecateHostZone) (*MsgDeprecateHostZoneResponse, error)
+	SetAutoValidatorWeighting(context.Context, *MsgSetAutoValidatorWeighting) (*MsgSetAutoValidatorWeightingResponse, error)
+	InstantRedeemStake(context.Context, *MsgInstantRedeemStake) (*MsgInstantRedeemStakeResponse, error)
+	SetInstantRedemptionConfig(context.Context, *MsgSetInstantRedemptionConfig) (*MsgSetInstantRedemptionConfigResponse, error)
+	SetValidatorBlacklistPolicy(context.Context, *MsgSetValidatorBlacklistPolicy) (*MsgSetValidatorBlacklistPolicyResponse, error)
+	RemoveBlacklistedValidator(context.Context, *MsgRemoveBlacklistedValidator) (*MsgRemoveBlacklistedValidatorResponse, error)
+	SetRedemptionRateGuardConfig(context.Context, *MsgSetRedemptionRateGuardConfig) (*MsgSetRedemptionRateGuardConfigResponse, error)
+	SetCircuitBreakerGuardian(context.Context, *MsgSetCircuitBreakerGuardian) (*MsgSetCircuitBreakerGuardianResponse, error)
+	TripCircuitBreaker(context.Context, *MsgTripCircuitBreaker) (*MsgTripCircuitBreakerResponse, error)
+	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	SetInsuranceFundConfig(context.Context, *MsgSetInsuranceFundConfig) (*MsgSetInsuranceFundConfigResponse, error)
+	SetReconciliationThreshold(context.Context, *MsgSetReconciliationThreshold) (*MsgSetReconciliationThresholdResponse, error)
+	OnboardHostZone(context.Context, *MsgOnboardHostZone) (*MsgOnboardHostZoneResponse, error)
+	SetUnbondCap(context.Context, *MsgSetUnbondCap) (*MsgSetUnbondCapResponse, error)
 }
 
-func (m *MsgUpdateHostZoneParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxMessagesPerIcaTx != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxMessagesPerIcaTx))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateHostZoneParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
 }
-
-func (m *MsgUpdateHostZoneParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) LSMLiquidStake(ctx context.Context, req *MsgLSMLiquidStake) (*MsgLSMLiquidStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LSMLiquidStake not implemented")
 }
-
-func (m *MsgUpdateHostZoneParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) RedeemStake(ctx context.Context, req *MsgRedeemStake) (*MsgRedeemStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemStake not implemented")
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) RegisterHostZone(ctx context.Context, req *MsgRegisterHostZone) (*MsgRegisterHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterHostZone not implemented")
 }
-func (m *MsgUpdateInnerRedemptionRateBounds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.MinInnerRedemptionRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxInnerRedemptionRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (*UnimplementedMsgServer) ClaimUndelegatedTokens(ctx context.Context, req *MsgClaimUndelegatedTokens) (*MsgClaimUndelegatedTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimUndelegatedTokens not implemented")
 }
-
-func (m *MsgUpdateInnerRedemptionRateBoundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServer) TransferRedemptionRecord(ctx context.Context, req *MsgTransferRedemptionRecord) (*MsgTransferRedemptionRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRedemptionRecord not implemented")
+}
+func (*UnimplementedMsgServer) CancelRedemption(ctx context.Context, req *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRedemption not implemented")
+}
+func (*UnimplementedMsgServer) TokenizeRedemptionRecord(ctx context.Context, req *MsgTokenizeRedemptionRecord) (*MsgTokenizeRedemptionRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeRedemptionRecord not implemented")
+}
+func (*UnimplementedMsgServer) DetokenizeRedemptionRecord(ctx context.Context, req *MsgDetokenizeRedemptionRecord) (*MsgDetokenizeRedemptionRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetokenizeRedemptionRecord not implemented")
+}
+func (*UnimplementedMsgServer) LiquidStakeBasket(ctx context.Context, req *MsgLiquidStakeBasket) (*MsgLiquidStakeBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStakeBasket not implemented")
+}
+func (*UnimplementedMsgServer) RedeemBasket(ctx context.Context, req *MsgRedeemBasket) (*MsgRedeemBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemBasket not implemented")
+}
+func (*UnimplementedMsgServer) CreateBasket(ctx context.Context, req *MsgCreateBasket) (*MsgCreateBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBasket not implemented")
+}
+func (*UnimplementedMsgServer) RebalanceValidators(ctx context.Context, req *MsgRebalanceValidators) (*MsgRebalanceValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceValidators not implemented")
+}
+func (*UnimplementedMsgServer) AddValidators(ctx context.Context, req *MsgAddValidators) (*MsgAddValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddValidators not implemented")
+}
+func (*UnimplementedMsgServer) ChangeValidatorWeight(ctx context.Context, req *MsgChangeValidatorWeights) (*MsgChangeValidatorWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeValidatorWeight not implemented")
+}
+func (*UnimplementedMsgServer) DeleteValidator(ctx context.Context, req *MsgDeleteValidator) (*MsgDeleteValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteValidator not implemented")
+}
+func (*UnimplementedMsgServer) RestoreInterchainAccount(ctx context.Context, req *MsgRestoreInterchainAccount) (*MsgRestoreInterchainAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreInterchainAccount not implemented")
+}
+func (*UnimplementedMsgServer) CloseDelegationChannel(ctx context.Context, req *MsgCloseDelegationChannel) (*MsgCloseDelegationChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseDelegationChannel not implemented")
+}
+func (*UnimplementedMsgServer) UpdateValidatorSharesExchRate(ctx context.Context, req *MsgUpdateValidatorSharesExchRate) (*MsgUpdateValidatorSharesExchRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorSharesExchRate not implemented")
+}
+func (*UnimplementedMsgServer) CalibrateDelegation(ctx context.Context, req *MsgCalibrateDelegation) (*MsgCalibrateDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalibrateDelegation not implemented")
+}
+func (*UnimplementedMsgServer) ClearBalance(ctx context.Context, req *MsgClearBalance) (*MsgClearBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearBalance not implemented")
+}
+func (*UnimplementedMsgServer) UpdateInnerRedemptionRateBounds(ctx context.Context, req *MsgUpdateInnerRedemptionRateBounds) (*MsgUpdateInnerRedemptionRateBoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInnerRedemptionRateBounds not implemented")
+}
+func (*UnimplementedMsgServer) ResumeHostZone(ctx context.Context, req *MsgResumeHostZone) (*MsgResumeHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeHostZone not implemented")
+}
+func (*UnimplementedMsgServer) CreateTradeRoute(ctx context.Context, req *MsgCreateTradeRoute) (*MsgCreateTradeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTradeRoute not implemented")
+}
+func (*UnimplementedMsgServer) DeleteTradeRoute(ctx context.Context, req *MsgDeleteTradeRoute) (*MsgDeleteTradeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTradeRoute not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTradeRoute(ctx context.Context, req *MsgUpdateTradeRoute) (*MsgUpdateTradeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTradeRoute not implemented")
+}
+func (*UnimplementedMsgServer) SetCommunityPoolRebate(ctx context.Context, req *MsgSetCommunityPoolRebate) (*MsgSetCommunityPoolRebateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommunityPoolRebate not implemented")
+}
+func (*UnimplementedMsgServer) ToggleTradeController(ctx context.Context, req *MsgToggleTradeController) (*MsgToggleTradeControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleTradeController not implemented")
+}
+func (*UnimplementedMsgServer) UpdateHostZoneParams(ctx context.Context, req *MsgUpdateHostZoneParams) (*MsgUpdateHostZoneParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostZoneParams not implemented")
+}
+func (*UnimplementedMsgServer) DeprecateHostZone(ctx context.Context, req *MsgDeprecateHostZone) (*MsgDeprecateHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateHostZone not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoValidatorWeighting(ctx context.Context, req *MsgSetAutoValidatorWeighting) (*MsgSetAutoValidatorWeightingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoValidatorWeighting not implemented")
+}
+func (*UnimplementedMsgServer) InstantRedeemStake(ctx context.Context, req *MsgInstantRedeemStake) (*MsgInstantRedeemStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantRedeemStake not implemented")
+}
+func (*UnimplementedMsgServer) SetInstantRedemptionConfig(ctx context.Context, req *MsgSetInstantRedemptionConfig) (*MsgSetInstantRedemptionConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInstantRedemptionConfig not implemented")
+}
+func (*UnimplementedMsgServer) SetValidatorBlacklistPolicy(ctx context.Context, req *MsgSetValidatorBlacklistPolicy) (*MsgSetValidatorBlacklistPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorBlacklistPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveBlacklistedValidator(ctx context.Context, req *MsgRemoveBlacklistedValidator) (*MsgRemoveBlacklistedValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlacklistedValidator not implemented")
+}
+func (*UnimplementedMsgServer) SetRedemptionRateGuardConfig(ctx context.Context, req *MsgSetRedemptionRateGuardConfig) (*MsgSetRedemptionRateGuardConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRedemptionRateGuardConfig not implemented")
+}
+func (*UnimplementedMsgServer) SetCircuitBreakerGuardian(ctx context.Context, req *MsgSetCircuitBreakerGuardian) (*MsgSetCircuitBreakerGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCircuitBreakerGuardian not implemented")
+}
+func (*UnimplementedMsgServer) TripCircuitBreaker(ctx context.Context, req *MsgTripCircuitBreaker) (*MsgTripCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) ResetCircuitBreaker(ctx context.Context, req *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) SetInsuranceFundConfig(ctx context.Context, req *MsgSetInsuranceFundConfig) (*MsgSetInsuranceFundConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInsuranceFundConfig not implemented")
+}
+func (*UnimplementedMsgServer) SetReconciliationThreshold(ctx context.Context, req *MsgSetReconciliationThreshold) (*MsgSetReconciliationThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReconciliationThreshold not implemented")
+}
+func (*UnimplementedMsgServer) OnboardHostZone(ctx context.Context, req *MsgOnboardHostZone) (*MsgOnboardHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnboardHostZone not implemented")
+}
+func (*UnimplementedMsgServer) SetUnbondCap(ctx context.Context, req *MsgSetUnbondCap) (*MsgSetUnbondCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnbondCap not implemented")
 }
 
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidStake(ctx, in)
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/LiquidStake",
 	}
-	return n
-}
-
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
 	}
-	var l int
-	_ = l
-	l = m.StToken.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLSMLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LSMLiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLSMLiquidStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).LSMLiquidStake(ctx, in)
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.LsmTokenIbcDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/LSMLiquidStake",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LSMLiquidStake(ctx, req.(*MsgLSMLiquidStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLSMLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RedeemStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TransactionComplete {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemStake(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/RedeemStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemStake(ctx, req.(*MsgRedeemStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClearBalance) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterHostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterHostZone)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterHostZone(ctx, in)
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/RegisterHostZone",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterHostZone(ctx, req.(*MsgRegisterHostZone))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClearBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClaimUndelegatedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimUndelegatedTokens)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimUndelegatedTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/ClaimUndelegatedTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimUndelegatedTokens(ctx, req.(*MsgClaimUndelegatedTokens))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRedeemStake) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferRedemptionRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferRedemptionRecord)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TransferRedemptionRecord(ctx, in)
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.HostZone)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/TransferRedemptionRecord",
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferRedemptionRecord(ctx, req.(*MsgTransferRedemptionRecord))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRedeemStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/CancelRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRedemption(ctx, req.(*MsgCancelRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterHostZone) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_TokenizeRedemptionRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeRedemptionRecord)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeRedemptionRecord(ctx, in)
 	}
-	l = len(m.TransferChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/TokenizeRedemptionRecord",
 	}
-	if m.UnbondingPeriod != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingPeriod))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeRedemptionRecord(ctx, req.(*MsgTokenizeRedemptionRecord))
 	}
-	l = len(m.Bech32Prefix)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DetokenizeRedemptionRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDetokenizeRedemptionRecord)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = m.MinRedemptionRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxRedemptionRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.LsmLiquidStakeEnabled {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).DetokenizeRedemptionRecord(ctx, in)
 	}
-	l = len(m.CommunityPoolTreasuryAddress)
-	if l > 0 {
-		n += 2 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/DetokenizeRedemptionRecord",
 	}
-	if m.MaxMessagesPerIcaTx != 0 {
-		n += 2 + sovTx(uint64(m.MaxMessagesPerIcaTx))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DetokenizeRedemptionRecord(ctx, req.(*MsgDetokenizeRedemptionRecord))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterHostZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LiquidStakeBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidStakeBasket)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidStakeBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/LiquidStakeBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidStakeBasket(ctx, req.(*MsgLiquidStakeBasket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimUndelegatedTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_RedeemBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemBasket)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.HostZoneId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemBasket(ctx, in)
 	}
-	if m.Epoch != 0 {
-		n += 1 + sovTx(uint64(m.Epoch))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/RedeemBasket",
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemBasket(ctx, req.(*MsgRedeemBasket))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimUndelegatedTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBasket)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/CreateBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBasket(ctx, req.(*MsgCreateBasket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRebalanceValidators) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RebalanceValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebalanceValidators)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RebalanceValidators(ctx, in)
 	}
-	l = len(m.HostZone)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/RebalanceValidators",
 	}
-	if m.NumRebalance != 0 {
-		n += 1 + sovTx(uint64(m.NumRebalance))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebalanceValidators(ctx, req.(*MsgRebalanceValidators))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRebalanceValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddValidators)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AddValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/AddValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddValidators(ctx, req.(*MsgAddValidators))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddValidators) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ChangeValidatorWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeValidatorWeights)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeValidatorWeight(ctx, in)
 	}
-	l = len(m.HostZone)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/ChangeValidatorWeight",
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeValidatorWeight(ctx, req.(*MsgChangeValidatorWeights))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteValidator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ValidatorWeight) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteValidator(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/DeleteValidator",
 	}
-	if m.Weight != 0 {
-		n += 1 + sovTx(uint64(m.Weight))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteValidator(ctx, req.(*MsgDeleteValidator))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgChangeValidatorWeights) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RestoreInterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestoreInterchainAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RestoreInterchainAccount(ctx, in)
 	}
-	l = len(m.HostZone)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/RestoreInterchainAccount",
 	}
-	if len(m.ValidatorWeights) > 0 {
-		for _, e := range m.ValidatorWeights {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestoreInterchainAccount(ctx, req.(*MsgRestoreInterchainAccount))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgChangeValidatorWeightsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CloseDelegationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseDelegationChannel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeleteValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CloseDelegationChannel(ctx, in)
 	}
-	l = len(m.HostZone)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/CloseDelegationChannel",
 	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseDelegationChannel(ctx, req.(*MsgCloseDelegationChannel))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateValidatorSharesExchRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorSharesExchRate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorSharesExchRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/UpdateValidatorSharesExchRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorSharesExchRate(ctx, req.(*MsgUpdateValidatorSharesExchRate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRestoreInterchainAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_CalibrateDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCalibrateDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CalibrateDelegation(ctx, in)
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/CalibrateDelegation",
 	}
-	l = len(m.AccountOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CalibrateDelegation(ctx, req.(*MsgCalibrateDelegation))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRestoreInterchainAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClearBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearBalance)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCloseDelegationChannel) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).ClearBalance(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/ClearBalance",
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearBalance(ctx, req.(*MsgClearBalance))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseDelegationChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateInnerRedemptionRateBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInnerRedemptionRateBounds)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInnerRedemptionRateBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/UpdateInnerRedemptionRateBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInnerRedemptionRateBounds(ctx, req.(*MsgUpdateInnerRedemptionRateBounds))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateValidatorSharesExchRate) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResumeHostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeHostZone)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeHostZone(ctx, in)
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/ResumeHostZone",
 	}
-	l = len(m.Valoper)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeHostZone(ctx, req.(*MsgResumeHostZone))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateValidatorSharesExchRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateTradeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTradeRoute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTradeRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/CreateTradeRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTradeRoute(ctx, req.(*MsgCreateTradeRoute))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCalibrateDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteTradeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteTradeRoute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteTradeRoute(ctx, in)
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/DeleteTradeRoute",
 	}
-	l = len(m.Valoper)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteTradeRoute(ctx, req.(*MsgDeleteTradeRoute))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCalibrateDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateTradeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTradeRoute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgResumeHostZone) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTradeRoute(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/UpdateTradeRoute",
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTradeRoute(ctx, req.(*MsgUpdateTradeRoute))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgResumeHostZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetCommunityPoolRebate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCommunityPoolRebate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeprecateHostZone) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SetCommunityPoolRebate(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/SetCommunityPoolRebate",
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCommunityPoolRebate(ctx, req.(*MsgSetCommunityPoolRebate))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeprecateHostZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ToggleTradeController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgToggleTradeController)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCreateTradeRoute) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).ToggleTradeController(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/ToggleTradeController",
 	}
-	l = len(m.HostChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ToggleTradeController(ctx, req.(*MsgToggleTradeController))
 	}
-	l = len(m.StrideToRewardConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateHostZoneParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateHostZoneParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.StrideToTradeConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateHostZoneParams(ctx, in)
 	}
-	l = len(m.HostToRewardTransferChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/UpdateHostZoneParams",
 	}
-	l = len(m.RewardToTradeTransferChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateHostZoneParams(ctx, req.(*MsgUpdateHostZoneParams))
 	}
-	l = len(m.TradeToHostTransferChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeprecateHostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeprecateHostZone)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.RewardDenomOnHost)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeprecateHostZone(ctx, in)
 	}
-	l = len(m.RewardDenomOnReward)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/DeprecateHostZone",
 	}
-	l = len(m.RewardDenomOnTrade)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeprecateHostZone(ctx, req.(*MsgDeprecateHostZone))
 	}
-	l = len(m.HostDenomOnTrade)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoValidatorWeighting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoValidatorWeighting)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.HostDenomOnHost)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoValidatorWeighting(ctx, in)
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/SetAutoValidatorWeighting",
 	}
-	l = len(m.MaxAllowedSwapLossRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoValidatorWeighting(ctx, req.(*MsgSetAutoValidatorWeighting))
 	}
-	l = m.MinSwapAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxSwapAmount.Size()
-	n += 2 + l + sovTx(uint64(l))
-	l = m.MinTransferAmount.Size()
-	n += 2 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTradeRouteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_InstantRedeemStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantRedeemStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).InstantRedeemStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/InstantRedeemStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantRedeemStake(ctx, req.(*MsgInstantRedeemStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteTradeRoute) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetInstantRedemptionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInstantRedemptionConfig)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetInstantRedemptionConfig(ctx, in)
 	}
-	l = len(m.RewardDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/SetInstantRedemptionConfig",
 	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInstantRedemptionConfig(ctx, req.(*MsgSetInstantRedemptionConfig))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteTradeRouteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetValidatorBlacklistPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorBlacklistPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateTradeRoute) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorBlacklistPolicy(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/SetValidatorBlacklistPolicy",
 	}
-	l = len(m.RewardDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorBlacklistPolicy(ctx, req.(*MsgSetValidatorBlacklistPolicy))
 	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBlacklistedValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBlacklistedValidator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBlacklistedValidator(ctx, in)
 	}
-	l = len(m.MaxAllowedSwapLossRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/RemoveBlacklistedValidator",
 	}
-	l = m.MinSwapAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxSwapAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinTransferAmount.Size()
-	n += 2 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateTradeRouteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBlacklistedValidator(ctx, req.(*MsgRemoveBlacklistedValidator))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetCommunityPoolRebate) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetRedemptionRateGuardConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRedemptionRateGuardConfig)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetRedemptionRateGuardConfig(ctx, in)
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/SetRedemptionRateGuardConfig",
 	}
-	l = m.RebateRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.LiquidStakedStTokenAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgSetCommunityPoolRebateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRedemptionRateGuardConfig(ctx, req.(*MsgSetRedemptionRateGuardConfig))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgToggleTradeController) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetCircuitBreakerGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCircuitBreakerGuardian)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetCircuitBreakerGuardian(ctx, in)
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/SetCircuitBreakerGuardian",
 	}
-	if m.PermissionChange != 0 {
-		n += 1 + sovTx(uint64(m.PermissionChange))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCircuitBreakerGuardian(ctx, req.(*MsgSetCircuitBreakerGuardian))
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TripCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTripCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Legacy {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).TripCircuitBreaker(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/TripCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TripCircuitBreaker(ctx, req.(*MsgTripCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgToggleTradeControllerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/ResetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, req.(*MsgResetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateHostZoneParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetInsuranceFundConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInsuranceFundConfig)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetInsuranceFundConfig(ctx, in)
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/SetInsuranceFundConfig",
 	}
-	if m.MaxMessagesPerIcaTx != 0 {
-		n += 1 + sovTx(uint64(m.MaxMessagesPerIcaTx))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInsuranceFundConfig(ctx, req.(*MsgSetInsuranceFundConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReconciliationThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReconciliationThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReconciliationThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/SetReconciliationThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReconciliationThreshold(ctx, req.(*MsgSetReconciliationThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OnboardHostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOnboardHostZone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OnboardHostZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/OnboardHostZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OnboardHostZone(ctx, req.(*MsgOnboardHostZone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUnbondCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUnbondCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUnbondCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Msg/SetUnbondCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUnbondCap(ctx, req.(*MsgSetUnbondCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stride.stakeibc.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LiquidStake",
+			Handler:    _Msg_LiquidStake_Handler,
+		},
+		{
+			MethodName: "LSMLiquidStake",
+			Handler:    _Msg_LSMLiquidStake_Handler,
+		},
+		{
+			MethodName: "RedeemStake",
+			Handler:    _Msg_RedeemStake_Handler,
+		},
+		{
+			MethodName: "RegisterHostZone",
+			Handler:    _Msg_RegisterHostZone_Handler,
+		},
+		{
+			MethodName: "ClaimUndelegatedTokens",
+			Handler:    _Msg_ClaimUndelegatedTokens_Handler,
+		},
+		{
+			MethodName: "TransferRedemptionRecord",
+			Handler:    _Msg_TransferRedemptionRecord_Handler,
+		},
+		{
+			MethodName: "CancelRedemption",
+			Handler:    _Msg_CancelRedemption_Handler,
+		},
+		{
+			MethodName: "TokenizeRedemptionRecord",
+			Handler:    _Msg_TokenizeRedemptionRecord_Handler,
+		},
+		{
+			MethodName: "DetokenizeRedemptionRecord",
+			Handler:    _Msg_DetokenizeRedemptionRecord_Handler,
+		},
+		{
+			MethodName: "LiquidStakeBasket",
+			Handler:    _Msg_LiquidStakeBasket_Handler,
+		},
+		{
+			MethodName: "RedeemBasket",
+			Handler:    _Msg_RedeemBasket_Handler,
+		},
+		{
+			MethodName: "CreateBasket",
+			Handler:    _Msg_CreateBasket_Handler,
+		},
+		{
+			MethodName: "RebalanceValidators",
+			Handler:    _Msg_RebalanceValidators_Handler,
+		},
+		{
+			MethodName: "AddValidators",
+			Handler:    _Msg_AddValidators_Handler,
+		},
+		{
+			MethodName: "ChangeValidatorWeight",
+			Handler:    _Msg_ChangeValidatorWeight_Handler,
+		},
+		{
+			MethodName: "DeleteValidator",
+			Handler:    _Msg_DeleteValidator_Handler,
+		},
+		{
+			MethodName: "RestoreInterchainAccount",
+			Handler:    _Msg_RestoreInterchainAccount_Handler,
+		},
+		{
+			MethodName: "CloseDelegationChannel",
+			Handler:    _Msg_CloseDelegationChannel_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorSharesExchRate",
+			Handler:    _Msg_UpdateValidatorSharesExchRate_Handler,
+		},
+		{
+			MethodName: "CalibrateDelegation",
+			Handler:    _Msg_CalibrateDelegation_Handler,
+		},
+		{
+			MethodName: "ClearBalance",
+			Handler:    _Msg_ClearBalance_Handler,
+		},
+		{
+			MethodName: "UpdateInnerRedemptionRateBounds",
+			Handler:    _Msg_UpdateInnerRedemptionRateBounds_Handler,
+		},
+		{
+			MethodName: "ResumeHostZone",
+			Handler:    _Msg_ResumeHostZone_Handler,
+		},
+		{
+			MethodName: "CreateTradeRoute",
+			Handler:    _Msg_CreateTradeRoute_Handler,
+		},
+		{
+			MethodName: "DeleteTradeRoute",
+			Handler:    _Msg_DeleteTradeRoute_Handler,
+		},
+		{
+			MethodName: "UpdateTradeRoute",
+			Handler:    _Msg_UpdateTradeRoute_Handler,
+		},
+		{
+			MethodName: "SetCommunityPoolRebate",
+			Handler:    _Msg_SetCommunityPoolRebate_Handler,
+		},
+		{
+			MethodName: "ToggleTradeController",
+			Handler:    _Msg_ToggleTradeController_Handler,
+		},
+		{
+			MethodName: "UpdateHostZoneParams",
+			Handler:    _Msg_UpdateHostZoneParams_Handler,
+		},
+		{
+			MethodName: "DeprecateHostZone",
+			Handler:    _Msg_DeprecateHostZone_Handler,
+		},
+		{
+			MethodName: "SetAutoValidatorWeighting",
+			Handler:    _Msg_SetAutoValidatorWeighting_Handler,
+		},
+		{
+			MethodName: "InstantRedeemStake",
+			Handler:    _Msg_InstantRedeemStake_Handler,
+		},
+		{
+			MethodName: "SetInstantRedemptionConfig",
+			Handler:    _Msg_SetInstantRedemptionConfig_Handler,
+		},
+		{
+			MethodName: "SetValidatorBlacklistPolicy",
+			Handler:    _Msg_SetValidatorBlacklistPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveBlacklistedValidator",
+			Handler:    _Msg_RemoveBlacklistedValidator_Handler,
+		},
+		{
+			MethodName: "SetRedemptionRateGuardConfig",
+			Handler:    _Msg_SetRedemptionRateGuardConfig_Handler,
+		},
+		{
+			MethodName: "SetCircuitBreakerGuardian",
+			Handler:    _Msg_SetCircuitBreakerGuardian_Handler,
+		},
+		{
+			MethodName: "TripCircuitBreaker",
+			Handler:    _Msg_TripCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _Msg_ResetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "SetInsuranceFundConfig",
+			Handler:    _Msg_SetInsuranceFundConfig_Handler,
+		},
+		{
+			MethodName: "SetReconciliationThreshold",
+			Handler:    _Msg_SetReconciliationThreshold_Handler,
+		},
+		{
+			MethodName: "OnboardHostZone",
+			Handler:    _Msg_OnboardHostZone_Handler,
+		},
+		{
+			MethodName: "SetUnbondCap",
+			Handler:    _Msg_SetUnbondCap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stride/stakeibc/tx.proto",
+}
+
+func (m *MsgUpdateInnerRedemptionRateBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInnerRedemptionRateBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInnerRedemptionRateBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxInnerRedemptionRate.Size()
+		i -= size
+		if _, err := m.MaxInnerRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinInnerRedemptionRate.Size()
+		i -= size
+		if _, err := m.MinInnerRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInnerRedemptionRateBoundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInnerRedemptionRateBoundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInnerRedemptionRateBoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorPreferences) > 0 {
+		for iNdEx := len(m.ValidatorPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLSMLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLSMLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLSMLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LsmTokenIbcDenom) > 0 {
+		i -= len(m.LsmTokenIbcDenom)
+		copy(dAtA[i:], m.LsmTokenIbcDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LsmTokenIbcDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLSMLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLSMLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLSMLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransactionComplete {
+		i--
+		if m.TransactionComplete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantRedeemStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantRedeemStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantRedeemStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinNativeAmount.Size()
+		i -= size
+		if _, err := m.MinNativeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantRedeemStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantRedeemStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantRedeemStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.NativeToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterHostZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterHostZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterHostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMessagesPerIcaTx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxMessagesPerIcaTx))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.CommunityPoolTreasuryAddress) > 0 {
+		i -= len(m.CommunityPoolTreasuryAddress)
+		copy(dAtA[i:], m.CommunityPoolTreasuryAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CommunityPoolTreasuryAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.LsmLiquidStakeEnabled {
+		i--
+		if m.LsmLiquidStakeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.MaxRedemptionRate.Size()
+		i -= size
+		if _, err := m.MaxRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MinRedemptionRate.Size()
+		i -= size
+		if _, err := m.MinRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.Bech32Prefix) > 0 {
+		i -= len(m.Bech32Prefix)
+		copy(dAtA[i:], m.Bech32Prefix)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bech32Prefix)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TransferChannelId) > 0 {
+		i -= len(m.TransferChannelId)
+		copy(dAtA[i:], m.TransferChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferChannelId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterHostZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimUndelegatedTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimUndelegatedTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimUndelegatedTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimUndelegatedTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimUndelegatedTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimUndelegatedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferRedemptionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferRedemptionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferRedemptionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewReceiver) > 0 {
+		i -= len(m.NewReceiver)
+		copy(dAtA[i:], m.NewReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewReceiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferRedemptionRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferRedemptionRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferRedemptionRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StTokenAmount.Size()
+		i -= size
+		if _, err := m.StTokenAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeRedemptionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeRedemptionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeRedemptionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeRedemptionRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeRedemptionRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeRedemptionRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDetokenizeRedemptionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDetokenizeRedemptionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDetokenizeRedemptionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDetokenizeRedemptionRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDetokenizeRedemptionRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDetokenizeRedemptionRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStakeBasket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStakeBasket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStakeBasket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BasketId) > 0 {
+		i -= len(m.BasketId)
+		copy(dAtA[i:], m.BasketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStakeBasketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStakeBasketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStakeBasketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NativeTokens) > 0 {
+		for iNdEx := len(m.NativeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NativeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.BasketToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BasketRedemptionReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasketRedemptionReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasketRedemptionReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemBasket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemBasket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemBasket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BasketId) > 0 {
+		i -= len(m.BasketId)
+		copy(dAtA[i:], m.BasketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemBasketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemBasketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemBasketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBasket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBasket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBasket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BasketId) > 0 {
+		i -= len(m.BasketId)
+		copy(dAtA[i:], m.BasketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBasketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBasketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBasketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebalanceValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumRebalance != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumRebalance))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebalanceValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeValidatorWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeValidatorWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeValidatorWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorWeights) > 0 {
+		for iNdEx := len(m.ValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeValidatorWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeValidatorWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeValidatorWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestoreInterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountOwner) > 0 {
+		i -= len(m.AccountOwner)
+		copy(dAtA[i:], m.AccountOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestoreInterchainAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreInterchainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseDelegationChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseDelegationChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseDelegationChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseDelegationChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseDelegationChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseDelegationChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorSharesExchRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorSharesExchRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorSharesExchRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Valoper) > 0 {
+		i -= len(m.Valoper)
+		copy(dAtA[i:], m.Valoper)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Valoper)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorSharesExchRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorSharesExchRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorSharesExchRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCalibrateDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCalibrateDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCalibrateDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Valoper) > 0 {
+		i -= len(m.Valoper)
+		copy(dAtA[i:], m.Valoper)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Valoper)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCalibrateDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCalibrateDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCalibrateDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeHostZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeHostZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeHostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeHostZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprecateHostZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprecateHostZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprecateHostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprecateHostZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprecateHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprecateHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTradeRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTradeRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardDenomOnStride) > 0 {
+		i -= len(m.RewardDenomOnStride)
+		copy(dAtA[i:], m.RewardDenomOnStride)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenomOnStride)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.RewardToStrideTransferChannelId) > 0 {
+		i -= len(m.RewardToStrideTransferChannelId)
+		copy(dAtA[i:], m.RewardToStrideTransferChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardToStrideTransferChannelId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.TradeVenue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeVenue))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.MinTransferAmount.Size()
+		i -= size
+		if _, err := m.MinTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.MaxSwapAmount.Size()
+		i -= size
+		if _, err := m.MaxSwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MinSwapAmount.Size()
+		i -= size
+		if _, err := m.MinSwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.MaxAllowedSwapLossRate) > 0 {
+		i -= len(m.MaxAllowedSwapLossRate)
+		copy(dAtA[i:], m.MaxAllowedSwapLossRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxAllowedSwapLossRate)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.HostDenomOnHost) > 0 {
+		i -= len(m.HostDenomOnHost)
+		copy(dAtA[i:], m.HostDenomOnHost)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenomOnHost)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.HostDenomOnTrade) > 0 {
+		i -= len(m.HostDenomOnTrade)
+		copy(dAtA[i:], m.HostDenomOnTrade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenomOnTrade)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RewardDenomOnTrade) > 0 {
+		i -= len(m.RewardDenomOnTrade)
+		copy(dAtA[i:], m.RewardDenomOnTrade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenomOnTrade)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RewardDenomOnReward) > 0 {
+		i -= len(m.RewardDenomOnReward)
+		copy(dAtA[i:], m.RewardDenomOnReward)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenomOnReward)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RewardDenomOnHost) > 0 {
+		i -= len(m.RewardDenomOnHost)
+		copy(dAtA[i:], m.RewardDenomOnHost)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenomOnHost)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TradeToHostTransferChannelId) > 0 {
+		i -= len(m.TradeToHostTransferChannelId)
+		copy(dAtA[i:], m.TradeToHostTransferChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TradeToHostTransferChannelId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RewardToTradeTransferChannelId) > 0 {
+		i -= len(m.RewardToTradeTransferChannelId)
+		copy(dAtA[i:], m.RewardToTradeTransferChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardToTradeTransferChannelId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.HostToRewardTransferChannelId) > 0 {
+		i -= len(m.HostToRewardTransferChannelId)
+		copy(dAtA[i:], m.HostToRewardTransferChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostToRewardTransferChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StrideToTradeConnectionId) > 0 {
+		i -= len(m.StrideToTradeConnectionId)
+		copy(dAtA[i:], m.StrideToTradeConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StrideToTradeConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StrideToRewardConnectionId) > 0 {
+		i -= len(m.StrideToRewardConnectionId)
+		copy(dAtA[i:], m.StrideToRewardConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StrideToRewardConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HostChainId) > 0 {
+		i -= len(m.HostChainId)
+		copy(dAtA[i:], m.HostChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTradeRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTradeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTradeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTradeRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTradeRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RewardDenom) > 0 {
+		i -= len(m.RewardDenom)
+		copy(dAtA[i:], m.RewardDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTradeRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTradeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTradeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTradeRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTradeRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTradeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinTransferAmount.Size()
+		i -= size
+		if _, err := m.MinTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.MaxSwapAmount.Size()
+		i -= size
+		if _, err := m.MaxSwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinSwapAmount.Size()
+		i -= size
+		if _, err := m.MinSwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.MaxAllowedSwapLossRate) > 0 {
+		i -= len(m.MaxAllowedSwapLossRate)
+		copy(dAtA[i:], m.MaxAllowedSwapLossRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxAllowedSwapLossRate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RewardDenom) > 0 {
+		i -= len(m.RewardDenom)
+		copy(dAtA[i:], m.RewardDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTradeRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTradeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTradeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCommunityPoolRebate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCommunityPoolRebate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommunityPoolRebate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidStakedStTokenAmount.Size()
+		i -= size
+		if _, err := m.LiquidStakedStTokenAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RebateRate.Size()
+		i -= size
+		if _, err := m.RebateRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCommunityPoolRebateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCommunityPoolRebateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommunityPoolRebateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleTradeController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleTradeController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleTradeController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Legacy {
+		i--
+		if m.Legacy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PermissionChange != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PermissionChange))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleTradeControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleTradeControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleTradeControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostZoneParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostZoneParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostZoneParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemoveMinRebalanceAmount {
+		i--
+		if m.RemoveMinRebalanceAmount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RemoveMaxIcaTxGas {
+		i--
+		if m.RemoveMaxIcaTxGas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinRebalanceAmount.Size()
+		i -= size
+		if _, err := m.MinRebalanceAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.RemoveFeeSchedule {
+		i--
+		if m.RemoveFeeSchedule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxIcaTxGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxIcaTxGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FeeSchedule != nil {
+		{
+			size, err := m.FeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxMessagesPerIcaTx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxMessagesPerIcaTx))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostZoneParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostZoneParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostZoneParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoValidatorWeighting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoValidatorWeighting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoValidatorWeighting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoValidatorWeightingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoValidatorWeightingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoValidatorWeightingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInstantRedemptionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInstantRedemptionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInstantRedemptionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInstantRedemptionConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInstantRedemptionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInstantRedemptionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorBlacklistPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorBlacklistPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorBlacklistPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorBlacklistPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorBlacklistPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorBlacklistPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBlacklistedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBlacklistedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBlacklistedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBlacklistedValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBlacklistedValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBlacklistedValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRedemptionRateGuardConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRedemptionRateGuardConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRedemptionRateGuardConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRedemptionRateGuardConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRedemptionRateGuardConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRedemptionRateGuardConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCircuitBreakerGuardian) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCircuitBreakerGuardian) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCircuitBreakerGuardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCircuitBreakerGuardianResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCircuitBreakerGuardianResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCircuitBreakerGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTripCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTripCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTripCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		dAtA13 := make([]byte, len(m.Actions)*10)
+		var j12 int
+		for _, num := range m.Actions {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintTx(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTripCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTripCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTripCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		dAtA15 := make([]byte, len(m.Actions)*10)
+		var j14 int
+		for _, num := range m.Actions {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintTx(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInsuranceFundConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInsuranceFundConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInsuranceFundConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInsuranceFundConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInsuranceFundConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInsuranceFundConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReconciliationThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReconciliationThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReconciliationThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReconciliationThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReconciliationThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReconciliationThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitWhitelistEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitWhitelistEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitWhitelistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOnboardHostZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOnboardHostZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOnboardHostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimitWhitelist) > 0 {
+		for iNdEx := len(m.RateLimitWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitWhitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OracleConnectionId) > 0 {
+		i -= len(m.OracleConnectionId)
+		copy(dAtA[i:], m.OracleConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleConnectionId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CommunityPoolRebate != nil {
+		{
+			size, err := m.CommunityPoolRebate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TradeRoute != nil {
+		{
+			size, err := m.TradeRoute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.HostZone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOnboardHostZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOnboardHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOnboardHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUnbondCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnbondCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnbondCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxUnbondPerEpoch.Size()
+		i -= size
+		if _, err := m.MaxUnbondPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUnbondCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnbondCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnbondCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateInnerRedemptionRateBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinInnerRedemptionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxInnerRedemptionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateInnerRedemptionRateBoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ValidatorPreferences) > 0 {
+		for _, e := range m.ValidatorPreferences {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLSMLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.LsmTokenIbcDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLSMLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransactionComplete {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgClearBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClearBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstantRedeemStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinNativeAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInstantRedeemStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NativeToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterHostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingPeriod != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingPeriod))
+	}
+	l = len(m.Bech32Prefix)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinRedemptionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxRedemptionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LsmLiquidStakeEnabled {
+		n += 2
+	}
+	l = len(m.CommunityPoolTreasuryAddress)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.MaxMessagesPerIcaTx != 0 {
+		n += 2 + sovTx(uint64(m.MaxMessagesPerIcaTx))
+	}
+	return n
+}
+
+func (m *MsgRegisterHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimUndelegatedTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimUndelegatedTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferRedemptionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferRedemptionRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StTokenAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTokenizeRedemptionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenizeRedemptionRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDetokenizeRedemptionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDetokenizeRedemptionRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidStakeBasket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidStakeBasketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BasketToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.NativeTokens) > 0 {
+		for _, e := range m.NativeTokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BasketRedemptionReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemBasket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Receivers) > 0 {
+		for _, e := range m.Receivers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRedeemBasketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBasket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateBasketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRebalanceValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NumRebalance != 0 {
+		n += 1 + sovTx(uint64(m.NumRebalance))
+	}
+	return n
+}
+
+func (m *MsgRebalanceValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ValidatorWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovTx(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *MsgChangeValidatorWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ValidatorWeights) > 0 {
+		for _, e := range m.ValidatorWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgChangeValidatorWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRestoreInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRestoreInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseDelegationChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseDelegationChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValidatorSharesExchRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Valoper)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateValidatorSharesExchRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCalibrateDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Valoper)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCalibrateDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeHostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeprecateHostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeprecateHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTradeRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StrideToRewardConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StrideToTradeConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostToRewardTransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardToTradeTransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TradeToHostTransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardDenomOnHost)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardDenomOnReward)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardDenomOnTrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostDenomOnTrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostDenomOnHost)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.MaxAllowedSwapLossRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinSwapAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSwapAmount.Size()
+	n += 2 + l + sovTx(uint64(l))
+	l = m.MinTransferAmount.Size()
+	n += 2 + l + sovTx(uint64(l))
+	if m.TradeVenue != 0 {
+		n += 2 + sovTx(uint64(m.TradeVenue))
+	}
+	l = len(m.RewardToStrideTransferChannelId)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardDenomOnStride)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTradeRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteTradeRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteTradeRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTradeRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.MaxAllowedSwapLossRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinSwapAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSwapAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinTransferAmount.Size()
+	n += 2 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateTradeRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCommunityPoolRebate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RebateRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidStakedStTokenAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetCommunityPoolRebateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgToggleTradeController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PermissionChange != 0 {
+		n += 1 + sovTx(uint64(m.PermissionChange))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Legacy {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgToggleTradeControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateHostZoneParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxMessagesPerIcaTx != 0 {
+		n += 1 + sovTx(uint64(m.MaxMessagesPerIcaTx))
+	}
+	if m.FeeSchedule != nil {
+		l = m.FeeSchedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxIcaTxGas != 0 {
+		n += 1 + sovTx(uint64(m.MaxIcaTxGas))
+	}
+	if m.RemoveFeeSchedule {
+		n += 2
+	}
+	l = m.MinRebalanceAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RemoveMaxIcaTxGas {
+		n += 2
+	}
+	if m.RemoveMinRebalanceAmount {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateHostZoneParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoValidatorWeighting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAutoValidatorWeightingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetInstantRedemptionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetInstantRedemptionConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetValidatorBlacklistPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetValidatorBlacklistPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveBlacklistedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveBlacklistedValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRedemptionRateGuardConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRedemptionRateGuardConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCircuitBreakerGuardian) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetCircuitBreakerGuardianResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTripCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		l = 0
+		for _, e := range m.Actions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgTripCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		l = 0
+		for _, e := range m.Actions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgResetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetInsuranceFundConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetInsuranceFundConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetReconciliationThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetReconciliationThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RateLimitWhitelistEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOnboardHostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.HostZone.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TradeRoute != nil {
+		l = m.TradeRoute.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommunityPoolRebate != nil {
+		l = m.CommunityPoolRebate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RateLimitWhitelist) > 0 {
+		for _, e := range m.RateLimitWhitelist {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgOnboardHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetUnbondCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxUnbondPerEpoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetUnbondCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateInnerRedemptionRateBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInnerRedemptionRateBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInnerRedemptionRateBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInnerRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInnerRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInnerRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInnerRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInnerRedemptionRateBoundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInnerRedemptionRateBoundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInnerRedemptionRateBoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPreferences = append(m.ValidatorPreferences, ValidatorPreference{})
+			if err := m.ValidatorPreferences[len(m.ValidatorPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLSMLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLSMLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLSMLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmTokenIbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LsmTokenIbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLSMLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLSMLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLSMLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionComplete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TransactionComplete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantRedeemStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantRedeemStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantRedeemStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNativeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantRedeemStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantRedeemStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantRedeemStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterHostZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterHostZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmLiquidStakeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LsmLiquidStakeEnabled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolTreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolTreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessagesPerIcaTx", wireType)
+			}
+			m.MaxMessagesPerIcaTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessagesPerIcaTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterHostZoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterHostZoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUndelegatedTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUndelegatedTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUndelegatedTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUndelegatedTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUndelegatedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferRedemptionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferRedemptionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferRedemptionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferRedemptionRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferRedemptionRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenizeRedemptionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenizeRedemptionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenizeRedemptionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenizeRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenizeRedemptionRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenizeRedemptionRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDetokenizeRedemptionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDetokenizeRedemptionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDetokenizeRedemptionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDetokenizeRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDetokenizeRedemptionRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDetokenizeRedemptionRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeBasket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeBasket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeBasket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeBasketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeBasketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasketToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeTokens = append(m.NativeTokens, types.Coin{})
+			if err := m.NativeTokens[len(m.NativeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasketRedemptionReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasketRedemptionReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasketRedemptionReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemBasket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemBasket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemBasket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receivers = append(m.Receivers, BasketRedemptionReceiver{})
+			if err := m.Receivers[len(m.Receivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemBasketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemBasketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBasket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBasket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBasket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, BasketComponent{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBasketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBasketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalanceValidators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalanceValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumRebalance", wireType)
+			}
+			m.NumRebalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumRebalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRebalanceValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalanceValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalanceValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddValidators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddValidators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeValidatorWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeValidatorWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeValidatorWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorWeights = append(m.ValidatorWeights, &ValidatorWeight{})
+			if err := m.ValidatorWeights[len(m.ValidatorWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeValidatorWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeValidatorWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeValidatorWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRestoreInterchainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRestoreInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRestoreInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRestoreInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRestoreInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseDelegationChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseDelegationChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseDelegationChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateHostZoneParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgCloseDelegationChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseDelegationChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseDelegationChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateInnerRedemptionRateBounds) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6341,10 +17324,10 @@ func (m *MsgUpdateInnerRedemptionRateBounds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateInnerRedemptionRateBounds: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateInnerRedemptionRateBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6413,41 +17396,7 @@ func (m *MsgUpdateInnerRedemptionRateBounds) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInnerRedemptionRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinInnerRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxInnerRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6475,9 +17424,7 @@ func (m *MsgUpdateInnerRedemptionRateBounds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxInnerRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Valoper = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6500,7 +17447,7 @@ func (m *MsgUpdateInnerRedemptionRateBounds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateInnerRedemptionRateBoundsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValidatorSharesExchRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6523,10 +17470,10 @@ func (m *MsgUpdateInnerRedemptionRateBoundsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateInnerRedemptionRateBoundsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateInnerRedemptionRateBoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6550,7 +17497,7 @@ func (m *MsgUpdateInnerRedemptionRateBoundsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+func (m *MsgCalibrateDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6573,10 +17520,10 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCalibrateDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCalibrateDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6613,7 +17560,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6641,13 +17588,11 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6675,7 +17620,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			m.Valoper = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6698,7 +17643,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCalibrateDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6721,45 +17666,12 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCalibrateDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCalibrateDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StToken", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6781,7 +17693,7 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLSMLiquidStake) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeHostZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6804,10 +17716,10 @@ func (m *MsgLSMLiquidStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLSMLiquidStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeHostZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLSMLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeHostZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6844,41 +17756,7 @@ func (m *MsgLSMLiquidStake) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LsmTokenIbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6906,7 +17784,7 @@ func (m *MsgLSMLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LsmTokenIbcDenom = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6929,7 +17807,7 @@ func (m *MsgLSMLiquidStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLSMLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeHostZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6952,32 +17830,12 @@ func (m *MsgLSMLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLSMLiquidStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeHostZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLSMLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionComplete", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TransactionComplete = bool(v != 0)
+			return fmt.Errorf("proto: MsgResumeHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6999,7 +17857,7 @@ func (m *MsgLSMLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
+func (m *MsgDeprecateHostZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7022,15 +17880,15 @@ func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeprecateHostZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeprecateHostZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7058,7 +17916,7 @@ func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -7092,72 +17950,6 @@ func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7179,7 +17971,7 @@ func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeprecateHostZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7202,10 +17994,10 @@ func (m *MsgClearBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeprecateHostZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeprecateHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7229,7 +18021,7 @@ func (m *MsgClearBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7252,15 +18044,15 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTradeRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTradeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7288,11 +18080,11 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7320,13 +18112,11 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HostChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrideToRewardConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7354,11 +18144,11 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = string(dAtA[iNdEx:postIndex])
+			m.StrideToRewardConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrideToTradeConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7386,111 +18176,107 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.StrideToTradeConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostToRewardTransferChannelId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRedeemStakeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.HostToRewardTransferChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToTradeTransferChannelId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemStakeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.RewardToTradeTransferChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeToHostTransferChannelId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterHostZone: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterHostZone: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			m.TradeToHostTransferChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomOnHost", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7518,11 +18304,11 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.RewardDenomOnHost = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomOnReward", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7550,11 +18336,11 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			m.RewardDenomOnReward = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomOnTrade", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7582,11 +18368,11 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			m.RewardDenomOnTrade = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenomOnTrade", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7614,11 +18400,11 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.HostDenomOnTrade = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenomOnHost", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7646,13 +18432,13 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
+			m.HostDenomOnHost = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.UnbondingPeriod = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7662,14 +18448,14 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingPeriod |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedSwapLossRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7697,11 +18483,11 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			m.MaxAllowedSwapLossRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7729,13 +18515,13 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7763,15 +18549,49 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTransferAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LsmLiquidStakeEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeVenue", wireType)
 			}
-			var v int
+			m.TradeVenue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7781,15 +18601,14 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TradeVenue |= TradeVenue(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LsmLiquidStakeEnabled = bool(v != 0)
-		case 16:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolTreasuryAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToStrideTransferChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7817,13 +18636,13 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPoolTreasuryAddress = string(dAtA[iNdEx:postIndex])
+			m.RewardToStrideTransferChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessagesPerIcaTx", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomOnStride", wireType)
 			}
-			m.MaxMessagesPerIcaTx = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7833,11 +18652,24 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMessagesPerIcaTx |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenomOnStride = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7859,7 +18691,7 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTradeRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7882,10 +18714,10 @@ func (m *MsgRegisterHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTradeRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTradeRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7909,7 +18741,7 @@ func (m *MsgRegisterHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteTradeRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7932,15 +18764,15 @@ func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUndelegatedTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteTradeRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUndelegatedTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteTradeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7968,11 +18800,11 @@ func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8000,30 +18832,11 @@ func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8051,7 +18864,7 @@ func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8074,7 +18887,7 @@ func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUndelegatedTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteTradeRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8097,10 +18910,10 @@ func (m *MsgClaimUndelegatedTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUndelegatedTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteTradeRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUndelegatedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteTradeRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8124,7 +18937,7 @@ func (m *MsgClaimUndelegatedTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTradeRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8147,15 +18960,15 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRebalanceValidators: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTradeRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRebalanceValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTradeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8183,11 +18996,11 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8215,13 +19028,45 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = string(dAtA[iNdEx:postIndex])
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumRebalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.NumRebalance = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8231,114 +19076,46 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumRebalance |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedSwapLossRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRebalanceValidatorsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRebalanceValidatorsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRebalanceValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddValidators) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddValidators: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddValidators: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MaxAllowedSwapLossRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8366,11 +19143,13 @@ func (m *MsgAddValidators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8398,13 +19177,15 @@ func (m *MsgAddValidators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTransferAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8414,23 +19195,23 @@ func (m *MsgAddValidators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8455,7 +19236,7 @@ func (m *MsgAddValidators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTradeRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8478,10 +19259,10 @@ func (m *MsgAddValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTradeRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTradeRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8505,7 +19286,7 @@ func (m *MsgAddValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorWeight) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCommunityPoolRebate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8528,15 +19309,15 @@ func (m *ValidatorWeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorWeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCommunityPoolRebate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCommunityPoolRebate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8564,80 +19345,11 @@ func (m *ValidatorWeight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
-			}
-			m.Weight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgChangeValidatorWeights) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeValidatorWeights: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeValidatorWeights: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8665,11 +19377,11 @@ func (m *MsgChangeValidatorWeights) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RebateRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8697,13 +19409,15 @@ func (m *MsgChangeValidatorWeights) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = string(dAtA[iNdEx:postIndex])
+			if err := m.RebateRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWeights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakedStTokenAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8713,23 +19427,23 @@ func (m *MsgChangeValidatorWeights) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorWeights = append(m.ValidatorWeights, &ValidatorWeight{})
-			if err := m.ValidatorWeights[len(m.ValidatorWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidStakedStTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8754,7 +19468,7 @@ func (m *MsgChangeValidatorWeights) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeValidatorWeightsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCommunityPoolRebateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8777,10 +19491,10 @@ func (m *MsgChangeValidatorWeightsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeValidatorWeightsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCommunityPoolRebateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeValidatorWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCommunityPoolRebateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8804,7 +19518,7 @@ func (m *MsgChangeValidatorWeightsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleTradeController) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8827,10 +19541,10 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleTradeController: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleTradeController: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8867,7 +19581,7 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8895,11 +19609,30 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionChange", wireType)
+			}
+			m.PermissionChange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermissionChange |= AuthzPermissionChange(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8927,8 +19660,28 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Legacy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Legacy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8950,7 +19703,7 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleTradeControllerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8973,10 +19726,10 @@ func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleTradeControllerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleTradeControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9000,7 +19753,7 @@ func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateHostZoneParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9023,15 +19776,15 @@ func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRestoreInterchainAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateHostZoneParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRestoreInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateHostZoneParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9059,7 +19812,7 @@ func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9094,10 +19847,29 @@ func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessagesPerIcaTx", wireType)
+			}
+			m.MaxMessagesPerIcaTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessagesPerIcaTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9107,27 +19879,70 @@ func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			if m.FeeSchedule == nil {
+				m.FeeSchedule = &HostZoneFeeSchedule{}
+			}
+			if err := m.FeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIcaTxGas", wireType)
+			}
+			m.MaxIcaTxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIcaTxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveFeeSchedule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoveFeeSchedule = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRebalanceAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9155,8 +19970,50 @@ func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountOwner = string(dAtA[iNdEx:postIndex])
+			if err := m.MinRebalanceAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveMaxIcaTxGas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoveMaxIcaTxGas = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveMinRebalanceAmount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoveMinRebalanceAmount = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9178,7 +20035,7 @@ func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRestoreInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateHostZoneParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9201,10 +20058,10 @@ func (m *MsgRestoreInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRestoreInterchainAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateHostZoneParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRestoreInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateHostZoneParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9228,7 +20085,7 @@ func (m *MsgRestoreInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseDelegationChannel) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoValidatorWeighting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9251,15 +20108,15 @@ func (m *MsgCloseDelegationChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseDelegationChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoValidatorWeighting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseDelegationChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoValidatorWeighting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9287,7 +20144,7 @@ func (m *MsgCloseDelegationChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9321,6 +20178,42 @@ func (m *MsgCloseDelegationChannel) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &AutoValidatorWeightingConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9342,7 +20235,7 @@ func (m *MsgCloseDelegationChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseDelegationChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoValidatorWeightingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9365,10 +20258,10 @@ func (m *MsgCloseDelegationChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseDelegationChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoValidatorWeightingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseDelegationChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoValidatorWeightingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9392,7 +20285,7 @@ func (m *MsgCloseDelegationChannelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInstantRedemptionConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9415,15 +20308,15 @@ func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInstantRedemptionConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInstantRedemptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9451,7 +20344,7 @@ func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9487,9 +20380,9 @@ func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9499,23 +20392,27 @@ func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valoper = string(dAtA[iNdEx:postIndex])
+			if m.Config == nil {
+				m.Config = &InstantRedemptionConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9538,7 +20435,7 @@ func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValidatorSharesExchRateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInstantRedemptionConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9561,10 +20458,10 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInstantRedemptionConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInstantRedemptionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9588,7 +20485,7 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgCalibrateDelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgSetValidatorBlacklistPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9611,15 +20508,15 @@ func (m *MsgCalibrateDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCalibrateDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetValidatorBlacklistPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCalibrateDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetValidatorBlacklistPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9647,7 +20544,7 @@ func (m *MsgCalibrateDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9683,9 +20580,9 @@ func (m *MsgCalibrateDelegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9695,23 +20592,27 @@ func (m *MsgCalibrateDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valoper = string(dAtA[iNdEx:postIndex])
+			if m.Policy == nil {
+				m.Policy = &ValidatorBlacklistPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9734,7 +20635,7 @@ func (m *MsgCalibrateDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCalibrateDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetValidatorBlacklistPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9757,10 +20658,10 @@ func (m *MsgCalibrateDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCalibrateDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetValidatorBlacklistPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCalibrateDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetValidatorBlacklistPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9784,7 +20685,7 @@ func (m *MsgCalibrateDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResumeHostZone) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveBlacklistedValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9807,15 +20708,15 @@ func (m *MsgResumeHostZone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResumeHostZone: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveBlacklistedValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResumeHostZone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveBlacklistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9843,7 +20744,7 @@ func (m *MsgResumeHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9877,6 +20778,38 @@ func (m *MsgResumeHostZone) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9898,7 +20831,7 @@ func (m *MsgResumeHostZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResumeHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveBlacklistedValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9921,10 +20854,10 @@ func (m *MsgResumeHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResumeHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveBlacklistedValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResumeHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveBlacklistedValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9948,7 +20881,7 @@ func (m *MsgResumeHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeprecateHostZone) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRedemptionRateGuardConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9971,10 +20904,10 @@ func (m *MsgDeprecateHostZone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeprecateHostZone: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRedemptionRateGuardConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeprecateHostZone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRedemptionRateGuardConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10041,6 +20974,42 @@ func (m *MsgDeprecateHostZone) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &RedemptionRateGuardConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10062,7 +21031,7 @@ func (m *MsgDeprecateHostZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeprecateHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRedemptionRateGuardConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10085,10 +21054,10 @@ func (m *MsgDeprecateHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeprecateHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRedemptionRateGuardConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeprecateHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRedemptionRateGuardConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10112,7 +21081,7 @@ func (m *MsgDeprecateHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCircuitBreakerGuardian) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10135,10 +21104,10 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTradeRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCircuitBreakerGuardian: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTradeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCircuitBreakerGuardian: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10175,7 +21144,7 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10203,13 +21172,13 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostChainId = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrideToRewardConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10219,155 +21188,128 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StrideToRewardConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrideToTradeConnectionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.StrideToTradeConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostToRewardTransferChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostToRewardTransferChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToTradeTransferChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCircuitBreakerGuardianResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardToTradeTransferChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeToHostTransferChannelId", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCircuitBreakerGuardianResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCircuitBreakerGuardianResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradeToHostTransferChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTripCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTripCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomOnHost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10395,11 +21337,11 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDenomOnHost = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomOnReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10427,11 +21369,11 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDenomOnReward = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomOnTrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10459,94 +21401,180 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDenomOnTrade = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenomOnTrade", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+		case 4:
+			if wireType == 0 {
+				var v CircuitBreakerAction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CircuitBreakerAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Actions = append(m.Actions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				if elementCount != 0 && len(m.Actions) == 0 {
+					m.Actions = make([]CircuitBreakerAction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CircuitBreakerAction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CircuitBreakerAction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Actions = append(m.Actions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenomOnTrade = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenomOnHost", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTripCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTripCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTripCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenomOnHost = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 14:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedSwapLossRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10574,11 +21602,11 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxAllowedSwapLossRate = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10606,13 +21634,11 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10640,44 +21666,77 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTransferAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+		case 4:
+			if wireType == 0 {
+				var v CircuitBreakerAction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CircuitBreakerAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Actions = append(m.Actions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				var elementCount int
+				if elementCount != 0 && len(m.Actions) == 0 {
+					m.Actions = make([]CircuitBreakerAction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CircuitBreakerAction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CircuitBreakerAction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Actions = append(m.Actions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			if err := m.MinTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10699,7 +21758,7 @@ func (m *MsgCreateTradeRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTradeRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10722,10 +21781,10 @@ func (m *MsgCreateTradeRouteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTradeRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTradeRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10749,7 +21808,7 @@ func (m *MsgCreateTradeRouteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteTradeRoute) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInsuranceFundConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10772,10 +21831,10 @@ func (m *MsgDeleteTradeRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteTradeRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInsuranceFundConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteTradeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInsuranceFundConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10812,7 +21871,7 @@ func (m *MsgDeleteTradeRoute) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10840,13 +21899,13 @@ func (m *MsgDeleteTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10856,23 +21915,27 @@ func (m *MsgDeleteTradeRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			if m.Config == nil {
+				m.Config = &InsuranceFundConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10895,7 +21958,7 @@ func (m *MsgDeleteTradeRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteTradeRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInsuranceFundConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10918,10 +21981,10 @@ func (m *MsgDeleteTradeRouteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteTradeRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInsuranceFundConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteTradeRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInsuranceFundConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10945,7 +22008,7 @@ func (m *MsgDeleteTradeRouteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTradeRoute) Unmarshal(dAtA []byte) error {
+func (m *MsgSetReconciliationThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10968,10 +22031,10 @@ func (m *MsgUpdateTradeRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTradeRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetReconciliationThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTradeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetReconciliationThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11008,7 +22071,7 @@ func (m *MsgUpdateTradeRoute) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11036,11 +22099,11 @@ func (m *MsgUpdateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11068,96 +22131,113 @@ func (m *MsgUpdateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedSwapLossRate", wireType)
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReconciliationThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxAllowedSwapLossRate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReconciliationThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReconciliationThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitWhitelistEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitWhitelistEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitWhitelistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11185,13 +22265,11 @@ func (m *MsgUpdateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTransferAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11216,63 +22294,11 @@ func (m *MsgUpdateTradeRoute) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateTradeRouteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTradeRouteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTradeRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11294,7 +22320,7 @@ func (m *MsgUpdateTradeRouteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetCommunityPoolRebate) Unmarshal(dAtA []byte) error {
+func (m *MsgOnboardHostZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11317,15 +22343,15 @@ func (m *MsgSetCommunityPoolRebate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetCommunityPoolRebate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOnboardHostZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetCommunityPoolRebate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOnboardHostZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11353,13 +22379,13 @@ func (m *MsgSetCommunityPoolRebate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11369,29 +22395,30 @@ func (m *MsgSetCommunityPoolRebate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.HostZone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebateRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11401,31 +22428,31 @@ func (m *MsgSetCommunityPoolRebate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RebateRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakedStTokenAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRoute", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11435,131 +22462,33 @@ func (m *MsgSetCommunityPoolRebate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidStakedStTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetCommunityPoolRebateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.TradeRoute == nil {
+				m.TradeRoute = &MsgCreateTradeRoute{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetCommunityPoolRebateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetCommunityPoolRebateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.TradeRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgToggleTradeController) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleTradeController: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleTradeController: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRebate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11569,27 +22498,31 @@ func (m *MsgToggleTradeController) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if m.CommunityPoolRebate == nil {
+				m.CommunityPoolRebate = &CommunityPoolRebate{}
+			}
+			if err := m.CommunityPoolRebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11617,32 +22550,13 @@ func (m *MsgToggleTradeController) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.OracleConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermissionChange", wireType)
-			}
-			m.PermissionChange = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PermissionChange |= AuthzPermissionChange(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWhitelist", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11652,44 +22566,26 @@ func (m *MsgToggleTradeController) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Legacy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.RateLimitWhitelist = append(m.RateLimitWhitelist, RateLimitWhitelistEntry{})
+			if err := m.RateLimitWhitelist[len(m.RateLimitWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Legacy = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11711,7 +22607,7 @@ func (m *MsgToggleTradeController) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleTradeControllerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOnboardHostZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11734,10 +22630,10 @@ func (m *MsgToggleTradeControllerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleTradeControllerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOnboardHostZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleTradeControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOnboardHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11761,7 +22657,7 @@ func (m *MsgToggleTradeControllerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostZoneParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUnbondCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11784,10 +22680,10 @@ func (m *MsgUpdateHostZoneParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostZoneParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUnbondCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostZoneParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUnbondCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11855,10 +22751,10 @@ func (m *MsgUpdateHostZoneParams) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessagesPerIcaTx", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnbondPerEpoch", wireType)
 			}
-			m.MaxMessagesPerIcaTx = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11868,11 +22764,26 @@ func (m *MsgUpdateHostZoneParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMessagesPerIcaTx |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxUnbondPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11894,7 +22805,7 @@ func (m *MsgUpdateHostZoneParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostZoneParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUnbondCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11917,10 +22828,10 @@ func (m *MsgUpdateHostZoneParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostZoneParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUnbondCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostZoneParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUnbondCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// The max number of validators a staker can direct a liquid stake to
+const MaxValidatorPreferences = 5
+
+// Returns the validator's directed delegation, treating an unset value as zero
+func (v Validator) GetDirectedDelegationOrZero() sdkmath.Int {
+	if v.DirectedDelegation.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return v.DirectedDelegation
+}
+
+// Returns the validator's pending directed delegation, treating an unset value as zero
+func (v Validator) GetPendingDirectedDelegationOrZero() sdkmath.Int {
+	if v.PendingDirectedDelegation.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return v.PendingDirectedDelegation
+}
+
+// Validates the validator preferences on a liquid stake
+// Each preference must have a validator and a non-zero weight, and validators cannot be repeated
+func ValidateValidatorPreferences(preferences []ValidatorPreference) error {
+	if len(preferences) > MaxValidatorPreferences {
+		return errorsmod.Wrapf(ErrInvalidValidatorPreference,
+			"at most %d validator preferences can be specified, %d provided", MaxValidatorPreferences, len(preferences))
+	}
+
+	seenValidators := map[string]bool{}
+	for _, preference := range preferences {
+		if preference.Validator == "" {
+			return errorsmod.Wrap(ErrInvalidValidatorPreference, "validator address must be specified")
+		}
+		if preference.Weight == 0 {
+			return errorsmod.Wrapf(ErrInvalidValidatorPreference, "weight for validator %s must be positive", preference.Validator)
+		}
+		if seenValidators[preference.Validator] {
+			return errorsmod.Wrapf(ErrInvalidValidatorPreference, "duplicate validator %s", preference.Validator)
+		}
+		seenValidators[preference.Validator] = true
+	}
+
+	return nil
+}
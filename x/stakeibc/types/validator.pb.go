@@ -35,6 +35,19 @@ type Validator struct {
 	SharesToTokensRate          cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=shares_to_tokens_rate,json=sharesToTokensRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares_to_tokens_rate"`
 	DelegationChangesInProgress int64                       `protobuf:"varint,11,opt,name=delegation_changes_in_progress,json=delegationChangesInProgress,proto3" json:"delegation_changes_in_progress,omitempty"`
 	SlashQueryInProgress        bool                        `protobuf:"varint,13,opt,name=slash_query_in_progress,json=slashQueryInProgress,proto3" json:"slash_query_in_progress,omitempty"`
+	// Jailed status of the validator, as of the last validator query
+	Jailed bool `protobuf:"varint,14,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// Commission rate of the validator, as of the last validator query
+	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	// Number of slashes that have been detected on the validator
+	SlashCount uint64 `protobuf:"varint,16,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	// Native tokens that stakers have directed to this validator through
+	// validator preferences, and that have been delegated. The validator's target
+	// delegation is this amount plus its weight-based share of the remainder
+	DirectedDelegation cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=directed_delegation,json=directedDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"directed_delegation"`
+	// Native tokens directed to this validator from liquid stakes that have not
+	// yet been delegated
+	PendingDirectedDelegation cosmossdk_io_math.Int `protobuf:"bytes,18,opt,name=pending_directed_delegation,json=pendingDirectedDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"pending_directed_delegation"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -105,44 +118,122 @@ func (m *Validator) GetSlashQueryInProgress() bool {
 	return false
 }
 
+func (m *Validator) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *Validator) GetSlashCount() uint64 {
+	if m != nil {
+		return m.SlashCount
+	}
+	return 0
+}
+
+// A staker's preference to direct a portion of their liquid stake to a
+// validator. The deposit is split across each preference in proportion to
+// its weight
+type ValidatorPreference struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Weight    uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *ValidatorPreference) Reset()         { *m = ValidatorPreference{} }
+func (m *ValidatorPreference) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPreference) ProtoMessage()    {}
+func (*ValidatorPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f32e16bd6ab8f, []int{1}
+}
+func (m *ValidatorPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPreference.Merge(m, src)
+}
+func (m *ValidatorPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPreference proto.InternalMessageInfo
+
+func (m *ValidatorPreference) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorPreference) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Validator)(nil), "stride.stakeibc.Validator")
+	proto.RegisterType((*ValidatorPreference)(nil), "stride.stakeibc.ValidatorPreference")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/validator.proto", fileDescriptor_5d2f32e16bd6ab8f) }
 
 var fileDescriptor_5d2f32e16bd6ab8f = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x1b, 0x96, 0x6d, 0xa9, 0x01, 0x51, 0x59, 0xdd, 0xc8, 0x36, 0x48, 0x2b, 0x4e, 0xbd,
-	0x34, 0x11, 0xab, 0x38, 0x72, 0x60, 0xdd, 0x65, 0x55, 0x0f, 0x90, 0x56, 0x1c, 0x38, 0x10, 0xb9,
-	0xce, 0x5f, 0x89, 0x95, 0xd6, 0x2e, 0xb6, 0x37, 0xe8, 0x5b, 0xf0, 0x30, 0x7b, 0x88, 0x1d, 0xa7,
-	0x9d, 0x80, 0xc3, 0x84, 0xda, 0x17, 0x41, 0x89, 0xd3, 0x25, 0x12, 0x97, 0xdd, 0xec, 0xbf, 0xbf,
-	0xef, 0x67, 0xfd, 0x3f, 0x7d, 0xa8, 0xa3, 0xb4, 0x64, 0x31, 0x04, 0x4a, 0x93, 0x0c, 0xd8, 0x8c,
-	0x06, 0x57, 0x64, 0xce, 0x62, 0xa2, 0x85, 0xf4, 0x97, 0x52, 0x68, 0x81, 0x5f, 0x18, 0x81, 0xbf,
-	0x15, 0x1c, 0x1f, 0x51, 0xa1, 0x16, 0x42, 0x45, 0xc5, 0x73, 0x60, 0x2e, 0x46, 0x7b, 0xdc, 0x4e,
-	0x44, 0x22, 0xcc, 0x3c, 0x3f, 0x99, 0xe9, 0x9b, 0xdf, 0x36, 0x6a, 0x7e, 0xde, 0x52, 0x31, 0x46,
-	0x36, 0x27, 0x0b, 0x70, 0xad, 0xae, 0xd5, 0x6b, 0x86, 0xc5, 0x19, 0x9f, 0xa2, 0x7d, 0x12, 0xc7,
-	0x12, 0x94, 0x72, 0x9f, 0xe4, 0xe3, 0x33, 0xf7, 0xee, 0xba, 0xdf, 0x2e, 0xd1, 0x1f, 0xcc, 0xcb,
-	0x44, 0x4b, 0xc6, 0x93, 0x70, 0x2b, 0xc4, 0x87, 0x68, 0xef, 0x3b, 0xb0, 0x24, 0xd5, 0xee, 0x5e,
-	0xd7, 0xea, 0xd9, 0x61, 0x79, 0xc3, 0xef, 0x11, 0x8a, 0x61, 0x0e, 0x09, 0xd1, 0x4c, 0x70, 0x77,
-	0xb7, 0xc0, 0xbd, 0xbe, 0xb9, 0xef, 0x34, 0xfe, 0xdc, 0x77, 0x0e, 0x0c, 0x52, 0xc5, 0x99, 0xcf,
-	0x44, 0xb0, 0x20, 0x3a, 0xf5, 0x2f, 0xb8, 0x0e, 0x6b, 0x06, 0xfc, 0x15, 0xbd, 0x52, 0x73, 0xa2,
-	0xd2, 0xe8, 0xdb, 0x25, 0xc8, 0x55, 0xbe, 0x64, 0x92, 0x7f, 0x17, 0x69, 0x49, 0x68, 0x06, 0xd2,
-	0x6d, 0x3e, 0x06, 0x78, 0x54, 0x20, 0x3e, 0xe5, 0x84, 0x8f, 0x25, 0x60, 0x6a, 0xfc, 0x78, 0x82,
-	0x0e, 0xeb, 0x7c, 0x9a, 0x02, 0xcd, 0x96, 0x82, 0x71, 0xed, 0x3e, 0x7b, 0x0c, 0xb9, 0x5d, 0x91,
-	0x87, 0x0f, 0x56, 0x1c, 0xa3, 0x03, 0x95, 0x12, 0x09, 0x2a, 0xd2, 0x22, 0xd2, 0x22, 0x03, 0xae,
-	0x22, 0x49, 0x34, 0xb8, 0xa8, 0x60, 0xbe, 0x2d, 0x99, 0x27, 0xff, 0x33, 0xc7, 0x90, 0x10, 0xba,
-	0x3a, 0x07, 0x7a, 0x77, 0xdd, 0x47, 0x65, 0xe0, 0xe7, 0x40, 0x43, 0x6c, 0x78, 0x53, 0x31, 0x2d,
-	0x68, 0x21, 0xd1, 0x80, 0x87, 0xc8, 0xab, 0x82, 0x8a, 0x68, 0x4a, 0x78, 0x02, 0x2a, 0x62, 0xfc,
-	0x21, 0x24, 0xf7, 0x69, 0xd7, 0xea, 0xed, 0x84, 0x27, 0x95, 0x6a, 0x68, 0x44, 0x17, 0x7c, 0x1b,
-	0x03, 0x7e, 0x87, 0x5e, 0xd6, 0xf7, 0xaf, 0xbb, 0x9f, 0x77, 0xad, 0x9e, 0x53, 0xdf, 0xb0, 0xb2,
-	0x8d, 0x6c, 0x67, 0xa7, 0x65, 0x8f, 0x6c, 0xc7, 0x6e, 0xed, 0x8e, 0x6c, 0x67, 0xbf, 0xe5, 0x8c,
-	0x6c, 0xc7, 0x69, 0x35, 0xcf, 0xc6, 0x37, 0x6b, 0xcf, 0xba, 0x5d, 0x7b, 0xd6, 0xdf, 0xb5, 0x67,
-	0xfd, 0xdc, 0x78, 0x8d, 0xdb, 0x8d, 0xd7, 0xf8, 0xb5, 0xf1, 0x1a, 0x5f, 0x4e, 0x13, 0xa6, 0xd3,
-	0xcb, 0x99, 0x4f, 0xc5, 0x22, 0x98, 0x14, 0x15, 0xee, 0x8f, 0xc9, 0x4c, 0x05, 0x65, 0xdf, 0xaf,
-	0x06, 0x83, 0xe0, 0x47, 0xd5, 0x7a, 0xbd, 0x5a, 0x82, 0x9a, 0xed, 0x15, 0x85, 0x1d, 0xfc, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0x70, 0x0a, 0x76, 0x38, 0x15, 0x03, 0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x4f, 0xdb, 0x4c,
+	0x10, 0xc7, 0x63, 0x30, 0xc1, 0x59, 0x9e, 0x07, 0xf2, 0x2c, 0x2f, 0x8f, 0x79, 0xa9, 0x13, 0x71,
+	0xca, 0x85, 0x58, 0x05, 0xf5, 0xd8, 0x43, 0x49, 0x2e, 0xa4, 0xa8, 0xa2, 0x06, 0xf5, 0x80, 0xd4,
+	0x5a, 0x9b, 0xf5, 0xd4, 0xde, 0x26, 0xd9, 0x4d, 0x77, 0x17, 0x5a, 0xbe, 0x45, 0xbf, 0x40, 0xbf,
+	0x05, 0x1f, 0x82, 0x23, 0xe2, 0x54, 0xf5, 0x80, 0x2a, 0xf8, 0x22, 0x95, 0xd7, 0x4e, 0x6c, 0x89,
+	0x1e, 0xa2, 0xde, 0x76, 0x66, 0xff, 0xf3, 0xf3, 0xce, 0x78, 0x66, 0x50, 0x43, 0x69, 0xc9, 0x22,
+	0xf0, 0x95, 0x26, 0x03, 0x60, 0x7d, 0xea, 0x5f, 0x92, 0x21, 0x8b, 0x88, 0x16, 0xb2, 0x3d, 0x96,
+	0x42, 0x0b, 0xbc, 0x92, 0x09, 0xda, 0x13, 0xc1, 0xd6, 0x26, 0x15, 0x6a, 0x24, 0x54, 0x68, 0xae,
+	0xfd, 0xcc, 0xc8, 0xb4, 0x5b, 0x6b, 0xb1, 0x88, 0x45, 0xe6, 0x4f, 0x4f, 0x99, 0x77, 0xf7, 0xfb,
+	0x22, 0xaa, 0xbd, 0x9b, 0x50, 0x31, 0x46, 0x36, 0x27, 0x23, 0x70, 0xad, 0xa6, 0xd5, 0xaa, 0x05,
+	0xe6, 0x8c, 0xf7, 0xd1, 0x22, 0x89, 0x22, 0x09, 0x4a, 0xb9, 0x73, 0xa9, 0xfb, 0xd0, 0xbd, 0xbb,
+	0xde, 0x5b, 0xcb, 0xd1, 0xaf, 0xb2, 0x9b, 0x53, 0x2d, 0x19, 0x8f, 0x83, 0x89, 0x10, 0x6f, 0xa0,
+	0xea, 0x17, 0x60, 0x71, 0xa2, 0xdd, 0x6a, 0xd3, 0x6a, 0xd9, 0x41, 0x6e, 0xe1, 0x97, 0x08, 0x45,
+	0x30, 0x84, 0x98, 0x68, 0x26, 0xb8, 0xbb, 0x60, 0x70, 0xcf, 0x6e, 0xee, 0x1b, 0x95, 0x9f, 0xf7,
+	0x8d, 0xf5, 0x0c, 0xa9, 0xa2, 0x41, 0x9b, 0x09, 0x7f, 0x44, 0x74, 0xd2, 0x3e, 0xe2, 0x3a, 0x28,
+	0x05, 0xe0, 0x0f, 0x68, 0x47, 0x0d, 0x89, 0x4a, 0xc2, 0xcf, 0x17, 0x20, 0xaf, 0xd2, 0x24, 0xe3,
+	0xf4, 0x73, 0xa1, 0x96, 0x84, 0x0e, 0x40, 0xba, 0xb5, 0x59, 0x80, 0x9b, 0x06, 0xf1, 0x36, 0x25,
+	0x9c, 0xe4, 0x80, 0xb3, 0x2c, 0x1e, 0x9f, 0xa2, 0x8d, 0x32, 0x9f, 0x26, 0x40, 0x07, 0x63, 0xc1,
+	0xb8, 0x76, 0xff, 0x99, 0x85, 0xbc, 0x56, 0x90, 0x3b, 0xd3, 0x50, 0x1c, 0xa1, 0x75, 0x95, 0x10,
+	0x09, 0x2a, 0xd4, 0x22, 0xd4, 0x62, 0x00, 0x5c, 0x85, 0x92, 0x68, 0x70, 0x91, 0x61, 0x3e, 0xcf,
+	0x99, 0xdb, 0x4f, 0x99, 0xc7, 0x10, 0x13, 0x7a, 0xd5, 0x05, 0x7a, 0x77, 0xbd, 0x87, 0xf2, 0x82,
+	0x77, 0x81, 0x06, 0x38, 0xe3, 0x9d, 0x89, 0x33, 0x43, 0x0b, 0x88, 0x06, 0xdc, 0x41, 0x5e, 0x51,
+	0xa8, 0x90, 0x26, 0x84, 0xc7, 0xa0, 0x42, 0xc6, 0xa7, 0x45, 0x72, 0x97, 0x9a, 0x56, 0x6b, 0x3e,
+	0xd8, 0x2e, 0x54, 0x9d, 0x4c, 0x74, 0xc4, 0x27, 0x65, 0xc0, 0x2f, 0xd0, 0xff, 0xe5, 0xfc, 0xcb,
+	0xd1, 0xff, 0x36, 0xad, 0x96, 0x53, 0xce, 0xb0, 0x14, 0xb6, 0x81, 0xaa, 0x9f, 0x08, 0x1b, 0x42,
+	0xe4, 0x2e, 0x1b, 0x55, 0x6e, 0xe1, 0x73, 0xb4, 0x42, 0xc5, 0x68, 0xc4, 0x94, 0x4a, 0xdf, 0x64,
+	0x72, 0x5e, 0xf9, 0xdb, 0x9c, 0x97, 0x0b, 0x92, 0xc9, 0xb7, 0x81, 0x96, 0xb2, 0xa7, 0x52, 0x71,
+	0xc1, 0xb5, 0x5b, 0x37, 0x6d, 0x86, 0x8c, 0xab, 0x93, 0x7a, 0xf0, 0x1b, 0xb4, 0x1a, 0x31, 0x09,
+	0x54, 0x43, 0x14, 0x96, 0x7a, 0xee, 0xbf, 0x59, 0x7e, 0x24, 0x9e, 0x44, 0x76, 0x8b, 0xde, 0x7b,
+	0x8f, 0xb6, 0xc7, 0xc0, 0x23, 0xc6, 0xe3, 0xf0, 0x4f, 0x5c, 0x3c, 0x53, 0xeb, 0xe5, 0x84, 0xee,
+	0x13, 0x7c, 0xcf, 0x76, 0xe6, 0xeb, 0x76, 0xcf, 0x76, 0xec, 0xfa, 0x42, 0xcf, 0x76, 0x16, 0xeb,
+	0x4e, 0xcf, 0x76, 0x9c, 0x7a, 0x6d, 0xf7, 0x35, 0x5a, 0x9d, 0x8e, 0xe7, 0x89, 0x84, 0x8f, 0x20,
+	0x81, 0x53, 0xc0, 0x3b, 0xa8, 0x36, 0xdd, 0x05, 0xf9, 0xb4, 0x16, 0x8e, 0xd2, 0xf8, 0xcd, 0x95,
+	0xc7, 0xef, 0xf0, 0xf8, 0xe6, 0xc1, 0xb3, 0x6e, 0x1f, 0x3c, 0xeb, 0xd7, 0x83, 0x67, 0x7d, 0x7b,
+	0xf4, 0x2a, 0xb7, 0x8f, 0x5e, 0xe5, 0xc7, 0xa3, 0x57, 0x39, 0xdf, 0x8f, 0x99, 0x4e, 0x2e, 0xfa,
+	0x6d, 0x2a, 0x46, 0xfe, 0xa9, 0xd9, 0x29, 0x7b, 0xc7, 0xa4, 0xaf, 0xfc, 0x7c, 0x01, 0x5d, 0x1e,
+	0x1c, 0xf8, 0x5f, 0x8b, 0x35, 0xa4, 0xaf, 0xc6, 0xa0, 0xfa, 0x55, 0xb3, 0x41, 0x0e, 0x7e, 0x0f,
+	0x00, 0xcb, 0x23, 0x50, 0x5e, 0xa6, 0x04, 0x00, 0x00,
 }
 
 func (m *Validator) Marshal() (dAtA []byte, err error) {
@@ -165,6 +256,57 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PendingDirectedDelegation.Size()
+		i -= size
+		if _, err := m.PendingDirectedDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.DirectedDelegation.Size()
+		i -= size
+		if _, err := m.DirectedDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.SlashCount != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.SlashCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.SlashQueryInProgress {
 		i--
 		if m.SlashQueryInProgress {
@@ -242,6 +384,41 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintValidator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovValidator(v)
 	base := offset
@@ -284,6 +461,34 @@ func (m *Validator) Size() (n int) {
 	if m.SlashQueryInProgress {
 		n += 2
 	}
+	if m.Jailed {
+		n += 2
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	if m.SlashCount != 0 {
+		n += 2 + sovValidator(uint64(m.SlashCount))
+	}
+	l = m.DirectedDelegation.Size()
+	n += 2 + l + sovValidator(uint64(l))
+	l = m.PendingDirectedDelegation.Size()
+	n += 2 + l + sovValidator(uint64(l))
+	return n
+}
+
+func (m *ValidatorPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovValidator(uint64(m.Weight))
+	}
 	return n
 }
 
@@ -580,6 +785,248 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SlashQueryInProgress = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+			}
+			m.SlashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectedDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DirectedDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDirectedDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingDirectedDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidator(dAtA[iNdEx:])
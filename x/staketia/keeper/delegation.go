@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v33/utils"
+	stakeibctypes "github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 	"github.com/Stride-Labs/stride/v33/x/staketia/types"
 )
 
@@ -123,6 +124,9 @@ func (k Keeper) ConfirmDelegation(ctx sdk.Context, recordId uint64, txHash, send
 
 // Runs prepare delegations with a cache context wrapper so revert any partial state changes
 func (k Keeper) SafelyPrepareDelegation(ctx sdk.Context, epochNumber uint64, epochDuration time.Duration) error {
+	if err := k.CheckCircuitBreaker(ctx, stakeibctypes.PAUSE_DELEGATE_ICA); err != nil {
+		return err
+	}
 	return utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
 		return k.PrepareDelegation(ctx, epochNumber, epochDuration)
 	})
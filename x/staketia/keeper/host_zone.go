@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	stakeibctypes "github.com/Stride-Labs/stride/v33/x/stakeibc/types"
 	"github.com/Stride-Labs/stride/v33/x/staketia/types"
 )
 
@@ -45,3 +46,12 @@ func (k Keeper) GetUnhaltedHostZone(ctx sdk.Context) (hostZone types.HostZone, e
 	}
 	return hostZone, nil
 }
+
+// Returns an error if the action has been paused for the host zone by the circuit breaker in stakeibc
+func (k Keeper) CheckCircuitBreaker(ctx sdk.Context, action stakeibctypes.CircuitBreakerAction) error {
+	hostZone, err := k.GetHostZone(ctx)
+	if err != nil {
+		return err
+	}
+	return k.stakeibcKeeper.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, action)
+}
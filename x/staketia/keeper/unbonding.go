@@ -42,6 +42,11 @@ func (k Keeper) RedeemStake(
 		return nativeToken, types.ErrRedemptionsDisabled
 	}
 
+	// Confirm redemptions have not been paused by the circuit breaker
+	if err := k.stakeibcKeeper.CheckCircuitBreaker(ctx, types.ModuleName, hostZone.ChainId, stakeibctypes.PAUSE_REDEEM); err != nil {
+		return nativeToken, err
+	}
+
 	// Check that the redemption address exists as the escrow account
 	escrowAccount, err := sdk.AccAddressFromBech32(hostZone.RedemptionAddress)
 	if err != nil {
@@ -453,6 +458,9 @@ func (k Keeper) DistributeClaimsForUnbondingRecord(
 
 // Runs prepare undelegations with a cache context wrapper so revert any partial state changes
 func (k Keeper) SafelyPrepareUndelegation(ctx sdk.Context, epochNumber uint64) error {
+	if err := k.CheckCircuitBreaker(ctx, stakeibctypes.PAUSE_UNDELEGATE_ICA); err != nil {
+		return err
+	}
 	return utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
 		return k.PrepareUndelegation(ctx, epochNumber)
 	})
@@ -460,5 +468,8 @@ func (k Keeper) SafelyPrepareUndelegation(ctx sdk.Context, epochNumber uint64) e
 
 // Runs distribute claims with a cache context wrapper so revert any partial state changes
 func (k Keeper) SafelyDistributeClaims(ctx sdk.Context) error {
+	if err := k.CheckCircuitBreaker(ctx, stakeibctypes.PAUSE_CLAIM); err != nil {
+		return err
+	}
 	return utils.ApplyFuncIfNoError(ctx, k.DistributeClaims)
 }
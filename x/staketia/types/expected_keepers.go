@@ -54,6 +54,7 @@ type StakeibcKeeper interface {
 	RedeemStake(ctx sdk.Context, msg *stakeibctypes.MsgRedeemStake) (*stakeibctypes.MsgRedeemStakeResponse, error)
 	EnableRedemptions(ctx sdk.Context, chainId string) error
 	RegisterHostZone(ctx sdk.Context, msg *stakeibctypes.MsgRegisterHostZone) (*stakeibctypes.MsgRegisterHostZoneResponse, error)
+	CheckCircuitBreaker(ctx sdk.Context, module, chainId string, action stakeibctypes.CircuitBreakerAction) error
 }
 
 // Required RecordsKeeper functions